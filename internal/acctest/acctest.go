@@ -17,9 +17,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/acctest/cassette"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/acctest/fakencp"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/provider"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/provider/fwprovider"
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/verify"
@@ -51,6 +53,29 @@ var credsEnvVars = []string{
 
 var regionEnvVar = "NCLOUD_REGION"
 
+// fakeNcpEnvVar, when set, runs acceptance tests against the fake ncloud API
+var fakeNcpEnvVar = "NCLOUD_ACC_FAKE"
+
+var (
+	fakeNcpServer      *fakencp.Server
+	fakeNcpServerStart sync.Once
+)
+
+// cassetteModeEnvVar, when set to record or replay, records the API calls of the acceptance tests of a package
+// to a cassette, or replays them without ncloud. cassetteEnvVar overrides the path of the cassette.
+var (
+	cassetteModeEnvVar = "NCLOUD_ACC_RECORD"
+	cassetteEnvVar     = "NCLOUD_ACC_CASSETTE"
+)
+
+const defaultCassettePath = "testdata/ncloud.cassette.jsonl"
+
+var (
+	cassetteRecorder    *cassette.Recorder
+	cassetteRecorderErr error
+	cassetteRecorderNew sync.Once
+)
+
 func init() {
 	testAccProvider = getTestAccProvider(true)
 	testAccClassicProvider = getTestAccProvider(false)
@@ -73,17 +98,109 @@ func GetTestProvider(isVpc bool) *schema.Provider {
 
 func getTestAccProvider(isVpc bool) *schema.Provider {
 	p := provider.New(context.Background())
-	p.ConfigureContextFunc = func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+	p.ConfigureContextFunc = testAccProviderConfigureFunc(isVpc)
+	return p
+}
+
+func testAccProviderConfigureFunc(isVpc bool) schema.ConfigureContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 		d.Set("region", testAccGetRegion())
 		d.Set("support_vpc", isVpc)
+
+		if IsFakeNcp() {
+			d.Set("access_key", "fake-access-key")
+			d.Set("secret_key", "fake-secret-key")
+			d.Set("endpoints", []interface{}{fakeNcpEndpoints()})
+		}
+
+		if cassetteMode() != "" {
+			recorder, err := cassetteTransport()
+			if err != nil {
+				return nil, diag.FromErr(err)
+			}
+			if cassetteMode() == cassette.ModeReplay {
+				d.Set("access_key", "replay-access-key")
+				d.Set("secret_key", "replay-secret-key")
+			}
+			ctx = conn.ContextWithTransport(ctx, recorder)
+		}
+
 		return provider.ProviderConfigure(ctx, d)
 	}
-	return p
+}
+
+// IsFakeNcp reports whether acceptance tests run against the fake ncloud API of package fakencp instead of ncloud
+func IsFakeNcp() bool {
+	return os.Getenv(fakeNcpEnvVar) != ""
+}
+
+// fakeNcpEndpoints starts the fake ncloud API shared by the tests of the package, once
+func fakeNcpEndpoints() map[string]interface{} {
+	fakeNcpServerStart.Do(func() {
+		fakeNcpServer = fakencp.NewServer()
+		log.Printf("[INFO] Test: Using the fake ncloud API at %s", fakeNcpServer.URL)
+	})
+
+	endpoints := map[string]interface{}{}
+	for service, endpoint := range fakeNcpServer.Endpoints() {
+		endpoints[service] = endpoint
+	}
+	return endpoints
+}
+
+func cassetteMode() string {
+	return os.Getenv(cassetteModeEnvVar)
+}
+
+// IsReplaying reports whether acceptance tests replay the API calls of a cassette instead of calling ncloud
+func IsReplaying() bool {
+	return cassetteMode() == cassette.ModeReplay
+}
+
+// cassetteTransport opens the cassette shared by the tests of the package, once.
+// Tests run in the directory of their package, so each package has its own cassette.
+func cassetteTransport() (*cassette.Recorder, error) {
+	cassetteRecorderNew.Do(func() {
+		path := os.Getenv(cassetteEnvVar)
+		if path == "" {
+			path = defaultCassettePath
+		}
+
+		cassetteRecorder, cassetteRecorderErr = cassette.New(cassetteMode(), path, nil,
+			os.Getenv("NCLOUD_ACCESS_KEY"), os.Getenv("NCLOUD_SECRET_KEY"))
+		if cassetteRecorderErr == nil {
+			log.Printf("[INFO] Test: Using cassette %s to %s API calls", path, cassetteMode())
+		}
+	})
+	return cassetteRecorder, cassetteRecorderErr
+}
+
+// flushCassette writes the interactions recorded by the tests of the package so far to their cassette
+func flushCassette(t *testing.T) {
+	recorder, err := cassetteTransport()
+	if err != nil {
+		return
+	}
+	if err := recorder.Flush(); err != nil {
+		t.Errorf("writing cassette: %s", err)
+	}
 }
 
 func TestAccPreCheck(t *testing.T) {
+	if cassetteMode() == cassette.ModeRecord {
+		t.Cleanup(func() { flushCassette(t) })
+	}
+
 	testAccProviderConfigure.Do(func() {
-		if v := multiEnvSearch(credsEnvVars); v == "" {
+		if IsFakeNcp() {
+			log.Printf("[INFO] Test: Using the fake ncloud API, which only supports VPC")
+			if diags := testAccProvider.Configure(context.Background(), terraform.NewResourceConfigRaw(nil)); diags.HasError() {
+				t.Fatalf("configuring provider: %v", diags)
+			}
+			return
+		}
+
+		if v := multiEnvSearch(credsEnvVars); v == "" && !IsReplaying() {
 			t.Fatalf("One of %s must be set for acceptance tests", strings.Join(credsEnvVars, ", "))
 		}
 
@@ -102,6 +219,10 @@ func TestAccPreCheck(t *testing.T) {
 }
 
 func testAccGetRegion() string {
+	if IsFakeNcp() {
+		return fakencp.RegionCode
+	}
+
 	v := os.Getenv(regionEnvVar)
 	if v == "" {
 		return "KR"
@@ -118,9 +239,9 @@ func multiEnvSearch(ks []string) string {
 	return ""
 }
 
-func GetTestPrefix() string {
-	rand := acctest.RandString(5)
-	return fmt.Sprintf("tf%s", rand)
+func GetTestPrefix(t testing.TB) string {
+	rand := RandString(t, 5)
+	return fmt.Sprintf("tf-%s", rand)
 }
 
 func ComposeConfig(config ...string) string {
@@ -175,8 +296,8 @@ func TestAccValidateOneResult(t *testing.T) {
 	}
 }
 
-func GetTestClusterName() string {
-	rInt := acctest.RandIntRange(1, 9999)
+func GetTestClusterName(t testing.TB) string {
+	rInt := RandIntRange(t, 1, 9999)
 	testClusterName := fmt.Sprintf("tf-%d-cluster", rInt)
 	return testClusterName
 }
@@ -201,11 +322,7 @@ func protoV6ProviderFactoriesInit(ctx context.Context, isVpc bool, providerNames
 
 func protoV6TestProviderServerFactory(ctx context.Context, isVpc bool) (func() tfprotov6.ProviderServer, *schema.Provider, error) {
 	primary := provider.New(ctx)
-	primary.ConfigureContextFunc = func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
-		d.Set("region", testAccGetRegion())
-		d.Set("support_vpc", isVpc)
-		return provider.ProviderConfigure(ctx, d)
-	}
+	primary.ConfigureContextFunc = testAccProviderConfigureFunc(isVpc)
 
 	upgradedSdkProvider, err := tf5to6server.UpgradeServer(
 		ctx,
@@ -228,5 +345,11 @@ func protoV6TestProviderServerFactory(ctx context.Context, isVpc bool) (func() t
 		return nil, nil, err
 	}
 
-	return muxServer.ProviderServer, primary, nil
+	if err := provider.RegisterSensitiveAttributes(ctx, muxServer.ProviderServer()); err != nil {
+		return nil, nil, err
+	}
+
+	return func() tfprotov6.ProviderServer {
+		return provider.WithNcpErrorDetails(muxServer.ProviderServer())
+	}, primary, nil
 }
@@ -0,0 +1,329 @@
+// Package cassette records the API calls of acceptance tests to a file, and replays them without ncloud.
+//
+// A cassette is a JSON lines file of interactions, a request and its response each.
+// Recording merges with the interactions already in the cassette: those of the requests recorded again are replaced,
+// the others are kept, so the tests of a package can be recorded one at a time.
+// Credentials are never written: request headers are dropped, signatures are removed from URLs,
+// and the access and secret keys are replaced wherever they appear.
+package cassette
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+const (
+	ModeRecord = "record"
+	ModeReplay = "replay"
+
+	// redacted replaces the secrets in recorded interactions
+	redacted = "REDACTED"
+)
+
+// sensitiveQueryParams are the query parameters of presigned S3 requests carrying credentials
+var sensitiveQueryParams = []string{
+	"X-Amz-Credential",
+	"X-Amz-Signature",
+	"X-Amz-Security-Token",
+}
+
+type Request struct {
+	Method string `json:"method"`
+	URL    string `json:"url"`
+	Body   string `json:"body,omitempty"`
+}
+
+type Response struct {
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       string      `json:"body,omitempty"`
+}
+
+type Interaction struct {
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+}
+
+func (r Request) key() string {
+	return r.Method + " " + r.URL + "\n" + r.Body
+}
+
+// Recorder is a transport recording the interactions with its base transport to a cassette,
+// or replaying the interactions of a cassette.
+//
+// Interactions are replayed by request, in the order they were recorded: waiting for a status sends the same request
+// again and again, getting each recorded response in turn. The last response of a request is replayed once they are used up.
+type Recorder struct {
+	Base http.RoundTripper
+
+	mode    string
+	path    string
+	secrets []string
+
+	mu           sync.Mutex
+	closed       bool
+	interactions map[string][]Interaction
+	replayed     map[string]int
+
+	// order is the order of the first interaction of each request, in which they are written
+	order []string
+	// recorded are the requests recorded by r, whose interactions replace those loaded from the cassette
+	recorded map[string]bool
+}
+
+// New returns a recorder of the cassette at path, in mode ModeRecord or ModeReplay.
+// secrets, e.g. the access and secret keys, are redacted from recorded interactions.
+func New(mode, path string, base http.RoundTripper, secrets ...string) (*Recorder, error) {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	r := &Recorder{
+		Base:         base,
+		mode:         mode,
+		path:         path,
+		interactions: map[string][]Interaction{},
+		replayed:     map[string]int{},
+		recorded:     map[string]bool{},
+	}
+	for _, secret := range secrets {
+		if secret != "" {
+			r.secrets = append(r.secrets, secret)
+		}
+	}
+
+	switch mode {
+	case ModeRecord:
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			return nil, err
+		}
+		if err := r.load(); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return nil, err
+		}
+	case ModeReplay:
+		if err := r.load(); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unknown cassette mode %q, expected %q or %q", mode, ModeRecord, ModeReplay)
+	}
+
+	return r, nil
+}
+
+func (r *Recorder) load() error {
+	file, err := os.Open(r.path)
+	if err != nil {
+		return fmt.Errorf("reading cassette: %w", err)
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(nil, 64*1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		if len(bytes.TrimSpace(scanner.Bytes())) == 0 {
+			continue
+		}
+
+		var i Interaction
+		if err := json.Unmarshal(scanner.Bytes(), &i); err != nil {
+			return fmt.Errorf("reading cassette %s, line %d: %w", r.path, line, err)
+		}
+		r.add(i)
+	}
+	return scanner.Err()
+}
+
+func (r *Recorder) add(i Interaction) {
+	key := i.Request.key()
+	if _, ok := r.interactions[key]; !ok {
+		r.order = append(r.order, key)
+	}
+	r.interactions[key] = append(r.interactions[key], i)
+}
+
+// Flush writes the cassette being recorded: the interactions recorded so far, and the loaded interactions
+// of the requests not recorded again
+func (r *Recorder) Flush() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.flush()
+}
+
+func (r *Recorder) flush() error {
+	if r.mode != ModeRecord || r.closed {
+		return nil
+	}
+
+	var buf bytes.Buffer
+	for _, key := range r.order {
+		for _, i := range r.interactions[key] {
+			line, err := json.Marshal(i)
+			if err != nil {
+				return err
+			}
+			buf.Write(append(line, '\n'))
+		}
+	}
+
+	// the cassette is replaced at once, for an interrupted test run not to leave it half written
+	tmp := r.path + ".tmp"
+	if err := os.WriteFile(tmp, buf.Bytes(), 0644); err != nil {
+		return fmt.Errorf("writing cassette: %w", err)
+	}
+	if err := os.Rename(tmp, r.path); err != nil {
+		return fmt.Errorf("writing cassette: %w", err)
+	}
+	return nil
+}
+
+// Close writes the cassette being recorded, see Flush. Interactions aren't recorded anymore once closed.
+func (r *Recorder) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	err := r.flush()
+	r.closed = true
+	return err
+}
+
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	recorded := Request{
+		Method: req.Method,
+		URL:    r.scrub(scrubURL(req.URL)),
+		Body:   r.scrub(string(body)),
+	}
+
+	if r.mode == ModeReplay {
+		return r.replay(req, recorded)
+	}
+	return r.record(req, recorded)
+}
+
+func (r *Recorder) replay(req *http.Request, recorded Request) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := recorded.key()
+	interactions := r.interactions[key]
+	if len(interactions) == 0 {
+		return nil, fmt.Errorf("cassette %s has no interaction for %s %s, record it again", r.path, recorded.Method, recorded.URL)
+	}
+
+	i := r.replayed[key]
+	if i < len(interactions)-1 {
+		r.replayed[key]++
+	} else {
+		i = len(interactions) - 1
+	}
+
+	resp := interactions[i].Response
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", resp.StatusCode, http.StatusText(resp.StatusCode)),
+		StatusCode:    resp.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        resp.Header.Clone(),
+		Body:          io.NopCloser(strings.NewReader(resp.Body)),
+		ContentLength: int64(len(resp.Body)),
+		Request:       req,
+	}, nil
+}
+
+func (r *Recorder) record(req *http.Request, recorded Request) (*http.Response, error) {
+	resp, err := r.Base.RoundTrip(req)
+	if err != nil {
+		return resp, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	header := resp.Header.Clone()
+	header.Del("Set-Cookie")
+
+	i := Interaction{
+		Request: recorded,
+		Response: Response{
+			StatusCode: resp.StatusCode,
+			Header:     header,
+			Body:       r.scrub(string(body)),
+		},
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.closed {
+		return nil, fmt.Errorf("cassette %s is closed", r.path)
+	}
+
+	key := recorded.key()
+	if _, loaded := r.interactions[key]; loaded && !r.recorded[key] {
+		// the first interaction recorded for a request replaces those loaded from the cassette
+		r.interactions[key] = nil
+	}
+	r.recorded[key] = true
+	r.add(i)
+	return resp, nil
+}
+
+// scrub replaces the secrets in s
+func (r *Recorder) scrub(s string) string {
+	for _, secret := range r.secrets {
+		s = strings.ReplaceAll(s, secret, redacted)
+	}
+	return s
+}
+
+// readRequestBody reads the body of req, leaving it readable by the base transport
+func readRequestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+
+	body, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	return body, nil
+}
+
+func scrubURL(u *url.URL) string {
+	scrubbed := *u
+	query := scrubbed.Query()
+	found := false
+	for _, param := range sensitiveQueryParams {
+		if query.Has(param) {
+			query.Set(param, redacted)
+			found = true
+		}
+	}
+	if found {
+		scrubbed.RawQuery = query.Encode()
+	}
+	return scrubbed.String()
+}
@@ -0,0 +1,177 @@
+package cassette
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+const (
+	testAccessKey = "test-access-key"
+	testSecretKey = "test-secret-key"
+)
+
+func post(t *testing.T, client *http.Client, url, body string) (int, string) {
+	t.Helper()
+
+	req, err := http.NewRequest(http.MethodPost, url, strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("x-ncp-iam-access-key", testAccessKey)
+	req.Header.Set("x-ncp-apigw-signature-v2", "signature")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return resp.StatusCode, string(b)
+}
+
+func TestRecordReplay(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&calls, 1)
+		b, _ := io.ReadAll(r.Body)
+		if string(b) == "status" {
+			if n < 3 {
+				io.WriteString(w, "INIT")
+			} else {
+				io.WriteString(w, "RUN")
+			}
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		io.WriteString(w, "created by "+testAccessKey)
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "testdata", "cassette.jsonl")
+
+	recorder, err := New(ModeRecord, path, nil, testAccessKey, testSecretKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := &http.Client{Transport: recorder}
+
+	if status, body := post(t, client, server.URL+"/create", "name=a"); status != http.StatusCreated || body != "created by "+testAccessKey {
+		t.Fatalf("recording got %d %q", status, body)
+	}
+	for _, want := range []string{"INIT", "RUN", "RUN"} {
+		if _, body := post(t, client, server.URL+"/status", "status"); body != want {
+			t.Fatalf("recording got %q, want %q", body, want)
+		}
+	}
+	if err := recorder.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, secret := range []string{testAccessKey, testSecretKey, "signature"} {
+		if strings.Contains(string(b), secret) {
+			t.Errorf("cassette contains %q:\n%s", secret, b)
+		}
+	}
+
+	server.Close()
+	replayer, err := New(ModeReplay, path, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client = &http.Client{Transport: replayer}
+
+	if status, body := post(t, client, server.URL+"/create", "name=a"); status != http.StatusCreated || body != "created by "+redacted {
+		t.Fatalf("replay got %d %q", status, body)
+	}
+	// statuses are replayed in order, the last one once they are used up
+	for _, want := range []string{"INIT", "RUN", "RUN", "RUN"} {
+		if _, body := post(t, client, server.URL+"/status", "status"); body != want {
+			t.Fatalf("replay got %q, want %q", body, want)
+		}
+	}
+
+	req, _ := http.NewRequest(http.MethodPost, server.URL+"/create", strings.NewReader("name=b"))
+	if _, err := client.Do(req); err == nil || !strings.Contains(err.Error(), "no interaction") {
+		t.Fatalf("expected a missing interaction error, got %v", err)
+	}
+}
+
+func TestScrubPresignedURL(t *testing.T) {
+	req, _ := http.NewRequest(http.MethodGet, "https://kr.object.ncloudstorage.com/bucket/key?X-Amz-Credential=test-access-key%2F20240101&X-Amz-Signature=abc&x-id=GetObject", nil)
+
+	got := scrubURL(req.URL)
+	if strings.Contains(got, "abc") || strings.Contains(got, testAccessKey) {
+		t.Errorf("presigned URL not scrubbed: %s", got)
+	}
+	if !strings.Contains(got, "x-id=GetObject") {
+		t.Errorf("scrubbed URL lost parameters: %s", got)
+	}
+}
+
+func TestUnknownMode(t *testing.T) {
+	if _, err := New("rewind", filepath.Join(t.TempDir(), "cassette.jsonl"), nil); err == nil {
+		t.Fatal("expected an error for an unknown mode")
+	}
+}
+
+func TestRecordMerge(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		io.WriteString(w, "created "+string(b))
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "cassette.jsonl")
+
+	record := func(names ...string) {
+		recorder, err := New(ModeRecord, path, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		client := &http.Client{Transport: recorder}
+		for _, name := range names {
+			post(t, client, server.URL+"/create", name)
+		}
+		if err := recorder.Close(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// each recording is a test of the package, recorded again on its own
+	record("a", "b")
+	record("b", "c")
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lines := strings.Count(string(b), "\n"); lines != 3 {
+		t.Fatalf("expected 3 interactions, got %d:\n%s", lines, b)
+	}
+
+	server.Close()
+	replayer, err := New(ModeReplay, path, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := &http.Client{Transport: replayer}
+	for _, name := range []string{"a", "b", "c"} {
+		if _, body := post(t, client, server.URL+"/create", name); body != "created "+name {
+			t.Fatalf("replay got %q, want %q", body, "created "+name)
+		}
+	}
+}
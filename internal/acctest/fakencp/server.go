@@ -0,0 +1,496 @@
+// Package fakencp is an in-memory fake of the ncloud API, for running acceptance tests without an account.
+//
+// It serves the vpc, vserver and vloadbalancer endpoints the VPC, subnet, NAT gateway, server, access control group,
+// block storage and load balancer resources use. Objects go through the same statuses as on ncloud,
+// each intermediate status lasting StepDuration.
+package fakencp
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
+)
+
+const (
+	// DefaultStepDuration is how long each intermediate status lasts by default
+	DefaultStepDuration = 100 * time.Millisecond
+
+	RegionCode = "KR"
+)
+
+// Zones are the zones of the fake region
+var Zones = []string{"KR-1", "KR-2"}
+
+// terminated is the status of an object which is gone
+const terminated = "TERMINATED"
+
+// status is the status code, operation code and status name of an object at one step of its lifecycle
+type status struct {
+	code      string
+	operation string
+	name      string
+}
+
+type object struct {
+	no    string
+	value interface{}
+
+	// apply copies the status to the status fields of value
+	apply func(status)
+
+	stages []status
+	since  time.Time
+}
+
+type apiError struct {
+	statusCode int
+	code       string
+	message    string
+}
+
+func (e *apiError) Error() string {
+	return fmt.Sprintf("%s: %s", e.code, e.message)
+}
+
+// notFoundCodes are the return codes ncloud answers when an object of the kind doesn't exist.
+// The other kinds answer invalidParameterCode, as their code isn't known.
+var notFoundCodes = map[string]string{
+	kindAccessControlGroup: common.ApiErrorAcgNotFound,
+	kindRouteTable:         common.ApiErrorRouteTableNotFound,
+}
+
+// inOperationCodes are the return codes ncloud answers when an object of the kind is busy,
+// common.ApiErrorObjectInOperation for the other kinds
+var inOperationCodes = map[string]string{
+	kindServer:             common.ApiErrorServerObjectInOperation2,
+	kindAccessControlGroup: common.ApiErrorAcgCantChangeSameTime,
+	kindNetworkAcl:         common.ApiErrorNetworkAclRuleChangeIngRules,
+	kindLoadBalancer:       "1200004",
+}
+
+const invalidParameterCode = "1000002"
+
+func notFound(kind, no string) *apiError {
+	code, ok := notFoundCodes[kind]
+	if !ok {
+		code = invalidParameterCode
+	}
+	return &apiError{http.StatusBadRequest, code, fmt.Sprintf("%s (%s) not found", kind, no)}
+}
+
+func invalidParameter(format string, a ...interface{}) *apiError {
+	return &apiError{http.StatusBadRequest, invalidParameterCode, fmt.Sprintf(format, a...)}
+}
+
+func inOperation(kind, no string) *apiError {
+	code, ok := inOperationCodes[kind]
+	if !ok {
+		code = common.ApiErrorObjectInOperation
+	}
+	return &apiError{http.StatusBadRequest, code, fmt.Sprintf("%s (%s) is in operation", kind, no)}
+}
+
+// result is the body of a successful response besides requestId, returnCode and returnMessage.
+// Handlers return it with the list of objects under the key the SDK expects, e.g. vpcList.
+type result map[string]interface{}
+
+type handlerFunc func(p params) (result, error)
+
+type Server struct {
+	*httptest.Server
+
+	// StepDuration is how long each intermediate status of an object lasts.
+	// Set it before the first request.
+	StepDuration time.Duration
+
+	// MaxPageSize caps the pages of the list responses below the pageSize requested, as ncloud does.
+	// Zero leaves the pages uncapped. Set it before the first request.
+	MaxPageSize int
+
+	mu       sync.Mutex
+	nextNo   int
+	objects  map[string]map[string]*object
+	handlers map[string]handlerFunc
+
+	// ips is the number of IP addresses allocated in each CIDR block
+	ips map[string]int
+
+	// rules are the rules of each access control group
+	rules map[string][]*vserver.AccessControlGroupRule
+}
+
+// NewServer starts a fake ncloud API server. Close it when done.
+func NewServer() *Server {
+	s := &Server{
+		StepDuration: DefaultStepDuration,
+		nextNo:       1000,
+		objects:      map[string]map[string]*object{},
+		handlers:     map[string]handlerFunc{},
+		ips:          map[string]int{},
+		rules:        map[string][]*vserver.AccessControlGroupRule{},
+	}
+
+	s.registerVpcHandlers()
+	s.registerVserverHandlers()
+	s.registerVloadbalancerHandlers()
+
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// Endpoints returns the endpoints of the services served by s, keyed as the provider `endpoints` block
+func (s *Server) Endpoints() map[string]string {
+	return map[string]string{
+		"vpc":           s.URL + "/vpc/v2",
+		"vserver":       s.URL + "/vserver/v2",
+		"vloadbalancer": s.URL + "/vloadbalancer/v2",
+	}
+}
+
+func (s *Server) handle(service, action string, h handlerFunc) {
+	s.handlers[fmt.Sprintf("/%s/v2/%s", service, action)] = h
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("x-ncp-iam-access-key") == "" {
+		writeJSON(w, http.StatusUnauthorized, `{"error":{"errorCode":"200","message":"Authentication Failed","details":"Invalid authentication information."}}`)
+		return
+	}
+
+	h, ok := s.handlers[r.URL.Path]
+	if !ok {
+		writeError(w, &apiError{http.StatusNotFound, "1000000", fmt.Sprintf("unsupported action (%s)", r.URL.Path)})
+		return
+	}
+
+	if err := r.ParseForm(); err != nil {
+		writeError(w, invalidParameter("%s", err))
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	res, err := h(params(r.PostForm))
+	if err != nil {
+		if apiErr, ok := err.(*apiError); ok {
+			writeError(w, apiErr)
+		} else {
+			writeError(w, &apiError{http.StatusInternalServerError, "1000099", err.Error()})
+		}
+		return
+	}
+
+	action := r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]
+	s.nextNo++
+	res["requestId"] = fmt.Sprintf("fake-%d", s.nextNo)
+	res["returnCode"] = "0"
+	res["returnMessage"] = "success"
+
+	body, err := json.Marshal(res)
+	if err != nil {
+		writeError(w, &apiError{http.StatusInternalServerError, "1000099", err.Error()})
+		return
+	}
+
+	// The SDK expects the body of the response wrapped by the action, with a space after the colon
+	writeJSON(w, http.StatusOK, fmt.Sprintf(`{"%sResponse": %s}`, action, body))
+}
+
+func writeError(w http.ResponseWriter, err *apiError) {
+	log.Printf("[DEBUG] fakencp: %s", err)
+	body, _ := json.Marshal(map[string]interface{}{
+		"responseError": map[string]string{
+			"returnCode":    err.code,
+			"returnMessage": err.message,
+		},
+	})
+	writeJSON(w, err.statusCode, string(body))
+}
+
+func writeJSON(w http.ResponseWriter, statusCode int, body string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	fmt.Fprint(w, body)
+}
+
+func (s *Server) newNo() string {
+	s.nextNo++
+	return strconv.Itoa(s.nextNo)
+}
+
+// nextIp allocates an IP address of the CIDR block, skipping the first addresses reserved by ncloud
+func (s *Server) nextIp(cidr string) string {
+	_, ipnet, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return ""
+	}
+
+	s.ips[cidr]++
+	ip := binary.BigEndian.Uint32(ipnet.IP.To4()) + uint32(5+s.ips[cidr])
+	addr := make(net.IP, net.IPv4len)
+	binary.BigEndian.PutUint32(addr, ip)
+	return addr.String()
+}
+
+// create adds a new object of kind, starting the stages of its lifecycle
+func (s *Server) create(kind, no string, value interface{}, apply func(status), stages ...status) *object {
+	o := &object{no: no, value: value, apply: apply}
+	if s.objects[kind] == nil {
+		s.objects[kind] = map[string]*object{}
+	}
+	s.objects[kind][no] = o
+	s.transition(o, stages...)
+	return o
+}
+
+// transition starts new stages of the lifecycle of o, the status of o being the first stage right away
+func (s *Server) transition(o *object, stages ...status) {
+	o.stages = stages
+	o.since = time.Now()
+	o.apply(stages[0])
+}
+
+// stage returns the index of the current stage of the lifecycle of o
+func (s *Server) stage(o *object) int {
+	i := len(o.stages) - 1
+	if s.StepDuration > 0 {
+		if step := int(time.Since(o.since) / s.StepDuration); step < i {
+			i = step
+		}
+	}
+	return i
+}
+
+// refresh moves o along its stages, reporting false when o is terminated
+func (s *Server) refresh(kind string, o *object) bool {
+	st := o.stages[s.stage(o)]
+	if st.code == terminated {
+		delete(s.objects[kind], o.no)
+		return false
+	}
+
+	o.apply(st)
+	return true
+}
+
+// ready reports whether o reached the last stage of its lifecycle, with one of codes if any
+func (s *Server) ready(o *object, codes ...string) bool {
+	i := s.stage(o)
+	if i != len(o.stages)-1 {
+		return false
+	}
+	for _, code := range codes {
+		if o.stages[i].code == code {
+			return true
+		}
+	}
+	return len(codes) == 0
+}
+
+// get returns the object of kind with no, or nil when there is none
+func (s *Server) get(kind, no string) *object {
+	o, ok := s.objects[kind][no]
+	if !ok || !s.refresh(kind, o) {
+		return nil
+	}
+	return o
+}
+
+// list returns the objects of kind matching filter, ordered by creation
+func (s *Server) list(kind string, filter func(*object) bool) []*object {
+	objects := make([]*object, 0, len(s.objects[kind]))
+	for _, o := range s.objects[kind] {
+		if s.refresh(kind, o) && (filter == nil || filter(o)) {
+			objects = append(objects, o)
+		}
+	}
+
+	sort.Slice(objects, func(i, j int) bool {
+		a, _ := strconv.Atoi(objects[i].no)
+		b, _ := strconv.Atoi(objects[j].no)
+		return a < b
+	})
+	return objects
+}
+
+func values(objects []*object) []interface{} {
+	v := make([]interface{}, 0, len(objects))
+	for _, o := range objects {
+		v = append(v, o.value)
+	}
+	return v
+}
+
+func listResult(key string, objects []*object) result {
+	return result{
+		"totalRows": len(objects),
+		key:         values(objects),
+	}
+}
+
+// pagedListResult is the listResult of the page pageNo of pageSize objects, totalRows counting the objects of all the
+// pages. Without pageSize, all the objects are in the first page. pageNo 0 is the first page as well.
+func (s *Server) pagedListResult(p params, key string, objects []*object) result {
+	total := len(objects)
+
+	pageSize, _ := strconv.Atoi(p.value("pageSize"))
+	if s.MaxPageSize > 0 && (pageSize <= 0 || pageSize > s.MaxPageSize) {
+		pageSize = s.MaxPageSize
+	}
+	if pageSize > 0 {
+		pageNo, _ := strconv.Atoi(p.value("pageNo"))
+		start := (max(pageNo, 1) - 1) * pageSize
+		objects = objects[min(start, total):min(start+pageSize, total)]
+	}
+
+	return result{
+		"totalRows": total,
+		key:         values(objects),
+	}
+}
+
+// params are the form parameters of a request, encoded as the SDK does:
+// lists as name.1, name.2, and lists of structs as name.1.field
+type params url.Values
+
+func (p params) str(name string) *string {
+	if v, ok := p[name]; ok && len(v) > 0 {
+		return &v[0]
+	}
+	return nil
+}
+
+func (p params) value(name string) string {
+	return url.Values(p).Get(name)
+}
+
+func (p params) required(names ...string) error {
+	for _, name := range names {
+		if p.value(name) == "" {
+			return invalidParameter("%s is required", name)
+		}
+	}
+	return nil
+}
+
+func (p params) boolean(name string) *bool {
+	if v := p.str(name); v != nil {
+		b, _ := strconv.ParseBool(*v)
+		return &b
+	}
+	return nil
+}
+
+func (p params) int32(name string) *int32 {
+	if v := p.str(name); v != nil {
+		i, _ := strconv.Atoi(*v)
+		i32 := int32(i)
+		return &i32
+	}
+	return nil
+}
+
+func (p params) list(name string) []string {
+	var l []string
+	for i := 1; ; i++ {
+		v, ok := p[fmt.Sprintf("%s.%d", name, i)]
+		if !ok {
+			return l
+		}
+		l = append(l, v[0])
+	}
+}
+
+func (p params) structs(name string) []params {
+	var l []params
+	for i := 1; ; i++ {
+		prefix := fmt.Sprintf("%s.%d.", name, i)
+		item := params{}
+		for k, v := range p {
+			if strings.HasPrefix(k, prefix) {
+				item[strings.TrimPrefix(k, prefix)] = v
+			}
+		}
+		if len(item) == 0 {
+			return l
+		}
+		l = append(l, item)
+	}
+}
+
+// matches reports whether the parameter name is empty or equal to the value
+func (p params) matches(name string, value *string) bool {
+	v := p.value(name)
+	return v == "" || (value != nil && *value == v)
+}
+
+// in reports whether the list parameter name is empty or contains no
+func (p params) in(name, no string) bool {
+	l := p.list(name)
+	if len(l) == 0 {
+		return true
+	}
+	for _, v := range l {
+		if v == no {
+			return true
+		}
+	}
+	return false
+}
+
+func createDate() *string {
+	return str(time.Now().Format("2006-01-02T15:04:05-0700"))
+}
+
+func str(s string) *string {
+	return &s
+}
+
+func stringValue(v *string) string {
+	if v == nil {
+		return ""
+	}
+	return *v
+}
+
+func toPtrs(l []string) []*string {
+	ptrs := make([]*string, 0, len(l))
+	for i := range l {
+		ptrs = append(ptrs, &l[i])
+	}
+	return ptrs
+}
+
+func int32Ptr(i int32) *int32 {
+	return &i
+}
+
+func int64Ptr(i int64) *int64 {
+	return &i
+}
+
+func strOr(v *string, def string) *string {
+	if v == nil || *v == "" {
+		return &def
+	}
+	return v
+}
+
+func boolean(b bool) *bool {
+	return &b
+}
@@ -0,0 +1,451 @@
+package fakencp
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vloadbalancer"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vpc"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/paging"
+)
+
+const testStepDuration = 10 * time.Millisecond
+
+func newTestClient(t *testing.T) (*Server, *conn.NcloudAPIClient) {
+	t.Helper()
+
+	s := NewServer()
+	s.StepDuration = testStepDuration
+	t.Cleanup(s.Close)
+
+	config := &conn.Config{
+		AccessKey: "access",
+		SecretKey: "secret",
+		Region:    RegionCode,
+		Endpoints: s.Endpoints(),
+	}
+	client, err := config.Client()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	return s, client
+}
+
+func setStepDuration(s *Server, d time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.StepDuration = d
+}
+
+// settle waits until every object reached the last stage of its lifecycle
+func settle() {
+	time.Sleep(5 * testStepDuration)
+}
+
+func createTestSubnet(t *testing.T, client *conn.NcloudAPIClient) (*vpc.Vpc, *vpc.Subnet) {
+	t.Helper()
+
+	vpcResp, err := client.Vpc.V2Api.CreateVpc(&vpc.CreateVpcRequest{
+		RegionCode:    ncloud.String(RegionCode),
+		VpcName:       ncloud.String("tf-vpc"),
+		Ipv4CidrBlock: ncloud.String("10.0.0.0/16"),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	v := vpcResp.VpcList[0]
+	settle()
+
+	aclResp, err := client.Vpc.V2Api.GetNetworkAclList(&vpc.GetNetworkAclListRequest{VpcNo: v.VpcNo})
+	if err != nil || len(aclResp.NetworkAclList) != 1 || !*aclResp.NetworkAclList[0].IsDefault {
+		t.Fatalf("expected the default network ACL of the VPC, got %v (%v)", aclResp.NetworkAclList, err)
+	}
+
+	subnetResp, err := client.Vpc.V2Api.CreateSubnet(&vpc.CreateSubnetRequest{
+		RegionCode:     ncloud.String(RegionCode),
+		VpcNo:          v.VpcNo,
+		Subnet:         ncloud.String("10.0.1.0/24"),
+		ZoneCode:       ncloud.String("KR-2"),
+		NetworkAclNo:   aclResp.NetworkAclList[0].NetworkAclNo,
+		SubnetTypeCode: ncloud.String("PUBLIC"),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	settle()
+
+	return v, subnetResp.SubnetList[0]
+}
+
+func TestVpcLifecycle(t *testing.T) {
+	_, client := newTestClient(t)
+
+	resp, err := client.Vpc.V2Api.CreateVpc(&vpc.CreateVpcRequest{
+		RegionCode:    ncloud.String(RegionCode),
+		VpcName:       ncloud.String("tf-vpc"),
+		Ipv4CidrBlock: ncloud.String("10.0.0.0/16"),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if *resp.VpcList[0].VpcStatus.Code != "INIT" {
+		t.Fatalf("expected INIT VPC, got %s", *resp.VpcList[0].VpcStatus.Code)
+	}
+
+	vpcNo := resp.VpcList[0].VpcNo
+	getStatus := func() string {
+		resp, err := client.Vpc.V2Api.GetVpcDetail(&vpc.GetVpcDetailRequest{VpcNo: vpcNo})
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if len(resp.VpcList) == 0 {
+			return terminated
+		}
+		return *resp.VpcList[0].VpcStatus.Code
+	}
+
+	if _, err := client.Vpc.V2Api.DeleteVpc(&vpc.DeleteVpcRequest{VpcNo: vpcNo}); err == nil {
+		t.Fatalf("expected error deleting a VPC in creation")
+	}
+
+	settle()
+	if status := getStatus(); status != "RUN" {
+		t.Fatalf("expected RUN VPC, got %s", status)
+	}
+
+	acgResp, err := client.Vserver.V2Api.GetAccessControlGroupList(&vserver.GetAccessControlGroupListRequest{VpcNo: vpcNo})
+	if err != nil || len(acgResp.AccessControlGroupList) != 1 || !*acgResp.AccessControlGroupList[0].IsDefault {
+		t.Fatalf("expected the default access control group of the VPC, got %v (%v)", acgResp.AccessControlGroupList, err)
+	}
+
+	tableResp, err := client.Vpc.V2Api.GetRouteTableList(&vpc.GetRouteTableListRequest{VpcNo: vpcNo})
+	if err != nil || len(tableResp.RouteTableList) != 2 {
+		t.Fatalf("expected the default route tables of the VPC, got %v (%v)", tableResp.RouteTableList, err)
+	}
+
+	if _, err := client.Vpc.V2Api.DeleteVpc(&vpc.DeleteVpcRequest{VpcNo: vpcNo}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if status := getStatus(); status != "TERMTING" {
+		t.Fatalf("expected TERMTING VPC, got %s", status)
+	}
+
+	settle()
+	if status := getStatus(); status != terminated {
+		t.Fatalf("expected VPC to be gone, got %s", status)
+	}
+
+	acgResp, err = client.Vserver.V2Api.GetAccessControlGroupList(&vserver.GetAccessControlGroupListRequest{VpcNo: vpcNo})
+	if err != nil || len(acgResp.AccessControlGroupList) != 0 {
+		t.Fatalf("expected the default access control group to be deleted with the VPC, got %v (%v)", acgResp.AccessControlGroupList, err)
+	}
+}
+
+func TestServerLifecycle(t *testing.T) {
+	_, client := newTestClient(t)
+	v, subnet := createTestSubnet(t, client)
+
+	acgResp, err := client.Vserver.V2Api.GetAccessControlGroupList(&vserver.GetAccessControlGroupListRequest{VpcNo: v.VpcNo})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	resp, err := client.Vserver.V2Api.CreateServerInstances(&vserver.CreateServerInstancesRequest{
+		RegionCode:             ncloud.String(RegionCode),
+		ServerImageProductCode: ncloud.String("SW.VSVR.OS.LNX64.ROCKY.0808.B050"),
+		ServerName:             ncloud.String("tf-server"),
+		VpcNo:                  v.VpcNo,
+		SubnetNo:               subnet.SubnetNo,
+		NetworkInterfaceList: []*vserver.NetworkInterfaceParameter{{
+			NetworkInterfaceOrder:    ncloud.Int32(0),
+			AccessControlGroupNoList: []*string{acgResp.AccessControlGroupList[0].AccessControlGroupNo},
+		}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	serverNo := resp.ServerInstanceList[0].ServerInstanceNo
+	getServer := func() *vserver.ServerInstance {
+		resp, err := client.Vserver.V2Api.GetServerInstanceDetail(&vserver.GetServerInstanceDetailRequest{ServerInstanceNo: serverNo})
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if len(resp.ServerInstanceList) == 0 {
+			return nil
+		}
+		return resp.ServerInstanceList[0]
+	}
+
+	if status := *getServer().ServerInstanceStatus.Code; status != "INIT" && status != "CREAT" {
+		t.Fatalf("expected server in creation, got %s", status)
+	}
+
+	settle()
+	server := getServer()
+	if *server.ServerInstanceStatus.Code != "RUN" || *server.ServerInstanceOperation.Code != "NULL" {
+		t.Fatalf("expected running server, got %s/%s", *server.ServerInstanceStatus.Code, *server.ServerInstanceOperation.Code)
+	}
+
+	nicResp, err := client.Vserver.V2Api.GetNetworkInterfaceDetail(&vserver.GetNetworkInterfaceDetailRequest{NetworkInterfaceNo: server.NetworkInterfaceNoList[0]})
+	if err != nil || len(nicResp.NetworkInterfaceList) != 1 {
+		t.Fatalf("expected the network interface of the server, got %v (%v)", nicResp.NetworkInterfaceList, err)
+	}
+	if nic := nicResp.NetworkInterfaceList[0]; *nic.DeviceName != "eth0" || !strings.HasPrefix(*nic.Ip, "10.0.1.") {
+		t.Fatalf("unexpected network interface: %s %s", *nic.DeviceName, *nic.Ip)
+	}
+
+	storageResp, err := client.Vserver.V2Api.GetBlockStorageInstanceList(&vserver.GetBlockStorageInstanceListRequest{ServerInstanceNo: serverNo})
+	if err != nil || len(storageResp.BlockStorageInstanceList) != 1 || *storageResp.BlockStorageInstanceList[0].BlockStorageInstanceStatusName != "attached" {
+		t.Fatalf("expected the attached base block storage of the server, got %v (%v)", storageResp.BlockStorageInstanceList, err)
+	}
+
+	if _, err := client.Vserver.V2Api.TerminateServerInstances(&vserver.TerminateServerInstancesRequest{ServerInstanceNoList: []*string{serverNo}}); err == nil {
+		t.Fatalf("expected error terminating a running server")
+	}
+
+	if _, err := client.Vserver.V2Api.StopServerInstances(&vserver.StopServerInstancesRequest{ServerInstanceNoList: []*string{serverNo}}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	settle()
+	if status := *getServer().ServerInstanceStatus.Code; status != "NSTOP" {
+		t.Fatalf("expected stopped server, got %s", status)
+	}
+
+	if _, err := client.Vpc.V2Api.DeleteSubnet(&vpc.DeleteSubnetRequest{SubnetNo: subnet.SubnetNo}); err == nil {
+		t.Fatalf("expected error deleting a subnet in use")
+	}
+
+	if _, err := client.Vserver.V2Api.TerminateServerInstances(&vserver.TerminateServerInstancesRequest{ServerInstanceNoList: []*string{serverNo}}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	settle()
+	if server := getServer(); server != nil {
+		t.Fatalf("expected server to be gone, got %s", *server.ServerInstanceStatus.Code)
+	}
+
+	nicListResp, err := client.Vserver.V2Api.GetNetworkInterfaceList(&vserver.GetNetworkInterfaceListRequest{InstanceNo: serverNo})
+	if err != nil || len(nicListResp.NetworkInterfaceList) != 0 {
+		t.Fatalf("expected the network interfaces to be deleted with the server, got %v (%v)", nicListResp.NetworkInterfaceList, err)
+	}
+}
+
+func TestBlockStorageAttachment(t *testing.T) {
+	_, client := newTestClient(t)
+
+	resp, err := client.Vserver.V2Api.CreateBlockStorageInstance(&vserver.CreateBlockStorageInstanceRequest{
+		BlockStorageSize: ncloud.Int32(10),
+		ZoneCode:         ncloud.String("KR-2"),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	storage := resp.BlockStorageInstanceList[0]
+	if *storage.BlockStorageSize != 10*gigabyte || *storage.BlockStorageInstanceStatusName != "initialized" {
+		t.Fatalf("unexpected block storage: %d %s", *storage.BlockStorageSize, *storage.BlockStorageInstanceStatusName)
+	}
+
+	settle()
+	getStorage := func() *vserver.BlockStorageInstance {
+		resp, err := client.Vserver.V2Api.GetBlockStorageInstanceDetail(&vserver.GetBlockStorageInstanceDetailRequest{BlockStorageInstanceNo: storage.BlockStorageInstanceNo})
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if len(resp.BlockStorageInstanceList) == 0 {
+			return nil
+		}
+		return resp.BlockStorageInstanceList[0]
+	}
+
+	if storage := getStorage(); *storage.BlockStorageInstanceStatus.Code != "CREAT" || *storage.BlockStorageInstanceStatusName != "detached" {
+		t.Fatalf("expected detached block storage, got %s/%s", *storage.BlockStorageInstanceStatus.Code, *storage.BlockStorageInstanceStatusName)
+	}
+
+	if _, err := client.Vserver.V2Api.AttachBlockStorageInstance(&vserver.AttachBlockStorageInstanceRequest{
+		ServerInstanceNo:       ncloud.String("1"),
+		BlockStorageInstanceNo: storage.BlockStorageInstanceNo,
+	}); err == nil {
+		t.Fatalf("expected error attaching to an unknown server")
+	}
+
+	if _, err := client.Vserver.V2Api.DeleteBlockStorageInstances(&vserver.DeleteBlockStorageInstancesRequest{
+		BlockStorageInstanceNoList: []*string{storage.BlockStorageInstanceNo},
+	}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	settle()
+	if storage := getStorage(); storage != nil {
+		t.Fatalf("expected block storage to be gone, got %s", *storage.BlockStorageInstanceStatusName)
+	}
+}
+
+func TestInOperation(t *testing.T) {
+	s, client := newTestClient(t)
+	setStepDuration(s, time.Hour)
+
+	resp, err := client.Vserver.V2Api.CreateBlockStorageInstance(&vserver.CreateBlockStorageInstanceRequest{
+		BlockStorageSize: ncloud.Int32(10),
+		ZoneCode:         ncloud.String("KR-2"),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if _, err := client.Vserver.V2Api.DeleteBlockStorageInstances(&vserver.DeleteBlockStorageInstancesRequest{
+		BlockStorageInstanceNoList: []*string{resp.BlockStorageInstanceList[0].BlockStorageInstanceNo},
+	}); err == nil || !common.HasReturnCode(err, common.ApiErrorObjectInOperation) {
+		t.Fatalf("expected error deleting a block storage in creation, got %v", err)
+	}
+}
+
+func TestInOperationRetried(t *testing.T) {
+	s := NewServer()
+	s.StepDuration = testStepDuration
+	t.Cleanup(s.Close)
+
+	config := &conn.Config{
+		AccessKey:            "access",
+		SecretKey:            "secret",
+		Region:               RegionCode,
+		Endpoints:            s.Endpoints(),
+		MaxRetries:           conn.DefaultMaxRetries,
+		RetryableReturnCodes: conn.DefaultRetryableReturnCodes,
+	}
+	client, err := config.Client()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	resp, err := client.Vserver.V2Api.CreateBlockStorageInstance(&vserver.CreateBlockStorageInstanceRequest{
+		BlockStorageSize: ncloud.Int32(10),
+		ZoneCode:         ncloud.String("KR-2"),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// the transport retries the deletion until the block storage is created
+	if _, err := client.Vserver.V2Api.DeleteBlockStorageInstances(&vserver.DeleteBlockStorageInstancesRequest{
+		BlockStorageInstanceNoList: []*string{resp.BlockStorageInstanceList[0].BlockStorageInstanceNo},
+	}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
+
+func TestNotFound(t *testing.T) {
+	_, client := newTestClient(t)
+
+	_, err := client.Vserver.V2Api.GetAccessControlGroupDetail(&vserver.GetAccessControlGroupDetailRequest{AccessControlGroupNo: ncloud.String("1")})
+	if !common.IsNotFound(err) || !common.HasReturnCode(err, common.ApiErrorAcgNotFound) {
+		t.Fatalf("expected not found access control group, got %v", err)
+	}
+
+	_, err = client.Vpc.V2Api.GetRouteTableDetail(&vpc.GetRouteTableDetailRequest{RouteTableNo: ncloud.String("1")})
+	if !common.IsNotFound(err) || !common.HasReturnCode(err, common.ApiErrorRouteTableNotFound) {
+		t.Fatalf("expected not found route table, got %v", err)
+	}
+}
+
+func TestLoadBalancerLifecycle(t *testing.T) {
+	_, client := newTestClient(t)
+	v, subnet := createTestSubnet(t, client)
+
+	resp, err := client.Vloadbalancer.V2Api.CreateLoadBalancerInstance(&vloadbalancer.CreateLoadBalancerInstanceRequest{
+		LoadBalancerTypeCode: ncloud.String("APPLICATION"),
+		VpcNo:                v.VpcNo,
+		SubnetNoList:         []*string{subnet.SubnetNo},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	lbNo := resp.LoadBalancerInstanceList[0].LoadBalancerInstanceNo
+	if *resp.LoadBalancerInstanceList[0].LoadBalancerInstanceOperation.Code != "CREAT" {
+		t.Fatalf("expected load balancer in creation")
+	}
+
+	settle()
+	detail, err := client.Vloadbalancer.V2Api.GetLoadBalancerInstanceDetail(&vloadbalancer.GetLoadBalancerInstanceDetailRequest{LoadBalancerInstanceNo: lbNo})
+	if err != nil || *detail.LoadBalancerInstanceList[0].LoadBalancerInstanceOperation.Code != "NULL" {
+		t.Fatalf("expected active load balancer, got %v (%v)", detail.LoadBalancerInstanceList, err)
+	}
+
+	if _, err := client.Vloadbalancer.V2Api.DeleteLoadBalancerInstances(&vloadbalancer.DeleteLoadBalancerInstancesRequest{LoadBalancerInstanceNoList: []*string{lbNo}}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	settle()
+	detail, err = client.Vloadbalancer.V2Api.GetLoadBalancerInstanceDetail(&vloadbalancer.GetLoadBalancerInstanceDetailRequest{LoadBalancerInstanceNo: lbNo})
+	if err != nil || len(detail.LoadBalancerInstanceList) != 0 {
+		t.Fatalf("expected load balancer to be gone, got %v (%v)", detail.LoadBalancerInstanceList, err)
+	}
+}
+
+func TestListPaging(t *testing.T) {
+	s, client := newTestClient(t)
+	s.MaxPageSize = 2
+
+	var vpcNos []string
+	for i := 1; i <= 5; i++ {
+		resp, err := client.Vpc.V2Api.CreateVpc(&vpc.CreateVpcRequest{
+			RegionCode:    ncloud.String(RegionCode),
+			VpcName:       ncloud.String(fmt.Sprintf("tf-vpc-%d", i)),
+			Ipv4CidrBlock: ncloud.String(fmt.Sprintf("10.%d.0.0/16", i)),
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		vpcNos = append(vpcNos, *resp.VpcList[0].VpcNo)
+	}
+
+	// each VPC has its default network ACL
+	first, err := client.Vpc.V2Api.GetNetworkAclList(&vpc.GetNetworkAclListRequest{PageNo: ncloud.Int32(1), PageSize: ncloud.Int32(100)})
+	if err != nil || len(first.NetworkAclList) != 2 || ncloud.Int32Value(first.TotalRows) != 5 {
+		t.Fatalf("expected the first 2 of 5 network ACLs, got %d of %d (%v)", len(first.NetworkAclList), ncloud.Int32Value(first.TotalRows), err)
+	}
+
+	acls, err := paging.All(context.Background(), func(ctx context.Context, pageNo, pageSize int32) ([]*vpc.NetworkAcl, *int32, error) {
+		resp, err := client.Vpc.V2Api.GetNetworkAclList(&vpc.GetNetworkAclListRequest{PageNo: ncloud.Int32(pageNo), PageSize: ncloud.Int32(pageSize)})
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.NetworkAclList, resp.TotalRows, nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(acls) != len(vpcNos) {
+		t.Fatalf("expected %d network ACLs, got %d", len(vpcNos), len(acls))
+	}
+	for i, acl := range acls {
+		if *acl.VpcNo != vpcNos[i] {
+			t.Fatalf("expected the network ACLs in the order of their VPC, got %v", acls)
+		}
+	}
+}
+
+func TestUnauthenticatedRequest(t *testing.T) {
+	s, _ := newTestClient(t)
+
+	resp, err := http.Post(s.URL+"/vpc/v2/getVpcList", "application/x-www-form-urlencoded", strings.NewReader("responseFormatType=json"))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("expected 401, got %d", resp.StatusCode)
+	}
+}
@@ -0,0 +1,132 @@
+package fakencp
+
+import (
+	"fmt"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vloadbalancer"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vpc"
+)
+
+const kindLoadBalancer = "Load balancer"
+
+var (
+	// Load balancers are waited for by operation code
+	loadBalancerCreation    = []status{{"INIT", "CREAT", "creating"}, {"USED", "NULL", "running"}}
+	loadBalancerChanging    = []status{{"USED", "CHANG", "changing"}, {"USED", "NULL", "running"}}
+	loadBalancerTermination = []status{{"USED", "TERMT", "terminating"}, {code: terminated}}
+)
+
+func lbCode(code, name string) *vloadbalancer.CommonCode {
+	return &vloadbalancer.CommonCode{Code: str(code), CodeName: str(name)}
+}
+
+func (s *Server) registerVloadbalancerHandlers() {
+	s.handle("vloadbalancer", "createLoadBalancerInstance", s.createLoadBalancerInstance)
+	s.handle("vloadbalancer", "getLoadBalancerInstanceDetail", s.getLoadBalancerInstanceList)
+	s.handle("vloadbalancer", "getLoadBalancerInstanceList", s.getLoadBalancerInstanceList)
+	s.handle("vloadbalancer", "deleteLoadBalancerInstances", s.deleteLoadBalancerInstances)
+	s.handle("vloadbalancer", "setLoadBalancerDescription", s.setLoadBalancerDescription)
+}
+
+func (s *Server) createLoadBalancerInstance(p params) (result, error) {
+	if err := p.required("vpcNo", "loadBalancerTypeCode"); err != nil {
+		return nil, err
+	}
+
+	subnetNoList := p.list("subnetNoList")
+	if len(subnetNoList) == 0 {
+		return nil, invalidParameter("subnetNoList is required")
+	}
+
+	no := s.newNo()
+	lb := &vloadbalancer.LoadBalancerInstance{
+		LoadBalancerInstanceNo:     str(no),
+		LoadBalancerName:           strOr(p.str("loadBalancerName"), "lb-"+no),
+		LoadBalancerDescription:    strOr(p.str("loadBalancerDescription"), ""),
+		LoadBalancerDomain:         str(fmt.Sprintf("lb-%s.fake.ncloudslb.com", no)),
+		LoadBalancerType:           lbCode(p.value("loadBalancerTypeCode"), p.value("loadBalancerTypeCode")),
+		LoadBalancerNetworkType:    lbCode(*strOr(p.str("loadBalancerNetworkTypeCode"), "PUBLIC"), *strOr(p.str("loadBalancerNetworkTypeCode"), "PUBLIC")),
+		ThroughputType:             lbCode(*strOr(p.str("throughputTypeCode"), "SMALL"), *strOr(p.str("throughputTypeCode"), "SMALL")),
+		IdleTimeout:                p.int32("idleTimeout"),
+		VpcNo:                      p.str("vpcNo"),
+		RegionCode:                 str(RegionCode),
+		CreateDate:                 createDate(),
+		SubnetNoList:               toPtrs(subnetNoList),
+		LoadBalancerListenerNoList: []*string{},
+	}
+	if lb.IdleTimeout == nil {
+		lb.IdleTimeout = int32Ptr(60)
+	}
+
+	for _, subnetNo := range subnetNoList {
+		o := s.get(kindSubnet, subnetNo)
+		if o == nil {
+			return nil, notFound(kindSubnet, subnetNo)
+		}
+
+		subnet := o.value.(*vpc.Subnet)
+		if *subnet.VpcNo != *lb.VpcNo {
+			return nil, invalidParameter("subnet (%s) is not in VPC (%s)", subnetNo, *lb.VpcNo)
+		}
+
+		lb.LoadBalancerIpList = append(lb.LoadBalancerIpList, str(s.nextIp(*subnet.Subnet)))
+		lb.LoadBalancerSubnetList = append(lb.LoadBalancerSubnetList, &vloadbalancer.LoadBalancerSubnet{
+			ZoneCode: subnet.ZoneCode,
+			SubnetNo: subnet.SubnetNo,
+		})
+	}
+
+	o := s.create(kindLoadBalancer, no, lb, func(st status) {
+		lb.LoadBalancerInstanceStatus = lbCode(st.code, st.name)
+		lb.LoadBalancerInstanceOperation = lbCode(st.operation, st.operation)
+		lb.LoadBalancerInstanceStatusName = str(st.name)
+	}, loadBalancerCreation...)
+
+	return listResult("loadBalancerInstanceList", []*object{o}), nil
+}
+
+func (s *Server) getLoadBalancerInstanceList(p params) (result, error) {
+	return s.pagedListResult(p, "loadBalancerInstanceList", s.list(kindLoadBalancer, func(o *object) bool {
+		lb := o.value.(*vloadbalancer.LoadBalancerInstance)
+		return p.matches("loadBalancerInstanceNo", lb.LoadBalancerInstanceNo) && p.in("loadBalancerInstanceNoList", o.no) &&
+			p.matches("vpcNo", lb.VpcNo)
+	})), nil
+}
+
+func (s *Server) deleteLoadBalancerInstances(p params) (result, error) {
+	var lbs []*object
+	for _, no := range p.list("loadBalancerInstanceNoList") {
+		o := s.get(kindLoadBalancer, no)
+		if o == nil {
+			return nil, notFound(kindLoadBalancer, no)
+		}
+		if !s.ready(o) {
+			return nil, inOperation(kindLoadBalancer, no)
+		}
+		lbs = append(lbs, o)
+	}
+
+	if len(lbs) == 0 {
+		return nil, invalidParameter("loadBalancerInstanceNoList is required")
+	}
+
+	for _, o := range lbs {
+		s.transition(o, loadBalancerTermination...)
+	}
+	return listResult("loadBalancerInstanceList", lbs), nil
+}
+
+func (s *Server) setLoadBalancerDescription(p params) (result, error) {
+	no := p.value("loadBalancerInstanceNo")
+	o := s.get(kindLoadBalancer, no)
+	if o == nil {
+		return nil, notFound(kindLoadBalancer, no)
+	}
+	if !s.ready(o) {
+		return nil, inOperation(kindLoadBalancer, no)
+	}
+
+	o.value.(*vloadbalancer.LoadBalancerInstance).LoadBalancerDescription = strOr(p.str("loadBalancerDescription"), "")
+	s.transition(o, loadBalancerChanging...)
+	return listResult("loadBalancerInstanceList", []*object{o}), nil
+}
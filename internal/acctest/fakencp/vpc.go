@@ -0,0 +1,357 @@
+package fakencp
+
+import (
+	"net"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vpc"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
+)
+
+const (
+	kindVpc        = "VPC"
+	kindSubnet     = "Subnet"
+	kindNatGateway = "NAT gateway"
+	kindNetworkAcl = "Network ACL"
+	kindRouteTable = "Route table"
+)
+
+var (
+	vpcCreation    = []status{{code: "INIT"}, {code: "CREATING"}, {code: "RUN"}}
+	vpcTermination = []status{{code: "TERMTING"}, {code: terminated}}
+	vpcSetting     = []status{{code: "SET"}, {code: "RUN"}}
+	vpcRunning     = []status{{code: "RUN"}}
+
+	vpcStatusNames = map[string]string{"INIT": "init", "CREATING": "creating", "RUN": "run", "SET": "setting", "TERMTING": "terminating", "NULL": "null"}
+)
+
+func vpcCode(code string) *vpc.CommonCode {
+	return &vpc.CommonCode{Code: str(code), CodeName: str(vpcStatusNames[code])}
+}
+
+func (s *Server) registerVpcHandlers() {
+	s.handle("vpc", "createVpc", s.createVpc)
+	s.handle("vpc", "getVpcDetail", s.getVpcList)
+	s.handle("vpc", "getVpcList", s.getVpcList)
+	s.handle("vpc", "deleteVpc", s.deleteVpc)
+	s.handle("vpc", "getNetworkAclList", s.getNetworkAclList)
+	s.handle("vpc", "getNetworkAclDetail", s.getNetworkAclList)
+	s.handle("vpc", "getRouteTableList", s.getRouteTableList)
+	s.handle("vpc", "getRouteTableDetail", s.getRouteTableDetail)
+	s.handle("vpc", "createSubnet", s.createSubnet)
+	s.handle("vpc", "getSubnetDetail", s.getSubnetList)
+	s.handle("vpc", "getSubnetList", s.getSubnetList)
+	s.handle("vpc", "deleteSubnet", s.deleteSubnet)
+	s.handle("vpc", "setSubnetNetworkAcl", s.setSubnetNetworkAcl)
+	s.handle("vpc", "createNatGatewayInstance", s.createNatGatewayInstance)
+	s.handle("vpc", "getNatGatewayInstanceDetail", s.getNatGatewayInstanceList)
+	s.handle("vpc", "getNatGatewayInstanceList", s.getNatGatewayInstanceList)
+	s.handle("vpc", "deleteNatGatewayInstance", s.deleteNatGatewayInstance)
+	s.handle("vpc", "setNatGatewayDescription", s.setNatGatewayDescription)
+}
+
+// createVpc creates the VPC with its default network ACL, access control group and route tables, as ncloud does
+func (s *Server) createVpc(p params) (result, error) {
+	if err := p.required("ipv4CidrBlock"); err != nil {
+		return nil, err
+	}
+	if _, _, err := net.ParseCIDR(p.value("ipv4CidrBlock")); err != nil {
+		return nil, invalidParameter("invalid ipv4CidrBlock (%s)", p.value("ipv4CidrBlock"))
+	}
+
+	no := s.newNo()
+	v := &vpc.Vpc{
+		VpcNo:         str(no),
+		VpcName:       strOr(p.str("vpcName"), "vpc-"+no),
+		Ipv4CidrBlock: p.str("ipv4CidrBlock"),
+		RegionCode:    str(RegionCode),
+		CreateDate:    createDate(),
+	}
+	o := s.create(kindVpc, no, v, func(st status) { v.VpcStatus = vpcCode(st.code) }, vpcCreation...)
+
+	aclNo := s.newNo()
+	acl := &vpc.NetworkAcl{
+		NetworkAclNo:          str(aclNo),
+		NetworkAclName:        str(*v.VpcName + "-default-network-acl"),
+		VpcNo:                 str(no),
+		NetworkAclDescription: str("default network ACL"),
+		CreateDate:            createDate(),
+		IsDefault:             boolean(true),
+	}
+	s.create(kindNetworkAcl, aclNo, acl, func(st status) { acl.NetworkAclStatus = vpcCode(st.code) }, vpcRunning...)
+
+	for _, subnetType := range []string{"PUBLIC", "PRIVATE"} {
+		tableNo := s.newNo()
+		table := &vpc.RouteTable{
+			RouteTableNo:          str(tableNo),
+			RouteTableName:        str(*v.VpcName + "-default-" + subnetType + "-table"),
+			RegionCode:            str(RegionCode),
+			VpcNo:                 str(no),
+			SupportedSubnetType:   &vpc.CommonCode{Code: str(subnetType), CodeName: str(subnetType)},
+			IsDefault:             boolean(true),
+			RouteTableDescription: str("default route table"),
+		}
+		s.create(kindRouteTable, tableNo, table, func(st status) { table.RouteTableStatus = vpcCode(st.code) }, vpcRunning...)
+	}
+
+	s.createDefaultAccessControlGroup(no, *v.VpcName)
+
+	return listResult("vpcList", []*object{o}), nil
+}
+
+func (s *Server) getVpcList(p params) (result, error) {
+	return s.pagedListResult(p, "vpcList", s.list(kindVpc, func(o *object) bool {
+		v := o.value.(*vpc.Vpc)
+		return p.matches("vpcNo", v.VpcNo) && p.in("vpcNoList", o.no) && p.matches("vpcName", v.VpcName)
+	})), nil
+}
+
+// deleteVpc refuses to delete a VPC having subnets or NAT gateways, as ncloud does
+func (s *Server) deleteVpc(p params) (result, error) {
+	no := p.value("vpcNo")
+	o := s.get(kindVpc, no)
+	if o == nil {
+		return nil, notFound(kindVpc, no)
+	}
+	if !s.ready(o, "RUN") {
+		return nil, inOperation(kindVpc, no)
+	}
+
+	inVpc := func(o *object) bool { return vpcNoOf(o.value) == no }
+	if len(s.list(kindSubnet, inVpc)) > 0 || len(s.list(kindNatGateway, inVpc)) > 0 {
+		return nil, invalidParameter("VPC (%s) has subnets or NAT gateways", no)
+	}
+
+	for _, kind := range []string{kindNetworkAcl, kindRouteTable} {
+		for _, d := range s.list(kind, inVpc) {
+			delete(s.objects[kind], d.no)
+		}
+	}
+	s.deleteAccessControlGroupsOfVpc(no)
+
+	s.transition(o, vpcTermination...)
+	return listResult("vpcList", []*object{o}), nil
+}
+
+func vpcNoOf(value interface{}) string {
+	switch v := value.(type) {
+	case *vpc.Subnet:
+		return *v.VpcNo
+	case *vpc.NatGatewayInstance:
+		return *v.VpcNo
+	case *vpc.NetworkAcl:
+		return *v.VpcNo
+	case *vpc.RouteTable:
+		return *v.VpcNo
+	}
+	return ""
+}
+
+func subnetNoOf(value interface{}) string {
+	switch v := value.(type) {
+	case *vpc.NatGatewayInstance:
+		return stringValue(v.SubnetNo)
+	case *vserver.ServerInstance:
+		return *v.SubnetNo
+	case *vserver.NetworkInterface:
+		return *v.SubnetNo
+	}
+	return ""
+}
+
+func (s *Server) getNetworkAclList(p params) (result, error) {
+	return s.pagedListResult(p, "networkAclList", s.list(kindNetworkAcl, func(o *object) bool {
+		acl := o.value.(*vpc.NetworkAcl)
+		return p.matches("vpcNo", acl.VpcNo) && p.matches("networkAclNo", acl.NetworkAclNo) &&
+			p.in("networkAclNoList", o.no) && p.matches("networkAclName", acl.NetworkAclName)
+	})), nil
+}
+
+func (s *Server) getRouteTableList(p params) (result, error) {
+	return s.pagedListResult(p, "routeTableList", s.list(kindRouteTable, func(o *object) bool {
+		table := o.value.(*vpc.RouteTable)
+		return p.matches("vpcNo", table.VpcNo) && p.in("routeTableNoList", o.no) &&
+			p.matches("supportedSubnetTypeCode", table.SupportedSubnetType.Code)
+	})), nil
+}
+
+// getRouteTableDetail fails on an unknown route table, as ncloud does
+func (s *Server) getRouteTableDetail(p params) (result, error) {
+	no := p.value("routeTableNo")
+	if s.get(kindRouteTable, no) == nil {
+		return nil, notFound(kindRouteTable, no)
+	}
+	return s.pagedListResult(p, "routeTableList", s.list(kindRouteTable, func(o *object) bool { return o.no == no })), nil
+}
+
+func (s *Server) createSubnet(p params) (result, error) {
+	if err := p.required("vpcNo", "subnet", "zoneCode", "networkAclNo", "subnetTypeCode"); err != nil {
+		return nil, err
+	}
+
+	vpcObject := s.get(kindVpc, p.value("vpcNo"))
+	if vpcObject == nil {
+		return nil, notFound(kindVpc, p.value("vpcNo"))
+	}
+	if !s.ready(vpcObject, "RUN") {
+		return nil, inOperation(kindVpc, vpcObject.no)
+	}
+	if s.get(kindNetworkAcl, p.value("networkAclNo")) == nil {
+		return nil, notFound(kindNetworkAcl, p.value("networkAclNo"))
+	}
+
+	_, vpcCidr, _ := net.ParseCIDR(*vpcObject.value.(*vpc.Vpc).Ipv4CidrBlock)
+	ip, _, err := net.ParseCIDR(p.value("subnet"))
+	if err != nil || !vpcCidr.Contains(ip) {
+		return nil, invalidParameter("subnet (%s) must be within the CIDR block of the VPC (%s)", p.value("subnet"), vpcCidr)
+	}
+
+	no := s.newNo()
+	subnet := &vpc.Subnet{
+		SubnetNo:     str(no),
+		VpcNo:        p.str("vpcNo"),
+		ZoneCode:     p.str("zoneCode"),
+		SubnetName:   strOr(p.str("subnetName"), "subnet-"+no),
+		Subnet:       p.str("subnet"),
+		CreateDate:   createDate(),
+		SubnetType:   &vpc.CommonCode{Code: p.str("subnetTypeCode"), CodeName: p.str("subnetTypeCode")},
+		UsageType:    &vpc.CommonCode{Code: strOr(p.str("usageTypeCode"), "GEN"), CodeName: strOr(p.str("usageTypeCode"), "GEN")},
+		NetworkAclNo: p.str("networkAclNo"),
+	}
+	o := s.create(kindSubnet, no, subnet, func(st status) { subnet.SubnetStatus = vpcCode(st.code) }, vpcCreation...)
+
+	return listResult("subnetList", []*object{o}), nil
+}
+
+func (s *Server) getSubnetList(p params) (result, error) {
+	return s.pagedListResult(p, "subnetList", s.list(kindSubnet, func(o *object) bool {
+		subnet := o.value.(*vpc.Subnet)
+		return p.matches("subnetNo", subnet.SubnetNo) && p.in("subnetNoList", o.no) &&
+			p.matches("vpcNo", subnet.VpcNo) && p.matches("subnetName", subnet.SubnetName) &&
+			p.matches("subnet", subnet.Subnet) && p.matches("zoneCode", subnet.ZoneCode) &&
+			p.matches("subnetTypeCode", subnet.SubnetType.Code) && p.matches("networkAclNo", subnet.NetworkAclNo)
+	})), nil
+}
+
+func (s *Server) deleteSubnet(p params) (result, error) {
+	no := p.value("subnetNo")
+	o := s.get(kindSubnet, no)
+	if o == nil {
+		return nil, notFound(kindSubnet, no)
+	}
+	if !s.ready(o, "RUN") {
+		return nil, inOperation(kindSubnet, no)
+	}
+
+	inSubnet := func(o *object) bool { return subnetNoOf(o.value) == no }
+	if len(s.list(kindServer, inSubnet)) > 0 || len(s.list(kindNatGateway, inSubnet)) > 0 || len(s.list(kindNetworkInterface, inSubnet)) > 0 {
+		return nil, invalidParameter("subnet (%s) is in use", no)
+	}
+
+	s.transition(o, vpcTermination...)
+	return listResult("subnetList", []*object{o}), nil
+}
+
+func (s *Server) setSubnetNetworkAcl(p params) (result, error) {
+	if err := p.required("subnetNo", "networkAclNo"); err != nil {
+		return nil, err
+	}
+
+	o := s.get(kindSubnet, p.value("subnetNo"))
+	if o == nil {
+		return nil, notFound(kindSubnet, p.value("subnetNo"))
+	}
+	if !s.ready(o, "RUN") {
+		return nil, inOperation(kindSubnet, o.no)
+	}
+	if s.get(kindNetworkAcl, p.value("networkAclNo")) == nil {
+		return nil, notFound(kindNetworkAcl, p.value("networkAclNo"))
+	}
+
+	o.value.(*vpc.Subnet).NetworkAclNo = p.str("networkAclNo")
+	s.transition(o, vpcSetting...)
+	return s.pagedListResult(p, "networkAclList", s.list(kindNetworkAcl, func(acl *object) bool { return acl.no == p.value("networkAclNo") })), nil
+}
+
+func (s *Server) createNatGatewayInstance(p params) (result, error) {
+	if err := p.required("vpcNo", "zoneCode"); err != nil {
+		return nil, err
+	}
+
+	vpcObject := s.get(kindVpc, p.value("vpcNo"))
+	if vpcObject == nil {
+		return nil, notFound(kindVpc, p.value("vpcNo"))
+	}
+
+	no := s.newNo()
+	nat := &vpc.NatGatewayInstance{
+		VpcNo:                 p.str("vpcNo"),
+		VpcName:               vpcObject.value.(*vpc.Vpc).VpcName,
+		NatGatewayInstanceNo:  str(no),
+		NatGatewayName:        strOr(p.str("natGatewayName"), "nat-"+no),
+		NatGatewayDescription: strOr(p.str("natGatewayDescription"), ""),
+		ZoneCode:              p.str("zoneCode"),
+		NatGatewayType:        &vpc.CommonCode{Code: str("PUBLIC"), CodeName: str("Public")},
+		CreateDate:            createDate(),
+	}
+
+	if subnetNo := p.value("subnetNo"); subnetNo != "" {
+		subnetObject := s.get(kindSubnet, subnetNo)
+		if subnetObject == nil {
+			return nil, notFound(kindSubnet, subnetNo)
+		}
+		subnet := subnetObject.value.(*vpc.Subnet)
+		nat.SubnetNo = subnet.SubnetNo
+		nat.SubnetName = subnet.SubnetName
+		nat.PrivateIp = strOr(p.str("privateIp"), s.nextIp(*subnet.Subnet))
+		nat.PublicIpInstanceNo = strOr(p.str("publicIpInstanceNo"), s.newNo())
+		nat.PublicIp = str(s.nextIp("203.0.113.0/24"))
+		if *subnet.SubnetType.Code == "PRIVATE" {
+			nat.NatGatewayType = &vpc.CommonCode{Code: str("PRIVATE"), CodeName: str("Private")}
+			nat.PublicIp = nil
+			nat.PublicIpInstanceNo = nil
+		}
+	}
+
+	o := s.create(kindNatGateway, no, nat, func(st status) {
+		nat.NatGatewayInstanceStatus = vpcCode(st.code)
+		nat.NatGatewayInstanceStatusName = str(vpcStatusNames[st.code])
+		nat.NatGatewayInstanceOperation = vpcCode("NULL")
+	}, vpcCreation...)
+
+	return listResult("natGatewayInstanceList", []*object{o}), nil
+}
+
+func (s *Server) getNatGatewayInstanceList(p params) (result, error) {
+	return s.pagedListResult(p, "natGatewayInstanceList", s.list(kindNatGateway, func(o *object) bool {
+		nat := o.value.(*vpc.NatGatewayInstance)
+		return p.matches("natGatewayInstanceNo", nat.NatGatewayInstanceNo) && p.in("natGatewayInstanceNoList", o.no) &&
+			p.matches("vpcNo", nat.VpcNo) && p.matches("vpcName", nat.VpcName) &&
+			p.matches("natGatewayName", nat.NatGatewayName) && p.matches("zoneCode", nat.ZoneCode) &&
+			p.matches("subnetNo", nat.SubnetNo)
+	})), nil
+}
+
+func (s *Server) deleteNatGatewayInstance(p params) (result, error) {
+	no := p.value("natGatewayInstanceNo")
+	o := s.get(kindNatGateway, no)
+	if o == nil {
+		return nil, notFound(kindNatGateway, no)
+	}
+	if !s.ready(o, "RUN") {
+		return nil, inOperation(kindNatGateway, no)
+	}
+
+	s.transition(o, vpcTermination...)
+	return listResult("natGatewayInstanceList", []*object{o}), nil
+}
+
+func (s *Server) setNatGatewayDescription(p params) (result, error) {
+	no := p.value("natGatewayInstanceNo")
+	o := s.get(kindNatGateway, no)
+	if o == nil {
+		return nil, notFound(kindNatGateway, no)
+	}
+
+	o.value.(*vpc.NatGatewayInstance).NatGatewayDescription = strOr(p.str("natGatewayDescription"), "")
+	return listResult("natGatewayInstanceList", []*object{o}), nil
+}
@@ -0,0 +1,741 @@
+package fakencp
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vpc"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
+)
+
+const (
+	kindServer             = "Server"
+	kindNetworkInterface   = "Network interface"
+	kindAccessControlGroup = "Access control group"
+	kindBlockStorage       = "Block storage"
+
+	gigabyte = 1024 * 1024 * 1024
+)
+
+var (
+	serverCreation    = []status{{"INIT", "NULL", "init"}, {"CREAT", "NULL", "creating"}, {"RUN", "NULL", "running"}}
+	serverStopping    = []status{{"RUN", "STOP", "shutting down"}, {"NSTOP", "NULL", "stopped"}}
+	serverStarting    = []status{{"NSTOP", "START", "booting"}, {"RUN", "NULL", "running"}}
+	serverTermination = []status{{"NSTOP", "TERMT", "terminating"}, {code: terminated}}
+
+	networkInterfaceUsed    = []status{{"USED", "NULL", "used"}}
+	networkInterfaceNotUsed = []status{{"NOTUSED", "NULL", "not used"}}
+
+	accessControlGroupRunning     = []status{{"RUN", "NULL", "run"}}
+	accessControlGroupSetting     = []status{{"SET", "NULL", "setting"}, {"RUN", "NULL", "run"}}
+	accessControlGroupTermination = []status{{"RUN", "NULL", "run"}, {code: terminated}}
+
+	// Block storages are waited for by status name as well as by status code
+	baseBlockStorageCreation = []status{{"INIT", "NULL", "initialized"}, {"ATTAC", "NULL", "attached"}}
+	blockStorageCreation     = []status{{"INIT", "NULL", "initialized"}, {"INIT", "NULL", "creating"}, {"CREAT", "NULL", "detached"}}
+	blockStorageAttaching    = []status{{"CREAT", "ATTAC", "attaching"}, {"ATTAC", "NULL", "attached"}}
+	blockStorageDetaching    = []status{{"ATTAC", "DETAC", "attached"}, {"CREAT", "NULL", "detached"}}
+	blockStorageTermination  = []status{{"CREAT", "TERMT", "terminating"}, {code: terminated}}
+
+	protocolNumbers = map[string]int32{"ICMP": 1, "TCP": 6, "UDP": 17}
+)
+
+func vserverCode(code, name string) *vserver.CommonCode {
+	return &vserver.CommonCode{Code: str(code), CodeName: str(name)}
+}
+
+func (s *Server) registerVserverHandlers() {
+	s.handle("vserver", "getRegionList", s.getRegionList)
+	s.handle("vserver", "getZoneList", s.getZoneList)
+	s.handle("vserver", "createServerInstances", s.createServerInstances)
+	s.handle("vserver", "getServerInstanceDetail", s.getServerInstanceList)
+	s.handle("vserver", "getServerInstanceList", s.getServerInstanceList)
+	s.handle("vserver", "stopServerInstances", s.stopServerInstances)
+	s.handle("vserver", "startServerInstances", s.startServerInstances)
+	s.handle("vserver", "terminateServerInstances", s.terminateServerInstances)
+	s.handle("vserver", "setProtectServerTermination", s.setProtectServerTermination)
+	s.handle("vserver", "getNetworkInterfaceDetail", s.getNetworkInterfaceList)
+	s.handle("vserver", "getNetworkInterfaceList", s.getNetworkInterfaceList)
+	s.handle("vserver", "createAccessControlGroup", s.createAccessControlGroup)
+	s.handle("vserver", "getAccessControlGroupDetail", s.getAccessControlGroupDetail)
+	s.handle("vserver", "getAccessControlGroupList", s.getAccessControlGroupList)
+	s.handle("vserver", "deleteAccessControlGroup", s.deleteAccessControlGroup)
+	s.handle("vserver", "getAccessControlGroupRuleList", s.getAccessControlGroupRuleList)
+	s.handle("vserver", "addAccessControlGroupInboundRule", s.addAccessControlGroupRule("INBND"))
+	s.handle("vserver", "addAccessControlGroupOutboundRule", s.addAccessControlGroupRule("OTBND"))
+	s.handle("vserver", "removeAccessControlGroupInboundRule", s.removeAccessControlGroupRule("INBND"))
+	s.handle("vserver", "removeAccessControlGroupOutboundRule", s.removeAccessControlGroupRule("OTBND"))
+	s.handle("vserver", "createBlockStorageInstance", s.createBlockStorageInstance)
+	s.handle("vserver", "getBlockStorageInstanceDetail", s.getBlockStorageInstanceList)
+	s.handle("vserver", "getBlockStorageInstanceList", s.getBlockStorageInstanceList)
+	s.handle("vserver", "attachBlockStorageInstance", s.attachBlockStorageInstance)
+	s.handle("vserver", "detachBlockStorageInstances", s.detachBlockStorageInstances)
+	s.handle("vserver", "deleteBlockStorageInstances", s.deleteBlockStorageInstances)
+}
+
+func (s *Server) getRegionList(p params) (result, error) {
+	return result{
+		"totalRows":  1,
+		"regionList": []*vserver.Region{{RegionCode: str(RegionCode), RegionName: str("Korea")}},
+	}, nil
+}
+
+func (s *Server) getZoneList(p params) (result, error) {
+	zones := make([]*vserver.Zone, 0, len(Zones))
+	for _, zone := range Zones {
+		zones = append(zones, &vserver.Zone{ZoneCode: str(zone), ZoneName: str(zone), RegionCode: str(RegionCode), ZoneDescription: str(zone)})
+	}
+
+	return result{
+		"totalRows": len(zones),
+		"zoneList":  zones,
+	}, nil
+}
+
+// createServerInstances creates a server with its network interfaces and base block storage, as ncloud does
+func (s *Server) createServerInstances(p params) (result, error) {
+	if err := p.required("subnetNo"); err != nil {
+		return nil, err
+	}
+	if p.value("serverImageProductCode") == "" && p.value("memberServerImageInstanceNo") == "" && p.value("serverImageNo") == "" {
+		return nil, invalidParameter("one of serverImageProductCode, memberServerImageInstanceNo or serverImageNo is required")
+	}
+
+	subnetObject := s.get(kindSubnet, p.value("subnetNo"))
+	if subnetObject == nil {
+		return nil, notFound(kindSubnet, p.value("subnetNo"))
+	}
+	if !s.ready(subnetObject, "RUN") {
+		return nil, inOperation(kindSubnet, subnetObject.no)
+	}
+	subnet := subnetObject.value.(*vpc.Subnet)
+
+	networkInterfaces := p.structs("networkInterfaceList")
+	if len(networkInterfaces) == 0 {
+		return nil, invalidParameter("networkInterfaceList is required")
+	}
+
+	no := s.newNo()
+	hypervisor := "XEN"
+	if p.value("serverSpecCode") != "" {
+		hypervisor = "KVM"
+	}
+
+	server := &vserver.ServerInstance{
+		ServerInstanceNo:               str(no),
+		ServerName:                     strOr(p.str("serverName"), "svr-"+no),
+		ServerDescription:              strOr(p.str("serverDescription"), ""),
+		CpuCount:                       int32Ptr(2),
+		MemorySize:                     int64Ptr(4 * gigabyte),
+		PlatformType:                   vserverCode("LNX64", "Linux 64 Bit"),
+		LoginKeyName:                   p.str("loginKeyName"),
+		CreateDate:                     createDate(),
+		ServerImageProductCode:         p.str("serverImageProductCode"),
+		ServerProductCode:              p.str("serverProductCode"),
+		ServerImageNo:                  p.str("serverImageNo"),
+		ServerSpecCode:                 p.str("serverSpecCode"),
+		IsProtectServerTermination:     boolean(p.value("isProtectServerTermination") == "true"),
+		ZoneCode:                       subnet.ZoneCode,
+		RegionCode:                     str(RegionCode),
+		VpcNo:                          subnet.VpcNo,
+		SubnetNo:                       subnet.SubnetNo,
+		InitScriptNo:                   p.str("initScriptNo"),
+		PlacementGroupNo:               p.str("placementGroupNo"),
+		ServerInstanceType:             vserverCode("STAND", "Standard"),
+		BaseBlockStorageDiskType:       vserverCode("NET", "Network Storage"),
+		BaseBlockStorageDiskDetailType: vserverCode("SSD", "SSD"),
+		HypervisorType:                 vserverCode(hypervisor, hypervisor),
+	}
+
+	for _, ni := range networkInterfaces {
+		nic, err := s.attachNetworkInterface(server, subnet, ni)
+		if err != nil {
+			return nil, err
+		}
+		server.NetworkInterfaceNoList = append(server.NetworkInterfaceNoList, nic.NetworkInterfaceNo)
+	}
+
+	o := s.create(kindServer, no, server, func(st status) {
+		server.ServerInstanceStatus = vserverCode(st.code, st.name)
+		server.ServerInstanceOperation = vserverCode(st.operation, st.operation)
+		server.ServerInstanceStatusName = str(st.name)
+	}, serverCreation...)
+
+	s.newBlockStorage(&vserver.BlockStorageInstance{
+		ServerInstanceNo:     str(no),
+		BlockStorageName:     str(*server.ServerName + "-base"),
+		BlockStorageType:     vserverCode("BASIC", "Basic BS"),
+		BlockStorageSize:     int64Ptr(50 * gigabyte),
+		DeviceName:           str("/dev/xvda"),
+		ZoneCode:             server.ZoneCode,
+		BlockStorageDiskType: server.BaseBlockStorageDiskType,
+		HypervisorType:       server.HypervisorType,
+	}, baseBlockStorageCreation)
+
+	return listResult("serverInstanceList", []*object{o}), nil
+}
+
+// attachNetworkInterface attaches the network interface given by the parameters to the server, creating it if needed
+func (s *Server) attachNetworkInterface(server *vserver.ServerInstance, subnet *vpc.Subnet, ni params) (*vserver.NetworkInterface, error) {
+	order := ni.value("networkInterfaceOrder")
+	deviceName := "eth" + order
+
+	if nicNo := ni.value("networkInterfaceNo"); nicNo != "" {
+		o := s.get(kindNetworkInterface, nicNo)
+		if o == nil {
+			return nil, notFound(kindNetworkInterface, nicNo)
+		}
+
+		nic := o.value.(*vserver.NetworkInterface)
+		if nic.InstanceNo != nil {
+			return nil, invalidParameter("network interface (%s) is already attached to %s", nicNo, *nic.InstanceNo)
+		}
+
+		nic.InstanceNo = server.ServerInstanceNo
+		nic.DeviceName = str(deviceName)
+		nic.IsDefault = boolean(order == "0")
+		s.transition(o, networkInterfaceUsed...)
+		return nic, nil
+	}
+
+	acgNoList := ni.list("accessControlGroupNoList")
+	if len(acgNoList) == 0 {
+		return nil, invalidParameter("accessControlGroupNoList of networkInterfaceList is required")
+	}
+	for _, acgNo := range acgNoList {
+		if s.get(kindAccessControlGroup, acgNo) == nil {
+			return nil, notFound(kindAccessControlGroup, acgNo)
+		}
+	}
+
+	no := s.newNo()
+	nic := &vserver.NetworkInterface{
+		NetworkInterfaceNo:          str(no),
+		NetworkInterfaceName:        str("nic-" + no),
+		SubnetNo:                    subnet.SubnetNo,
+		DeleteOnTermination:         boolean(true),
+		IsDefault:                   boolean(order == "0"),
+		DeviceName:                  str(deviceName),
+		InstanceType:                vserverCode("SVR", "Server"),
+		InstanceNo:                  server.ServerInstanceNo,
+		Ip:                          strOr(ni.str("ip"), s.nextIp(*subnet.Subnet)),
+		MacAddress:                  str(macAddress(no)),
+		AccessControlGroupNoList:    toPtrs(acgNoList),
+		NetworkInterfaceDescription: str(""),
+	}
+	s.create(kindNetworkInterface, no, nic, func(st status) {
+		nic.NetworkInterfaceStatus = vserverCode(st.code, st.name)
+	}, networkInterfaceUsed...)
+
+	return nic, nil
+}
+
+func macAddress(no string) string {
+	n, _ := strconv.Atoi(no)
+	return fmt.Sprintf("F2:20:AF:%02X:%02X:%02X", n>>16&0xff, n>>8&0xff, n&0xff)
+}
+
+func (s *Server) getServerInstanceList(p params) (result, error) {
+	return s.pagedListResult(p, "serverInstanceList", s.list(kindServer, func(o *object) bool {
+		server := o.value.(*vserver.ServerInstance)
+		return p.matches("serverInstanceNo", server.ServerInstanceNo) && p.in("serverInstanceNoList", o.no) &&
+			p.matches("vpcNo", server.VpcNo) && p.matches("serverName", server.ServerName)
+	})), nil
+}
+
+// serverInstances returns the servers of the serverInstanceNoList parameter, all in one of codes
+func (s *Server) serverInstances(p params, codes ...string) ([]*object, error) {
+	var servers []*object
+	for _, no := range p.list("serverInstanceNoList") {
+		o := s.get(kindServer, no)
+		if o == nil {
+			return nil, notFound(kindServer, no)
+		}
+		if !s.ready(o, codes...) {
+			return nil, inOperation(kindServer, no)
+		}
+		servers = append(servers, o)
+	}
+
+	if len(servers) == 0 {
+		return nil, invalidParameter("serverInstanceNoList is required")
+	}
+	return servers, nil
+}
+
+func (s *Server) stopServerInstances(p params) (result, error) {
+	servers, err := s.serverInstances(p, "RUN")
+	if err != nil {
+		return nil, err
+	}
+
+	for _, o := range servers {
+		s.transition(o, serverStopping...)
+	}
+	return listResult("serverInstanceList", servers), nil
+}
+
+func (s *Server) startServerInstances(p params) (result, error) {
+	servers, err := s.serverInstances(p, "NSTOP")
+	if err != nil {
+		return nil, err
+	}
+
+	for _, o := range servers {
+		s.transition(o, serverStarting...)
+	}
+	return listResult("serverInstanceList", servers), nil
+}
+
+// terminateServerInstances terminates stopped servers with their base block storages and network interfaces.
+// Additional block storages must be detached beforehand, as on ncloud.
+func (s *Server) terminateServerInstances(p params) (result, error) {
+	servers, err := s.serverInstances(p, "NSTOP")
+	if err != nil {
+		return nil, err
+	}
+
+	for _, o := range servers {
+		if *o.value.(*vserver.ServerInstance).IsProtectServerTermination {
+			return nil, invalidParameter("server (%s) is protected from termination", o.no)
+		}
+		if len(s.list(kindBlockStorage, attachedTo(o.no, "SVRBS"))) > 0 {
+			return nil, invalidParameter("server (%s) has additional block storages attached", o.no)
+		}
+	}
+
+	for _, o := range servers {
+		for _, storage := range s.list(kindBlockStorage, attachedTo(o.no, "BASIC")) {
+			delete(s.objects[kindBlockStorage], storage.no)
+		}
+
+		for _, nic := range s.list(kindNetworkInterface, func(nic *object) bool {
+			return stringValue(nic.value.(*vserver.NetworkInterface).InstanceNo) == o.no
+		}) {
+			if *nic.value.(*vserver.NetworkInterface).DeleteOnTermination {
+				delete(s.objects[kindNetworkInterface], nic.no)
+				continue
+			}
+			nic.value.(*vserver.NetworkInterface).InstanceNo = nil
+			nic.value.(*vserver.NetworkInterface).DeviceName = nil
+			s.transition(nic, networkInterfaceNotUsed...)
+		}
+
+		s.transition(o, serverTermination...)
+	}
+	return listResult("serverInstanceList", servers), nil
+}
+
+func (s *Server) setProtectServerTermination(p params) (result, error) {
+	if err := p.required("serverInstanceNo", "isProtectServerTermination"); err != nil {
+		return nil, err
+	}
+
+	o := s.get(kindServer, p.value("serverInstanceNo"))
+	if o == nil {
+		return nil, notFound(kindServer, p.value("serverInstanceNo"))
+	}
+
+	o.value.(*vserver.ServerInstance).IsProtectServerTermination = p.boolean("isProtectServerTermination")
+	return listResult("serverInstanceList", []*object{o}), nil
+}
+
+func (s *Server) getNetworkInterfaceList(p params) (result, error) {
+	return s.pagedListResult(p, "networkInterfaceList", s.list(kindNetworkInterface, func(o *object) bool {
+		nic := o.value.(*vserver.NetworkInterface)
+		return p.matches("networkInterfaceNo", nic.NetworkInterfaceNo) && p.in("networkInterfaceNoList", o.no) &&
+			p.matches("instanceNo", nic.InstanceNo) && p.matches("networkInterfaceName", nic.NetworkInterfaceName) &&
+			p.matches("ip", nic.Ip)
+	})), nil
+}
+
+func (s *Server) createDefaultAccessControlGroup(vpcNo, vpcName string) {
+	no := s.newNo()
+	acg := &vserver.AccessControlGroup{
+		AccessControlGroupNo:          str(no),
+		AccessControlGroupName:        str(vpcName + "-default-acg"),
+		IsDefault:                     boolean(true),
+		VpcNo:                         str(vpcNo),
+		AccessControlGroupDescription: str("default access control group"),
+	}
+	s.create(kindAccessControlGroup, no, acg, func(st status) {
+		acg.AccessControlGroupStatus = vserverCode(st.code, st.name)
+	}, accessControlGroupRunning...)
+}
+
+func (s *Server) deleteAccessControlGroupsOfVpc(vpcNo string) {
+	for _, o := range s.list(kindAccessControlGroup, func(o *object) bool {
+		return *o.value.(*vserver.AccessControlGroup).VpcNo == vpcNo
+	}) {
+		delete(s.objects[kindAccessControlGroup], o.no)
+		delete(s.rules, o.no)
+	}
+}
+
+func (s *Server) createAccessControlGroup(p params) (result, error) {
+	if err := p.required("vpcNo"); err != nil {
+		return nil, err
+	}
+	if s.get(kindVpc, p.value("vpcNo")) == nil {
+		return nil, notFound(kindVpc, p.value("vpcNo"))
+	}
+
+	no := s.newNo()
+	acg := &vserver.AccessControlGroup{
+		AccessControlGroupNo:          str(no),
+		AccessControlGroupName:        strOr(p.str("accessControlGroupName"), "acg-"+no),
+		IsDefault:                     boolean(false),
+		VpcNo:                         p.str("vpcNo"),
+		AccessControlGroupDescription: strOr(p.str("accessControlGroupDescription"), ""),
+	}
+	o := s.create(kindAccessControlGroup, no, acg, func(st status) {
+		acg.AccessControlGroupStatus = vserverCode(st.code, st.name)
+	}, accessControlGroupRunning...)
+
+	return listResult("accessControlGroupList", []*object{o}), nil
+}
+
+func (s *Server) getAccessControlGroupList(p params) (result, error) {
+	return s.pagedListResult(p, "accessControlGroupList", s.list(kindAccessControlGroup, func(o *object) bool {
+		acg := o.value.(*vserver.AccessControlGroup)
+		return p.matches("accessControlGroupNo", acg.AccessControlGroupNo) && p.in("accessControlGroupNoList", o.no) &&
+			p.matches("vpcNo", acg.VpcNo) && p.matches("accessControlGroupName", acg.AccessControlGroupName)
+	})), nil
+}
+
+// getAccessControlGroupDetail fails on an unknown access control group, as ncloud does
+func (s *Server) getAccessControlGroupDetail(p params) (result, error) {
+	if no := p.value("accessControlGroupNo"); s.get(kindAccessControlGroup, no) == nil {
+		return nil, notFound(kindAccessControlGroup, no)
+	}
+	return s.getAccessControlGroupList(p)
+}
+
+// deleteAccessControlGroup refuses to delete default access control groups and those in use, as ncloud does
+func (s *Server) deleteAccessControlGroup(p params) (result, error) {
+	no := p.value("accessControlGroupNo")
+	o := s.get(kindAccessControlGroup, no)
+	if o == nil {
+		return nil, notFound(kindAccessControlGroup, no)
+	}
+	if !s.ready(o, "RUN") {
+		return nil, inOperation(kindAccessControlGroup, no)
+	}
+	if *o.value.(*vserver.AccessControlGroup).IsDefault {
+		return nil, invalidParameter("default access control group (%s) can not be deleted", no)
+	}
+
+	inUse := s.list(kindNetworkInterface, func(nic *object) bool {
+		for _, acgNo := range nic.value.(*vserver.NetworkInterface).AccessControlGroupNoList {
+			if *acgNo == no {
+				return true
+			}
+		}
+		return false
+	})
+	if len(inUse) > 0 {
+		return nil, invalidParameter("access control group (%s) is in use", no)
+	}
+
+	delete(s.rules, no)
+	s.transition(o, accessControlGroupTermination...)
+	return listResult("accessControlGroupList", []*object{o}), nil
+}
+
+func (s *Server) getAccessControlGroupRuleList(p params) (result, error) {
+	if err := p.required("accessControlGroupNo"); err != nil {
+		return nil, err
+	}
+
+	rules := make([]*vserver.AccessControlGroupRule, 0)
+	for _, rule := range s.rules[p.value("accessControlGroupNo")] {
+		if p.matches("accessControlGroupRuleTypeCode", rule.AccessControlGroupRuleType.Code) {
+			rules = append(rules, rule)
+		}
+	}
+
+	return result{
+		"totalRows":                  len(rules),
+		"accessControlGroupRuleList": rules,
+	}, nil
+}
+
+func (s *Server) addAccessControlGroupRule(ruleType string) handlerFunc {
+	return func(p params) (result, error) {
+		o, err := s.settableAccessControlGroup(p)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, r := range p.structs("accessControlGroupRuleList") {
+			if r.value("ipBlock") == "" && r.value("accessControlGroupSequence") == "" {
+				return nil, invalidParameter("one of ipBlock or accessControlGroupSequence is required")
+			}
+			if s.findRule(o.no, ruleType, r) >= 0 {
+				return nil, invalidParameter("duplicated rule of access control group (%s)", o.no)
+			}
+
+			protocol := r.value("protocolTypeCode")
+			number, ok := protocolNumbers[protocol]
+			if !ok {
+				n, err := strconv.Atoi(protocol)
+				if err != nil {
+					return nil, invalidParameter("invalid protocolTypeCode (%s)", protocol)
+				}
+				number = int32(n)
+			}
+
+			s.rules[o.no] = append(s.rules[o.no], &vserver.AccessControlGroupRule{
+				AccessControlGroupNo:              str(o.no),
+				ProtocolType:                      &vserver.ProtocolType{Code: str(protocol), CodeName: str(protocol), Number: &number},
+				IpBlock:                           r.str("ipBlock"),
+				AccessControlGroupSequence:        r.str("accessControlGroupSequence"),
+				PortRange:                         r.str("portRange"),
+				AccessControlGroupRuleType:        vserverCode(ruleType, ruleType),
+				AccessControlGroupRuleDescription: strOr(r.str("accessControlGroupRuleDescription"), ""),
+			})
+		}
+
+		s.transition(o, accessControlGroupSetting...)
+		return s.getAccessControlGroupRuleList(params{"accessControlGroupNo": {o.no}, "accessControlGroupRuleTypeCode": {ruleType}})
+	}
+}
+
+func (s *Server) removeAccessControlGroupRule(ruleType string) handlerFunc {
+	return func(p params) (result, error) {
+		o, err := s.settableAccessControlGroup(p)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, r := range p.structs("accessControlGroupRuleList") {
+			i := s.findRule(o.no, ruleType, r)
+			if i < 0 {
+				return nil, invalidParameter("no matching rule of access control group (%s)", o.no)
+			}
+			s.rules[o.no] = append(s.rules[o.no][:i], s.rules[o.no][i+1:]...)
+		}
+
+		s.transition(o, accessControlGroupSetting...)
+		return s.getAccessControlGroupRuleList(params{"accessControlGroupNo": {o.no}, "accessControlGroupRuleTypeCode": {ruleType}})
+	}
+}
+
+// settableAccessControlGroup returns the access control group of the parameters, if its rules can be changed
+func (s *Server) settableAccessControlGroup(p params) (*object, error) {
+	if err := p.required("accessControlGroupNo"); err != nil {
+		return nil, err
+	}
+
+	o := s.get(kindAccessControlGroup, p.value("accessControlGroupNo"))
+	if o == nil {
+		return nil, notFound(kindAccessControlGroup, p.value("accessControlGroupNo"))
+	}
+	if !s.ready(o, "RUN") {
+		return nil, inOperation(kindAccessControlGroup, o.no)
+	}
+	return o, nil
+}
+
+// findRule returns the index of the rule matching the parameters, or -1
+func (s *Server) findRule(acgNo, ruleType string, r params) int {
+	for i, rule := range s.rules[acgNo] {
+		if *rule.AccessControlGroupRuleType.Code == ruleType &&
+			*rule.ProtocolType.Code == r.value("protocolTypeCode") &&
+			stringValue(rule.IpBlock) == r.value("ipBlock") &&
+			stringValue(rule.AccessControlGroupSequence) == r.value("accessControlGroupSequence") &&
+			stringValue(rule.PortRange) == r.value("portRange") {
+			return i
+		}
+	}
+	return -1
+}
+
+func (s *Server) newBlockStorage(storage *vserver.BlockStorageInstance, stages []status) *object {
+	no := s.newNo()
+	storage.BlockStorageInstanceNo = str(no)
+	storage.RegionCode = str(RegionCode)
+	storage.CreateDate = createDate()
+	storage.BlockStorageProductCode = str("SPBSTBSTAD000006")
+	storage.IsEncryptedVolume = boolean(false)
+	storage.MaxIopsThroughput = int32Ptr(4000)
+	if storage.BlockStorageDescription == nil {
+		storage.BlockStorageDescription = str("")
+	}
+	if storage.BlockStorageDiskDetailType == nil {
+		storage.BlockStorageDiskDetailType = vserverCode("SSD", "SSD")
+	}
+	if storage.BlockStorageVolumeType == nil {
+		storage.BlockStorageVolumeType = vserverCode("SSD", "SSD")
+	}
+	if storage.IsReturnProtection == nil {
+		storage.IsReturnProtection = boolean(false)
+	}
+
+	return s.create(kindBlockStorage, no, storage, func(st status) {
+		storage.BlockStorageInstanceStatus = vserverCode(st.code, st.name)
+		storage.BlockStorageInstanceOperation = vserverCode(st.operation, st.operation)
+		storage.BlockStorageInstanceStatusName = str(st.name)
+	}, stages...)
+}
+
+func (s *Server) createBlockStorageInstance(p params) (result, error) {
+	if err := p.required("blockStorageSize"); err != nil {
+		return nil, err
+	}
+
+	storage := &vserver.BlockStorageInstance{
+		BlockStorageName:           p.str("blockStorageName"),
+		BlockStorageType:           vserverCode("SVRBS", "Server BS"),
+		BlockStorageSize:           int64Ptr(int64(*p.int32("blockStorageSize")) * gigabyte),
+		BlockStorageDescription:    p.str("blockStorageDescription"),
+		BlockStorageDiskType:       vserverCode("NET", "Network Storage"),
+		IsReturnProtection:         p.boolean("isReturnProtection"),
+		ZoneCode:                   p.str("zoneCode"),
+		HypervisorType:             vserverCode("XEN", "XEN"),
+		BlockStorageDiskDetailType: vserverCode(p.value("blockStorageDiskDetailTypeCode"), p.value("blockStorageDiskDetailTypeCode")),
+	}
+	if p.value("blockStorageDiskDetailTypeCode") == "" {
+		storage.BlockStorageDiskDetailType = nil
+	}
+	if volumeType := p.value("blockStorageVolumeTypeCode"); volumeType != "" {
+		storage.BlockStorageVolumeType = vserverCode(volumeType, volumeType)
+		if volumeType == "FB1" || volumeType == "CB1" {
+			storage.HypervisorType = vserverCode("KVM", "KVM")
+		}
+	}
+
+	var server *object
+	if serverNo := p.value("serverInstanceNo"); serverNo != "" {
+		server = s.get(kindServer, serverNo)
+		if server == nil {
+			return nil, notFound(kindServer, serverNo)
+		}
+		storage.ZoneCode = server.value.(*vserver.ServerInstance).ZoneCode
+	} else if storage.ZoneCode == nil {
+		return nil, invalidParameter("one of serverInstanceNo or zoneCode is required")
+	}
+
+	o := s.newBlockStorage(storage, blockStorageCreation)
+	if storage.BlockStorageName == nil {
+		storage.BlockStorageName = str("bs-" + o.no)
+	}
+
+	if server != nil {
+		storage.ServerInstanceNo = str(server.no)
+		storage.DeviceName = str(s.nextDeviceName(server.no))
+		s.transition(o, append(blockStorageCreation[:2:2], blockStorageAttaching...)...)
+	}
+
+	return listResult("blockStorageInstanceList", []*object{o}), nil
+}
+
+func (s *Server) nextDeviceName(serverNo string) string {
+	return fmt.Sprintf("/dev/xvd%c", 'a'+len(s.list(kindBlockStorage, attachedTo(serverNo, ""))))
+}
+
+func attachedTo(serverNo, blockStorageType string) func(*object) bool {
+	return func(o *object) bool {
+		storage := o.value.(*vserver.BlockStorageInstance)
+		return stringValue(storage.ServerInstanceNo) == serverNo &&
+			(blockStorageType == "" || *storage.BlockStorageType.Code == blockStorageType)
+	}
+}
+
+func (s *Server) getBlockStorageInstanceList(p params) (result, error) {
+	return s.pagedListResult(p, "blockStorageInstanceList", s.list(kindBlockStorage, func(o *object) bool {
+		storage := o.value.(*vserver.BlockStorageInstance)
+		return p.matches("blockStorageInstanceNo", storage.BlockStorageInstanceNo) && p.in("blockStorageInstanceNoList", o.no) &&
+			p.matches("serverInstanceNo", storage.ServerInstanceNo) && p.matches("blockStorageName", storage.BlockStorageName) &&
+			p.in("blockStorageTypeCodeList", *storage.BlockStorageType.Code)
+	})), nil
+}
+
+func (s *Server) attachBlockStorageInstance(p params) (result, error) {
+	if err := p.required("serverInstanceNo", "blockStorageInstanceNo"); err != nil {
+		return nil, err
+	}
+
+	server := s.get(kindServer, p.value("serverInstanceNo"))
+	if server == nil {
+		return nil, notFound(kindServer, p.value("serverInstanceNo"))
+	}
+
+	o := s.get(kindBlockStorage, p.value("blockStorageInstanceNo"))
+	if o == nil {
+		return nil, notFound(kindBlockStorage, p.value("blockStorageInstanceNo"))
+	}
+	if !s.ready(o, "CREAT") {
+		return nil, inOperation(kindBlockStorage, o.no)
+	}
+
+	storage := o.value.(*vserver.BlockStorageInstance)
+	if *storage.ZoneCode != *server.value.(*vserver.ServerInstance).ZoneCode {
+		return nil, invalidParameter("block storage (%s) and server (%s) must be in the same zone", o.no, server.no)
+	}
+
+	storage.DeviceName = str(s.nextDeviceName(server.no))
+	storage.ServerInstanceNo = str(server.no)
+	s.transition(o, blockStorageAttaching...)
+	return listResult("blockStorageInstanceList", []*object{o}), nil
+}
+
+func (s *Server) detachBlockStorageInstances(p params) (result, error) {
+	storages, err := s.blockStorageInstances(p, "ATTAC")
+	if err != nil {
+		return nil, err
+	}
+
+	for _, o := range storages {
+		storage := o.value.(*vserver.BlockStorageInstance)
+		if *storage.BlockStorageType.Code == "BASIC" {
+			return nil, invalidParameter("base block storage (%s) can not be detached", o.no)
+		}
+	}
+
+	for _, o := range storages {
+		storage := o.value.(*vserver.BlockStorageInstance)
+		storage.ServerInstanceNo = nil
+		storage.DeviceName = nil
+		s.transition(o, blockStorageDetaching...)
+	}
+	return listResult("blockStorageInstanceList", storages), nil
+}
+
+func (s *Server) deleteBlockStorageInstances(p params) (result, error) {
+	storages, err := s.blockStorageInstances(p, "CREAT")
+	if err != nil {
+		return nil, err
+	}
+
+	for _, o := range storages {
+		if *o.value.(*vserver.BlockStorageInstance).IsReturnProtection {
+			return nil, invalidParameter("block storage (%s) is protected from return", o.no)
+		}
+	}
+
+	for _, o := range storages {
+		s.transition(o, blockStorageTermination...)
+	}
+	return listResult("blockStorageInstanceList", storages), nil
+}
+
+// blockStorageInstances returns the block storages of the blockStorageInstanceNoList parameter, all in one of codes
+func (s *Server) blockStorageInstances(p params, codes ...string) ([]*object, error) {
+	var storages []*object
+	for _, no := range p.list("blockStorageInstanceNoList") {
+		o := s.get(kindBlockStorage, no)
+		if o == nil {
+			return nil, notFound(kindBlockStorage, no)
+		}
+		if !s.ready(o, codes...) {
+			return nil, inOperation(kindBlockStorage, no)
+		}
+		storages = append(storages, o)
+	}
+
+	if len(storages) == 0 {
+		return nil, invalidParameter("blockStorageInstanceNoList is required")
+	}
+	return storages, nil
+}
@@ -0,0 +1,82 @@
+package acctest
+
+import (
+	"hash/fnv"
+	"math/rand"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+)
+
+// testRands are the random sources of the running tests, by test name, when recording or replaying a cassette
+var (
+	testRands   = map[string]*rand.Rand{}
+	testRandsMu sync.Mutex
+)
+
+// withTestRand calls draw with the random source of the test, seeded by the name of the test when recording or
+// replaying a cassette. The random names of a test are the same whichever tests run and in whichever order, for its
+// requests to match the cassette. The source is nil otherwise, for the names to be drawn from the global source.
+func withTestRand(t testing.TB, draw func(r *rand.Rand)) {
+	if cassetteMode() == "" {
+		draw(nil)
+		return
+	}
+
+	testRandsMu.Lock()
+	defer testRandsMu.Unlock()
+
+	name := t.Name()
+	r, ok := testRands[name]
+	if !ok {
+		h := fnv.New64a()
+		h.Write([]byte(name))
+		r = rand.New(rand.NewSource(int64(h.Sum64())))
+		testRands[name] = r
+
+		// a test run again, e.g. with -count, draws the same names
+		t.Cleanup(func() {
+			testRandsMu.Lock()
+			defer testRandsMu.Unlock()
+			delete(testRands, name)
+		})
+	}
+	draw(r)
+}
+
+// RandString returns a random string of lowercase letters, like acctest.RandString, drawn for the test
+func RandString(t testing.TB, n int) string {
+	return RandStringFromCharSet(t, n, acctest.CharSetAlpha)
+}
+
+// RandStringFromCharSet returns a random string of the characters of charSet, like acctest.RandStringFromCharSet,
+// drawn for the test
+func RandStringFromCharSet(t testing.TB, n int, charSet string) string {
+	var s string
+	withTestRand(t, func(r *rand.Rand) {
+		if r == nil {
+			s = acctest.RandStringFromCharSet(n, charSet)
+			return
+		}
+		b := make([]byte, n)
+		for i := range b {
+			b[i] = charSet[r.Intn(len(charSet))]
+		}
+		s = string(b)
+	})
+	return s
+}
+
+// RandIntRange returns a random integer in [min, max), like acctest.RandIntRange, drawn for the test
+func RandIntRange(t testing.TB, min int, max int) int {
+	var i int
+	withTestRand(t, func(r *rand.Rand) {
+		if r == nil {
+			i = acctest.RandIntRange(min, max)
+			return
+		}
+		i = r.Intn(max-min) + min
+	})
+	return i
+}
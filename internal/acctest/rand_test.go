@@ -0,0 +1,86 @@
+package acctest
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/acctest/cassette"
+)
+
+// namedTest names the subtests the same when recording and replaying, like the tests of separate runs
+type namedTest struct {
+	testing.TB
+	name string
+}
+
+func (n namedTest) Name() string {
+	return n.name
+}
+
+func TestRandStringReplay(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		io.WriteString(w, "created "+string(b))
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "testdata", "cassette.jsonl")
+
+	// run runs the tests in parallel, each creating resources of random names
+	run := func(t *testing.T, recorder *cassette.Recorder, order []string) {
+		client := &http.Client{Transport: recorder}
+		for _, name := range order {
+			t.Run(name, func(t *testing.T) {
+				t.Parallel()
+
+				test := namedTest{t, name}
+				for _, resourceName := range []string{
+					fmt.Sprintf("tf-vpc-%s", RandString(test, 5)),
+					fmt.Sprintf("tf-%d-vm", RandIntRange(test, 1, 9999)),
+				} {
+					resp, err := client.Post(server.URL, "text/plain", strings.NewReader(resourceName))
+					if err != nil {
+						t.Fatalf("creating %s: %s", resourceName, err)
+					}
+					b, _ := io.ReadAll(resp.Body)
+					resp.Body.Close()
+					if string(b) != "created "+resourceName {
+						t.Errorf("expected %s to be created but %q", resourceName, b)
+					}
+				}
+			})
+		}
+	}
+
+	t.Setenv(cassetteModeEnvVar, cassette.ModeRecord)
+	recorder, err := cassette.New(cassette.ModeRecord, path, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Run("record", func(t *testing.T) {
+		run(t, recorder, []string{"TestAccFirst", "TestAccSecond"})
+	})
+	if err := recorder.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Setenv(cassetteModeEnvVar, cassette.ModeReplay)
+	for name, order := range map[string][]string{
+		"replay":         {"TestAccFirst", "TestAccSecond"},
+		"replay reverse": {"TestAccSecond", "TestAccFirst"},
+		"replay one":     {"TestAccSecond"},
+	} {
+		recorder, err := cassette.New(cassette.ModeReplay, path, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Run(name, func(t *testing.T) {
+			run(t, recorder, order)
+		})
+	}
+}
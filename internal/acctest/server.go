@@ -2,12 +2,11 @@ package acctest
 
 import (
 	"fmt"
-
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"testing"
 )
 
-func GetTestServerName() string {
-	rInt := acctest.RandIntRange(1, 9999)
+func GetTestServerName(t testing.TB) string {
+	rInt := RandIntRange(t, 1, 9999)
 	testServerName := fmt.Sprintf("tf-%d-vm", rInt)
 	return testServerName
 }
@@ -0,0 +1,55 @@
+package acctest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// UpgradeResourceState upgrades rawState, the JSON state of resourceType written at version, with the provider
+// of the acceptance tests, and returns the attributes of the upgraded state
+func UpgradeResourceState(t *testing.T, resourceType string, version int64, rawState string) map[string]tftypes.Value {
+	t.Helper()
+	ctx := context.Background()
+
+	server, err := ProtoV6ProviderFactories[ProviderName]()
+	if err != nil {
+		t.Fatalf("creating provider server: %s", err)
+	}
+
+	schemaResp, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("getting provider schema: %s", err)
+	}
+	resourceSchema, ok := schemaResp.ResourceSchemas[resourceType]
+	if !ok {
+		t.Fatalf("no schema of resource %s", resourceType)
+	}
+
+	resp, err := server.UpgradeResourceState(ctx, &tfprotov6.UpgradeResourceStateRequest{
+		TypeName: resourceType,
+		Version:  version,
+		RawState: &tfprotov6.RawState{JSON: []byte(rawState)},
+	})
+	if err != nil {
+		t.Fatalf("upgrading state: %s", err)
+	}
+	for _, d := range resp.Diagnostics {
+		if d.Severity == tfprotov6.DiagnosticSeverityError {
+			t.Fatalf("upgrading state: %s: %s", d.Summary, d.Detail)
+		}
+	}
+
+	state, err := resp.UpgradedState.Unmarshal(resourceSchema.ValueType())
+	if err != nil {
+		t.Fatalf("reading upgraded state: %s", err)
+	}
+
+	var attributes map[string]tftypes.Value
+	if err := state.As(&attributes); err != nil {
+		t.Fatalf("reading upgraded state: %s", err)
+	}
+	return attributes
+}
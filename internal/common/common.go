@@ -1,14 +1,7 @@
 package common
 
 import (
-	"encoding/json"
-	"fmt"
-	"log"
 	"regexp"
-
-	"strings"
-
-	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 )
 
 const (
@@ -25,13 +18,18 @@ const (
 
 	ApiErrorNetworkInterfaceAtLeastOneAcgMustRemain = "1002035"
 
+	ApiErrorAcgNotFound                     = "1007000"
 	ApiErrorAcgCantChangeSameTime           = "1007009"
 	ApiErrorNetworkAclCantAccessaApropriate = "1011002"
 	ApiErrorNetworkAclRuleChangeIngRules    = "1012005"
+	ApiErrorRouteTableNotFound              = "1017007"
 
 	ApiErrorASGIsUsingPolicyOrLaunchConfiguration      = "50150" // This is returned when you cannot delete a launch configuration, scaling policy, or auto scaling group because it is being used.
 	ApiErrorASGScalingIsActive                         = "50160" // You cannot request actions while there are scaling activities in progress for that group.
 	ApiErrorASGIsUsingPolicyOrLaunchConfigurationOnVpc = "1250700"
+
+	ApiErrorCloudDBNotFound     = "5001017" // The Cloud DB instance doesn't exist
+	ApiErrorCloudMssqlIsDeleted = "5001269"
 )
 
 const (
@@ -68,26 +66,6 @@ type CommonError struct {
 	ReturnMessage string
 }
 
-func LogErrorResponse(tag string, err error, args interface{}) {
-	param, _ := json.Marshal(args)
-	log.Printf("[ERROR] %s error params=%s, err=%s", tag, param, err)
-}
-
-func LogCommonRequest(tag string, args interface{}) {
-	param, _ := json.Marshal(args)
-	log.Printf("[INFO] %s params=%s", tag, param)
-}
-
-func LogResponse(tag string, args interface{}) {
-	resp, _ := json.Marshal(args)
-	log.Printf("[INFO] %s response=%s", tag, resp)
-}
-
-func LogCommonResponse(tag string, commonResponse *CommonResponse, logs ...string) {
-	result := fmt.Sprintf("RequestID: %s, ReturnCode: %s, ReturnMessage: %s", ncloud.StringValue(commonResponse.RequestId), ncloud.StringValue(commonResponse.ReturnCode), ncloud.StringValue(commonResponse.ReturnMessage))
-	log.Printf("[INFO] %s success response=%s %s", tag, result, strings.Join(logs, " "))
-}
-
 func ContainsInStringList(str string, s []string) bool {
 	for _, v := range s {
 		if v == str {
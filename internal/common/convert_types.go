@@ -4,7 +4,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"reflect"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
@@ -57,25 +56,14 @@ func GetCommonResponse(i interface{}) *CommonResponse {
 
 // GetCommonErrorBody parse common error message
 func GetCommonErrorBody(err error) (*CommonError, error) {
-	sa := strings.Split(err.Error(), "Body: ")
-	var errMsg string
-
-	if len(sa) != 2 {
+	e, ok := AsNcpError(err)
+	if !ok {
 		return nil, fmt.Errorf("error body is incorrect: %s", err)
 	}
 
-	errMsg = sa[1]
-
-	var m map[string]interface{}
-	if err := json.Unmarshal([]byte(errMsg), &m); err != nil {
-		return nil, err
-	}
-
-	e := m["responseError"].(map[string]interface{})
-
 	return &CommonError{
-		ReturnCode:    e["returnCode"].(string),
-		ReturnMessage: e["returnMessage"].(string),
+		ReturnCode:    e.ReturnCode,
+		ReturnMessage: e.ReturnMessage,
 	}, nil
 }
 
@@ -1,6 +1,18 @@
 package common
 
-import "fmt"
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	"github.com/aws/smithy-go"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+)
 
 // NotSupportClassic return error for not support classic
 func NotSupportClassic(name string) error {
@@ -21,3 +33,273 @@ func ErrorRequiredArgOnVpc(name string) error {
 func ErrorRequiredArgOnClassic(name string) error {
 	return fmt.Errorf("missing required argument: The argument \"%s\" is required on classic", name)
 }
+
+// ErrorKind classifies the errors of the NCP APIs
+type ErrorKind int
+
+const (
+	ErrorKindUnknown ErrorKind = iota
+	ErrorKindNotFound
+	ErrorKindConflict
+	ErrorKindInOperation
+	ErrorKindThrottled
+	ErrorKindAuth
+)
+
+func (k ErrorKind) String() string {
+	switch k {
+	case ErrorKindNotFound:
+		return "NotFound"
+	case ErrorKindConflict:
+		return "Conflict"
+	case ErrorKindInOperation:
+		return "InOperation"
+	case ErrorKindThrottled:
+		return "Throttled"
+	case ErrorKindAuth:
+		return "Auth"
+	}
+	return "Unknown"
+}
+
+const (
+	// return codes of the NCP API gateway, in the body of the "error" format
+	apiGatewayErrorAuthenticationFailed = "200"
+	apiGatewayErrorPermissionDenied     = "210"
+	apiGatewayErrorNotFound             = "300"
+	apiGatewayErrorThrottleLimited      = "410"
+	apiGatewayErrorRateLimited          = "420"
+)
+
+var (
+	notFoundReturnCodes           = []string{ApiErrorAcgNotFound, ApiErrorRouteTableNotFound, ApiErrorCloudDBNotFound, ApiErrorCloudMssqlIsDeleted}
+	authReturnCodes               = []string{ApiErrorAuthorityParameter}
+	apiGatewayAuthReturnCodes     = []string{apiGatewayErrorAuthenticationFailed, apiGatewayErrorPermissionDenied}
+	apiGatewayThrottleReturnCodes = []string{apiGatewayErrorThrottleLimited, apiGatewayErrorRateLimited}
+)
+
+// NcpError is an error response of the NCP APIs, parsed from the errors of the swagger clients
+// ("Status: <status>, Body: <body>") or of the S3 client of object storage
+type NcpError struct {
+	StatusCode    int
+	ReturnCode    string
+	ReturnMessage string
+	RequestId     string
+	Kind          ErrorKind
+
+	err error
+}
+
+func (e *NcpError) Error() string {
+	return e.err.Error()
+}
+
+func (e *NcpError) Unwrap() error {
+	return e.err
+}
+
+// Detail describes the error for diagnostics, with the return code and request id reported by NCP
+func (e *NcpError) Detail() string {
+	var sb strings.Builder
+	if e.ReturnCode != "" {
+		sb.WriteString("NCP return code: " + e.ReturnCode)
+		if e.ReturnMessage != "" {
+			sb.WriteString(" (" + e.ReturnMessage + ")")
+		}
+	}
+	if e.RequestId != "" {
+		if sb.Len() > 0 {
+			sb.WriteString("\n")
+		}
+		sb.WriteString("NCP request id: " + e.RequestId)
+	}
+	return sb.String()
+}
+
+// AsNcpError finds the NCP error response in the chain of err
+func AsNcpError(err error) (*NcpError, bool) {
+	if err == nil {
+		return nil, false
+	}
+
+	var ncpErr *NcpError
+	if errors.As(err, &ncpErr) {
+		return ncpErr, true
+	}
+
+	if e, ok := parseS3Error(err); ok {
+		return e, true
+	}
+
+	e, ok := ParseNcpErrorMessage(err.Error())
+	if !ok {
+		return nil, false
+	}
+	e.err = err
+	return e, true
+}
+
+// ParseNcpErrorMessage parses the "Status: <status>, Body: <body>" message the swagger clients report errors with.
+// Both the "responseError" body of the NCP APIs and the "error" body of the API gateway are supported.
+func ParseNcpErrorMessage(msg string) (*NcpError, bool) {
+	statusIdx := strings.Index(msg, "Status: ")
+	bodyIdx := strings.Index(msg, "Body: ")
+	if statusIdx < 0 || bodyIdx < statusIdx {
+		return nil, false
+	}
+
+	e := &NcpError{err: errors.New(msg)}
+
+	status := strings.Fields(msg[statusIdx+len("Status: ") : bodyIdx])
+	if len(status) > 0 {
+		e.StatusCode, _ = strconv.Atoi(strings.TrimSuffix(status[0], ","))
+	}
+
+	var fromApiGateway bool
+	var body struct {
+		RequestId     string `json:"requestId"`
+		ResponseError struct {
+			ReturnCode    conn.ReturnCode `json:"returnCode"`
+			ReturnMessage string          `json:"returnMessage"`
+			RequestId     string          `json:"requestId"`
+		} `json:"responseError"`
+		Error struct {
+			ErrorCode conn.ReturnCode `json:"errorCode"`
+			Message   string          `json:"message"`
+			Details   string          `json:"details"`
+			RequestId string          `json:"requestId"`
+		} `json:"error"`
+	}
+
+	// the body may be followed by the message of an error wrapping it
+	if err := json.NewDecoder(strings.NewReader(msg[bodyIdx+len("Body: "):])).Decode(&body); err == nil {
+		switch {
+		case body.ResponseError.ReturnCode != "":
+			e.ReturnCode = string(body.ResponseError.ReturnCode)
+			e.ReturnMessage = body.ResponseError.ReturnMessage
+		case body.Error.ErrorCode != "":
+			e.ReturnCode = string(body.Error.ErrorCode)
+			fromApiGateway = true
+			e.ReturnMessage = body.Error.Message
+			if e.ReturnMessage == "" {
+				e.ReturnMessage = body.Error.Details
+			}
+		}
+
+		for _, id := range []string{body.RequestId, body.ResponseError.RequestId, body.Error.RequestId} {
+			if id != "" {
+				e.RequestId = id
+				break
+			}
+		}
+	}
+
+	if e.StatusCode == 0 && e.ReturnCode == "" {
+		return nil, false
+	}
+
+	e.Kind = classifyNcpError(e.StatusCode, e.ReturnCode, fromApiGateway)
+	return e, true
+}
+
+func parseS3Error(err error) (*NcpError, bool) {
+	var apiErr smithy.APIError
+	if !errors.As(err, &apiErr) {
+		return nil, false
+	}
+
+	e := &NcpError{
+		ReturnCode:    apiErr.ErrorCode(),
+		ReturnMessage: apiErr.ErrorMessage(),
+		err:           err,
+	}
+
+	var respErr *awshttp.ResponseError
+	if errors.As(err, &respErr) {
+		e.StatusCode = respErr.HTTPStatusCode()
+		e.RequestId = respErr.ServiceRequestID()
+	}
+
+	switch e.ReturnCode {
+	case "NoSuchBucket", "NoSuchKey", "NotFound":
+		e.Kind = ErrorKindNotFound
+	case "BucketAlreadyExists", "BucketAlreadyOwnedByYou", "BucketNotEmpty":
+		e.Kind = ErrorKindConflict
+	case "OperationAborted":
+		e.Kind = ErrorKindInOperation
+	case "SlowDown", "RequestLimitExceeded":
+		e.Kind = ErrorKindThrottled
+	case "AccessDenied", "InvalidAccessKeyId", "SignatureDoesNotMatch":
+		e.Kind = ErrorKindAuth
+	default:
+		e.Kind = classifyNcpError(e.StatusCode, "", false)
+	}
+
+	return e, true
+}
+
+func classifyNcpError(statusCode int, returnCode string, fromApiGateway bool) ErrorKind {
+	if fromApiGateway {
+		switch {
+		case ContainsInStringList(returnCode, apiGatewayThrottleReturnCodes):
+			return ErrorKindThrottled
+		case ContainsInStringList(returnCode, apiGatewayAuthReturnCodes):
+			return ErrorKindAuth
+		// the API gateway answers 404 for unknown paths too, which doesn't tell the object is gone
+		case returnCode == apiGatewayErrorNotFound:
+			return ErrorKindUnknown
+		}
+	}
+
+	switch {
+	case ContainsInStringList(returnCode, notFoundReturnCodes):
+		return ErrorKindNotFound
+	case ContainsInStringList(returnCode, conn.DefaultRetryableReturnCodes):
+		return ErrorKindInOperation
+	case statusCode == http.StatusTooManyRequests:
+		return ErrorKindThrottled
+	case ContainsInStringList(returnCode, authReturnCodes), statusCode == http.StatusUnauthorized, statusCode == http.StatusForbidden:
+		return ErrorKindAuth
+	case statusCode == http.StatusNotFound:
+		return ErrorKindNotFound
+	case statusCode == http.StatusConflict:
+		return ErrorKindConflict
+	}
+	return ErrorKindUnknown
+}
+
+func isNcpErrorKind(err error, kind ErrorKind) bool {
+	e, ok := AsNcpError(err)
+	return ok && e.Kind == kind
+}
+
+// IsNotFound tells the requested object doesn't exist (anymore)
+func IsNotFound(err error) bool {
+	return isNcpErrorKind(err, ErrorKindNotFound)
+}
+
+// IsConflict tells the request conflicts with the current state of the object
+func IsConflict(err error) bool {
+	return isNcpErrorKind(err, ErrorKindConflict)
+}
+
+// IsInOperation tells the object is busy with another operation, and the request may succeed later
+func IsInOperation(err error) bool {
+	return isNcpErrorKind(err, ErrorKindInOperation)
+}
+
+// IsThrottled tells the request was rejected by the rate limits of NCP
+func IsThrottled(err error) bool {
+	return isNcpErrorKind(err, ErrorKindThrottled)
+}
+
+// IsAuth tells the credentials are invalid or not allowed to make the request
+func IsAuth(err error) bool {
+	return isNcpErrorKind(err, ErrorKindAuth)
+}
+
+// HasReturnCode tells the NCP error response has one of the return codes
+func HasReturnCode(err error, codes ...string) bool {
+	e, ok := AsNcpError(err)
+	return ok && ContainsInStringList(e.ReturnCode, codes)
+}
@@ -0,0 +1,150 @@
+package common
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	"github.com/aws/smithy-go"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+)
+
+func TestAsNcpError(t *testing.T) {
+	cases := []struct {
+		name       string
+		err        error
+		statusCode int
+		returnCode string
+		requestId  string
+		kind       ErrorKind
+	}{
+		{
+			name:       "response error in operation",
+			err:        fmt.Errorf(`Status: 400 Bad Request, Body: {"responseError": {"returnCode": "25013", "returnMessage": "Object in operation"}}`),
+			statusCode: 400,
+			returnCode: ApiErrorObjectInOperation,
+			kind:       ErrorKindInOperation,
+		},
+		{
+			name:       "cloud db not found with request id",
+			err:        fmt.Errorf(`Status: 400 Bad Request, Body: {"responseError": {"returnCode": "5001017", "returnMessage": "No data", "requestId": "a1b2"}}`),
+			statusCode: 400,
+			returnCode: ApiErrorCloudDBNotFound,
+			requestId:  "a1b2",
+			kind:       ErrorKindNotFound,
+		},
+		{
+			name:       "wrapped response error",
+			err:        fmt.Errorf("reading cluster: %w", fmt.Errorf(`Status: 404 Not Found, Body: {"error": {"errorCode": 404, "message": "cluster not found"}}`)),
+			statusCode: 404,
+			returnCode: "404",
+			kind:       ErrorKindNotFound,
+		},
+		{
+			name:       "api gateway unknown path",
+			err:        fmt.Errorf(`Status: 404 Not Found, Body: {"error": {"errorCode": "300", "message": "Not Found Exception"}}`),
+			statusCode: 404,
+			returnCode: "300",
+			kind:       ErrorKindUnknown,
+		},
+		{
+			name:       "api gateway authentication failed",
+			err:        fmt.Errorf(`Status: 401 Unauthorized, Body: {"error": {"errorCode": "200", "message": "Authentication Failed"}}`),
+			statusCode: 401,
+			returnCode: "200",
+			kind:       ErrorKindAuth,
+		},
+		{
+			name:       "api gateway throttled",
+			err:        fmt.Errorf(`Status: 429 Too Many Requests, Body: {"error": {"errorCode": "420", "message": "Rate Limited"}}`),
+			statusCode: 429,
+			returnCode: "420",
+			kind:       ErrorKindThrottled,
+		},
+		{
+			name:       "conflict without body",
+			err:        fmt.Errorf("Status: 409 Conflict, Body: "),
+			statusCode: 409,
+			kind:       ErrorKindConflict,
+		},
+		{
+			name: "s3 no such key",
+			err: &awshttp.ResponseError{
+				ResponseError: &smithyhttp.ResponseError{
+					Response: &smithyhttp.Response{Response: &http.Response{StatusCode: 404}},
+					Err:      &smithy.GenericAPIError{Code: "NoSuchKey", Message: "The specified key does not exist."},
+				},
+				RequestID: "s3-req",
+			},
+			statusCode: 404,
+			returnCode: "NoSuchKey",
+			requestId:  "s3-req",
+			kind:       ErrorKindNotFound,
+		},
+		{
+			name:       "s3 slow down",
+			err:        &smithy.GenericAPIError{Code: "SlowDown"},
+			returnCode: "SlowDown",
+			kind:       ErrorKindThrottled,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			e, ok := AsNcpError(tc.err)
+			if !ok {
+				t.Fatalf("expected an NCP error from %q", tc.err)
+			}
+			if e.StatusCode != tc.statusCode {
+				t.Errorf("status code expected %d but %d", tc.statusCode, e.StatusCode)
+			}
+			if e.ReturnCode != tc.returnCode {
+				t.Errorf("return code expected %q but %q", tc.returnCode, e.ReturnCode)
+			}
+			if e.RequestId != tc.requestId {
+				t.Errorf("request id expected %q but %q", tc.requestId, e.RequestId)
+			}
+			if e.Kind != tc.kind {
+				t.Errorf("kind expected %s but %s", tc.kind, e.Kind)
+			}
+			if e.Error() != tc.err.Error() {
+				t.Errorf("message expected %q but %q", tc.err.Error(), e.Error())
+			}
+		})
+	}
+}
+
+func TestAsNcpError_notNcpError(t *testing.T) {
+	for _, err := range []error{nil, fmt.Errorf("connection refused"), fmt.Errorf("Status: unknown, Body: not json")} {
+		if _, ok := AsNcpError(err); ok {
+			t.Errorf("expected no NCP error from %v", err)
+		}
+		if IsNotFound(err) || HasReturnCode(err, "") {
+			t.Errorf("expected no classification of %v", err)
+		}
+	}
+}
+
+func TestNcpErrorDetail(t *testing.T) {
+	e, _ := ParseNcpErrorMessage(`Status: 400 Bad Request, Body: {"responseError": {"returnCode": "5001017", "returnMessage": "No data", "requestId": "a1b2"}}`)
+
+	expected := "NCP return code: 5001017 (No data)\nNCP request id: a1b2"
+	if detail := e.Detail(); detail != expected {
+		t.Errorf("detail expected %q but %q", expected, detail)
+	}
+}
+
+func TestHasReturnCode(t *testing.T) {
+	err := fmt.Errorf(`Status: 400 Bad Request, Body: {"responseError": {"returnCode": "1007009", "returnMessage": "busy"}}`)
+
+	if !HasReturnCode(err, ApiErrorAcgNotFound, ApiErrorAcgCantChangeSameTime) {
+		t.Errorf("expected return code %s", ApiErrorAcgCantChangeSameTime)
+	}
+	if HasReturnCode(err, ApiErrorAcgNotFound) {
+		t.Errorf("unexpected return code %s", ApiErrorAcgNotFound)
+	}
+	if !IsInOperation(err) {
+		t.Errorf("expected an object in operation")
+	}
+}
@@ -0,0 +1,472 @@
+package common
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+const (
+	FilterOperatorEquals    = "equals"
+	FilterOperatorNotEquals = "not_equals"
+	FilterOperatorPrefix    = "prefix"
+	FilterOperatorGt        = "gt"
+	FilterOperatorLt        = "lt"
+	FilterOperatorIn        = "in"
+)
+
+// FilterOperators are the accepted values of the "operator" of the filters
+var FilterOperators = []string{
+	FilterOperatorEquals,
+	FilterOperatorNotEquals,
+	FilterOperatorPrefix,
+	FilterOperatorGt,
+	FilterOperatorLt,
+	FilterOperatorIn,
+}
+
+// filterTimeLayouts are the formats of the dates compared by the "gt" and "lt" operators and sorted by "sort_by"
+var filterTimeLayouts = []string{
+	time.RFC3339,
+	"2006-01-02T15:04:05-0700",
+	"2006-01-02 15:04:05",
+	"2006-01-02",
+}
+
+// DataSourceFilter is a "filter" block of the data sources, with the path of the attribute it filters by.
+// The path goes through the nested objects and lists, the filter matches when any of the elements of a list matches.
+type DataSourceFilter struct {
+	Path     []string
+	Values   []string
+	Regex    bool
+	Operator string
+}
+
+// DataSourceQuery is the filters and the sort order of the results of a plural data source
+type DataSourceQuery struct {
+	Filters []DataSourceFilter
+
+	// SortBy is the path of the attribute the results are sorted by in ascending order, the results without the
+	// attribute come first
+	SortBy []string
+
+	// MostRecent keeps only the last result sorted by SortBy
+	MostRecent bool
+}
+
+// QueryItems returns the items matching all the filters of the query, in the order of the query.
+// fields returns the attributes of an item as decoded from JSON: maps, lists and scalar values.
+func QueryItems[T any](items []T, query DataSourceQuery, fields func(T) interface{}) []T {
+	type queryItem struct {
+		item   T
+		fields interface{}
+	}
+
+	var matches []queryItem
+	for _, item := range items {
+		f := fields(item)
+		if query.matches(f) {
+			matches = append(matches, queryItem{item, f})
+		}
+	}
+
+	if len(query.SortBy) > 0 {
+		slices.SortStableFunc(matches, func(a, b queryItem) int {
+			return compareSortValues(firstValueAtPath(a.fields, query.SortBy), firstValueAtPath(b.fields, query.SortBy))
+		})
+
+		if query.MostRecent && len(matches) > 0 {
+			matches = matches[len(matches)-1:]
+		}
+	}
+
+	var res []T
+	for _, m := range matches {
+		res = append(res, m.item)
+	}
+	return res
+}
+
+func (q DataSourceQuery) matches(fields interface{}) bool {
+	for _, f := range q.Filters {
+		if !f.Match(fields) {
+			return false
+		}
+	}
+	return true
+}
+
+// Match tells if the attributes of an item match the filter
+func (f DataSourceFilter) Match(fields interface{}) bool {
+	targets := valuesAtPath(fields, f.Path)
+
+	if f.Operator == FilterOperatorNotEquals {
+		return !f.matchAny(targets, FilterOperatorEquals)
+	}
+	return f.matchAny(targets, f.Operator)
+}
+
+// matchAny returns true for any filter value that matches any of the target values
+func (f DataSourceFilter) matchAny(targets []interface{}, operator string) bool {
+	for _, target := range targets {
+		for _, value := range f.Values {
+			if f.matchValue(target, value, operator) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (f DataSourceFilter) matchValue(target interface{}, value string, operator string) bool {
+	switch operator {
+	case FilterOperatorPrefix:
+		s, ok := target.(string)
+		return ok && strings.HasPrefix(s, value)
+	case FilterOperatorGt, FilterOperatorLt:
+		c, ok := compareFilterValue(target, value)
+		if !ok {
+			log.Printf(`[WARN] Filtering "%s" with "%s" against a value which is neither a number nor a date: %v`, strings.Join(f.Path, "."), operator, target)
+			return false
+		}
+		if operator == FilterOperatorGt {
+			return c > 0
+		}
+		return c < 0
+	default:
+		return f.equalValue(target, value)
+	}
+}
+
+func (f DataSourceFilter) equalValue(target interface{}, value string) bool {
+	switch t := target.(type) {
+	case bool:
+		fBool, err := strconv.ParseBool(value)
+		if err != nil {
+			log.Println("[WARN] Filtering against Type Bool field with un-parsable string boolean form")
+			return false
+		}
+		return t == fBool
+	case string:
+		if f.Regex {
+			re, err := regexp.Compile(value)
+			if err != nil {
+				log.Printf(`[WARN] Invalid regular expression "%s" for "%s" filter\n`, value, strings.Join(f.Path, "."))
+				return false
+			}
+			return re.MatchString(t)
+		}
+		return t == value
+	}
+
+	if n, ok := toFloat(target); ok {
+		// users can supply string or number like `values = [300, "3600"]` but terraform converts them to string
+		fFloat, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			log.Println("[WARN] Filtering against Type Number field with non-number filter value")
+			return false
+		}
+		return n == fFloat
+	}
+	return false
+}
+
+// compareFilterValue compares the target to the filter value as numbers, or as dates
+func compareFilterValue(target interface{}, value string) (int, bool) {
+	if n, ok := toFloat(target); ok {
+		fFloat, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return 0, false
+		}
+		return compareFloats(n, fFloat), true
+	}
+
+	if s, ok := target.(string); ok {
+		return compareStrings(s, value)
+	}
+	return 0, false
+}
+
+// compareStrings compares the strings as numbers, or as dates
+func compareStrings(a, b string) (int, bool) {
+	if fa, err := strconv.ParseFloat(a, 64); err == nil {
+		if fb, err := strconv.ParseFloat(b, 64); err == nil {
+			return compareFloats(fa, fb), true
+		}
+	}
+
+	if ta, ok := parseFilterTime(a); ok {
+		if tb, ok := parseFilterTime(b); ok {
+			return ta.Compare(tb), true
+		}
+	}
+	return 0, false
+}
+
+// compareSortValues orders the values of "sort_by", missing values first
+func compareSortValues(a, b interface{}) int {
+	switch {
+	case a == nil && b == nil:
+		return 0
+	case a == nil:
+		return -1
+	case b == nil:
+		return 1
+	}
+
+	if fa, ok := toFloat(a); ok {
+		if fb, ok := toFloat(b); ok {
+			return compareFloats(fa, fb)
+		}
+	}
+
+	if ba, ok := a.(bool); ok {
+		if bb, ok := b.(bool); ok {
+			return compareBools(ba, bb)
+		}
+	}
+
+	sa, sb := toString(a), toString(b)
+	if c, ok := compareStrings(sa, sb); ok {
+		return c
+	}
+	return strings.Compare(sa, sb)
+}
+
+func compareFloats(a, b float64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+func compareBools(a, b bool) int {
+	switch {
+	case a == b:
+		return 0
+	case b:
+		return -1
+	}
+	return 1
+}
+
+func parseFilterTime(s string) (time.Time, bool) {
+	for _, layout := range filterTimeLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+func toFloat(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case float32:
+		return float64(n), true
+	case int:
+		return float64(n), true
+	case int32:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case json.Number:
+		f, err := n.Float64()
+		return f, err == nil
+	}
+	return 0, false
+}
+
+func toString(v interface{}) string {
+	if s, ok := v.(string); ok {
+		return s
+	}
+	b, _ := json.Marshal(v)
+	return string(b)
+}
+
+func firstValueAtPath(fields interface{}, path []string) interface{} {
+	if values := valuesAtPath(fields, path); len(values) > 0 {
+		return values[0]
+	}
+	return nil
+}
+
+// valuesAtPath returns the scalar values at the path, through the maps and all the elements of the lists
+func valuesAtPath(v interface{}, path []string) []interface{} {
+	v = normalizeFieldValue(v)
+
+	switch v := v.(type) {
+	case nil:
+		return nil
+	case []interface{}:
+		var values []interface{}
+		for _, elem := range v {
+			values = append(values, valuesAtPath(elem, path)...)
+		}
+		return values
+	case map[string]interface{}:
+		if len(path) == 0 {
+			return nil
+		}
+		return valuesAtPath(v[path[0]], path[1:])
+	}
+
+	if len(path) > 0 {
+		return nil
+	}
+	return []interface{}{v}
+}
+
+// normalizeFieldValue converts the pointers, the typed slices and maps and the string based enums of the SDK into the
+// values decoded from JSON
+func normalizeFieldValue(v interface{}) interface{} {
+	switch v.(type) {
+	case nil, bool, string, float64, float32, int, int32, int64, json.Number, []interface{}, map[string]interface{}:
+		return v
+	}
+
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Ptr || rv.Kind() == reflect.Interface {
+		if rv.IsNil() {
+			return nil
+		}
+		rv = rv.Elem()
+	}
+
+	switch rv.Kind() {
+	case reflect.Bool:
+		return rv.Bool()
+	case reflect.String:
+		return rv.String()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return rv.Int()
+	case reflect.Float32, reflect.Float64:
+		return rv.Float()
+	case reflect.Slice, reflect.Array:
+		list := make([]interface{}, rv.Len())
+		for i := range list {
+			list[i] = rv.Index(i).Interface()
+		}
+		return list
+	case reflect.Map:
+		if rv.Type().Key().Kind() != reflect.String {
+			return nil
+		}
+		m := make(map[string]interface{}, rv.Len())
+		for _, key := range rv.MapKeys() {
+			m[key.String()] = rv.MapIndex(key).Interface()
+		}
+		return m
+	case reflect.Struct:
+		// structs of the SDK, by their JSON names
+		b, err := json.Marshal(rv.Interface())
+		if err != nil {
+			return nil
+		}
+		var m map[string]interface{}
+		if json.Unmarshal(b, &m) != nil {
+			return nil
+		}
+		return m
+	}
+	return nil
+}
+
+// modelFields returns the attributes of a framework model by their tfsdk names
+func modelFields[M any](ctx context.Context, data *M) interface{} {
+	rv := reflect.ValueOf(data).Elem()
+	if rv.Kind() != reflect.Struct {
+		return nil
+	}
+
+	fields := make(map[string]interface{}, rv.NumField())
+	for i := 0; i < rv.NumField(); i++ {
+		alias, ok := rv.Type().Field(i).Tag.Lookup("tfsdk")
+		if !ok || alias == "" || alias == "-" || !rv.Field(i).CanInterface() {
+			continue
+		}
+
+		if v, ok := rv.Field(i).Interface().(attr.Value); ok {
+			fields[alias] = AttrValueToInterface(ctx, v)
+		}
+	}
+	return fields
+}
+
+// AttrValueToInterface converts a framework value into the value decoded from JSON, nil when it is null or unknown
+func AttrValueToInterface(ctx context.Context, v attr.Value) interface{} {
+	if v == nil || v.IsNull() || v.IsUnknown() {
+		return nil
+	}
+
+	switch v := v.(type) {
+	case basetypes.StringValuable:
+		s, _ := v.ToStringValue(ctx)
+		return s.ValueString()
+	case basetypes.BoolValuable:
+		b, _ := v.ToBoolValue(ctx)
+		return b.ValueBool()
+	case basetypes.Int64Valuable:
+		i, _ := v.ToInt64Value(ctx)
+		return i.ValueInt64()
+	case basetypes.Int32Valuable:
+		i, _ := v.ToInt32Value(ctx)
+		return int64(i.ValueInt32())
+	case basetypes.Float64Valuable:
+		f, _ := v.ToFloat64Value(ctx)
+		return f.ValueFloat64()
+	case basetypes.NumberValuable:
+		n, _ := v.ToNumberValue(ctx)
+		f, _ := n.ValueBigFloat().Float64()
+		return f
+	case basetypes.ListValuable:
+		l, _ := v.ToListValue(ctx)
+		return attrValuesToInterface(ctx, l.Elements())
+	case basetypes.SetValuable:
+		s, _ := v.ToSetValue(ctx)
+		return attrValuesToInterface(ctx, s.Elements())
+	case basetypes.ObjectValuable:
+		o, _ := v.ToObjectValue(ctx)
+		return attrMapToInterface(ctx, o.Attributes())
+	case basetypes.MapValuable:
+		m, _ := v.ToMapValue(ctx)
+		return attrMapToInterface(ctx, m.Elements())
+	}
+	return nil
+}
+
+func attrValuesToInterface(ctx context.Context, elems []attr.Value) []interface{} {
+	list := make([]interface{}, 0, len(elems))
+	for _, elem := range elems {
+		list = append(list, AttrValueToInterface(ctx, elem))
+	}
+	return list
+}
+
+func attrMapToInterface(ctx context.Context, elems map[string]attr.Value) map[string]interface{} {
+	m := make(map[string]interface{}, len(elems))
+	for key, elem := range elems {
+		m[key] = AttrValueToInterface(ctx, elem)
+	}
+	return m
+}
+
+// WriteListToFile writes the elements of a framework list, such as the filtered results of a data source, as JSON
+func WriteListToFile(ctx context.Context, filePath string, list types.List) error {
+	return WriteToFile(filePath, attrValuesToInterface(ctx, list.Elements()))
+}
@@ -7,15 +7,17 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"reflect"
-	"regexp"
-	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func DataSourceFiltersSchema() *schema.Schema {
@@ -41,11 +43,35 @@ func DataSourceFiltersSchema() *schema.Schema {
 					Optional: true,
 					Default:  false,
 				},
+
+				"operator": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(FilterOperators, false)),
+				},
 			},
 		},
 	}
 }
 
+// DataSourceSortBySchema is the attribute the results of a plural data source are sorted by, in ascending order
+func DataSourceSortBySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+	}
+}
+
+// DataSourceMostRecentSchema keeps only the last result of a plural data source sorted by "sort_by"
+func DataSourceMostRecentSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeBool,
+		Optional:     true,
+		Default:      false,
+		RequiredWith: []string{"sort_by"},
+	}
+}
+
 // DataSourceFiltersBlock is the Plugin Framework variant of DataSourceFiltersSchema.
 func DataSourceFiltersBlock() datasourceschema.Block {
 	return datasourceschema.SetNestedBlock{
@@ -61,58 +87,86 @@ func DataSourceFiltersBlock() datasourceschema.Block {
 				"regex": datasourceschema.BoolAttribute{
 					Optional: true,
 				},
+				"operator": datasourceschema.StringAttribute{
+					Optional: true,
+					Validators: []validator.String{
+						stringvalidator.OneOf(FilterOperators...),
+					},
+				},
 			},
 		},
 	}
 }
 
+// DataSourceSortByAttribute is the Plugin Framework variant of DataSourceSortBySchema.
+func DataSourceSortByAttribute() datasourceschema.Attribute {
+	return datasourceschema.StringAttribute{
+		Optional: true,
+	}
+}
+
+// DataSourceMostRecentAttribute is the Plugin Framework variant of DataSourceMostRecentSchema.
+func DataSourceMostRecentAttribute() datasourceschema.Attribute {
+	return datasourceschema.BoolAttribute{
+		Optional: true,
+		Validators: []validator.Bool{
+			boolvalidator.AlsoRequires(path.MatchRoot("sort_by")),
+		},
+	}
+}
+
 func ApplyFilters(filters *schema.Set, items []map[string]interface{}, resourceSchema map[string]*schema.Schema) []map[string]interface{} {
 	if filters == nil || filters.Len() == 0 {
 		return items
 	}
 
+	query := DataSourceQuery{}
 	for _, f := range filters.List() {
 		fSet := f.(map[string]interface{})
-		keyword := fSet["name"].(string)
-		var pathElements []string
-		var err error
-		if pathElements, err = getFieldPathElements(resourceSchema, keyword); err != nil {
-			pathElements = []string{keyword}
-		}
 
-		isReg := false
+		filter := DataSourceFilter{
+			Path: getFilterPath(resourceSchema, fSet["name"].(string)),
+		}
+		for _, v := range fSet["values"].([]interface{}) {
+			filter.Values = append(filter.Values, v.(string))
+		}
 		if regex, regexOk := fSet["regex"]; regexOk {
-			isReg = regex.(bool)
+			filter.Regex = regex.(bool)
+		}
+		if operator, operatorOk := fSet["operator"]; operatorOk {
+			filter.Operator = operator.(string)
 		}
+		query.Filters = append(query.Filters, filter)
+	}
 
-		// create a string equality check strategy based on this filters "regex" flag
-		stringsEqual := func(propertyVal string, filterVal string) bool {
-			if isReg {
-				re, err := regexp.Compile(filterVal)
-				if err != nil {
-					// todo: when all SetData() fns are refactored to return a possible error, these log statements should
-					// be converted to errors for return propagation
-					log.Printf(`[WARN] Invalid regular expression "%s" for "%s" filter\n`, filterVal, keyword)
-					return false
-				}
-				return re.MatchString(propertyVal)
-			}
+	return QueryItems(items, query, func(item map[string]interface{}) interface{} { return item })
+}
 
-			return filterVal == propertyVal
-		}
+// ApplyDataSourceQuery applies the "filter", "sort_by" and "most_recent" arguments of a SDKv2 plural data source to its items
+func ApplyDataSourceQuery(d *schema.ResourceData, items []map[string]interface{}, resourceSchema map[string]*schema.Schema) []map[string]interface{} {
+	if f, ok := d.GetOk("filter"); ok {
+		items = ApplyFilters(f.(*schema.Set), items, resourceSchema)
+	}
 
-		// build a collection of items from matches against the set of filters
-		res := make([]map[string]interface{}, 0)
-		for _, item := range items {
-			targetVal, targetValOk := getValueFromPath(item, pathElements)
-			if targetValOk && orComparator(targetVal, fSet["values"].([]interface{}), stringsEqual) {
-				res = append(res, item)
-			}
-		}
-		items = res
+	sortBy, ok := d.GetOk("sort_by")
+	if !ok {
+		return items
+	}
+
+	query := DataSourceQuery{
+		SortBy:     getFilterPath(resourceSchema, sortBy.(string)),
+		MostRecent: d.Get("most_recent").(bool),
 	}
+	return QueryItems(items, query, func(item map[string]interface{}) interface{} { return item })
+}
 
-	return items
+// getFilterPath returns the path of the attribute named by the filter, or the tokens of its name when the schema doesn't
+// know it
+func getFilterPath(resourceSchema map[string]*schema.Schema, filterName string) []string {
+	if pathElements, err := getFieldPathElements(resourceSchema, filterName); err == nil {
+		return pathElements
+	}
+	return strings.Split(filterName, ".")
 }
 
 // Converts the filter name which is delimited by '.' into a list of XPath elements
@@ -179,7 +233,7 @@ func isValidSchemaType(fieldSchema *schema.Schema) bool {
 	if fieldSchema.Type == schema.TypeList || fieldSchema.Type == schema.TypeSet {
 		if elemSchema, conversionOk := fieldSchema.Elem.(*schema.Schema); conversionOk && elemSchema.Type == schema.TypeString {
 			return true
-		} else if fieldSchema.MaxItems == 1 && fieldSchema.MinItems == 1 { //nested structures
+		} else if _, conversionOk := fieldSchema.Elem.(*schema.Resource); conversionOk { //nested structures, matched by any of their elements
 			return true
 		}
 		return false
@@ -188,126 +242,21 @@ func isValidSchemaType(fieldSchema *schema.Schema) bool {
 	return true
 }
 
-func getValueFromPath(item map[string]interface{}, path []string) (targetVal interface{}, targetValOk bool) {
-	workingMap := item
-	var tempWorkingMap map[string]interface{}
-	var conversionOk bool
-	for _, pathElement := range path[:len(path)-1] {
-		// Defensive check for non existent values
-		if workingMap[pathElement] == nil {
-			return nil, false
-		}
-		// Check if it is map
-		if tempWorkingMap, conversionOk = checkAndConvertMap(workingMap[pathElement]); !conversionOk {
-			// if not map then it has to be a nested structure which is modeled as list with exactly one element of type map[string]interface{}
-			if tempWorkingMap, conversionOk = checkAndConvertNestedStructure(workingMap[pathElement]); !conversionOk {
-				return nil, false
-			}
-		}
-		workingMap = tempWorkingMap
-	}
-
-	targetVal, targetValOk = workingMap[path[len(path)-1]]
-	return
-}
-
-func checkAndConvertMap(element interface{}) (map[string]interface{}, bool) {
-	if tempWorkingMap, isOk := element.(map[string]interface{}); isOk {
-		return tempWorkingMap, true
-	}
-
-	if stringToStrinMap, isOk := element.(map[string]string); isOk {
-		return convertToObjectMap(stringToStrinMap), true
-	}
-
-	return nil, false
-}
-
-func convertToObjectMap(stringTostring map[string]string) map[string]interface{} {
-	convertedMap := make(map[string]interface{}, len(stringTostring))
-	for key, value := range stringTostring {
-		convertedMap[key] = value
-	}
-
-	return convertedMap
-}
-
-func checkAndConvertNestedStructure(element interface{}) (map[string]interface{}, bool) {
-	if convertedList, convertedListOk := element.([]interface{}); convertedListOk && len(convertedList) == 1 {
-		workingMap, isOk := convertedList[0].(map[string]interface{})
-		return workingMap, isOk
-	}
-
-	return nil, false
+// FilterModels applies the "filter" blocks of a framework data source to its models
+func FilterModels[M any](ctx context.Context, filterSet types.Set, datas []*M) []*M {
+	return QueryModels(ctx, filterSet, types.StringNull(), types.BoolNull(), datas)
 }
 
-type StringCheck func(propertyVal string, filterVal string) bool
-
-// orComparator returns true for any filter that matches the target property
-func orComparator(target interface{}, filters []interface{}, stringsEqual StringCheck) bool {
-	// Use reflection to determine whether the underlying type of the filtering attribute is a string or
-	// array of strings. Mainly used because the property could be an SDK enum with underlying string type.
-	val := reflect.ValueOf(target)
-	valType := val.Type()
-
-	for _, fVal := range filters {
-		switch valType.Kind() {
-		case reflect.Bool:
-			fBool, err := strconv.ParseBool(fVal.(string))
-			if err != nil {
-				log.Println("[WARN] Filtering against Type Bool field with un-parsable string boolean form")
-				return false
-			}
-			if val.Bool() == fBool {
-				return true
-			}
-		case reflect.Int, reflect.Int32, reflect.Int64:
-			// the target field is of type int, but the filter values list element type is string, users can supply string
-			// or int like `values = [300, "3600"]` but terraform will converts to string, so use ParseInt
-			fInt, err := strconv.ParseInt(fVal.(string), 10, 64)
-			if err != nil {
-				log.Println("[WARN] Filtering against Type Int field with non-int filter value")
-				return false
-			}
-			if val.Int() == fInt {
-				return true
-			}
-		case reflect.Float64:
-			// same comment as above for Ints
-			fFloat, err := strconv.ParseFloat(fVal.(string), 64)
-			if err != nil {
-				log.Println("[WARN] Filtering against Type Float field with non-float filter value")
-				return false
-			}
-			if val.Float() == fFloat {
-				return true
-			}
-		case reflect.String:
-			if stringsEqual(val.String(), fVal.(string)) {
-				return true
-			}
-		case reflect.Slice, reflect.Array:
-			if valType.Elem().Kind() == reflect.String {
-				arrLen := val.Len()
-				for i := 0; i < arrLen; i++ {
-					if stringsEqual(val.Index(i).String(), fVal.(string)) {
-						return true
-					}
-				}
-			}
-		}
+// QueryModels applies the "filter" blocks and the "sort_by" and "most_recent" arguments of a framework data source to its models
+func QueryModels[M any](ctx context.Context, filterSet types.Set, sortBy types.String, mostRecent types.Bool, datas []*M) []*M {
+	query := DataSourceQuery{
+		MostRecent: mostRecent.ValueBool(),
 	}
-	return false
-}
-
-func FilterModels[M any](ctx context.Context, filterSet types.Set, datas []*M) []*M {
-	if filterSet.IsNull() || filterSet.IsUnknown() {
-		return datas
+	if !sortBy.IsNull() && !sortBy.IsUnknown() && sortBy.ValueString() != "" {
+		query.SortBy = strings.Split(sortBy.ValueString(), ".")
 	}
 
-	var outputs []*M
-
-	for _, dataModel := range datas {
+	if !filterSet.IsNull() && !filterSet.IsUnknown() {
 		for _, v := range filterSet.Elements() {
 			var data customFilterData
 
@@ -319,112 +268,29 @@ func FilterModels[M any](ctx context.Context, filterSet types.Set, datas []*M) [
 				continue
 			}
 
-			if applyFilter(dataModel, data) {
-				outputs = append(outputs, dataModel)
+			filter := DataSourceFilter{
+				Path:     strings.Split(data.Name.ValueString(), "."),
+				Regex:    data.Regex.ValueBool(),
+				Operator: data.Operator.ValueString(),
 			}
-		}
-
-	}
-
-	return outputs
-}
-
-func applyFilter[M any](dataModel *M, filterData customFilterData) bool {
-	useRegex := false
-	if !filterData.Regex.IsNull() && filterData.Regex.ValueBool() {
-		useRegex = true
-	}
-
-	// create a string equality check strategy based on this filters "regex" flag
-	stringsEqual := func(propertyVal string, filterVal string) bool {
-		// value in filter set enclosed with quote
-		if len(filterVal) > 2 && filterVal[0] == '"' && filterVal[len(filterVal)-1] == '"' {
-			filterVal = filterVal[1 : len(filterVal)-1]
-		}
-		if useRegex {
-			re, err := regexp.Compile(filterVal)
-			if err != nil {
-				// todo: when all SetData() fns are refactored to return a possible error, these log statements should
-				// be converted to errors for return propagation
-				log.Printf(`[WARN] Invalid regular expression "%s" for "%s" filter\n`, filterVal, filterData.Name.String())
-				return false
+			if data.Values.ElementsAs(ctx, &filter.Values, false).HasError() {
+				continue
 			}
-			return re.MatchString(propertyVal)
+			query.Filters = append(query.Filters, filter)
 		}
-
-		return filterVal == propertyVal
 	}
 
-	ty := reflect.TypeOf(*dataModel)
-
-	for i := 0; i < ty.NumField(); i++ {
-		field := ty.Field(i)
-		alias, ok := field.Tag.Lookup("tfsdk")
-		if !ok {
-			continue
-		}
-
-		if alias == "" || alias != filterData.Name.ValueString() {
-			continue
-		}
-
-		// get the value in types defined at the framework
-		// TODO: handle block attributes
-		value := reflect.ValueOf(*dataModel).Field(i).FieldByName("value")
-
-		for _, fValue := range filterData.Values.Elements() {
-			switch value.Kind() {
-			case reflect.Bool:
-				fBool, err := strconv.ParseBool(fValue.String())
-				if err != nil {
-					log.Println("[WARN] Filtering against Type Bool field with un-parsable string boolean form")
-					continue
-				}
-				if value.Bool() == fBool {
-					return true
-				}
-			case reflect.Int, reflect.Int32, reflect.Int64:
-				// the target field is of type int, but the filter values list element type is string, users can supply string
-				// or int like `values = [300, "3600"]` but terraform will converts to string, so use ParseInt
-				fInt, err := strconv.ParseInt(fValue.String(), 10, 64)
-				if err != nil {
-					log.Println("[WARN] Filtering against Type Int field with non-int filter value")
-					continue
-				}
-				if value.Int() == fInt {
-					return true
-				}
-			case reflect.Float64:
-				fFloat, err := strconv.ParseFloat(fValue.String(), 64)
-				if err != nil {
-					log.Println("[WARN] Filtering against Type Float field with non-float filter value")
-					continue
-				}
-				if value.Float() == fFloat {
-					return true
-				}
-			case reflect.Slice, reflect.Array:
-				if value.Elem().Kind() == reflect.String {
-					arrLen := value.Len()
-					for i := 0; i < arrLen; i++ {
-						if stringsEqual(value.String(), fValue.String()) {
-							return true
-						}
-					}
-				}
-			case reflect.String:
-				if stringsEqual(value.String(), fValue.String()) {
-					return true
-				}
-			}
-		}
+	if len(query.Filters) == 0 && len(query.SortBy) == 0 {
+		return datas
 	}
-	return false
+
+	return QueryItems(datas, query, func(data *M) interface{} { return modelFields(ctx, data) })
 }
 
 // customFilterData represents a single configured filter.
 type customFilterData struct {
-	Name   types.String `tfsdk:"name"`
-	Values types.Set    `tfsdk:"values"`
-	Regex  types.Bool   `tfsdk:"regex"`
+	Name     types.String `tfsdk:"name"`
+	Values   types.Set    `tfsdk:"values"`
+	Regex    types.Bool   `tfsdk:"regex"`
+	Operator types.String `tfsdk:"operator"`
 }
@@ -0,0 +1,210 @@
+package common
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var testFilterItems = []map[string]interface{}{
+	{
+		"instance_no": "1",
+		"name":        "web-001",
+		"cpu_count":   float64(2),
+		"create_date": "2024-01-10T10:00:00+0900",
+		"network_interface": []interface{}{
+			map[string]interface{}{"subnet_no": "10", "private_ip": "10.0.0.4"},
+		},
+	},
+	{
+		"instance_no": "2",
+		"name":        "web-002",
+		"cpu_count":   float64(8),
+		"create_date": "2024-03-01T10:00:00+0900",
+		"network_interface": []interface{}{
+			map[string]interface{}{"subnet_no": "10", "private_ip": "10.0.0.5"},
+			map[string]interface{}{"subnet_no": "20", "private_ip": "10.0.1.5"},
+		},
+	},
+	{
+		"instance_no": "3",
+		"name":        "db-001",
+		"cpu_count":   float64(4),
+		"create_date": "2024-02-01T10:00:00+0900",
+	},
+}
+
+var testFilterSchema = map[string]*schema.Schema{
+	"instance_no": {Type: schema.TypeString},
+	"name":        {Type: schema.TypeString},
+	"cpu_count":   {Type: schema.TypeInt},
+	"create_date": {Type: schema.TypeString},
+	"network_interface": {
+		Type: schema.TypeList,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"subnet_no":  {Type: schema.TypeString},
+				"private_ip": {Type: schema.TypeString},
+			},
+		},
+	},
+}
+
+func testInstanceNos(items []map[string]interface{}) []string {
+	var ids []string
+	for _, item := range items {
+		ids = append(ids, item["instance_no"].(string))
+	}
+	return ids
+}
+
+func TestApplyFilters(t *testing.T) {
+	filterSchema := DataSourceFiltersSchema().Elem.(*schema.Resource)
+
+	cases := map[string]struct {
+		filters  []interface{}
+		expected []string
+	}{
+		"equals": {
+			filters:  []interface{}{map[string]interface{}{"name": "name", "values": []interface{}{"web-001", "db-001"}}},
+			expected: []string{"1", "3"},
+		},
+		"regex": {
+			filters:  []interface{}{map[string]interface{}{"name": "name", "values": []interface{}{"^web-"}, "regex": true}},
+			expected: []string{"1", "2"},
+		},
+		"not_equals": {
+			filters:  []interface{}{map[string]interface{}{"name": "name", "values": []interface{}{"web-001"}, "operator": "not_equals"}},
+			expected: []string{"2", "3"},
+		},
+		"prefix": {
+			filters:  []interface{}{map[string]interface{}{"name": "name", "values": []interface{}{"db-"}, "operator": "prefix"}},
+			expected: []string{"3"},
+		},
+		"gt number": {
+			filters:  []interface{}{map[string]interface{}{"name": "cpu_count", "values": []interface{}{"2"}, "operator": "gt"}},
+			expected: []string{"2", "3"},
+		},
+		"lt date": {
+			filters:  []interface{}{map[string]interface{}{"name": "create_date", "values": []interface{}{"2024-02-15T00:00:00Z"}, "operator": "lt"}},
+			expected: []string{"1", "3"},
+		},
+		"in": {
+			filters:  []interface{}{map[string]interface{}{"name": "cpu_count", "values": []interface{}{"4", "8"}, "operator": "in"}},
+			expected: []string{"2", "3"},
+		},
+		"nested list element": {
+			filters:  []interface{}{map[string]interface{}{"name": "network_interface.subnet_no", "values": []interface{}{"20"}}},
+			expected: []string{"2"},
+		},
+		"all filters": {
+			filters: []interface{}{
+				map[string]interface{}{"name": "network_interface.subnet_no", "values": []interface{}{"10"}},
+				map[string]interface{}{"name": "cpu_count", "values": []interface{}{"4"}, "operator": "gt"},
+			},
+			expected: []string{"2"},
+		},
+	}
+
+	for name, tc := range cases {
+		filters := schema.NewSet(schema.HashResource(filterSchema), tc.filters)
+		res := testInstanceNos(ApplyFilters(filters, testFilterItems, testFilterSchema))
+		if !reflect.DeepEqual(res, tc.expected) {
+			t.Errorf("%s: expected %v but %v", name, tc.expected, res)
+		}
+	}
+}
+
+func TestQueryItems_sort(t *testing.T) {
+	identity := func(item map[string]interface{}) interface{} { return item }
+
+	res := testInstanceNos(QueryItems(testFilterItems, DataSourceQuery{SortBy: []string{"create_date"}}, identity))
+	if expected := []string{"1", "3", "2"}; !reflect.DeepEqual(res, expected) {
+		t.Errorf("expected %v but %v", expected, res)
+	}
+
+	res = testInstanceNos(QueryItems(testFilterItems, DataSourceQuery{SortBy: []string{"cpu_count"}, MostRecent: true}, identity))
+	if expected := []string{"2"}; !reflect.DeepEqual(res, expected) {
+		t.Errorf("expected %v but %v", expected, res)
+	}
+
+	query := DataSourceQuery{
+		Filters:    []DataSourceFilter{{Path: []string{"name"}, Values: []string{"web-"}, Operator: FilterOperatorPrefix}},
+		SortBy:     []string{"network_interface", "private_ip"},
+		MostRecent: true,
+	}
+	res = testInstanceNos(QueryItems(testFilterItems, query, identity))
+	if expected := []string{"2"}; !reflect.DeepEqual(res, expected) {
+		t.Errorf("expected %v but %v", expected, res)
+	}
+}
+
+type testFilterModel struct {
+	Name     types.String `tfsdk:"name"`
+	CpuCount types.Int64  `tfsdk:"cpu_count"`
+	Tags     types.List   `tfsdk:"tags"`
+}
+
+func TestQueryModels(t *testing.T) {
+	ctx := context.Background()
+
+	tags := func(values ...string) types.List {
+		var elems []attr.Value
+		for _, v := range values {
+			elems = append(elems, types.StringValue(v))
+		}
+		return types.ListValueMust(types.StringType, elems)
+	}
+	models := []*testFilterModel{
+		{Name: types.StringValue("SVR.VSVR.STAND.C002"), CpuCount: types.Int64Value(2), Tags: tags("a")},
+		{Name: types.StringValue("SVR.VSVR.HICPU.C004"), CpuCount: types.Int64Value(4), Tags: tags("a", "b")},
+		{Name: types.StringValue("SVR.VSVR.STAND.C008"), CpuCount: types.Int64Value(8), Tags: types.ListNull(types.StringType)},
+	}
+
+	filterType := types.ObjectType{AttrTypes: map[string]attr.Type{
+		"name":     types.StringType,
+		"values":   types.SetType{ElemType: types.StringType},
+		"regex":    types.BoolType,
+		"operator": types.StringType,
+	}}
+	filter := func(name, operator string, values ...string) attr.Value {
+		var elems []attr.Value
+		for _, v := range values {
+			elems = append(elems, types.StringValue(v))
+		}
+		return types.ObjectValueMust(filterType.AttrTypes, map[string]attr.Value{
+			"name":     types.StringValue(name),
+			"values":   types.SetValueMust(types.StringType, elems),
+			"regex":    types.BoolNull(),
+			"operator": types.StringValue(operator),
+		})
+	}
+
+	filters := types.SetValueMust(filterType, []attr.Value{
+		filter("name", "prefix", "SVR.VSVR.STAND"),
+		filter("cpu_count", "lt", "8"),
+	})
+	res := QueryModels(ctx, filters, types.StringNull(), types.BoolNull(), models)
+	if len(res) != 1 || res[0] != models[0] {
+		t.Errorf("expected the first model but %v", res)
+	}
+
+	filters = types.SetValueMust(filterType, []attr.Value{filter("tags", "equals", "b")})
+	res = QueryModels(ctx, filters, types.StringNull(), types.BoolNull(), models)
+	if len(res) != 1 || res[0] != models[1] {
+		t.Errorf("expected the second model but %v", res)
+	}
+
+	res = QueryModels(ctx, types.SetNull(filterType), types.StringValue("cpu_count"), types.BoolValue(true), models)
+	if len(res) != 1 || res[0] != models[2] {
+		t.Errorf("expected the last model but %v", res)
+	}
+
+	if res := FilterModels(ctx, types.SetNull(filterType), models); len(res) != len(models) {
+		t.Errorf("expected all the models without filter but %v", res)
+	}
+}
@@ -12,8 +12,9 @@ func MarshalUnchecked(value interface{}) []byte {
 	return v
 }
 
+// MarshalUncheckedString returns the JSON encoding of value for the logs, with the sensitive attributes masked
 func MarshalUncheckedString(value interface{}) string {
-	return string(MarshalUnchecked(value))
+	return string(MaskSensitiveValues(value))
 }
 
 func ReplaceNull(s string) string {
@@ -0,0 +1,246 @@
+package common
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"reflect"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// MaskedValue replaces the values of sensitive attributes in the logs
+	MaskedValue = "***"
+
+	// LogSubsystemEnvPrefix prefixes the environment variables setting the log level of a service,
+	// e.g. TF_LOG_PROVIDER_NCLOUD_MYSQL=DEBUG
+	LogSubsystemEnvPrefix = "TF_LOG_PROVIDER_NCLOUD"
+
+	LogFieldOperation = "ncp_operation"
+	LogFieldRequestId = "ncp_request_id"
+	LogFieldLatency   = "ncp_latency_ms"
+	LogFieldRequest   = "ncp_request"
+	LogFieldResponse  = "ncp_response"
+	LogFieldError     = "error"
+)
+
+// schema names of the sensitive attributes, by their key normalized by normalizeLogKey
+var sensitiveLogKeys sync.Map
+
+// requestStartKey is the context key of the requestStart of the request logged by LogCommonRequest
+type requestStartKey struct{}
+
+type requestStart struct {
+	operation string
+	time      time.Time
+}
+
+// RegisterSensitiveAttributes masks the values of the attributes in the logs, under their schema name
+// (user_password) as well as the name of the API parameters (userPassword)
+func RegisterSensitiveAttributes(names ...string) {
+	for _, name := range names {
+		sensitiveLogKeys.Store(normalizeLogKey(name), name)
+	}
+}
+
+// IsSensitiveAttribute tells the values of the attribute or API parameter are masked in the logs
+func IsSensitiveAttribute(name string) bool {
+	_, ok := sensitiveLogKeys.Load(normalizeLogKey(name))
+	return ok
+}
+
+func normalizeLogKey(name string) string {
+	return strings.ToLower(strings.ReplaceAll(name, "_", ""))
+}
+
+// MaskSensitiveValues returns the JSON encoding of value, with the values of the sensitive attributes masked
+func MaskSensitiveValues(value interface{}) []byte {
+	b, err := json.Marshal(value)
+	if err != nil {
+		return b
+	}
+
+	// numbers are kept as they are, instead of float64
+	d := json.NewDecoder(bytes.NewReader(b))
+	d.UseNumber()
+
+	var v interface{}
+	if err := d.Decode(&v); err != nil {
+		return b
+	}
+	if !maskSensitiveValues(v) {
+		return b
+	}
+
+	masked, err := json.Marshal(v)
+	if err != nil {
+		return b
+	}
+	return masked
+}
+
+// maskSensitiveValues masks v in place, and tells if any value was masked
+func maskSensitiveValues(v interface{}) bool {
+	masked := false
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			if IsSensitiveAttribute(key) && value != nil {
+				v[key] = MaskedValue
+				masked = true
+				continue
+			}
+			masked = maskSensitiveValues(value) || masked
+		}
+	case []interface{}:
+		for _, value := range v {
+			masked = maskSensitiveValues(value) || masked
+		}
+	}
+	return masked
+}
+
+// LogCommonRequest logs the parameters of the request to the API operation, in the subsystem of the calling service.
+// It returns the context to log the response of the request with, which carries the start of the request to log its
+// latency.
+func LogCommonRequest(ctx context.Context, operation string, args interface{}) context.Context {
+	logCtx, subsystem := logSubsystem(ctx)
+	tflog.SubsystemInfo(logCtx, subsystem, "NCP API request", map[string]interface{}{
+		LogFieldOperation: operation,
+		LogFieldRequest:   string(MaskSensitiveValues(args)),
+	})
+
+	return context.WithValue(ctx, requestStartKey{}, requestStart{operation, time.Now()})
+}
+
+// LogResponse logs the response of the API operation, with its request id and latency
+func LogResponse(ctx context.Context, operation string, args interface{}) {
+	fields := map[string]interface{}{
+		LogFieldOperation: operation,
+		LogFieldResponse:  string(MaskSensitiveValues(args)),
+	}
+	if requestId := responseRequestId(args); requestId != "" {
+		fields[LogFieldRequestId] = requestId
+	}
+	addLatency(ctx, operation, fields)
+
+	ctx, subsystem := logSubsystem(ctx)
+	tflog.SubsystemInfo(ctx, subsystem, "NCP API response", fields)
+}
+
+// LogErrorResponse logs the error of the API operation, with the parameters of its request
+func LogErrorResponse(ctx context.Context, operation string, err error, args interface{}) {
+	fields := map[string]interface{}{
+		LogFieldOperation: operation,
+		LogFieldRequest:   string(MaskSensitiveValues(args)),
+		LogFieldError:     err.Error(),
+	}
+	if e, ok := AsNcpError(err); ok && e.RequestId != "" {
+		fields[LogFieldRequestId] = e.RequestId
+	}
+	addLatency(ctx, operation, fields)
+
+	ctx, subsystem := logSubsystem(ctx)
+	tflog.SubsystemError(ctx, subsystem, "NCP API error", fields)
+}
+
+// LogCommonResponse logs the result of the API operation
+func LogCommonResponse(ctx context.Context, operation string, commonResponse *CommonResponse, logs ...string) {
+	fields := map[string]interface{}{
+		LogFieldOperation: operation,
+		LogFieldRequestId: ncloud.StringValue(commonResponse.RequestId),
+		"ncp_return_code": ncloud.StringValue(commonResponse.ReturnCode),
+		"ncp_return_msg":  ncloud.StringValue(commonResponse.ReturnMessage),
+	}
+	if len(logs) > 0 {
+		fields["detail"] = strings.Join(logs, " ")
+	}
+	addLatency(ctx, operation, fields)
+
+	ctx, subsystem := logSubsystem(ctx)
+	tflog.SubsystemInfo(ctx, subsystem, "NCP API response", fields)
+}
+
+// addLatency adds the latency of the request to the operation, when ctx is returned by its LogCommonRequest
+func addLatency(ctx context.Context, operation string, fields map[string]interface{}) {
+	if start, ok := ctx.Value(requestStartKey{}).(requestStart); ok && start.operation == operation {
+		fields[LogFieldLatency] = time.Since(start.time).Milliseconds()
+	}
+}
+
+// responseRequestId returns the RequestId the responses of the NCP APIs carry
+func responseRequestId(resp interface{}) string {
+	v := reflect.ValueOf(resp)
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return ""
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return ""
+	}
+
+	if f := v.FieldByName("RequestId"); ValidField(f) {
+		return ncloud.StringValue(StringField(f))
+	}
+	return ""
+}
+
+// logSubsystem returns the subsystem of the service calling the logging functions, named after its package.
+// The fields of the root logger, such as tf_resource_type and tf_req_id, are kept.
+func logSubsystem(ctx context.Context) (context.Context, string) {
+	subsystem := "ncloud"
+	if pc, _, _, ok := runtime.Caller(2); ok {
+		if fn := runtime.FuncForPC(pc); fn != nil {
+			subsystem = callerService(fn.Name(), subsystem)
+		}
+	}
+
+	ctx = tflog.NewSubsystem(ctx, subsystem,
+		tflog.WithRootFields(),
+		tflog.WithLevelFromEnv(LogSubsystemEnvPrefix, strings.ToUpper(subsystem)),
+	)
+	ctx = tflog.SubsystemMaskFieldValuesWithFieldKeys(ctx, subsystem, sensitiveFieldKeys()...)
+	return ctx, subsystem
+}
+
+// callerService returns the service package of the function, e.g. "mysql" for ".../internal/service/mysql.GetMysqlInstance"
+func callerService(funcName, defaultName string) string {
+	const servicePath = "/internal/service/"
+	i := strings.Index(funcName, servicePath)
+	if i < 0 {
+		return defaultName
+	}
+	service := funcName[i+len(servicePath):]
+	if j := strings.IndexAny(service, "./"); j >= 0 {
+		service = service[:j]
+	}
+	return service
+}
+
+// sensitiveFieldKeys returns the schema and API parameter names of the sensitive attributes
+func sensitiveFieldKeys() []string {
+	var keys []string
+	sensitiveLogKeys.Range(func(_, name interface{}) bool {
+		keys = append(keys, name.(string), lowerCamelCase(name.(string)))
+		return true
+	})
+	return keys
+}
+
+func lowerCamelCase(name string) string {
+	parts := strings.Split(name, "_")
+	for i := 1; i < len(parts); i++ {
+		if parts[i] != "" {
+			parts[i] = strings.ToUpper(parts[i][:1]) + parts[i][1:]
+		}
+	}
+	return strings.Join(parts, "")
+}
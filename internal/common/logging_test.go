@@ -0,0 +1,113 @@
+package common
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vnas"
+)
+
+func TestMaskSensitiveValues(t *testing.T) {
+	RegisterSensitiveAttributes("user_password", "cifs_user_password")
+
+	reqParams := &vnas.CreateNasVolumeInstanceRequest{
+		VolumeName:       ncloud.String("tf-nas"),
+		CifsUserName:     ncloud.String("admin"),
+		CifsUserPassword: ncloud.String("secret"),
+	}
+	masked := string(MaskSensitiveValues(reqParams))
+	if !strings.Contains(masked, `"cifsUserPassword":"***"`) || strings.Contains(masked, "secret") {
+		t.Errorf("expected the password masked but %s", masked)
+	}
+	if !strings.Contains(masked, `"cifsUserName":"admin"`) {
+		t.Errorf("expected the user name kept but %s", masked)
+	}
+
+	nested := map[string]interface{}{
+		"userList": []interface{}{
+			map[string]interface{}{"userName": "admin", "user_password": "secret"},
+		},
+	}
+	expected := `{"userList":[{"userName":"admin","user_password":"***"}]}`
+	if masked := string(MaskSensitiveValues(nested)); masked != expected {
+		t.Errorf("expected %s but %s", expected, masked)
+	}
+
+	if masked := MarshalUncheckedString(nested); masked != expected {
+		t.Errorf("expected %s but %s", expected, masked)
+	}
+}
+
+func TestSensitiveFieldKeys(t *testing.T) {
+	RegisterSensitiveAttributes("kdc_password")
+
+	keys := map[string]bool{}
+	for _, k := range sensitiveFieldKeys() {
+		keys[k] = true
+	}
+	for _, k := range []string{"kdc_password", "kdcPassword"} {
+		if !keys[k] {
+			t.Errorf("expected %s to be masked", k)
+		}
+	}
+}
+
+func TestCallerService(t *testing.T) {
+	cases := map[string]string{
+		"github.com/terraform-providers/terraform-provider-ncloud/internal/service/mysql.GetMysqlInstance":         "mysql",
+		"github.com/terraform-providers/terraform-provider-ncloud/internal/service/server.createVpcPublicIp.func1": "server",
+		"github.com/terraform-providers/terraform-provider-ncloud/internal/service/vpc.(*vpcResource).Create":      "vpc",
+		"github.com/terraform-providers/terraform-provider-ncloud/internal/zone.getZones":                          "ncloud",
+	}
+
+	for name, expected := range cases {
+		if service := callerService(name, "ncloud"); service != expected {
+			t.Errorf("expected %s for %s but %s", expected, name, service)
+		}
+	}
+}
+
+func TestResponseRequestId(t *testing.T) {
+	resp := &vnas.CreateNasVolumeInstanceResponse{RequestId: ncloud.String("a1b2")}
+	if id := responseRequestId(resp); id != "a1b2" {
+		t.Errorf("expected request id a1b2 but %q", id)
+	}
+
+	for _, resp := range []interface{}{nil, (*vnas.CreateNasVolumeInstanceResponse)(nil), "response"} {
+		if id := responseRequestId(resp); id != "" {
+			t.Errorf("expected no request id from %v but %q", resp, id)
+		}
+	}
+}
+
+func TestAddLatency(t *testing.T) {
+	ctx := context.Background()
+	first := LogCommonRequest(ctx, "GetMysqlUserList", nil)
+	second := LogCommonRequest(ctx, "GetMysqlUserList", nil)
+
+	// the requests sharing a context and an operation have their own start
+	for _, reqCtx := range []context.Context{first, second} {
+		fields := map[string]interface{}{}
+		addLatency(reqCtx, "GetMysqlUserList", fields)
+		if _, ok := fields[LogFieldLatency]; !ok {
+			t.Errorf("expected the latency logged but %v", fields)
+		}
+	}
+
+	cases := map[string]struct {
+		ctx       context.Context
+		operation string
+	}{
+		"without request": {ctx, "GetMysqlUserList"},
+		"other operation": {first, "DeleteMysqlUser"},
+	}
+	for name, tc := range cases {
+		fields := map[string]interface{}{}
+		addLatency(tc.ctx, tc.operation, fields)
+		if _, ok := fields[LogFieldLatency]; ok {
+			t.Errorf("%s: expected no latency but %v", name, fields)
+		}
+	}
+}
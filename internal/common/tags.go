@@ -0,0 +1,120 @@
+package common
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+)
+
+// TagsSchema returns the schema of resource tags which are merged with the provider default_tags
+func TagsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeMap,
+		Optional: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+	}
+}
+
+// TagsSchemaComputed returns the schema of all tags of a resource, including the provider default_tags
+func TagsSchemaComputed() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeMap,
+		Computed: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+	}
+}
+
+// ExpandTags converts a TypeMap value to tags
+func ExpandTags(m map[string]interface{}) map[string]string {
+	tags := make(map[string]string, len(m))
+	for k, v := range m {
+		tags[k] = v.(string)
+	}
+	return tags
+}
+
+// FlattenTags converts tags to a TypeMap value
+func FlattenTags(tags map[string]string) map[string]interface{} {
+	m := make(map[string]interface{}, len(tags))
+	for k, v := range tags {
+		m[k] = v
+	}
+	return m
+}
+
+// MergeTags returns the default tags overridden by the resource tags
+func MergeTags(defaultTags, tags map[string]string) map[string]string {
+	merged := make(map[string]string, len(defaultTags)+len(tags))
+	for k, v := range defaultTags {
+		merged[k] = v
+	}
+	for k, v := range tags {
+		merged[k] = v
+	}
+	return merged
+}
+
+// IgnoreDefaultTags returns tags without the default tags which are not configured on the resource itself
+func IgnoreDefaultTags(tagsAll, defaultTags, configured map[string]string) map[string]string {
+	tags := map[string]string{}
+	for k, v := range tagsAll {
+		if dv, ok := defaultTags[k]; ok && dv == v {
+			if _, ok := configured[k]; !ok {
+				continue
+			}
+		}
+		tags[k] = v
+	}
+	return tags
+}
+
+// DiffTags returns the tags to create and the keys to delete to move from oldTags to newTags.
+// Tags whose value changed are deleted and created again.
+func DiffTags(oldTags, newTags map[string]string) (map[string]string, []string) {
+	create := map[string]string{}
+	var remove []string
+
+	for k, v := range oldTags {
+		if nv, ok := newTags[k]; !ok || nv != v {
+			remove = append(remove, k)
+		}
+	}
+	for k, v := range newTags {
+		if ov, ok := oldTags[k]; !ok || ov != v {
+			create[k] = v
+		}
+	}
+
+	sort.Strings(remove)
+	return create, remove
+}
+
+// SetTagsDiff computes tags_all from the resource tags and the provider default_tags
+func SetTagsDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	config := meta.(*conn.ProviderConfig)
+
+	if plan := d.GetRawPlan(); !plan.IsNull() && !plan.GetAttr("tags").IsWhollyKnown() {
+		return d.SetNewComputed("tags_all")
+	}
+
+	tagsAll := MergeTags(config.DefaultTags, ExpandTags(d.Get("tags").(map[string]interface{})))
+	if !equalTags(ExpandTags(d.Get("tags_all").(map[string]interface{})), tagsAll) {
+		return d.SetNew("tags_all", FlattenTags(tagsAll))
+	}
+
+	return nil
+}
+
+func equalTags(a, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, v := range a {
+		if bv, ok := b[k]; !ok || bv != v {
+			return false
+		}
+	}
+	return true
+}
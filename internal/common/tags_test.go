@@ -0,0 +1,49 @@
+package common
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMergeTags(t *testing.T) {
+	defaultTags := map[string]string{"env": "dev", "team": "infra"}
+	tags := map[string]string{"env": "prod", "name": "web"}
+
+	merged := MergeTags(defaultTags, tags)
+	expected := map[string]string{"env": "prod", "team": "infra", "name": "web"}
+	if !reflect.DeepEqual(merged, expected) {
+		t.Fatalf("Got:\n\n%#v\n\nExpected:\n\n%#v\n", merged, expected)
+	}
+
+	if defaultTags["env"] != "dev" {
+		t.Fatalf("default tags must not be modified")
+	}
+}
+
+func TestIgnoreDefaultTags(t *testing.T) {
+	tagsAll := map[string]string{"env": "prod", "team": "infra", "owner": "ops"}
+	defaultTags := map[string]string{"env": "prod", "team": "infra"}
+
+	tags := IgnoreDefaultTags(tagsAll, defaultTags, map[string]string{"env": "prod"})
+	expected := map[string]string{"env": "prod", "owner": "ops"}
+	if !reflect.DeepEqual(tags, expected) {
+		t.Fatalf("Got:\n\n%#v\n\nExpected:\n\n%#v\n", tags, expected)
+	}
+}
+
+func TestDiffTags(t *testing.T) {
+	oldTags := map[string]string{"env": "dev", "team": "infra", "name": "web"}
+	newTags := map[string]string{"env": "prod", "name": "web", "owner": "ops"}
+
+	create, remove := DiffTags(oldTags, newTags)
+
+	expectedCreate := map[string]string{"env": "prod", "owner": "ops"}
+	if !reflect.DeepEqual(create, expectedCreate) {
+		t.Fatalf("Got:\n\n%#v\n\nExpected:\n\n%#v\n", create, expectedCreate)
+	}
+
+	expectedRemove := []string{"env", "team"}
+	if !reflect.DeepEqual(remove, expectedRemove) {
+		t.Fatalf("Got:\n\n%#v\n\nExpected:\n\n%#v\n", remove, expectedRemove)
+	}
+}
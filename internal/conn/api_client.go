@@ -4,28 +4,25 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net/http"
 	"strings"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/config"
-	"github.com/aws/aws-sdk-go-v2/credentials"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 )
 
-func NewS3Client(region string, api *ncloud.APIKey, site, endpointFromEnv string) *s3.Client {
+func NewS3Client(region string, credentialsProvider aws.CredentialsProvider, httpClient *http.Client, site, endpointFromEnv string) *s3.Client {
 	var endpoint string
 	if endpointFromEnv != "" {
 		endpoint = endpointFromEnv
 	} else {
-		endpoint = genEndpointWithCode(region, site)
-	}
-
-	if api.AccessKey == "" || api.SecretKey == "" {
-		log.Fatal("AccessKey and SecretKey must not be empty")
+		endpoint = GenEndpointWithCode(region, site)
 	}
 
 	cfg, err := config.LoadDefaultConfig(context.TODO(),
-		config.WithCredentialsProvider(credentials.NewStaticCredentialsProvider(api.AccessKey, api.SecretKey, "")),
+		config.WithCredentialsProvider(credentialsProvider),
 		config.WithRegion(region),
 	)
 
@@ -35,14 +32,18 @@ func NewS3Client(region string, api *ncloud.APIKey, site, endpointFromEnv string
 
 	newClient := s3.NewFromConfig(cfg, func(o *s3.Options) {
 		o.BaseEndpoint = ncloud.String(endpoint)
+		// retries are handled by the shared transport of httpClient
+		o.HTTPClient = httpClient
+		o.Retryer = aws.NopRetryer{}
 	})
 
 	return newClient
 }
 
+// GenEndpointWithCode returns the object storage endpoint of the region and site
 // API docs: https://api.ncloud-docs.com/docs/platform-region-getregionlist
 // Common object storage docs; https://api.ncloud-docs.com/docs/storage-objectstorage
-func genEndpointWithCode(region, site string) string {
+func GenEndpointWithCode(region, site string) string {
 	var s3Endpoint string
 	switch site {
 	case "gov":
@@ -0,0 +1,196 @@
+package conn
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/hmac"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud/credentials"
+	"github.com/aws/aws-sdk-go-v2/aws"
+)
+
+const (
+	DefaultAssumeRoleDuration    = 1 * time.Hour
+	DefaultAssumeRoleSessionName = "terraform-provider-ncloud"
+
+	// assumeRoleExpiryWindow is how long before expiry temporary credentials are refreshed
+	assumeRoleExpiryWindow = 5 * time.Minute
+)
+
+// stsEndpointBySite is the token endpoint used when AssumeRole.Endpoint is not set
+var stsEndpointBySite = map[string]string{
+	"":       "https://sts.apigw.ntruss.com/api/v1/credentials",
+	"public": "https://sts.apigw.ntruss.com/api/v1/credentials",
+	"gov":    "https://sts.apigw.gov-ntruss.com/api/v1/credentials",
+	"fin":    "https://sts.apigw.fin-ntruss.com/api/v1/credentials",
+}
+
+// AssumeRole describes a sub account role whose temporary credentials are used instead of the base keys
+type AssumeRole struct {
+	RoleNrn     string
+	SessionName string
+	Duration    time.Duration
+	Endpoint    string
+}
+
+type assumeRoleRequest struct {
+	RoleNrn     string `json:"roleNrn"`
+	SessionName string `json:"sessionName"`
+	DurationSec int64  `json:"durationSec"`
+}
+
+type assumeRoleResponse struct {
+	AccessKey  string    `json:"accessKey"`
+	SecretKey  string    `json:"secretKey"`
+	ExpireTime time.Time `json:"expireTime"`
+}
+
+// AssumeRoleProvider exchanges base credentials for temporary ones and refreshes them before they expire.
+// It is shared by every API client of a provider instance.
+type AssumeRoleProvider struct {
+	role       AssumeRole
+	accessKey  string
+	secretKey  string
+	httpClient *http.Client
+
+	mu         sync.Mutex
+	value      credentials.Value
+	expiration time.Time
+}
+
+// NewAssumeRoleProvider returns the provider of role, requesting the token endpoint with httpClient,
+// the client of the shared transport, or http.DefaultClient when nil.
+func NewAssumeRoleProvider(role AssumeRole, accessKey, secretKey, site string, httpClient *http.Client) *AssumeRoleProvider {
+	if role.SessionName == "" {
+		role.SessionName = DefaultAssumeRoleSessionName
+	}
+	if role.Duration == 0 {
+		role.Duration = DefaultAssumeRoleDuration
+	}
+	if role.Endpoint == "" {
+		role.Endpoint = stsEndpointBySite[site]
+	}
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	return &AssumeRoleProvider{
+		role:       role,
+		accessKey:  accessKey,
+		secretKey:  secretKey,
+		httpClient: httpClient,
+	}
+}
+
+func (p *AssumeRoleProvider) Name() string {
+	return "AssumeRoleProvider"
+}
+
+// Retrieve implements credentials.Provider of ncloud-sdk-go-v2.
+// The returned expiration is moved forward by the expiry window so the SDK asks again before the keys go stale.
+func (p *AssumeRoleProvider) Retrieve() (credentials.Value, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if time.Now().Before(p.expiration.Add(-assumeRoleExpiryWindow)) {
+		return p.value, nil
+	}
+
+	resp, err := p.assumeRole(context.Background())
+	if err != nil {
+		return credentials.Value{}, err
+	}
+
+	p.expiration = resp.ExpireTime
+	p.value = credentials.Value{
+		AccessKey:  resp.AccessKey,
+		SecretKey:  resp.SecretKey,
+		Expiration: resp.ExpireTime.Add(-assumeRoleExpiryWindow),
+	}
+
+	return p.value, nil
+}
+
+// Credentials returns SDK credentials backed by the provider, for swagger generated clients
+func (p *AssumeRoleProvider) Credentials() *credentials.Credentials {
+	return credentials.LoadCredentials([]credentials.Provider{p})
+}
+
+// AWSCredentialsProvider returns the provider adapted for the Object Storage client
+func (p *AssumeRoleProvider) AWSCredentialsProvider() aws.CredentialsProvider {
+	return aws.NewCredentialsCache(aws.CredentialsProviderFunc(func(ctx context.Context) (aws.Credentials, error) {
+		value, err := p.Retrieve()
+		if err != nil {
+			return aws.Credentials{}, err
+		}
+
+		return aws.Credentials{
+			AccessKeyID:     value.AccessKey,
+			SecretAccessKey: value.SecretKey,
+			Source:          p.Name(),
+			CanExpire:       true,
+			Expires:         value.Expiration,
+		}, nil
+	}))
+}
+
+func (p *AssumeRoleProvider) assumeRole(ctx context.Context) (*assumeRoleResponse, error) {
+	body, err := json.Marshal(&assumeRoleRequest{
+		RoleNrn:     p.role.RoleNrn,
+		SessionName: p.role.SessionName,
+		DurationSec: int64(p.role.Duration / time.Second),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.role.Endpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	timestamp := strconv.FormatInt(time.Now().UnixNano()/int64(time.Millisecond), 10)
+	signature, err := hmac.NewSigner(p.secretKey, crypto.SHA256).Sign(http.MethodPost, p.role.Endpoint, p.accessKey, timestamp)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("x-ncp-apigw-timestamp", timestamp)
+	req.Header.Set("x-ncp-iam-access-key", p.accessKey)
+	req.Header.Set("x-ncp-apigw-signature-v2", signature)
+
+	httpResp, err := p.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("assuming role `%s`: %w", p.role.RoleNrn, err)
+	}
+	defer httpResp.Body.Close()
+
+	respBody, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("assuming role `%s`: %w", p.role.RoleNrn, err)
+	}
+
+	if httpResp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("assuming role `%s`: %s: %s", p.role.RoleNrn, httpResp.Status, respBody)
+	}
+
+	resp := &assumeRoleResponse{}
+	if err := json.Unmarshal(respBody, resp); err != nil {
+		return nil, fmt.Errorf("assuming role `%s`: decoding response: %w", p.role.RoleNrn, err)
+	}
+
+	if resp.AccessKey == "" || resp.SecretKey == "" {
+		return nil, fmt.Errorf("assuming role `%s`: response has no credentials", p.role.RoleNrn)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,137 @@
+package conn
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+type testTokenServer struct {
+	*httptest.Server
+	calls    int32
+	lifetime time.Duration
+	lastReq  assumeRoleRequest
+}
+
+func newTestTokenServer(t *testing.T, lifetime time.Duration) *testTokenServer {
+	s := &testTokenServer{lifetime: lifetime}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("x-ncp-iam-access-key") != "base-access" || r.Header.Get("x-ncp-apigw-signature-v2") == "" {
+			w.WriteHeader(http.StatusUnauthorized)
+			fmt.Fprint(w, `{"error":{"errorCode":"200","message":"Authentication Failed"}}`)
+			return
+		}
+
+		if err := json.NewDecoder(r.Body).Decode(&s.lastReq); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		n := atomic.AddInt32(&s.calls, 1)
+		json.NewEncoder(w).Encode(&assumeRoleResponse{
+			AccessKey:  fmt.Sprintf("temp-access-%d", n),
+			SecretKey:  fmt.Sprintf("temp-secret-%d", n),
+			ExpireTime: time.Now().Add(s.lifetime),
+		})
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func TestAssumeRoleProviderRetrieve(t *testing.T) {
+	server := newTestTokenServer(t, time.Hour)
+
+	p := NewAssumeRoleProvider(AssumeRole{RoleNrn: "nrn:PUB:IAM::1:Role/test", Endpoint: server.URL}, "base-access", "base-secret", "", nil)
+
+	for i := 0; i < 3; i++ {
+		value, err := p.Retrieve()
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if value.AccessKey != "temp-access-1" {
+			t.Fatalf("expected cached temporary credentials, got %s", value.AccessKey)
+		}
+	}
+
+	if calls := atomic.LoadInt32(&server.calls); calls != 1 {
+		t.Fatalf("expected 1 token request, got %d", calls)
+	}
+	if server.lastReq.SessionName != DefaultAssumeRoleSessionName || server.lastReq.DurationSec != 3600 {
+		t.Fatalf("unexpected token request: %#v", server.lastReq)
+	}
+}
+
+func TestAssumeRoleProviderRefreshBeforeExpiry(t *testing.T) {
+	server := newTestTokenServer(t, assumeRoleExpiryWindow-time.Second)
+
+	p := NewAssumeRoleProvider(AssumeRole{RoleNrn: "nrn:PUB:IAM::1:Role/test", Endpoint: server.URL, Duration: 15 * time.Minute}, "base-access", "base-secret", "", nil)
+
+	creds := p.Credentials()
+	if creds.AccessKey() != "temp-access-1" {
+		t.Fatalf("expected first temporary credentials, got %s", creds.AccessKey())
+	}
+
+	// credentials within the expiry window are exchanged again on next use
+	if creds.Retrieve().AccessKey() != "temp-access-2" {
+		t.Fatalf("expected refreshed temporary credentials, got %s", creds.AccessKey())
+	}
+
+	awsCreds, err := p.AWSCredentialsProvider().Retrieve(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if awsCreds.AccessKeyID != "temp-access-3" || !awsCreds.CanExpire {
+		t.Fatalf("unexpected object storage credentials: %#v", awsCreds)
+	}
+	if server.lastReq.DurationSec != 900 {
+		t.Fatalf("expected duration of 900 seconds, got %d", server.lastReq.DurationSec)
+	}
+}
+
+func TestAssumeRoleProviderError(t *testing.T) {
+	server := newTestTokenServer(t, time.Hour)
+
+	p := NewAssumeRoleProvider(AssumeRole{RoleNrn: "nrn:PUB:IAM::1:Role/test", Endpoint: server.URL}, "wrong-access", "base-secret", "", nil)
+	if _, err := p.Retrieve(); err == nil {
+		t.Fatalf("expected error for rejected base credentials")
+	}
+
+	config := &Config{AccessKey: "wrong-access", SecretKey: "base-secret", Region: "KR", AssumeRole: &AssumeRole{RoleNrn: "nrn:PUB:IAM::1:Role/test", Endpoint: server.URL}}
+	if _, err := config.Client(); err == nil {
+		t.Fatalf("expected client creation to fail when the role cannot be assumed")
+	}
+}
+
+type countingTransport struct {
+	requests int32
+}
+
+func (c *countingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	atomic.AddInt32(&c.requests, 1)
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestConfigClientAssumeRoleTransport(t *testing.T) {
+	server := newTestTokenServer(t, time.Hour)
+	transport := &countingTransport{}
+
+	config := &Config{
+		Region:     "KR",
+		AccessKey:  "base-access",
+		SecretKey:  "base-secret",
+		Transport:  transport,
+		AssumeRole: &AssumeRole{RoleNrn: "nrn:PUB:IAM::1:Role/test", Endpoint: server.URL},
+	}
+	if _, err := config.Client(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if requests := atomic.LoadInt32(&transport.requests); requests != 1 {
+		t.Fatalf("expected the token request through the shared transport, got %d requests", requests)
+	}
+}
@@ -0,0 +1,101 @@
+package conn
+
+import (
+	"encoding/json"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+)
+
+// DefaultCatalogTTL is how long the results of CachedLookup are kept, unless ProviderConfig.CatalogTTL is set
+const DefaultCatalogTTL = 10 * time.Minute
+
+// catalogNow is the clock of the catalog, replaced by the tests
+var catalogNow = time.Now
+
+// catalog caches the lookups of the catalog of the platform, such as the products, images, zones and regions, which
+// barely change during a plan or an apply. Concurrent lookups of the same key share a single API call.
+type catalog struct {
+	mu      sync.Mutex
+	entries map[string]catalogEntry
+	group   singleflight.Group
+}
+
+type catalogEntry struct {
+	value   interface{}
+	expires time.Time
+}
+
+func (c *catalog) load(key string) (interface{}, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	if catalogNow().After(entry.expires) {
+		delete(c.entries, key)
+		return nil, false
+	}
+	return entry.value, true
+}
+
+func (c *catalog) store(key string, value interface{}, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.entries == nil {
+		c.entries = make(map[string]catalogEntry)
+	}
+	c.entries[key] = catalogEntry{value: value, expires: catalogNow().Add(ttl)}
+}
+
+// CatalogKey returns the key of CachedLookup for the API operation called with params
+func CatalogKey(operation string, params interface{}) string {
+	b, err := json.Marshal(params)
+	if err != nil {
+		return operation
+	}
+	return operation + " " + string(b)
+}
+
+// CachedLookup returns the result of lookup stored under key for the provider instance, and calls lookup only when
+// the result is missing or older than the TTL of the catalog. Concurrent calls of the same key wait for a single
+// lookup, and failed lookups are not stored so that they are tried again.
+func CachedLookup[T any](config *ProviderConfig, key string, lookup func() (T, error)) (T, error) {
+	c := &config.catalog
+	if v, ok := c.load(key); ok {
+		if t, ok := v.(T); ok {
+			return t, nil
+		}
+	}
+
+	v, err, _ := c.group.Do(key, func() (interface{}, error) {
+		v, err := lookup()
+		if err != nil {
+			return nil, err
+		}
+		c.store(key, v, config.catalogTTL())
+		return v, nil
+	})
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+
+	// the key is shared with a lookup of another type
+	t, ok := v.(T)
+	if !ok {
+		return lookup()
+	}
+	return t, nil
+}
+
+func (c *ProviderConfig) catalogTTL() time.Duration {
+	if c.CatalogTTL > 0 {
+		return c.CatalogTTL
+	}
+	return DefaultCatalogTTL
+}
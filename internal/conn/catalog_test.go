@@ -0,0 +1,125 @@
+package conn
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
+)
+
+func TestCachedLookup(t *testing.T) {
+	config := &ProviderConfig{}
+
+	calls := 0
+	lookup := func() ([]string, error) {
+		calls++
+		return []string{"SVR.VSVR.STAND.C002.M008.NET.SSD.B050.G002"}, nil
+	}
+
+	for i := 0; i < 3; i++ {
+		codes, err := CachedLookup(config, "server-products", lookup)
+		if err != nil || len(codes) != 1 {
+			t.Fatalf("unexpected lookup result %v, %v", codes, err)
+		}
+	}
+	if calls != 1 {
+		t.Errorf("expected the lookup called once but %d", calls)
+	}
+
+	if _, err := CachedLookup(&ProviderConfig{}, "server-products", lookup); err != nil || calls != 2 {
+		t.Errorf("expected the cache per provider instance but %d calls, %v", calls, err)
+	}
+}
+
+func TestCachedLookupError(t *testing.T) {
+	config := &ProviderConfig{}
+
+	calls := 0
+	lookup := func() ([]string, error) {
+		calls++
+		if calls == 1 {
+			return nil, errors.New("temporary failure")
+		}
+		return []string{"KR-1"}, nil
+	}
+
+	if _, err := CachedLookup(config, "zones", lookup); err == nil {
+		t.Fatal("expected the error of the lookup")
+	}
+	if codes, err := CachedLookup(config, "zones", lookup); err != nil || len(codes) != 1 {
+		t.Errorf("expected the failed lookup tried again but %v, %v", codes, err)
+	}
+}
+
+func TestCachedLookupTTL(t *testing.T) {
+	now := time.Now()
+	catalogNow = func() time.Time { return now }
+	defer func() { catalogNow = time.Now }()
+
+	config := &ProviderConfig{CatalogTTL: time.Minute}
+
+	calls := 0
+	lookup := func() (int, error) {
+		calls++
+		return calls, nil
+	}
+
+	CachedLookup(config, "regions", lookup)
+	now = now.Add(59 * time.Second)
+	if v, _ := CachedLookup(config, "regions", lookup); v != 1 {
+		t.Errorf("expected the cached result before the TTL but %d", v)
+	}
+
+	now = now.Add(2 * time.Second)
+	if v, _ := CachedLookup(config, "regions", lookup); v != 2 {
+		t.Errorf("expected a new lookup after the TTL but %d", v)
+	}
+}
+
+func TestCachedLookupConcurrent(t *testing.T) {
+	config := &ProviderConfig{}
+
+	var calls int32
+	release := make(chan struct{})
+	lookup := func() ([]string, error) {
+		atomic.AddInt32(&calls, 1)
+		<-release
+		return []string{"SVR.VSVR.STAND.C002.M008.NET.SSD.B050.G002"}, nil
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 200; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if codes, err := CachedLookup(config, "server-products", lookup); err != nil || len(codes) != 1 {
+				t.Errorf("unexpected lookup result %v, %v", codes, err)
+			}
+		}()
+	}
+
+	// let the goroutines join the first lookup
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	if calls != 1 {
+		t.Errorf("expected a single lookup for concurrent calls but %d", calls)
+	}
+}
+
+func TestCatalogKey(t *testing.T) {
+	a := CatalogKey("vserver.GetServerProductList", &vserver.GetServerProductListRequest{RegionCode: ncloud.String("KR"), ZoneCode: ncloud.String("KR-1")})
+	b := CatalogKey("vserver.GetServerProductList", &vserver.GetServerProductListRequest{RegionCode: ncloud.String("KR"), ZoneCode: ncloud.String("KR-2")})
+	if a == b {
+		t.Errorf("expected the keys of different parameters to differ but %s", a)
+	}
+
+	if c := CatalogKey("vserver.GetServerProductList", &vserver.GetServerProductListRequest{RegionCode: ncloud.String("KR"), ZoneCode: ncloud.String("KR-1")}); a != c {
+		t.Errorf("expected the keys of the same parameters to be equal but %s and %s", a, c)
+	}
+}
@@ -2,9 +2,15 @@ package conn
 
 import (
 	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vhadoop"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vautoscaling"
@@ -35,9 +41,6 @@ import (
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vsourcepipeline"
 )
 
-// DefaultWaitForInterval is Interval for checking status in WaitForXXX method
-const DefaultWaitForInterval = 10
-
 // Default timeout
 const DefaultTimeout = 5 * time.Minute
 const DefaultCreateTimeout = 1 * time.Hour
@@ -50,6 +53,71 @@ type Config struct {
 	AccessKey string
 	SecretKey string
 	Region    string
+	Site      string
+
+	// Endpoints overrides the base path of each service client, keyed by EndpointServices
+	Endpoints map[string]string
+
+	// MaxRetries, RateLimit and RetryableReturnCodes tune the transport shared by every service client
+	MaxRetries           int
+	RateLimit            float64
+	RetryableReturnCodes []string
+
+	// Transport is the transport under the retries of every service client, http.DefaultTransport by default
+	Transport http.RoundTripper
+
+	// AssumeRole, when set, replaces the keys above with temporary credentials of the role
+	AssumeRole *AssumeRole
+
+	assumeRoleProvider *AssumeRoleProvider
+	httpClient         *http.Client
+}
+
+// EndpointServices is the list of services whose endpoint can be overridden
+var EndpointServices = []string{
+	"server",
+	"autoscaling",
+	"loadbalancer",
+	"cdn",
+	"clouddb",
+	"vpc",
+	"vserver",
+	"vnas",
+	"vautoscaling",
+	"vloadbalancer",
+	"vnks",
+	"vpostgresql",
+	"sourcecommit",
+	"sourcebuild",
+	"sourcepipeline",
+	"vsourcepipeline",
+	"vsourcedeploy",
+	"vses",
+	"vcdss",
+	"vmysql",
+	"vmongodb",
+	"vmssql",
+	"vhadoop",
+	"vredis",
+	"objectstorage",
+}
+
+// apiGatewayBySite is the API gateway of each non-public site
+var apiGatewayBySite = map[string]string{
+	"gov": "https://ncloud.apigw.gov-ntruss.com",
+	"fin": "https://fin-ncloud.apigw.fin-ntruss.com",
+}
+
+// serviceHosts is the host prefix of services served from their own domain instead of `ncloud`, for public/gov and fin sites
+var serviceHosts = map[string][2]string{
+	"vnks":            {"nks", "nks"},
+	"sourcecommit":    {"sourcecommit", "sourcecommit"},
+	"sourcebuild":     {"sourcebuild", "sourcebuild"},
+	"sourcepipeline":  {"sourcepipeline", "sourcepipeline"},
+	"vsourcedeploy":   {"vpcsourcedeploy", "vpcsourcedeploy"},
+	"vsourcepipeline": {"vpcsourcepipeline", "vpcsourcepipeline"},
+	"vses":            {"vpcsearchengine", "fin-vpcsearchengine"},
+	"vcdss":           {"clouddatastreamingservice", "fin-clouddatastreamingservice"},
 }
 
 type NcloudAPIClient struct {
@@ -80,45 +148,107 @@ type NcloudAPIClient struct {
 	ObjectStorage   *s3.Client
 }
 
-func (c *Config) Client(site, endpoint string) (*NcloudAPIClient, error) {
+func (c *Config) Client() (*NcloudAPIClient, error) {
+	if c.AccessKey == "" || c.SecretKey == "" {
+		return nil, fmt.Errorf("AccessKey and SecretKey must not be empty")
+	}
+
 	apiKey := &ncloud.APIKey{
 		AccessKey: c.AccessKey,
 		SecretKey: c.SecretKey,
 	}
+	c.httpClient = &http.Client{
+		Transport: NewRetryTransport(c.Transport, c.MaxRetries, c.RateLimit, c.RetryableReturnCodes),
+	}
+
+	var s3Credentials aws.CredentialsProvider = credentials.NewStaticCredentialsProvider(c.AccessKey, c.SecretKey, "")
+
+	if c.AssumeRole != nil {
+		c.assumeRoleProvider = NewAssumeRoleProvider(*c.AssumeRole, c.AccessKey, c.SecretKey, c.Site, c.httpClient)
+		if _, err := c.assumeRoleProvider.Retrieve(); err != nil {
+			return nil, err
+		}
+		s3Credentials = c.assumeRoleProvider.AWSCredentialsProvider()
+	}
 
 	return &NcloudAPIClient{
-		Server:          server.NewAPIClient(server.NewConfiguration(apiKey)),
-		Autoscaling:     autoscaling.NewAPIClient(autoscaling.NewConfiguration(apiKey)),
-		Loadbalancer:    loadbalancer.NewAPIClient(loadbalancer.NewConfiguration(apiKey)),
-		Cdn:             cdn.NewAPIClient(cdn.NewConfiguration(apiKey)),
-		Clouddb:         clouddb.NewAPIClient(clouddb.NewConfiguration(apiKey)),
-		Vpc:             vpc.NewAPIClient(vpc.NewConfiguration(apiKey)),
-		Vserver:         vserver.NewAPIClient(vserver.NewConfiguration(apiKey)),
-		Vnas:            vnas.NewAPIClient(vnas.NewConfiguration(apiKey)),
-		Vautoscaling:    vautoscaling.NewAPIClient(vautoscaling.NewConfiguration(apiKey)),
-		Vloadbalancer:   vloadbalancer.NewAPIClient(vloadbalancer.NewConfiguration(apiKey)),
-		Vnks:            vnks.NewAPIClient(vnks.NewConfigurationWithUserAgent(c.Region, fmt.Sprintf("Ncloud Terraform Provider/%s", version), apiKey)),
-		Sourcecommit:    sourcecommit.NewAPIClient(sourcecommit.NewConfiguration(c.Region, apiKey)),
-		Sourcebuild:     sourcebuild.NewAPIClient((sourcebuild.NewConfiguration(c.Region, apiKey))),
-		Sourcepipeline:  sourcepipeline.NewAPIClient(sourcepipeline.NewConfiguration(c.Region, apiKey)),
-		Vsourcedeploy:   vsourcedeploy.NewAPIClient(vsourcedeploy.NewConfiguration(c.Region, apiKey)),
-		Vsourcepipeline: vsourcepipeline.NewAPIClient(vsourcepipeline.NewConfiguration(c.Region, apiKey)),
-		Vses:            vses2.NewAPIClient(vses2.NewConfiguration(c.Region, apiKey)),
-		Vcdss:           vcdss.NewAPIClient(vcdss.NewConfiguration(c.Region, apiKey)),
-		Vmysql:          vmysql.NewAPIClient(vmysql.NewConfiguration(apiKey)),
-		Vmongodb:        vmongodb.NewAPIClient(vmongodb.NewConfiguration(apiKey)),
-		Vmssql:          vmssql.NewAPIClient(vmssql.NewConfiguration(apiKey)),
-		Vpostgresql:     vpostgresql.NewAPIClient(vpostgresql.NewConfiguration(apiKey)),
-		Vhadoop:         vhadoop.NewAPIClient(vhadoop.NewConfiguration(apiKey)),
-		Vredis:          vredis.NewAPIClient(vredis.NewConfiguration(apiKey)),
-		ObjectStorage:   NewS3Client(c.Region, apiKey, site, endpoint),
+		Server:          server.NewAPIClient(c.configure("server", server.NewConfiguration(apiKey))),
+		Autoscaling:     autoscaling.NewAPIClient(c.configure("autoscaling", autoscaling.NewConfiguration(apiKey))),
+		Loadbalancer:    loadbalancer.NewAPIClient(c.configure("loadbalancer", loadbalancer.NewConfiguration(apiKey))),
+		Cdn:             cdn.NewAPIClient(c.configure("cdn", cdn.NewConfiguration(apiKey))),
+		Clouddb:         clouddb.NewAPIClient(c.configure("clouddb", clouddb.NewConfiguration(apiKey))),
+		Vpc:             vpc.NewAPIClient(c.configure("vpc", vpc.NewConfiguration(apiKey))),
+		Vserver:         vserver.NewAPIClient(c.configure("vserver", vserver.NewConfiguration(apiKey))),
+		Vnas:            vnas.NewAPIClient(c.configure("vnas", vnas.NewConfiguration(apiKey))),
+		Vautoscaling:    vautoscaling.NewAPIClient(c.configure("vautoscaling", vautoscaling.NewConfiguration(apiKey))),
+		Vloadbalancer:   vloadbalancer.NewAPIClient(c.configure("vloadbalancer", vloadbalancer.NewConfiguration(apiKey))),
+		Vnks:            vnks.NewAPIClient(c.configure("vnks", vnks.NewConfigurationWithUserAgent(c.Region, fmt.Sprintf("Ncloud Terraform Provider/%s", version), apiKey))),
+		Sourcecommit:    sourcecommit.NewAPIClient(c.configure("sourcecommit", sourcecommit.NewConfiguration(c.Region, apiKey))),
+		Sourcebuild:     sourcebuild.NewAPIClient(c.configure("sourcebuild", sourcebuild.NewConfiguration(c.Region, apiKey))),
+		Sourcepipeline:  sourcepipeline.NewAPIClient(c.configure("sourcepipeline", sourcepipeline.NewConfiguration(c.Region, apiKey))),
+		Vsourcedeploy:   vsourcedeploy.NewAPIClient(c.configure("vsourcedeploy", vsourcedeploy.NewConfiguration(c.Region, apiKey))),
+		Vsourcepipeline: vsourcepipeline.NewAPIClient(c.configure("vsourcepipeline", vsourcepipeline.NewConfiguration(c.Region, apiKey))),
+		Vses:            vses2.NewAPIClient(c.configure("vses", vses2.NewConfiguration(c.Region, apiKey))),
+		Vcdss:           vcdss.NewAPIClient(c.configure("vcdss", vcdss.NewConfiguration(c.Region, apiKey))),
+		Vmysql:          vmysql.NewAPIClient(c.configure("vmysql", vmysql.NewConfiguration(apiKey))),
+		Vmongodb:        vmongodb.NewAPIClient(c.configure("vmongodb", vmongodb.NewConfiguration(apiKey))),
+		Vmssql:          vmssql.NewAPIClient(c.configure("vmssql", vmssql.NewConfiguration(apiKey))),
+		Vpostgresql:     vpostgresql.NewAPIClient(c.configure("vpostgresql", vpostgresql.NewConfiguration(apiKey))),
+		Vhadoop:         vhadoop.NewAPIClient(c.configure("vhadoop", vhadoop.NewConfiguration(apiKey))),
+		Vredis:          vredis.NewAPIClient(c.configure("vredis", vredis.NewConfiguration(apiKey))),
+		ObjectStorage:   NewS3Client(c.Region, s3Credentials, c.httpClient, c.Site, c.Endpoints["objectstorage"]),
 	}, nil
 }
 
+// configure points the client configuration at the shared transport, the endpoint of the service and the assumed role credentials, if any.
+// Without an endpoint override, the API gateway of the configured site is used.
+func (c *Config) configure(service string, cfg *ncloud.Configuration) *ncloud.Configuration {
+	cfg.HTTPClient = c.httpClient
+
+	if c.assumeRoleProvider != nil {
+		// NewAPIClient re-initializes credentials from APIKey when it is set
+		cfg.APIKey = nil
+		cfg.Credentials = c.assumeRoleProvider.Credentials()
+	}
+
+	if endpoint := c.Endpoints[service]; endpoint != "" {
+		cfg.BasePath = strings.TrimSuffix(endpoint, "/")
+		return cfg
+	}
+
+	gateway, ok := apiGatewayBySite[c.Site]
+	if !ok {
+		return cfg
+	}
+
+	basePath, err := url.Parse(cfg.BasePath)
+	if err != nil {
+		return cfg
+	}
+
+	if hosts, ok := serviceHosts[service]; ok {
+		gateway = strings.Replace(gateway, "https://fin-ncloud.", fmt.Sprintf("https://%s.", hosts[1]), 1)
+		gateway = strings.Replace(gateway, "https://ncloud.", fmt.Sprintf("https://%s.", hosts[0]), 1)
+	}
+
+	cfg.BasePath = gateway + basePath.Path
+	return cfg
+}
+
 type ProviderConfig struct {
 	Site       string
 	SupportVPC bool
 	RegionCode string
 	RegionNo   string
 	Client     *NcloudAPIClient
+
+	// DefaultTags are added to the tags of every resource supporting tags
+	DefaultTags map[string]string
+
+	// CatalogTTL is how long the catalog lookups are cached, DefaultCatalogTTL by default
+	CatalogTTL time.Duration
+
+	regionCacheByCode sync.Map
+
+	catalog catalog
 }
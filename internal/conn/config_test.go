@@ -0,0 +1,93 @@
+package conn
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vmysql"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vnks"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
+)
+
+func TestConfigConfigureGateway(t *testing.T) {
+	apiKey := &ncloud.APIKey{AccessKey: "access", SecretKey: "secret"}
+
+	cases := []struct {
+		site     string
+		service  string
+		cfg      *ncloud.Configuration
+		expected string
+	}{
+		{"", "vserver", vserver.NewConfiguration(apiKey), "https://ncloud.apigw.ntruss.com/vserver/v2"},
+		{"gov", "vserver", vserver.NewConfiguration(apiKey), "https://ncloud.apigw.gov-ntruss.com/vserver/v2"},
+		{"fin", "vserver", vserver.NewConfiguration(apiKey), "https://fin-ncloud.apigw.fin-ntruss.com/vserver/v2"},
+		{"gov", "vnks", vnks.NewConfiguration("KRS", apiKey), "https://nks.apigw.gov-ntruss.com/vnks/krs-v2"},
+		{"fin", "vnks", vnks.NewConfiguration("FKR", apiKey), "https://nks.apigw.fin-ntruss.com/nks/v2"},
+	}
+
+	for _, tc := range cases {
+		config := &Config{Site: tc.site}
+		if actual := config.configure(tc.service, tc.cfg).BasePath; actual != tc.expected {
+			t.Fatalf("site %q: expected %s, got %s", tc.site, tc.expected, actual)
+		}
+	}
+}
+
+func TestConfigEndpoints(t *testing.T) {
+	var requestPath string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestPath = r.URL.Path
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"getRegionListResponse": {"returnCode":"0","regionList":[{"regionCode":"KR","regionName":"Korea"}]}}`)
+	}))
+	defer server.Close()
+
+	config := &Config{
+		AccessKey: "access",
+		SecretKey: "secret",
+		Region:    "KR",
+		Site:      "gov",
+		Endpoints: map[string]string{
+			"vserver": server.URL + "/vserver/v2/",
+			"vmysql":  server.URL + "/vmysql/v2",
+		},
+	}
+
+	if actual := config.configure("vmysql", vmysql.NewConfiguration()).BasePath; actual != server.URL+"/vmysql/v2" {
+		t.Fatalf("endpoint override must take precedence over the site gateway, got %s", actual)
+	}
+
+	client, err := config.Client()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	resp, err := client.Vserver.V2Api.GetRegionList(&vserver.GetRegionListRequest{})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if requestPath != "/vserver/v2/getRegionList" {
+		t.Fatalf("expected request to the overridden endpoint, got %s", requestPath)
+	}
+	if len(resp.RegionList) != 1 || *resp.RegionList[0].RegionCode != "KR" {
+		t.Fatalf("unexpected response: %#v", resp)
+	}
+}
+
+func TestProviderConfigRegionCacheIsolation(t *testing.T) {
+	kr := &ProviderConfig{RegionCode: "KR"}
+	jpn := &ProviderConfig{RegionCode: "JPN"}
+
+	kr.regionCacheByCode.Store("KR", Region{RegionNo: ncloud.String("1"), RegionCode: ncloud.String("KR")})
+	jpn.regionCacheByCode.Store("JPN", Region{RegionNo: ncloud.String("10"), RegionCode: ncloud.String("JPN")})
+
+	if IsValidRegionCode(kr, "JPN") {
+		t.Fatalf("region cache of one provider must not leak into another")
+	}
+	if regionNo := GetRegionNoByCode(jpn, "JPN"); regionNo == nil || *regionNo != "10" {
+		t.Fatalf("expected region no 10 for JPN, got %v", regionNo)
+	}
+}
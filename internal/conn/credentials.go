@@ -0,0 +1,170 @@
+package conn
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	multierror "github.com/hashicorp/go-multierror"
+)
+
+const (
+	DefaultProfile = "DEFAULT"
+
+	credentialsSourceStatic = "provider configuration"
+	credentialsSourceEnv    = "environment variables"
+	credentialsSourceShared = "shared credentials file"
+)
+
+// Credentials is the pair of keys resolved from a credentials source
+type Credentials struct {
+	AccessKey string
+	SecretKey string
+	Source    string
+}
+
+// CredentialsChain resolves credentials from explicit values, then environment variables, then a ncloud CLI configure file.
+type CredentialsChain struct {
+	AccessKey             string
+	SecretKey             string
+	Profile               string
+	SharedCredentialsFile string
+}
+
+// Retrieve returns the credentials of the first source that provides both keys.
+// When none does, the returned *multierror.Error has one entry per source explaining why it was skipped.
+func (c *CredentialsChain) Retrieve() (*Credentials, error) {
+	sources := []func() (*Credentials, error){
+		func() (*Credentials, error) {
+			return staticCredentials(credentialsSourceStatic, c.AccessKey, c.SecretKey, "access_key", "secret_key")
+		},
+		func() (*Credentials, error) {
+			return staticCredentials(credentialsSourceEnv, os.Getenv("NCLOUD_ACCESS_KEY"), os.Getenv("NCLOUD_SECRET_KEY"), "NCLOUD_ACCESS_KEY", "NCLOUD_SECRET_KEY")
+		},
+		c.sharedCredentials,
+	}
+
+	var errs *multierror.Error
+	for _, source := range sources {
+		creds, err := source()
+		if err == nil {
+			return creds, nil
+		}
+		errs = multierror.Append(errs, err)
+	}
+
+	return nil, errs
+}
+
+func staticCredentials(source, accessKey, secretKey, accessKeyName, secretKeyName string) (*Credentials, error) {
+	switch {
+	case accessKey == "" && secretKey == "":
+		return nil, fmt.Errorf("%s: %s and %s are not set", source, accessKeyName, secretKeyName)
+	case accessKey == "":
+		return nil, fmt.Errorf("%s: %s is set but %s is missing", source, secretKeyName, accessKeyName)
+	case secretKey == "":
+		return nil, fmt.Errorf("%s: %s is set but %s is missing", source, accessKeyName, secretKeyName)
+	}
+
+	return &Credentials{
+		AccessKey: accessKey,
+		SecretKey: secretKey,
+		Source:    source,
+	}, nil
+}
+
+func (c *CredentialsChain) sharedCredentials() (*Credentials, error) {
+	profile := c.Profile
+	if profile == "" {
+		profile = DefaultProfile
+	}
+
+	path, err := c.sharedCredentialsFilePath()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", credentialsSourceShared, err)
+	}
+
+	profiles, err := parseSharedCredentialsFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("%s (%s): %w", credentialsSourceShared, path, err)
+	}
+
+	values, ok := profiles[profile]
+	if !ok {
+		return nil, fmt.Errorf("%s (%s): profile `%s` not found", credentialsSourceShared, path, profile)
+	}
+
+	source := fmt.Sprintf("%s (%s), profile `%s`", credentialsSourceShared, path, profile)
+	return staticCredentials(source, values["ncloud_access_key_id"], values["ncloud_secret_access_key"], "ncloud_access_key_id", "ncloud_secret_access_key")
+}
+
+func (c *CredentialsChain) sharedCredentialsFilePath() (string, error) {
+	path := c.SharedCredentialsFile
+	if path == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", fmt.Errorf("unable to find home directory: %w", err)
+		}
+		return filepath.Join(home, ".ncloud", "configure"), nil
+	}
+
+	if path == "~" || strings.HasPrefix(path, "~/") {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", fmt.Errorf("unable to expand `%s`: %w", path, err)
+		}
+		path = filepath.Join(home, path[1:])
+	}
+
+	return path, nil
+}
+
+// parseSharedCredentialsFile reads an ncloud CLI style configure file.
+// Keys that appear before any [profile] header belong to the DEFAULT profile.
+func parseSharedCredentialsFile(path string) (map[string]map[string]string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	profiles := map[string]map[string]string{}
+	profile := DefaultProfile
+
+	scanner := bufio.NewScanner(file)
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+
+		if strings.HasPrefix(line, "[") {
+			if !strings.HasSuffix(line, "]") {
+				return nil, fmt.Errorf("line %d: malformed profile header `%s`", lineNo, line)
+			}
+			profile = strings.TrimSpace(line[1 : len(line)-1])
+			if _, ok := profiles[profile]; !ok {
+				profiles[profile] = map[string]string{}
+			}
+			continue
+		}
+
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			return nil, fmt.Errorf("line %d: expected `key = value`", lineNo)
+		}
+
+		if _, ok := profiles[profile]; !ok {
+			profiles[profile] = map[string]string{}
+		}
+		profiles[profile][strings.TrimSpace(key)] = strings.TrimSpace(value)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return profiles, nil
+}
@@ -0,0 +1,140 @@
+package conn
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	multierror "github.com/hashicorp/go-multierror"
+)
+
+const testSharedCredentialsFile = `
+# ncloud configure
+ncloud_access_key_id = default-access
+ncloud_secret_access_key = default-secret
+
+[dev]
+ncloud_access_key_id = dev-access
+ncloud_secret_access_key = dev-secret
+
+[broken]
+ncloud_access_key_id = broken-access
+`
+
+func writeTestSharedCredentialsFile(t *testing.T, content string) string {
+	path := filepath.Join(t.TempDir(), "configure")
+	if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatalf("writing shared credentials file: %s", err)
+	}
+	return path
+}
+
+func unsetCredentialsEnv(t *testing.T) {
+	t.Setenv("NCLOUD_ACCESS_KEY", "")
+	t.Setenv("NCLOUD_SECRET_KEY", "")
+}
+
+func TestCredentialsChainStatic(t *testing.T) {
+	t.Setenv("NCLOUD_ACCESS_KEY", "env-access")
+	t.Setenv("NCLOUD_SECRET_KEY", "env-secret")
+
+	chain := &CredentialsChain{AccessKey: "static-access", SecretKey: "static-secret"}
+	creds, err := chain.Retrieve()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if creds.AccessKey != "static-access" || creds.SecretKey != "static-secret" || creds.Source != credentialsSourceStatic {
+		t.Fatalf("expected static credentials, got %#v", creds)
+	}
+}
+
+func TestCredentialsChainEnv(t *testing.T) {
+	t.Setenv("NCLOUD_ACCESS_KEY", "env-access")
+	t.Setenv("NCLOUD_SECRET_KEY", "env-secret")
+
+	chain := &CredentialsChain{SharedCredentialsFile: writeTestSharedCredentialsFile(t, testSharedCredentialsFile)}
+	creds, err := chain.Retrieve()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if creds.AccessKey != "env-access" || creds.SecretKey != "env-secret" || creds.Source != credentialsSourceEnv {
+		t.Fatalf("expected environment credentials, got %#v", creds)
+	}
+}
+
+func TestCredentialsChainSharedCredentialsFile(t *testing.T) {
+	unsetCredentialsEnv(t)
+	path := writeTestSharedCredentialsFile(t, testSharedCredentialsFile)
+
+	cases := map[string]string{
+		"":        "default-access",
+		"DEFAULT": "default-access",
+		"dev":     "dev-access",
+	}
+
+	for profile, expected := range cases {
+		chain := &CredentialsChain{Profile: profile, SharedCredentialsFile: path}
+		creds, err := chain.Retrieve()
+		if err != nil {
+			t.Fatalf("profile %q: unexpected error: %s", profile, err)
+		}
+		if creds.AccessKey != expected {
+			t.Fatalf("profile %q: expected access key %s, got %s", profile, expected, creds.AccessKey)
+		}
+	}
+}
+
+func TestCredentialsChainDiagnostics(t *testing.T) {
+	unsetCredentialsEnv(t)
+	path := writeTestSharedCredentialsFile(t, testSharedCredentialsFile)
+
+	cases := []struct {
+		name     string
+		chain    *CredentialsChain
+		expected []string
+	}{
+		{
+			name:  "partial static and unknown profile",
+			chain: &CredentialsChain{AccessKey: "static-access", Profile: "unknown", SharedCredentialsFile: path},
+			expected: []string{
+				"provider configuration: access_key is set but secret_key is missing",
+				"environment variables: NCLOUD_ACCESS_KEY and NCLOUD_SECRET_KEY are not set",
+				"profile `unknown` not found",
+			},
+		},
+		{
+			name:     "incomplete profile",
+			chain:    &CredentialsChain{Profile: "broken", SharedCredentialsFile: path},
+			expected: []string{"profile `broken`: ncloud_access_key_id is set but ncloud_secret_access_key is missing"},
+		},
+		{
+			name:     "missing file",
+			chain:    &CredentialsChain{SharedCredentialsFile: filepath.Join(t.TempDir(), "missing")},
+			expected: []string{"no such file or directory"},
+		},
+		{
+			name:     "malformed file",
+			chain:    &CredentialsChain{SharedCredentialsFile: writeTestSharedCredentialsFile(t, "[dev\n")},
+			expected: []string{"line 1: malformed profile header"},
+		},
+	}
+
+	for _, tc := range cases {
+		_, err := tc.chain.Retrieve()
+		if err == nil {
+			t.Fatalf("%s: expected error", tc.name)
+		}
+
+		merr, ok := err.(*multierror.Error)
+		if !ok || len(merr.Errors) != 3 {
+			t.Fatalf("%s: expected one error per source, got %#v", tc.name, err)
+		}
+
+		for _, expected := range tc.expected {
+			if !strings.Contains(err.Error(), expected) {
+				t.Fatalf("%s: expected error to contain %q, got %s", tc.name, expected, err)
+			}
+		}
+	}
+}
@@ -1,9 +1,8 @@
 package conn
 
 import (
+	"context"
 	"fmt"
-	"os"
-	"sync"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
 
@@ -17,11 +16,9 @@ type Region struct {
 	RegionName *string `json:"regionName,omitempty"`
 }
 
-var regionCacheByCode = sync.Map{}
-
-func ParseRegionNoParameter(d *schema.ResourceData) (*string, error) {
+func ParseRegionNoParameter(config *ProviderConfig, d *schema.ResourceData) (*string, error) {
 	if regionCode, regionCodeOk := d.GetOk("region"); regionCodeOk {
-		regionNo := GetRegionNoByCode(regionCode.(string))
+		regionNo := GetRegionNoByCode(config, regionCode.(string))
 		if regionNo == nil {
 			return nil, fmt.Errorf("no region data for region_code `%s`. please change region_code and try again", regionCode.(string))
 		}
@@ -29,8 +26,8 @@ func ParseRegionNoParameter(d *schema.ResourceData) (*string, error) {
 	}
 
 	// provider region
-	if regionCode := os.Getenv("NCLOUD_REGION"); regionCode != "" {
-		regionNo := GetRegionNoByCode(regionCode)
+	if regionCode := config.RegionCode; regionCode != "" {
+		regionNo := GetRegionNoByCode(config, regionCode)
 		if regionNo == nil {
 			return nil, fmt.Errorf("no region data for region_code `%s`. please change region_code and try again", regionCode)
 		}
@@ -40,14 +37,14 @@ func ParseRegionNoParameter(d *schema.ResourceData) (*string, error) {
 	return nil, nil
 }
 
-func GetRegionNoByCode(code string) *string {
-	if region, ok := regionCacheByCode.Load(code); ok {
+func GetRegionNoByCode(config *ProviderConfig, code string) *string {
+	if region, ok := config.regionCacheByCode.Load(code); ok {
 		return region.(Region).RegionNo
 	}
 	return nil
 }
 
-func GetRegionByCode(client *NcloudAPIClient, code string) (*server.Region, error) {
+func GetRegionByCode(ctx context.Context, client *NcloudAPIClient, code string) (*server.Region, error) {
 	resp, err := client.Server.V2Api.GetRegionList(&server.GetRegionListRequest{})
 	if err != nil {
 		return nil, err
@@ -65,13 +62,13 @@ func GetRegionByCode(client *NcloudAPIClient, code string) (*server.Region, erro
 	return filteredRegion, nil
 }
 
-func SetRegionCache(client *NcloudAPIClient, supportVPC bool) error {
+func SetRegionCache(ctx context.Context, config *ProviderConfig) error {
 	var regionList []*Region
 	var err error
-	if supportVPC {
-		regionList, err = getVpcRegionList(client)
+	if config.SupportVPC {
+		regionList, err = getVpcRegionList(ctx, config)
 	} else {
-		regionList, err = getClassicRegionList(client)
+		regionList, err = getClassicRegionList(ctx, config)
 	}
 
 	if err != nil {
@@ -83,18 +80,21 @@ func SetRegionCache(client *NcloudAPIClient, supportVPC bool) error {
 			RegionCode: r.RegionCode,
 			RegionName: r.RegionName,
 		}
-		if !supportVPC {
+		if !config.SupportVPC {
 			region.RegionNo = r.RegionNo
 		}
 
-		regionCacheByCode.Store(*region.RegionCode, region)
+		config.regionCacheByCode.Store(*region.RegionCode, region)
 	}
 
 	return nil
 }
 
-func getClassicRegionList(client *NcloudAPIClient) ([]*Region, error) {
-	resp, err := client.Server.V2Api.GetRegionList(&server.GetRegionListRequest{})
+func getClassicRegionList(ctx context.Context, config *ProviderConfig) ([]*Region, error) {
+	reqParams := &server.GetRegionListRequest{}
+	resp, err := CachedLookup(config, CatalogKey("server.GetRegionList", reqParams), func() (*server.GetRegionListResponse, error) {
+		return config.Client.Server.V2Api.GetRegionList(reqParams)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -112,8 +112,11 @@ func getClassicRegionList(client *NcloudAPIClient) ([]*Region, error) {
 	return regionList, nil
 }
 
-func getVpcRegionList(client *NcloudAPIClient) ([]*Region, error) {
-	resp, err := client.Vserver.V2Api.GetRegionList(&vserver.GetRegionListRequest{})
+func getVpcRegionList(ctx context.Context, config *ProviderConfig) ([]*Region, error) {
+	reqParams := &vserver.GetRegionListRequest{}
+	resp, err := CachedLookup(config, CatalogKey("vserver.GetRegionList", reqParams), func() (*vserver.GetRegionListResponse, error) {
+		return config.Client.Vserver.V2Api.GetRegionList(reqParams)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -129,7 +132,7 @@ func getVpcRegionList(client *NcloudAPIClient) ([]*Region, error) {
 	return regionList, nil
 }
 
-func IsValidRegionCode(code string) bool {
-	_, ok := regionCacheByCode.Load(code)
+func IsValidRegionCode(config *ProviderConfig, code string) bool {
+	_, ok := config.regionCacheByCode.Load(code)
 	return ok
 }
@@ -0,0 +1,278 @@
+package conn
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"log"
+	"math/rand"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	DefaultMaxRetries = 5
+	DefaultRateLimit  = 20
+
+	retryBaseDelay = 1 * time.Second
+	retryMaxDelay  = 30 * time.Second
+)
+
+// DefaultRetryableReturnCodes are NCP return codes of requests rejected because the target object is busy
+var DefaultRetryableReturnCodes = []string{
+	"3000",    // please try again
+	"23003",   // previous servers have not been entirely terminated
+	"23006",   // unable to request server termination and creation simultaneously
+	"25013",   // object in operation
+	"25017",   // server object in operation
+	"25033",   // port forwarding object in operation
+	"50160",   // auto scaling activity in progress
+	"1001015", // vpc is being changed
+	"1002035", // at least one access control group must remain on the network interface
+	"1003016", // server of the public ip is in operation
+	"1007009", // access control group can't be changed at the same time
+	"1011002", // network acl is being changed
+	"1012005", // network acl rules are being changed
+	"1017013", // route table is being changed
+	"1200004", // load balancer is busy
+	"1250000", // load balancer server error, please try again
+}
+
+// RetryTransport retries throttled, failed and busy-object requests with exponential backoff and jitter,
+// and caps the rate of requests sent through it.
+type RetryTransport struct {
+	Base           http.RoundTripper
+	MaxRetries     int
+	RetryableCodes map[string]bool
+
+	limiter *rateLimiter
+	sleep   func(ctx context.Context, attempt int, retryAfter time.Duration) error
+}
+
+func NewRetryTransport(base http.RoundTripper, maxRetries int, rateLimit float64, retryableCodes []string) *RetryTransport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	codes := map[string]bool{}
+	for _, code := range retryableCodes {
+		codes[code] = true
+	}
+
+	return &RetryTransport{
+		Base:           base,
+		MaxRetries:     maxRetries,
+		RetryableCodes: codes,
+		limiter:        newRateLimiter(rateLimit),
+		sleep:          sleepWithJitter,
+	}
+}
+
+func (t *RetryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	for attempt := 0; ; attempt++ {
+		if err := t.limiter.wait(ctx); err != nil {
+			return nil, err
+		}
+
+		r := req
+		if attempt > 0 && req.Body != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			r = req.Clone(ctx)
+			r.Body = body
+		}
+
+		resp, err := t.Base.RoundTrip(r)
+		if err != nil {
+			return resp, err
+		}
+
+		// requests whose body can't be replayed are sent once
+		retry, reason := t.shouldRetry(req, resp)
+		if !retry || attempt >= t.MaxRetries || (req.Body != nil && req.GetBody == nil) {
+			return resp, nil
+		}
+
+		retryAfter := parseRetryAfter(resp.Header.Get("Retry-After"))
+		io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+
+		log.Printf("[DEBUG] retrying %s %s (attempt %d/%d): %s", req.Method, req.URL.Path, attempt+1, t.MaxRetries, reason)
+		if err := t.sleep(ctx, attempt, retryAfter); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (t *RetryTransport) shouldRetry(req *http.Request, resp *http.Response) (bool, string) {
+	switch {
+	case resp.StatusCode == http.StatusTooManyRequests:
+		return true, resp.Status
+	case resp.StatusCode >= http.StatusInternalServerError:
+		// a request changing an object may have been done despite the error, e.g. a server created twice,
+		// so only the errors of gateways, which didn't reach the API, are retried
+		if isReadRequest(req) || resp.StatusCode == http.StatusBadGateway || resp.StatusCode == http.StatusServiceUnavailable || resp.StatusCode == http.StatusGatewayTimeout {
+			return true, resp.Status
+		}
+		return false, ""
+	case resp.StatusCode < http.StatusBadRequest || len(t.RetryableCodes) == 0:
+		return false, ""
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		return false, ""
+	}
+
+	if code := parseReturnCode(body); t.RetryableCodes[code] {
+		return true, "return code " + code
+	}
+	return false, ""
+}
+
+// isReadRequest tells the request only reads objects, by its method or, for the APIs sending every operation
+// with POST, by its operation name, like getServerInstanceList
+func isReadRequest(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	}
+	return strings.HasPrefix(path.Base(req.URL.Path), "get")
+}
+
+// ReturnCode is an NCP return code, which some APIs report as a number
+type ReturnCode string
+
+func (c *ReturnCode) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err == nil {
+		*c = ReturnCode(s)
+		return nil
+	}
+
+	var n json.Number
+	if err := json.Unmarshal(b, &n); err != nil {
+		return err
+	}
+	*c = ReturnCode(n.String())
+	return nil
+}
+
+// parseReturnCode extracts the return code of both NCP error body formats
+func parseReturnCode(body []byte) string {
+	var errBody struct {
+		ResponseError struct {
+			ReturnCode ReturnCode `json:"returnCode"`
+		} `json:"responseError"`
+		Error struct {
+			ErrorCode ReturnCode `json:"errorCode"`
+		} `json:"error"`
+	}
+
+	if err := json.Unmarshal(body, &errBody); err != nil {
+		return ""
+	}
+	if errBody.ResponseError.ReturnCode != "" {
+		return string(errBody.ResponseError.ReturnCode)
+	}
+	return string(errBody.Error.ErrorCode)
+}
+
+func parseRetryAfter(v string) time.Duration {
+	if seconds, err := strconv.Atoi(v); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	return 0
+}
+
+// sleepWithJitter waits a random duration up to the exponential backoff of the attempt ("full jitter"),
+// or for retryAfter when the server asked for it.
+func sleepWithJitter(ctx context.Context, attempt int, retryAfter time.Duration) error {
+	delay := retryAfter
+	if delay == 0 {
+		backoff := retryBaseDelay << attempt
+		if backoff <= 0 || backoff > retryMaxDelay {
+			backoff = retryMaxDelay
+		}
+		delay = time.Duration(rand.Int63n(int64(backoff)) + 1)
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// rateLimiter spaces requests evenly so that no more than rate requests are sent per second
+type rateLimiter struct {
+	interval time.Duration
+
+	mu   sync.Mutex
+	next time.Time
+}
+
+func newRateLimiter(rate float64) *rateLimiter {
+	if rate <= 0 {
+		return &rateLimiter{}
+	}
+	return &rateLimiter{interval: time.Duration(float64(time.Second) / rate)}
+}
+
+func (l *rateLimiter) wait(ctx context.Context) error {
+	if l.interval == 0 {
+		return nil
+	}
+
+	l.mu.Lock()
+	now := time.Now()
+	if l.next.Before(now) {
+		l.next = now
+	}
+	delay := l.next.Sub(now)
+	l.next = l.next.Add(l.interval)
+	l.mu.Unlock()
+
+	if delay == 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+type transportContextKey struct{}
+
+// ContextWithTransport returns a copy of ctx carrying the transport ProviderConfigure uses for the service clients,
+// e.g. for acceptance tests recording and replaying API calls.
+func ContextWithTransport(ctx context.Context, transport http.RoundTripper) context.Context {
+	return context.WithValue(ctx, transportContextKey{}, transport)
+}
+
+// TransportFromContext returns the transport carried by ctx, or nil
+func TransportFromContext(ctx context.Context) http.RoundTripper {
+	transport, _ := ctx.Value(transportContextKey{}).(http.RoundTripper)
+	return transport
+}
@@ -0,0 +1,142 @@
+package conn
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func newTestRetryTransport(maxRetries int, rateLimit float64) *RetryTransport {
+	t := NewRetryTransport(nil, maxRetries, rateLimit, DefaultRetryableReturnCodes)
+	t.sleep = func(ctx context.Context, attempt int, retryAfter time.Duration) error {
+		return ctx.Err()
+	}
+	return t
+}
+
+func TestRetryTransportRetries(t *testing.T) {
+	cases := []struct {
+		name     string
+		path     string
+		status   int
+		body     string
+		expected int32
+	}{
+		{"throttled", "/vserver/v2/createServerInstances", http.StatusTooManyRequests, "", 3},
+		{"gateway error", "/vserver/v2/createServerInstances", http.StatusBadGateway, "", 3},
+		{"server error of a read", "/vserver/v2/getServerInstanceList", http.StatusInternalServerError, "", 3},
+		{"server error of a create", "/vserver/v2/createServerInstances", http.StatusInternalServerError, "", 1},
+		{"object in operation", "/vserver/v2/createServerInstances", http.StatusBadRequest, `{"responseError":{"returnCode":"25013","returnMessage":"object in operation"}}`, 3},
+		{"numeric return code", "/vserver/v2/createServerInstances", http.StatusBadRequest, `{"responseError":{"returnCode":23006,"returnMessage":"in operation"}}`, 3},
+		{"acg in operation", "/vserver/v2/addAccessControlGroupInboundRule", http.StatusBadRequest, `{"error":{"errorCode":"1007009","message":"acg in operation"}}`, 3},
+		{"not retryable", "/vserver/v2/createServerInstances", http.StatusBadRequest, `{"responseError":{"returnCode":"1300","returnMessage":"unknown"}}`, 1},
+	}
+
+	for _, tc := range cases {
+		var calls int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ := io.ReadAll(r.Body)
+			if string(body) != "payload" {
+				t.Errorf("%s: request body must be replayed, got %q", tc.name, body)
+			}
+
+			if atomic.AddInt32(&calls, 1) < 3 {
+				w.WriteHeader(tc.status)
+				fmt.Fprint(w, tc.body)
+				return
+			}
+			fmt.Fprint(w, `{}`)
+		}))
+
+		client := &http.Client{Transport: newTestRetryTransport(5, 0)}
+		resp, err := client.Post(server.URL+tc.path, "application/json", strings.NewReader("payload"))
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", tc.name, err)
+		}
+		resp.Body.Close()
+		server.Close()
+
+		if calls != tc.expected {
+			t.Fatalf("%s: expected %d requests, got %d", tc.name, tc.expected, calls)
+		}
+		if tc.expected == 1 && resp.StatusCode != tc.status {
+			t.Fatalf("%s: expected the original response, got %s", tc.name, resp.Status)
+		}
+	}
+}
+
+func TestRetryTransportMaxRetries(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: newTestRetryTransport(2, 0)}
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	resp.Body.Close()
+
+	if calls != 3 || resp.StatusCode != http.StatusServiceUnavailable {
+		t.Fatalf("expected 3 requests ending with 503, got %d requests and %s", calls, resp.Status)
+	}
+}
+
+func TestRetryTransportCancel(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Retry-After", "60")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	transport := NewRetryTransport(nil, 10, 0, nil)
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+
+	start := time.Now()
+	if _, err := (&http.Client{Transport: transport}).Do(req); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected cancellation error, got %v", err)
+	}
+	// the cancellation cuts the wait for the Retry-After of 60s short
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Fatalf("cancellation must stop retries promptly, took %s", elapsed)
+	}
+	if calls != 1 {
+		t.Fatalf("expected 1 request before the cancellation, got %d", calls)
+	}
+}
+
+func TestRetryTransportRateLimit(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	client := &http.Client{Transport: newTestRetryTransport(0, 20)}
+
+	start := time.Now()
+	for i := 0; i < 5; i++ {
+		resp, err := client.Get(server.URL)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		resp.Body.Close()
+	}
+
+	// 5 requests at 20 per second are spaced by at least 4 intervals of 50ms
+	if elapsed := time.Since(start); elapsed < 200*time.Millisecond {
+		t.Fatalf("expected requests to be rate limited, took %s", elapsed)
+	}
+}
@@ -0,0 +1,30 @@
+package framework
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// PlannedStringChange returns the planned value of the string attribute at p and true when it is known and differs
+// from the state, i.e. when a new value is about to be sent to the API.
+func PlannedStringChange(ctx context.Context, req resource.ModifyPlanRequest, p path.Path) (string, bool, diag.Diagnostics) {
+	var plan types.String
+	diags := req.Plan.GetAttribute(ctx, p, &plan)
+	if diags.HasError() || plan.IsNull() || plan.IsUnknown() {
+		return "", false, diags
+	}
+
+	if !req.State.Raw.IsNull() {
+		var state types.String
+		diags.Append(req.State.GetAttribute(ctx, p, &state)...)
+		if diags.HasError() || state.Equal(plan) {
+			return "", false, diags
+		}
+	}
+
+	return plan.ValueString(), true, diags
+}
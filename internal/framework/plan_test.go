@@ -0,0 +1,58 @@
+package framework_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/framework"
+)
+
+func TestPlannedStringChange(t *testing.T) {
+	ctx := context.Background()
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"product_code": schema.StringAttribute{Optional: true, Computed: true},
+		},
+	}
+	objectType := s.Type().TerraformType(ctx)
+	value := func(v interface{}) tftypes.Value {
+		return tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"product_code": tftypes.NewValue(tftypes.String, v),
+		})
+	}
+
+	cases := []struct {
+		name    string
+		state   tftypes.Value
+		plan    tftypes.Value
+		code    string
+		changed bool
+	}{
+		{"create", tftypes.NewValue(objectType, nil), value("SVR.VDBAS.STAND.C002.M008.NET.SSD.B050.G002"), "SVR.VDBAS.STAND.C002.M008.NET.SSD.B050.G002", true},
+		{"update", value("SVR.VDBAS.STAND.C002.M008.NET.SSD.B050.G002"), value("SVR.VDBAS.STAND.C004.M016.NET.SSD.B050.G002"), "SVR.VDBAS.STAND.C004.M016.NET.SSD.B050.G002", true},
+		{"unchanged", value("SVR.VDBAS.STAND.C002.M008.NET.SSD.B050.G002"), value("SVR.VDBAS.STAND.C002.M008.NET.SSD.B050.G002"), "", false},
+		{"unknown", tftypes.NewValue(objectType, nil), value(tftypes.UnknownValue), "", false},
+		{"null", tftypes.NewValue(objectType, nil), value(nil), "", false},
+	}
+
+	for _, tc := range cases {
+		req := resource.ModifyPlanRequest{
+			State: tfsdk.State{Schema: s, Raw: tc.state},
+			Plan:  tfsdk.Plan{Schema: s, Raw: tc.plan},
+		}
+
+		code, changed, diags := framework.PlannedStringChange(ctx, req, path.Root("product_code"))
+		if diags.HasError() {
+			t.Fatalf("%s: %v", tc.name, diags)
+		}
+		if code != tc.code || changed != tc.changed {
+			t.Errorf("%s: expected (%q, %t) but (%q, %t)", tc.name, tc.code, tc.changed, code, changed)
+		}
+	}
+}
@@ -0,0 +1,28 @@
+package framework
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+// SDKv2SchemaVersion is the schema version of the state written by the SDKv2 implementation of a resource
+const SDKv2SchemaVersion = 0
+
+// UpgradeFromSDKv2 returns the state upgrader of a resource migrated from SDKv2 without changing its attributes,
+// from the state of prior, the schema of its SDKv2 implementation, to M, the model of both schemas.
+func UpgradeFromSDKv2[M any](prior schema.Schema) resource.StateUpgrader {
+	return resource.StateUpgrader{
+		PriorSchema: &prior,
+		StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+			var state M
+			resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+		},
+	}
+}
@@ -0,0 +1,63 @@
+package framework_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/framework"
+)
+
+type upgradeTestModel struct {
+	ID     types.String `tfsdk:"id"`
+	Name   types.String `tfsdk:"name"`
+	IpList types.Set    `tfsdk:"ip_list"`
+}
+
+func TestUpgradeFromSDKv2(t *testing.T) {
+	ctx := context.Background()
+	prior := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id":      schema.StringAttribute{Computed: true},
+			"name":    schema.StringAttribute{Optional: true},
+			"ip_list": schema.SetAttribute{ElementType: types.StringType, Required: true},
+		},
+	}
+	current := prior
+	current.Version = 1
+
+	// the state as written by the SDKv2 implementation
+	rawState := tftypes.NewValue(prior.Type().TerraformType(ctx), map[string]tftypes.Value{
+		"id":   tftypes.NewValue(tftypes.String, "1234"),
+		"name": tftypes.NewValue(tftypes.String, "tf-test"),
+		"ip_list": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
+			tftypes.NewValue(tftypes.String, "10.0.0.1"),
+		}),
+	})
+
+	upgrader := framework.UpgradeFromSDKv2[upgradeTestModel](prior)
+	req := resource.UpgradeStateRequest{
+		State: &tfsdk.State{Schema: *upgrader.PriorSchema, Raw: rawState},
+	}
+	resp := &resource.UpgradeStateResponse{
+		State: tfsdk.State{Schema: current, Raw: tftypes.NewValue(current.Type().TerraformType(ctx), nil)},
+	}
+
+	upgrader.StateUpgrader(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("upgrading state: %v", resp.Diagnostics)
+	}
+
+	var got upgradeTestModel
+	if diags := resp.State.Get(ctx, &got); diags.HasError() {
+		t.Fatalf("reading upgraded state: %v", diags)
+	}
+	if got.ID.ValueString() != "1234" || got.Name.ValueString() != "tf-test" || len(got.IpList.Elements()) != 1 {
+		t.Errorf("unexpected upgraded state: %+v", got)
+	}
+}
@@ -0,0 +1,198 @@
+package function
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func runFunction(t *testing.T, f function.Function, returnValue attr.Value, args ...attr.Value) (attr.Value, *function.FuncError) {
+	t.Helper()
+
+	resp := &function.RunResponse{
+		Result: function.NewResultData(returnValue),
+	}
+	f.Run(context.Background(), function.RunRequest{Arguments: function.NewArgumentsData(args)}, resp)
+
+	return resp.Result.Value(), resp.Error
+}
+
+func TestSubnetCidrFunction(t *testing.T) {
+	cases := []struct {
+		vpcCidr  string
+		newbits  int64
+		netnum   int64
+		expected string
+		errorArg int64
+	}{
+		{"10.0.0.0/16", 8, 0, "10.0.0.0/24", -1},
+		{"10.0.0.0/16", 8, 255, "10.0.255.0/24", -1},
+		{"172.16.0.0/16", 4, 3, "172.16.48.0/20", -1},
+		{"192.168.10.0/24", 4, 15, "192.168.10.240/28", -1},
+		{"10.0.0.0/16", 0, 0, "10.0.0.0/16", -1},
+		{"10.0.0.0/8", 8, 0, "", 0},
+		{"10.0.0.1/16", 8, 0, "", 0},
+		{"10.0.0.0/16", 13, 0, "", 1},
+		{"10.0.0.0/16", -1, 0, "", 1},
+		{"10.0.0.0/16", 8, 256, "", 2},
+	}
+
+	for _, tc := range cases {
+		result, err := runFunction(t, NewSubnetCidrFunction(), types.StringUnknown(),
+			types.StringValue(tc.vpcCidr), types.Int64Value(tc.newbits), types.Int64Value(tc.netnum))
+
+		if tc.errorArg >= 0 {
+			if err == nil || err.FunctionArgument == nil || *err.FunctionArgument != tc.errorArg {
+				t.Fatalf("subnet_cidr(%s, %d, %d): expected error on argument %d, got %v", tc.vpcCidr, tc.newbits, tc.netnum, tc.errorArg, err)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Fatalf("subnet_cidr(%s, %d, %d): unexpected error: %s", tc.vpcCidr, tc.newbits, tc.netnum, err)
+		}
+		if !result.Equal(types.StringValue(tc.expected)) {
+			t.Fatalf("subnet_cidr(%s, %d, %d): expected %s, got %s", tc.vpcCidr, tc.newbits, tc.netnum, tc.expected, result)
+		}
+	}
+}
+
+func TestZoneRegionFunction(t *testing.T) {
+	cases := map[string]string{
+		"KR-1":  "KR",
+		"KR-2":  "KR",
+		"JPN-4": "JPN",
+		"SGN-5": "SGN",
+		"KRS-1": "KRS",
+		"FKR-2": "FKR",
+	}
+
+	for zone, expected := range cases {
+		result, err := runFunction(t, NewZoneRegionFunction(), types.StringUnknown(), types.StringValue(zone))
+		if err != nil {
+			t.Fatalf("zone_region(%s): unexpected error: %s", zone, err)
+		}
+		if !result.Equal(types.StringValue(expected)) {
+			t.Fatalf("zone_region(%s): expected %s, got %s", zone, expected, result)
+		}
+	}
+
+	for _, zone := range []string{"", "KR", "kr-1", "KR-"} {
+		if _, err := runFunction(t, NewZoneRegionFunction(), types.StringUnknown(), types.StringValue(zone)); err == nil {
+			t.Fatalf("zone_region(%q): expected error", zone)
+		}
+	}
+}
+
+func TestObjectStorageEndpointFunction(t *testing.T) {
+	cases := []struct {
+		region   string
+		site     string
+		expected string
+	}{
+		{"KR", "public", "https://kr.object.ncloudstorage.com"},
+		{"JPN", "", "https://jp.object.ncloudstorage.com"},
+		{"KRS", "gov", "https://krs.object.gov-ncloudstorage.com"},
+		{"FKR", "fin", "https://kr.object.fin-ncloudstorage.com"},
+	}
+
+	for _, tc := range cases {
+		result, err := runFunction(t, NewObjectStorageEndpointFunction(), types.StringUnknown(), types.StringValue(tc.region), types.StringValue(tc.site))
+		if err != nil {
+			t.Fatalf("objectstorage_endpoint(%s, %s): unexpected error: %s", tc.region, tc.site, err)
+		}
+		if !result.Equal(types.StringValue(tc.expected)) {
+			t.Fatalf("objectstorage_endpoint(%s, %s): expected %s, got %s", tc.region, tc.site, tc.expected, result)
+		}
+	}
+
+	if _, err := runFunction(t, NewObjectStorageEndpointFunction(), types.StringUnknown(), types.StringValue("KR"), types.StringValue("private")); err == nil {
+		t.Fatalf("expected error for unknown site")
+	}
+	if _, err := runFunction(t, NewObjectStorageEndpointFunction(), types.StringUnknown(), types.StringValue(""), types.StringValue("public")); err == nil {
+		t.Fatalf("expected error for empty region")
+	}
+}
+
+func TestParseIdFunction(t *testing.T) {
+	result, err := runFunction(t, NewParseIdFunction(), types.MapUnknown(types.StringType),
+		types.StringValue("ncloud_route"), types.StringValue("12345:0.0.0.0/0"))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"route_table_no":         types.StringValue("12345"),
+		"destination_cidr_block": types.StringValue("0.0.0.0/0"),
+	})
+	if !result.Equal(expected) {
+		t.Fatalf("expected %s, got %s", expected, result)
+	}
+
+	// The ID each resource writes to the state, only ncloud_route_table_association writes its import ID
+	idCases := []struct {
+		resourceType string
+		stateId      string
+		importId     string
+		parsed       bool
+	}{
+		{"ncloud_auto_scaling_policy", "12345", "34567:12345", false},
+		{"ncloud_auto_scaling_schedule", "12345", "34567:12345", false},
+		{"ncloud_lb_listener", "12345", "34567:12345", false},
+		{"ncloud_route", "route-2416436231", "12345:0.0.0.0/0", false},
+		{"ncloud_route_table_association", "12345:67890", "12345:67890", true},
+		{"ncloud_sourcedeploy_project_stage", "12", "34:12", false},
+		{"ncloud_sourcedeploy_project_stage_scenario", "56", "34:12:56", false},
+	}
+
+	for _, tc := range idCases {
+		if _, err := runFunction(t, NewParseIdFunction(), types.MapUnknown(types.StringType), types.StringValue(tc.resourceType), types.StringValue(tc.importId)); err != nil {
+			t.Fatalf("parse_id(%s, %s): unexpected error: %s", tc.resourceType, tc.importId, err)
+		}
+
+		_, err := runFunction(t, NewParseIdFunction(), types.MapUnknown(types.StringType), types.StringValue(tc.resourceType), types.StringValue(tc.stateId))
+		if parsed := err == nil; parsed != tc.parsed {
+			t.Fatalf("parse_id(%s, %s): expected the state ID to be parsed %t, got %v", tc.resourceType, tc.stateId, tc.parsed, err)
+		}
+	}
+
+	cases := []struct {
+		resourceType string
+		id           string
+		errorArg     int64
+	}{
+		{"ncloud_vpc", "1:2", 0},
+		{"ncloud_lb_target_group_attachment", "100:200", 0},
+		{"ncloud_route", "12345", 1},
+		{"ncloud_route", "12345:", 1},
+		{"ncloud_sourcedeploy_project_stage_scenario", "1:2", 1},
+	}
+
+	for _, tc := range cases {
+		_, err := runFunction(t, NewParseIdFunction(), types.MapUnknown(types.StringType), types.StringValue(tc.resourceType), types.StringValue(tc.id))
+		if err == nil || err.FunctionArgument == nil || *err.FunctionArgument != tc.errorArg {
+			t.Fatalf("parse_id(%s, %s): expected error on argument %d, got %v", tc.resourceType, tc.id, tc.errorArg, err)
+		}
+	}
+
+	// The errors on IDs which aren't composite point to the attributes holding their parts
+	hintCases := []struct {
+		resourceType string
+		id           string
+		attribute    string
+	}{
+		{"ncloud_lb_target_group_attachment", "2024-01-01 00:00:00 +0000 UTC", "target_group_no"},
+		{"ncloud_route", "route-2416436231", "destination_cidr_block"},
+	}
+
+	for _, tc := range hintCases {
+		_, err := runFunction(t, NewParseIdFunction(), types.MapUnknown(types.StringType), types.StringValue(tc.resourceType), types.StringValue(tc.id))
+		if err == nil || !strings.Contains(err.Text, tc.attribute) {
+			t.Fatalf("parse_id(%s, %s): expected error mentioning %s, got %v", tc.resourceType, tc.id, tc.attribute, err)
+		}
+	}
+}
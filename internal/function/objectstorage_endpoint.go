@@ -0,0 +1,63 @@
+package function
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+)
+
+var _ function.Function = objectStorageEndpointFunction{}
+
+func NewObjectStorageEndpointFunction() function.Function {
+	return objectStorageEndpointFunction{}
+}
+
+type objectStorageEndpointFunction struct{}
+
+func (f objectStorageEndpointFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "objectstorage_endpoint"
+}
+
+func (f objectStorageEndpointFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Returns the object storage endpoint of a region and site",
+		Description: "Builds the S3 compatible object storage endpoint the provider uses for the region code and site (public, gov or fin).",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "region",
+				Description: "Region code",
+			},
+			function.StringParameter{
+				Name:        "site",
+				Description: "Site of ncloud (public / gov / fin)",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f objectStorageEndpointFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var region, site string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &region, &site))
+	if resp.Error != nil {
+		return
+	}
+
+	if len(region) < 2 {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("invalid region code (%q)", region))
+		return
+	}
+
+	switch site {
+	case "", "public", "gov", "fin":
+	default:
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("unexpected site (%q), expected public, gov or fin", site))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, conn.GenEndpointWithCode(region, site)))
+}
@@ -0,0 +1,115 @@
+package function
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// idFormats are the parts of the composite import IDs, in order, separated by a colon. Except for
+// ncloud_route_table_association, the resources write another ID to the state, e.g. a route hash or a single number.
+var idFormats = map[string][]string{
+	"ncloud_auto_scaling_policy":                 {"auto_scaling_group_no", "id"},
+	"ncloud_auto_scaling_schedule":               {"auto_scaling_group_no", "id"},
+	"ncloud_lb_listener":                         {"load_balancer_no", "listener_no"},
+	"ncloud_route":                               {"route_table_no", "destination_cidr_block"},
+	"ncloud_route_table_association":             {"route_table_no", "subnet_no"},
+	"ncloud_sourcedeploy_project_stage":          {"project_id", "stage_id"},
+	"ncloud_sourcedeploy_project_stage_scenario": {"project_id", "stage_id", "scenario_id"},
+}
+
+// idAttributes are the attributes holding the ID parts of the resources which write no composite ID to the state
+var idAttributes = map[string][]string{
+	"ncloud_auto_scaling_policy":                 {"auto_scaling_group_no", "id"},
+	"ncloud_auto_scaling_schedule":               {"auto_scaling_group_no", "id"},
+	"ncloud_lb_listener":                         {"load_balancer_no", "id"},
+	"ncloud_lb_target_group_attachment":          {"target_group_no", "target_no_list"},
+	"ncloud_route":                               {"route_table_no", "destination_cidr_block"},
+	"ncloud_sourcedeploy_project_stage":          {"project_id", "id"},
+	"ncloud_sourcedeploy_project_stage_scenario": {"project_id", "stage_id", "id"},
+}
+
+var _ function.Function = parseIdFunction{}
+
+func NewParseIdFunction() function.Function {
+	return parseIdFunction{}
+}
+
+type parseIdFunction struct{}
+
+func (f parseIdFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_id"
+}
+
+func (f parseIdFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Parses the composite import ID of a resource",
+		Description: fmt.Sprintf("Splits a colon separated composite import ID into a map of its parts. Only the IDs given to `terraform import` are parsed, the `id` attribute in the state is a single value for most resources. Supported resource types: %s.", strings.Join(supportedIdResourceTypes(), ", ")),
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "resource_type",
+				Description: "Resource type such as ncloud_route",
+			},
+			function.StringParameter{
+				Name:        "id",
+				Description: "Composite import ID to parse",
+			},
+		},
+		Return: function.MapReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f parseIdFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var resourceType, id string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &resourceType, &id))
+	if resp.Error != nil {
+		return
+	}
+
+	parts, ok := idFormats[resourceType]
+	if !ok {
+		if attributes, ok := idAttributes[resourceType]; ok {
+			resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("%s has no composite ID, read its %s attributes instead", resourceType, strings.Join(attributes, ", ")))
+			return
+		}
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("unsupported resource type (%q), expected one of %s", resourceType, strings.Join(supportedIdResourceTypes(), ", ")))
+		return
+	}
+
+	idParts := strings.Split(id, ":")
+	if len(idParts) != len(parts) {
+		msg := fmt.Sprintf("unexpected format of ID (%q), expected %s", id, strings.ToUpper(strings.Join(parts, ":")))
+		if attributes, ok := idAttributes[resourceType]; ok && len(idParts) == 1 {
+			msg += fmt.Sprintf(". The id in the state isn't a composite ID, read the %s attributes of the resource instead", strings.Join(attributes, ", "))
+		}
+		resp.Error = function.NewArgumentFuncError(1, msg)
+		return
+	}
+
+	result := make(map[string]string, len(parts))
+	for i, part := range parts {
+		if idParts[i] == "" {
+			resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("unexpected format of ID (%q), %s is empty", id, part))
+			return
+		}
+		result[part] = idParts[i]
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
+
+func supportedIdResourceTypes() []string {
+	resourceTypes := make([]string, 0, len(idFormats))
+	for k := range idFormats {
+		resourceTypes = append(resourceTypes, k)
+	}
+	sort.Strings(resourceTypes)
+	return resourceTypes
+}
@@ -0,0 +1,102 @@
+package function
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"net"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/verify"
+)
+
+const (
+	// NCP accepts VPC and subnet CIDR blocks from /16 to /28
+	minPrefixLength = 16
+	maxPrefixLength = 28
+)
+
+var _ function.Function = subnetCidrFunction{}
+
+func NewSubnetCidrFunction() function.Function {
+	return subnetCidrFunction{}
+}
+
+type subnetCidrFunction struct{}
+
+func (f subnetCidrFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "subnet_cidr"
+}
+
+func (f subnetCidrFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Calculates a subnet CIDR block within a VPC CIDR block",
+		Description: "Works like cidrsubnet, but ensures both the VPC and the subnet CIDR blocks have a prefix length between /16 and /28 as required by ncloud.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "vpc_cidr",
+				Description: "CIDR block of the VPC",
+			},
+			function.Int64Parameter{
+				Name:        "newbits",
+				Description: "Number of additional bits of the subnet prefix",
+			},
+			function.Int64Parameter{
+				Name:        "netnum",
+				Description: "Number of the subnet within the VPC",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f subnetCidrFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var vpcCidr string
+	var newbits, netnum int64
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &vpcCidr, &newbits, &netnum))
+	if resp.Error != nil {
+		return
+	}
+
+	subnet, err := subnetCidr(vpcCidr, newbits, netnum)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, err)
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, subnet))
+}
+
+func subnetCidr(vpcCidr string, newbits, netnum int64) (string, *function.FuncError) {
+	if err := verify.ValidateCIDRBlock(vpcCidr); err != nil {
+		return "", function.NewArgumentFuncError(0, err.Error())
+	}
+
+	_, ipnet, _ := net.ParseCIDR(vpcCidr)
+	ip := ipnet.IP.To4()
+	if ip == nil {
+		return "", function.NewArgumentFuncError(0, fmt.Sprintf("%q is not an IPv4 CIDR block", vpcCidr))
+	}
+
+	prefix, _ := ipnet.Mask.Size()
+	if prefix < minPrefixLength || prefix > maxPrefixLength {
+		return "", function.NewArgumentFuncError(0, fmt.Sprintf("prefix length of the VPC CIDR block must be between /%d and /%d, got /%d", minPrefixLength, maxPrefixLength, prefix))
+	}
+
+	subnetPrefix := int64(prefix) + newbits
+	if newbits < 0 || subnetPrefix > maxPrefixLength {
+		return "", function.NewArgumentFuncError(1, fmt.Sprintf("prefix length of the subnet CIDR block must be between /%d and /%d, got /%d", prefix, maxPrefixLength, subnetPrefix))
+	}
+
+	if netnum < 0 || netnum >= int64(1)<<newbits {
+		return "", function.NewArgumentFuncError(2, fmt.Sprintf("netnum must be between 0 and %d for %d new bits, got %d", int64(1)<<newbits-1, newbits, netnum))
+	}
+
+	base := binary.BigEndian.Uint32(ip)
+	subnet := make(net.IP, net.IPv4len)
+	binary.BigEndian.PutUint32(subnet, base|uint32(netnum)<<(32-subnetPrefix))
+
+	return fmt.Sprintf("%s/%d", subnet, subnetPrefix), nil
+}
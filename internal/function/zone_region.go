@@ -0,0 +1,55 @@
+package function
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// zone codes are the region code followed by a zone number, e.g. KR-2, JPN-4, FKR-1
+var zoneCodeRegexp = regexp.MustCompile(`^([A-Z]+)-[0-9]+$`)
+
+var _ function.Function = zoneRegionFunction{}
+
+func NewZoneRegionFunction() function.Function {
+	return zoneRegionFunction{}
+}
+
+type zoneRegionFunction struct{}
+
+func (f zoneRegionFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "zone_region"
+}
+
+func (f zoneRegionFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Returns the region code of a zone code",
+		Description: "Maps a zone code such as KR-2 to its region code such as KR.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "zone",
+				Description: "Zone code",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f zoneRegionFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var zone string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &zone))
+	if resp.Error != nil {
+		return
+	}
+
+	matches := zoneCodeRegexp.FindStringSubmatch(zone)
+	if matches == nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("unexpected format of zone code (%q), expected REGION-NUMBER such as KR-2", zone))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, matches[1]))
+}
@@ -0,0 +1,142 @@
+// Package paging lists all the items of the paged list APIs.
+//
+// The NCP APIs are paged by pageNo and pageSize, and the responses carry the totalRows of all the pages. The Object
+// Storage is paged by continuation tokens. Without paging, the list APIs silently return their first page only.
+package paging
+
+import (
+	"context"
+	"iter"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// DefaultPageSize is the size of the pages requested to the NCP APIs
+const DefaultPageSize = 100
+
+// PageFunc requests the page pageNo of pageSize items, and returns its items with the totalRows of the response, the
+// number of the items of all the pages, nil when the API doesn't return it
+type PageFunc[T any] func(ctx context.Context, pageNo, pageSize int32) (items []T, totalRows *int32, err error)
+
+// Pager describes how to page a NCP list API
+type Pager[T any] struct {
+	Page PageFunc[T]
+	// PageSize is DefaultPageSize when zero
+	PageSize int32
+	// ZeroBased is set for the APIs whose first page is 0 instead of 1, such as those of the Cloud DB
+	ZeroBased bool
+}
+
+// All returns the items of all the pages of the API, starting from page 1
+func All[T any](ctx context.Context, page PageFunc[T]) ([]T, error) {
+	return Pager[T]{Page: page}.All(ctx)
+}
+
+// All returns the items of all the pages
+func (p Pager[T]) All(ctx context.Context) ([]T, error) {
+	var items []T
+	for item, err := range p.Items(ctx) {
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+// Items iterates over the items of all the pages, requesting the next page when the items of the page run out.
+// The iteration stops at the first error.
+func (p Pager[T]) Items(ctx context.Context) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+
+		pageSize := p.PageSize
+		if pageSize <= 0 {
+			pageSize = DefaultPageSize
+		}
+		pageNo := int32(1)
+		if p.ZeroBased {
+			pageNo = 0
+		}
+
+		count := 0
+		for {
+			if err := ctx.Err(); err != nil {
+				yield(zero, err)
+				return
+			}
+
+			items, totalRows, err := p.Page(ctx, pageNo, pageSize)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			count += len(items)
+
+			if isLastPage(len(items), totalRows, count, pageSize) {
+				return
+			}
+
+			tflog.Debug(ctx, "Requesting next page", map[string]interface{}{
+				"page_no":    pageNo + 1,
+				"page_size":  pageSize,
+				"item_count": count,
+			})
+			pageNo++
+		}
+	}
+}
+
+func isLastPage(pageItems int, totalRows *int32, count int, pageSize int32) bool {
+	if pageItems == 0 {
+		return true
+	}
+	if totalRows != nil {
+		return count >= int(*totalRows)
+	}
+	return pageItems < int(pageSize)
+}
+
+// TokenPageFunc requests the page following the continuation token, nil for the first page, and returns the token of
+// the next page, nil after the last page
+type TokenPageFunc[T any] func(ctx context.Context, token *string) (items []T, next *string, err error)
+
+// ItemsByToken iterates over the items of all the pages of an API paged by continuation tokens.
+// The iteration stops at the first error.
+func ItemsByToken[T any](ctx context.Context, page TokenPageFunc[T]) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		var token *string
+
+		for {
+			if err := ctx.Err(); err != nil {
+				yield(zero, err)
+				return
+			}
+
+			items, next, err := page(ctx, token)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+
+			// a token repeated by the server would never end the listing
+			if next == nil || *next == "" || (token != nil && *next == *token) {
+				return
+			}
+			token = next
+		}
+	}
+}
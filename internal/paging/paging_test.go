@@ -0,0 +1,195 @@
+package paging
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// pages serves items by pages of at most maxPageSize, starting from firstPage, and records the pages requested
+func pages(items []int, maxPageSize int32, firstPage int32, withTotalRows bool) (PageFunc[int], *[]int32) {
+	var requested []int32
+	return func(ctx context.Context, pageNo, pageSize int32) ([]int, *int32, error) {
+		requested = append(requested, pageNo)
+
+		size := min(pageSize, maxPageSize)
+		start := int((pageNo - firstPage) * size)
+		end := start + int(size)
+
+		var totalRows *int32
+		if withTotalRows {
+			total := int32(len(items))
+			totalRows = &total
+		}
+		return items[min(start, len(items)):min(end, len(items))], totalRows, nil
+	}, &requested
+}
+
+func sequence(n int) []int {
+	items := make([]int, n)
+	for i := range items {
+		items[i] = i
+	}
+	return items
+}
+
+func TestAll_totalRows(t *testing.T) {
+	items := sequence(250)
+	page, requested := pages(items, 100, 1, true)
+
+	res, err := All(context.Background(), page)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !reflect.DeepEqual(res, items) {
+		t.Errorf("expected all the %d items but %d", len(items), len(res))
+	}
+	if expected := []int32{1, 2, 3}; !reflect.DeepEqual(*requested, expected) {
+		t.Errorf("expected pages %v but %v", expected, *requested)
+	}
+}
+
+func TestAll_cappedPageSize(t *testing.T) {
+	// the API returns pages smaller than requested, the totalRows tells there are more
+	items := sequence(25)
+	page, requested := pages(items, 10, 1, true)
+
+	res, err := All(context.Background(), page)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !reflect.DeepEqual(res, items) {
+		t.Errorf("expected all the %d items but %d", len(items), len(res))
+	}
+	if len(*requested) != 3 {
+		t.Errorf("expected 3 pages but %v", *requested)
+	}
+}
+
+func TestAll_shortLastPage(t *testing.T) {
+	items := sequence(200)
+	page, requested := pages(items, 100, 1, false)
+
+	res, err := All(context.Background(), page)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !reflect.DeepEqual(res, items) {
+		t.Errorf("expected all the %d items but %d", len(items), len(res))
+	}
+	// without totalRows, only an empty or short page ends the listing
+	if expected := []int32{1, 2, 3}; !reflect.DeepEqual(*requested, expected) {
+		t.Errorf("expected pages %v but %v", expected, *requested)
+	}
+}
+
+func TestPager_zeroBased(t *testing.T) {
+	items := sequence(15)
+	page, requested := pages(items, 10, 0, true)
+
+	res, err := Pager[int]{Page: page, PageSize: 10, ZeroBased: true}.All(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !reflect.DeepEqual(res, items) {
+		t.Errorf("expected all the %d items but %d", len(items), len(res))
+	}
+	if expected := []int32{0, 1}; !reflect.DeepEqual(*requested, expected) {
+		t.Errorf("expected pages %v but %v", expected, *requested)
+	}
+}
+
+func TestPager_itemsStop(t *testing.T) {
+	page, requested := pages(sequence(250), 100, 1, true)
+
+	var res []int
+	for item, err := range (Pager[int]{Page: page}).Items(context.Background()) {
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if item == 5 {
+			break
+		}
+		res = append(res, item)
+	}
+	if len(res) != 5 || len(*requested) != 1 {
+		t.Errorf("expected 5 items of the first page but %v of pages %v", res, *requested)
+	}
+}
+
+func TestAll_error(t *testing.T) {
+	pageErr := errors.New("page error")
+	_, err := All(context.Background(), func(ctx context.Context, pageNo, pageSize int32) ([]int, *int32, error) {
+		if pageNo == 2 {
+			return nil, nil, pageErr
+		}
+		return sequence(int(pageSize)), nil, nil
+	})
+	if !errors.Is(err, pageErr) {
+		t.Errorf("expected the error of the page but %v", err)
+	}
+}
+
+func TestAll_canceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	_, err := All(ctx, func(ctx context.Context, pageNo, pageSize int32) ([]int, *int32, error) {
+		cancel()
+		return sequence(int(pageSize)), nil, nil
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("expected the context to be canceled but %v", err)
+	}
+}
+
+func collectByToken[T any](page TokenPageFunc[T]) ([]T, error) {
+	var items []T
+	for item, err := range ItemsByToken(context.Background(), page) {
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+func TestItemsByToken(t *testing.T) {
+	tokens := map[string]string{"": "a", "a": "b", "b": ""}
+
+	var requested []string
+	res, err := collectByToken(func(ctx context.Context, token *string) ([]string, *string, error) {
+		current := ""
+		if token != nil {
+			current = *token
+		}
+		requested = append(requested, current)
+
+		next := tokens[current]
+		return []string{"item-" + current}, &next, nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if expected := []string{"item-", "item-a", "item-b"}; !reflect.DeepEqual(res, expected) {
+		t.Errorf("expected %v but %v", expected, res)
+	}
+	if len(requested) != 3 {
+		t.Errorf("expected 3 pages but %v", requested)
+	}
+}
+
+func TestItemsByToken_repeatedToken(t *testing.T) {
+	calls := 0
+	res, err := collectByToken(func(ctx context.Context, token *string) ([]int, *string, error) {
+		calls++
+		next := "same"
+		return []int{calls}, &next, nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if calls != 2 || len(res) != 2 {
+		t.Errorf("expected the listing to stop at the repeated token but %d pages", calls)
+	}
+}
@@ -0,0 +1,90 @@
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
+)
+
+// ncpErrorDetailServer adds the return code and request id of NCP error responses to the diagnostics of
+// both the SDKv2 and the framework resources and data sources, which only report the message of the errors.
+type ncpErrorDetailServer struct {
+	tfprotov6.ProviderServerWithResourceIdentity
+}
+
+// WithNcpErrorDetails wraps the muxed provider server to detail the diagnostics of NCP errors
+func WithNcpErrorDetails(server tfprotov6.ProviderServer) tfprotov6.ProviderServer {
+	s, ok := server.(tfprotov6.ProviderServerWithResourceIdentity)
+	if !ok {
+		return server
+	}
+	return &ncpErrorDetailServer{s}
+}
+
+func (s *ncpErrorDetailServer) ReadResource(ctx context.Context, req *tfprotov6.ReadResourceRequest) (*tfprotov6.ReadResourceResponse, error) {
+	resp, err := s.ProviderServerWithResourceIdentity.ReadResource(ctx, req)
+	if resp != nil {
+		detailNcpErrors(resp.Diagnostics)
+	}
+	return resp, err
+}
+
+func (s *ncpErrorDetailServer) PlanResourceChange(ctx context.Context, req *tfprotov6.PlanResourceChangeRequest) (*tfprotov6.PlanResourceChangeResponse, error) {
+	resp, err := s.ProviderServerWithResourceIdentity.PlanResourceChange(ctx, req)
+	if resp != nil {
+		detailNcpErrors(resp.Diagnostics)
+	}
+	return resp, err
+}
+
+func (s *ncpErrorDetailServer) ApplyResourceChange(ctx context.Context, req *tfprotov6.ApplyResourceChangeRequest) (*tfprotov6.ApplyResourceChangeResponse, error) {
+	resp, err := s.ProviderServerWithResourceIdentity.ApplyResourceChange(ctx, req)
+	if resp != nil {
+		detailNcpErrors(resp.Diagnostics)
+	}
+	return resp, err
+}
+
+func (s *ncpErrorDetailServer) ImportResourceState(ctx context.Context, req *tfprotov6.ImportResourceStateRequest) (*tfprotov6.ImportResourceStateResponse, error) {
+	resp, err := s.ProviderServerWithResourceIdentity.ImportResourceState(ctx, req)
+	if resp != nil {
+		detailNcpErrors(resp.Diagnostics)
+	}
+	return resp, err
+}
+
+func (s *ncpErrorDetailServer) ReadDataSource(ctx context.Context, req *tfprotov6.ReadDataSourceRequest) (*tfprotov6.ReadDataSourceResponse, error) {
+	resp, err := s.ProviderServerWithResourceIdentity.ReadDataSource(ctx, req)
+	if resp != nil {
+		detailNcpErrors(resp.Diagnostics)
+	}
+	return resp, err
+}
+
+// detailNcpErrors appends the details of the NCP error responses found in the messages of error diagnostics.
+// SDKv2 resources report errors in the summary, the framework ones in the detail.
+func detailNcpErrors(diags []*tfprotov6.Diagnostic) {
+	for _, d := range diags {
+		if d == nil || d.Severity != tfprotov6.DiagnosticSeverityError {
+			continue
+		}
+
+		e, ok := common.ParseNcpErrorMessage(d.Summary + "\n" + d.Detail)
+		if !ok {
+			continue
+		}
+
+		detail := e.Detail()
+		if detail == "" || strings.Contains(d.Detail, detail) {
+			continue
+		}
+
+		if d.Detail != "" {
+			d.Detail += "\n\n"
+		}
+		d.Detail += detail
+	}
+}
@@ -38,5 +38,11 @@ func ProtoV6ProviderServerFactory(ctx context.Context) (func() tfprotov6.Provide
 		return nil, nil, err
 	}
 
-	return muxServer.ProviderServer, primary, nil
+	if err := RegisterSensitiveAttributes(ctx, muxServer.ProviderServer()); err != nil {
+		return nil, nil, err
+	}
+
+	return func() tfprotov6.ProviderServer {
+		return WithNcpErrorDetails(muxServer.ProviderServer())
+	}, primary, nil
 }
@@ -2,6 +2,7 @@ package fwprovider
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/service/hadoop"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/service/loadbalancer"
@@ -9,15 +10,22 @@ import (
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/service/postgresql"
 
 	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	ncloudfunction "github.com/terraform-providers/terraform-provider-ncloud/internal/function"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/service/mongodb"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/service/mssql"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/service/mysql"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/service/nks"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/service/redis"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/service/server"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/service/vpc"
@@ -29,6 +37,11 @@ func New(primary interface{ Meta() interface{} }) provider.Provider {
 	}
 }
 
+var (
+	_ provider.ProviderWithEphemeralResources = &fwprovider{}
+	_ provider.ProviderWithFunctions          = &fwprovider{}
+)
+
 type fwprovider struct {
 	Primary interface{ Meta() interface{} }
 }
@@ -44,6 +57,23 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Optional:    true,
 				Description: "Access key of ncloud",
 			},
+			"default_tags": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Tags added to every resource supporting tags",
+			},
+			"max_retries": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Maximum number of retries of a throttled, failed or busy-object API request",
+			},
+			"profile": schema.StringAttribute{
+				Optional:    true,
+				Description: "Profile of the shared credentials file",
+			},
+			"rate_limit": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Maximum number of API requests per second. 0 means unlimited",
+			},
 			"region": schema.StringAttribute{
 				Optional:    true,
 				Description: "Region of ncloud",
@@ -52,6 +82,10 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Optional:    true,
 				Description: "Secret key of ncloud",
 			},
+			"shared_credentials_file": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path of the shared credentials file (default: ~/.ncloud/configure)",
+			},
 			"site": schema.StringAttribute{
 				Optional:    true,
 				Description: "Site of ncloud (public / gov / fin)",
@@ -61,7 +95,55 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Description: "Support VPC platform",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"endpoints": schema.ListNestedBlock{
+				Description: "Custom endpoints of ncloud services",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: endpointsAttributes(),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+			},
+			"assume_role": schema.ListNestedBlock{
+				Description: "Sub account role to assume with temporary credentials",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"role_nrn": schema.StringAttribute{
+							Required:    true,
+							Description: "NRN of the role to assume",
+						},
+						"session_name": schema.StringAttribute{
+							Optional:    true,
+							Description: "Session name of the assumed role",
+						},
+						"duration": schema.StringAttribute{
+							Optional:    true,
+							Description: "Lifetime of the temporary credentials (e.g. 1h)",
+						},
+						"endpoint": schema.StringAttribute{
+							Optional:    true,
+							Description: "Token endpoint that issues the temporary credentials",
+						},
+					},
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+			},
+		},
+	}
+}
+
+func endpointsAttributes() map[string]schema.Attribute {
+	endpoints := map[string]schema.Attribute{}
+	for _, service := range conn.EndpointServices {
+		endpoints[service] = schema.StringAttribute{
+			Optional:    true,
+			Description: fmt.Sprintf("Use this to override the default endpoint URL of %s", service),
+		}
 	}
+	return endpoints
 }
 
 func (p *fwprovider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
@@ -69,6 +151,25 @@ func (p *fwprovider) Configure(ctx context.Context, req provider.ConfigureReques
 
 	resp.DataSourceData = providerConfig
 	resp.ResourceData = providerConfig
+	resp.EphemeralResourceData = providerConfig
+}
+
+func (p *fwprovider) Functions(ctx context.Context) []func() function.Function {
+	return []func() function.Function{
+		ncloudfunction.NewObjectStorageEndpointFunction,
+		ncloudfunction.NewParseIdFunction,
+		ncloudfunction.NewSubnetCidrFunction,
+		ncloudfunction.NewZoneRegionFunction,
+	}
+}
+
+func (p *fwprovider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	var ephemeralResources []func() ephemeral.EphemeralResource
+
+	ephemeralResources = append(ephemeralResources, nks.NewNKSKubeConfigEphemeralResource)
+	ephemeralResources = append(ephemeralResources, server.NewRootPasswordEphemeralResource)
+
+	return ephemeralResources
 }
 
 func (p *fwprovider) DataSources(ctx context.Context) []func() datasource.DataSource {
@@ -83,6 +184,7 @@ func (p *fwprovider) DataSources(ctx context.Context) []func() datasource.DataSo
 	dataSources = append(dataSources, vpc.NewVpcPeeringDataSource)
 	dataSources = append(dataSources, server.NewInitScriptDataSource)
 	dataSources = append(dataSources, server.NewLoginKeyDataSource)
+	dataSources = append(dataSources, server.NewPlacementGroupDataSource)
 	dataSources = append(dataSources, server.NewServerImageNumbersDataSource)
 	dataSources = append(dataSources, server.NewServerSpecsDataSource)
 	dataSources = append(dataSources, mysql.NewMysqlDataSource)
@@ -132,8 +234,11 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 	resources = append(resources, vpc.NewSubnetResource)
 	resources = append(resources, vpc.NewNatGatewayResource)
 	resources = append(resources, vpc.NewVpcPeeringResource)
+	resources = append(resources, vpc.NewNetworkACLDenyAllowGroupResource)
 	resources = append(resources, server.NewLoginKeyResource)
 	resources = append(resources, server.NewInitScriptResource)
+	resources = append(resources, server.NewPlacementGroupResource)
+	resources = append(resources, server.NewAccessControlGroupRuleResource)
 	resources = append(resources, mysql.NewMysqlResource)
 	resources = append(resources, mysql.NewMysqlUsersResource)
 	resources = append(resources, mysql.NewMysqlRecoveryResource)
@@ -150,6 +255,7 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 	resources = append(resources, postgresql.NewPostgresqlDatabasesResource)
 	resources = append(resources, postgresql.NewPostgresqlUsersResource)
 	resources = append(resources, loadbalancer.NewLbResource)
+	resources = append(resources, loadbalancer.NewLbListenerResource)
 	resources = append(resources, objectstorage.NewBucketResource)
 	resources = append(resources, objectstorage.NewObjectResource)
 	resources = append(resources, objectstorage.NewObjectACLResource)
@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
+)
+
+// RegisterSensitiveAttributes masks the attributes flagged Sensitive in the schemas of the resources, data sources
+// and ephemeral resources, of both the SDKv2 and the framework providers, in the logs of the NCP API requests.
+// The attributes are masked by name in the logs of every service, e.g. the `source` of ncloud_objectstorage_object
+// masks the `source` of the DevTools requests as well.
+func RegisterSensitiveAttributes(ctx context.Context, server tfprotov6.ProviderServer) error {
+	resp, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		return err
+	}
+	for _, d := range resp.Diagnostics {
+		if d.Severity == tfprotov6.DiagnosticSeverityError {
+			return fmt.Errorf("%s: %s", d.Summary, d.Detail)
+		}
+	}
+
+	var names []string
+	for _, s := range resp.ResourceSchemas {
+		names = appendSensitiveAttributes(names, s.Block)
+	}
+	for _, s := range resp.DataSourceSchemas {
+		names = appendSensitiveAttributes(names, s.Block)
+	}
+	for _, s := range resp.EphemeralResourceSchemas {
+		names = appendSensitiveAttributes(names, s.Block)
+	}
+	common.RegisterSensitiveAttributes(names...)
+
+	return nil
+}
+
+func appendSensitiveAttributes(names []string, block *tfprotov6.SchemaBlock) []string {
+	if block == nil {
+		return names
+	}
+
+	for _, a := range block.Attributes {
+		names = appendSensitiveAttribute(names, a)
+	}
+	for _, b := range block.BlockTypes {
+		names = appendSensitiveAttributes(names, b.Block)
+	}
+	return names
+}
+
+func appendSensitiveAttribute(names []string, a *tfprotov6.SchemaAttribute) []string {
+	if a.Sensitive {
+		names = append(names, a.Name)
+	}
+	if a.NestedType != nil {
+		for _, nested := range a.NestedType.Attributes {
+			names = appendSensitiveAttribute(names, nested)
+		}
+	}
+	return names
+}
@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
+)
+
+type schemaProviderServer struct {
+	tfprotov6.ProviderServer
+	resp *tfprotov6.GetProviderSchemaResponse
+}
+
+func (s schemaProviderServer) GetProviderSchema(ctx context.Context, req *tfprotov6.GetProviderSchemaRequest) (*tfprotov6.GetProviderSchemaResponse, error) {
+	return s.resp, nil
+}
+
+func TestRegisterSensitiveAttributes(t *testing.T) {
+	server := schemaProviderServer{resp: &tfprotov6.GetProviderSchemaResponse{
+		EphemeralResourceSchemas: map[string]*tfprotov6.Schema{
+			"ncloud_test_ephemeral": {Block: &tfprotov6.SchemaBlock{
+				Attributes: []*tfprotov6.SchemaAttribute{
+					{Name: "test_ephemeral_id"},
+					{Name: "test_ephemeral_secret", Sensitive: true},
+				},
+			}},
+		},
+		DataSourceSchemas: map[string]*tfprotov6.Schema{
+			"ncloud_test_data_source": {Block: &tfprotov6.SchemaBlock{
+				BlockTypes: []*tfprotov6.SchemaNestedBlock{
+					{TypeName: "test_block", Block: &tfprotov6.SchemaBlock{
+						Attributes: []*tfprotov6.SchemaAttribute{{Name: "test_nested_secret", Sensitive: true}},
+					}},
+				},
+			}},
+		},
+	}}
+
+	if err := RegisterSensitiveAttributes(context.Background(), server); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	for _, name := range []string{"test_ephemeral_secret", "testEphemeralSecret", "test_nested_secret"} {
+		if !common.IsSensitiveAttribute(name) {
+			t.Errorf("expected %s to be masked", name)
+		}
+	}
+	if common.IsSensitiveAttribute("test_ephemeral_id") {
+		t.Errorf("expected test_ephemeral_id not to be masked")
+	}
+}
@@ -4,10 +4,14 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"time"
 
+	multierror "github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/region"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/service/autoscaling"
@@ -23,6 +27,9 @@ import (
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/zone"
 )
 
+// New returns the SDKv2 provider, muxed with the framework provider of package fwprovider.
+// Its resources and data sources are being ported to fwprovider, with state upgraders from their SDKv2 schema;
+// the ported ones are removed from the maps below.
 func New(ctx context.Context) *schema.Provider {
 	dataSourceMap := map[string]*schema.Resource{
 		"ncloud_access_control_group":                    server.DataSourceNcloudAccessControlGroup(),
@@ -62,7 +69,6 @@ func New(ctx context.Context) *schema.Provider {
 		"ncloud_nks_server_images":                       nks.DataSourceNcloudNKSServerImages(),
 		"ncloud_nks_server_products":                     nks.DataSourceNcloudNKSServerProducts(),
 		"ncloud_nks_versions":                            nks.DataSourceNcloudNKSVersions(),
-		"ncloud_placement_group":                         server.DataSourceNcloudPlacementGroup(),
 		"ncloud_port_forwarding_rule":                    server.DataSourceNcloudPortForwardingRule(),
 		"ncloud_port_forwarding_rules":                   server.DataSourceNcloudPortForwardingRules(),
 		"ncloud_public_ip":                               server.DataSourceNcloudPublicIp(),
@@ -102,30 +108,28 @@ func New(ctx context.Context) *schema.Provider {
 	}
 
 	resourceMap := map[string]*schema.Resource{
-		"ncloud_access_control_group_rule":           server.ResourceNcloudAccessControlGroupRule(),
 		"ncloud_access_control_group":                server.ResourceNcloudAccessControlGroup(),
 		"ncloud_auto_scaling_group":                  autoscaling.ResourceNcloudAutoScalingGroup(),
 		"ncloud_auto_scaling_policy":                 autoscaling.ResourceNcloudAutoScalingPolicy(),
 		"ncloud_auto_scaling_schedule":               autoscaling.ResourceNcloudAutoScalingSchedule(),
 		"ncloud_block_storage_snapshot":              server.ResourceNcloudBlockStorageSnapshot(),
 		"ncloud_block_storage":                       server.ResourceNcloudBlockStorage(),
+		"ncloud_block_storage_attachment":            server.ResourceNcloudBlockStorageAttachment(),
 		"ncloud_cdss_cluster":                        cdss.ResourceNcloudCDSSCluster(),
 		"ncloud_cdss_config_group":                   cdss.ResourceNcloudCDSSConfigGroup(),
 		"ncloud_launch_configuration":                autoscaling.ResourceNcloudLaunchConfiguration(),
-		"ncloud_lb_listener":                         loadbalancer.ResourceNcloudLbListener(),
 		"ncloud_lb_target_group_attachment":          loadbalancer.ResourceNcloudLbTargetGroupAttachment(),
 		"ncloud_lb_target_group":                     loadbalancer.ResourceNcloudLbTargetGroup(),
 		"ncloud_load_balancer_ssl_certificate":       classicloadbalancer.ResourceNcloudLoadBalancerSSLCertificate(),
 		"ncloud_load_balancer":                       classicloadbalancer.ResourceNcloudLoadBalancer(),
 		"ncloud_nas_volume":                          nasvolume.ResourceNcloudNasVolume(),
 		"ncloud_network_acl":                         vpc.ResourceNcloudNetworkACL(),
-		"ncloud_network_acl_deny_allow_group":        vpc.ResourceNcloudNetworkACLDenyAllowGroup(),
 		"ncloud_network_acl_rule":                    vpc.ResourceNcloudNetworkACLRule(),
 		"ncloud_network_interface":                   server.ResourceNcloudNetworkInterface(),
 		"ncloud_nks_cluster":                         nks.ResourceNcloudNKSCluster(),
 		"ncloud_nks_node_pool":                       nks.ResourceNcloudNKSNodePool(),
-		"ncloud_placement_group":                     server.ResourceNcloudPlacementGroup(),
 		"ncloud_port_forwarding_rule":                server.ResourceNcloudPortForwadingRule(),
+		"ncloud_member_server_image":                 server.ResourceNcloudMemberServerImage(),
 		"ncloud_public_ip":                           server.ResourceNcloudPublicIpInstance(),
 		"ncloud_route":                               vpc.ResourceNcloudRoute(),
 		"ncloud_route_table":                         vpc.ResourceNcloudRouteTable(),
@@ -155,6 +159,70 @@ func SchemaMap() map[string]*schema.Schema {
 			Optional:    true,
 			Description: "Access key of ncloud",
 		},
+		"assume_role": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Description: "Sub account role to assume with temporary credentials",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"role_nrn": {
+						Type:        schema.TypeString,
+						Required:    true,
+						Description: "NRN of the role to assume",
+					},
+					"session_name": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "Session name of the assumed role",
+					},
+					"duration": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "Lifetime of the temporary credentials (e.g. 1h)",
+					},
+					"endpoint": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "Token endpoint that issues the temporary credentials",
+					},
+				},
+			},
+		},
+		"default_tags": {
+			Type:        schema.TypeMap,
+			Optional:    true,
+			Description: "Tags added to every resource supporting tags",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"endpoints": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Description: "Custom endpoints of ncloud services",
+			Elem: &schema.Resource{
+				Schema: endpointsSchema(),
+			},
+		},
+		"max_retries": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      conn.DefaultMaxRetries,
+			Description:  "Maximum number of retries of a throttled, failed or busy-object API request",
+			ValidateFunc: validation.IntAtLeast(0),
+		},
+		"profile": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Profile of the shared credentials file",
+		},
+		"rate_limit": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      conn.DefaultRateLimit,
+			Description:  "Maximum number of API requests per second. 0 means unlimited",
+			ValidateFunc: validation.IntAtLeast(0),
+		},
 		"region": {
 			Type:        schema.TypeString,
 			Optional:    true,
@@ -165,6 +233,11 @@ func SchemaMap() map[string]*schema.Schema {
 			Optional:    true,
 			Description: "Secret key of ncloud",
 		},
+		"shared_credentials_file": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Path of the shared credentials file (default: ~/.ncloud/configure)",
+		},
 		"site": {
 			Type:        schema.TypeString,
 			Optional:    true,
@@ -179,7 +252,7 @@ func SchemaMap() map[string]*schema.Schema {
 }
 
 func ProviderConfigure(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
-	providerConfig := conn.ProviderConfig{
+	providerConfig := &conn.ProviderConfig{
 		SupportVPC: true,
 	}
 
@@ -190,13 +263,10 @@ func ProviderConfigure(ctx context.Context, d *schema.ResourceData) (interface{}
 	// Set site
 	if site, ok := getOrFromEnv(d, "site", "NCLOUD_SITE"); ok {
 		providerConfig.Site = site.(string)
+	}
 
-		switch site {
-		case "gov":
-			os.Setenv("NCLOUD_API_GW", "https://ncloud.apigw.gov-ntruss.com")
-		case "fin":
-			os.Setenv("NCLOUD_API_GW", "https://fin-ncloud.apigw.fin-ntruss.com")
-		}
+	if v, ok := d.GetOk("default_tags"); ok {
+		providerConfig.DefaultTags = common.ExpandTags(v.(map[string]interface{}))
 	}
 
 	// Fin only supports VPC
@@ -204,14 +274,22 @@ func ProviderConfigure(ctx context.Context, d *schema.ResourceData) (interface{}
 		providerConfig.SupportVPC = true
 	}
 
-	accessKey, ok := getOrFromEnv(d, "access_key", "NCLOUD_ACCESS_KEY")
-	if !ok {
-		return nil, diag.Errorf("missing provider configuration: ACCESS_KEY")
+	credentialsChain := conn.CredentialsChain{
+		AccessKey: d.Get("access_key").(string),
+		SecretKey: d.Get("secret_key").(string),
 	}
-	secretKey, ok := getOrFromEnv(d, "secret_key", "NCLOUD_SECRET_KEY")
-	if !ok {
-		return nil, diag.Errorf("missing provider configuration: SECRET_KEY")
+	if profile, ok := getOrFromEnv(d, "profile", "NCLOUD_PROFILE"); ok {
+		credentialsChain.Profile = profile.(string)
 	}
+	if file, ok := getOrFromEnv(d, "shared_credentials_file", "NCLOUD_SHARED_CREDENTIALS_FILE"); ok {
+		credentialsChain.SharedCredentialsFile = file.(string)
+	}
+
+	credentials, err := credentialsChain.Retrieve()
+	if err != nil {
+		return nil, credentialsDiagnostics(err)
+	}
+
 	region, ok := getOrFromEnv(d, "region", "NCLOUD_REGION")
 	if !ok {
 		return nil, diag.Errorf("missing provider configuration: REGION")
@@ -219,30 +297,52 @@ func ProviderConfigure(ctx context.Context, d *schema.ResourceData) (interface{}
 
 	// Set client
 	config := conn.Config{
-		AccessKey: accessKey.(string),
-		SecretKey: secretKey.(string),
+		AccessKey: credentials.AccessKey,
+		SecretKey: credentials.SecretKey,
 		Region:    region.(string),
+		Site:      providerConfig.Site,
+
+		MaxRetries:           d.Get("max_retries").(int),
+		RateLimit:            float64(d.Get("rate_limit").(int)),
+		RetryableReturnCodes: conn.DefaultRetryableReturnCodes,
+		Transport:            conn.TransportFromContext(ctx),
 	}
 
-	// Set endpoint (only for debugging)
-	obs_endpoint := os.Getenv("NCLOUD_OBS_ENDPOINT")
+	if v, ok := d.GetOk("assume_role"); ok && v.([]interface{})[0] != nil {
+		assumeRole, err := expandAssumeRole(v.([]interface{})[0].(map[string]interface{}))
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+		config.AssumeRole = assumeRole
+	}
+
+	config.Endpoints = map[string]string{}
+	if v, ok := d.GetOk("endpoints"); ok && v.([]interface{})[0] != nil {
+		for service, endpoint := range v.([]interface{})[0].(map[string]interface{}) {
+			config.Endpoints[service] = endpoint.(string)
+		}
+	}
 
-	if client, err := config.Client(providerConfig.Site, obs_endpoint); err != nil {
+	// Set object storage endpoint from env (only for debugging)
+	if config.Endpoints["objectstorage"] == "" {
+		config.Endpoints["objectstorage"] = os.Getenv("NCLOUD_OBS_ENDPOINT")
+	}
+
+	if client, err := config.Client(); err != nil {
 		return nil, diag.FromErr(err)
 	} else {
 		providerConfig.Client = client
 	}
 
 	// Set region
-	if err := conn.SetRegionCache(providerConfig.Client, providerConfig.SupportVPC); err != nil {
+	if err := conn.SetRegionCache(ctx, providerConfig); err != nil {
 		return nil, diag.FromErr(err)
 	}
 
-	if conn.IsValidRegionCode(region.(string)) {
-		os.Setenv("NCLOUD_REGION", region.(string))
+	if conn.IsValidRegionCode(providerConfig, region.(string)) {
 		providerConfig.RegionCode = region.(string)
 		if !providerConfig.SupportVPC {
-			providerConfig.RegionNo = *conn.GetRegionNoByCode(region.(string))
+			providerConfig.RegionNo = *conn.GetRegionNoByCode(providerConfig, region.(string))
 		}
 	} else {
 		return nil, []diag.Diagnostic{
@@ -253,7 +353,57 @@ func ProviderConfigure(ctx context.Context, d *schema.ResourceData) (interface{}
 		}
 	}
 
-	return &providerConfig, nil
+	return providerConfig, nil
+}
+
+func endpointsSchema() map[string]*schema.Schema {
+	endpoints := map[string]*schema.Schema{}
+	for _, service := range conn.EndpointServices {
+		endpoints[service] = &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			Description:  fmt.Sprintf("Use this to override the default endpoint URL of %s", service),
+			ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+		}
+	}
+	return endpoints
+}
+
+func expandAssumeRole(m map[string]interface{}) (*conn.AssumeRole, error) {
+	assumeRole := &conn.AssumeRole{
+		RoleNrn:     m["role_nrn"].(string),
+		SessionName: m["session_name"].(string),
+		Endpoint:    m["endpoint"].(string),
+	}
+
+	if v := m["duration"].(string); v != "" {
+		duration, err := time.ParseDuration(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid assume_role.duration `%s`: %w", v, err)
+		}
+		assumeRole.Duration = duration
+	}
+
+	return assumeRole, nil
+}
+
+func credentialsDiagnostics(err error) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	merr, ok := err.(*multierror.Error)
+	if !ok {
+		return diag.FromErr(err)
+	}
+
+	for _, e := range merr.Errors {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "missing provider credentials",
+			Detail:   e.Error(),
+		})
+	}
+
+	return diags
 }
 
 func getOrFromEnv(d *schema.ResourceData, name, env string) (any, bool) {
@@ -84,8 +84,10 @@ func dataSourceNcloudRegionsRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func getClassicRegions(d *schema.ResourceData, config *conn.ProviderConfig) ([]*conn.Region, error) {
-	client := config.Client
-	resp, err := client.Server.V2Api.GetRegionList(&server.GetRegionListRequest{})
+	reqParams := &server.GetRegionListRequest{}
+	resp, err := conn.CachedLookup(config, conn.CatalogKey("server.GetRegionList", reqParams), func() (*server.GetRegionListResponse, error) {
+		return config.Client.Server.V2Api.GetRegionList(reqParams)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -104,8 +106,10 @@ func getClassicRegions(d *schema.ResourceData, config *conn.ProviderConfig) ([]*
 }
 
 func getVpcRegions(d *schema.ResourceData, config *conn.ProviderConfig) ([]*conn.Region, error) {
-	client := config.Client
-	resp, err := client.Vserver.V2Api.GetRegionList(&vserver.GetRegionListRequest{})
+	reqParams := &vserver.GetRegionListRequest{}
+	resp, err := conn.CachedLookup(config, conn.CatalogKey("vserver.GetRegionList", reqParams), func() (*vserver.GetRegionListResponse, error) {
+		return config.Client.Vserver.V2Api.GetRegionList(reqParams)
+	})
 	if err != nil {
 		return nil, err
 	}
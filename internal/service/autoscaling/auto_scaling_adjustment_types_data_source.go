@@ -1,7 +1,10 @@
 package autoscaling
 
 import (
+	"context"
+
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vautoscaling"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
@@ -9,7 +12,7 @@ import (
 
 func DataSourceNcloudAutoScalingAdjustmentTypes() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNcloudAutoScalingAdjustmentTypesRead,
+		ReadContext: dataSourceNcloudAutoScalingAdjustmentTypesRead,
 
 		Schema: map[string]*schema.Schema{
 			"types": {
@@ -33,13 +36,13 @@ func DataSourceNcloudAutoScalingAdjustmentTypes() *schema.Resource {
 	}
 }
 
-func dataSourceNcloudAutoScalingAdjustmentTypesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceNcloudAutoScalingAdjustmentTypesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*conn.ProviderConfig)
 
-	resources, err := getAutoScalingAdjustmentListFiltered(d, config)
+	resources, err := getAutoScalingAdjustmentListFiltered(ctx, d, config)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	types := make([]map[string]interface{}, len(resources))
@@ -56,14 +59,14 @@ func dataSourceNcloudAutoScalingAdjustmentTypesRead(d *schema.ResourceData, meta
 	return nil
 }
 
-func getAutoScalingAdjustmentListFiltered(d *schema.ResourceData, config *conn.ProviderConfig) ([]map[string]interface{}, error) {
+func getAutoScalingAdjustmentListFiltered(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) ([]map[string]interface{}, error) {
 	var resources []map[string]interface{}
 	var err error
 
 	if config.SupportVPC {
-		resources, err = getVpcAutoScalingAdjustmentTypeList(config)
+		resources, err = getVpcAutoScalingAdjustmentTypeList(ctx, config)
 	} else {
-		resources, err = getClassicAutoScalingAdjustmentTypeList(config)
+		resources, err = getClassicAutoScalingAdjustmentTypeList(ctx, config)
 	}
 
 	if err != nil {
@@ -76,7 +79,7 @@ func getAutoScalingAdjustmentListFiltered(d *schema.ResourceData, config *conn.P
 	return resources, nil
 }
 
-func getVpcAutoScalingAdjustmentTypeList(config *conn.ProviderConfig) ([]map[string]interface{}, error) {
+func getVpcAutoScalingAdjustmentTypeList(ctx context.Context, config *conn.ProviderConfig) ([]map[string]interface{}, error) {
 	client := config.Client
 	regionCode := config.RegionCode
 
@@ -84,15 +87,15 @@ func getVpcAutoScalingAdjustmentTypeList(config *conn.ProviderConfig) ([]map[str
 		RegionCode: &regionCode,
 	}
 
-	LogCommonRequest("GetAdjustmentTypeListRequest", reqParams)
+	ctx = LogCommonRequest(ctx, "GetAdjustmentTypeListRequest", reqParams)
 
 	resp, err := client.Vautoscaling.V2Api.GetAdjustmentTypeList(reqParams)
 	if err != nil {
-		LogErrorResponse("GetAdjustmentTypeListRequest", err, reqParams)
+		LogErrorResponse(ctx, "GetAdjustmentTypeListRequest", err, reqParams)
 		return nil, err
 	}
 
-	LogResponse("GetAdjustmentTypeListRequest", resp)
+	LogResponse(ctx, "GetAdjustmentTypeListRequest", resp)
 
 	var resources []map[string]interface{}
 
@@ -107,7 +110,7 @@ func getVpcAutoScalingAdjustmentTypeList(config *conn.ProviderConfig) ([]map[str
 	return resources, nil
 }
 
-func getClassicAutoScalingAdjustmentTypeList(config *conn.ProviderConfig) ([]map[string]interface{}, error) {
+func getClassicAutoScalingAdjustmentTypeList(ctx context.Context, config *conn.ProviderConfig) ([]map[string]interface{}, error) {
 	client := config.Client
 	regionCode := config.RegionCode
 
@@ -115,15 +118,15 @@ func getClassicAutoScalingAdjustmentTypeList(config *conn.ProviderConfig) ([]map
 		RegionCode: &regionCode,
 	}
 
-	LogCommonRequest("GetAdjustmentTypeListRequest", reqParams)
+	ctx = LogCommonRequest(ctx, "GetAdjustmentTypeListRequest", reqParams)
 
 	resp, err := client.Vautoscaling.V2Api.GetAdjustmentTypeList(reqParams)
 	if err != nil {
-		LogErrorResponse("GetAdjustmentTypeListRequest", err, reqParams)
+		LogErrorResponse(ctx, "GetAdjustmentTypeListRequest", err, reqParams)
 		return nil, err
 	}
 
-	LogResponse("GetAdjustmentTypeListRequest", resp)
+	LogResponse(ctx, "GetAdjustmentTypeListRequest", resp)
 
 	var resources []map[string]interface{}
 
@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/acctest"
 )
@@ -13,8 +12,8 @@ func TestAccDataSourceNcloudAutoScalingAdjustmentTypes_classic_basic(t *testing.
 	// Images are all deprecated in Classic
 	t.Skip()
 
-	lcName := fmt.Sprintf("lc-%s", acctest.RandString(5))
-	policyName := fmt.Sprintf("policy-%s", acctest.RandString(5))
+	lcName := fmt.Sprintf("lc-%s", RandString(t, 5))
+	policyName := fmt.Sprintf("policy-%s", RandString(t, 5))
 	dataName := "data.ncloud_auto_scaling_adjustment_types.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -1,6 +1,7 @@
 package autoscaling
 
 import (
+	"context"
 	"fmt"
 
 	"strings"
@@ -9,6 +10,7 @@ import (
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/autoscaling"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vautoscaling"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -17,14 +19,15 @@ import (
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/service/vpc"
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/verify"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/waiter"
 )
 
 func ResourceNcloudAutoScalingGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNcloudAutoScalingGroupCreate,
-		Read:   resourceNcloudAutoScalingGroupRead,
-		Update: resourceNcloudAutoScalingGroupUpdate,
-		Delete: resourceNcloudAutoScalingGroupDelete,
+		CreateContext: resourceNcloudAutoScalingGroupCreate,
+		ReadContext:   resourceNcloudAutoScalingGroupRead,
+		UpdateContext: resourceNcloudAutoScalingGroupUpdate,
+		DeleteContext: resourceNcloudAutoScalingGroupDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -135,31 +138,31 @@ func ResourceNcloudAutoScalingGroup() *schema.Resource {
 	}
 }
 
-func resourceNcloudAutoScalingGroupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudAutoScalingGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*conn.ProviderConfig)
 
-	id, err := createAutoScalingGroup(d, config)
+	id, err := createAutoScalingGroup(ctx, d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(ncloud.StringValue(id))
-	if err := waitForAutoScalingGroupCapacity(d, config); err != nil {
-		return err
+	if err := waitForAutoScalingGroupCapacity(ctx, d, config); err != nil {
+		return diag.FromErr(err)
 	}
 
-	return resourceNcloudAutoScalingGroupRead(d, meta)
+	return resourceNcloudAutoScalingGroupRead(ctx, d, meta)
 }
 
-func createAutoScalingGroup(d *schema.ResourceData, config *conn.ProviderConfig) (*string, error) {
+func createAutoScalingGroup(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) (*string, error) {
 	if config.SupportVPC {
-		return createVpcAutoScalingGroup(d, config)
+		return createVpcAutoScalingGroup(ctx, d, config)
 	} else {
-		return createClassicAutoScalingGroup(d, config)
+		return createClassicAutoScalingGroup(ctx, d, config)
 	}
 }
 
-func createVpcAutoScalingGroup(d *schema.ResourceData, config *conn.ProviderConfig) (*string, error) {
+func createVpcAutoScalingGroup(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) (*string, error) {
 
 	if _, ok := d.GetOk("subnet_no"); !ok {
 		return nil, ErrorRequiredArgOnVpc("subnet_no")
@@ -170,7 +173,7 @@ func createVpcAutoScalingGroup(d *schema.ResourceData, config *conn.ProviderConf
 	}
 
 	subnetNo := d.Get("subnet_no").(string)
-	subnet, err := vpc.GetSubnetInstance(config, subnetNo)
+	subnet, err := vpc.GetCachedSubnetInstance(ctx, config, subnetNo)
 	if err != nil {
 		return nil, err
 	}
@@ -204,12 +207,12 @@ func createVpcAutoScalingGroup(d *schema.ResourceData, config *conn.ProviderConf
 	return resp.AutoScalingGroupList[0].AutoScalingGroupNo, nil
 }
 
-func createClassicAutoScalingGroup(d *schema.ResourceData, config *conn.ProviderConfig) (*string, error) {
+func createClassicAutoScalingGroup(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) (*string, error) {
 	if _, ok := d.GetOk("zone_no_list"); !ok {
 		return nil, ErrorRequiredArgOnClassic("zone_no_list")
 	}
 	// TODO : Zero value 핸들링
-	l, err := GetClassicLaunchConfigurationByNo(StringPtrOrNil(d.GetOk("launch_configuration_no")), config)
+	l, err := GetClassicLaunchConfigurationByNo(ctx, StringPtrOrNil(d.GetOk("launch_configuration_no")), config)
 	if err != nil {
 		return nil, err
 	}
@@ -225,22 +228,22 @@ func createClassicAutoScalingGroup(d *schema.ResourceData, config *conn.Provider
 		HealthCheckTypeCode:    StringPtrOrNil(d.GetOk("health_check_type_code")),
 		ZoneNoList:             ExpandStringInterfaceList(d.Get("zone_no_list").([]interface{})),
 	}
-	LogCommonRequest("createClassicAutoScalingGroup", reqParams)
+	ctx = LogCommonRequest(ctx, "createClassicAutoScalingGroup", reqParams)
 	resp, err := config.Client.Autoscaling.V2Api.CreateAutoScalingGroup(reqParams)
 	if err != nil {
-		LogErrorResponse("createClassicAutoScalingGroup", err, reqParams)
+		LogErrorResponse(ctx, "createClassicAutoScalingGroup", err, reqParams)
 		return nil, err
 	}
-	LogResponse("createClassicAutoScalingGroup", resp)
+	LogResponse(ctx, "createClassicAutoScalingGroup", resp)
 	return resp.AutoScalingGroupList[0].AutoScalingGroupNo, nil
 }
 
-func resourceNcloudAutoScalingGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudAutoScalingGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*conn.ProviderConfig)
 
-	autoScalingGroup, err := GetAutoScalingGroup(config, d.Id())
+	autoScalingGroup, err := GetAutoScalingGroup(ctx, config, d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if autoScalingGroup == nil {
@@ -257,44 +260,44 @@ func resourceNcloudAutoScalingGroupRead(d *schema.ResourceData, meta interface{}
 
 	if d.Get("ignore_capacity_changes").(bool) {
 		if err := d.Set("max_size", max_size); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		if err := d.Set("min_size", min_size); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		if err := d.Set("desired_capacity", desired_capacity); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	if err := d.Set("server_instance_no_list", autoScalingGroup.InAutoScalingGroupServerInstanceList); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
 }
 
-func GetAutoScalingGroup(config *conn.ProviderConfig, id string) (*AutoScalingGroup, error) {
+func GetAutoScalingGroup(ctx context.Context, config *conn.ProviderConfig, id string) (*AutoScalingGroup, error) {
 	if config.SupportVPC {
-		return getVpcAutoScalingGroup(config, id)
+		return getVpcAutoScalingGroup(ctx, config, id)
 	} else {
-		return getClassicAutoScalingGroup(config, id)
+		return getClassicAutoScalingGroup(ctx, config, id)
 	}
 }
 
-func getVpcAutoScalingGroup(config *conn.ProviderConfig, id string) (*AutoScalingGroup, error) {
+func getVpcAutoScalingGroup(ctx context.Context, config *conn.ProviderConfig, id string) (*AutoScalingGroup, error) {
 	reqParams := &vautoscaling.GetAutoScalingGroupListRequest{
 		RegionCode:             &config.RegionCode,
 		AutoScalingGroupNoList: []*string{ncloud.String(id)},
 	}
 
-	LogCommonRequest("getVpcAutoScalingGroup", reqParams)
+	ctx = LogCommonRequest(ctx, "getVpcAutoScalingGroup", reqParams)
 	resp, err := config.Client.Vautoscaling.V2Api.GetAutoScalingGroupList(reqParams)
 	if err != nil {
-		LogErrorResponse("getVpcAutoScalingGroup", err, reqParams)
+		LogErrorResponse(ctx, "getVpcAutoScalingGroup", err, reqParams)
 		return nil, err
 	}
-	LogResponse("getVpcAutoScalingGroup", resp)
+	LogResponse(ctx, "getVpcAutoScalingGroup", resp)
 
 	if len(resp.AutoScalingGroupList) < 1 {
 		return nil, nil
@@ -322,19 +325,19 @@ func getVpcAutoScalingGroup(config *conn.ProviderConfig, id string) (*AutoScalin
 	}, nil
 }
 
-func getClassicAutoScalingGroup(config *conn.ProviderConfig, id string) (*AutoScalingGroup, error) {
+func getClassicAutoScalingGroup(ctx context.Context, config *conn.ProviderConfig, id string) (*AutoScalingGroup, error) {
 	no := ncloud.String(id)
 	reqParams := &autoscaling.GetAutoScalingGroupListRequest{
 		RegionNo: &config.RegionNo,
 	}
 
-	LogCommonRequest("getClassicAutoScalingGroup", reqParams)
+	ctx = LogCommonRequest(ctx, "getClassicAutoScalingGroup", reqParams)
 	resp, err := config.Client.Autoscaling.V2Api.GetAutoScalingGroupList(reqParams)
 	if err != nil {
-		LogErrorResponse("getClassicAutoScalingGroup", err, reqParams)
+		LogErrorResponse(ctx, "getClassicAutoScalingGroup", err, reqParams)
 		return nil, err
 	}
-	LogResponse("getClassicAutoScalingGroup", resp)
+	LogResponse(ctx, "getClassicAutoScalingGroup", resp)
 
 	for _, a := range resp.AutoScalingGroupList {
 		if *a.AutoScalingGroupNo == *no {
@@ -359,29 +362,29 @@ func getClassicAutoScalingGroup(config *conn.ProviderConfig, id string) (*AutoSc
 	return nil, nil
 }
 
-func resourceNcloudAutoScalingGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudAutoScalingGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*conn.ProviderConfig)
-	if err := updateAutoScalingGroup(d, config); err != nil {
-		return err
+	if err := updateAutoScalingGroup(ctx, d, config); err != nil {
+		return diag.FromErr(err)
 	}
 
-	if err := waitForAutoScalingGroupCapacity(d, config); err != nil {
-		return err
+	if err := waitForAutoScalingGroupCapacity(ctx, d, config); err != nil {
+		return diag.FromErr(err)
 	}
 
-	return resourceNcloudAutoScalingGroupRead(d, config)
+	return resourceNcloudAutoScalingGroupRead(ctx, d, config)
 }
 
-func updateAutoScalingGroup(d *schema.ResourceData, config *conn.ProviderConfig) error {
+func updateAutoScalingGroup(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) error {
 	if config.SupportVPC {
-		return changeVpcAutoScalingGroup(d, config)
+		return changeVpcAutoScalingGroup(ctx, d, config)
 	} else {
-		return changeClassicAutoScalingGroup(d, config)
+		return changeClassicAutoScalingGroup(ctx, d, config)
 	}
 }
 
-func changeVpcAutoScalingGroup(d *schema.ResourceData, config *conn.ProviderConfig) error {
-	asg, err := GetAutoScalingGroup(config, d.Id())
+func changeVpcAutoScalingGroup(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) error {
+	asg, err := GetAutoScalingGroup(ctx, config, d.Id())
 	if err != nil {
 		return nil
 	}
@@ -426,9 +429,9 @@ func changeVpcAutoScalingGroup(d *schema.ResourceData, config *conn.ProviderConf
 		reqParams.ServerNamePrefix = StringPtrOrNil(d.GetOk("server_name_prefix"))
 	}
 
-	LogCommonRequest("changeVpcAutoScalingGroup", reqParams)
+	ctx = LogCommonRequest(ctx, "changeVpcAutoScalingGroup", reqParams)
 	resp, err := config.Client.Vautoscaling.V2Api.UpdateAutoScalingGroup(reqParams)
-	LogResponse("changeVpcAutoScalingGroup", resp)
+	LogResponse(ctx, "changeVpcAutoScalingGroup", resp)
 	if err != nil {
 		return err
 	}
@@ -436,8 +439,8 @@ func changeVpcAutoScalingGroup(d *schema.ResourceData, config *conn.ProviderConf
 	return nil
 }
 
-func changeClassicAutoScalingGroup(d *schema.ResourceData, config *conn.ProviderConfig) error {
-	asg, err := GetAutoScalingGroup(config, d.Id())
+func changeClassicAutoScalingGroup(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) error {
+	asg, err := GetAutoScalingGroup(ctx, config, d.Id())
 	if err != nil {
 		return err
 	}
@@ -446,7 +449,7 @@ func changeClassicAutoScalingGroup(d *schema.ResourceData, config *conn.Provider
 	}
 
 	if d.HasChange("launch_configuration_no") {
-		launchConfiguration, err := GetClassicLaunchConfigurationByNo(ncloud.String(d.Get("launch_configuration_no").(string)), config)
+		launchConfiguration, err := GetClassicLaunchConfigurationByNo(ctx, ncloud.String(d.Get("launch_configuration_no").(string)), config)
 		if err != nil {
 			return err
 		}
@@ -485,34 +488,34 @@ func changeClassicAutoScalingGroup(d *schema.ResourceData, config *conn.Provider
 		reqParams.ZoneNoList = ExpandStringInterfaceList(d.Get("zone_no_list").([]interface{}))
 	}
 
-	LogCommonRequest("changeClassicAutoScalingGroup", reqParams)
+	ctx = LogCommonRequest(ctx, "changeClassicAutoScalingGroup", reqParams)
 	resp, err := config.Client.Autoscaling.V2Api.UpdateAutoScalingGroup(reqParams)
-	LogResponse("changeClassicAutoScalingGroup", resp)
+	LogResponse(ctx, "changeClassicAutoScalingGroup", resp)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-func resourceNcloudAutoScalingGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudAutoScalingGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*conn.ProviderConfig)
-	if err := deleteAutoScalingGroup(d, config); err != nil {
-		return err
+	if err := deleteAutoScalingGroup(ctx, d, config); err != nil {
+		return diag.FromErr(err)
 	}
 	return nil
 }
 
-func deleteAutoScalingGroup(d *schema.ResourceData, config *conn.ProviderConfig) error {
+func deleteAutoScalingGroup(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) error {
 	d.Timeout(schema.TimeoutDelete)
 	if config.SupportVPC {
-		return deleteVpcAutoScalingGroup(config, d.Id())
+		return deleteVpcAutoScalingGroup(ctx, config, d.Id())
 	} else {
-		return deleteClassicAutoScalingGroup(config, d.Id())
+		return deleteClassicAutoScalingGroup(ctx, config, d.Id())
 	}
 }
 
-func deleteVpcAutoScalingGroup(config *conn.ProviderConfig, id string) error {
-	asg, err := GetAutoScalingGroup(config, id)
+func deleteVpcAutoScalingGroup(ctx context.Context, config *conn.ProviderConfig, id string) error {
+	asg, err := GetAutoScalingGroup(ctx, config, id)
 	if err != nil {
 		return err
 	}
@@ -529,19 +532,19 @@ func deleteVpcAutoScalingGroup(config *conn.ProviderConfig, id string) error {
 		return err
 	}
 
-	if err := waitForVpcInAutoScalingGroupServerInstanceListDeletion(config, id); err != nil {
+	if err := waitForVpcInAutoScalingGroupServerInstanceListDeletion(ctx, config, id); err != nil {
 		return err
 	}
 
-	if err := waitForVpcAutoScalingGroupDeletion(config, id); err != nil {
+	if err := waitForVpcAutoScalingGroupDeletion(ctx, config, id); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func deleteClassicAutoScalingGroup(config *conn.ProviderConfig, id string) error {
-	asg, err := GetAutoScalingGroup(config, id)
+func deleteClassicAutoScalingGroup(ctx context.Context, config *conn.ProviderConfig, id string) error {
+	asg, err := GetAutoScalingGroup(ctx, config, id)
 	if err != nil {
 		return err
 	}
@@ -559,19 +562,19 @@ func deleteClassicAutoScalingGroup(config *conn.ProviderConfig, id string) error
 	}
 
 	// 2. Delete Server Instance List in AutoScalingGroup
-	if err := waitForClassicInAutoScalingGroupServerInstanceListDeletion(config, id); err != nil {
+	if err := waitForClassicInAutoScalingGroupServerInstanceListDeletion(ctx, config, id); err != nil {
 		return err
 	}
 
 	// 3. Delete Auto Scaling Group
-	if err := waitForClassicAutoScalingGroupDeletion(config, ncloud.StringValue(asg.AutoScalingGroupName)); err != nil {
+	if err := waitForClassicAutoScalingGroupDeletion(ctx, config, ncloud.StringValue(asg.AutoScalingGroupName)); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func getVpcInAutoScalingGroupServerInstanceList(config *conn.ProviderConfig, id string) ([]*InAutoScalingGroupServerInstance, error) {
+func getVpcInAutoScalingGroupServerInstanceList(ctx context.Context, config *conn.ProviderConfig, id string) ([]*InAutoScalingGroupServerInstance, error) {
 	reqParams := &vautoscaling.GetAutoScalingGroupListRequest{
 		RegionCode:             &config.RegionCode,
 		AutoScalingGroupNoList: []*string{ncloud.String(id)},
@@ -594,8 +597,8 @@ func getVpcInAutoScalingGroupServerInstanceList(config *conn.ProviderConfig, id
 	return list, nil
 }
 
-func getClassicInAutoScalingGroupServerInstanceList(config *conn.ProviderConfig, id string) ([]*InAutoScalingGroupServerInstance, error) {
-	tmpAsg, err := getClassicAutoScalingGroup(config, id)
+func getClassicInAutoScalingGroupServerInstanceList(ctx context.Context, config *conn.ProviderConfig, id string) ([]*InAutoScalingGroupServerInstance, error) {
+	tmpAsg, err := getClassicAutoScalingGroup(ctx, config, id)
 	if err != nil {
 		return nil, err
 	}
@@ -622,12 +625,12 @@ func getClassicInAutoScalingGroupServerInstanceList(config *conn.ProviderConfig,
 	return list, nil
 }
 
-func waitForClassicInAutoScalingGroupServerInstanceListDeletion(config *conn.ProviderConfig, id string) error {
-	stateConf := &resource.StateChangeConf{
+func waitForClassicInAutoScalingGroupServerInstanceListDeletion(ctx context.Context, config *conn.ProviderConfig, id string) error {
+	stateConf := &waiter.Config[any]{
 		Pending: []string{"INSVC"},
 		Target:  []string{"TERMT"},
-		Refresh: func() (interface{}, string, error) {
-			asg, err := GetAutoScalingGroup(config, id)
+		Refresh: func(ctx context.Context) (any, string, error) {
+			asg, err := GetAutoScalingGroup(ctx, config, id)
 			if err != nil {
 				return 0, "", err
 			}
@@ -637,23 +640,23 @@ func waitForClassicInAutoScalingGroupServerInstanceListDeletion(config *conn.Pro
 				return asg, "TERMT", nil
 			}
 		},
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
-		Timeout:    conn.DefaultStopTimeout * 3,
+		Delay:       2 * time.Second,
+		MinInterval: 3 * time.Second,
+		Timeout:     conn.DefaultStopTimeout * 3,
 	}
 
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForState(ctx); err != nil {
 		return fmt.Errorf("Error waiting for InAutoScalingGroupServerInstanceList (%s) to become deleting: %s", id, err)
 	}
 	return nil
 }
 
-func waitForVpcInAutoScalingGroupServerInstanceListDeletion(config *conn.ProviderConfig, id string) error {
-	stateConf := &resource.StateChangeConf{
+func waitForVpcInAutoScalingGroupServerInstanceListDeletion(ctx context.Context, config *conn.ProviderConfig, id string) error {
+	stateConf := &waiter.Config[any]{
 		Pending: []string{"INSVC"},
 		Target:  []string{"TERMT"},
-		Refresh: func() (interface{}, string, error) {
-			asg, err := GetAutoScalingGroup(config, id)
+		Refresh: func(ctx context.Context) (any, string, error) {
+			asg, err := GetAutoScalingGroup(ctx, config, id)
 			if err != nil {
 				return 0, "", err
 			}
@@ -663,30 +666,29 @@ func waitForVpcInAutoScalingGroupServerInstanceListDeletion(config *conn.Provide
 				return asg, "TERMT", nil
 			}
 		},
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
-		Timeout:    conn.DefaultStopTimeout * 3,
+		Delay:       2 * time.Second,
+		MinInterval: 3 * time.Second,
+		Timeout:     conn.DefaultStopTimeout * 3,
 	}
 
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForState(ctx); err != nil {
 		return fmt.Errorf("Error waiting for InAutoScalingGroupServerInstanceList (%s) to become deleting: %s", id, err)
 	}
 	return nil
 }
 
-func waitForClassicAutoScalingGroupDeletion(config *conn.ProviderConfig, name string) error {
-	stateConf := &resource.StateChangeConf{
+func waitForClassicAutoScalingGroupDeletion(ctx context.Context, config *conn.ProviderConfig, name string) error {
+	stateConf := &waiter.Config[any]{
 		Pending: []string{"RUN"},
 		Target:  []string{"DELETE"},
-		Refresh: func() (interface{}, string, error) {
+		Refresh: func(ctx context.Context) (any, string, error) {
 			client := config.Client
 			reqParams := &autoscaling.DeleteAutoScalingGroupRequest{
 				AutoScalingGroupName: ncloud.String(name),
 			}
 			resp, err := client.Autoscaling.V2Api.DeleteAutoScalingGroup(reqParams)
 			if err != nil {
-				errBody, _ := GetCommonErrorBody(err)
-				if errBody.ReturnCode == ApiErrorASGScalingIsActive || errBody.ReturnCode == ApiErrorASGIsUsingPolicyOrLaunchConfiguration {
+				if HasReturnCode(err, ApiErrorASGScalingIsActive, ApiErrorASGIsUsingPolicyOrLaunchConfiguration) {
 					return resp, "RUN", nil
 				} else {
 					return 0, "", err
@@ -695,30 +697,29 @@ func waitForClassicAutoScalingGroupDeletion(config *conn.ProviderConfig, name st
 				return resp, "DELETE", nil
 			}
 		},
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
-		Timeout:    conn.DefaultTimeout,
+		Delay:       2 * time.Second,
+		MinInterval: 3 * time.Second,
+		Timeout:     conn.DefaultTimeout,
 	}
 
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForState(ctx); err != nil {
 		return fmt.Errorf("Error waiting for AutoScalingGroup (%s) to become deleting: %s", name, err)
 	}
 	return nil
 }
 
-func waitForVpcAutoScalingGroupDeletion(config *conn.ProviderConfig, id string) error {
-	stateConf := &resource.StateChangeConf{
+func waitForVpcAutoScalingGroupDeletion(ctx context.Context, config *conn.ProviderConfig, id string) error {
+	stateConf := &waiter.Config[any]{
 		Pending: []string{"RUN"},
 		Target:  []string{"DELETE"},
-		Refresh: func() (interface{}, string, error) {
+		Refresh: func(ctx context.Context) (any, string, error) {
 			client := config.Client
 			reqParams := &vautoscaling.DeleteAutoScalingGroupRequest{
 				AutoScalingGroupNo: ncloud.String(id),
 			}
 			resp, err := client.Vautoscaling.V2Api.DeleteAutoScalingGroup(reqParams)
 			if err != nil {
-				errBody, _ := GetCommonErrorBody(err)
-				if errBody.ReturnCode == ApiErrorASGIsUsingPolicyOrLaunchConfigurationOnVpc {
+				if HasReturnCode(err, ApiErrorASGIsUsingPolicyOrLaunchConfigurationOnVpc) {
 					return resp, "RUN", nil
 				} else {
 					return 0, "", err
@@ -727,18 +728,18 @@ func waitForVpcAutoScalingGroupDeletion(config *conn.ProviderConfig, id string)
 				return resp, "DELETE", nil
 			}
 		},
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
-		Timeout:    conn.DefaultTimeout,
+		Delay:       2 * time.Second,
+		MinInterval: 3 * time.Second,
+		Timeout:     conn.DefaultTimeout,
 	}
 
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForState(ctx); err != nil {
 		return fmt.Errorf("Error waiting for AutoScalingGroup (%s) to become deleting: %s", id, err)
 	}
 	return nil
 }
 
-func waitForAutoScalingGroupCapacity(d *schema.ResourceData, config *conn.ProviderConfig) error {
+func waitForAutoScalingGroupCapacity(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) error {
 	wait, err := time.ParseDuration(d.Get("wait_for_capacity_timeout").(string))
 	if err != nil {
 		return err
@@ -749,20 +750,20 @@ func waitForAutoScalingGroupCapacity(d *schema.ResourceData, config *conn.Provid
 	}
 
 	if config.SupportVPC {
-		return waitForVpcAutoScalingGroupCapacity(d, config, wait)
+		return waitForVpcAutoScalingGroupCapacity(ctx, d, config, wait)
 	} else {
-		return waitForClassicAutoScalingGroupCapacity(d, config, wait)
+		return waitForClassicAutoScalingGroupCapacity(ctx, d, config, wait)
 	}
 }
 
-func waitForVpcAutoScalingGroupCapacity(d *schema.ResourceData, config *conn.ProviderConfig, wait time.Duration) error {
-	return resource.Retry(wait, func() *resource.RetryError {
-		asg, err := getVpcAutoScalingGroup(config, d.Id())
+func waitForVpcAutoScalingGroupCapacity(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig, wait time.Duration) error {
+	return resource.RetryContext(ctx, wait, func() *resource.RetryError {
+		asg, err := getVpcAutoScalingGroup(ctx, config, d.Id())
 		if err != nil {
 			return resource.NonRetryableError(err)
 		}
 
-		asgServerInstanceList, err := getVpcInAutoScalingGroupServerInstanceList(config, d.Id())
+		asgServerInstanceList, err := getVpcInAutoScalingGroupServerInstanceList(ctx, config, d.Id())
 		if err != nil {
 			return resource.NonRetryableError(err)
 		}
@@ -792,14 +793,14 @@ func waitForVpcAutoScalingGroupCapacity(d *schema.ResourceData, config *conn.Pro
 	})
 }
 
-func waitForClassicAutoScalingGroupCapacity(d *schema.ResourceData, config *conn.ProviderConfig, wait time.Duration) error {
-	return resource.Retry(wait, func() *resource.RetryError {
-		asg, err := getClassicAutoScalingGroup(config, d.Id())
+func waitForClassicAutoScalingGroupCapacity(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig, wait time.Duration) error {
+	return resource.RetryContext(ctx, wait, func() *resource.RetryError {
+		asg, err := getClassicAutoScalingGroup(ctx, config, d.Id())
 		if err != nil {
 			return resource.NonRetryableError(err)
 		}
 
-		asgServerInstanceList, err := getClassicInAutoScalingGroupServerInstanceList(config, d.Id())
+		asgServerInstanceList, err := getClassicInAutoScalingGroupServerInstanceList(ctx, config, d.Id())
 		if err != nil {
 			return resource.NonRetryableError(err)
 		}
@@ -831,7 +832,7 @@ func waitForClassicAutoScalingGroupCapacity(d *schema.ResourceData, config *conn
 	})
 }
 
-func getClassicAutoScalingGroupByName(config *conn.ProviderConfig, name string) (*AutoScalingGroup, error) {
+func getClassicAutoScalingGroupByName(ctx context.Context, config *conn.ProviderConfig, name string) (*AutoScalingGroup, error) {
 	reqParams := &autoscaling.GetAutoScalingGroupListRequest{
 		RegionNo:                 &config.RegionCode,
 		AutoScalingGroupNameList: []*string{ncloud.String(name)},
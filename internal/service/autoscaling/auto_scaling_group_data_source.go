@@ -1,13 +1,17 @@
 package autoscaling
 
 import (
+	"context"
+
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/autoscaling"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vautoscaling"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/paging"
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/verify"
 )
 
@@ -20,20 +24,20 @@ func DataSourceNcloudAutoScalingGroup() *schema.Resource {
 		},
 		"filter": DataSourceFiltersSchema(),
 	}
-	return GetSingularDataSourceItemSchema(ResourceNcloudAutoScalingGroup(), fieldMap, dataSourceNcloudAutoScalingGroupRead)
+	return GetSingularDataSourceItemSchemaContext(ResourceNcloudAutoScalingGroup(), fieldMap, dataSourceNcloudAutoScalingGroupRead)
 }
 
-func dataSourceNcloudAutoScalingGroupRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceNcloudAutoScalingGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*conn.ProviderConfig)
 
 	if v, ok := d.GetOk("id"); ok {
 		d.SetId(v.(string))
 	}
 
-	autoScalingGroupList, err := getAutoScalingGroupList(config, d.Id())
+	autoScalingGroupList, err := getAutoScalingGroupList(ctx, config, d.Id())
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	autoScalingGroupListMap := ConvertToArrayMap(autoScalingGroupList)
@@ -42,7 +46,7 @@ func dataSourceNcloudAutoScalingGroupRead(d *schema.ResourceData, meta interface
 	}
 
 	if err := ValidateOneResult(len(autoScalingGroupListMap)); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(autoScalingGroupListMap[0]["auto_scaling_group_no"].(string))
@@ -50,16 +54,16 @@ func dataSourceNcloudAutoScalingGroupRead(d *schema.ResourceData, meta interface
 	return nil
 }
 
-func getAutoScalingGroupList(config *conn.ProviderConfig, id string) ([]*AutoScalingGroup, error) {
+func getAutoScalingGroupList(ctx context.Context, config *conn.ProviderConfig, id string) ([]*AutoScalingGroup, error) {
 	if config.SupportVPC {
-		return getVpcAutoScalingGroupList(config, id)
+		return getVpcAutoScalingGroupList(ctx, config, id)
 	} else {
-		return getClassicAutoScalingGroupList(config, id)
+		return getClassicAutoScalingGroupList(ctx, config, id)
 	}
 
 }
 
-func getVpcAutoScalingGroupList(config *conn.ProviderConfig, id string) ([]*AutoScalingGroup, error) {
+func getVpcAutoScalingGroupList(ctx context.Context, config *conn.ProviderConfig, id string) ([]*AutoScalingGroup, error) {
 	reqParams := &vautoscaling.GetAutoScalingGroupListRequest{
 		RegionCode: &config.RegionCode,
 	}
@@ -68,17 +72,26 @@ func getVpcAutoScalingGroupList(config *conn.ProviderConfig, id string) ([]*Auto
 		reqParams.AutoScalingGroupNoList = []*string{ncloud.String(id)}
 	}
 
-	resp, err := config.Client.Vautoscaling.V2Api.GetAutoScalingGroupList(reqParams)
+	autoScalingGroupList, err := paging.All(ctx, func(ctx context.Context, pageNo, pageSize int32) ([]*vautoscaling.AutoScalingGroup, *int32, error) {
+		reqParams.PageNo = ncloud.Int32(pageNo)
+		reqParams.PageSize = ncloud.Int32(pageSize)
+
+		resp, err := config.Client.Vautoscaling.V2Api.GetAutoScalingGroupList(reqParams)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.AutoScalingGroupList, resp.TotalRows, nil
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	if len(resp.AutoScalingGroupList) < 1 {
+	if len(autoScalingGroupList) < 1 {
 		return nil, nil
 	}
 
 	list := make([]*AutoScalingGroup, 0)
-	for _, a := range resp.AutoScalingGroupList {
+	for _, a := range autoScalingGroupList {
 		list = append(list, &AutoScalingGroup{
 			AutoScalingGroupNo:                   a.AutoScalingGroupNo,
 			AutoScalingGroupName:                 a.AutoScalingGroupName,
@@ -102,19 +115,28 @@ func getVpcAutoScalingGroupList(config *conn.ProviderConfig, id string) ([]*Auto
 	return list, nil
 }
 
-func getClassicAutoScalingGroupList(config *conn.ProviderConfig, id string) ([]*AutoScalingGroup, error) {
+func getClassicAutoScalingGroupList(ctx context.Context, config *conn.ProviderConfig, id string) ([]*AutoScalingGroup, error) {
 	no := ncloud.String(id)
 	reqParams := &autoscaling.GetAutoScalingGroupListRequest{
 		RegionNo: &config.RegionNo,
 	}
 
-	resp, err := config.Client.Autoscaling.V2Api.GetAutoScalingGroupList(reqParams)
+	autoScalingGroupList, err := paging.All(ctx, func(ctx context.Context, pageNo, pageSize int32) ([]*autoscaling.AutoScalingGroup, *int32, error) {
+		reqParams.PageNo = ncloud.Int32(pageNo)
+		reqParams.PageSize = ncloud.Int32(pageSize)
+
+		resp, err := config.Client.Autoscaling.V2Api.GetAutoScalingGroupList(reqParams)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.AutoScalingGroupList, resp.TotalRows, nil
+	})
 	if err != nil {
 		return nil, err
 	}
 
 	list := make([]*AutoScalingGroup, 0)
-	for _, a := range resp.AutoScalingGroupList {
+	for _, a := range autoScalingGroupList {
 		autoScalingGroup := &AutoScalingGroup{
 			AutoScalingGroupNo:                   a.AutoScalingGroupNo,
 			AutoScalingGroupName:                 a.AutoScalingGroupName,
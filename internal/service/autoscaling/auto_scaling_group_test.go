@@ -1,6 +1,7 @@
 package autoscaling_test
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -201,7 +202,7 @@ func testAccCheckAutoScalingGroupDestroy(s *terraform.State, provider *schema.Pr
 		if rs.Type != "ncloud_auto_scaling_group" {
 			continue
 		}
-		autoScalingGroup, err := autoscaling.GetAutoScalingGroup(config, rs.Primary.ID)
+		autoScalingGroup, err := autoscaling.GetAutoScalingGroup(context.Background(), config, rs.Primary.ID)
 		if err != nil {
 			return err
 		}
@@ -225,7 +226,7 @@ func testAccCheckAutoScalingGroupExists(n string, a *autoscaling.AutoScalingGrou
 		}
 
 		config := provider.Meta().(*conn.ProviderConfig)
-		autoScalingGroup, err := autoscaling.GetAutoScalingGroup(config, rs.Primary.ID)
+		autoScalingGroup, err := autoscaling.GetAutoScalingGroup(context.Background(), config, rs.Primary.ID)
 		if err != nil {
 			return err
 		}
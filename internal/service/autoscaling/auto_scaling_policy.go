@@ -1,6 +1,7 @@
 package autoscaling
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"strings"
@@ -8,6 +9,7 @@ import (
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/autoscaling"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vautoscaling"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -17,10 +19,10 @@ import (
 
 func ResourceNcloudAutoScalingPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNcloudAutoScalingPolicyCreate,
-		Read:   resourceNcloudAutoScalingPolicyRead,
-		Update: resourceNcloudAutoScalingPolicyUpdate,
-		Delete: resourceNcloudAutoScalingPolicyDelete,
+		CreateContext: resourceNcloudAutoScalingPolicyCreate,
+		ReadContext:   resourceNcloudAutoScalingPolicyRead,
+		UpdateContext: resourceNcloudAutoScalingPolicyUpdate,
+		DeleteContext: resourceNcloudAutoScalingPolicyDelete,
 		Importer: &schema.ResourceImporter{
 			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 				idParts := strings.Split(d.Id(), ":")
@@ -73,28 +75,28 @@ func ResourceNcloudAutoScalingPolicy() *schema.Resource {
 	}
 }
 
-func resourceNcloudAutoScalingPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudAutoScalingPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*conn.ProviderConfig)
 
-	autoscaling_group_no, id, err := createAutoScalingPolicy(d, config)
+	autoscaling_group_no, id, err := createAutoScalingPolicy(ctx, d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(ncloud.StringValue(id))
 	d.Set("auto_scaling_group_no", autoscaling_group_no)
-	return resourceNcloudAutoScalingPolicyRead(d, meta)
+	return resourceNcloudAutoScalingPolicyRead(ctx, d, meta)
 }
 
-func createAutoScalingPolicy(d *schema.ResourceData, config *conn.ProviderConfig) (*string, *string, error) {
+func createAutoScalingPolicy(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) (*string, *string, error) {
 	if config.SupportVPC {
-		return createVpcAutoScalingPolicy(d, config)
+		return createVpcAutoScalingPolicy(ctx, d, config)
 	} else {
-		return createClassicAutoScalingPolicy(d, config)
+		return createClassicAutoScalingPolicy(ctx, d, config)
 	}
 }
 
-func createVpcAutoScalingPolicy(d *schema.ResourceData, config *conn.ProviderConfig) (*string, *string, error) {
+func createVpcAutoScalingPolicy(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) (*string, *string, error) {
 	reqParams := &vautoscaling.PutScalingPolicyRequest{
 		RegionCode: &config.RegionCode,
 		// Required
@@ -106,22 +108,22 @@ func createVpcAutoScalingPolicy(d *schema.ResourceData, config *conn.ProviderCon
 		MinAdjustmentStep: Int32PtrOrNil(d.GetOk("min_adjustment_step")),
 		CoolDown:          ncloud.Int32(int32(d.Get("cooldown").(int))),
 	}
-	LogCommonRequest("createVpcAutoScalingPolicy", reqParams)
+	ctx = LogCommonRequest(ctx, "createVpcAutoScalingPolicy", reqParams)
 
 	resp, err := config.Client.Vautoscaling.V2Api.PutScalingPolicy(reqParams)
 	if err != nil {
 		return nil, nil, err
 	}
-	LogResponse("createVpcAutoScalingPolicy", resp)
+	LogResponse(ctx, "createVpcAutoScalingPolicy", resp)
 
 	policy := resp.ScalingPolicyList[0]
 	return policy.AutoScalingGroupNo, policy.PolicyNo, nil
 }
 
-func createClassicAutoScalingPolicy(d *schema.ResourceData, config *conn.ProviderConfig) (*string, *string, error) {
+func createClassicAutoScalingPolicy(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) (*string, *string, error) {
 	no := d.Get("auto_scaling_group_no").(string)
 	name := ncloud.String(d.Get("name").(string))
-	asg, err := getClassicAutoScalingGroup(config, no)
+	asg, err := getClassicAutoScalingGroup(ctx, config, no)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -135,22 +137,22 @@ func createClassicAutoScalingPolicy(d *schema.ResourceData, config *conn.Provide
 		MinAdjustmentStep: Int32PtrOrNil(d.GetOk("min_adjustment_step")),
 		Cooldown:          ncloud.Int32(int32(d.Get("cooldown").(int))),
 	}
-	LogCommonRequest("createClassicAutoScalingPolicy", reqParams)
+	ctx = LogCommonRequest(ctx, "createClassicAutoScalingPolicy", reqParams)
 
 	resp, err := config.Client.Autoscaling.V2Api.PutScalingPolicy(reqParams)
 	if err != nil {
 		return nil, nil, err
 	}
-	LogResponse("createClassicAutoScalingPolicy", resp)
+	LogResponse(ctx, "createClassicAutoScalingPolicy", resp)
 
 	return ncloud.String(no), name, nil
 }
 
-func resourceNcloudAutoScalingPolicyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudAutoScalingPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*conn.ProviderConfig)
-	policy, err := GetAutoScalingPolicy(config, d.Id(), d.Get("auto_scaling_group_no").(string))
+	policy, err := GetAutoScalingPolicy(ctx, config, d.Id(), d.Get("auto_scaling_group_no").(string))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if policy == nil {
@@ -163,27 +165,27 @@ func resourceNcloudAutoScalingPolicyRead(d *schema.ResourceData, meta interface{
 	return nil
 }
 
-func GetAutoScalingPolicy(config *conn.ProviderConfig, id string, autoScalingGroupNo string) (*AutoScalingPolicy, error) {
+func GetAutoScalingPolicy(ctx context.Context, config *conn.ProviderConfig, id string, autoScalingGroupNo string) (*AutoScalingPolicy, error) {
 	if config.SupportVPC {
-		return getVpcAutoScalingPolicy(config, id, autoScalingGroupNo)
+		return getVpcAutoScalingPolicy(ctx, config, id, autoScalingGroupNo)
 	} else {
-		return getClassicAutoScalingPolicy(config, id, autoScalingGroupNo)
+		return getClassicAutoScalingPolicy(ctx, config, id, autoScalingGroupNo)
 	}
 }
 
-func getVpcAutoScalingPolicy(config *conn.ProviderConfig, id string, autoScalingGroupNo string) (*AutoScalingPolicy, error) {
+func getVpcAutoScalingPolicy(ctx context.Context, config *conn.ProviderConfig, id string, autoScalingGroupNo string) (*AutoScalingPolicy, error) {
 	reqParams := &vautoscaling.GetAutoScalingPolicyListRequest{
 		RegionCode:         &config.RegionCode,
 		AutoScalingGroupNo: ncloud.String(autoScalingGroupNo),
 		PolicyNoList:       []*string{ncloud.String(id)},
 	}
-	LogCommonRequest("getVpcAutoScalingPolicy", reqParams)
+	ctx = LogCommonRequest(ctx, "getVpcAutoScalingPolicy", reqParams)
 
 	resp, err := config.Client.Vautoscaling.V2Api.GetAutoScalingPolicyList(reqParams)
 	if err != nil {
 		return nil, err
 	}
-	LogResponse("getVpcAutoScalingPolicy", resp)
+	LogResponse(ctx, "getVpcAutoScalingPolicy", resp)
 
 	if len(resp.ScalingPolicyList) == 0 {
 		return nil, nil
@@ -202,8 +204,8 @@ func getVpcAutoScalingPolicy(config *conn.ProviderConfig, id string, autoScaling
 
 }
 
-func getClassicAutoScalingPolicy(config *conn.ProviderConfig, id string, autoScalingGroupNo string) (*AutoScalingPolicy, error) {
-	asg, err := getClassicAutoScalingGroup(config, autoScalingGroupNo)
+func getClassicAutoScalingPolicy(ctx context.Context, config *conn.ProviderConfig, id string, autoScalingGroupNo string) (*AutoScalingPolicy, error) {
+	asg, err := getClassicAutoScalingGroup(ctx, config, autoScalingGroupNo)
 	if err != nil {
 		return nil, err
 	}
@@ -215,13 +217,13 @@ func getClassicAutoScalingPolicy(config *conn.ProviderConfig, id string, autoSca
 		PolicyNameList:       []*string{ncloud.String(id)},
 		AutoScalingGroupName: asg.AutoScalingGroupName,
 	}
-	LogCommonRequest("getClassicAutoScalingPolicy", reqParams)
+	ctx = LogCommonRequest(ctx, "getClassicAutoScalingPolicy", reqParams)
 
 	resp, err := config.Client.Autoscaling.V2Api.GetAutoScalingPolicyList(reqParams)
 	if err != nil {
 		return nil, err
 	}
-	LogResponse("getClassicAutoScalingPolicy", resp)
+	LogResponse(ctx, "getClassicAutoScalingPolicy", resp)
 
 	if len(resp.ScalingPolicyList) < 1 {
 		return nil, nil
@@ -238,33 +240,33 @@ func getClassicAutoScalingPolicy(config *conn.ProviderConfig, id string, autoSca
 	}, nil
 }
 
-func resourceNcloudAutoScalingPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudAutoScalingPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*conn.ProviderConfig)
-	_, _, err := createAutoScalingPolicy(d, config)
+	_, _, err := createAutoScalingPolicy(ctx, d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
-	return resourceNcloudAutoScalingPolicyRead(d, meta)
+	return resourceNcloudAutoScalingPolicyRead(ctx, d, meta)
 }
 
-func resourceNcloudAutoScalingPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudAutoScalingPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*conn.ProviderConfig)
-	if err := deleteAutoScalingPolicy(config, d.Id(), d.Get("auto_scaling_group_no").(string)); err != nil {
-		return err
+	if err := deleteAutoScalingPolicy(ctx, config, d.Id(), d.Get("auto_scaling_group_no").(string)); err != nil {
+		return diag.FromErr(err)
 	}
 	return nil
 }
 
-func deleteAutoScalingPolicy(config *conn.ProviderConfig, id string, autoScalingGroupNo string) error {
+func deleteAutoScalingPolicy(ctx context.Context, config *conn.ProviderConfig, id string, autoScalingGroupNo string) error {
 	if config.SupportVPC {
-		return deleteVpcAutoScalingPolicy(config, id, autoScalingGroupNo)
+		return deleteVpcAutoScalingPolicy(ctx, config, id, autoScalingGroupNo)
 	} else {
-		return deleteClassicAutoScalingPolicy(config, id, autoScalingGroupNo)
+		return deleteClassicAutoScalingPolicy(ctx, config, id, autoScalingGroupNo)
 	}
 }
 
-func deleteVpcAutoScalingPolicy(config *conn.ProviderConfig, id string, autoScalingGroupNo string) error {
-	p, err := getVpcAutoScalingPolicy(config, id, autoScalingGroupNo)
+func deleteVpcAutoScalingPolicy(ctx context.Context, config *conn.ProviderConfig, id string, autoScalingGroupNo string) error {
+	p, err := getVpcAutoScalingPolicy(ctx, config, id, autoScalingGroupNo)
 	if err != nil {
 		return err
 	}
@@ -273,19 +275,19 @@ func deleteVpcAutoScalingPolicy(config *conn.ProviderConfig, id string, autoScal
 		AutoScalingGroupNo: p.AutoScalingGroupNo,
 		PolicyNo:           p.AutoScalingPolicyNo,
 	}
-	LogCommonRequest("deleteVpcAutoScalingPolicy", reqParams)
+	ctx = LogCommonRequest(ctx, "deleteVpcAutoScalingPolicy", reqParams)
 
 	resp, err := config.Client.Vautoscaling.V2Api.DeleteScalingPolicy(reqParams)
 	if err != nil {
 		return err
 	}
-	LogResponse("deleteVpcAutoScalingPolicy", resp)
+	LogResponse(ctx, "deleteVpcAutoScalingPolicy", resp)
 
 	return nil
 }
 
-func deleteClassicAutoScalingPolicy(config *conn.ProviderConfig, id string, autoScalingGroupNo string) error {
-	asg, err := getClassicAutoScalingGroup(config, autoScalingGroupNo)
+func deleteClassicAutoScalingPolicy(ctx context.Context, config *conn.ProviderConfig, id string, autoScalingGroupNo string) error {
+	asg, err := getClassicAutoScalingGroup(ctx, config, autoScalingGroupNo)
 	if err != nil {
 		return err
 	}
@@ -293,13 +295,13 @@ func deleteClassicAutoScalingPolicy(config *conn.ProviderConfig, id string, auto
 		AutoScalingGroupName: asg.AutoScalingGroupName,
 		PolicyName:           ncloud.String(id),
 	}
-	LogCommonRequest("deleteClassicAutoScalingPolicy", reqParams)
+	ctx = LogCommonRequest(ctx, "deleteClassicAutoScalingPolicy", reqParams)
 
 	resp, err := config.Client.Autoscaling.V2Api.DeletePolicy(reqParams)
 	if err != nil {
 		return err
 	}
-	LogResponse("deleteClassicAutoScalingPolicy", resp)
+	LogResponse(ctx, "deleteClassicAutoScalingPolicy", resp)
 
 	return nil
 }
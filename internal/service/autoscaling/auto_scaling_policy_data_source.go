@@ -1,13 +1,17 @@
 package autoscaling
 
 import (
+	"context"
+
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/autoscaling"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vautoscaling"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/paging"
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/verify"
 )
 
@@ -25,19 +29,19 @@ func DataSourceNcloudAutoScalingPolicy() *schema.Resource {
 		"filter": DataSourceFiltersSchema(),
 	}
 
-	return GetSingularDataSourceItemSchema(ResourceNcloudAutoScalingPolicy(), fieldMap, dataSourceNcloudAutoScalingPolicyRead)
+	return GetSingularDataSourceItemSchemaContext(ResourceNcloudAutoScalingPolicy(), fieldMap, dataSourceNcloudAutoScalingPolicyRead)
 }
 
-func dataSourceNcloudAutoScalingPolicyRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceNcloudAutoScalingPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*conn.ProviderConfig)
 
 	if v, ok := d.GetOk("id"); ok {
 		d.SetId(v.(string))
 	}
 
-	policyList, err := getAutoScalingPolicyList(d, config)
+	policyList, err := getAutoScalingPolicyList(ctx, d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	policyListMap := ConvertToArrayMap(policyList)
@@ -46,7 +50,7 @@ func dataSourceNcloudAutoScalingPolicyRead(d *schema.ResourceData, meta interfac
 	}
 
 	if err := ValidateOneResult(len(policyListMap)); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(policyListMap[0]["name"].(string))
@@ -54,15 +58,15 @@ func dataSourceNcloudAutoScalingPolicyRead(d *schema.ResourceData, meta interfac
 	return nil
 }
 
-func getAutoScalingPolicyList(d *schema.ResourceData, config *conn.ProviderConfig) ([]*AutoScalingPolicy, error) {
+func getAutoScalingPolicyList(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) ([]*AutoScalingPolicy, error) {
 	if config.SupportVPC {
-		return getVpcAutoScalingPolicyList(d, config)
+		return getVpcAutoScalingPolicyList(ctx, d, config)
 	} else {
-		return getClassicAutoScalingPolicyList(d, config)
+		return getClassicAutoScalingPolicyList(ctx, d, config)
 	}
 }
 
-func getVpcAutoScalingPolicyList(d *schema.ResourceData, config *conn.ProviderConfig) ([]*AutoScalingPolicy, error) {
+func getVpcAutoScalingPolicyList(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) ([]*AutoScalingPolicy, error) {
 	reqParams := &vautoscaling.GetAutoScalingPolicyListRequest{
 		RegionCode:         &config.RegionCode,
 		AutoScalingGroupNo: ncloud.String(d.Get("auto_scaling_group_no").(string)),
@@ -96,21 +100,30 @@ func getVpcAutoScalingPolicyList(d *schema.ResourceData, config *conn.ProviderCo
 	return list, nil
 }
 
-func getClassicAutoScalingPolicyList(d *schema.ResourceData, config *conn.ProviderConfig) ([]*AutoScalingPolicy, error) {
+func getClassicAutoScalingPolicyList(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) ([]*AutoScalingPolicy, error) {
 	reqParams := &autoscaling.GetAutoScalingPolicyListRequest{}
 
 	if d.Id() != "" {
 		reqParams.PolicyNameList = []*string{ncloud.String(d.Id())}
 	}
 
-	resp, err := config.Client.Autoscaling.V2Api.GetAutoScalingPolicyList(reqParams)
+	scalingPolicyList, err := paging.All(ctx, func(ctx context.Context, pageNo, pageSize int32) ([]*autoscaling.ScalingPolicy, *int32, error) {
+		reqParams.PageNo = ncloud.Int32(pageNo)
+		reqParams.PageSize = ncloud.Int32(pageSize)
+
+		resp, err := config.Client.Autoscaling.V2Api.GetAutoScalingPolicyList(reqParams)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.ScalingPolicyList, resp.TotalRows, nil
+	})
 	if err != nil {
 		return nil, err
 	}
 
 	list := make([]*AutoScalingPolicy, 0)
-	for _, p := range resp.ScalingPolicyList {
-		asg, err := getClassicAutoScalingGroupByName(config, *p.AutoScalingGroupName)
+	for _, p := range scalingPolicyList {
+		asg, err := getClassicAutoScalingGroupByName(ctx, config, *p.AutoScalingGroupName)
 		if err != nil {
 			return nil, err
 		}
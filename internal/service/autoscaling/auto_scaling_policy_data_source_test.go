@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/acctest"
@@ -14,7 +13,7 @@ func TestAccDataSourceNcloudAutoScalingPolicy_classic_basic(t *testing.T) {
 	// Images are all deprecated in Classic
 	t.Skip()
 
-	name := fmt.Sprintf("terraform-testacc-asp-%s", acctest.RandString(5))
+	name := fmt.Sprintf("terraform-testacc-asp-%s", RandString(t, 5))
 	dataName := "data.ncloud_auto_scaling_policy.policy"
 	resourceName := "ncloud_auto_scaling_policy.test-policy-CHANG"
 
@@ -39,7 +38,7 @@ func TestAccDataSourceNcloudAutoScalingPolicy_classic_basic(t *testing.T) {
 }
 
 func TestAccDataSourceNcloudAutoScalingPolicy_vpc_basic(t *testing.T) {
-	name := fmt.Sprintf("terraform-testacc-asp-%s", acctest.RandString(5))
+	name := fmt.Sprintf("terraform-testacc-asp-%s", RandString(t, 5))
 	dataName := "data.ncloud_auto_scaling_policy.policy"
 	resourceName := "ncloud_auto_scaling_policy.test-policy-CHANG"
 
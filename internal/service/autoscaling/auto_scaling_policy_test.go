@@ -1,11 +1,11 @@
 package autoscaling_test
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -20,7 +20,7 @@ func TestAccResourceNcloudAutoScalingPolicy_classic_basic(t *testing.T) {
 	t.Skip()
 
 	var policy autoscaling.AutoScalingPolicy
-	name := fmt.Sprintf("terraform-testacc-asp-%s", acctest.RandString(5))
+	name := fmt.Sprintf("terraform-testacc-asp-%s", RandString(t, 5))
 	resourceCHANG := "ncloud_auto_scaling_policy.test-policy-CHANG"
 	resourceEXACT := "ncloud_auto_scaling_policy.test-policy-EXACT"
 	resourcePRCNT := "ncloud_auto_scaling_policy.test-policy-PRCNT"
@@ -62,7 +62,7 @@ func TestAccResourceNcloudAutoScalingPolicy_classic_zero_value(t *testing.T) {
 	t.Skip()
 
 	var policy autoscaling.AutoScalingPolicy
-	name := fmt.Sprintf("terraform-testacc-asp-%s", acctest.RandString(5))
+	name := fmt.Sprintf("terraform-testacc-asp-%s", RandString(t, 5))
 	resourceCHANG := "ncloud_auto_scaling_policy.test-policy-CHANG"
 	resourceEXACT := "ncloud_auto_scaling_policy.test-policy-EXACT"
 	resourcePRCNT := "ncloud_auto_scaling_policy.test-policy-PRCNT"
@@ -124,7 +124,7 @@ func TestAccResourceNcloudAutoScalingPolicy_classic_zero_value(t *testing.T) {
 
 func TestAccResourceNcloudAutoScalingPolicy_vpc_zero_value(t *testing.T) {
 	var policy autoscaling.AutoScalingPolicy
-	name := fmt.Sprintf("terraform-testacc-asp-%s", acctest.RandString(5))
+	name := fmt.Sprintf("terraform-testacc-asp-%s", RandString(t, 5))
 	resourceCHANG := "ncloud_auto_scaling_policy.test-policy-CHANG"
 	resourceEXACT := "ncloud_auto_scaling_policy.test-policy-EXACT"
 	resourcePRCNT := "ncloud_auto_scaling_policy.test-policy-PRCNT"
@@ -191,7 +191,7 @@ func TestAccResourceNcloudAutoScalingPolicy_classic_disappears(t *testing.T) {
 	t.Skip()
 
 	var policy autoscaling.AutoScalingPolicy
-	name := fmt.Sprintf("terraform-testacc-asp-%s", acctest.RandString(5))
+	name := fmt.Sprintf("terraform-testacc-asp-%s", RandString(t, 5))
 	resourceCHANG := "ncloud_auto_scaling_policy.test-policy-CHANG"
 	resourceEXACT := "ncloud_auto_scaling_policy.test-policy-EXACT"
 	resourcePRCNT := "ncloud_auto_scaling_policy.test-policy-PRCNT"
@@ -221,7 +221,7 @@ func TestAccResourceNcloudAutoScalingPolicy_classic_disappears(t *testing.T) {
 
 func TestAccResourceNcloudAutoScalingPolicy_vpc_disappears(t *testing.T) {
 	var policy autoscaling.AutoScalingPolicy
-	name := fmt.Sprintf("terraform-testacc-asp-%s", acctest.RandString(5))
+	name := fmt.Sprintf("terraform-testacc-asp-%s", RandString(t, 5))
 	resourceCHANG := "ncloud_auto_scaling_policy.test-policy-CHANG"
 	resourceEXACT := "ncloud_auto_scaling_policy.test-policy-EXACT"
 	resourcePRCNT := "ncloud_auto_scaling_policy.test-policy-PRCNT"
@@ -261,7 +261,7 @@ func testAccCheckNcloudAutoScalingPolicyExists(n string, p *autoscaling.AutoScal
 		}
 
 		config := provider.Meta().(*conn.ProviderConfig)
-		autoScalingPolicy, err := autoscaling.GetAutoScalingPolicy(config, rs.Primary.ID, rs.Primary.Attributes["auto_scaling_group_no"])
+		autoScalingPolicy, err := autoscaling.GetAutoScalingPolicy(context.Background(), config, rs.Primary.ID, rs.Primary.Attributes["auto_scaling_group_no"])
 		if err != nil {
 			return err
 		}
@@ -280,7 +280,7 @@ func testAccCheckNcloudAutoScalingPolicyDestroy(s *terraform.State, provider *sc
 		if rs.Type != "ncloud_auto_scaling_policy" {
 			continue
 		}
-		autoScalingPolicy, err := autoscaling.GetAutoScalingPolicy(config, rs.Primary.ID, rs.Primary.Attributes["auto_scaling_group_no"])
+		autoScalingPolicy, err := autoscaling.GetAutoScalingPolicy(context.Background(), config, rs.Primary.ID, rs.Primary.Attributes["auto_scaling_group_no"])
 		if err != nil {
 			return err
 		}
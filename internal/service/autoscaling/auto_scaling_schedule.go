@@ -1,6 +1,7 @@
 package autoscaling
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"strings"
@@ -8,6 +9,7 @@ import (
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/autoscaling"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vautoscaling"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -20,10 +22,10 @@ const SCHEDULE_TIME_FORMAT = "2006-01-02T15:04:05Z0700"
 
 func ResourceNcloudAutoScalingSchedule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNcloudAutoScalingScheduleCreate,
-		Read:   resourceNcloudAutoScalingScheduleRead,
-		Update: resourceNcloudAutoScalingScheduleUpdate,
-		Delete: resourceNcloudAutoScalingScheduleDelete,
+		CreateContext: resourceNcloudAutoScalingScheduleCreate,
+		ReadContext:   resourceNcloudAutoScalingScheduleRead,
+		UpdateContext: resourceNcloudAutoScalingScheduleUpdate,
+		DeleteContext: resourceNcloudAutoScalingScheduleDelete,
 		Importer: &schema.ResourceImporter{
 			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 				idParts := strings.Split(d.Id(), ":")
@@ -95,26 +97,26 @@ func ResourceNcloudAutoScalingSchedule() *schema.Resource {
 	}
 }
 
-func resourceNcloudAutoScalingScheduleCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudAutoScalingScheduleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*conn.ProviderConfig)
-	id, err := createAutoScalingSchedule(d, config)
+	id, err := createAutoScalingSchedule(ctx, d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(ncloud.StringValue(id))
-	return resourceNcloudAutoScalingScheduleRead(d, meta)
+	return resourceNcloudAutoScalingScheduleRead(ctx, d, meta)
 }
 
-func createAutoScalingSchedule(d *schema.ResourceData, config *conn.ProviderConfig) (*string, error) {
+func createAutoScalingSchedule(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) (*string, error) {
 	if config.SupportVPC {
-		return createVpcAutoScalingSchedule(d, config)
+		return createVpcAutoScalingSchedule(ctx, d, config)
 	} else {
-		return createClassicAutoScalingSchedule(d, config)
+		return createClassicAutoScalingSchedule(ctx, d, config)
 	}
 }
 
-func createVpcAutoScalingSchedule(d *schema.ResourceData, config *conn.ProviderConfig) (*string, error) {
+func createVpcAutoScalingSchedule(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) (*string, error) {
 	reqParams := &vautoscaling.PutScheduledUpdateGroupActionRequest{
 		RegionCode: &config.RegionCode,
 		// Required
@@ -129,20 +131,20 @@ func createVpcAutoScalingSchedule(d *schema.ResourceData, config *conn.ProviderC
 		Recurrence: StringPtrOrNil(d.GetOk("recurrence")),
 		TimeZone:   StringPtrOrNil(d.GetOk("time_zone")),
 	}
-	LogCommonRequest("createVpcAutoScalingSchedule", reqParams)
+	ctx = LogCommonRequest(ctx, "createVpcAutoScalingSchedule", reqParams)
 
 	resp, err := config.Client.Vautoscaling.V2Api.PutScheduledUpdateGroupAction(reqParams)
 	if err != nil {
 		return nil, err
 	}
-	LogResponse("createVpcAutoScalingSchedule", resp)
+	LogResponse(ctx, "createVpcAutoScalingSchedule", resp)
 
 	return resp.ScheduledUpdateGroupActionList[0].ScheduledActionNo, nil
 }
 
-func createClassicAutoScalingSchedule(d *schema.ResourceData, config *conn.ProviderConfig) (*string, error) {
+func createClassicAutoScalingSchedule(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) (*string, error) {
 	asgNo := d.Get("auto_scaling_group_no").(string)
-	asg, err := getClassicAutoScalingGroup(config, asgNo)
+	asg, err := getClassicAutoScalingGroup(ctx, config, asgNo)
 	if err != nil {
 		return nil, err
 	}
@@ -158,22 +160,22 @@ func createClassicAutoScalingSchedule(d *schema.ResourceData, config *conn.Provi
 		EndTime:         StringPtrOrNil(d.GetOk("end_time")),
 		RecurrenceInKST: StringPtrOrNil(d.GetOk("recurrence")),
 	}
-	LogCommonRequest("createClassicAutoScalingSchedule", reqParams)
+	ctx = LogCommonRequest(ctx, "createClassicAutoScalingSchedule", reqParams)
 
 	resp, err := config.Client.Autoscaling.V2Api.PutScheduledUpdateGroupAction(reqParams)
 	if err != nil {
 		return nil, err
 	}
-	LogResponse("createClassicAutoScalingSchedule", resp)
+	LogResponse(ctx, "createClassicAutoScalingSchedule", resp)
 
 	return resp.ScheduledUpdateGroupActionList[0].ScheduledActionName, nil
 }
 
-func resourceNcloudAutoScalingScheduleRead(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudAutoScalingScheduleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*conn.ProviderConfig)
-	schedule, err := GetAutoScalingSchedule(config, d.Id(), d.Get("auto_scaling_group_no").(string))
+	schedule, err := GetAutoScalingSchedule(ctx, config, d.Id(), d.Get("auto_scaling_group_no").(string))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if schedule == nil {
@@ -186,27 +188,27 @@ func resourceNcloudAutoScalingScheduleRead(d *schema.ResourceData, meta interfac
 	return nil
 }
 
-func GetAutoScalingSchedule(config *conn.ProviderConfig, id string, asgNo string) (*AutoScalingSchedule, error) {
+func GetAutoScalingSchedule(ctx context.Context, config *conn.ProviderConfig, id string, asgNo string) (*AutoScalingSchedule, error) {
 	if config.SupportVPC {
-		return getVpcAutoScalingSchedule(config, id, asgNo)
+		return getVpcAutoScalingSchedule(ctx, config, id, asgNo)
 	} else {
-		return getClassicAutoScalingSchedule(config, id, asgNo)
+		return getClassicAutoScalingSchedule(ctx, config, id, asgNo)
 	}
 }
 
-func getVpcAutoScalingSchedule(config *conn.ProviderConfig, id string, asgNo string) (*AutoScalingSchedule, error) {
+func getVpcAutoScalingSchedule(ctx context.Context, config *conn.ProviderConfig, id string, asgNo string) (*AutoScalingSchedule, error) {
 	reqParams := &vautoscaling.GetScheduledActionListRequest{
 		RegionCode:            &config.RegionCode,
 		AutoScalingGroupNo:    ncloud.String(asgNo),
 		ScheduledActionNoList: []*string{ncloud.String(id)},
 	}
-	LogCommonRequest("getVpcAutoScalingSchedule", reqParams)
+	ctx = LogCommonRequest(ctx, "getVpcAutoScalingSchedule", reqParams)
 
 	resp, err := config.Client.Vautoscaling.V2Api.GetScheduledActionList(reqParams)
 	if err != nil {
 		return nil, err
 	}
-	LogResponse("getVpcAutoScalingSchedule", resp)
+	LogResponse(ctx, "getVpcAutoScalingSchedule", resp)
 
 	if len(resp.ScheduledUpdateGroupActionList) < 1 {
 		return nil, nil
@@ -227,8 +229,8 @@ func getVpcAutoScalingSchedule(config *conn.ProviderConfig, id string, asgNo str
 	}, nil
 }
 
-func getClassicAutoScalingSchedule(config *conn.ProviderConfig, id string, asgNo string) (*AutoScalingSchedule, error) {
-	asg, err := getClassicAutoScalingGroup(config, asgNo)
+func getClassicAutoScalingSchedule(ctx context.Context, config *conn.ProviderConfig, id string, asgNo string) (*AutoScalingSchedule, error) {
+	asg, err := getClassicAutoScalingGroup(ctx, config, asgNo)
 	if err != nil {
 		return nil, err
 	}
@@ -240,13 +242,13 @@ func getClassicAutoScalingSchedule(config *conn.ProviderConfig, id string, asgNo
 		AutoScalingGroupName:    asg.AutoScalingGroupName,
 		ScheduledActionNameList: []*string{ncloud.String(id)},
 	}
-	LogCommonRequest("getClassicAutoScalingSchedule", reqParams)
+	ctx = LogCommonRequest(ctx, "getClassicAutoScalingSchedule", reqParams)
 
 	resp, err := config.Client.Autoscaling.V2Api.GetScheduledActionList(reqParams)
 	if err != nil {
 		return nil, err
 	}
-	LogResponse("getClassicAutoScalingSchedule", resp)
+	LogResponse(ctx, "getClassicAutoScalingSchedule", resp)
 
 	if len(resp.ScheduledUpdateGroupActionList) < 1 {
 		return nil, nil
@@ -265,32 +267,32 @@ func getClassicAutoScalingSchedule(config *conn.ProviderConfig, id string, asgNo
 	}, nil
 }
 
-func resourceNcloudAutoScalingScheduleUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudAutoScalingScheduleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*conn.ProviderConfig)
-	if _, err := createAutoScalingSchedule(d, config); err != nil {
-		return err
+	if _, err := createAutoScalingSchedule(ctx, d, config); err != nil {
+		return diag.FromErr(err)
 	}
-	return resourceNcloudAutoScalingScheduleRead(d, meta)
+	return resourceNcloudAutoScalingScheduleRead(ctx, d, meta)
 }
 
-func resourceNcloudAutoScalingScheduleDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudAutoScalingScheduleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*conn.ProviderConfig)
-	if err := deleteAutoScalingSchedule(config, d.Id(), d.Get("auto_scaling_group_no").(string)); err != nil {
-		return err
+	if err := deleteAutoScalingSchedule(ctx, config, d.Id(), d.Get("auto_scaling_group_no").(string)); err != nil {
+		return diag.FromErr(err)
 	}
 	return nil
 }
 
-func deleteAutoScalingSchedule(config *conn.ProviderConfig, id string, asgNo string) error {
+func deleteAutoScalingSchedule(ctx context.Context, config *conn.ProviderConfig, id string, asgNo string) error {
 	if config.SupportVPC {
-		return deleteVpcAutoScalingSchedule(config, id, asgNo)
+		return deleteVpcAutoScalingSchedule(ctx, config, id, asgNo)
 	} else {
-		return deleteClassicAutoScalingSchedule(config, id, asgNo)
+		return deleteClassicAutoScalingSchedule(ctx, config, id, asgNo)
 	}
 }
 
-func deleteVpcAutoScalingSchedule(config *conn.ProviderConfig, id string, asgNo string) error {
-	schedule, err := getVpcAutoScalingSchedule(config, id, asgNo)
+func deleteVpcAutoScalingSchedule(ctx context.Context, config *conn.ProviderConfig, id string, asgNo string) error {
+	schedule, err := getVpcAutoScalingSchedule(ctx, config, id, asgNo)
 	if err != nil {
 		return err
 	}
@@ -299,19 +301,19 @@ func deleteVpcAutoScalingSchedule(config *conn.ProviderConfig, id string, asgNo
 		AutoScalingGroupNo: ncloud.String(asgNo),
 		ScheduledActionNo:  schedule.ScheduledActionNo,
 	}
-	LogCommonRequest("deleteVpcAutoScalingSchedule", reqParams)
+	ctx = LogCommonRequest(ctx, "deleteVpcAutoScalingSchedule", reqParams)
 
 	resp, err := config.Client.Vautoscaling.V2Api.DeleteScheduledAction(reqParams)
 	if err != nil {
 		return err
 	}
-	LogResponse("deleteVpcAutoScalingSchedule", resp)
+	LogResponse(ctx, "deleteVpcAutoScalingSchedule", resp)
 
 	return nil
 }
 
-func deleteClassicAutoScalingSchedule(config *conn.ProviderConfig, id string, asgNo string) error {
-	asg, err := getClassicAutoScalingGroup(config, asgNo)
+func deleteClassicAutoScalingSchedule(ctx context.Context, config *conn.ProviderConfig, id string, asgNo string) error {
+	asg, err := getClassicAutoScalingGroup(ctx, config, asgNo)
 	if err != nil {
 		return err
 	}
@@ -319,13 +321,13 @@ func deleteClassicAutoScalingSchedule(config *conn.ProviderConfig, id string, as
 		AutoScalingGroupName: asg.AutoScalingGroupName,
 		ScheduledActionName:  ncloud.String(id),
 	}
-	LogCommonRequest("deleteClassicAutoScalingSchedule", reqParams)
+	ctx = LogCommonRequest(ctx, "deleteClassicAutoScalingSchedule", reqParams)
 
 	resp, err := config.Client.Autoscaling.V2Api.DeleteScheduledAction(reqParams)
 	if err != nil {
 		return err
 	}
-	LogResponse("deleteClassicAutoScalingSchedule", resp)
+	LogResponse(ctx, "deleteClassicAutoScalingSchedule", resp)
 
 	return nil
 }
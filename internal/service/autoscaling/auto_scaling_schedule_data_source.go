@@ -1,13 +1,17 @@
 package autoscaling
 
 import (
+	"context"
+
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/autoscaling"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vautoscaling"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/paging"
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/verify"
 )
 
@@ -25,19 +29,19 @@ func DataSourceNcloudAutoScalingSchedule() *schema.Resource {
 		"filter": DataSourceFiltersSchema(),
 	}
 
-	return GetSingularDataSourceItemSchema(ResourceNcloudAutoScalingSchedule(), fieldMap, dataSourceNcloudAutoScalingScheduleRead)
+	return GetSingularDataSourceItemSchemaContext(ResourceNcloudAutoScalingSchedule(), fieldMap, dataSourceNcloudAutoScalingScheduleRead)
 }
 
-func dataSourceNcloudAutoScalingScheduleRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceNcloudAutoScalingScheduleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*conn.ProviderConfig)
 
 	if v, ok := d.GetOk("id"); ok {
 		d.SetId(v.(string))
 	}
 
-	scheduleList, err := getAutoScalingScheduleList(d, config)
+	scheduleList, err := getAutoScalingScheduleList(ctx, d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	scheduleListMap := ConvertToArrayMap(scheduleList)
@@ -46,7 +50,7 @@ func dataSourceNcloudAutoScalingScheduleRead(d *schema.ResourceData, meta interf
 	}
 
 	if err := ValidateOneResult(len(scheduleListMap)); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(scheduleListMap[0]["name"].(string))
@@ -54,15 +58,15 @@ func dataSourceNcloudAutoScalingScheduleRead(d *schema.ResourceData, meta interf
 	return nil
 }
 
-func getAutoScalingScheduleList(d *schema.ResourceData, config *conn.ProviderConfig) ([]*AutoScalingSchedule, error) {
+func getAutoScalingScheduleList(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) ([]*AutoScalingSchedule, error) {
 	if config.SupportVPC {
-		return getVpcAutoScalingScheduleList(d, config)
+		return getVpcAutoScalingScheduleList(ctx, d, config)
 	} else {
-		return getClassicAutoScalingScheduleList(d, config)
+		return getClassicAutoScalingScheduleList(ctx, d, config)
 	}
 }
 
-func getVpcAutoScalingScheduleList(d *schema.ResourceData, config *conn.ProviderConfig) ([]*AutoScalingSchedule, error) {
+func getVpcAutoScalingScheduleList(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) ([]*AutoScalingSchedule, error) {
 	reqParams := &vautoscaling.GetScheduledActionListRequest{
 		RegionCode:         &config.RegionCode,
 		AutoScalingGroupNo: ncloud.String(d.Get("auto_scaling_group_no").(string)),
@@ -71,13 +75,22 @@ func getVpcAutoScalingScheduleList(d *schema.ResourceData, config *conn.Provider
 		reqParams.ScheduledActionNameList = []*string{ncloud.String(d.Id())}
 	}
 
-	resp, err := config.Client.Vautoscaling.V2Api.GetScheduledActionList(reqParams)
+	scheduledUpdateGroupActionList, err := paging.All(ctx, func(ctx context.Context, pageNo, pageSize int32) ([]*vautoscaling.ScheduledUpdateGroupAction, *int32, error) {
+		reqParams.PageNo = ncloud.Int32(pageNo)
+		reqParams.PageSize = ncloud.Int32(pageSize)
+
+		resp, err := config.Client.Vautoscaling.V2Api.GetScheduledActionList(reqParams)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.ScheduledUpdateGroupActionList, resp.TotalRows, nil
+	})
 	if err != nil {
 		return nil, err
 	}
 
 	list := make([]*AutoScalingSchedule, 0)
-	for _, s := range resp.ScheduledUpdateGroupActionList {
+	for _, s := range scheduledUpdateGroupActionList {
 		schedule := &AutoScalingSchedule{
 			ScheduledActionNo:   s.ScheduledActionNo,
 			ScheduledActionName: s.ScheduledActionName,
@@ -99,21 +112,30 @@ func getVpcAutoScalingScheduleList(d *schema.ResourceData, config *conn.Provider
 	return list, nil
 }
 
-func getClassicAutoScalingScheduleList(d *schema.ResourceData, config *conn.ProviderConfig) ([]*AutoScalingSchedule, error) {
+func getClassicAutoScalingScheduleList(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) ([]*AutoScalingSchedule, error) {
 	reqParams := &autoscaling.GetScheduledActionListRequest{}
 
 	if d.Id() != "" {
 		reqParams.ScheduledActionNameList = []*string{ncloud.String(d.Id())}
 	}
 
-	resp, err := config.Client.Autoscaling.V2Api.GetScheduledActionList(reqParams)
+	scheduledUpdateGroupActionList, err := paging.All(ctx, func(ctx context.Context, pageNo, pageSize int32) ([]*autoscaling.ScheduledUpdateGroupAction, *int32, error) {
+		reqParams.PageNo = ncloud.Int32(pageNo)
+		reqParams.PageSize = ncloud.Int32(pageSize)
+
+		resp, err := config.Client.Autoscaling.V2Api.GetScheduledActionList(reqParams)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.ScheduledUpdateGroupActionList, resp.TotalRows, nil
+	})
 	if err != nil {
 		return nil, err
 	}
 
 	list := make([]*AutoScalingSchedule, 0)
-	for _, s := range resp.ScheduledUpdateGroupActionList {
-		asg, err := getClassicAutoScalingGroupByName(config, *s.AutoScalingGroupName)
+	for _, s := range scheduledUpdateGroupActionList {
+		asg, err := getClassicAutoScalingGroupByName(ctx, config, *s.AutoScalingGroupName)
 		if err != nil {
 			return nil, err
 		}
@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/acctest"
@@ -14,7 +13,7 @@ func TestAccDataSourceNcloudAutoScalingSchedule_classic_basic(t *testing.T) {
 	// Images are all deprecated in Classic
 	t.Skip()
 
-	name := fmt.Sprintf("terraform-testacc-asp-%s", acctest.RandString(5))
+	name := fmt.Sprintf("terraform-testacc-asp-%s", RandString(t, 5))
 	dataName := "data.ncloud_auto_scaling_schedule.schedule"
 	resourceName := "ncloud_auto_scaling_schedule.test-schedule"
 	start := testAccNcloudAutoscalingScheduleValidStart(t)
@@ -42,7 +41,7 @@ func TestAccDataSourceNcloudAutoScalingSchedule_classic_basic(t *testing.T) {
 }
 
 func TestAccDataSourceNcloudAutoScalingSchedule_vpc_basic(t *testing.T) {
-	name := fmt.Sprintf("terraform-testacc-asp-%s", acctest.RandString(5))
+	name := fmt.Sprintf("terraform-testacc-asp-%s", RandString(t, 5))
 	dataName := "data.ncloud_auto_scaling_schedule.schedule"
 	resourceName := "ncloud_auto_scaling_schedule.test-schedule"
 	start := testAccNcloudAutoscalingScheduleValidStart(t)
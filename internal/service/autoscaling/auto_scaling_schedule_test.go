@@ -1,12 +1,12 @@
 package autoscaling_test
 
 import (
+	"context"
 	"fmt"
 	"testing"
 	"time"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -21,7 +21,7 @@ func TestAccResourceNcloudAutoScalingSchedule_classic_basic(t *testing.T) {
 	t.Skip()
 
 	var schedule autoscaling.AutoScalingSchedule
-	name := fmt.Sprintf("terraform-testacc-asp-%s", acctest.RandString(5))
+	name := fmt.Sprintf("terraform-testacc-asp-%s", RandString(t, 5))
 	resourceName := "ncloud_auto_scaling_schedule.test-schedule"
 	start := testAccNcloudAutoscalingScheduleValidStart(t)
 	end := testAccNcloudAutoscalingScheduleValidEnd(t)
@@ -45,7 +45,7 @@ func TestAccResourceNcloudAutoScalingSchedule_classic_basic(t *testing.T) {
 
 func TestAccResourceNcloudAutoScalingSchedule_vpc_basic(t *testing.T) {
 	var schedule autoscaling.AutoScalingSchedule
-	name := fmt.Sprintf("terraform-testacc-asp-%s", acctest.RandString(5))
+	name := fmt.Sprintf("terraform-testacc-asp-%s", RandString(t, 5))
 	resourceName := "ncloud_auto_scaling_schedule.test-schedule"
 	start := testAccNcloudAutoscalingScheduleValidStart(t)
 	end := testAccNcloudAutoscalingScheduleValidEnd(t)
@@ -71,7 +71,7 @@ func TestAccResourceNcloudAutoScalingSchedule_classic_disappears(t *testing.T) {
 	t.Skip()
 
 	var schedule autoscaling.AutoScalingSchedule
-	name := fmt.Sprintf("terraform-testacc-asp-%s", acctest.RandString(5))
+	name := fmt.Sprintf("terraform-testacc-asp-%s", RandString(t, 5))
 	resourceName := "ncloud_auto_scaling_schedule.test-schedule"
 	start := testAccNcloudAutoscalingScheduleValidStart(t)
 	end := testAccNcloudAutoscalingScheduleValidEnd(t)
@@ -97,7 +97,7 @@ func TestAccResourceNcloudAutoScalingSchedule_classic_disappears(t *testing.T) {
 
 func TestAccResourceNcloudAutoScalingSchedule_vpc_disappears(t *testing.T) {
 	var schedule autoscaling.AutoScalingSchedule
-	name := fmt.Sprintf("terraform-testacc-asp-%s", acctest.RandString(5))
+	name := fmt.Sprintf("terraform-testacc-asp-%s", RandString(t, 5))
 	resourceName := "ncloud_auto_scaling_schedule.test-schedule"
 	start := testAccNcloudAutoscalingScheduleValidStart(t)
 	end := testAccNcloudAutoscalingScheduleValidEnd(t)
@@ -149,7 +149,7 @@ func testAccCheckNcloudAutoScalingScheduleDestroy(s *terraform.State, provider *
 		if rs.Type != "ncloud_auto_scaling_schedule" {
 			continue
 		}
-		autoScalingSchedule, err := autoscaling.GetAutoScalingSchedule(config, rs.Primary.ID, rs.Primary.Attributes["auto_scaling_group_no"])
+		autoScalingSchedule, err := autoscaling.GetAutoScalingSchedule(context.Background(), config, rs.Primary.ID, rs.Primary.Attributes["auto_scaling_group_no"])
 		if err != nil {
 			return err
 		}
@@ -173,7 +173,7 @@ func testAccCheckNcloudAutoScalingScheduleExists(n string, schedule *autoscaling
 		}
 
 		config := provider.Meta().(*conn.ProviderConfig)
-		autoScalingSchedule, err := autoscaling.GetAutoScalingSchedule(config, rs.Primary.ID, rs.Primary.Attributes["auto_scaling_group_no"])
+		autoScalingSchedule, err := autoscaling.GetAutoScalingSchedule(context.Background(), config, rs.Primary.ID, rs.Primary.Attributes["auto_scaling_group_no"])
 		if err != nil {
 			return err
 		}
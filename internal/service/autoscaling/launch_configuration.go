@@ -1,11 +1,13 @@
 package autoscaling
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/autoscaling"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vautoscaling"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -15,9 +17,9 @@ import (
 
 func ResourceNcloudLaunchConfiguration() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNcloudLaunchConfigurationCreate,
-		Read:   resourceNcloudLaunchConfigurationRead,
-		Delete: resourceNcloudLaunchConfigurationDelete,
+		CreateContext: resourceNcloudLaunchConfigurationCreate,
+		ReadContext:   resourceNcloudLaunchConfigurationRead,
+		DeleteContext: resourceNcloudLaunchConfigurationDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -86,26 +88,26 @@ func ResourceNcloudLaunchConfiguration() *schema.Resource {
 	}
 }
 
-func resourceNcloudLaunchConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudLaunchConfigurationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*conn.ProviderConfig)
-	id, err := createLaunchConfiguration(d, config)
+	id, err := createLaunchConfiguration(ctx, d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(ncloud.StringValue(id))
-	return resourceNcloudLaunchConfigurationRead(d, meta)
+	return resourceNcloudLaunchConfigurationRead(ctx, d, meta)
 }
 
-func createLaunchConfiguration(d *schema.ResourceData, config *conn.ProviderConfig) (*string, error) {
+func createLaunchConfiguration(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) (*string, error) {
 	if config.SupportVPC {
-		return createVpcLaunchConfiguration(d, config)
+		return createVpcLaunchConfiguration(ctx, d, config)
 	} else {
-		return createClassicLaunchConfiguration(d, config)
+		return createClassicLaunchConfiguration(ctx, d, config)
 	}
 }
 
-func createVpcLaunchConfiguration(d *schema.ResourceData, config *conn.ProviderConfig) (*string, error) {
+func createVpcLaunchConfiguration(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) (*string, error) {
 	reqParams := &vautoscaling.CreateLaunchConfigurationRequest{
 		RegionCode:                  &config.RegionCode,
 		ServerImageProductCode:      StringPtrOrNil(d.GetOk("server_image_product_code")),
@@ -117,17 +119,17 @@ func createVpcLaunchConfiguration(d *schema.ResourceData, config *conn.ProviderC
 		LoginKeyName:                StringPtrOrNil(d.GetOk("login_key_name")),
 	}
 
-	LogCommonRequest("createVpcLaunchConfiguration", reqParams)
+	ctx = LogCommonRequest(ctx, "createVpcLaunchConfiguration", reqParams)
 	res, err := config.Client.Vautoscaling.V2Api.CreateLaunchConfiguration(reqParams)
 	if err != nil {
-		LogErrorResponse("createVpcLaunchConfiguration", err, reqParams)
+		LogErrorResponse(ctx, "createVpcLaunchConfiguration", err, reqParams)
 		return nil, err
 	}
-	LogResponse("createVpcLaunchConfiguration", res)
+	LogResponse(ctx, "createVpcLaunchConfiguration", res)
 	return res.LaunchConfigurationList[0].LaunchConfigurationNo, nil
 }
 
-func createClassicLaunchConfiguration(d *schema.ResourceData, config *conn.ProviderConfig) (*string, error) {
+func createClassicLaunchConfiguration(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) (*string, error) {
 	reqParams := &autoscaling.CreateLaunchConfigurationRequest{
 		LaunchConfigurationName: StringPtrOrNil(d.GetOk("name")),
 		ServerImageProductCode:  StringPtrOrNil(d.GetOk("server_image_product_code")),
@@ -142,22 +144,22 @@ func createClassicLaunchConfiguration(d *schema.ResourceData, config *conn.Provi
 		reqParams.AccessControlGroupConfigurationNoList = ExpandStringInterfaceList(param.([]interface{}))
 	}
 
-	LogCommonRequest("createClassicLaunchConfiguration", reqParams)
+	ctx = LogCommonRequest(ctx, "createClassicLaunchConfiguration", reqParams)
 	res, err := config.Client.Autoscaling.V2Api.CreateLaunchConfiguration(reqParams)
 	if err != nil {
-		LogErrorResponse("createClassicLaunchConfiguration", err, reqParams)
+		LogErrorResponse(ctx, "createClassicLaunchConfiguration", err, reqParams)
 		return nil, err
 	}
-	LogResponse("createClassicLaunchConfiguration", res)
+	LogResponse(ctx, "createClassicLaunchConfiguration", res)
 	return res.LaunchConfigurationList[0].LaunchConfigurationNo, nil
 }
 
-func resourceNcloudLaunchConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudLaunchConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*conn.ProviderConfig)
 
-	launchConfig, err := GetLaunchConfiguration(config, d.Id())
+	launchConfig, err := GetLaunchConfiguration(ctx, config, d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if launchConfig == nil {
@@ -171,15 +173,15 @@ func resourceNcloudLaunchConfigurationRead(d *schema.ResourceData, meta interfac
 	return nil
 }
 
-func GetLaunchConfiguration(config *conn.ProviderConfig, id string) (*LaunchConfiguration, error) {
+func GetLaunchConfiguration(ctx context.Context, config *conn.ProviderConfig, id string) (*LaunchConfiguration, error) {
 	if config.SupportVPC {
-		return getVpcLaunchConfiguration(config, id)
+		return getVpcLaunchConfiguration(ctx, config, id)
 	} else {
-		return GetClassicLaunchConfiguration(config, id)
+		return GetClassicLaunchConfiguration(ctx, config, id)
 	}
 }
 
-func getVpcLaunchConfiguration(config *conn.ProviderConfig, id string) (*LaunchConfiguration, error) {
+func getVpcLaunchConfiguration(ctx context.Context, config *conn.ProviderConfig, id string) (*LaunchConfiguration, error) {
 	reqParams := &vautoscaling.GetLaunchConfigurationListRequest{
 		RegionCode: &config.RegionCode,
 	}
@@ -188,13 +190,13 @@ func getVpcLaunchConfiguration(config *conn.ProviderConfig, id string) (*LaunchC
 		reqParams.LaunchConfigurationNoList = []*string{ncloud.String(id)}
 	}
 
-	LogCommonRequest("getVpcLaunchConfiguration", reqParams)
+	ctx = LogCommonRequest(ctx, "getVpcLaunchConfiguration", reqParams)
 	resp, err := config.Client.Vautoscaling.V2Api.GetLaunchConfigurationList(reqParams)
 	if err != nil {
-		LogErrorResponse("getVpcLaunchConfiguration", err, reqParams)
+		LogErrorResponse(ctx, "getVpcLaunchConfiguration", err, reqParams)
 		return nil, err
 	}
-	LogResponse("getVpcLaunchConfiguration", resp)
+	LogResponse(ctx, "getVpcLaunchConfiguration", resp)
 
 	if len(resp.LaunchConfigurationList) < 1 {
 		return nil, nil
@@ -215,18 +217,18 @@ func getVpcLaunchConfiguration(config *conn.ProviderConfig, id string) (*LaunchC
 	}, nil
 }
 
-func GetClassicLaunchConfiguration(config *conn.ProviderConfig, id string) (*LaunchConfiguration, error) {
+func GetClassicLaunchConfiguration(ctx context.Context, config *conn.ProviderConfig, id string) (*LaunchConfiguration, error) {
 	no := ncloud.String(id)
 	reqParams := &autoscaling.GetLaunchConfigurationListRequest{
 		RegionNo: &config.RegionNo,
 	}
-	LogCommonRequest("getClassicLaunchConfiguration", reqParams)
+	ctx = LogCommonRequest(ctx, "getClassicLaunchConfiguration", reqParams)
 	resp, err := config.Client.Autoscaling.V2Api.GetLaunchConfigurationList(reqParams)
 	if err != nil {
-		LogErrorResponse("getClassicLaunchConfiguration", err, reqParams)
+		LogErrorResponse(ctx, "getClassicLaunchConfiguration", err, reqParams)
 		return nil, err
 	}
-	LogResponse("getClassicLaunchConfiguration", resp)
+	LogResponse(ctx, "getClassicLaunchConfiguration", resp)
 
 	for _, l := range resp.LaunchConfigurationList {
 		if *l.LaunchConfigurationNo == *no {
@@ -246,42 +248,42 @@ func GetClassicLaunchConfiguration(config *conn.ProviderConfig, id string) (*Lau
 	return nil, nil
 }
 
-func resourceNcloudLaunchConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudLaunchConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*conn.ProviderConfig)
 
-	err := deleteLaunchConfiguration(config, d.Id())
+	err := deleteLaunchConfiguration(ctx, config, d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
 }
 
-func deleteLaunchConfiguration(config *conn.ProviderConfig, id string) error {
+func deleteLaunchConfiguration(ctx context.Context, config *conn.ProviderConfig, id string) error {
 	if config.SupportVPC {
-		return deleteVpcLaunchConfiguration(config, id)
+		return deleteVpcLaunchConfiguration(ctx, config, id)
 	} else {
-		return deleteClassicLaunchConfiguration(config, id)
+		return deleteClassicLaunchConfiguration(ctx, config, id)
 	}
 }
 
-func deleteVpcLaunchConfiguration(config *conn.ProviderConfig, id string) error {
+func deleteVpcLaunchConfiguration(ctx context.Context, config *conn.ProviderConfig, id string) error {
 	reqParams := &vautoscaling.DeleteLaunchConfigurationRequest{
 		LaunchConfigurationNo: ncloud.String(id),
 	}
 
-	LogCommonRequest("deleteVpcLaunchConfiguration", reqParams)
+	ctx = LogCommonRequest(ctx, "deleteVpcLaunchConfiguration", reqParams)
 	res, err := config.Client.Vautoscaling.V2Api.DeleteLaunchConfiguration(reqParams)
 	if err != nil {
-		LogErrorResponse("deleteVpcLaunchConfiguration", err, reqParams)
+		LogErrorResponse(ctx, "deleteVpcLaunchConfiguration", err, reqParams)
 		return err
 	}
-	LogResponse("deleteVpcLaunchConfiguration", res)
+	LogResponse(ctx, "deleteVpcLaunchConfiguration", res)
 	return nil
 }
 
-func deleteClassicLaunchConfiguration(config *conn.ProviderConfig, id string) error {
-	launchConfig, err := GetClassicLaunchConfiguration(config, id)
+func deleteClassicLaunchConfiguration(ctx context.Context, config *conn.ProviderConfig, id string) error {
+	launchConfig, err := GetClassicLaunchConfiguration(ctx, config, id)
 	if err != nil {
 		return err
 	}
@@ -294,18 +296,18 @@ func deleteClassicLaunchConfiguration(config *conn.ProviderConfig, id string) er
 		LaunchConfigurationName: launchConfig.LaunchConfigurationName,
 	}
 
-	LogCommonRequest("deleteClassicLaunchConfiguration", reqParams)
+	ctx = LogCommonRequest(ctx, "deleteClassicLaunchConfiguration", reqParams)
 	res, err := config.Client.Autoscaling.V2Api.DeleteAutoScalingLaunchConfiguration(reqParams)
 	if err != nil {
-		LogErrorResponse("deleteClassicLaunchConfiguration", err, reqParams)
+		LogErrorResponse(ctx, "deleteClassicLaunchConfiguration", err, reqParams)
 		return err
 	}
-	LogResponse("deleteClassicLaunchConfiguration", res)
+	LogResponse(ctx, "deleteClassicLaunchConfiguration", res)
 
 	return nil
 }
 
-func GetClassicLaunchConfigurationByNo(no *string, config *conn.ProviderConfig) (*LaunchConfiguration, error) {
+func GetClassicLaunchConfigurationByNo(ctx context.Context, no *string, config *conn.ProviderConfig) (*LaunchConfiguration, error) {
 	reqParams := &autoscaling.GetLaunchConfigurationListRequest{
 		RegionNo: &config.RegionNo,
 	}
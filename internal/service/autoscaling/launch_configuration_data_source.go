@@ -1,13 +1,17 @@
 package autoscaling
 
 import (
+	"context"
+
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/autoscaling"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vautoscaling"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/paging"
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/verify"
 )
 
@@ -20,19 +24,19 @@ func DataSourceNcloudLaunchConfiguration() *schema.Resource {
 		},
 		"filter": DataSourceFiltersSchema(),
 	}
-	return GetSingularDataSourceItemSchema(ResourceNcloudLaunchConfiguration(), fieldMap, dataSourceNcloudLaunchConfigurationRead)
+	return GetSingularDataSourceItemSchemaContext(ResourceNcloudLaunchConfiguration(), fieldMap, dataSourceNcloudLaunchConfigurationRead)
 }
 
-func dataSourceNcloudLaunchConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceNcloudLaunchConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*conn.ProviderConfig)
 
 	if v, ok := d.GetOk("id"); ok {
 		d.SetId(v.(string))
 	}
 
-	launchConfigList, err := getLaunchConfigurationList(config, d.Id())
+	launchConfigList, err := getLaunchConfigurationList(ctx, config, d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if launchConfigList == nil {
@@ -45,7 +49,7 @@ func dataSourceNcloudLaunchConfigurationRead(d *schema.ResourceData, meta interf
 	}
 
 	if err := ValidateOneResult(len(launchConfigListMap)); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(launchConfigListMap[0]["launch_configuration_no"].(string))
@@ -53,15 +57,15 @@ func dataSourceNcloudLaunchConfigurationRead(d *schema.ResourceData, meta interf
 	return nil
 }
 
-func getLaunchConfigurationList(config *conn.ProviderConfig, id string) ([]*LaunchConfiguration, error) {
+func getLaunchConfigurationList(ctx context.Context, config *conn.ProviderConfig, id string) ([]*LaunchConfiguration, error) {
 	if config.SupportVPC {
-		return getVpcLaunchConfigurationList(config, id)
+		return getVpcLaunchConfigurationList(ctx, config, id)
 	} else {
-		return getClassicLaunchConfigurationList(config, id)
+		return getClassicLaunchConfigurationList(ctx, config, id)
 	}
 }
 
-func getVpcLaunchConfigurationList(config *conn.ProviderConfig, id string) ([]*LaunchConfiguration, error) {
+func getVpcLaunchConfigurationList(ctx context.Context, config *conn.ProviderConfig, id string) ([]*LaunchConfiguration, error) {
 	reqParams := &vautoscaling.GetLaunchConfigurationListRequest{
 		RegionCode: &config.RegionCode,
 	}
@@ -70,20 +74,30 @@ func getVpcLaunchConfigurationList(config *conn.ProviderConfig, id string) ([]*L
 		reqParams.LaunchConfigurationNoList = []*string{ncloud.String(id)}
 	}
 
-	LogCommonRequest("getVpcLaunchConfigurationList", reqParams)
-	resp, err := config.Client.Vautoscaling.V2Api.GetLaunchConfigurationList(reqParams)
+	launchConfigurationList, err := paging.All(ctx, func(ctx context.Context, pageNo, pageSize int32) ([]*vautoscaling.LaunchConfiguration, *int32, error) {
+		reqParams.PageNo = ncloud.Int32(pageNo)
+		reqParams.PageSize = ncloud.Int32(pageSize)
+
+		ctx = LogCommonRequest(ctx, "getVpcLaunchConfigurationList", reqParams)
+		resp, err := config.Client.Vautoscaling.V2Api.GetLaunchConfigurationList(reqParams)
+		if err != nil {
+			LogErrorResponse(ctx, "getVpcLaunchConfigurationList", err, reqParams)
+			return nil, nil, err
+		}
+		LogResponse(ctx, "getVpcLaunchConfigurationList", resp)
+
+		return resp.LaunchConfigurationList, resp.TotalRows, nil
+	})
 	if err != nil {
-		LogErrorResponse("getVpcLaunchConfigurationList", err, reqParams)
 		return nil, err
 	}
-	LogResponse("getVpcLaunchConfigurationList", resp)
 
-	if len(resp.LaunchConfigurationList) < 1 {
+	if len(launchConfigurationList) < 1 {
 		return nil, nil
 	}
 
 	list := make([]*LaunchConfiguration, 0)
-	for _, l := range resp.LaunchConfigurationList {
+	for _, l := range launchConfigurationList {
 		list = append(list, &LaunchConfiguration{
 			LaunchConfigurationName:     l.LaunchConfigurationName,
 			ServerImageProductCode:      l.ServerImageProductCode,
@@ -99,21 +113,31 @@ func getVpcLaunchConfigurationList(config *conn.ProviderConfig, id string) ([]*L
 	return list, nil
 }
 
-func getClassicLaunchConfigurationList(config *conn.ProviderConfig, id string) ([]*LaunchConfiguration, error) {
+func getClassicLaunchConfigurationList(ctx context.Context, config *conn.ProviderConfig, id string) ([]*LaunchConfiguration, error) {
 	no := ncloud.String(id)
 	reqParams := &autoscaling.GetLaunchConfigurationListRequest{
 		RegionNo: &config.RegionNo,
 	}
-	LogCommonRequest("getClassicLaunchConfigurationList", reqParams)
-	resp, err := config.Client.Autoscaling.V2Api.GetLaunchConfigurationList(reqParams)
+	launchConfigurationList, err := paging.All(ctx, func(ctx context.Context, pageNo, pageSize int32) ([]*autoscaling.LaunchConfiguration, *int32, error) {
+		reqParams.PageNo = ncloud.Int32(pageNo)
+		reqParams.PageSize = ncloud.Int32(pageSize)
+
+		ctx = LogCommonRequest(ctx, "getClassicLaunchConfigurationList", reqParams)
+		resp, err := config.Client.Autoscaling.V2Api.GetLaunchConfigurationList(reqParams)
+		if err != nil {
+			LogErrorResponse(ctx, "getClassicLaunchConfigurationList", err, reqParams)
+			return nil, nil, err
+		}
+		LogResponse(ctx, "getClassicLaunchConfigurationList", resp)
+
+		return resp.LaunchConfigurationList, resp.TotalRows, nil
+	})
 	if err != nil {
-		LogErrorResponse("getClassicLaunchConfigurationList", err, reqParams)
 		return nil, err
 	}
-	LogResponse("getClassicLaunchConfigurationList", resp)
 
 	list := make([]*LaunchConfiguration, 0)
-	for _, l := range resp.LaunchConfigurationList {
+	for _, l := range launchConfigurationList {
 		launchConfiguration := &LaunchConfiguration{
 			LaunchConfigurationNo:       l.LaunchConfigurationNo,
 			LaunchConfigurationName:     l.LaunchConfigurationName,
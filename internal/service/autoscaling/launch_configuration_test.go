@@ -1,6 +1,7 @@
 package autoscaling_test
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -134,7 +135,7 @@ func testAccCheckLaunchConfigurationExists(n string, l *autoscaling.LaunchConfig
 		}
 
 		config := provider.Meta().(*conn.ProviderConfig)
-		launchConfiguration, err := autoscaling.GetLaunchConfiguration(config, rs.Primary.ID)
+		launchConfiguration, err := autoscaling.GetLaunchConfiguration(context.Background(), config, rs.Primary.ID)
 		if err != nil {
 			return err
 		}
@@ -153,7 +154,7 @@ func testAccCheckLaunchConfigurationDestroy(s *terraform.State, provider *schema
 		if rs.Type != "ncloud_launch_configuration" {
 			continue
 		}
-		launchConfiguration, err := autoscaling.GetClassicLaunchConfiguration(config, rs.Primary.ID)
+		launchConfiguration, err := autoscaling.GetClassicLaunchConfiguration(context.Background(), config, rs.Primary.ID)
 		if err != nil {
 			return err
 		}
@@ -0,0 +1,78 @@
+package autoscaling
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/sweep"
+)
+
+// RegisterSweepers registers the sweepers of auto scaling groups and launch configurations.
+// Policies and schedules are deleted with their group.
+func RegisterSweepers() {
+	sweep.AddTestSweepers("ncloud_auto_scaling_group", &resource.Sweeper{
+		Name: "ncloud_auto_scaling_group",
+		F:    sweepAutoScalingGroups,
+	})
+
+	sweep.AddTestSweepers("ncloud_launch_configuration", &resource.Sweeper{
+		Name: "ncloud_launch_configuration",
+		F:    sweepLaunchConfigurations,
+		Dependencies: []string{
+			"ncloud_auto_scaling_group",
+		},
+	})
+
+	sweep.AddChildTestSweepers("ncloud_auto_scaling_group",
+		"ncloud_auto_scaling_policy",
+		"ncloud_auto_scaling_schedule",
+	)
+}
+
+func sweepAutoScalingGroups(region string) error {
+	ctx := context.Background()
+	config, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+
+	groups, err := getAutoScalingGroupList(ctx, config, "")
+	if err != nil {
+		return fmt.Errorf("listing auto scaling groups: %w", err)
+	}
+
+	var sweepables []sweep.Sweepable
+	for _, g := range groups {
+		if !sweep.HasResourcePrefix(g.AutoScalingGroupName) {
+			continue
+		}
+		sweepables = append(sweepables, sweep.NewSweepResource(ResourceNcloudAutoScalingGroup(), config, *g.AutoScalingGroupNo, nil))
+	}
+
+	return sweep.SweepOrchestrator(ctx, sweepables)
+}
+
+func sweepLaunchConfigurations(region string) error {
+	ctx := context.Background()
+	config, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+
+	launchConfigurations, err := getLaunchConfigurationList(ctx, config, "")
+	if err != nil {
+		return fmt.Errorf("listing launch configurations: %w", err)
+	}
+
+	var sweepables []sweep.Sweepable
+	for _, l := range launchConfigurations {
+		if !sweep.HasResourcePrefix(l.LaunchConfigurationName) {
+			continue
+		}
+		sweepables = append(sweepables, sweep.NewSweepResource(ResourceNcloudLaunchConfiguration(), config, *l.LaunchConfigurationNo, nil))
+	}
+
+	return sweep.SweepOrchestrator(ctx, sweepables)
+}
@@ -12,12 +12,12 @@ import (
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vcdss"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/waiter"
 )
 
 const (
@@ -243,10 +243,10 @@ func resourceNcloudCDSSClusterCreate(ctx context.Context, d *schema.ResourceData
 
 	resp, _, err := config.Client.Vcdss.V1Api.ClusterCreateCDSSClusterReturnServiceGroupInstanceNoPost(ctx, reqParams)
 	if err != nil {
-		LogErrorResponse("resourceNcloudCDSSClusterCreate", err, reqParams)
+		LogErrorResponse(ctx, "resourceNcloudCDSSClusterCreate", err, reqParams)
 		return diag.FromErr(err)
 	}
-	LogResponse("resourceNcloudCDSSClusterCreate", resp)
+	LogResponse(ctx, "resourceNcloudCDSSClusterCreate", resp)
 
 	id := strconv.Itoa(int(ncloud.Int32Value(&resp.Result.ServiceGroupInstanceNo)))
 	if err := waitForCDSSClusterActive(ctx, d, config, id); err != nil {
@@ -263,6 +263,11 @@ func resourceNcloudCDSSClusterRead(ctx context.Context, d *schema.ResourceData,
 	}
 
 	cluster, err := getCDSSCluster(ctx, config, d.Id())
+	if IsNotFound(err) {
+		log.Printf("[WARN] CDSS cluster %s was not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -370,7 +375,7 @@ func checkConfigGroupNoChanged(ctx context.Context, d *schema.ResourceData, conf
 		_, n := d.GetChange("config_group_no")
 
 		newConfigGroupNo := n.(string)
-		LogCommonRequest("resourceNcloudCDSSClusterUpdate", d.Id())
+		ctx = LogCommonRequest(ctx, "resourceNcloudCDSSClusterUpdate", d.Id())
 		if err := waitForCDSSClusterActive(ctx, d, config, d.Id()); err != nil {
 			return diag.FromErr(err)
 		}
@@ -381,7 +386,7 @@ func checkConfigGroupNoChanged(ctx context.Context, d *schema.ResourceData, conf
 		}
 
 		if _, _, err := config.Client.Vcdss.V1Api.ConfigGroupSetClusterKafkaConfigGroupConfigGroupNoPost(ctx, reqParams, newConfigGroupNo); err != nil {
-			LogErrorResponse("resourceNcloudCDSSClusterUpdate", err, d.Id())
+			LogErrorResponse(ctx, "resourceNcloudCDSSClusterUpdate", err, d.Id())
 			return diag.FromErr(err)
 		}
 		if err := waitForCDSSClusterActive(ctx, d, config, d.Id()); err != nil {
@@ -398,7 +403,7 @@ func checkCmakPasswordChanged(ctx context.Context, d *schema.ResourceData, confi
 		oldCmakMap := o.([]interface{})[0].(map[string]interface{})
 		newCmakMap := n.([]interface{})[0].(map[string]interface{})
 		if oldCmakMap["user_password"] != newCmakMap["user_password"] {
-			LogCommonRequest("resourceNcloudCDSSClusterUpdate", d.Id())
+			LogCommonRequest(ctx, "resourceNcloudCDSSClusterUpdate", d.Id())
 			if err := waitForCDSSClusterActive(ctx, d, config, d.Id()); err != nil {
 				return diag.FromErr(err)
 			}
@@ -408,7 +413,7 @@ func checkCmakPasswordChanged(ctx context.Context, d *schema.ResourceData, confi
 			}
 
 			if _, _, err := config.Client.Vcdss.V1Api.ClusterResetCMAKPasswordServiceGroupInstanceNoPost(ctx, reqParams, d.Id()); err != nil {
-				LogErrorResponse("resourceNcloudCDSSClusterResetCmakUserPassword", err, d.Id())
+				LogErrorResponse(ctx, "resourceNcloudCDSSClusterResetCmakUserPassword", err, d.Id())
 				return diag.FromErr(err)
 			}
 
@@ -431,7 +436,7 @@ func checkNodeCountChanged(ctx context.Context, d *schema.ResourceData, config *
 		newDataNodeCount := *Int32PtrOrNil(newBrokerNodesMap["node_count"], true)
 
 		if oldDataNodeCount < newDataNodeCount {
-			LogCommonRequest("resourceNcloudCDSSClusterUpdate", d.Id())
+			LogCommonRequest(ctx, "resourceNcloudCDSSClusterUpdate", d.Id())
 			if err := waitForCDSSClusterActive(ctx, d, config, d.Id()); err != nil {
 				return fmt.Errorf("error waiting for CDSS Cluster (%s) to become activating: %s", d.Id(), err)
 			}
@@ -441,7 +446,7 @@ func checkNodeCountChanged(ctx context.Context, d *schema.ResourceData, config *
 			}
 
 			if _, _, err := config.Client.Vcdss.V1Api.ClusterChangeCountOfBrokerNodeServiceGroupInstanceNoPost(ctx, reqParams, d.Id()); err != nil {
-				LogErrorResponse("resourceNcloudCDSSClusterAddNodes", err, d.Id())
+				LogErrorResponse(ctx, "resourceNcloudCDSSClusterAddNodes", err, d.Id())
 				return fmt.Errorf("error Add Nodes to CDSS Cluster (%s) : %s", d.Id(), err)
 			}
 
@@ -449,7 +454,7 @@ func checkNodeCountChanged(ctx context.Context, d *schema.ResourceData, config *
 				return fmt.Errorf("error waiting for CDSS Cluster (%s) to become activating: %s", d.Id(), err)
 			}
 		} else if oldDataNodeCount > newDataNodeCount {
-			LogErrorResponse("resourceNcloudCDSSClusterAddNodes", nil, d.Id())
+			LogErrorResponse(ctx, "resourceNcloudCDSSClusterAddNodes", nil, d.Id())
 			return fmt.Errorf("broker node count cannot be decreased")
 		}
 	}
@@ -470,7 +475,7 @@ func checkCDSSNodeProductCodeChanged(ctx context.Context, d *schema.ResourceData
 		}
 
 		if _, _, err := config.Client.Vcdss.V1Api.ClusterChangeSpecNodeServiceGroupInstanceNoPost(ctx, reqParams, d.Id()); err != nil {
-			LogErrorResponse("resourceNcloudCDSSClusterChangeSpec", nil, d.Id())
+			LogErrorResponse(ctx, "resourceNcloudCDSSClusterChangeSpec", nil, d.Id())
 			return fmt.Errorf("error Change Node Product Code (%s) : %s", d.Id(), err)
 		}
 
@@ -507,9 +512,9 @@ func resourceNcloudCDSSClusterDelete(ctx context.Context, d *schema.ResourceData
 		return diag.FromErr(err)
 	}
 
-	LogCommonRequest("resourceNcloudCDSSClusterDelete", d.Id())
+	ctx = LogCommonRequest(ctx, "resourceNcloudCDSSClusterDelete", d.Id())
 	if _, _, err := config.Client.Vcdss.V1Api.ClusterDeleteCDSSClusterServiceGroupInstanceNoDelete(ctx, d.Id()); err != nil {
-		LogErrorResponse("resourceNcloudCDSSClusterDelete", err, d.Id())
+		LogErrorResponse(ctx, "resourceNcloudCDSSClusterDelete", err, d.Id())
 		return diag.FromErr(err)
 	}
 
@@ -521,10 +526,10 @@ func resourceNcloudCDSSClusterDelete(ctx context.Context, d *schema.ResourceData
 }
 
 func waitForCDSSClusterDeletion(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) error {
-	stateConf := &resource.StateChangeConf{
+	stateConf := &waiter.Config[any]{
 		Pending: []string{CDSSStatusDeleting},
 		Target:  []string{CDSSStatusReturn},
-		Refresh: func() (result interface{}, state string, err error) {
+		Refresh: func(ctx context.Context) (result any, state string, err error) {
 			cluster, err := getCDSSCluster(ctx, config, d.Id())
 			if err != nil {
 				return nil, "", err
@@ -534,21 +539,21 @@ func waitForCDSSClusterDeletion(ctx context.Context, d *schema.ResourceData, con
 			}
 			return cluster, cluster.Status, nil
 		},
-		Timeout:    d.Timeout(schema.TimeoutDelete),
-		MinTimeout: 3 * time.Second,
-		Delay:      2 * time.Second,
+		Timeout:     d.Timeout(schema.TimeoutDelete),
+		MinInterval: 3 * time.Second,
+		Delay:       2 * time.Second,
 	}
-	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+	if _, err := stateConf.WaitForState(ctx); err != nil {
 		return fmt.Errorf("Error waiting for VCDSS Cluster (%s) to become terminating: %s", d.Id(), err)
 	}
 	return nil
 }
 
 func waitForCDSSClusterActive(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig, id string) error {
-	stateConf := &resource.StateChangeConf{
+	stateConf := &waiter.Config[any]{
 		Pending: []string{CDSSStatusCreating, CDSSStatusChanging},
 		Target:  []string{CDSSStatusRunning},
-		Refresh: func() (result interface{}, state string, err error) {
+		Refresh: func(ctx context.Context) (result any, state string, err error) {
 			cluster, err := getCDSSCluster(ctx, config, id)
 			if err != nil {
 				return nil, "", err
@@ -558,11 +563,11 @@ func waitForCDSSClusterActive(ctx context.Context, d *schema.ResourceData, confi
 			}
 			return cluster, cluster.Status, nil
 		},
-		Timeout:    d.Timeout(schema.TimeoutCreate),
-		MinTimeout: 3 * time.Second,
-		Delay:      2 * time.Second,
+		Timeout:     d.Timeout(schema.TimeoutCreate),
+		MinInterval: 3 * time.Second,
+		Delay:       2 * time.Second,
 	}
-	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+	if _, err := stateConf.WaitForState(ctx); err != nil {
 		return fmt.Errorf("error waiting for CDSS Cluster (%s) to become activating: %s", id, err)
 	}
 	return nil
@@ -573,7 +578,7 @@ func getCDSSCluster(ctx context.Context, config *conn.ProviderConfig, id string)
 	if err != nil {
 		return nil, err
 	}
-	LogResponse("getCDSSCluster", resp)
+	LogResponse(ctx, "getCDSSCluster", resp)
 
 	return resp.Result, nil
 }
@@ -583,7 +588,7 @@ func getBrokerInfo(ctx context.Context, config *conn.ProviderConfig, id string)
 	if err != nil {
 		return nil, err
 	}
-	LogResponse("getBrokerInfo", resp)
+	LogResponse(ctx, "getBrokerInfo", resp)
 
 	return resp.Result, nil
 }
@@ -9,6 +9,7 @@ import (
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vcdss"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/common"
@@ -17,7 +18,7 @@ import (
 
 func DataSourceNcloudCDSSCluster() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNcloudCDSSClusterRead,
+		ReadContext: dataSourceNcloudCDSSClusterRead,
 		Schema: map[string]*schema.Schema{
 			"filter": DataSourceFiltersSchema(),
 			"id": {
@@ -58,8 +59,9 @@ func DataSourceNcloudCDSSCluster() *schema.Resource {
 							Optional: true,
 						},
 						"user_password": {
-							Type:     schema.TypeString,
-							Optional: true,
+							Type:      schema.TypeString,
+							Optional:  true,
+							Sensitive: true,
 						},
 					},
 				},
@@ -161,15 +163,15 @@ func DataSourceNcloudCDSSCluster() *schema.Resource {
 	}
 }
 
-func dataSourceNcloudCDSSClusterRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceNcloudCDSSClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*conn.ProviderConfig)
 	if !config.SupportVPC {
-		return NotSupportClassic("dataSource `ncloud_vcdss_cluster`")
+		return diag.FromErr(NotSupportClassic("dataSource `ncloud_vcdss_cluster`"))
 	}
 
-	resources, err := getCDSSClusterList(config)
+	resources, err := getCDSSClusterList(ctx, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if f, ok := d.GetOk("filter"); ok {
@@ -177,13 +179,13 @@ func dataSourceNcloudCDSSClusterRead(d *schema.ResourceData, meta interface{}) e
 	}
 
 	if len(resources) < 1 {
-		return fmt.Errorf("no results. please change search criteria and try again")
+		return diag.FromErr(fmt.Errorf("no results. please change search criteria and try again"))
 	}
 
 	id := resources[0]["id"].(string)
 	cluster, err := getCDSSCluster(context.Background(), config, id)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(id)
@@ -215,7 +217,7 @@ func dataSourceNcloudCDSSClusterRead(d *schema.ResourceData, meta interface{}) e
 
 	endpoints, err := getBrokerInfo(context.Background(), config, d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	commaSplitFn := func(c rune) bool {
@@ -256,16 +258,16 @@ func dataSourceNcloudCDSSClusterRead(d *schema.ResourceData, meta interface{}) e
 	return nil
 }
 
-func getCDSSClusterList(config *conn.ProviderConfig) ([]map[string]interface{}, error) {
-	LogCommonRequest("GetCDSSClusterList", "")
+func getCDSSClusterList(ctx context.Context, config *conn.ProviderConfig) ([]map[string]interface{}, error) {
+	ctx = LogCommonRequest(ctx, "GetCDSSClusterList", "")
 	resp, _, err := config.Client.Vcdss.V1Api.ClusterGetClusterInfoListPost(context.Background(), vcdss.GetClusterRequest{})
 
 	if err != nil {
-		LogErrorResponse("GetCDSSClusterList", err, "")
+		LogErrorResponse(ctx, "GetCDSSClusterList", err, "")
 		return nil, err
 	}
 
-	LogResponse("GetCDSSClusterList", resp)
+	LogResponse(ctx, "GetCDSSClusterList", resp)
 
 	resources := []map[string]interface{}{}
 
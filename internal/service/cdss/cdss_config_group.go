@@ -3,6 +3,7 @@ package cdss
 import (
 	"context"
 	"fmt"
+	"log"
 	"strconv"
 	"strings"
 
@@ -76,13 +77,13 @@ func resourceNcloudCDSSConfigGroupCreate(ctx context.Context, d *schema.Resource
 		reqParams.Description = *description
 	}
 
-	LogCommonRequest("resourceNcloudCDSSConfigGroupCreate", reqParams)
+	ctx = LogCommonRequest(ctx, "resourceNcloudCDSSConfigGroupCreate", reqParams)
 	resp, _, err := config.Client.Vcdss.V1Api.ConfigGroupCreateConfigGroupPost(ctx, reqParams)
 	if err != nil {
-		LogErrorResponse("resourceNcloudCDSSConfigGroupCreate", err, reqParams)
+		LogErrorResponse(ctx, "resourceNcloudCDSSConfigGroupCreate", err, reqParams)
 		return diag.FromErr(err)
 	}
-	LogResponse("resourceNcloudCDSSConfigGroupCreate", resp)
+	LogResponse(ctx, "resourceNcloudCDSSConfigGroupCreate", resp)
 
 	id := strconv.Itoa(int(ncloud.Int32Value(&resp.Result.ConfigGroupNo)))
 	d.SetId(id)
@@ -96,6 +97,11 @@ func resourceNcloudCDSSConfigGroupRead(ctx context.Context, d *schema.ResourceDa
 	}
 
 	configGroup, err := getCDSSConfigGroup(ctx, config, d.Get("kafka_version_code").(string), d.Id())
+	if IsNotFound(err) {
+		log.Printf("[WARN] CDSS config group %s was not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -122,7 +128,7 @@ func resourceNcloudCDSSConfigGroupUpdate(ctx context.Context, d *schema.Resource
 		_, n := d.GetChange("description")
 
 		newDescription := n.(string)
-		LogCommonRequest("resourceNcloudCDSSConfigGroupUpdate", d.Id())
+		ctx = LogCommonRequest(ctx, "resourceNcloudCDSSConfigGroupUpdate", d.Id())
 
 		reqParams := vcdss.SetKafkaConfigGroupMemoRequest{
 			KafkaVersionCode: *StringPtrOrNil(d.GetOk("kafka_version_code")),
@@ -130,7 +136,7 @@ func resourceNcloudCDSSConfigGroupUpdate(ctx context.Context, d *schema.Resource
 		}
 
 		if _, _, err := config.Client.Vcdss.V1Api.ConfigGroupSetKafkaConfigGroupMemoConfigGroupNoPost(ctx, reqParams, d.Id()); err != nil {
-			LogErrorResponse("resourceNcloudCDSSConfigGroupUpdate", err, d.Id())
+			LogErrorResponse(ctx, "resourceNcloudCDSSConfigGroupUpdate", err, d.Id())
 			return diag.FromErr(err)
 		}
 	}
@@ -143,9 +149,9 @@ func resourceNcloudCDSSConfigGroupDelete(ctx context.Context, d *schema.Resource
 		return diag.FromErr(NotSupportClassic("resource `ncloud_cdss_config_group`"))
 	}
 
-	LogCommonRequest("resourceNcloudCDSSConfigGroupDelete", d.Id())
+	ctx = LogCommonRequest(ctx, "resourceNcloudCDSSConfigGroupDelete", d.Id())
 	if _, _, err := config.Client.Vcdss.V1Api.ConfigGroupDeleteConfigGroupConfigGroupNoDelete(ctx, d.Id()); err != nil {
-		LogErrorResponse("resourceNcloudCDSSConfigGroupDelete", err, d.Id())
+		LogErrorResponse(ctx, "resourceNcloudCDSSConfigGroupDelete", err, d.Id())
 		return diag.FromErr(err)
 	}
 
@@ -156,13 +162,13 @@ func getCDSSConfigGroup(ctx context.Context, config *conn.ProviderConfig, kafkaV
 	reqParams := vcdss.GetKafkaConfigGroupRequest{
 		KafkaVersionCode: kafkaVersionCode,
 	}
-	LogCommonRequest("getCDSSConfigGroup", reqParams)
+	ctx = LogCommonRequest(ctx, "getCDSSConfigGroup", reqParams)
 
 	resp, _, err := config.Client.Vcdss.V1Api.ConfigGroupGetKafkaConfigGroupConfigGroupNoPost(ctx, reqParams, id)
 	if err != nil {
 		return nil, err
 	}
-	LogResponse("getCDSSConfigGroup", resp)
+	LogResponse(ctx, "getCDSSConfigGroup", resp)
 
 	return resp.Result, nil
 }
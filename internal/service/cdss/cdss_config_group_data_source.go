@@ -6,6 +6,7 @@ import (
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vcdss"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/common"
@@ -14,7 +15,7 @@ import (
 
 func DataSourceNcloudCDSSConfigGroup() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNcloudCDSSConfigGroupRead,
+		ReadContext: dataSourceNcloudCDSSConfigGroupRead,
 		Schema: map[string]*schema.Schema{
 			"filter": DataSourceFiltersSchema(),
 			"id": {
@@ -41,15 +42,15 @@ func DataSourceNcloudCDSSConfigGroup() *schema.Resource {
 	}
 }
 
-func dataSourceNcloudCDSSConfigGroupRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceNcloudCDSSConfigGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*conn.ProviderConfig)
 	if !config.SupportVPC {
-		return NotSupportClassic("dataSource `ncloud_cdss_config_group`")
+		return diag.FromErr(NotSupportClassic("dataSource `ncloud_cdss_config_group`"))
 	}
 
-	resources, err := getCDSSConfigGroups(config, *StringPtrOrNil(d.GetOk("kafka_version_code")))
+	resources, err := getCDSSConfigGroups(ctx, config, *StringPtrOrNil(d.GetOk("kafka_version_code")))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if f, ok := d.GetOk("filter"); ok {
@@ -57,7 +58,7 @@ func dataSourceNcloudCDSSConfigGroupRead(d *schema.ResourceData, meta interface{
 	}
 
 	if len(resources) < 1 {
-		return fmt.Errorf("no results. please change search criteria and try again")
+		return diag.FromErr(fmt.Errorf("no results. please change search criteria and try again"))
 	}
 
 	for k, v := range resources[0] {
@@ -70,18 +71,18 @@ func dataSourceNcloudCDSSConfigGroupRead(d *schema.ResourceData, meta interface{
 	return nil
 }
 
-func getCDSSConfigGroups(config *conn.ProviderConfig, kafkaVersionCode string) ([]map[string]interface{}, error) {
-	LogCommonRequest("GetCDSSConfigGroups", "")
+func getCDSSConfigGroups(ctx context.Context, config *conn.ProviderConfig, kafkaVersionCode string) ([]map[string]interface{}, error) {
+	ctx = LogCommonRequest(ctx, "GetCDSSConfigGroups", "")
 	resp, _, err := config.Client.Vcdss.V1Api.ConfigGroupGetKafkaVersionConfigGroupListPost(context.Background(), vcdss.GetKafkaVersionConfigGroupListRequest{
 		KafkaVersionCode: kafkaVersionCode,
 	})
 
 	if err != nil {
-		LogErrorResponse("GetCDSSConfigGroups", err, "")
+		LogErrorResponse(ctx, "GetCDSSConfigGroups", err, "")
 		return nil, err
 	}
 
-	LogResponse("GetCDSSConfigGroups", resp)
+	LogResponse(ctx, "GetCDSSConfigGroups", resp)
 
 	resources := []map[string]interface{}{}
 
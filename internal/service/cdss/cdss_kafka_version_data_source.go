@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/common"
@@ -13,7 +14,7 @@ import (
 
 func DataSourceNcloudCDSSKafkaVersion() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNcloudCDSSKafkaVersionRead,
+		ReadContext: dataSourceNcloudCDSSKafkaVersionRead,
 		Schema: map[string]*schema.Schema{
 			"filter": DataSourceFiltersSchema(),
 			"id": {
@@ -28,15 +29,15 @@ func DataSourceNcloudCDSSKafkaVersion() *schema.Resource {
 	}
 }
 
-func dataSourceNcloudCDSSKafkaVersionRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceNcloudCDSSKafkaVersionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*conn.ProviderConfig)
 	if !config.SupportVPC {
-		return NotSupportClassic("datasource `ncloud_cdss_kafka_version`")
+		return diag.FromErr(NotSupportClassic("datasource `ncloud_cdss_kafka_version`"))
 	}
 
-	resources, err := getCDSSKafkaVersions(config)
+	resources, err := getCDSSKafkaVersions(ctx, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if f, ok := d.GetOk("filter"); ok {
@@ -44,7 +45,7 @@ func dataSourceNcloudCDSSKafkaVersionRead(d *schema.ResourceData, meta interface
 	}
 
 	if len(resources) < 1 {
-		return fmt.Errorf("no results. please change search criteria and try again")
+		return diag.FromErr(fmt.Errorf("no results. please change search criteria and try again"))
 	}
 
 	for k, v := range resources[0] {
@@ -57,16 +58,16 @@ func dataSourceNcloudCDSSKafkaVersionRead(d *schema.ResourceData, meta interface
 	return nil
 }
 
-func getCDSSKafkaVersions(config *conn.ProviderConfig) ([]map[string]interface{}, error) {
-	LogCommonRequest("GetCDSSVersionList", "")
+func getCDSSKafkaVersions(ctx context.Context, config *conn.ProviderConfig) ([]map[string]interface{}, error) {
+	ctx = LogCommonRequest(ctx, "GetCDSSVersionList", "")
 	resp, _, err := config.Client.Vcdss.V1Api.ClusterGetCDSSVersionListGet(context.Background())
 
 	if err != nil {
-		LogErrorResponse("GetCDSSVersionList", err, "")
+		LogErrorResponse(ctx, "GetCDSSVersionList", err, "")
 		return nil, err
 	}
 
-	LogResponse("GetCDSSVersionList", resp)
+	LogResponse(ctx, "GetCDSSVersionList", resp)
 
 	resources := []map[string]interface{}{}
 
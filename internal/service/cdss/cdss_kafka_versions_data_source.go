@@ -1,9 +1,11 @@
 package cdss
 
 import (
+	"context"
 	"fmt"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/common"
@@ -12,7 +14,7 @@ import (
 
 func DataSourceNcloudCDSSKafkaVersions() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNcloudCDSSKafkaVersionsRead,
+		ReadContext: dataSourceNcloudCDSSKafkaVersionsRead,
 		Schema: map[string]*schema.Schema{
 			"kafka_versions": {
 				Type:     schema.TypeList,
@@ -23,20 +25,20 @@ func DataSourceNcloudCDSSKafkaVersions() *schema.Resource {
 	}
 }
 
-func dataSourceNcloudCDSSKafkaVersionsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceNcloudCDSSKafkaVersionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*conn.ProviderConfig)
 	if !config.SupportVPC {
-		return NotSupportClassic("datasource `ncloud_cdss_kafka_versions`")
+		return diag.FromErr(NotSupportClassic("datasource `ncloud_cdss_kafka_versions`"))
 	}
 
-	resources, err := getCDSSKafkaVersions(config)
+	resources, err := getCDSSKafkaVersions(ctx, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(time.Now().UTC().String())
 	if err := d.Set("kafka_versions", resources); err != nil {
-		return fmt.Errorf("Error setting node products: %s", err)
+		return diag.FromErr(fmt.Errorf("Error setting node products: %s", err))
 	}
 
 	return nil
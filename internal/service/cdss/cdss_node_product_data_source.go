@@ -7,6 +7,7 @@ import (
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vcdss"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/common"
@@ -15,7 +16,7 @@ import (
 
 func DataSourceNcloudCDSSNodeProduct() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNcloudCDSSNodeProductRead,
+		ReadContext: dataSourceNcloudCDSSNodeProductRead,
 		Schema: map[string]*schema.Schema{
 			"os_image": {
 				Type:     schema.TypeString,
@@ -46,10 +47,10 @@ func DataSourceNcloudCDSSNodeProduct() *schema.Resource {
 	}
 }
 
-func dataSourceNcloudCDSSNodeProductRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceNcloudCDSSNodeProductRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*conn.ProviderConfig)
 	if !config.SupportVPC {
-		return NotSupportClassic("datasource `ncloud_cdss_node_product`")
+		return diag.FromErr(NotSupportClassic("datasource `ncloud_cdss_node_product`"))
 	}
 
 	reqParams := vcdss.NodeProduct{
@@ -57,9 +58,9 @@ func dataSourceNcloudCDSSNodeProductRead(d *schema.ResourceData, meta interface{
 		SubnetNo:            *GetInt32FromString(d.GetOk("subnet_no")),
 	}
 
-	resources, err := getCDSSNodeProducts(config, reqParams)
+	resources, err := getCDSSNodeProducts(ctx, config, reqParams)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if f, ok := d.GetOk("filter"); ok {
@@ -67,7 +68,7 @@ func dataSourceNcloudCDSSNodeProductRead(d *schema.ResourceData, meta interface{
 	}
 
 	if len(resources) < 1 {
-		return fmt.Errorf("no results. please change search criteria and try again")
+		return diag.FromErr(fmt.Errorf("no results. please change search criteria and try again"))
 	}
 
 	for k, v := range resources[0] {
@@ -80,22 +81,22 @@ func dataSourceNcloudCDSSNodeProductRead(d *schema.ResourceData, meta interface{
 	return nil
 }
 
-func getCDSSNodeProducts(config *conn.ProviderConfig, reqParams vcdss.NodeProduct) ([]map[string]interface{}, error) {
-	LogCommonRequest("GetOsProductList", reqParams)
+func getCDSSNodeProducts(ctx context.Context, config *conn.ProviderConfig, reqParams vcdss.NodeProduct) ([]map[string]interface{}, error) {
+	ctx = LogCommonRequest(ctx, "GetOsProductList", reqParams)
 
 	resp, _, err := config.Client.Vcdss.V1Api.ClusterGetNodeProductListPost(context.Background(), reqParams)
 	if err != nil {
-		LogErrorResponse("GetOsProductList", err, "")
+		LogErrorResponse(ctx, "GetOsProductList", err, "")
 		return nil, err
 	}
-	LogResponse("GetOsProductList", resp)
+	LogResponse(ctx, "GetOsProductList", resp)
 
 	resources := []map[string]interface{}{}
 
 	for _, r := range resp.Result.ProductList {
 		memorySize, err := parseMemorySize(r.MemorySize)
 		if err != nil {
-			LogErrorResponse("Invalid Memory Size", err, "")
+			LogErrorResponse(ctx, "Invalid Memory Size", err, "")
 			return nil, err
 		}
 
@@ -1,10 +1,12 @@
 package cdss
 
 import (
+	"context"
 	"fmt"
 	"time"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vcdss"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/common"
@@ -13,7 +15,7 @@ import (
 
 func DataSourceNcloudCDSSNodeProducts() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNcloudCDSSNodeProductsRead,
+		ReadContext: dataSourceNcloudCDSSNodeProductsRead,
 		Schema: map[string]*schema.Schema{
 			"os_image": {
 				Type:     schema.TypeString,
@@ -51,10 +53,10 @@ func DataSourceNcloudCDSSNodeProducts() *schema.Resource {
 	}
 }
 
-func dataSourceNcloudCDSSNodeProductsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceNcloudCDSSNodeProductsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*conn.ProviderConfig)
 	if !config.SupportVPC {
-		return NotSupportClassic("datasource `ncloud_cdss_node_products`")
+		return diag.FromErr(NotSupportClassic("datasource `ncloud_cdss_node_products`"))
 	}
 
 	reqParams := vcdss.NodeProduct{
@@ -62,14 +64,14 @@ func dataSourceNcloudCDSSNodeProductsRead(d *schema.ResourceData, meta interface
 		SubnetNo:            *GetInt32FromString(d.GetOk("subnet_no")),
 	}
 
-	resources, err := getCDSSNodeProducts(config, reqParams)
+	resources, err := getCDSSNodeProducts(ctx, config, reqParams)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(time.Now().UTC().String())
 	if err := d.Set("node_products", resources); err != nil {
-		return fmt.Errorf("Error setting node products: %s", err)
+		return diag.FromErr(fmt.Errorf("Error setting node products: %s", err))
 	}
 
 	return nil
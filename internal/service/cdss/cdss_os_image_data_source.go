@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/common"
@@ -13,7 +14,7 @@ import (
 
 func DataSourceNcloudCDSSOsImage() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNcloudCDSSOsProductRead,
+		ReadContext: dataSourceNcloudCDSSOsProductRead,
 		Schema: map[string]*schema.Schema{
 			"filter": DataSourceFiltersSchema(),
 			"id": {
@@ -28,15 +29,15 @@ func DataSourceNcloudCDSSOsImage() *schema.Resource {
 	}
 }
 
-func dataSourceNcloudCDSSOsProductRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceNcloudCDSSOsProductRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*conn.ProviderConfig)
 	if !config.SupportVPC {
-		return NotSupportClassic("datasource `ncloud_cdss_node_os_image`")
+		return diag.FromErr(NotSupportClassic("datasource `ncloud_cdss_node_os_image`"))
 	}
 
-	resources, err := getCDSSOsProducts(config)
+	resources, err := getCDSSOsProducts(ctx, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if f, ok := d.GetOk("filter"); ok {
@@ -44,7 +45,7 @@ func dataSourceNcloudCDSSOsProductRead(d *schema.ResourceData, meta interface{})
 	}
 
 	if len(resources) < 1 {
-		return fmt.Errorf("no results. please change search criteria and try again")
+		return diag.FromErr(fmt.Errorf("no results. please change search criteria and try again"))
 	}
 
 	for k, v := range resources[0] {
@@ -57,16 +58,16 @@ func dataSourceNcloudCDSSOsProductRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func getCDSSOsProducts(config *conn.ProviderConfig) ([]map[string]interface{}, error) {
-	LogCommonRequest("GetOsProductList", "")
+func getCDSSOsProducts(ctx context.Context, config *conn.ProviderConfig) ([]map[string]interface{}, error) {
+	ctx = LogCommonRequest(ctx, "GetOsProductList", "")
 	resp, _, err := config.Client.Vcdss.V1Api.ClusterGetOsProductListGet(context.Background())
 
 	if err != nil {
-		LogErrorResponse("GetOsProductList", err, "")
+		LogErrorResponse(ctx, "GetOsProductList", err, "")
 		return nil, err
 	}
 
-	LogResponse("GetOsProductList", resp)
+	LogResponse(ctx, "GetOsProductList", resp)
 
 	resources := []map[string]interface{}{}
 
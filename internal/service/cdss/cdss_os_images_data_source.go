@@ -1,9 +1,11 @@
 package cdss
 
 import (
+	"context"
 	"fmt"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/common"
@@ -12,7 +14,7 @@ import (
 
 func DataSourceNcloudCDSSOsImages() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNcloudCDSSOsImagesRead,
+		ReadContext: dataSourceNcloudCDSSOsImagesRead,
 		Schema: map[string]*schema.Schema{
 			"os_images": {
 				Type:     schema.TypeList,
@@ -23,20 +25,20 @@ func DataSourceNcloudCDSSOsImages() *schema.Resource {
 	}
 }
 
-func dataSourceNcloudCDSSOsImagesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceNcloudCDSSOsImagesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*conn.ProviderConfig)
 	if !config.SupportVPC {
-		return NotSupportClassic("datasource `ncloud_cdss_os_images`")
+		return diag.FromErr(NotSupportClassic("datasource `ncloud_cdss_os_images`"))
 	}
 
-	resources, err := getCDSSOsProducts(config)
+	resources, err := getCDSSOsProducts(ctx, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(time.Now().UTC().String())
 	if err := d.Set("os_images", resources); err != nil {
-		return fmt.Errorf("Error setting os images: %s", err)
+		return diag.FromErr(fmt.Errorf("Error setting os images: %s", err))
 	}
 
 	return nil
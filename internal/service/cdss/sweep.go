@@ -0,0 +1,97 @@
+package cdss
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/sweep"
+)
+
+func RegisterSweepers() {
+	sweep.AddTestSweepers("ncloud_cdss_cluster", &resource.Sweeper{
+		Name: "ncloud_cdss_cluster",
+		F:    sweepCDSSClusters,
+	})
+
+	sweep.AddTestSweepers("ncloud_cdss_config_group", &resource.Sweeper{
+		Name: "ncloud_cdss_config_group",
+		F:    sweepCDSSConfigGroups,
+		Dependencies: []string{
+			"ncloud_cdss_cluster",
+		},
+	})
+}
+
+func sweepCDSSClusters(region string) error {
+	ctx := context.Background()
+	config, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+	if !config.SupportVPC {
+		return nil
+	}
+
+	clusters, err := getCDSSClusterList(ctx, config)
+	if err != nil {
+		if sweep.SkipSweepError(err) {
+			return nil
+		}
+		return fmt.Errorf("listing CDSS clusters: %w", err)
+	}
+
+	var sweepables []sweep.Sweepable
+	for _, c := range clusters {
+		name := c["name"].(string)
+		if !sweep.HasResourcePrefix(&name) {
+			continue
+		}
+		sweepables = append(sweepables, sweep.NewSweepResource(ResourceNcloudCDSSCluster(), config, c["id"].(string), nil))
+	}
+
+	return sweep.SweepOrchestrator(ctx, sweepables)
+}
+
+// sweepCDSSConfigGroups removes the config groups of every Kafka version, which they are listed by.
+func sweepCDSSConfigGroups(region string) error {
+	ctx := context.Background()
+	config, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+	if !config.SupportVPC {
+		return nil
+	}
+
+	versions, err := getCDSSKafkaVersions(ctx, config)
+	if err != nil {
+		if sweep.SkipSweepError(err) {
+			return nil
+		}
+		return fmt.Errorf("listing Kafka versions: %w", err)
+	}
+
+	var sweepables []sweep.Sweepable
+	for _, v := range versions {
+		kafkaVersionCode := v["id"].(string)
+
+		configGroups, err := getCDSSConfigGroups(ctx, config, kafkaVersionCode)
+		if err != nil {
+			return fmt.Errorf("listing CDSS config groups of Kafka version %s: %w", kafkaVersionCode, err)
+		}
+
+		for _, g := range configGroups {
+			name := g["name"].(string)
+			if !sweep.HasResourcePrefix(&name) {
+				continue
+			}
+			sweepables = append(sweepables, sweep.NewSweepResource(ResourceNcloudCDSSConfigGroup(), config, g["id"].(string), map[string]interface{}{
+				"kafka_version_code": kafkaVersionCode,
+			}))
+		}
+	}
+
+	return sweep.SweepOrchestrator(ctx, sweepables)
+}
@@ -1,28 +1,30 @@
 package classicloadbalancer
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/loadbalancer"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/waiter"
 )
 
 func ResourceNcloudLoadBalancer() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNcloudLoadBalancerCreate,
-		Read:   resourceNcloudLoadBalancerRead,
-		Update: resourceNcloudLoadBalancerUpdate,
-		Delete: resourceNcloudLoadBalancerDelete,
+		CreateContext: resourceNcloudLoadBalancerCreate,
+		ReadContext:   resourceNcloudLoadBalancerRead,
+		UpdateContext: resourceNcloudLoadBalancerUpdate,
+		DeleteContext: resourceNcloudLoadBalancerDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(conn.DefaultCreateTimeout),
@@ -127,34 +129,34 @@ func ResourceNcloudLoadBalancer() *schema.Resource {
 	}
 }
 
-func resourceNcloudLoadBalancerCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudLoadBalancerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*conn.ProviderConfig).Client
 	config := meta.(*conn.ProviderConfig)
 
 	if config.SupportVPC {
-		return NotSupportVpc("resource `ncloud_load_balancer`")
+		return diag.FromErr(NotSupportVpc("resource `ncloud_load_balancer`"))
 	}
 
-	reqParams, err := buildCreateLoadBalancerInstanceParams(d)
+	reqParams, err := buildCreateLoadBalancerInstanceParams(config, d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
-	LogCommonRequest("CreateLoadBalancerInstance", reqParams)
+	ctx = LogCommonRequest(ctx, "CreateLoadBalancerInstance", reqParams)
 	resp, err := client.Loadbalancer.V2Api.CreateLoadBalancerInstance(reqParams)
 	if err != nil {
-		LogErrorResponse("CreateLoadBalancerInstance", err, reqParams)
-		return err
+		LogErrorResponse(ctx, "CreateLoadBalancerInstance", err, reqParams)
+		return diag.FromErr(err)
 	}
-	LogCommonResponse("CreateLoadBalancerInstance", GetCommonResponse(resp))
+	LogCommonResponse(ctx, "CreateLoadBalancerInstance", GetCommonResponse(resp))
 
 	loadBalancerInstance := resp.LoadBalancerInstanceList[0]
 	d.SetId(*loadBalancerInstance.LoadBalancerInstanceNo)
 
-	stateConf := &resource.StateChangeConf{
+	stateConf := &waiter.Config[any]{
 		Pending: []string{"INIT", "USE"},
 		Target:  []string{"USED"},
-		Refresh: func() (interface{}, string, error) {
-			instance, err := GetLoadBalancerInstance(client, ncloud.StringValue(loadBalancerInstance.LoadBalancerInstanceNo))
+		Refresh: func(ctx context.Context) (any, string, error) {
+			instance, err := GetLoadBalancerInstance(ctx, client, ncloud.StringValue(loadBalancerInstance.LoadBalancerInstanceNo))
 			if err != nil {
 				return 0, "", err
 			}
@@ -165,25 +167,25 @@ func resourceNcloudLoadBalancerCreate(d *schema.ResourceData, meta interface{})
 
 			return instance, ncloud.StringValue(instance.LoadBalancerInstanceOperation.Code), nil
 		},
-		Timeout:    conn.DefaultCreateTimeout,
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Timeout:     conn.DefaultCreateTimeout,
+		Delay:       2 * time.Second,
+		MinInterval: 3 * time.Second,
 	}
 
-	_, err = stateConf.WaitForState()
+	_, err = stateConf.WaitForState(ctx)
 	if err != nil {
-		return fmt.Errorf("Error waiting for LoadBalancerInstanceStatus state to be \"USED\": %s", err)
+		return diag.FromErr(fmt.Errorf("Error waiting for LoadBalancerInstanceStatus state to be \"USED\": %s", err))
 	}
 
-	return resourceNcloudLoadBalancerRead(d, meta)
+	return resourceNcloudLoadBalancerRead(ctx, d, meta)
 }
 
-func resourceNcloudLoadBalancerRead(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudLoadBalancerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*conn.ProviderConfig).Client
 
-	lb, err := GetLoadBalancerInstance(client, d.Id())
+	lb, err := GetLoadBalancerInstance(ctx, client, d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if lb != nil {
@@ -214,13 +216,13 @@ func resourceNcloudLoadBalancerRead(d *schema.ResourceData, meta interface{}) er
 
 		if len(lb.LoadBalancerRuleList) != 0 {
 			if err := d.Set("rule_list", flattenLoadBalancerRuleList(lb.LoadBalancerRuleList)); err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 
 		if len(lb.LoadBalancedServerInstanceList) != 0 {
 			if err := d.Set("load_balanced_server_instance_list", flattenLoadBalancedServerInstanceList(lb.LoadBalancedServerInstanceList)); err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		} else {
 			d.Set("load_balanced_server_instance_list", nil)
@@ -233,22 +235,22 @@ func resourceNcloudLoadBalancerRead(d *schema.ResourceData, meta interface{}) er
 	return nil
 }
 
-func resourceNcloudLoadBalancerDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudLoadBalancerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*conn.ProviderConfig).Client
-	if err := deleteLoadBalancerInstance(client, d.Id()); err != nil {
-		return err
+	if err := deleteLoadBalancerInstance(ctx, client, d.Id()); err != nil {
+		return diag.FromErr(err)
 	}
 	d.SetId("")
 	return nil
 }
 
-func resourceNcloudLoadBalancerUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudLoadBalancerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*conn.ProviderConfig).Client
 
 	// Change Load Balanced Server Instances
 	if d.HasChange("server_instance_no_list") {
-		if err := changeLoadBalancedServerInstances(client, d); err != nil {
-			return err
+		if err := changeLoadBalancedServerInstances(ctx, client, d); err != nil {
+			return diag.FromErr(err)
 		}
 	}
 
@@ -266,19 +268,19 @@ func resourceNcloudLoadBalancerUpdate(d *schema.ResourceData, meta interface{})
 	}
 
 	if d.HasChange("algorithm_type") || d.HasChange("description") || d.HasChange("rule_list") {
-		LogCommonRequest("ChangeLoadBalancerInstanceConfiguration", reqParams)
+		ctx = LogCommonRequest(ctx, "ChangeLoadBalancerInstanceConfiguration", reqParams)
 		resp, err := client.Loadbalancer.V2Api.ChangeLoadBalancerInstanceConfiguration(reqParams)
 		if err != nil {
-			LogErrorResponse("ChangeLoadBalancerInstanceConfiguration", err, reqParams)
-			return err
+			LogErrorResponse(ctx, "ChangeLoadBalancerInstanceConfiguration", err, reqParams)
+			return diag.FromErr(err)
 		}
-		LogCommonResponse("ChangeLoadBalancerInstanceConfiguration", GetCommonResponse(resp))
+		LogCommonResponse(ctx, "ChangeLoadBalancerInstanceConfiguration", GetCommonResponse(resp))
 
-		stateConf := &resource.StateChangeConf{
+		stateConf := &waiter.Config[any]{
 			Pending: []string{"INIT", "USE"},
 			Target:  []string{"USED"},
-			Refresh: func() (interface{}, string, error) {
-				instance, err := GetLoadBalancerInstance(client, d.Id())
+			Refresh: func(ctx context.Context) (any, string, error) {
+				instance, err := GetLoadBalancerInstance(ctx, client, d.Id())
 				if err != nil {
 					return 0, "", err
 				}
@@ -289,40 +291,40 @@ func resourceNcloudLoadBalancerUpdate(d *schema.ResourceData, meta interface{})
 
 				return instance, ncloud.StringValue(instance.LoadBalancerInstanceOperation.Code), nil
 			},
-			Timeout:    conn.DefaultUpdateTimeout,
-			Delay:      2 * time.Second,
-			MinTimeout: 3 * time.Second,
+			Timeout:     conn.DefaultUpdateTimeout,
+			Delay:       2 * time.Second,
+			MinInterval: 3 * time.Second,
 		}
 
-		_, err = stateConf.WaitForState()
+		_, err = stateConf.WaitForState(ctx)
 		if err != nil {
-			return fmt.Errorf("Error waiting for LoadBalancerInstanceStatus state to be \"USED\": %s", err)
+			return diag.FromErr(fmt.Errorf("Error waiting for LoadBalancerInstanceStatus state to be \"USED\": %s", err))
 		}
 	}
 
-	return resourceNcloudLoadBalancerRead(d, meta)
+	return resourceNcloudLoadBalancerRead(ctx, d, meta)
 }
 
-func changeLoadBalancedServerInstances(client *conn.NcloudAPIClient, d *schema.ResourceData) error {
+func changeLoadBalancedServerInstances(ctx context.Context, client *conn.NcloudAPIClient, d *schema.ResourceData) error {
 	reqParams := &loadbalancer.ChangeLoadBalancedServerInstancesRequest{
 		LoadBalancerInstanceNo: ncloud.String(d.Id()),
 		ServerInstanceNoList:   ExpandStringInterfaceList(d.Get("server_instance_no_list").([]interface{})),
 	}
 
-	LogCommonRequest("ChangeLoadBalancedServerInstances", reqParams)
+	ctx = LogCommonRequest(ctx, "ChangeLoadBalancedServerInstances", reqParams)
 
 	resp, err := client.Loadbalancer.V2Api.ChangeLoadBalancedServerInstances(reqParams)
 	if err != nil {
-		LogErrorResponse("ChangeLoadBalancedServerInstances", err, reqParams)
+		LogErrorResponse(ctx, "ChangeLoadBalancedServerInstances", err, reqParams)
 		return err
 	}
-	LogCommonResponse("ChangeLoadBalancedServerInstances", GetCommonResponse(resp))
+	LogCommonResponse(ctx, "ChangeLoadBalancedServerInstances", GetCommonResponse(resp))
 
-	stateConf := &resource.StateChangeConf{
+	stateConf := &waiter.Config[any]{
 		Pending: []string{"INIT", "USE"},
 		Target:  []string{"USED"},
-		Refresh: func() (interface{}, string, error) {
-			instance, err := GetLoadBalancerInstance(client, d.Id())
+		Refresh: func(ctx context.Context) (any, string, error) {
+			instance, err := GetLoadBalancerInstance(ctx, client, d.Id())
 			if err != nil {
 				return 0, "", err
 			}
@@ -333,12 +335,12 @@ func changeLoadBalancedServerInstances(client *conn.NcloudAPIClient, d *schema.R
 
 			return instance, ncloud.StringValue(instance.LoadBalancerInstanceOperation.Code), nil
 		},
-		Timeout:    conn.DefaultUpdateTimeout,
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Timeout:     conn.DefaultUpdateTimeout,
+		Delay:       2 * time.Second,
+		MinInterval: 3 * time.Second,
 	}
 
-	_, err = stateConf.WaitForState()
+	_, err = stateConf.WaitForState(ctx)
 	if err != nil {
 		return fmt.Errorf("Error waiting for LoadBalancerInstanceStatus state to be \"USED\": %s", err)
 	}
@@ -346,8 +348,8 @@ func changeLoadBalancedServerInstances(client *conn.NcloudAPIClient, d *schema.R
 	return nil
 }
 
-func buildCreateLoadBalancerInstanceParams(d *schema.ResourceData) (*loadbalancer.CreateLoadBalancerInstanceRequest, error) {
-	regionNo, err := conn.ParseRegionNoParameter(d)
+func buildCreateLoadBalancerInstanceParams(config *conn.ProviderConfig, d *schema.ResourceData) (*loadbalancer.CreateLoadBalancerInstanceRequest, error) {
+	regionNo, err := conn.ParseRegionNoParameter(config, d)
 	if err != nil {
 		return nil, err
 	}
@@ -383,17 +385,17 @@ func buildCreateLoadBalancerInstanceParams(d *schema.ResourceData) (*loadbalance
 	return reqParams, nil
 }
 
-func GetLoadBalancerInstance(client *conn.NcloudAPIClient, loadBalancerInstanceNo string) (*loadbalancer.LoadBalancerInstance, error) {
+func GetLoadBalancerInstance(ctx context.Context, client *conn.NcloudAPIClient, loadBalancerInstanceNo string) (*loadbalancer.LoadBalancerInstance, error) {
 	reqParams := &loadbalancer.GetLoadBalancerInstanceListRequest{
 		LoadBalancerInstanceNoList: []*string{ncloud.String(loadBalancerInstanceNo)},
 	}
-	LogCommonRequest("GetLoadBalancerInstanceList", reqParams)
+	ctx = LogCommonRequest(ctx, "GetLoadBalancerInstanceList", reqParams)
 	resp, err := client.Loadbalancer.V2Api.GetLoadBalancerInstanceList(reqParams)
 	if err != nil {
-		LogErrorResponse("GetLoadBalancerInstanceList", err, reqParams)
+		LogErrorResponse(ctx, "GetLoadBalancerInstanceList", err, reqParams)
 		return nil, err
 	}
-	LogCommonResponse("GetLoadBalancerInstanceList", GetCommonResponse(resp))
+	LogCommonResponse(ctx, "GetLoadBalancerInstanceList", GetCommonResponse(resp))
 
 	for _, inst := range resp.LoadBalancerInstanceList {
 		if loadBalancerInstanceNo == ncloud.StringValue(inst.LoadBalancerInstanceNo) {
@@ -403,27 +405,27 @@ func GetLoadBalancerInstance(client *conn.NcloudAPIClient, loadBalancerInstanceN
 	return nil, nil
 }
 
-func deleteLoadBalancerInstance(client *conn.NcloudAPIClient, loadBalancerInstanceNo string) error {
+func deleteLoadBalancerInstance(ctx context.Context, client *conn.NcloudAPIClient, loadBalancerInstanceNo string) error {
 	reqParams := &loadbalancer.DeleteLoadBalancerInstancesRequest{
 		LoadBalancerInstanceNoList: []*string{ncloud.String(loadBalancerInstanceNo)},
 	}
-	LogCommonRequest("DeleteLoadBalancerInstance", reqParams)
+	ctx = LogCommonRequest(ctx, "DeleteLoadBalancerInstance", reqParams)
 	resp, err := client.Loadbalancer.V2Api.DeleteLoadBalancerInstances(reqParams)
 	if err != nil {
-		LogErrorResponse("DeleteLoadBalancerInstance", err, loadBalancerInstanceNo)
+		LogErrorResponse(ctx, "DeleteLoadBalancerInstance", err, loadBalancerInstanceNo)
 		return err
 	}
 	var commonResponse = &CommonResponse{}
 	if resp != nil {
 		commonResponse = GetCommonResponse(resp)
 	}
-	LogCommonResponse("DeleteLoadBalancerInstance", commonResponse)
+	LogCommonResponse(ctx, "DeleteLoadBalancerInstance", commonResponse)
 
-	stateConf := &resource.StateChangeConf{
+	stateConf := &waiter.Config[any]{
 		Pending: []string{"", "USED"},
 		Target:  []string{"OK"},
-		Refresh: func() (interface{}, string, error) {
-			instance, err := GetLoadBalancerInstance(client, loadBalancerInstanceNo)
+		Refresh: func(ctx context.Context) (any, string, error) {
+			instance, err := GetLoadBalancerInstance(ctx, client, loadBalancerInstanceNo)
 			if err != nil {
 				return 0, "", err
 			}
@@ -434,12 +436,12 @@ func deleteLoadBalancerInstance(client *conn.NcloudAPIClient, loadBalancerInstan
 
 			return instance, "", nil
 		},
-		Timeout:    conn.DefaultUpdateTimeout,
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Timeout:     conn.DefaultUpdateTimeout,
+		Delay:       2 * time.Second,
+		MinInterval: 3 * time.Second,
 	}
 
-	_, err = stateConf.WaitForState()
+	_, err = stateConf.WaitForState(ctx)
 	if err != nil {
 		return fmt.Errorf("Error waiting to delete LoadBalancerInstance: %s", err)
 	}
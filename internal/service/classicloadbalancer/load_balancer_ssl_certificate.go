@@ -1,11 +1,13 @@
 package classicloadbalancer
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/loadbalancer"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/common"
@@ -14,12 +16,12 @@ import (
 
 func ResourceNcloudLoadBalancerSSLCertificate() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNcloudLoadBalancerSSLCertificateCreate,
-		Read:   resourceNcloudLoadBalancerSSLCertificateRead,
-		Update: resourceNcloudLoadBalancerSSLCertificateUpdate,
-		Delete: resourceNcloudLoadBalancerSSLCertificateDelete,
+		CreateContext: resourceNcloudLoadBalancerSSLCertificateCreate,
+		ReadContext:   resourceNcloudLoadBalancerSSLCertificateRead,
+		UpdateContext: resourceNcloudLoadBalancerSSLCertificateUpdate,
+		DeleteContext: resourceNcloudLoadBalancerSSLCertificateDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(conn.DefaultCreateTimeout),
@@ -51,46 +53,46 @@ func ResourceNcloudLoadBalancerSSLCertificate() *schema.Resource {
 	}
 }
 
-func resourceNcloudLoadBalancerSSLCertificateCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudLoadBalancerSSLCertificateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*conn.ProviderConfig).Client
 	config := meta.(*conn.ProviderConfig)
 
 	if config.SupportVPC {
-		return NotSupportVpc("resource `ncloud_load_balancer_ssl_certificate`")
+		return diag.FromErr(NotSupportVpc("resource `ncloud_load_balancer_ssl_certificate`"))
 	}
 
 	reqParams, err := buildCreateLoadBalancerSSLCertificateParams(d)
 	if err != nil {
-		LogErrorResponse("AddLoadBalancerSslCertificate", err, reqParams)
-		return err
+		LogErrorResponse(ctx, "AddLoadBalancerSslCertificate", err, reqParams)
+		return diag.FromErr(err)
 	}
 
-	LogCommonRequest("AddLoadBalancerSslCertificate", reqParams)
+	ctx = LogCommonRequest(ctx, "AddLoadBalancerSslCertificate", reqParams)
 
 	resp, err := client.Loadbalancer.V2Api.AddLoadBalancerSslCertificate(reqParams)
 	if err != nil {
-		LogErrorResponse("AddLoadBalancerSslCertificate", err, reqParams)
-		return err
+		LogErrorResponse(ctx, "AddLoadBalancerSslCertificate", err, reqParams)
+		return diag.FromErr(err)
 	}
 
-	LogCommonResponse("AddLoadBalancerSslCertificate", GetCommonResponse(resp))
+	LogCommonResponse(ctx, "AddLoadBalancerSslCertificate", GetCommonResponse(resp))
 
 	if len(resp.SslCertificateList) == 0 {
-		return fmt.Errorf("no SSL certificate found in the API response")
+		return diag.FromErr(fmt.Errorf("no SSL certificate found in the API response"))
 	}
 
 	cert := resp.SslCertificateList[0]
 	d.SetId(*cert.CertificateName)
 
-	return resourceNcloudLoadBalancerSSLCertificateRead(d, meta)
+	return resourceNcloudLoadBalancerSSLCertificateRead(ctx, d, meta)
 }
 
-func resourceNcloudLoadBalancerSSLCertificateRead(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudLoadBalancerSSLCertificateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*conn.ProviderConfig).Client
 
-	lb, err := GetLoadBalancerSslCertificateList(client, d.Id())
+	lb, err := GetLoadBalancerSslCertificateList(ctx, client, d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if lb != nil {
 		d.Set("certificate_name", lb.CertificateName)
@@ -105,17 +107,17 @@ func resourceNcloudLoadBalancerSSLCertificateRead(d *schema.ResourceData, meta i
 	return nil
 }
 
-func resourceNcloudLoadBalancerSSLCertificateDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudLoadBalancerSSLCertificateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*conn.ProviderConfig).Client
-	if err := deleteLoadBalancerSSLCertificate(client, d.Id()); err != nil {
-		return err
+	if err := deleteLoadBalancerSSLCertificate(ctx, client, d.Id()); err != nil {
+		return diag.FromErr(err)
 	}
 	d.SetId("")
 	return nil
 }
 
-func resourceNcloudLoadBalancerSSLCertificateUpdate(d *schema.ResourceData, meta interface{}) error {
-	return resourceNcloudLoadBalancerSSLCertificateRead(d, meta)
+func resourceNcloudLoadBalancerSSLCertificateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceNcloudLoadBalancerSSLCertificateRead(ctx, d, meta)
 }
 
 func buildCreateLoadBalancerSSLCertificateParams(d *schema.ResourceData) (*loadbalancer.AddLoadBalancerSslCertificateRequest, error) {
@@ -132,15 +134,15 @@ func buildCreateLoadBalancerSSLCertificateParams(d *schema.ResourceData) (*loadb
 	return reqParams, nil
 }
 
-func GetLoadBalancerSslCertificateList(client *conn.NcloudAPIClient, certificateName string) (*loadbalancer.SslCertificate, error) {
+func GetLoadBalancerSslCertificateList(ctx context.Context, client *conn.NcloudAPIClient, certificateName string) (*loadbalancer.SslCertificate, error) {
 	reqParams := loadbalancer.GetLoadBalancerSslCertificateListRequest{CertificateName: ncloud.String(certificateName)}
-	LogCommonRequest("GetLoadBalancerSslCertificateList", reqParams)
+	ctx = LogCommonRequest(ctx, "GetLoadBalancerSslCertificateList", reqParams)
 	resp, err := client.Loadbalancer.V2Api.GetLoadBalancerSslCertificateList(&reqParams)
 	if err != nil {
-		LogErrorResponse("GetLoadBalancerSslCertificateList", err, certificateName)
+		LogErrorResponse(ctx, "GetLoadBalancerSslCertificateList", err, certificateName)
 		return nil, err
 	}
-	LogCommonResponse("GetLoadBalancerSslCertificateList", GetCommonResponse(resp))
+	LogCommonResponse(ctx, "GetLoadBalancerSslCertificateList", GetCommonResponse(resp))
 
 	for _, cert := range resp.SslCertificateList {
 		if certificateName == ncloud.StringValue(cert.CertificateName) {
@@ -151,19 +153,19 @@ func GetLoadBalancerSslCertificateList(client *conn.NcloudAPIClient, certificate
 	return nil, nil
 }
 
-func deleteLoadBalancerSSLCertificate(client *conn.NcloudAPIClient, certificateName string) error {
+func deleteLoadBalancerSSLCertificate(ctx context.Context, client *conn.NcloudAPIClient, certificateName string) error {
 	reqParams := loadbalancer.DeleteLoadBalancerSslCertificateRequest{CertificateName: ncloud.String(certificateName)}
-	LogCommonRequest("DeleteLoadBalancerSslCertificate", reqParams)
+	ctx = LogCommonRequest(ctx, "DeleteLoadBalancerSslCertificate", reqParams)
 	resp, err := client.Loadbalancer.V2Api.DeleteLoadBalancerSslCertificate(&reqParams)
 	if err != nil {
-		LogErrorResponse("DeleteLoadBalancerSslCertificate", err, certificateName)
+		LogErrorResponse(ctx, "DeleteLoadBalancerSslCertificate", err, certificateName)
 		return err
 	}
 	var commonResponse = &CommonResponse{}
 	if resp != nil {
 		commonResponse = GetCommonResponse(resp)
 	}
-	LogCommonResponse("DeleteLoadBalancerSslCertificate", commonResponse)
+	LogCommonResponse(ctx, "DeleteLoadBalancerSslCertificate", commonResponse)
 
 	return nil
 }
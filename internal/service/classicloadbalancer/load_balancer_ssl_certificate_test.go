@@ -1,6 +1,7 @@
 package classicloadbalancer_test
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"testing"
@@ -20,7 +21,7 @@ func TestAccNcloudLoadBalancerSSLCertificateBasic(t *testing.T) {
 	t.Skip()
 
 	var sc loadbalancer.SslCertificate
-	prefix := GetTestPrefix()
+	prefix := GetTestPrefix(t)
 	testSSLCertificateName := prefix + "_cert"
 	testLoadBalancerName := prefix + "_lb"
 	testCertPEM := `-----BEGIN CERTIFICATE-----
@@ -135,7 +136,7 @@ func testAccCheckLoadBalancerSSLCertificateExistsWithProvider(n string, i *loadb
 
 		provider := providerF()
 		client := provider.Meta().(*conn.ProviderConfig).Client
-		sc, err := classicloadbalancer.GetLoadBalancerSslCertificateList(client, rs.Primary.ID)
+		sc, err := classicloadbalancer.GetLoadBalancerSslCertificateList(context.Background(), client, rs.Primary.ID)
 		if err != nil {
 			return nil
 		}
@@ -159,7 +160,7 @@ func testAccCheckLoadBalancerSSLCertificateDestroyWithProvider(s *terraform.Stat
 		if rs.Type != "ncloud_load_balancer_ssl_certificate" {
 			continue
 		}
-		sc, err := classicloadbalancer.GetLoadBalancerSslCertificateList(client, rs.Primary.ID)
+		sc, err := classicloadbalancer.GetLoadBalancerSslCertificateList(context.Background(), client, rs.Primary.ID)
 		if sc == nil {
 			return nil
 		}
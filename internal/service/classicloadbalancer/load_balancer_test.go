@@ -1,6 +1,7 @@
 package classicloadbalancer_test
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -16,7 +17,7 @@ import (
 
 func TestAccNcloudLoadBalancerBasic(t *testing.T) {
 	var loadBalancerInstance loadbalancer.LoadBalancerInstance
-	prefix := GetTestPrefix()
+	prefix := GetTestPrefix(t)
 	testLoadBalancerName := prefix + "_lb"
 
 	testCheck := func() func(*terraform.State) error {
@@ -61,7 +62,7 @@ func TestAccNcloudLoadBalancerBasic(t *testing.T) {
 func TestAccNcloudLoadBalancerChangeConfiguration(t *testing.T) {
 	var before loadbalancer.LoadBalancerInstance
 	var after loadbalancer.LoadBalancerInstance
-	prefix := GetTestPrefix()
+	prefix := GetTestPrefix(t)
 	testLoadBalancerName := prefix + "_lb"
 
 	resource.Test(t, resource.TestCase{
@@ -111,7 +112,7 @@ func testAccCheckLoadBalancerExistsWithProvider(n string, i *loadbalancer.LoadBa
 
 		provider := providerF()
 		client := provider.Meta().(*conn.ProviderConfig).Client
-		LoadBalancerInstance, err := classicloadbalancer.GetLoadBalancerInstance(client, rs.Primary.ID)
+		LoadBalancerInstance, err := classicloadbalancer.GetLoadBalancerInstance(context.Background(), client, rs.Primary.ID)
 		if err != nil {
 			return nil
 		}
@@ -135,7 +136,7 @@ func testAccCheckLoadBalancerDestroyWithProvider(s *terraform.State, provider *s
 		if rs.Type != "ncloud_load_balancer" {
 			continue
 		}
-		loadBalancerInstance, err := classicloadbalancer.GetLoadBalancerInstance(client, rs.Primary.ID)
+		loadBalancerInstance, err := classicloadbalancer.GetLoadBalancerInstance(context.Background(), client, rs.Primary.ID)
 		if loadBalancerInstance == nil {
 			return nil
 		}
@@ -0,0 +1,85 @@
+package classicloadbalancer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/loadbalancer"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/paging"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/sweep"
+)
+
+// RegisterSweepers registers the sweepers of classic load balancers, which only run with NCLOUD_SUPPORT_VPC=false
+func RegisterSweepers() {
+	sweep.AddTestSweepers("ncloud_load_balancer", &resource.Sweeper{
+		Name: "ncloud_load_balancer",
+		F:    sweepLoadBalancers,
+	})
+
+	sweep.AddTestSweepers("ncloud_load_balancer_ssl_certificate", &resource.Sweeper{
+		Name: "ncloud_load_balancer_ssl_certificate",
+		F:    sweepLoadBalancerSSLCertificates,
+		Dependencies: []string{
+			"ncloud_load_balancer",
+		},
+	})
+}
+
+func sweepLoadBalancers(region string) error {
+	config, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+	if config.SupportVPC {
+		return nil
+	}
+
+	loadBalancerInstanceList, err := paging.All(context.Background(), func(ctx context.Context, pageNo, pageSize int32) ([]*loadbalancer.LoadBalancerInstance, *int32, error) {
+		resp, err := config.Client.Loadbalancer.V2Api.GetLoadBalancerInstanceList(&loadbalancer.GetLoadBalancerInstanceListRequest{RegionNo: &config.RegionNo, PageNo: ncloud.Int32(pageNo), PageSize: ncloud.Int32(pageSize)})
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.LoadBalancerInstanceList, resp.TotalRows, nil
+	})
+	if err != nil {
+		return fmt.Errorf("listing load balancers: %w", err)
+	}
+
+	var sweepables []sweep.Sweepable
+	for _, lb := range loadBalancerInstanceList {
+		if !sweep.HasResourcePrefix(lb.LoadBalancerName) {
+			continue
+		}
+		sweepables = append(sweepables, sweep.NewSweepResource(ResourceNcloudLoadBalancer(), config, *lb.LoadBalancerInstanceNo, nil))
+	}
+
+	return sweep.SweepOrchestrator(context.Background(), sweepables)
+}
+
+func sweepLoadBalancerSSLCertificates(region string) error {
+	config, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+	if config.SupportVPC {
+		return nil
+	}
+
+	resp, err := config.Client.Loadbalancer.V2Api.GetLoadBalancerSslCertificateList(&loadbalancer.GetLoadBalancerSslCertificateListRequest{})
+	if err != nil {
+		return fmt.Errorf("listing SSL certificates: %w", err)
+	}
+
+	var sweepables []sweep.Sweepable
+	for _, cert := range resp.SslCertificateList {
+		if !sweep.HasResourcePrefix(cert.CertificateName) {
+			continue
+		}
+		sweepables = append(sweepables, sweep.NewSweepResource(ResourceNcloudLoadBalancerSSLCertificate(), config, *cert.CertificateName, nil))
+	}
+
+	return sweep.SweepOrchestrator(context.Background(), sweepables)
+}
@@ -488,7 +488,7 @@ func ResourceNcloudSourceBuildProject() *schema.Resource {
 func resourceNcloudSourceBuildProjectCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*conn.ProviderConfig)
 
-	id, err := SourceBuildProjectCreate(d, config)
+	id, err := SourceBuildProjectCreate(ctx, d, config)
 
 	if err != nil {
 		return diag.FromErr(err)
@@ -500,7 +500,7 @@ func resourceNcloudSourceBuildProjectCreate(ctx context.Context, d *schema.Resou
 	return resourceNcloudSourceBuildProjectRead(ctx, d, meta)
 }
 
-func SourceBuildProjectCreate(d *schema.ResourceData, config *conn.ProviderConfig) (*int32, error) {
+func SourceBuildProjectCreate(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) (*int32, error) {
 	commonParams, paramErr := getCommonProjectParams(d)
 	if paramErr != nil {
 		return nil, paramErr
@@ -518,13 +518,13 @@ func SourceBuildProjectCreate(d *schema.ResourceData, config *conn.ProviderConfi
 	}
 
 	var resp *sourcebuild.CreateProjectResponse
-	LogCommonRequest("createSourceBuildProject", reqParams)
+	ctx = LogCommonRequest(ctx, "createSourceBuildProject", reqParams)
 	resp, err := config.Client.Sourcebuild.V1Api.CreateProject(context.Background(), reqParams)
 	if err != nil {
-		LogErrorResponse("createSourceBuildProject", err, reqParams)
+		LogErrorResponse(ctx, "createSourceBuildProject", err, reqParams)
 		return nil, err
 	}
-	LogResponse("createSourceBuildProject", resp)
+	LogResponse(ctx, "createSourceBuildProject", resp)
 
 	return resp.Id, nil
 }
@@ -534,10 +534,10 @@ func resourceNcloudSourceBuildProjectDelete(ctx context.Context, d *schema.Resou
 
 	id := ncloud.String(d.Id())
 
-	LogCommonRequest("deleteSourceBuildProject", id)
+	ctx = LogCommonRequest(ctx, "deleteSourceBuildProject", id)
 	err := config.Client.Sourcebuild.V1Api.DeleteProject(ctx, id)
 	if err != nil {
-		LogErrorResponse("deleteSourceBuildProject", err, id)
+		LogErrorResponse(ctx, "deleteSourceBuildProject", err, id)
 		return diag.FromErr(err)
 	}
 
@@ -565,13 +565,13 @@ func changeBuildProject(ctx context.Context, d *schema.ResourceData, config *con
 	id := ncloud.String(d.Id())
 
 	var resp *sourcebuild.CreateProjectResponse
-	LogCommonRequest("updateSourceBuildProject", reqParams)
+	ctx = LogCommonRequest(ctx, "updateSourceBuildProject", reqParams)
 	resp, err := config.Client.Sourcebuild.V1Api.ChangeProject(ctx, reqParams, id)
 	if err != nil {
-		LogErrorResponse("updateSourceBuildProject", err, id)
+		LogErrorResponse(ctx, "updateSourceBuildProject", err, id)
 		return err
 	}
-	LogResponse("updateSourceBuildProject", resp)
+	LogResponse(ctx, "updateSourceBuildProject", resp)
 
 	return nil
 }
@@ -783,6 +783,11 @@ func resourceNcloudSourceBuildProjectRead(ctx context.Context, d *schema.Resourc
 	config := meta.(*conn.ProviderConfig)
 
 	project, err := getBuildProject(ctx, config, ncloud.String(d.Id()))
+	if IsNotFound(err) {
+		log.Printf("[WARN] SourceBuild project %s was not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -797,15 +802,15 @@ func resourceNcloudSourceBuildProjectRead(ctx context.Context, d *schema.Resourc
 }
 
 func getBuildProject(ctx context.Context, config *conn.ProviderConfig, id *string) (*sourcebuild.GetProjectDetailResponse, error) {
-	LogCommonRequest("getSourceBuildProjectDetail", id)
+	ctx = LogCommonRequest(ctx, "getSourceBuildProjectDetail", id)
 	resp, err := config.Client.Sourcebuild.V1Api.GetProject(ctx, id)
 
 	if err != nil {
-		LogErrorResponse("getSourceBuildProjectDetail", err, id)
+		LogErrorResponse(ctx, "getSourceBuildProjectDetail", err, id)
 		return nil, err
 	}
 
-	LogResponse("getSourceBuildProjectDetail", resp)
+	LogResponse(ctx, "getSourceBuildProjectDetail", resp)
 
 	return convertBuildProject(resp), nil
 }
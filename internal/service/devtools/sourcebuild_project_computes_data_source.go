@@ -42,13 +42,13 @@ func DataSourceNcloudSourceBuildComputes() *schema.Resource {
 func dataSourceNcloudSourceBuildComputesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*conn.ProviderConfig)
 
-	LogCommonRequest("GetComputeEnv", "")
+	ctx = LogCommonRequest(ctx, "GetComputeEnv", "")
 	resp, err := config.Client.Sourcebuild.V1Api.GetComputeEnv(ctx)
 	if err != nil {
-		LogErrorResponse("GetComputeEnv", err, "")
+		LogErrorResponse(ctx, "GetComputeEnv", err, "")
 		return diag.FromErr(err)
 	}
-	LogResponse("GetComputeEnv", resp)
+	LogResponse(ctx, "GetComputeEnv", resp)
 
 	resources := []map[string]interface{}{}
 
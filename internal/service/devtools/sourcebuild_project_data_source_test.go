@@ -4,15 +4,14 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/acctest"
 )
 
 func TestAccDataSourceNcloudSourceBuildProject(t *testing.T) {
-	name := fmt.Sprintf("test-sourcebuild-project-name-%s", acctest.RandString(5))
-	repoName := fmt.Sprintf("test-repo-basic-%s", acctest.RandString(5))
+	name := fmt.Sprintf("test-sourcebuild-project-name-%s", RandString(t, 5))
+	repoName := fmt.Sprintf("test-repo-basic-%s", RandString(t, 5))
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { TestAccPreCheck(t) },
@@ -38,13 +38,13 @@ func DataSourceNcloudSourceBuildDockerEngines() *schema.Resource {
 func dataSourceNcloudSourceBuildDockerEnginesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*conn.ProviderConfig)
 
-	LogCommonRequest("GetDockerEnv", "")
+	ctx = LogCommonRequest(ctx, "GetDockerEnv", "")
 	resp, err := config.Client.Sourcebuild.V1Api.GetDockerEnv(context.Background())
 	if err != nil {
-		LogErrorResponse("GetDockerEnv", err, "")
+		LogErrorResponse(ctx, "GetDockerEnv", err, "")
 		return diag.FromErr(err)
 	}
-	LogResponse("GetDockerEnv", resp)
+	LogResponse(ctx, "GetDockerEnv", resp)
 
 	resources := []map[string]interface{}{}
 
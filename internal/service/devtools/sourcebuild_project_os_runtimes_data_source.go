@@ -46,13 +46,13 @@ func dataSourceNcloudSourceBuildRuntimesRead(ctx context.Context, d *schema.Reso
 	osIdParam := Int32PtrOrNil(d.GetOk("os_id"))
 	osId := ncloud.IntString(int(ncloud.Int32Value(osIdParam)))
 
-	LogCommonRequest("GetRuntimeEnv", "")
+	ctx = LogCommonRequest(ctx, "GetRuntimeEnv", "")
 	resp, err := config.Client.Sourcebuild.V1Api.GetRuntimeEnv(context.Background(), osId)
 	if err != nil {
-		LogErrorResponse("GetRuntimeEnv", err, "")
+		LogErrorResponse(ctx, "GetRuntimeEnv", err, "")
 		return diag.FromErr(err)
 	}
-	LogResponse("GetRuntimeEnv", resp)
+	LogResponse(ctx, "GetRuntimeEnv", resp)
 
 	resources := []map[string]interface{}{}
 
@@ -8,7 +8,6 @@ import (
 	"testing"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/sourcebuild"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 
@@ -19,8 +18,8 @@ import (
 
 func TestAccResourceNcloudSourcebuildProject_basic(t *testing.T) {
 	var project sourcebuild.GetProjectDetailResponse
-	name := fmt.Sprintf("test-sourcebuild-project-basic-%s", acctest.RandString(5))
-	repoName := fmt.Sprintf("test-repo-basic-%s", acctest.RandString(5))
+	name := fmt.Sprintf("test-sourcebuild-project-basic-%s", RandString(t, 5))
+	repoName := fmt.Sprintf("test-repo-basic-%s", RandString(t, 5))
 	resourceName := "ncloud_sourcebuild_project.test-project"
 
 	resource.Test(t, resource.TestCase{
@@ -46,8 +45,8 @@ func TestAccResourceNcloudSourcebuildProject_basic(t *testing.T) {
 
 func TestAccResourceNcloudSourcebuildProject_update(t *testing.T) {
 	var project sourcebuild.GetProjectDetailResponse
-	name := fmt.Sprintf("test-sourcebuild-project-name-%s", acctest.RandString(5))
-	repoName := fmt.Sprintf("test-repo-basic-%s", acctest.RandString(5))
+	name := fmt.Sprintf("test-sourcebuild-project-name-%s", RandString(t, 5))
+	repoName := fmt.Sprintf("test-repo-basic-%s", RandString(t, 5))
 	resourceName := "ncloud_sourcebuild_project.test-project"
 
 	resource.Test(t, resource.TestCase{
@@ -238,7 +237,7 @@ func testAccCheckSourcebuildProjectDestroy(s *terraform.State) error {
 }
 
 func getSourceBuildProject(config *conn.ProviderConfig, id *string) (*sourcebuild.GetProjectDetailResponse, error) {
-	LogCommonRequest("getProjectDetail", id)
+	LogCommonRequest(context.Background(), "getProjectDetail", id)
 	//This api throws an error when the resource cannot be found.
 	resp, err := config.Client.Sourcebuild.V1Api.GetProject(context.Background(), id)
 
@@ -247,12 +246,12 @@ func getSourceBuildProject(config *conn.ProviderConfig, id *string) (*sourcebuil
 		if strings.Contains(err.Error(), "not found") {
 			return nil, nil
 		} else {
-			LogErrorResponse("getProjectDetail", err, id)
+			LogErrorResponse(context.Background(), "getProjectDetail", err, id)
 			return nil, err
 		}
 	}
 
-	LogResponse("getProjectDetail", resp)
+	LogResponse(context.Background(), "getProjectDetail", resp)
 
 	return resp, nil
 }
@@ -55,13 +55,13 @@ func dataSousrceNcloudSourceCommitRepositoriesRead(ctx context.Context, d *schem
 
 	config := meta.(*conn.ProviderConfig)
 
-	LogCommonRequest("GetSourceCommitRepositories", "")
+	ctx = LogCommonRequest(ctx, "GetSourceCommitRepositories", "")
 	resp, err := GetRepositories(ctx, config)
 	if err != nil {
-		LogErrorResponse("GetSourceCommitRepositories", err, "")
+		LogErrorResponse(ctx, "GetSourceCommitRepositories", err, "")
 		return diag.FromErr(err)
 	}
-	LogResponse("GetSourceCommitRepositories", resp)
+	LogResponse(ctx, "GetSourceCommitRepositories", resp)
 
 	resources := []map[string]interface{}{}
 
@@ -3,18 +3,19 @@ package devtools
 import (
 	"context"
 	"fmt"
+	"log"
 	"strconv"
 	"time"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/sourcecommit"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/waiter"
 )
 
 func ResourceNcloudSourceCommitRepository() *schema.Resource {
@@ -87,12 +88,12 @@ func resourceNcloudSourceCommitRepositoryCreate(ctx context.Context, d *schema.R
 		}
 	}
 
-	LogCommonRequest("resourceNcloudSourceCommitRepositoryCreate", reqParams)
+	ctx = LogCommonRequest(ctx, "resourceNcloudSourceCommitRepositoryCreate", reqParams)
 	resp, err := config.Client.Sourcecommit.V1Api.CreateRepository(ctx, reqParams)
 	var diags diag.Diagnostics
 
 	if err != nil {
-		LogErrorResponse("resourceNcloudSourceCommitRepositoryCreate", err, reqParams)
+		LogErrorResponse(ctx, "resourceNcloudSourceCommitRepositoryCreate", err, reqParams)
 
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -102,7 +103,7 @@ func resourceNcloudSourceCommitRepositoryCreate(ctx context.Context, d *schema.R
 		return diags
 	}
 
-	LogResponse("resourceNcloudSourceCommitRepositoryCreate", resp)
+	LogResponse(ctx, "resourceNcloudSourceCommitRepositoryCreate", resp)
 
 	name := ncloud.StringValue(reqParams.Name)
 
@@ -123,11 +124,16 @@ func resourceNcloudSourceCommitRepositoryRead(ctx context.Context, d *schema.Res
 	config := meta.(*conn.ProviderConfig)
 	name := ncloud.String(d.Get("name").(string))
 
-	LogCommonRequest("resourceNcloudSourceCommitRepositoryRead", name)
+	ctx = LogCommonRequest(ctx, "resourceNcloudSourceCommitRepositoryRead", name)
 	var diags diag.Diagnostics
 	repository, err := getRepository(ctx, config, *name)
+	if IsNotFound(err) {
+		log.Printf("[WARN] SourceCommit repository %s was not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
 	if err != nil {
-		LogErrorResponse("resourceNcloudSourceCommitRepositoryRead", err, *name)
+		LogErrorResponse(ctx, "resourceNcloudSourceCommitRepositoryRead", err, *name)
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
 			Summary:  "Unable to search repository",
@@ -136,7 +142,7 @@ func resourceNcloudSourceCommitRepositoryRead(ctx context.Context, d *schema.Res
 		return diags
 	}
 
-	LogResponse("resourceNcloudSourceCommitRepositoryRead", repository)
+	LogResponse(ctx, "resourceNcloudSourceCommitRepositoryRead", repository)
 
 	if repository == nil {
 		d.SetId("")
@@ -170,15 +176,15 @@ func resourceNcloudSourceCommitRepositoryUpdate(ctx context.Context, d *schema.R
 
 		id := ncloud.String(d.Id())
 
-		LogCommonRequest("resourceNcloudSourceCommitRepositoryUpdate", reqParams)
+		ctx = LogCommonRequest(ctx, "resourceNcloudSourceCommitRepositoryUpdate", reqParams)
 		_, err := config.Client.Sourcecommit.V1Api.ChangeRepository(ctx, reqParams, id)
 
 		if err != nil {
-			LogErrorResponse("resourceNcloudSourceCommitRepositoryUpdate", err, *id)
+			LogErrorResponse(ctx, "resourceNcloudSourceCommitRepositoryUpdate", err, *id)
 			return diag.FromErr(err)
 		}
 
-		LogResponse("resourceNcloudSourceCommitRepositoryUpdate", id)
+		LogResponse(ctx, "resourceNcloudSourceCommitRepositoryUpdate", id)
 	}
 
 	return resourceNcloudSourceCommitRepositoryRead(ctx, d, meta)
@@ -189,24 +195,24 @@ func resourceNcloudSourceCommitRepositoryDelete(ctx context.Context, d *schema.R
 
 	id := ncloud.String(d.Id())
 
-	LogCommonRequest("resourceNcloudSourceCommitRepositoryDelete", *id)
+	ctx = LogCommonRequest(ctx, "resourceNcloudSourceCommitRepositoryDelete", *id)
 
 	if _, err := config.Client.Sourcecommit.V1Api.DeleteRepository(ctx, id); err != nil {
-		LogErrorResponse("resourceNcloudSourceCommitRepositoryDelete", err, *id)
+		LogErrorResponse(ctx, "resourceNcloudSourceCommitRepositoryDelete", err, *id)
 		return diag.FromErr(err)
 	}
 
-	LogResponse("resourceNcloudSourceCommitRepositoryDelete", id)
+	LogResponse(ctx, "resourceNcloudSourceCommitRepositoryDelete", id)
 	d.SetId("")
 	return nil
 }
 
 func waitForSourceCommitRepositoryActive(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig, name string) error {
 
-	stateConf := &resource.StateChangeConf{
+	stateConf := &waiter.Config[any]{
 		Pending: []string{"PENDING"},
 		Target:  []string{"RESOLVE"},
-		Refresh: func() (result interface{}, state string, err error) {
+		Refresh: func(ctx context.Context) (result any, state string, err error) {
 			repository, err := getRepository(ctx, config, name)
 			if err != nil {
 				return nil, "", fmt.Errorf("Repository response error , name : (%s) to become activating: %s", name, err)
@@ -222,11 +228,11 @@ func waitForSourceCommitRepositoryActive(ctx context.Context, d *schema.Resource
 
 			return nil, "PENDING", nil
 		},
-		Timeout:    d.Timeout(schema.TimeoutCreate),
-		MinTimeout: 3 * time.Second,
-		Delay:      2 * time.Second,
+		Timeout:     d.Timeout(schema.TimeoutCreate),
+		MinInterval: 3 * time.Second,
+		Delay:       2 * time.Second,
 	}
-	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+	if _, err := stateConf.WaitForState(ctx); err != nil {
 		return fmt.Errorf("error waiting for SourceCommit Repository id : (%s) to become activating: %s", name, err)
 	}
 	return nil
@@ -234,40 +240,40 @@ func waitForSourceCommitRepositoryActive(ctx context.Context, d *schema.Resource
 
 func getRepository(ctx context.Context, config *conn.ProviderConfig, name string) (*sourcecommit.GetRepositoryDetailResponse, error) {
 
-	LogCommonRequest("getRepository", name)
+	ctx = LogCommonRequest(ctx, "getRepository", name)
 	resp, err := config.Client.Sourcecommit.V1Api.GetRepository(ctx, &name)
 
 	if err != nil {
-		LogErrorResponse("getRepository", err, name)
+		LogErrorResponse(ctx, "getRepository", err, name)
 		return nil, err
 	}
-	LogResponse("getRepository", resp)
+	LogResponse(ctx, "getRepository", resp)
 
 	return resp, nil
 }
 
 func GetRepositoryById(ctx context.Context, config *conn.ProviderConfig, id string) (*sourcecommit.GetRepositoryDetailResponse, error) {
 
-	LogCommonRequest("getRepositoryById", id)
+	ctx = LogCommonRequest(ctx, "getRepositoryById", id)
 	resp, err := config.Client.Sourcecommit.V1Api.GetRepositoryById(ctx, &id)
 
 	if err != nil {
-		LogErrorResponse("getRepositoryById", err, id)
+		LogErrorResponse(ctx, "getRepositoryById", err, id)
 		return nil, err
 	}
-	LogResponse("getRepositoryById", resp)
+	LogResponse(ctx, "getRepositoryById", resp)
 
 	return resp, nil
 }
 
 func GetRepositories(ctx context.Context, config *conn.ProviderConfig) (*sourcecommit.GetRepositoryListResponse, error) {
-	LogCommonRequest("getRepositories", "")
+	ctx = LogCommonRequest(ctx, "getRepositories", "")
 	resp, err := config.Client.Sourcecommit.V1Api.GetRepositories(ctx)
 	if err != nil {
-		LogErrorResponse("getRepositories", err, "")
+		LogErrorResponse(ctx, "getRepositories", err, "")
 		return nil, err
 	}
-	LogResponse("getRepositories", resp)
+	LogResponse(ctx, "getRepositories", resp)
 
 	return resp, nil
 }
@@ -53,13 +53,13 @@ func dataSousrceNcloudSourceCommitRepositoryRead(ctx context.Context, d *schema.
 
 	name := d.Get("name").(string)
 
-	LogCommonRequest("GetSourceCommitRepository", "")
+	ctx = LogCommonRequest(ctx, "GetSourceCommitRepository", "")
 	repository, err := getRepository(ctx, config, name)
 
 	var diags diag.Diagnostics
 
 	if err != nil {
-		LogErrorResponse("GetSourceCommitRepository", err, "")
+		LogErrorResponse(ctx, "GetSourceCommitRepository", err, "")
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
 			Summary:  "Unable to search repository",
@@ -69,7 +69,7 @@ func dataSousrceNcloudSourceCommitRepositoryRead(ctx context.Context, d *schema.
 	}
 
 	if repository == nil {
-		LogErrorResponse("GetSourceCommitRepository", err, "")
+		LogErrorResponse(ctx, "GetSourceCommitRepository", err, "")
 		d.SetId("")
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -79,7 +79,7 @@ func dataSousrceNcloudSourceCommitRepositoryRead(ctx context.Context, d *schema.
 		return diags
 	}
 
-	LogResponse("GetSourceCommitRepository", repository)
+	LogResponse(ctx, "GetSourceCommitRepository", repository)
 	d.SetId(strconv.Itoa(*repository.Id))
 	d.Set("repository_no", strconv.Itoa(*repository.Id))
 	d.Set("name", repository.Name)
@@ -12,7 +12,7 @@ import (
 func TestAccDataSourceNcloudSourceCommitRepository(t *testing.T) {
 	dataName := "data.ncloud_sourcecommit_repository.test-repo"
 	resourceName := "ncloud_sourcecommit_repository.test-repo"
-	repositoryName := getTestRepositoryName()
+	repositoryName := getTestRepositoryName(t)
 	repositoryDesc := fmt.Sprintf("description of %v", repositoryName)
 
 	resource.ParallelTest(t, resource.TestCase{
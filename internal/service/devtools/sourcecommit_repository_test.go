@@ -9,7 +9,6 @@ import (
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/sourcecommit"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 
@@ -21,7 +20,7 @@ import (
 func TestAccResourceNcloudSourceCommitRepository_basic(t *testing.T) {
 	var repository sourcecommit.GetRepositoryDetailResponse
 	resourceName := "ncloud_sourcecommit_repository.test-repo-basic"
-	repositoryName := getTestRepositoryName()
+	repositoryName := getTestRepositoryName(t)
 	repositoryDesc := fmt.Sprintf("description of %v", repositoryName)
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -102,8 +101,8 @@ func testAccCheckSourceCommitRepositoryDestroy(s *terraform.State) error {
 	return nil
 }
 
-func getTestRepositoryName() string {
-	rInt := acctest.RandIntRange(1, 9999)
+func getTestRepositoryName(t *testing.T) string {
+	rInt := RandIntRange(t, 1, 9999)
 	testRepositoryName := fmt.Sprintf("tf-%d-repository", rInt)
 	return testRepositoryName
 }
@@ -2,6 +2,7 @@ package devtools
 
 import (
 	"context"
+	"log"
 	"regexp"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
@@ -56,13 +57,13 @@ func resourceNcloudSourceDeployProjectCreate(ctx context.Context, d *schema.Reso
 		Name: StringPtrOrNil(d.GetOk("name")),
 	}
 
-	LogCommonRequest("CreateSourceDeployProject", reqParams)
+	ctx = LogCommonRequest(ctx, "CreateSourceDeployProject", reqParams)
 	resp, err := config.Client.Vsourcedeploy.V1Api.CreateProject(ctx, reqParams)
 	if err != nil {
-		LogErrorResponse("CreateSourceDeployProject", err, reqParams)
+		LogErrorResponse(ctx, "CreateSourceDeployProject", err, reqParams)
 		return diag.FromErr(err)
 	}
-	LogResponse("CreateSourceDeployProject", resp)
+	LogResponse(ctx, "CreateSourceDeployProject", resp)
 	d.SetId(*ncloud.IntString(int(ncloud.Int32Value(resp.Id))))
 
 	return resourceNcloudSourceDeployProjectRead(ctx, d, meta)
@@ -75,6 +76,11 @@ func resourceNcloudSourceDeployProjectRead(ctx context.Context, d *schema.Resour
 		return diag.FromErr(NotSupportClassic("resource `ncloud_sourcedeploy_project`"))
 	}
 	project, err := GetSourceDeployProjectByName(ctx, config, d.Get("name").(string))
+	if IsNotFound(err) {
+		log.Printf("[WARN] SourceDeploy project %s was not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -96,14 +102,14 @@ func resourceNcloudSourceDeployProjectDelete(ctx context.Context, d *schema.Reso
 		return diag.FromErr(NotSupportClassic("resource `ncloud_sourcedeploy_project`"))
 	}
 
-	LogCommonRequest("DeleteSourceDeployProject", d.Id())
+	ctx = LogCommonRequest(ctx, "DeleteSourceDeployProject", d.Id())
 	resp, err := config.Client.Vsourcedeploy.V1Api.DeleteProject(ctx, ncloud.String(d.Id()))
 	if err != nil {
-		LogErrorResponse("DeleteSourceDeployProject", err, d.Id())
+		LogErrorResponse(ctx, "DeleteSourceDeployProject", err, d.Id())
 		return diag.FromErr(err)
 	}
 
-	LogResponse("DeleteSourceDeployProject", resp)
+	LogResponse(ctx, "DeleteSourceDeployProject", resp)
 	d.SetId("")
 	return nil
 }
@@ -137,13 +143,13 @@ func GetSourceDeployProjectByName(ctx context.Context, config *conn.ProviderConf
 func getSourceDeployProjects(ctx context.Context, config *conn.ProviderConfig) ([]*vsourcedeploy.GetIdNameResponse, error) {
 	reqParams := make(map[string]interface{})
 
-	LogCommonRequest("GetSourceDeployProjects", reqParams)
+	ctx = LogCommonRequest(ctx, "GetSourceDeployProjects", reqParams)
 	resp, err := config.Client.Vsourcedeploy.V1Api.GetProjects(ctx, reqParams)
 	if err != nil {
-		LogErrorResponse("GetSourceDeployProjects", err, reqParams)
+		LogErrorResponse(ctx, "GetSourceDeployProjects", err, reqParams)
 		return nil, err
 	}
-	LogResponse("GetSourceDeployProjects", resp)
+	LogResponse(ctx, "GetSourceDeployProjects", resp)
 
 	return resp.ProjectList, nil
 }
@@ -3,6 +3,7 @@ package devtools
 import (
 	"context"
 	"fmt"
+	"log"
 	"regexp"
 	"strconv"
 	"strings"
@@ -126,13 +127,13 @@ func resourceNcloudSourceDeployStageCreate(ctx context.Context, d *schema.Resour
 		return diag.FromErr(paramsErr)
 	}
 	projectId := ncloud.IntString(d.Get("project_id").(int))
-	LogCommonRequest("createSourceDeployStage", reqParams)
+	ctx = LogCommonRequest(ctx, "createSourceDeployStage", reqParams)
 	resp, err := config.Client.Vsourcedeploy.V1Api.CreateStage(ctx, reqParams, projectId)
 	if err != nil {
-		LogErrorResponse("createSourceDeployStage", err, reqParams)
+		LogErrorResponse(ctx, "createSourceDeployStage", err, reqParams)
 		return diag.FromErr(err)
 	}
-	LogResponse("createSourceDeployStage", resp.Id)
+	LogResponse(ctx, "createSourceDeployStage", resp.Id)
 
 	d.SetId(*ncloud.IntString(int(ncloud.Int32Value(resp.Id))))
 	d.Set("project_id", Int32PtrOrNil(d.GetOk("project_id")))
@@ -149,6 +150,11 @@ func resourceNcloudSourceDeployStageRead(ctx context.Context, d *schema.Resource
 	projectId := ncloud.IntString(d.Get("project_id").(int))
 	stage, err := GetSourceDeployStageById(ctx, config, projectId, ncloud.String(d.Id()))
 
+	if IsNotFound(err) {
+		log.Printf("[WARN] SourceDeploy stage %s was not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -184,14 +190,14 @@ func resourceNcloudSourceDeployStageDelete(ctx context.Context, d *schema.Resour
 	}
 
 	projectId := ncloud.IntString(d.Get("project_id").(int))
-	LogCommonRequest("deleteSourceDeployStage", d.Id())
+	ctx = LogCommonRequest(ctx, "deleteSourceDeployStage", d.Id())
 	resp, err := config.Client.Vsourcedeploy.V1Api.DeleteStage(ctx, projectId, ncloud.String(d.Id()))
 	if err != nil {
-		LogErrorResponse("deleteSourceDeployStage", err, d.Id())
+		LogErrorResponse(ctx, "deleteSourceDeployStage", err, d.Id())
 		return diag.FromErr(err)
 	}
 
-	LogResponse("deleteSourceDeployStage", resp)
+	LogResponse(ctx, "deleteSourceDeployStage", resp)
 	d.SetId("")
 	return nil
 }
@@ -246,13 +252,13 @@ func getDeployTarget(d *schema.ResourceData) (*vsourcedeploy.StageConfig, error)
 }
 
 func GetSourceDeployStageById(ctx context.Context, config *conn.ProviderConfig, projectId *string, id *string) (*vsourcedeploy.GetStageDetailResponse, error) {
-	LogCommonRequest("getSourceDeployStage", id)
+	ctx = LogCommonRequest(ctx, "getSourceDeployStage", id)
 	resp, err := config.Client.Vsourcedeploy.V1Api.GetStage(ctx, projectId, id)
 	if err != nil {
-		LogErrorResponse("getSourceDeployStage", err, *id)
+		LogErrorResponse(ctx, "getSourceDeployStage", err, *id)
 		return nil, err
 	}
-	LogResponse("getSourceDeployStage", resp)
+	LogResponse(ctx, "getSourceDeployStage", resp)
 
 	return resp, nil
 }
@@ -283,13 +289,13 @@ func changeDeployStage(ctx context.Context, d *schema.ResourceData, config *conn
 	projectId := ncloud.IntString(d.Get("project_id").(int))
 	id := ncloud.String(d.Id())
 
-	LogCommonRequest("changeSourceDeployStage", reqParams)
+	ctx = LogCommonRequest(ctx, "changeSourceDeployStage", reqParams)
 	resp, err := config.Client.Vsourcedeploy.V1Api.ChangeStage(ctx, reqParams, projectId, id)
 	if err != nil {
-		LogErrorResponse("changeSourceDeployStage", err, reqParams)
+		LogErrorResponse(ctx, "changeSourceDeployStage", err, reqParams)
 		return err
 	}
-	LogResponse("changeSourceDeployStage", resp)
+	LogResponse(ctx, "changeSourceDeployStage", resp)
 
 	return nil
 }
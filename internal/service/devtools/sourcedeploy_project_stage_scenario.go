@@ -3,6 +3,7 @@ package devtools
 import (
 	"context"
 	"fmt"
+	"log"
 	"regexp"
 	"strconv"
 	"strings"
@@ -430,13 +431,13 @@ func resourceNcloudSourceDeployScenarioCreate(ctx context.Context, d *schema.Res
 		return diag.FromErr(paramsErr)
 	}
 
-	LogCommonRequest("createSourceDeployScenario", reqParams)
+	ctx = LogCommonRequest(ctx, "createSourceDeployScenario", reqParams)
 	scenarioCreateResp, scenarioCreateRespErr := config.Client.Vsourcedeploy.V1Api.CreateScenario(ctx, reqParams, projectId, stageId)
 	if scenarioCreateRespErr != nil {
-		LogErrorResponse("createSourceDeployScenario", scenarioCreateRespErr, reqParams)
+		LogErrorResponse(ctx, "createSourceDeployScenario", scenarioCreateRespErr, reqParams)
 		return diag.FromErr(scenarioCreateRespErr)
 	}
-	LogResponse("createSourceDeployScenario", scenarioCreateResp.Id)
+	LogResponse(ctx, "createSourceDeployScenario", scenarioCreateResp.Id)
 
 	d.SetId(*ncloud.IntString(int(ncloud.Int32Value(scenarioCreateResp.Id))))
 
@@ -453,6 +454,11 @@ func resourceNcloudSourceDeployScenarioRead(ctx context.Context, d *schema.Resou
 	stageId := ncloud.IntString(d.Get("stage_id").(int))
 	scenario, err := GetSourceDeployScenarioById(ctx, config, projectId, stageId, ncloud.String(d.Id()))
 
+	if IsNotFound(err) {
+		log.Printf("[WARN] SourceDeploy scenario %s was not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -827,13 +833,13 @@ func getCanaryConfigAnalysisConfig(d *schema.ResourceData) (*vsourcedeploy.Scena
 }
 
 func GetSourceDeployScenarioById(ctx context.Context, config *conn.ProviderConfig, projectId *string, stageId *string, id *string) (*vsourcedeploy.GetScenarioDetailResponse, error) {
-	LogCommonRequest("getSourceDeployScenario", id)
+	ctx = LogCommonRequest(ctx, "getSourceDeployScenario", id)
 	resp, err := config.Client.Vsourcedeploy.V1Api.GetScenario(ctx, projectId, stageId, id)
 	if err != nil {
-		LogErrorResponse("getSourceDeployScenario", err, *id)
+		LogErrorResponse(ctx, "getSourceDeployScenario", err, *id)
 		return nil, err
 	}
-	LogResponse("getSourceDeployScenario", resp)
+	LogResponse(ctx, "getSourceDeployScenario", resp)
 
 	return resp, nil
 }
@@ -846,13 +852,13 @@ func resourceNcloudSourceDeployScenarioDelete(ctx context.Context, d *schema.Res
 
 	projectId := ncloud.IntString(d.Get("project_id").(int))
 	stageId := ncloud.IntString(d.Get("stage_id").(int))
-	LogCommonRequest("deleteSourceDeployScenario", d.Id())
+	ctx = LogCommonRequest(ctx, "deleteSourceDeployScenario", d.Id())
 	resp, err := config.Client.Vsourcedeploy.V1Api.DeleteScenario(ctx, projectId, stageId, ncloud.String(d.Id()))
 	if err != nil {
-		LogErrorResponse("deleteSourceDeployScenario", err, d.Id())
+		LogErrorResponse(ctx, "deleteSourceDeployScenario", err, d.Id())
 		return diag.FromErr(err)
 	}
-	LogResponse("deleteSourceDeployScenario", resp)
+	LogResponse(ctx, "deleteSourceDeployScenario", resp)
 	d.SetId("")
 	return nil
 }
@@ -881,13 +887,13 @@ func changeDeployScenario(ctx context.Context, d *schema.ResourceData, config *c
 		return paramsErr
 	}
 
-	LogCommonRequest("changeSourceDeployScenario", reqParams)
+	ctx = LogCommonRequest(ctx, "changeSourceDeployScenario", reqParams)
 	resp, err := config.Client.Vsourcedeploy.V1Api.ChangeScenario(ctx, reqParams, projectId, stageId, ncloud.String(d.Id()))
 	if err != nil {
-		LogErrorResponse("changeSourceDeployScenario", err, reqParams)
+		LogErrorResponse(ctx, "changeSourceDeployScenario", err, reqParams)
 		return err
 	}
-	LogResponse("changeSourceDeployScenario", resp)
+	LogResponse(ctx, "changeSourceDeployScenario", resp)
 
 	return nil
 }
@@ -10,7 +10,7 @@ import (
 )
 
 func TestAccDataSourceNcloudSourceDeploySingleScenario(t *testing.T) {
-	stageNameSvr := GetTestSourceDeployScenarioName() + "svr"
+	stageNameSvr := GetTestSourceDeployScenarioName(t) + "svr"
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { TestAccPreCheck(t) },
 		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
@@ -9,7 +9,6 @@ import (
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vsourcedeploy"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 
@@ -26,14 +25,14 @@ const TF_TEST_SD_PROMETHEUS_URL = "http://prometheus-example.com"
 
 func TestAccResourceNcloudSourceDeployScenario_basic(t *testing.T) {
 	var scenario vsourcedeploy.GetScenarioDetailResponse
-	scenarioNameSvrNormal := GetTestSourceDeployScenarioName() + "-server-normal"
-	scenarioNameAsgNoraml := GetTestSourceDeployScenarioName() + "-asg-normal"
-	scenarioNameAsgBg := GetTestSourceDeployScenarioName() + "-asg-bg"
-	scenarioNameNksRolling := GetTestSourceDeployScenarioName() + "-nks-rolling"
-	scenarioNameNksBg := GetTestSourceDeployScenarioName() + "-nks-bg"
-	scenarioNameNksCanaryManual := GetTestSourceDeployScenarioName() + "-nks-canary-manual"
-	scenarioNameNksCanaryAuto := GetTestSourceDeployScenarioName() + "-nks-canary-auto"
-	scenarioNameObjNormal := GetTestSourceDeployScenarioName() + "-obj-normal"
+	scenarioNameSvrNormal := GetTestSourceDeployScenarioName(t) + "-server-normal"
+	scenarioNameAsgNoraml := GetTestSourceDeployScenarioName(t) + "-asg-normal"
+	scenarioNameAsgBg := GetTestSourceDeployScenarioName(t) + "-asg-bg"
+	scenarioNameNksRolling := GetTestSourceDeployScenarioName(t) + "-nks-rolling"
+	scenarioNameNksBg := GetTestSourceDeployScenarioName(t) + "-nks-bg"
+	scenarioNameNksCanaryManual := GetTestSourceDeployScenarioName(t) + "-nks-canary-manual"
+	scenarioNameNksCanaryAuto := GetTestSourceDeployScenarioName(t) + "-nks-canary-auto"
+	scenarioNameObjNormal := GetTestSourceDeployScenarioName(t) + "-obj-normal"
 
 	resourceNameSvrNormal := "ncloud_sourcedeploy_project_stage_scenario.test-scenario-server-normal"
 	resourceNameAsgNormal := "ncloud_sourcedeploy_project_stage_scenario.test-scenario-asg-normal"
@@ -557,8 +556,8 @@ func testAccCheckSourceDeployScenarioDestroy(s *terraform.State) error {
 	return nil
 }
 
-func GetTestSourceDeployScenarioName() string {
-	rInt := acctest.RandIntRange(1, 9999)
+func GetTestSourceDeployScenarioName(t *testing.T) string {
+	rInt := RandIntRange(t, 1, 9999)
 	testScenarioName := fmt.Sprintf("tf-%d-scenario", rInt)
 	return testScenarioName
 }
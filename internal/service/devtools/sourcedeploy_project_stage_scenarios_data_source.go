@@ -58,7 +58,7 @@ func dataSourceNcloudSourceDeployScenariosReadContext(ctx context.Context, d *sc
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	LogResponse("GetScenarios", resp)
+	LogResponse(ctx, "GetScenarios", resp)
 
 	resources := []map[string]interface{}{}
 	for _, r := range resp.ScenarioList {
@@ -82,14 +82,14 @@ func dataSourceNcloudSourceDeployScenariosReadContext(ctx context.Context, d *sc
 func GetScenarios(ctx context.Context, config *conn.ProviderConfig, projectId *string, stageId *string) (*vsourcedeploy.GetScenarioListResponse, error) {
 
 	reqParams := make(map[string]interface{})
-	LogCommonRequest("GetScenarios", reqParams)
+	ctx = LogCommonRequest(ctx, "GetScenarios", reqParams)
 	resp, err := config.Client.Vsourcedeploy.V1Api.GetScenarioes(ctx, projectId, stageId, reqParams)
 
 	if err != nil {
-		LogErrorResponse("GetScenarios", err, "")
+		LogErrorResponse(ctx, "GetScenarios", err, "")
 		return nil, err
 	}
-	LogResponse("GetScenarios", resp)
+	LogResponse(ctx, "GetScenarios", resp)
 
 	return resp, nil
 }
@@ -10,7 +10,7 @@ import (
 )
 
 func TestAccDataSourceNcloudSourceDeployScenarios(t *testing.T) {
-	stageNameSvr := GetTestSourceDeployScenarioName() + "svr"
+	stageNameSvr := GetTestSourceDeployScenarioName(t) + "svr"
 	productCode := "SVR.VSVR.STAND.C002.M008.NET.HDD.B050.G002"
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { TestAccPreCheck(t) },
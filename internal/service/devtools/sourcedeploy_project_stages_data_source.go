@@ -74,14 +74,14 @@ func dataSourceNcloudSourceDeployStagesReadContext(ctx context.Context, d *schem
 func GetStages(ctx context.Context, config *conn.ProviderConfig, projectId *string) (*vsourcedeploy.GetStageListResponse, error) {
 
 	reqParams := make(map[string]interface{})
-	LogCommonRequest("getStages", reqParams)
+	ctx = LogCommonRequest(ctx, "getStages", reqParams)
 	resp, err := config.Client.Vsourcedeploy.V1Api.GetStages(ctx, projectId, reqParams)
 
 	if err != nil {
-		LogErrorResponse("getStages", err, "")
+		LogErrorResponse(ctx, "getStages", err, "")
 		return nil, err
 	}
-	LogResponse("getStages", resp)
+	LogResponse(ctx, "getStages", resp)
 
 	return resp, nil
 }
@@ -7,7 +7,6 @@ import (
 	"testing"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vsourcedeploy"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 
@@ -18,7 +17,7 @@ import (
 
 func TestAccResourceNcloudSourceDeployProject_basic(t *testing.T) {
 	var project vsourcedeploy.GetIdNameResponse
-	name := getTestSourceDeployProjectName()
+	name := getTestSourceDeployProjectName(t)
 	resourceName := "ncloud_sourcedeploy_project.test-project"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -92,8 +91,8 @@ func testAccCheckSourceDeployProjectDestroy(s *terraform.State) error {
 	return nil
 }
 
-func getTestSourceDeployProjectName() string {
-	rInt := acctest.RandIntRange(1, 9999)
+func getTestSourceDeployProjectName(t *testing.T) string {
+	rInt := RandIntRange(t, 1, 9999)
 	testProjectName := fmt.Sprintf("tf-%d-project", rInt)
 	return testProjectName
 }
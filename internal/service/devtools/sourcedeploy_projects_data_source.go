@@ -50,7 +50,7 @@ func dataSourceNcloudSourceDeployProjectsReadContext(ctx context.Context, d *sch
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	LogResponse("GetProjects", resp)
+	LogResponse(ctx, "GetProjects", resp)
 
 	resources := []map[string]interface{}{}
 	for _, r := range resp.ProjectList {
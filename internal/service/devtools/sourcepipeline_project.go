@@ -3,6 +3,7 @@ package devtools
 import (
 	"context"
 	"fmt"
+	"log"
 	"regexp"
 	"strings"
 
@@ -219,7 +220,7 @@ func ResourceNcloudSourcePipeline() *schema.Resource {
 func resourceNcloudSourcePipelineCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*conn.ProviderConfig)
 
-	id, err := createPipelineProject(d, config)
+	id, err := createPipelineProject(ctx, d, config)
 	if err != nil {
 		return err
 	}
@@ -233,6 +234,11 @@ func resourceNcloudSourcePipelineRead(ctx context.Context, d *schema.ResourceDat
 	config := meta.(*conn.ProviderConfig)
 
 	pipelineProject, err := GetPipelineProject(ctx, config, d.Id())
+	if IsNotFound(err) {
+		log.Printf("[WARN] SourcePipeline project %s was not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -279,14 +285,14 @@ func resourceNcloudSourcePipelineDelete(ctx context.Context, d *schema.ResourceD
 	return nil
 }
 
-func createPipelineProject(d *schema.ResourceData, config *conn.ProviderConfig) (*int32, diag.Diagnostics) {
+func createPipelineProject(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) (*int32, diag.Diagnostics) {
 	if config.SupportVPC {
-		return createVpcPipelineProject(d, config)
+		return createVpcPipelineProject(ctx, d, config)
 	}
-	return createClassicPipelineProject(d, config)
+	return createClassicPipelineProject(ctx, d, config)
 }
 
-func createClassicPipelineProject(d *schema.ResourceData, config *conn.ProviderConfig) (*int32, diag.Diagnostics) {
+func createClassicPipelineProject(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) (*int32, diag.Diagnostics) {
 	tasksParams, paramErr := makeClassicPipelineTaskParams(d)
 	if paramErr != nil {
 		return nil, paramErr
@@ -298,18 +304,18 @@ func createClassicPipelineProject(d *schema.ResourceData, config *conn.ProviderC
 		Trigger:     makeClassicPipelineTriggerParams(d),
 	}
 
-	LogCommonRequest("createSourcePipelineProject", reqParams)
+	ctx = LogCommonRequest(ctx, "createSourcePipelineProject", reqParams)
 	resp, err := config.Client.Sourcepipeline.V1Api.CreateProject(context.Background(), reqParams)
 	if err != nil {
-		LogErrorResponse("createSourcePipelineProject", err, reqParams)
+		LogErrorResponse(ctx, "createSourcePipelineProject", err, reqParams)
 		return nil, diag.FromErr(err)
 	}
-	LogResponse("createSourcePipelineProject", resp)
+	LogResponse(ctx, "createSourcePipelineProject", resp)
 
 	return resp.ProjectId, nil
 }
 
-func createVpcPipelineProject(d *schema.ResourceData, config *conn.ProviderConfig) (*int32, diag.Diagnostics) {
+func createVpcPipelineProject(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) (*int32, diag.Diagnostics) {
 	tasksParams, paramErr := makeVpcPipelineTaskParams(d)
 	if paramErr != nil {
 		return nil, paramErr
@@ -321,13 +327,13 @@ func createVpcPipelineProject(d *schema.ResourceData, config *conn.ProviderConfi
 		Trigger:     makeVpcPipelineTriggerParams(d),
 	}
 
-	LogCommonRequest("createSourcePipelineProject", reqParams)
+	ctx = LogCommonRequest(ctx, "createSourcePipelineProject", reqParams)
 	resp, err := config.Client.Vsourcepipeline.V1Api.CreateProject(context.Background(), reqParams)
 	if err != nil {
-		LogErrorResponse("createSourcePipelineProject", err, reqParams)
+		LogErrorResponse(ctx, "createSourcePipelineProject", err, reqParams)
 		return nil, diag.FromErr(err)
 	}
-	LogResponse("createSourcePipelineProject", resp)
+	LogResponse(ctx, "createSourcePipelineProject", resp)
 
 	return resp.ProjectId, nil
 }
@@ -340,25 +346,25 @@ func GetPipelineProject(ctx context.Context, config *conn.ProviderConfig, id str
 }
 
 func getClassicPipelineProject(ctx context.Context, config *conn.ProviderConfig, projectId string) (*PipelineProject, error) {
-	LogCommonRequest("getSourcePipelineProject", projectId)
+	ctx = LogCommonRequest(ctx, "getSourcePipelineProject", projectId)
 	resp, err := config.Client.Sourcepipeline.V1Api.GetProject(ctx, &projectId)
 	if err != nil {
-		LogErrorResponse("getSourcePipelineProject", err, projectId)
+		LogErrorResponse(ctx, "getSourcePipelineProject", err, projectId)
 		return nil, err
 	}
-	LogResponse("getSourcePipelineProject", resp)
+	LogResponse(ctx, "getSourcePipelineProject", resp)
 
 	return convertClassicPipelineProject(resp), nil
 }
 
 func getVpcPipelineProject(ctx context.Context, config *conn.ProviderConfig, projectId string) (*PipelineProject, error) {
-	LogCommonRequest("getSourcePipelineProject", projectId)
+	ctx = LogCommonRequest(ctx, "getSourcePipelineProject", projectId)
 	resp, err := config.Client.Vsourcepipeline.V1Api.GetProject(ctx, &projectId)
 	if err != nil {
-		LogErrorResponse("getSourcePipelineProject", err, projectId)
+		LogErrorResponse(ctx, "getSourcePipelineProject", err, projectId)
 		return nil, err
 	}
-	LogResponse("getSourcePipelineProject", resp)
+	LogResponse(ctx, "getSourcePipelineProject", resp)
 
 	return convertVpcPipelineProject(resp), nil
 }
@@ -385,13 +391,13 @@ func updateClassicPipelineProject(ctx context.Context, d *schema.ResourceData, c
 		Trigger:     makeClassicPipelineTriggerParams(d),
 	}
 
-	LogCommonRequest("setSourcePipelineProject", reqParams)
+	ctx = LogCommonRequest(ctx, "setSourcePipelineProject", reqParams)
 	resp, err := config.Client.Sourcepipeline.V1Api.ChangeProject(ctx, reqParams, &projectId)
 	if err != nil {
-		LogErrorResponse("setSourcePipelineProject", err, projectId)
+		LogErrorResponse(ctx, "setSourcePipelineProject", err, projectId)
 		return diag.FromErr(err)
 	}
-	LogResponse("setSourcePipelineProject", resp)
+	LogResponse(ctx, "setSourcePipelineProject", resp)
 
 	return nil
 }
@@ -411,13 +417,13 @@ func updateVpcPipelineProject(ctx context.Context, d *schema.ResourceData, confi
 		Trigger:     makeVpcPipelineTriggerParams(d),
 	}
 
-	LogCommonRequest("setSourcePipelineProject", reqParams)
+	ctx = LogCommonRequest(ctx, "setSourcePipelineProject", reqParams)
 	resp, err := config.Client.Vsourcepipeline.V1Api.ChangeProject(ctx, reqParams, &projectId)
 	if err != nil {
-		LogErrorResponse("setSourcePipelineProject", err, projectId)
+		LogErrorResponse(ctx, "setSourcePipelineProject", err, projectId)
 		return diag.FromErr(err)
 	}
-	LogResponse("setSourcePipelineProject", resp)
+	LogResponse(ctx, "setSourcePipelineProject", resp)
 
 	return nil
 }
@@ -432,20 +438,20 @@ func deletePipelineProject(ctx context.Context, config *conn.ProviderConfig, id
 func deleteClassicPipelineProject(ctx context.Context, config *conn.ProviderConfig, projectId string) error {
 	resp, err := config.Client.Sourcepipeline.V1Api.DeleteProject(ctx, &projectId)
 	if err != nil {
-		LogErrorResponse("deleteSourcePipelineProject", err, projectId)
+		LogErrorResponse(ctx, "deleteSourcePipelineProject", err, projectId)
 		return err
 	}
-	LogResponse("deleteSourcePipelineProject", resp)
+	LogResponse(ctx, "deleteSourcePipelineProject", resp)
 	return nil
 }
 
 func deleteVpcPipelineProject(ctx context.Context, config *conn.ProviderConfig, projectId string) error {
 	resp, err := config.Client.Vsourcepipeline.V1Api.DeleteProject(ctx, &projectId)
 	if err != nil {
-		LogErrorResponse("deleteSourcePipelineProject", err, projectId)
+		LogErrorResponse(ctx, "deleteSourcePipelineProject", err, projectId)
 		return err
 	}
-	LogResponse("deleteSourcePipelineProject", resp)
+	LogResponse(ctx, "deleteSourcePipelineProject", resp)
 	return nil
 }
 
@@ -7,7 +7,6 @@ import (
 	"testing"
 	"time"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -19,7 +18,7 @@ import (
 
 func TestAccResourceNcloudSourcePipelineProject_classic_basic(t *testing.T) {
 	var project devtools.PipelineProject
-	name := fmt.Sprintf("test-pipeline-basic-%s", acctest.RandString(5))
+	name := fmt.Sprintf("test-pipeline-basic-%s", RandString(t, 5))
 	resourceName := "ncloud_sourcepipeline_project.foo"
 
 	resource.Test(t, resource.TestCase{
@@ -47,7 +46,7 @@ func TestAccResourceNcloudSourcePipelineProject_classic_basic(t *testing.T) {
 
 func TestAccResourceNcloudSourcePipelineProject_classic_updateTaskName(t *testing.T) {
 	var project devtools.PipelineProject
-	name := fmt.Sprintf("test-pipeline-name-%s", acctest.RandString(5))
+	name := fmt.Sprintf("test-pipeline-name-%s", RandString(t, 5))
 	resourceName := "ncloud_sourcepipeline_project.foo"
 
 	resource.Test(t, resource.TestCase{
@@ -84,7 +83,7 @@ func TestAccResourceNcloudSourcePipelineProject_classic_updateTaskName(t *testin
 
 func TestAccResourceNcloudSourcePipelineProject_classic_updateDescription(t *testing.T) {
 	var project devtools.PipelineProject
-	name := fmt.Sprintf("test-pipeline-name-%s", acctest.RandString(5))
+	name := fmt.Sprintf("test-pipeline-name-%s", RandString(t, 5))
 	resourceName := "ncloud_sourcepipeline_project.foo"
 
 	resource.Test(t, resource.TestCase{
@@ -121,7 +120,7 @@ func TestAccResourceNcloudSourcePipelineProject_classic_updateDescription(t *tes
 
 func TestAccResourceNcloudSourcePipelineProject_vpc_basic(t *testing.T) {
 	var project devtools.PipelineProject
-	name := fmt.Sprintf("test-pipeline-basic-%s", acctest.RandString(5))
+	name := fmt.Sprintf("test-pipeline-basic-%s", RandString(t, 5))
 	resourceName := "ncloud_sourcepipeline_project.foo"
 
 	resource.Test(t, resource.TestCase{
@@ -149,7 +148,7 @@ func TestAccResourceNcloudSourcePipelineProject_vpc_basic(t *testing.T) {
 
 func TestAccResourceNcloudSourcePipelineProject_vpc_updateTaskName(t *testing.T) {
 	var project devtools.PipelineProject
-	name := fmt.Sprintf("test-pipeline-name-%s", acctest.RandString(5))
+	name := fmt.Sprintf("test-pipeline-name-%s", RandString(t, 5))
 	resourceName := "ncloud_sourcepipeline_project.foo"
 
 	resource.Test(t, resource.TestCase{
@@ -186,7 +185,7 @@ func TestAccResourceNcloudSourcePipelineProject_vpc_updateTaskName(t *testing.T)
 
 func TestAccResourceNcloudSourcePipelineProject_vpc_updateDescription(t *testing.T) {
 	var project devtools.PipelineProject
-	name := fmt.Sprintf("test-pipeline-name-%s", acctest.RandString(5))
+	name := fmt.Sprintf("test-pipeline-name-%s", RandString(t, 5))
 	resourceName := "ncloud_sourcepipeline_project.foo"
 
 	resource.Test(t, resource.TestCase{
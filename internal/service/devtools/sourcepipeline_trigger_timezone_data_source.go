@@ -35,10 +35,10 @@ func dataSourceNcloudSourcePipelineTimeZoneRead(ctx context.Context, d *schema.R
 
 	timeZone, err := getSourcePipelineTimeZone(ctx, config)
 	if err != nil {
-		LogErrorResponse("getSourcePipelineTimeZone", err, timeZone)
+		LogErrorResponse(ctx, "getSourcePipelineTimeZone", err, timeZone)
 		return diag.FromErr(err)
 	}
-	LogResponse("getSourcePipelineTimeZone", timeZone)
+	LogResponse(ctx, "getSourcePipelineTimeZone", timeZone)
 
 	if timeZone == nil {
 		d.SetId("")
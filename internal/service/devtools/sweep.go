@@ -0,0 +1,159 @@
+package devtools
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/sweep"
+)
+
+// RegisterSweepers registers the sweepers of the developer tools projects.
+// Stages and scenarios of SourceDeploy are deleted with their project.
+func RegisterSweepers() {
+	sweep.AddTestSweepers("ncloud_sourcecommit_repository", &resource.Sweeper{
+		Name: "ncloud_sourcecommit_repository",
+		F:    sweepSourceCommitRepositories,
+		Dependencies: []string{
+			"ncloud_sourcebuild_project",
+			"ncloud_sourcedeploy_project",
+			"ncloud_sourcepipeline_project",
+		},
+	})
+
+	sweep.AddTestSweepers("ncloud_sourcebuild_project", &resource.Sweeper{
+		Name: "ncloud_sourcebuild_project",
+		F:    sweepSourceBuildProjects,
+		Dependencies: []string{
+			"ncloud_sourcepipeline_project",
+		},
+	})
+
+	sweep.AddTestSweepers("ncloud_sourcedeploy_project", &resource.Sweeper{
+		Name: "ncloud_sourcedeploy_project",
+		F:    sweepSourceDeployProjects,
+		Dependencies: []string{
+			"ncloud_sourcepipeline_project",
+		},
+	})
+
+	sweep.AddTestSweepers("ncloud_sourcepipeline_project", &resource.Sweeper{
+		Name: "ncloud_sourcepipeline_project",
+		F:    sweepSourcePipelineProjects,
+	})
+
+	sweep.AddChildTestSweepers("ncloud_sourcedeploy_project",
+		"ncloud_sourcedeploy_project_stage",
+		"ncloud_sourcedeploy_project_stage_scenario",
+	)
+}
+
+func sweepSourceCommitRepositories(region string) error {
+	config, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+
+	ctx := context.Background()
+	resp, err := GetRepositories(ctx, config)
+	if err != nil {
+		if sweep.SkipSweepError(err) {
+			return nil
+		}
+		return fmt.Errorf("listing SourceCommit repositories: %w", err)
+	}
+
+	var sweepables []sweep.Sweepable
+	for _, r := range resp.Repository {
+		if !sweep.HasResourcePrefix(r.Name) {
+			continue
+		}
+		sweepables = append(sweepables, sweep.NewSweepResource(ResourceNcloudSourceCommitRepository(), config, strconv.Itoa(*r.Id), nil))
+	}
+
+	return sweep.SweepOrchestrator(ctx, sweepables)
+}
+
+func sweepSourceBuildProjects(region string) error {
+	config, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+
+	ctx := context.Background()
+	resp, err := config.Client.Sourcebuild.V1Api.GetProjects(ctx, map[string]interface{}{})
+	if err != nil {
+		if sweep.SkipSweepError(err) {
+			return nil
+		}
+		return fmt.Errorf("listing SourceBuild projects: %w", err)
+	}
+
+	var sweepables []sweep.Sweepable
+	for _, p := range resp.Project {
+		if !sweep.HasResourcePrefix(p.Name) {
+			continue
+		}
+		sweepables = append(sweepables, sweep.NewSweepResource(ResourceNcloudSourceBuildProject(), config, *ncloud.Int32String(*p.Id), nil))
+	}
+
+	return sweep.SweepOrchestrator(ctx, sweepables)
+}
+
+func sweepSourceDeployProjects(region string) error {
+	config, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+	if !config.SupportVPC {
+		return nil
+	}
+
+	ctx := context.Background()
+	resp, err := config.Client.Vsourcedeploy.V1Api.GetProjects(ctx, map[string]interface{}{})
+	if err != nil {
+		if sweep.SkipSweepError(err) {
+			return nil
+		}
+		return fmt.Errorf("listing SourceDeploy projects: %w", err)
+	}
+
+	var sweepables []sweep.Sweepable
+	for _, p := range resp.ProjectList {
+		if !sweep.HasResourcePrefix(p.Name) {
+			continue
+		}
+		sweepables = append(sweepables, sweep.NewSweepResource(ResourceNcloudSourceDeployProject(), config, *ncloud.Int32String(*p.Id), nil))
+	}
+
+	return sweep.SweepOrchestrator(ctx, sweepables)
+}
+
+func sweepSourcePipelineProjects(region string) error {
+	config, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+
+	ctx := context.Background()
+	projects, err := getSourcePipelineProjects(ctx, config)
+	if err != nil {
+		if sweep.SkipSweepError(err) {
+			return nil
+		}
+		return fmt.Errorf("listing SourcePipeline projects: %w", err)
+	}
+
+	var sweepables []sweep.Sweepable
+	for _, p := range projects {
+		if !sweep.HasResourcePrefix(p.Name) {
+			continue
+		}
+		sweepables = append(sweepables, sweep.NewSweepResource(ResourceNcloudSourcePipeline(), config, *ncloud.Int32String(*p.Id), nil))
+	}
+
+	return sweep.SweepOrchestrator(ctx, sweepables)
+}
@@ -27,12 +27,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/framework"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/waiter"
 )
 
 var (
@@ -556,7 +555,7 @@ func (r *hadoopResource) Create(ctx context.Context, req resource.CreateRequest,
 		resp.Diagnostics.AddError("CREATING ERROR", err.Error())
 		return
 	}
-	tflog.Info(ctx, "CreateHadoop response="+common.MarshalUncheckedString(response))
+	common.LogResponse(ctx, "CreateHadoop", response)
 
 	if response == nil || len(response.CloudHadoopInstanceList) < 1 {
 		resp.Diagnostics.AddError("CREATING ERROR", "response invalid")
@@ -620,14 +619,14 @@ func (r *hadoopResource) Update(ctx context.Context, req resource.UpdateRequest,
 			CloudHadoopInstanceNo: state.ID.ValueStringPointer(),
 			WorkerNodeCount:       ncloud.Int32(int32(plan.WorkerNodeCount.ValueInt64())),
 		}
-		tflog.Info(ctx, "ChangeHadoopWorkerNodeCount reqParams="+common.MarshalUncheckedString(reqParams))
+		ctx = common.LogCommonRequest(ctx, "ChangeHadoopWorkerNodeCount", reqParams)
 
 		response, err := r.config.Client.Vhadoop.V2Api.ChangeCloudHadoopNodeCount(reqParams)
 		if err != nil {
 			resp.Diagnostics.AddError("UPDATE ERROR", err.Error())
 			return
 		}
-		tflog.Info(ctx, "ChangeHadoopWorkerNodeCount response="+common.MarshalUncheckedString(response))
+		common.LogResponse(ctx, "ChangeHadoopWorkerNodeCount", response)
 
 		if response == nil || len(response.CloudHadoopInstanceList) < 1 {
 			resp.Diagnostics.AddError("UPDATE ERROR", "response invalid")
@@ -662,14 +661,14 @@ func (r *hadoopResource) Update(ctx context.Context, req resource.UpdateRequest,
 		if !plan.WorkerNodeProductCode.Equal(state.WorkerNodeProductCode) {
 			reqParams.WorkerNodeProductCode = plan.WorkerNodeProductCode.ValueStringPointer()
 		}
-		tflog.Info(ctx, "ChangeHadoopNodeSpec reqParams="+common.MarshalUncheckedString(reqParams))
+		ctx = common.LogCommonRequest(ctx, "ChangeHadoopNodeSpec", reqParams)
 
 		response, err := r.config.Client.Vhadoop.V2Api.ChangeCloudHadoopNodeSpec(reqParams)
 		if err != nil {
 			resp.Diagnostics.AddError("UPDATE ERROR", err.Error())
 			return
 		}
-		tflog.Info(ctx, "ChangeHadoopNodeSpec response="+common.MarshalUncheckedString(response))
+		common.LogResponse(ctx, "ChangeHadoopNodeSpec", response)
 
 		if response == nil || len(response.CloudHadoopInstanceList) < 1 {
 			resp.Diagnostics.AddError("UPDATE ERROR", "response invalid")
@@ -700,14 +699,14 @@ func (r *hadoopResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		RegionCode:            &r.config.RegionCode,
 		CloudHadoopInstanceNo: state.ID.ValueStringPointer(),
 	}
-	tflog.Info(ctx, "DeleteHadoop reqParams="+common.MarshalUncheckedString(reqParams))
+	ctx = common.LogCommonRequest(ctx, "DeleteHadoop", reqParams)
 
 	response, err := r.config.Client.Vhadoop.V2Api.DeleteCloudHadoopInstance(reqParams)
 	if err != nil {
 		resp.Diagnostics.AddError("DELETING ERROR", err.Error())
 		return
 	}
-	tflog.Info(ctx, "DeleteHadoop response="+common.MarshalUncheckedString(response))
+	common.LogResponse(ctx, "DeleteHadoop", response)
 
 	if err := waitHadoopDeletion(ctx, r.config, state.ID.ValueString()); err != nil {
 		resp.Diagnostics.AddError("WAITING FOR DELETE ERROR", err.Error())
@@ -723,14 +722,14 @@ func GetHadoopInstance(ctx context.Context, config *conn.ProviderConfig, id stri
 		RegionCode:            &config.RegionCode,
 		CloudHadoopInstanceNo: ncloud.String(id),
 	}
-	tflog.Info(ctx, "GetHadoopDetail reqParams="+common.MarshalUncheckedString(reqParams))
+	ctx = common.LogCommonRequest(ctx, "GetHadoopDetail", reqParams)
 
 	resp, err := config.Client.Vhadoop.V2Api.GetCloudHadoopInstanceDetail(reqParams)
 	// If the lookup result is 0 or already deleted, it will respond with a 400 error with a 5001017 return code.
-	if err != nil && !(strings.Contains(err.Error(), `"returnCode": "5001017"`)) {
+	if err != nil && !common.IsNotFound(err) {
 		return nil, err
 	}
-	tflog.Info(ctx, "GetHadoopDetail response="+common.MarshalUncheckedString(resp))
+	common.LogResponse(ctx, "GetHadoopDetail", resp)
 
 	if resp == nil || len(resp.CloudHadoopInstanceList) < 1 || len(resp.CloudHadoopInstanceList[0].CloudHadoopServerInstanceList) < 1 {
 		return nil, nil
@@ -742,10 +741,10 @@ func GetHadoopInstance(ctx context.Context, config *conn.ProviderConfig, id stri
 func waitHadoopCreation(ctx context.Context, config *conn.ProviderConfig, id string) (*vhadoop.CloudHadoopInstance, error) {
 	var hadoopInstance *vhadoop.CloudHadoopInstance
 	var err error
-	stateConf := &retry.StateChangeConf{
+	stateConf := &waiter.Config[any]{
 		Pending: []string{"CREAT"},
 		Target:  []string{"RUN"},
-		Refresh: func() (interface{}, string, error) {
+		Refresh: func(ctx context.Context) (any, string, error) {
 			if hadoopInstance, err = GetHadoopInstance(ctx, config, id); err != nil {
 				return 0, "", err
 			}
@@ -764,11 +763,11 @@ func waitHadoopCreation(ctx context.Context, config *conn.ProviderConfig, id str
 			}
 			return 0, "", fmt.Errorf("error occurred while waiting to create")
 		},
-		Timeout:    90 * time.Minute,
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Timeout:     90 * time.Minute,
+		Delay:       2 * time.Second,
+		MinInterval: 3 * time.Second,
 	}
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForState(ctx); err != nil {
 		return nil, err
 	}
 
@@ -779,10 +778,10 @@ func waitHadoopUpdate(ctx context.Context, config *conn.ProviderConfig, id strin
 	var hadoopInstance *vhadoop.CloudHadoopInstance
 	var err error
 
-	stateConf := &retry.StateChangeConf{
+	stateConf := &waiter.Config[any]{
 		Pending: []string{"SET", "UPGD"},
 		Target:  []string{"RUN"},
-		Refresh: func() (interface{}, string, error) {
+		Refresh: func(ctx context.Context) (any, string, error) {
 			hadoopInstance, err = GetHadoopInstance(ctx, config, id)
 			if err != nil {
 				return 0, "", err
@@ -802,11 +801,11 @@ func waitHadoopUpdate(ctx context.Context, config *conn.ProviderConfig, id strin
 
 			return 0, "", fmt.Errorf("")
 		},
-		Timeout:    6 * conn.DefaultUpdateTimeout,
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Timeout:     6 * conn.DefaultUpdateTimeout,
+		Delay:       2 * time.Second,
+		MinInterval: 3 * time.Second,
 	}
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForState(ctx); err != nil {
 		return nil, err
 	}
 
@@ -814,10 +813,10 @@ func waitHadoopUpdate(ctx context.Context, config *conn.ProviderConfig, id strin
 }
 
 func waitHadoopDeletion(ctx context.Context, config *conn.ProviderConfig, id string) error {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &waiter.Config[any]{
 		Pending: []string{"PEND"},
 		Target:  []string{"DEL"},
-		Refresh: func() (interface{}, string, error) {
+		Refresh: func(ctx context.Context) (any, string, error) {
 			hadoopInstance, err := GetHadoopInstance(ctx, config, id)
 			if err != nil {
 				return 0, "", err
@@ -836,12 +835,12 @@ func waitHadoopDeletion(ctx context.Context, config *conn.ProviderConfig, id str
 
 			return 0, "", fmt.Errorf("error occurred while waiting to delete")
 		},
-		Timeout:    6 * conn.DefaultTimeout,
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Timeout:     6 * conn.DefaultTimeout,
+		Delay:       2 * time.Second,
+		MinInterval: 3 * time.Second,
 	}
 
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForState(ctx); err != nil {
 		return err
 	}
 
@@ -8,7 +8,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
 )
@@ -92,14 +91,14 @@ func (h *hadoopAddOnDataSource) Read(ctx context.Context, req datasource.ReadReq
 		CloudHadoopImageProductCode: data.ImageProductCode.ValueStringPointer(),
 		CloudHadoopClusterTypeCode:  data.ClusterTypeCode.ValueStringPointer(),
 	}
-	tflog.Info(ctx, "GetHadoopAddOnList reqParams="+common.MarshalUncheckedString(reqParams))
+	ctx = common.LogCommonRequest(ctx, "GetHadoopAddOnList", reqParams)
 
 	addOnResp, err := h.config.Client.Vhadoop.V2Api.GetCloudHadoopAddOnList(reqParams)
 	if err != nil {
 		resp.Diagnostics.AddError("READING ERROR", err.Error())
 		return
 	}
-	tflog.Info(ctx, "GetHadoopAddOnList response="+common.MarshalUncheckedString(addOnResp))
+	common.LogResponse(ctx, "GetHadoopAddOnList", addOnResp)
 
 	if addOnResp == nil || len(addOnResp.CloudHadoopAddOnList) < 1 {
 		resp.Diagnostics.AddError("READING ERROR", "no result.")
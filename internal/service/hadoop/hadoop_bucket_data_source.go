@@ -8,7 +8,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
 )
@@ -84,14 +83,14 @@ func (h *hadoopBucketDataSource) Read(ctx context.Context, req datasource.ReadRe
 	reqParams := &vhadoop.GetCloudHadoopBucketListRequest{
 		RegionCode: &h.config.RegionCode,
 	}
-	tflog.Info(ctx, "GetHadoopBucketList reqParams="+common.MarshalUncheckedString(reqParams))
+	ctx = common.LogCommonRequest(ctx, "GetHadoopBucketList", reqParams)
 
 	BucketResp, err := h.config.Client.Vhadoop.V2Api.GetCloudHadoopBucketList(reqParams)
 	if err != nil {
 		resp.Diagnostics.AddError("READING ERROR", err.Error())
 		return
 	}
-	tflog.Info(ctx, "GetHadoopBucketList response="+common.MarshalUncheckedString(BucketResp))
+	common.LogResponse(ctx, "GetHadoopBucketList", BucketResp)
 
 	data.refreshFromOutput(ctx, BucketResp)
 
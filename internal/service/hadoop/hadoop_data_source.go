@@ -11,7 +11,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
 )
@@ -228,14 +227,14 @@ func (d *hadoopDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 			RegionCode:             &d.config.RegionCode,
 			CloudHadoopClusterName: data.ClusterName.ValueStringPointer(),
 		}
-		tflog.Info(ctx, "GetHadoopList reqParams="+common.MarshalUncheckedString(reqParams))
+		ctx = common.LogCommonRequest(ctx, "GetHadoopList", reqParams)
 
 		listResp, err := d.config.Client.Vhadoop.V2Api.GetCloudHadoopInstanceList(reqParams)
 		if err != nil {
 			resp.Diagnostics.AddError("READING ERROR", err.Error())
 			return
 		}
-		tflog.Info(ctx, "GetHadoopList response="+common.MarshalUncheckedString(listResp))
+		common.LogResponse(ctx, "GetHadoopList", listResp)
 
 		if listResp == nil || len(listResp.CloudHadoopInstanceList) < 1 {
 			resp.Diagnostics.AddError("READING ERROR", "no result. please change search criteria and try again.")
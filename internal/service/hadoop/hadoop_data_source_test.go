@@ -3,7 +3,6 @@ package hadoop_test
 import (
 	"fmt"
 
-	randacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/acctest"
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/acctest"
@@ -14,7 +13,7 @@ import (
 func TestAccDataSourceNcloudHadoop_vpc_basic(t *testing.T) {
 	dataName := "data.ncloud_hadoop.hadoop"
 	resourceName := "ncloud_hadoop.hadoop"
-	instanceName := fmt.Sprintf("tf-hadoop-%s", randacctest.RandString(3))
+	instanceName := fmt.Sprintf("tf-hadoop-%s", acctest.RandString(t, 3))
 	bucketName := "hadoop.bucket"
 
 	resource.Test(t, resource.TestCase{
@@ -9,7 +9,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
 )
@@ -112,14 +111,20 @@ func (h *hadoopImageProductsDataSource) Read(ctx context.Context, req datasource
 	reqParams := &vhadoop.GetCloudHadoopImageProductListRequest{
 		RegionCode: &h.config.RegionCode,
 	}
-	tflog.Info(ctx, "GetHadoopImageProductList reqParams="+common.MarshalUncheckedString(reqParams))
-
-	imageProductResp, err := h.config.Client.Vhadoop.V2Api.GetCloudHadoopImageProductList(reqParams)
+	imageProductResp, err := conn.CachedLookup(h.config, conn.CatalogKey("vhadoop.GetCloudHadoopImageProductList", reqParams), func() (*vhadoop.GetCloudHadoopImageProductListResponse, error) {
+		ctx := common.LogCommonRequest(ctx, "GetHadoopImageProductList", reqParams)
+		output, err := h.config.Client.Vhadoop.V2Api.GetCloudHadoopImageProductList(reqParams)
+		if err != nil {
+			common.LogErrorResponse(ctx, "GetHadoopImageProductList", err, reqParams)
+			return nil, err
+		}
+		common.LogResponse(ctx, "GetHadoopimageProductList", output)
+		return output, nil
+	})
 	if err != nil {
 		resp.Diagnostics.AddError("READING ERROR", err.Error())
 		return
 	}
-	tflog.Info(ctx, "GetHadoopimageProductList response="+common.MarshalUncheckedString(imageProductResp))
 
 	if imageProductResp == nil || len(imageProductResp.ProductList) < 1 {
 		resp.Diagnostics.AddError("READING ERROR", "no result.")
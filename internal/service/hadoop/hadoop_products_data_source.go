@@ -11,7 +11,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
 )
@@ -131,16 +130,21 @@ func (h *hadoopProductsDataSource) Read(ctx context.Context, req datasource.Read
 		reqParams.InfraResourceDetailTypeCode = data.InfraResourceDetailTypeCode.ValueStringPointer()
 	}
 
-	tflog.Info(ctx, "GetHadoopProductsList reqParams="+common.MarshalUncheckedString(reqParams))
-
-	hadoopProductsResp, err := h.config.Client.Vhadoop.V2Api.GetCloudHadoopProductList(reqParams)
+	hadoopProductsResp, err := conn.CachedLookup(h.config, conn.CatalogKey("vhadoop.GetCloudHadoopProductList", reqParams), func() (*vhadoop.GetCloudHadoopProductListResponse, error) {
+		ctx := common.LogCommonRequest(ctx, "GetHadoopProductsList", reqParams)
+		output, err := h.config.Client.Vhadoop.V2Api.GetCloudHadoopProductList(reqParams)
+		if err != nil {
+			common.LogErrorResponse(ctx, "GetHadoopProductsList", err, reqParams)
+			return nil, err
+		}
+		common.LogResponse(ctx, "GetHadoopProductsList", output)
+		return output, nil
+	})
 	if err != nil {
 		resp.Diagnostics.AddError("READING ERROR", err.Error())
 		return
 	}
 
-	tflog.Info(ctx, "GetHadoopProductsList response="+common.MarshalUncheckedString(hadoopProductsResp))
-
 	if hadoopProductsResp == nil || len(hadoopProductsResp.ProductList) < 1 {
 		resp.Diagnostics.AddError("READING ERROR", "no result.")
 		return
@@ -9,7 +9,6 @@ import (
 	"testing"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vhadoop"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -20,7 +19,7 @@ import (
 
 func TestAccResourceNcloudHadoop_vpc_update(t *testing.T) {
 	var hadoopInstance vhadoop.CloudHadoopInstance
-	testHadoopName := fmt.Sprintf("tf-hadoop-%s", acctest.RandString(3))
+	testHadoopName := fmt.Sprintf("tf-hadoop-%s", RandString(t, 3))
 	resourceName := "ncloud_hadoop.hadoop"
 	bucketName := "hadoop.bucket"
 
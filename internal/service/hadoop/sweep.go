@@ -0,0 +1,47 @@
+package hadoop
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vhadoop"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/sweep"
+)
+
+// RegisterSweepers registers the sweeper of Hadoop clusters.
+func RegisterSweepers() {
+	sweep.AddTestSweepers("ncloud_hadoop", &resource.Sweeper{
+		Name: "ncloud_hadoop",
+		F:    sweepHadoops,
+	})
+}
+
+func sweepHadoops(region string) error {
+	config, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+	if !config.SupportVPC {
+		return nil
+	}
+
+	resp, err := config.Client.Vhadoop.V2Api.GetCloudHadoopInstanceList(&vhadoop.GetCloudHadoopInstanceListRequest{RegionCode: &config.RegionCode})
+	if err != nil {
+		if sweep.SkipSweepError(err) {
+			return nil
+		}
+		return fmt.Errorf("listing Hadoop clusters: %w", err)
+	}
+
+	var sweepables []sweep.Sweepable
+	for _, i := range resp.CloudHadoopInstanceList {
+		if !sweep.HasResourcePrefix(i.CloudHadoopClusterName) {
+			continue
+		}
+		sweepables = append(sweepables, sweep.NewFrameworkSweepResource(NewHadoopResource, config, *i.CloudHadoopInstanceNo, nil))
+	}
+
+	return sweep.SweepOrchestrator(context.Background(), sweepables)
+}
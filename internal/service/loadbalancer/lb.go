@@ -19,8 +19,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
@@ -29,6 +27,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/framework"
 	vpcservice "github.com/terraform-providers/terraform-provider-ncloud/internal/service/vpc"
 	verify "github.com/terraform-providers/terraform-provider-ncloud/internal/verify/int32"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/waiter"
 )
 
 var (
@@ -240,7 +239,7 @@ func (r *lbResource) Create(ctx context.Context, req resource.CreateRequest, res
 	vpcNoMap := make(map[string]int)
 	subnetList := make([]*vpc.Subnet, 0)
 	for _, subnetNo := range reqParams.SubnetNoList {
-		subnet, err := vpcservice.GetSubnetInstance(r.config, *subnetNo)
+		subnet, err := vpcservice.GetCachedSubnetInstance(ctx, r.config, *subnetNo)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error retrieving subnet instance",
@@ -269,17 +268,17 @@ func (r *lbResource) Create(ctx context.Context, req resource.CreateRequest, res
 
 	reqParams.VpcNo = subnetList[0].VpcNo
 
-	tflog.Info(ctx, "CreateLoadBalancerInstance reqParams="+common.MarshalUncheckedString(reqParams))
+	ctx = common.LogCommonRequest(ctx, "CreateLoadBalancerInstance", reqParams)
 	createResp, err := r.config.Client.Vloadbalancer.V2Api.CreateLoadBalancerInstance(reqParams)
 	if err != nil {
-		LogErrorResponse("createLoadBalancerInstance", err, reqParams)
+		LogErrorResponse(ctx, "createLoadBalancerInstance", err, reqParams)
 		resp.Diagnostics.AddError(
 			"Error creating load balancer instance",
 			err.Error(),
 		)
 		return
 	}
-	tflog.Info(ctx, "CreateLoadBalancerInstance response="+common.MarshalUncheckedString(createResp))
+	common.LogResponse(ctx, "CreateLoadBalancerInstance", createResp)
 
 	if err := waitForLoadBalancerActive(ctx, r.config, ncloud.StringValue(createResp.LoadBalancerInstanceList[0].LoadBalancerInstanceNo)); err != nil {
 		resp.Diagnostics.AddError(
@@ -457,7 +456,7 @@ func (r *lbResource) Delete(ctx context.Context, req resource.DeleteRequest, res
 		LoadBalancerInstanceNoList: []*string{ncloud.String(state.LoadBalancerNo.ValueString())},
 	}
 
-	tflog.Info(ctx, "DeleteLoadBalancer reqParams="+MarshalUncheckedString(reqParams))
+	ctx = LogCommonRequest(ctx, "DeleteLoadBalancer", reqParams)
 
 	if err := waitForLoadBalancerActive(ctx, r.config, state.LoadBalancerNo.ValueString()); err != nil {
 		resp.Diagnostics.AddError("WAIT FOR LOADBALANCER ERROR", err.Error())
@@ -469,7 +468,7 @@ func (r *lbResource) Delete(ctx context.Context, req resource.DeleteRequest, res
 		resp.Diagnostics.AddError("DELETING ERROR", err.Error())
 		return
 	}
-	tflog.Info(ctx, "DeleteLoadBalancer response="+MarshalUncheckedString(response))
+	LogResponse(ctx, "DeleteLoadBalancer", response)
 
 	if err := waitForLoadBalancerDeletion(ctx, r.config, state.LoadBalancerNo.ValueString()); err != nil {
 		resp.Diagnostics.AddError("WAITING FOR DELETE ERROR", err.Error())
@@ -482,14 +481,14 @@ func GetFwVpcLoadBalancer(ctx context.Context, config *conn.ProviderConfig, id s
 		RegionCode:             &config.RegionCode,
 		LoadBalancerInstanceNo: ncloud.String(id),
 	}
-	tflog.Info(ctx, "GetLoadBalancerInstanceDetail reqParams="+MarshalUncheckedString(reqParams))
+	ctx = LogCommonRequest(ctx, "GetLoadBalancerInstanceDetail", reqParams)
 
 	resp, err := config.Client.Vloadbalancer.V2Api.GetLoadBalancerInstanceDetail(reqParams)
 	if err != nil {
-		LogErrorResponse("getLoadBalancerInstanceDetail", err, reqParams)
+		LogErrorResponse(ctx, "getLoadBalancerInstanceDetail", err, reqParams)
 		return nil, err
 	}
-	tflog.Info(ctx, "GetLoadBalancerInstanceDetail response="+MarshalUncheckedString(resp))
+	LogResponse(ctx, "GetLoadBalancerInstanceDetail", resp)
 
 	if len(resp.LoadBalancerInstanceList) < 1 {
 		return nil, nil
@@ -499,10 +498,10 @@ func GetFwVpcLoadBalancer(ctx context.Context, config *conn.ProviderConfig, id s
 }
 
 func waitForLoadBalancerActive(ctx context.Context, config *conn.ProviderConfig, id string) error {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &waiter.Config[any]{
 		Pending: []string{LoadBalancerInstanceOperationCreateCode, LoadBalancerInstanceOperationChangeCode},
 		Target:  []string{LoadBalancerInstanceOperationNullCode},
-		Refresh: func() (result interface{}, state string, err error) {
+		Refresh: func(ctx context.Context) (result any, state string, err error) {
 			reqParams := &vloadbalancer.GetLoadBalancerInstanceDetailRequest{
 				RegionCode:             &config.RegionCode,
 				LoadBalancerInstanceNo: ncloud.String(id),
@@ -519,21 +518,21 @@ func waitForLoadBalancerActive(ctx context.Context, config *conn.ProviderConfig,
 			lb := resp.LoadBalancerInstanceList[0]
 			return resp, ncloud.StringValue(lb.LoadBalancerInstanceOperation.Code), nil
 		},
-		Timeout:    6 * conn.DefaultTimeout,
-		MinTimeout: 3 * time.Second,
-		Delay:      2 * time.Second,
+		Timeout:     6 * conn.DefaultTimeout,
+		MinInterval: 3 * time.Second,
+		Delay:       2 * time.Second,
 	}
-	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+	if _, err := stateConf.WaitForState(ctx); err != nil {
 		return fmt.Errorf("error waiting for Load Balancer instance (%s) to become activating: %s", id, err)
 	}
 	return nil
 }
 
 func waitForLoadBalancerDeletion(ctx context.Context, config *conn.ProviderConfig, id string) error {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &waiter.Config[any]{
 		Pending: []string{LoadBalancerInstanceOperationTerminateCode},
 		Target:  []string{LoadBalancerInstanceOperationNullCode},
-		Refresh: func() (interface{}, string, error) {
+		Refresh: func(ctx context.Context) (any, string, error) {
 			reqParams := &vloadbalancer.GetLoadBalancerInstanceDetailRequest{
 				RegionCode:             &config.RegionCode,
 				LoadBalancerInstanceNo: ncloud.String(id),
@@ -550,31 +549,31 @@ func waitForLoadBalancerDeletion(ctx context.Context, config *conn.ProviderConfi
 			respCode := resp.LoadBalancerInstanceList[0].LoadBalancerInstanceOperation.Code
 			return nil, ncloud.StringValue(respCode), nil
 		},
-		Timeout:    6 * conn.DefaultTimeout,
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Timeout:     6 * conn.DefaultTimeout,
+		Delay:       2 * time.Second,
+		MinInterval: 3 * time.Second,
 	}
 
-	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+	if _, err := stateConf.WaitForState(ctx); err != nil {
 		return fmt.Errorf("error waiting for Load Balancer instance (%s) to be deleted: %s", id, err)
 	}
 
 	return nil
 }
 
-func GetVpcLoadBalancer(config *conn.ProviderConfig, id string) (*LoadBalancerInstance, error) {
+func GetVpcLoadBalancer(ctx context.Context, config *conn.ProviderConfig, id string) (*LoadBalancerInstance, error) {
 	reqParams := &vloadbalancer.GetLoadBalancerInstanceDetailRequest{
 		RegionCode:             &config.RegionCode,
 		LoadBalancerInstanceNo: ncloud.String(id),
 	}
-	LogCommonRequest("getLoadBalancerInstanceDetail", reqParams)
+	ctx = LogCommonRequest(ctx, "getLoadBalancerInstanceDetail", reqParams)
 
 	resp, err := config.Client.Vloadbalancer.V2Api.GetLoadBalancerInstanceDetail(reqParams)
 	if err != nil {
-		LogErrorResponse("getLoadBalancerInstanceDetail", err, reqParams)
+		LogErrorResponse(ctx, "getLoadBalancerInstanceDetail", err, reqParams)
 		return nil, err
 	}
-	LogResponse("getLoadBalancerInstanceDetail", resp)
+	LogResponse(ctx, "getLoadBalancerInstanceDetail", resp)
 
 	if len(resp.LoadBalancerInstanceList) < 1 {
 		return nil, nil
@@ -14,6 +14,7 @@ import (
 
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/paging"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/verify"
 )
 
@@ -129,11 +130,23 @@ func (l *loadBalancerDataSource) Read(ctx context.Context, req datasource.ReadRe
 		reqParams.LoadBalancerInstanceNoList = []*string{data.ID.ValueStringPointer()}
 	}
 
-	tflog.Info(ctx, "GetLoadBalancerInstanceList", map[string]any{
-		"reqParams": common.MarshalUncheckedString(reqParams),
-	})
-	lbResp, err := l.config.Client.Vloadbalancer.V2Api.GetLoadBalancerInstanceList(reqParams)
+	loadBalancerInstanceList, err := paging.All(ctx, func(ctx context.Context, pageNo, pageSize int32) ([]*vloadbalancer.LoadBalancerInstance, *int32, error) {
+		reqParams.PageNo = ncloud.Int32(pageNo)
+		reqParams.PageSize = ncloud.Int32(pageSize)
+
+		tflog.Info(ctx, "GetLoadBalancerInstanceList", map[string]any{
+			"reqParams": common.MarshalUncheckedString(reqParams),
+		})
+		lbResp, err := l.config.Client.Vloadbalancer.V2Api.GetLoadBalancerInstanceList(reqParams)
+		if err != nil {
+			return nil, nil, err
+		}
+		tflog.Info(ctx, "GetLoadBalancerInstanceList response", map[string]any{
+			"lbResponse": common.MarshalUncheckedString(lbResp),
+		})
 
+		return lbResp.LoadBalancerInstanceList, lbResp.TotalRows, nil
+	})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"GetLoadBalancerInstanceList",
@@ -141,11 +154,8 @@ func (l *loadBalancerDataSource) Read(ctx context.Context, req datasource.ReadRe
 		)
 		return
 	}
-	tflog.Info(ctx, "GetLoadBalancerInstanceList response", map[string]any{
-		"lbResponse": common.MarshalUncheckedString(lbResp),
-	})
 
-	lbList, diags := flattenLoadBalancers(ctx, lbResp.LoadBalancerInstanceList)
+	lbList, diags := flattenLoadBalancers(ctx, loadBalancerInstanceList)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -4,14 +4,13 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/acctest"
 )
 
 func TestAccDataSourceNcloudLb_basic(t *testing.T) {
-	name := fmt.Sprintf("terraform-testacc-lb-%s", acctest.RandString(5))
+	name := fmt.Sprintf("terraform-testacc-lb-%s", RandString(t, 5))
 	dataName := "data.ncloud_lb.test"
 	resourceName := "ncloud_lb.test"
 	resource.ParallelTest(t, resource.TestCase{
@@ -7,221 +7,371 @@ import (
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vloadbalancer"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
-
-	. "github.com/terraform-providers/terraform-provider-ncloud/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/framework"
 )
 
-const (
-	LoadBalancerListenerBusyStateErrorCode = "1200004"
-	LoadBalancerListenerServerErrorCode    = "1250000"
+var (
+	_ resource.Resource                 = &lbListenerResource{}
+	_ resource.ResourceWithConfigure    = &lbListenerResource{}
+	_ resource.ResourceWithImportState  = &lbListenerResource{}
+	_ resource.ResourceWithUpgradeState = &lbListenerResource{}
 )
 
-func ResourceNcloudLbListener() *schema.Resource {
-	return &schema.Resource{
-		CreateContext: resourceNcloudLbListenerCreate,
-		ReadContext:   resourceNcloudLbListenerRead,
-		UpdateContext: resourceNcloudLbListenerUpdate,
-		DeleteContext: resourceNcloudLbListenerDelete,
-		Importer: &schema.ResourceImporter{
-			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-				idParts := strings.Split(d.Id(), ":")
-				if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-					return nil, fmt.Errorf("unexpected format of ID (%q), expected LOAD_BALANCER_NO:LOAD_BALANCER_LISTENER_NO", d.Id())
-				}
-				load_balancer_no := idParts[0]
-				listener_no := idParts[1]
-				d.SetId(listener_no)
-				d.Set("load_balancer_no", load_balancer_no)
-				return []*schema.ResourceData{d}, nil
-			},
-		},
-		Timeouts: &schema.ResourceTimeout{
-			Create: schema.DefaultTimeout(conn.DefaultCreateTimeout),
-			Update: schema.DefaultTimeout(conn.DefaultUpdateTimeout),
-			Delete: schema.DefaultTimeout(conn.DefaultTimeout),
-		},
-		Schema: map[string]*schema.Schema{
-			"listener_no": {
-				Type:     schema.TypeString,
+func NewLbListenerResource() resource.Resource {
+	return &lbListenerResource{}
+}
+
+type lbListenerResource struct {
+	config *conn.ProviderConfig
+}
+
+func (r *lbListenerResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_lb_listener"
+}
+
+func (r *lbListenerResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = lbListenerSchema(ctx)
+	resp.Schema.Version = 1
+}
+
+// lbListenerSchema is the schema of the resource, unchanged since its SDKv2 implementation
+func lbListenerSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": framework.IDAttribute(),
+			"listener_no": schema.StringAttribute{
 				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
-			"load_balancer_no": {
-				Type:     schema.TypeString,
+			"load_balancer_no": schema.StringAttribute{
 				Required: true,
-				ForceNew: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
-			"target_group_no": {
-				Type:     schema.TypeString,
+			"target_group_no": schema.StringAttribute{
 				Required: true,
-				ForceNew: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
-			"port": {
-				Type:             schema.TypeInt,
-				Required:         true,
-				ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(1, 65534)),
+			"port": schema.Int32Attribute{
+				Required: true,
+				Validators: []validator.Int32{
+					int32validator.Between(1, 65534),
+				},
 			},
-			"protocol": {
-				Type:             schema.TypeString,
-				Required:         true,
-				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"HTTP", "HTTPS", "TCP", "TLS", "UDP"}, false)),
+			"protocol": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("HTTP", "HTTPS", "TCP", "TLS", "UDP"),
+				},
 			},
-			"tls_min_version_type": {
-				Type:             schema.TypeString,
-				Optional:         true,
-				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"TLSV10", "TLSV11", "TLSV12"}, false)),
+			"tls_min_version_type": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("TLSV10", "TLSV11", "TLSV12"),
+				},
 			},
-			"use_http2": {
-				Type:     schema.TypeBool,
+			"use_http2": schema.BoolAttribute{
 				Optional: true,
 				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
 			},
-			"ssl_certificate_no": {
-				Type:     schema.TypeString,
+			"ssl_certificate_no": schema.StringAttribute{
 				Optional: true,
 			},
-			"rule_no_list": {
-				Type:     schema.TypeList,
-				Computed: true,
-				Elem:     &schema.Schema{Type: schema.TypeString},
+			"rule_no_list": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
-func resourceNcloudLbListenerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	config := meta.(*conn.ProviderConfig)
-	if !config.SupportVPC {
-		return diag.FromErr(NotSupportClassic("resource `ncloud_lb_listener`"))
+func (r *lbListenerResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		framework.SDKv2SchemaVersion: framework.UpgradeFromSDKv2[lbListenerResourceModel](lbListenerSchema(ctx)),
+	}
+}
+
+func (r *lbListenerResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*conn.ProviderConfig)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
 	}
 
+	r.config = config
+}
+
+func (r *lbListenerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan lbListenerResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !r.config.SupportVPC {
+		resp.Diagnostics.AddError(
+			"NOT SUPPORT CLASSIC",
+			"resource `ncloud_lb_listener` does not support classic",
+		)
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, conn.DefaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	reqParams := &vloadbalancer.CreateLoadBalancerListenerRequest{
-		RegionCode: &config.RegionCode,
+		RegionCode: &r.config.RegionCode,
 		// Required
-		LoadBalancerInstanceNo: ncloud.String(d.Get("load_balancer_no").(string)),
-		TargetGroupNo:          ncloud.String(d.Get("target_group_no").(string)),
-		Port:                   ncloud.Int32(int32(d.Get("port").(int))),
-		ProtocolTypeCode:       ncloud.String(d.Get("protocol").(string)),
+		LoadBalancerInstanceNo: plan.LoadBalancerNo.ValueStringPointer(),
+		TargetGroupNo:          plan.TargetGroupNo.ValueStringPointer(),
+		Port:                   plan.Port.ValueInt32Pointer(),
+		ProtocolTypeCode:       plan.Protocol.ValueStringPointer(),
 
 		// Optional
-		SslCertificateNo:      StringPtrOrNil(d.GetOk("ssl_certificate_no")),
-		UseHttp2:              BoolPtrOrNil(d.GetOk("use_http2")),
-		TlsMinVersionTypeCode: StringPtrOrNil(d.GetOk("tls_min_version_type")),
+		SslCertificateNo:      plan.SslCertificateNo.ValueStringPointer(),
+		TlsMinVersionTypeCode: plan.TlsMinVersionType.ValueStringPointer(),
 	}
 
-	listener := &vloadbalancer.LoadBalancerListener{}
-	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		resp, err := config.Client.Vloadbalancer.V2Api.CreateLoadBalancerListener(reqParams)
-		if err != nil {
-			errBody, _ := GetCommonErrorBody(err)
-			if errBody.ReturnCode == LoadBalancerListenerBusyStateErrorCode || errBody.ReturnCode == LoadBalancerListenerServerErrorCode {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		listener = getListenerFromCreateResponseByPort(resp.LoadBalancerListenerList, reqParams.Port)
-		return nil
-	})
+	if !plan.UseHttp2.IsNull() && !plan.UseHttp2.IsUnknown() {
+		reqParams.UseHttp2 = plan.UseHttp2.ValueBoolPointer()
+	}
 
+	ctx = common.LogCommonRequest(ctx, "CreateLoadBalancerListener", reqParams)
+	response, err := r.config.Client.Vloadbalancer.V2Api.CreateLoadBalancerListener(reqParams)
 	if err != nil {
-		return diag.FromErr(err)
+		common.LogErrorResponse(ctx, "CreateLoadBalancerListener", err, reqParams)
+		resp.Diagnostics.AddError("CREATING ERROR", err.Error())
+		return
+	}
+	common.LogResponse(ctx, "CreateLoadBalancerListener", response)
+
+	listener := getListenerFromCreateResponseByPort(response.LoadBalancerListenerList, reqParams.Port)
+	if listener == nil {
+		resp.Diagnostics.AddError("CREATING ERROR", fmt.Sprintf("no listener of port %d in the response", plan.Port.ValueInt32()))
+		return
+	}
+
+	plan.ID = types.StringPointerValue(listener.LoadBalancerListenerNo)
+
+	output, err := GetVpcLoadBalancerListener(r.config, plan.ID.ValueString(), plan.LoadBalancerNo.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("READING ERROR", err.Error())
+		return
+	}
+
+	if output == nil {
+		resp.Diagnostics.AddError("READING ERROR", fmt.Sprintf("no matching listener: %s", plan.ID.ValueString()))
+		return
 	}
 
-	d.SetId(ncloud.StringValue(listener.LoadBalancerListenerNo))
-	return resourceNcloudLbListenerRead(ctx, d, meta)
+	resp.Diagnostics.Append(plan.refreshComputedFromOutput(ctx, output)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
-func resourceNcloudLbListenerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	config := meta.(*conn.ProviderConfig)
-	if !config.SupportVPC {
-		return diag.FromErr(NotSupportClassic("resource `ncloud_lb_listener`"))
+func (r *lbListenerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state lbListenerResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	listener, err := GetVpcLoadBalancerListener(config, d.Id(), d.Get("load_balancer_no").(string))
+	if !r.config.SupportVPC {
+		resp.Diagnostics.AddError(
+			"NOT SUPPORT CLASSIC",
+			"resource `ncloud_lb_listener` does not support classic",
+		)
+		return
+	}
+
+	output, err := GetVpcLoadBalancerListener(r.config, state.ID.ValueString(), state.LoadBalancerNo.ValueString())
 	if err != nil {
-		return diag.FromErr(err)
+		resp.Diagnostics.AddError("READING ERROR", err.Error())
+		return
 	}
 
-	if listener == nil {
-		d.SetId("")
-		return nil
+	if output == nil {
+		resp.State.RemoveResource(ctx)
+		return
 	}
 
-	listerMap := ConvertToMap(listener)
-	SetSingularResourceDataFromMapSchema(ResourceNcloudLbListener(), d, listerMap)
-	return nil
+	resp.Diagnostics.Append(state.refreshFromOutput(ctx, output)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
-func resourceNcloudLbListenerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	config := meta.(*conn.ProviderConfig)
-	if !config.SupportVPC {
-		return diag.FromErr(NotSupportClassic("resource `ncloud_lb_listener`"))
+func (r *lbListenerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state lbListenerResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !r.config.SupportVPC {
+		resp.Diagnostics.AddError(
+			"NOT SUPPORT CLASSIC",
+			"resource `ncloud_lb_listener` does not support classic",
+		)
+		return
 	}
 
-	if d.HasChanges("port", "protocol", "ssl_certificate_no", "use_http2", "tls_min_version_type") {
+	updateTimeout, diags := plan.Timeouts.Update(ctx, conn.DefaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	if !plan.Port.Equal(state.Port) ||
+		!plan.Protocol.Equal(state.Protocol) ||
+		!plan.SslCertificateNo.Equal(state.SslCertificateNo) ||
+		!plan.UseHttp2.Equal(state.UseHttp2) ||
+		!plan.TlsMinVersionType.Equal(state.TlsMinVersionType) {
 		reqParams := &vloadbalancer.ChangeLoadBalancerListenerConfigurationRequest{
-			RegionCode: &config.RegionCode,
+			RegionCode: &r.config.RegionCode,
 			// Required
-			LoadBalancerListenerNo: ncloud.String(d.Id()),
-			Port:                   ncloud.Int32(int32(d.Get("port").(int))),
-			ProtocolTypeCode:       ncloud.String(d.Get("protocol").(string)),
+			LoadBalancerListenerNo: state.ID.ValueStringPointer(),
+			Port:                   plan.Port.ValueInt32Pointer(),
+			ProtocolTypeCode:       plan.Protocol.ValueStringPointer(),
 
 			// Optional
-			SslCertificateNo:      StringPtrOrNil(d.GetOk("ssl_certificate_no")),
-			UseHttp2:              BoolPtrOrNil(d.GetOk("use_http2")),
-			TlsMinVersionTypeCode: StringPtrOrNil(d.GetOk("tls_min_version_type")),
+			SslCertificateNo:      plan.SslCertificateNo.ValueStringPointer(),
+			TlsMinVersionTypeCode: plan.TlsMinVersionType.ValueStringPointer(),
 		}
 
-		err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
-			_, err := config.Client.Vloadbalancer.V2Api.ChangeLoadBalancerListenerConfiguration(reqParams)
-			if err != nil {
-				errBody, _ := GetCommonErrorBody(err)
-				if errBody.ReturnCode == LoadBalancerListenerBusyStateErrorCode || errBody.ReturnCode == LoadBalancerListenerServerErrorCode {
-					return resource.RetryableError(err)
-				}
-				return resource.NonRetryableError(err)
-			}
-			return nil
-		})
+		if !plan.UseHttp2.IsNull() && !plan.UseHttp2.IsUnknown() {
+			reqParams.UseHttp2 = plan.UseHttp2.ValueBoolPointer()
+		}
 
+		ctx = common.LogCommonRequest(ctx, "ChangeLoadBalancerListenerConfiguration", reqParams)
+		response, err := r.config.Client.Vloadbalancer.V2Api.ChangeLoadBalancerListenerConfiguration(reqParams)
 		if err != nil {
-			return diag.FromErr(err)
+			common.LogErrorResponse(ctx, "ChangeLoadBalancerListenerConfiguration", err, reqParams)
+			resp.Diagnostics.AddError("UPDATING ERROR", err.Error())
+			return
 		}
+		common.LogResponse(ctx, "ChangeLoadBalancerListenerConfiguration", response)
+	}
+
+	output, err := GetVpcLoadBalancerListener(r.config, state.ID.ValueString(), state.LoadBalancerNo.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("READING ERROR", err.Error())
+		return
 	}
 
-	return resourceNcloudLbListenerRead(ctx, d, config)
+	if output == nil {
+		resp.Diagnostics.AddError("READING ERROR", fmt.Sprintf("no matching listener: %s", state.ID.ValueString()))
+		return
+	}
+
+	resp.Diagnostics.Append(plan.refreshComputedFromOutput(ctx, output)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
-func resourceNcloudLbListenerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	config := meta.(*conn.ProviderConfig)
-	if !config.SupportVPC {
-		return diag.FromErr(NotSupportClassic("resource `ncloud_lb_listener`"))
+func (r *lbListenerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state lbListenerResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-	reqParams := &vloadbalancer.DeleteLoadBalancerListenersRequest{
-		RegionCode:                 &config.RegionCode,
-		LoadBalancerListenerNoList: []*string{ncloud.String(d.Id())},
+
+	if !r.config.SupportVPC {
+		resp.Diagnostics.AddError(
+			"NOT SUPPORT CLASSIC",
+			"resource `ncloud_lb_listener` does not support classic",
+		)
+		return
 	}
 
-	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
-		_, err := config.Client.Vloadbalancer.V2Api.DeleteLoadBalancerListeners(reqParams)
-		if err != nil {
-			errBody, _ := GetCommonErrorBody(err)
-			if errBody.ReturnCode == LoadBalancerListenerBusyStateErrorCode || errBody.ReturnCode == LoadBalancerListenerServerErrorCode {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		return nil
-	})
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, conn.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	reqParams := &vloadbalancer.DeleteLoadBalancerListenersRequest{
+		RegionCode:                 &r.config.RegionCode,
+		LoadBalancerListenerNoList: []*string{state.ID.ValueStringPointer()},
+	}
 
+	ctx = common.LogCommonRequest(ctx, "DeleteLoadBalancerListeners", reqParams)
+	response, err := r.config.Client.Vloadbalancer.V2Api.DeleteLoadBalancerListeners(reqParams)
 	if err != nil {
-		return diag.FromErr(err)
+		common.LogErrorResponse(ctx, "DeleteLoadBalancerListeners", err, reqParams)
+		resp.Diagnostics.AddError("DELETING ERROR", err.Error())
+		return
 	}
-	return nil
+	common.LogResponse(ctx, "DeleteLoadBalancerListeners", response)
+}
+
+func (r *lbListenerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, ":")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("unexpected format of ID (%q), expected LOAD_BALANCER_NO:LOAD_BALANCER_LISTENER_NO", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("load_balancer_no"), idParts[0])...)
 }
 
 func getListenerFromCreateResponseByPort(listenerList []*vloadbalancer.LoadBalancerListener, port *int32) *vloadbalancer.LoadBalancerListener {
@@ -291,3 +441,44 @@ func getVpcLoadBalancerListenerTargetGroupNo(config *conn.ProviderConfig, id str
 
 	return nil
 }
+
+type lbListenerResourceModel struct {
+	ID                types.String   `tfsdk:"id"`
+	ListenerNo        types.String   `tfsdk:"listener_no"`
+	LoadBalancerNo    types.String   `tfsdk:"load_balancer_no"`
+	TargetGroupNo     types.String   `tfsdk:"target_group_no"`
+	Port              types.Int32    `tfsdk:"port"`
+	Protocol          types.String   `tfsdk:"protocol"`
+	TlsMinVersionType types.String   `tfsdk:"tls_min_version_type"`
+	UseHttp2          types.Bool     `tfsdk:"use_http2"`
+	SslCertificateNo  types.String   `tfsdk:"ssl_certificate_no"`
+	RuleNoList        types.List     `tfsdk:"rule_no_list"`
+	Timeouts          timeouts.Value `tfsdk:"timeouts"`
+}
+
+// refreshComputedFromOutput sets the attributes the API computes, keeping the planned ones
+func (m *lbListenerResourceModel) refreshComputedFromOutput(ctx context.Context, output *LoadBalancerListener) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.ListenerNo = types.StringPointerValue(output.LoadBalancerListenerNo)
+	if m.UseHttp2.IsUnknown() {
+		m.UseHttp2 = types.BoolPointerValue(output.UseHttp2)
+	}
+	m.RuleNoList, diags = types.ListValueFrom(ctx, types.StringType, output.LoadBalancerRuleNoList)
+
+	return diags
+}
+
+func (m *lbListenerResourceModel) refreshFromOutput(ctx context.Context, output *LoadBalancerListener) diag.Diagnostics {
+	m.Port = types.Int32PointerValue(output.Port)
+	m.Protocol = types.StringPointerValue(output.ProtocolType)
+	m.UseHttp2 = types.BoolPointerValue(output.UseHttp2)
+	m.TlsMinVersionType = framework.EmptyStringToNull(types.StringPointerValue(output.TlsMinVersionType))
+	m.SslCertificateNo = framework.EmptyStringToNull(types.StringPointerValue(output.SslCertificateNo))
+	// a listener with only redirection rules has no target group
+	if output.TargetGroupNo != nil {
+		m.TargetGroupNo = types.StringPointerValue(output.TargetGroupNo)
+	}
+
+	return m.refreshComputedFromOutput(ctx, output)
+}
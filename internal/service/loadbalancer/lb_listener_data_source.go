@@ -34,7 +34,52 @@ func DataSourceNcloudLbListener() *schema.Resource {
 		},
 		"filter": DataSourceFiltersSchema(),
 	}
-	return GetSingularDataSourceItemSchemaContext(ResourceNcloudLbListener(), fieldMap, dataSourceNcloudLbListenerRead)
+	return GetSingularDataSourceItemSchemaContext(lbListenerItemSchema(), fieldMap, dataSourceNcloudLbListenerRead)
+}
+
+// lbListenerItemSchema is the schema of a listener, kept from the SDKv2 implementation of the resource
+func lbListenerItemSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"listener_no": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"load_balancer_no": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"target_group_no": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"port": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"protocol": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tls_min_version_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"use_http2": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"ssl_certificate_no": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"rule_no_list": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
 }
 
 func dataSourceNcloudLbListenerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -4,14 +4,13 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/acctest"
 )
 
 func TestAccDataSourceNcloudLbListener_basic(t *testing.T) {
-	lbName := fmt.Sprintf("terraform-testacc-lb-%s", acctest.RandString(5))
+	lbName := fmt.Sprintf("terraform-testacc-lb-%s", RandString(t, 5))
 	dataName := "data.ncloud_lb_listener.test"
 	resourceName := "ncloud_lb_listener.test"
 	resource.ParallelTest(t, resource.TestCase{
@@ -4,7 +4,7 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -16,7 +16,7 @@ import (
 
 func TestAccResourceNcloudLbListener_vpc_basic(t *testing.T) {
 	var listener loadbalancer.LoadBalancerListener
-	lbName := fmt.Sprintf("terraform-testacc-lb-%s", acctest.RandString(5))
+	lbName := fmt.Sprintf("terraform-testacc-lb-%s", RandString(t, 5))
 	resourceName := "ncloud_lb_listener.test"
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { TestAccPreCheck(t) },
@@ -40,6 +40,34 @@ func TestAccResourceNcloudLbListener_vpc_basic(t *testing.T) {
 	})
 }
 
+// TestResourceNcloudLbListener_upgradeFromSDKv2 upgrades the state written by the SDKv2 implementation of the resource
+func TestResourceNcloudLbListener_upgradeFromSDKv2(t *testing.T) {
+	rawState := `{"id":"34567","listener_no":"34567","load_balancer_no":"45678","port":443,"protocol":"HTTPS","rule_no_list":["1111","2222"],"ssl_certificate_no":"6789","target_group_no":"56789","timeouts":{"create":null,"delete":null,"update":null},"tls_min_version_type":"TLSV12","use_http2":true}`
+
+	state := UpgradeResourceState(t, "ncloud_lb_listener", 0, rawState)
+
+	expected := map[string]tftypes.Value{
+		"id":                   tftypes.NewValue(tftypes.String, "34567"),
+		"listener_no":          tftypes.NewValue(tftypes.String, "34567"),
+		"load_balancer_no":     tftypes.NewValue(tftypes.String, "45678"),
+		"target_group_no":      tftypes.NewValue(tftypes.String, "56789"),
+		"port":                 tftypes.NewValue(tftypes.Number, 443),
+		"protocol":             tftypes.NewValue(tftypes.String, "HTTPS"),
+		"tls_min_version_type": tftypes.NewValue(tftypes.String, "TLSV12"),
+		"use_http2":            tftypes.NewValue(tftypes.Bool, true),
+		"ssl_certificate_no":   tftypes.NewValue(tftypes.String, "6789"),
+		"rule_no_list": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+			tftypes.NewValue(tftypes.String, "1111"),
+			tftypes.NewValue(tftypes.String, "2222"),
+		}),
+	}
+	for k, v := range expected {
+		if !state[k].Equal(v) {
+			t.Errorf("upgraded %s: expected %s, got %s", k, v, state[k])
+		}
+	}
+}
+
 func testAccCheckLbListenerExists(n string, l *loadbalancer.LoadBalancerListener, provider *schema.Provider) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -162,11 +162,11 @@ func resourceNcloudTargetGroupCreate(ctx context.Context, d *schema.ResourceData
 		TargetGroupProtocolTypeCode: ncloud.String(d.Get("protocol").(string)),
 	}
 
-	if err := validateVpcTargetGroupVpc(config, *reqParams.VpcNo); err != nil {
+	if err := validateVpcTargetGroupVpc(ctx, config, *reqParams.VpcNo); err != nil {
 		return diag.FromErr(err)
 	}
 
-	if err := validateVpcTargetGroupDuplicateName(config, ncloud.StringValue(reqParams.TargetGroupName)); err != nil {
+	if err := validateVpcTargetGroupDuplicateName(ctx, config, ncloud.StringValue(reqParams.TargetGroupName)); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -193,11 +193,11 @@ func resourceNcloudTargetGroupCreate(ctx context.Context, d *schema.ResourceData
 		}
 	}
 
-	LogCommonRequest("resourceNcloudTargetGroupCreate", reqParams)
+	ctx = LogCommonRequest(ctx, "resourceNcloudTargetGroupCreate", reqParams)
 	resp, err := config.Client.Vloadbalancer.V2Api.CreateTargetGroup(reqParams)
-	LogResponse("resourceNcloudTargetGroupCreate", resp)
+	LogResponse(ctx, "resourceNcloudTargetGroupCreate", resp)
 	if err != nil {
-		LogErrorResponse("resourceNcloudTargetGroupCreate", err, reqParams)
+		LogErrorResponse(ctx, "resourceNcloudTargetGroupCreate", err, reqParams)
 		return diag.FromErr(err)
 	}
 
@@ -211,7 +211,7 @@ func resourceNcloudTargetGroupRead(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(NotSupportClassic("resource `ncloud_lb_target_group`"))
 	}
 
-	tg, err := GetVpcLoadBalancerTargetGroup(config, d.Id())
+	tg, err := GetVpcLoadBalancerTargetGroup(ctx, config, d.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -254,9 +254,9 @@ func resourceNcloudTargetGroupUpdate(ctx context.Context, d *schema.ResourceData
 				reqParams.HealthCheckHttpMethodTypeCode = ncloud.String(healthCheck["http_method"].(string))
 			}
 		}
-		LogCommonRequest("resourceNcloudTargetGroupUpdate", reqParams)
+		ctx = LogCommonRequest(ctx, "resourceNcloudTargetGroupUpdate", reqParams)
 		if _, err := config.Client.Vloadbalancer.V2Api.ChangeTargetGroupHealthCheckConfiguration(reqParams); err != nil {
-			LogErrorResponse("resourceNcloudTargetGroupUpdate", err, reqParams)
+			LogErrorResponse(ctx, "resourceNcloudTargetGroupUpdate", err, reqParams)
 			return diag.FromErr(err)
 		}
 	}
@@ -279,7 +279,7 @@ func resourceNcloudTargetGroupUpdate(ctx context.Context, d *schema.ResourceData
 		if err := validateAlgorithmTypeByTargetGroupProtocol(*reqParams.AlgorithmTypeCode, targetGroupProtocol); err != nil {
 			return diag.FromErr(err)
 		}
-		LogCommonRequest("resourceNcloudTargetGroupUpdate", reqParams)
+		LogCommonRequest(ctx, "resourceNcloudTargetGroupUpdate", reqParams)
 		if _, err := config.Client.Vloadbalancer.V2Api.ChangeTargetGroupConfiguration(reqParams); err != nil {
 			return diag.FromErr(err)
 		}
@@ -304,19 +304,19 @@ func resourceNcloudTargetGroupDelete(ctx context.Context, d *schema.ResourceData
 	return nil
 }
 
-func GetVpcLoadBalancerTargetGroup(config *conn.ProviderConfig, id string) (*TargetGroup, error) {
+func GetVpcLoadBalancerTargetGroup(ctx context.Context, config *conn.ProviderConfig, id string) (*TargetGroup, error) {
 	reqParams := &vloadbalancer.GetTargetGroupListRequest{
 		RegionCode:        &config.RegionCode,
 		TargetGroupNoList: []*string{ncloud.String(id)},
 	}
 
-	LogCommonRequest("getLbTargetGroup", reqParams)
+	ctx = LogCommonRequest(ctx, "getLbTargetGroup", reqParams)
 	resp, err := config.Client.Vloadbalancer.V2Api.GetTargetGroupList(reqParams)
 	if err != nil {
-		LogErrorResponse("getLbTargetGroup", err, reqParams)
+		LogErrorResponse(ctx, "getLbTargetGroup", err, reqParams)
 		return nil, err
 	}
-	LogResponse("getLbTargetGroup", resp)
+	LogResponse(ctx, "getLbTargetGroup", resp)
 	if len(resp.TargetGroupList) < 1 {
 		return nil, nil
 	}
@@ -377,8 +377,8 @@ func validateHealthCheckProtocolByTargetGroupProtocol(targetGroupProtocol string
 	return nil
 }
 
-func validateVpcTargetGroupVpc(config *conn.ProviderConfig, vpcNo string) error {
-	vpc, err := vpc.GetVpcInstance(config, vpcNo)
+func validateVpcTargetGroupVpc(ctx context.Context, config *conn.ProviderConfig, vpcNo string) error {
+	vpc, err := vpc.GetVpcInstance(ctx, config, vpcNo)
 
 	if err != nil {
 		return err
@@ -391,9 +391,9 @@ func validateVpcTargetGroupVpc(config *conn.ProviderConfig, vpcNo string) error
 	return nil
 }
 
-func validateVpcTargetGroupDuplicateName(config *conn.ProviderConfig, newName string) error {
+func validateVpcTargetGroupDuplicateName(ctx context.Context, config *conn.ProviderConfig, newName string) error {
 	// Get All target groups from api
-	targetGroupList, err := getVpcLoadBalancerTargetGroupList(config, "")
+	targetGroupList, err := getVpcLoadBalancerTargetGroupList(ctx, config, "")
 
 	if err != nil {
 		return err
@@ -9,16 +9,14 @@ import (
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vloadbalancer"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/waiter"
 )
 
 const (
-	TargetGroupAttachmentBusyStateErrorCode            = "1200004"
-	TargetGroupAttachmentPleaseTryAgainErrorCode       = "1250000"
 	TargetGroupAttachmentInvalidTargetGroupNoErrorCode = "1205009"
 )
 
@@ -59,7 +57,7 @@ func resourceNcloudLbTargetGroupAttachmentCreate(ctx context.Context, d *schema.
 		TargetNoList:  ncloud.StringInterfaceList(d.Get("target_no_list").([]interface{})),
 	}
 
-	err := waitForAddTarget(ctx, d, config, reqParams)
+	err := addTarget(ctx, config, reqParams)
 
 	if err != nil {
 		return diag.FromErr(err)
@@ -77,8 +75,7 @@ func resourceNcloudLbTargetGroupAttachmentRead(ctx context.Context, d *schema.Re
 
 	targetNoList, err := GetVpcLoadBalancerTargetGroupAttachment(config, d.Get("target_group_no").(string), ncloud.StringListValue(ncloud.StringInterfaceList(d.Get("target_no_list").([]interface{}))))
 	if err != nil {
-		errorBody, _ := GetCommonErrorBody(err)
-		if errorBody.ReturnCode == TargetGroupAttachmentInvalidTargetGroupNoErrorCode {
+		if HasReturnCode(err, TargetGroupAttachmentInvalidTargetGroupNoErrorCode) {
 			log.Printf("[WARN] Target group does not exist, removing target attachment %s", d.Id())
 			d.SetId("")
 			return nil
@@ -138,7 +135,7 @@ func resourceNcloudLbTargetGroupAttachmentUpdate(ctx context.Context, d *schema.
 				TargetNoList:  ncloud.StringList(addTargetNoList),
 			}
 
-			addErr := waitForAddTarget(ctx, d, config, addReqParams)
+			addErr := addTarget(ctx, config, addReqParams)
 
 			if addErr != nil {
 				return diag.FromErr(addErr)
@@ -152,7 +149,7 @@ func resourceNcloudLbTargetGroupAttachmentUpdate(ctx context.Context, d *schema.
 				TargetNoList:  ncloud.StringList(removeTargetNoList),
 			}
 
-			removeErr := waitForRemoveTarget(ctx, d, config, removeReqParams)
+			removeErr := removeTarget(ctx, config, removeReqParams, d.Timeout(schema.TimeoutDelete))
 
 			if removeErr != nil {
 				return diag.FromErr(removeErr)
@@ -173,7 +170,7 @@ func resourceNcloudLbTargetGroupAttachmentDelete(ctx context.Context, d *schema.
 		TargetNoList:  ncloud.StringInterfaceList(d.Get("target_no_list").([]interface{})),
 	}
 
-	err := waitForRemoveTarget(ctx, d, config, reqParams)
+	err := removeTarget(ctx, config, reqParams, d.Timeout(schema.TimeoutDelete))
 
 	if err != nil {
 		return diag.FromErr(err)
@@ -216,42 +213,50 @@ func getMatchTargetNoListFromResponse(respTargetList []*vloadbalancer.Target, ta
 	return matchTargetNoList
 }
 
-func waitForAddTarget(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig, reqParams *vloadbalancer.AddTargetRequest) error {
-	return resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		LogCommonRequest("resourceNcloudLbTargetGroupAttachmentCreate", reqParams)
-		resp, err := config.Client.Vloadbalancer.V2Api.AddTarget(reqParams)
-		if err != nil {
-			errBody, _ := GetCommonErrorBody(err)
-			if errBody.ReturnCode == TargetGroupAttachmentBusyStateErrorCode || errBody.ReturnCode == TargetGroupAttachmentPleaseTryAgainErrorCode {
-				return resource.RetryableError(err)
-			}
-			LogErrorResponse("resourceNcloudLbTargetGroupAttachmentCreate", err, reqParams)
-			return resource.NonRetryableError(err)
-		}
+func addTarget(ctx context.Context, config *conn.ProviderConfig, reqParams *vloadbalancer.AddTargetRequest) error {
+	ctx = LogCommonRequest(ctx, "resourceNcloudLbTargetGroupAttachmentCreate", reqParams)
+	resp, err := config.Client.Vloadbalancer.V2Api.AddTarget(reqParams)
+	if err != nil {
+		LogErrorResponse(ctx, "resourceNcloudLbTargetGroupAttachmentCreate", err, reqParams)
+		return err
+	}
+	LogResponse(ctx, "resourceNcloudLbTargetGroupAttachmentCreate", resp)
+	return nil
+}
+
+func removeTarget(ctx context.Context, config *conn.ProviderConfig, reqParams *vloadbalancer.RemoveTargetRequest, timeout time.Duration) error {
+	ctx = LogCommonRequest(ctx, "resourceNcloudLbTargetGroupAttachmentDelete", reqParams)
+	resp, err := config.Client.Vloadbalancer.V2Api.RemoveTarget(reqParams)
+	if err != nil {
+		LogErrorResponse(ctx, "resourceNcloudLbTargetGroupAttachmentDelete", err, reqParams)
+		return err
+	}
+	LogResponse(ctx, "resourceNcloudLbTargetGroupAttachmentDelete", resp)
 
-		LogResponse("resourceNcloudLbTargetGroupAttachmentCreate", resp)
-		return nil
-	})
+	return waitForTargetRemoval(ctx, config, ncloud.StringValue(reqParams.TargetGroupNo), ncloud.StringListValue(reqParams.TargetNoList), timeout)
 }
 
-func waitForRemoveTarget(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig, reqParams *vloadbalancer.RemoveTargetRequest) error {
-	return resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
-		LogCommonRequest("resourceNcloudLbTargetGroupAttachmentDelete", reqParams)
-		resp, err := config.Client.Vloadbalancer.V2Api.RemoveTarget(reqParams)
-		if err != nil {
-			errBody, _ := GetCommonErrorBody(err)
-			if errBody.ReturnCode == TargetGroupAttachmentBusyStateErrorCode || errBody.ReturnCode == TargetGroupAttachmentPleaseTryAgainErrorCode {
-				return resource.RetryableError(err)
+// waitForTargetRemoval waits until none of the targets targetNoList is in the target group anymore
+func waitForTargetRemoval(ctx context.Context, config *conn.ProviderConfig, targetGroupNo string, targetNoList []string, timeout time.Duration) error {
+	stateConf := &waiter.Config[[]string]{
+		Pending: []string{"REMOVING"},
+		Target:  []string{"REMOVED"},
+		Refresh: func(ctx context.Context) ([]string, string, error) {
+			matchTargetNoList, err := GetVpcLoadBalancerTargetGroupAttachment(config, targetGroupNo, targetNoList)
+			if err != nil {
+				return nil, "", err
 			}
-			LogErrorResponse("resourceNcloudLbTargetGroupAttachmentDelete", err, reqParams)
-			return resource.NonRetryableError(err)
-		}
-		LogResponse("resourceNcloudLbTargetGroupAttachmentDelete", resp)
+			if len(matchTargetNoList) > 0 {
+				return matchTargetNoList, "REMOVING", nil
+			}
+			return matchTargetNoList, "REMOVED", nil
+		},
+		Timeout:     timeout,
+		MinInterval: 3 * time.Second,
+	}
 
-		matchTargetNoList := getMatchTargetNoListFromResponse(resp.TargetList, ncloud.StringListValue(reqParams.TargetNoList))
-		if len(matchTargetNoList) > 0 {
-			return resource.RetryableError(fmt.Errorf("target has not been removed yet"))
-		}
-		return nil
-	})
+	if _, err := stateConf.WaitForState(ctx); err != nil {
+		return fmt.Errorf("error waiting for targets (%v) to be removed from target group (%s): %s", targetNoList, targetGroupNo, err)
+	}
+	return nil
 }
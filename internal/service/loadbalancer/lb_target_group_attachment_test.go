@@ -5,7 +5,6 @@ import (
 	"testing"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -17,8 +16,8 @@ import (
 
 func TestAccResourceNcloudLbTargetGroupAttachment_basic(t *testing.T) {
 	var target string
-	targetGroupName := fmt.Sprintf("terraform-testacc-tga-%s", acctest.RandString(5))
-	testServerName := GetTestServerName()
+	targetGroupName := fmt.Sprintf("terraform-testacc-tga-%s", RandString(t, 5))
+	testServerName := GetTestServerName(t)
 	resourceName := "ncloud_lb_target_group_attachment.test"
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { TestAccPreCheck(t) },
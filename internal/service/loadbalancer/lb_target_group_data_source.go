@@ -10,6 +10,7 @@ import (
 
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/paging"
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/verify"
 )
 
@@ -75,7 +76,7 @@ func dataSourceNcloudLbTargetGroupRead(ctx context.Context, d *schema.ResourceDa
 		d.SetId(v.(string))
 	}
 
-	targetGroupList, err := getVpcLoadBalancerTargetGroupList(config, d.Id())
+	targetGroupList, err := getVpcLoadBalancerTargetGroupList(ctx, config, d.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -94,7 +95,7 @@ func dataSourceNcloudLbTargetGroupRead(ctx context.Context, d *schema.ResourceDa
 	return nil
 }
 
-func getVpcLoadBalancerTargetGroupList(config *conn.ProviderConfig, id string) ([]*TargetGroup, error) {
+func getVpcLoadBalancerTargetGroupList(ctx context.Context, config *conn.ProviderConfig, id string) ([]*TargetGroup, error) {
 	reqParams := &vloadbalancer.GetTargetGroupListRequest{
 		RegionCode: &config.RegionCode,
 	}
@@ -103,13 +104,22 @@ func getVpcLoadBalancerTargetGroupList(config *conn.ProviderConfig, id string) (
 		reqParams.TargetGroupNoList = []*string{ncloud.String(id)}
 	}
 
-	resp, err := config.Client.Vloadbalancer.V2Api.GetTargetGroupList(reqParams)
+	targetGroups, err := paging.All(ctx, func(ctx context.Context, pageNo, pageSize int32) ([]*vloadbalancer.TargetGroup, *int32, error) {
+		reqParams.PageNo = ncloud.Int32(pageNo)
+		reqParams.PageSize = ncloud.Int32(pageSize)
+
+		resp, err := config.Client.Vloadbalancer.V2Api.GetTargetGroupList(reqParams)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.TargetGroupList, resp.TotalRows, nil
+	})
 	if err != nil {
 		return nil, err
 	}
 
 	targetGroupList := make([]*TargetGroup, 0)
-	for _, tg := range resp.TargetGroupList {
+	for _, tg := range targetGroups {
 		targetGroupList = append(targetGroupList, convertVpcTargetGroup(tg))
 	}
 
@@ -1,11 +1,11 @@
 package loadbalancer_test
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -17,7 +17,7 @@ import (
 
 func TestAccResourceNcloudLbTargetGroup_basic(t *testing.T) {
 	var tg loadbalancer.TargetGroup
-	name := fmt.Sprintf("terraform-testacc-tg-%s", acctest.RandString(5))
+	name := fmt.Sprintf("terraform-testacc-tg-%s", RandString(t, 5))
 	resourceName := "ncloud_lb_target_group.test"
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { TestAccPreCheck(t) },
@@ -99,7 +99,7 @@ func testAccCheckLbTargetGroupExists(n string, t *loadbalancer.TargetGroup, prov
 		}
 
 		config := provider.Meta().(*conn.ProviderConfig)
-		tg, err := loadbalancer.GetVpcLoadBalancerTargetGroup(config, rs.Primary.ID)
+		tg, err := loadbalancer.GetVpcLoadBalancerTargetGroup(context.Background(), config, rs.Primary.ID)
 
 		if err != nil {
 			return err
@@ -122,7 +122,7 @@ func testAccCheckLbTargetGroupDestroy(s *terraform.State, provider *schema.Provi
 			continue
 		}
 
-		tg, err := loadbalancer.GetVpcLoadBalancerTargetGroup(config, rs.Primary.ID)
+		tg, err := loadbalancer.GetVpcLoadBalancerTargetGroup(context.Background(), config, rs.Primary.ID)
 
 		if err != nil {
 			return err
@@ -1,11 +1,11 @@
 package loadbalancer_test
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -17,7 +17,7 @@ import (
 
 func TestAccResourceNcloudLb_vpc_basic(t *testing.T) {
 	var lb loadbalancer.LoadBalancerInstance
-	lbName := fmt.Sprintf("terraform-testacc-lb-%s", acctest.RandString(5))
+	lbName := fmt.Sprintf("terraform-testacc-lb-%s", RandString(t, 5))
 	resourceName := "ncloud_lb.test"
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { TestAccPreCheck(t) },
@@ -62,7 +62,7 @@ func testAccCheckLbExists(n string, lb *loadbalancer.LoadBalancerInstance, provi
 		}
 
 		config := provider.Meta().(*conn.ProviderConfig)
-		loadBalancer, err := loadbalancer.GetVpcLoadBalancer(config, rs.Primary.ID)
+		loadBalancer, err := loadbalancer.GetVpcLoadBalancer(context.Background(), config, rs.Primary.ID)
 		if err != nil {
 			return err
 		}
@@ -84,7 +84,7 @@ func testAccCheckLbDestroy(s *terraform.State, provider *schema.Provider) error
 			continue
 		}
 
-		loadBalancer, err := loadbalancer.GetVpcLoadBalancer(config, rs.Primary.ID)
+		loadBalancer, err := loadbalancer.GetVpcLoadBalancer(context.Background(), config, rs.Primary.ID)
 		if err != nil {
 			return err
 		}
@@ -0,0 +1,152 @@
+package loadbalancer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vloadbalancer"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/paging"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/sweep"
+)
+
+func RegisterSweepers() {
+	sweep.AddTestSweepers("ncloud_lb", &resource.Sweeper{
+		Name: "ncloud_lb",
+		F:    sweepLbs,
+		Dependencies: []string{
+			"ncloud_lb_listener",
+		},
+	})
+
+	sweep.AddTestSweepers("ncloud_lb_listener", &resource.Sweeper{
+		Name: "ncloud_lb_listener",
+		F:    sweepLbListeners,
+	})
+
+	sweep.AddTestSweepers("ncloud_lb_target_group", &resource.Sweeper{
+		Name: "ncloud_lb_target_group",
+		F:    sweepLbTargetGroups,
+		Dependencies: []string{
+			"ncloud_lb",
+			"ncloud_auto_scaling_group",
+		},
+	})
+
+	sweep.AddChildTestSweepers("ncloud_lb_target_group",
+		"ncloud_lb_target_group_attachment",
+	)
+}
+
+func sweepLoadBalancerInstances(config *conn.ProviderConfig) ([]*vloadbalancer.LoadBalancerInstance, error) {
+	loadBalancerInstanceList, err := paging.All(context.Background(), func(ctx context.Context, pageNo, pageSize int32) ([]*vloadbalancer.LoadBalancerInstance, *int32, error) {
+		resp, err := config.Client.Vloadbalancer.V2Api.GetLoadBalancerInstanceList(&vloadbalancer.GetLoadBalancerInstanceListRequest{RegionCode: &config.RegionCode, PageNo: ncloud.Int32(pageNo), PageSize: ncloud.Int32(pageSize)})
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.LoadBalancerInstanceList, resp.TotalRows, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("listing load balancers: %w", err)
+	}
+
+	var lbs []*vloadbalancer.LoadBalancerInstance
+	for _, lb := range loadBalancerInstanceList {
+		if sweep.HasResourcePrefix(lb.LoadBalancerName) {
+			lbs = append(lbs, lb)
+		}
+	}
+	return lbs, nil
+}
+
+func sweepLbs(region string) error {
+	config, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+	if !config.SupportVPC {
+		return nil
+	}
+
+	lbs, err := sweepLoadBalancerInstances(config)
+	if err != nil {
+		return err
+	}
+
+	var sweepables []sweep.Sweepable
+	for _, lb := range lbs {
+		sweepables = append(sweepables, sweep.NewFrameworkSweepResource(NewLbResource, config, *lb.LoadBalancerInstanceNo, map[string]string{
+			"load_balancer_no": *lb.LoadBalancerInstanceNo,
+		}))
+	}
+
+	return sweep.SweepOrchestrator(context.Background(), sweepables)
+}
+
+// sweepLbListeners removes the listeners of the load balancers of acceptance tests.
+// A load balancer keeps at least one listener, removed with it.
+func sweepLbListeners(region string) error {
+	config, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+	if !config.SupportVPC {
+		return nil
+	}
+
+	lbs, err := sweepLoadBalancerInstances(config)
+	if err != nil {
+		return err
+	}
+
+	var sweepables []sweep.Sweepable
+	for _, lb := range lbs {
+		listeners, err := getVpcLoadBalancerListenerList(config, "", *lb.LoadBalancerInstanceNo)
+		if err != nil {
+			return fmt.Errorf("listing listeners of load balancer %s: %w", *lb.LoadBalancerInstanceNo, err)
+		}
+
+		for i, l := range listeners {
+			if i == 0 {
+				continue
+			}
+			sweepables = append(sweepables, sweep.NewFrameworkSweepResource(NewLbListenerResource, config, *l.LoadBalancerListenerNo, nil))
+		}
+	}
+
+	return sweep.SweepOrchestrator(context.Background(), sweepables)
+}
+
+func sweepLbTargetGroups(region string) error {
+	config, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+	if !config.SupportVPC {
+		return nil
+	}
+
+	targetGroupList, err := paging.All(context.Background(), func(ctx context.Context, pageNo, pageSize int32) ([]*vloadbalancer.TargetGroup, *int32, error) {
+		resp, err := config.Client.Vloadbalancer.V2Api.GetTargetGroupList(&vloadbalancer.GetTargetGroupListRequest{RegionCode: &config.RegionCode, PageNo: ncloud.Int32(pageNo), PageSize: ncloud.Int32(pageSize)})
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.TargetGroupList, resp.TotalRows, nil
+	})
+	if err != nil {
+		return fmt.Errorf("listing target groups: %w", err)
+	}
+
+	var sweepables []sweep.Sweepable
+	for _, tg := range targetGroupList {
+		if !sweep.HasResourcePrefix(tg.TargetGroupName) {
+			continue
+		}
+		sweepables = append(sweepables, sweep.NewSweepResource(ResourceNcloudLbTargetGroup(), config, *tg.TargetGroupNo, nil))
+	}
+
+	return sweep.SweepOrchestrator(context.Background(), sweepables)
+}
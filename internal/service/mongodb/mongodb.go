@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"regexp"
-	"strings"
 	"time"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
@@ -12,8 +11,6 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -29,6 +26,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/framework"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/waiter"
 )
 
 var (
@@ -573,7 +571,7 @@ func (m *mongodbResource) Create(ctx context.Context, req resource.CreateRequest
 		resp.Diagnostics.AddError("CREATING ERROR", err.Error())
 		return
 	}
-	tflog.Info(ctx, "CreateMongoDb response="+common.MarshalUncheckedString(response))
+	common.LogResponse(ctx, "CreateMongoDb", response)
 
 	if response == nil || len(response.CloudMongoDbInstanceList) < 1 {
 		resp.Diagnostics.AddError("CREATING ERROR", "response invalid")
@@ -637,14 +635,14 @@ func (m *mongodbResource) Update(ctx context.Context, req resource.UpdateRequest
 			CloudMongoDbInstanceNo: state.ID.ValueStringPointer(),
 			ConfigServerCount:      ncloud.Int32(int32(plan.ConfigServerCount.ValueInt64())),
 		}
-		tflog.Info(ctx, "ChangeCloudMongoDbConfigCount reqParams="+common.MarshalUncheckedString(reqParams))
+		ctx = common.LogCommonRequest(ctx, "ChangeCloudMongoDbConfigCount", reqParams)
 
 		response, err := m.config.Client.Vmongodb.V2Api.ChangeCloudMongoDbConfigCount(reqParams)
 		if err != nil {
 			resp.Diagnostics.AddError("UPDATE ERROR", err.Error())
 			return
 		}
-		tflog.Info(ctx, "ChangeCloudMongoDbConfigCount response="+common.MarshalUncheckedString(response))
+		common.LogResponse(ctx, "ChangeCloudMongoDbConfigCount", response)
 
 		if response == nil || len(response.CloudMongoDbInstanceList) < 1 {
 			resp.Diagnostics.AddError("UPDATE ERROR", "response invalid")
@@ -668,14 +666,14 @@ func (m *mongodbResource) Update(ctx context.Context, req resource.UpdateRequest
 			CloudMongoDbInstanceNo: state.ID.ValueStringPointer(),
 			MongosServerCount:      ncloud.Int32(int32(plan.MongosServerCount.ValueInt64())),
 		}
-		tflog.Info(ctx, "ChangeCloudMongoDbMongosCount reqParams="+common.MarshalUncheckedString(reqParams))
+		ctx = common.LogCommonRequest(ctx, "ChangeCloudMongoDbMongosCount", reqParams)
 
 		response, err := m.config.Client.Vmongodb.V2Api.ChangeCloudMongoDbMongosCount(reqParams)
 		if err != nil {
 			resp.Diagnostics.AddError("UPDATE ERROR", err.Error())
 			return
 		}
-		tflog.Info(ctx, "ChangeCloudMongoDbMongosCount response="+common.MarshalUncheckedString(response))
+		common.LogResponse(ctx, "ChangeCloudMongoDbMongosCount", response)
 
 		if response == nil || len(response.CloudMongoDbInstanceList) < 1 {
 			resp.Diagnostics.AddError("UPDATE ERROR", "response invalid")
@@ -701,14 +699,14 @@ func (m *mongodbResource) Update(ctx context.Context, req resource.UpdateRequest
 			MemberServerCount:      ncloud.Int32(int32(plan.MemberServerCount.ValueInt64())),
 			ArbiterServerCount:     ncloud.Int32(int32(plan.ArbiterServerCount.ValueInt64())),
 		}
-		tflog.Info(ctx, "ChangeCloudMongoDbSecondaryCount reqParams="+common.MarshalUncheckedString(reqParams))
+		ctx = common.LogCommonRequest(ctx, "ChangeCloudMongoDbSecondaryCount", reqParams)
 
 		response, err := m.config.Client.Vmongodb.V2Api.ChangeCloudMongoDbSecondaryCount(reqParams)
 		if err != nil {
 			resp.Diagnostics.AddError("UPDATE ERROR", err.Error())
 			return
 		}
-		tflog.Info(ctx, "ChangeCloudMongoDbSecondaryCount response="+common.MarshalUncheckedString(response))
+		common.LogResponse(ctx, "ChangeCloudMongoDbSecondaryCount", response)
 
 		if response == nil || len(response.CloudMongoDbInstanceList) < 1 {
 			resp.Diagnostics.AddError("UPDATE ERROR", "response invalid")
@@ -732,14 +730,14 @@ func (m *mongodbResource) Update(ctx context.Context, req resource.UpdateRequest
 			CloudMongoDbInstanceNo: state.ID.ValueStringPointer(),
 			ShardCount:             ncloud.Int32(int32(plan.ShardCount.ValueInt64())),
 		}
-		tflog.Info(ctx, "ChangeCloudMongoDbShardCount reqParams="+common.MarshalUncheckedString(reqParams))
+		ctx = common.LogCommonRequest(ctx, "ChangeCloudMongoDbShardCount", reqParams)
 
 		response, err := m.config.Client.Vmongodb.V2Api.ChangeCloudMongoDbShardCount(reqParams)
 		if err != nil {
 			resp.Diagnostics.AddError("UPDATE ERROR", err.Error())
 			return
 		}
-		tflog.Info(ctx, "ChangeCloudMongoDbShardCount response="+common.MarshalUncheckedString(response))
+		common.LogResponse(ctx, "ChangeCloudMongoDbShardCount", response)
 
 		if response == nil || len(response.CloudMongoDbInstanceList) < 1 {
 			resp.Diagnostics.AddError("UPDATE ERROR", "response invalid")
@@ -772,14 +770,14 @@ func (m *mongodbResource) Delete(ctx context.Context, req resource.DeleteRequest
 		RegionCode:             &m.config.RegionCode,
 		CloudMongoDbInstanceNo: state.ID.ValueStringPointer(),
 	}
-	tflog.Info(ctx, "DeleteMongoDb reqParams="+common.MarshalUncheckedString(reqParams))
+	ctx = common.LogCommonRequest(ctx, "DeleteMongoDb", reqParams)
 
 	response, err := m.config.Client.Vmongodb.V2Api.DeleteCloudMongoDbInstance(reqParams)
 	if err != nil {
 		resp.Diagnostics.AddError("DELETING ERROR", err.Error())
 		return
 	}
-	tflog.Info(ctx, "DeleteMongoDb response="+common.MarshalUncheckedString(response))
+	common.LogResponse(ctx, "DeleteMongoDb", response)
 
 	if err := waitMongoDbDeleted(ctx, m.config, state.ID.ValueString()); err != nil {
 		resp.Diagnostics.AddError("WAITING FOR DELETE ERROR", err.Error())
@@ -795,14 +793,14 @@ func GetCloudMongoDbInstance(ctx context.Context, config *conn.ProviderConfig, n
 		RegionCode:             &config.RegionCode,
 		CloudMongoDbInstanceNo: ncloud.String(no),
 	}
-	tflog.Info(ctx, "GetMongoDbDetail reqParams="+common.MarshalUncheckedString(reqParams))
+	ctx = common.LogCommonRequest(ctx, "GetMongoDbDetail", reqParams)
 
 	resp, err := config.Client.Vmongodb.V2Api.GetCloudMongoDbInstanceDetail(reqParams)
 	// If the lookup result is 0 or already deleted, it will respond with a 400 error with a 5001017 return code.
-	if err != nil && !(strings.Contains(err.Error(), `"returnCode": "5001017"`)) {
+	if err != nil && !common.IsNotFound(err) {
 		return nil, err
 	}
-	tflog.Info(ctx, "GetMongoDbDetail response="+common.MarshalUncheckedString(resp))
+	common.LogResponse(ctx, "GetMongoDbDetail", resp)
 
 	if resp == nil || len(resp.CloudMongoDbInstanceList) < 1 || len(resp.CloudMongoDbInstanceList[0].CloudMongoDbServerInstanceList) < 1 {
 		return nil, nil
@@ -813,10 +811,10 @@ func GetCloudMongoDbInstance(ctx context.Context, config *conn.ProviderConfig, n
 
 func waitMongoDbCreated(ctx context.Context, config *conn.ProviderConfig, id string) (*vmongodb.CloudMongoDbInstance, error) {
 	var mongodbInstance *vmongodb.CloudMongoDbInstance
-	stateConf := &retry.StateChangeConf{
+	stateConf := &waiter.Config[any]{
 		Pending: []string{"creating", "settingUp"},
 		Target:  []string{"running"},
-		Refresh: func() (interface{}, string, error) {
+		Refresh: func(ctx context.Context) (any, string, error) {
 			instance, err := GetCloudMongoDbInstance(ctx, config, id)
 			mongodbInstance = instance
 			if err != nil {
@@ -837,12 +835,12 @@ func waitMongoDbCreated(ctx context.Context, config *conn.ProviderConfig, id str
 
 			return 0, "", fmt.Errorf("error occurred while waiting to create mongodb")
 		},
-		Timeout:    6 * conn.DefaultTimeout,
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Timeout:     6 * conn.DefaultTimeout,
+		Delay:       2 * time.Second,
+		MinInterval: 3 * time.Second,
 	}
 
-	_, err := stateConf.WaitForState()
+	_, err := stateConf.WaitForState(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("error waiting for MongoDbInstance state to be \"CREAT\": %s", err)
 	}
@@ -852,10 +850,10 @@ func waitMongoDbCreated(ctx context.Context, config *conn.ProviderConfig, id str
 
 func waitMongoDbUpdate(ctx context.Context, config *conn.ProviderConfig, id string) (*vmongodb.CloudMongoDbInstance, error) {
 	var mongodbInstance *vmongodb.CloudMongoDbInstance
-	stateConf := &retry.StateChangeConf{
+	stateConf := &waiter.Config[any]{
 		Pending: []string{"creating", "settingUp"},
 		Target:  []string{"running"},
-		Refresh: func() (interface{}, string, error) {
+		Refresh: func(ctx context.Context) (any, string, error) {
 			instance, err := GetCloudMongoDbInstance(ctx, config, id)
 			mongodbInstance = instance
 			if err != nil {
@@ -886,12 +884,12 @@ func waitMongoDbUpdate(ctx context.Context, config *conn.ProviderConfig, id stri
 
 			return instance, "running", nil
 		},
-		Timeout:    6 * conn.DefaultTimeout,
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Timeout:     6 * conn.DefaultTimeout,
+		Delay:       2 * time.Second,
+		MinInterval: 3 * time.Second,
 	}
 
-	_, err := stateConf.WaitForState()
+	_, err := stateConf.WaitForState(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("error waiting for MongoDbInstance state to be \"CREAT\": %s", err)
 	}
@@ -900,10 +898,10 @@ func waitMongoDbUpdate(ctx context.Context, config *conn.ProviderConfig, id stri
 }
 
 func waitMongoDbDeleted(ctx context.Context, config *conn.ProviderConfig, id string) error {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &waiter.Config[any]{
 		Pending: []string{"deleting"},
 		Target:  []string{"deleted"},
-		Refresh: func() (interface{}, string, error) {
+		Refresh: func(ctx context.Context) (any, string, error) {
 			instance, err := GetCloudMongoDbInstance(ctx, config, id)
 			if err != nil {
 				return 0, "", err
@@ -922,12 +920,12 @@ func waitMongoDbDeleted(ctx context.Context, config *conn.ProviderConfig, id str
 
 			return 0, "", fmt.Errorf("error occurred while waiting to delete mongodb")
 		},
-		Timeout:    2 * conn.DefaultTimeout,
-		Delay:      5 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Timeout:     2 * conn.DefaultTimeout,
+		Delay:       5 * time.Second,
+		MinInterval: 3 * time.Second,
 	}
 
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForState(ctx); err != nil {
 		return fmt.Errorf("error waiting for mongodb (%s) to become terminating: %s", id, err)
 	}
 
@@ -6,7 +6,6 @@ import (
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vmongodb"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -203,14 +202,14 @@ func (m *mongodbDataSource) Read(ctx context.Context, req datasource.ReadRequest
 			RegionCode:              &m.config.RegionCode,
 			CloudMongoDbServiceName: data.ServiceName.ValueStringPointer(),
 		}
-		tflog.Info(ctx, "GetMongoDbList reqParams="+common.MarshalUncheckedString(reqParams))
+		ctx = common.LogCommonRequest(ctx, "GetMongoDbList", reqParams)
 
 		listResp, err := m.config.Client.Vmongodb.V2Api.GetCloudMongoDbInstanceList(reqParams)
 		if err != nil {
 			resp.Diagnostics.AddError("READING ERROR", err.Error())
 			return
 		}
-		tflog.Info(ctx, "GetMongoDbList response="+common.MarshalUncheckedString(listResp))
+		common.LogResponse(ctx, "GetMongoDbList", listResp)
 
 		if listResp == nil || len(listResp.CloudMongoDbInstanceList) < 1 {
 			resp.Diagnostics.AddError("READING ERROR", "no result. please change search criteria and try again.")
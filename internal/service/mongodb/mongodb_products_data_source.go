@@ -7,7 +7,6 @@ import (
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vmongodb"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -137,14 +136,20 @@ func (m *mongodbProductsDataSource) Read(ctx context.Context, req datasource.Rea
 	if !data.InfraResourceDetailTypeCode.IsNull() && !data.InfraResourceDetailTypeCode.IsUnknown() {
 		reqParams.InfraResourceDetailTypeCode = data.InfraResourceDetailTypeCode.ValueStringPointer()
 	}
-	tflog.Info(ctx, "GetMongoDbProductsList reqParams="+common.MarshalUncheckedString(reqParams))
-
-	mongodbProductResp, err := m.config.Client.Vmongodb.V2Api.GetCloudMongoDbProductList(reqParams)
+	mongodbProductResp, err := conn.CachedLookup(m.config, conn.CatalogKey("vmongodb.GetCloudMongoDbProductList", reqParams), func() (*vmongodb.GetCloudMongoDbProductListResponse, error) {
+		ctx := common.LogCommonRequest(ctx, "GetMongoDbProductsList", reqParams)
+		output, err := m.config.Client.Vmongodb.V2Api.GetCloudMongoDbProductList(reqParams)
+		if err != nil {
+			common.LogErrorResponse(ctx, "GetMongoDbProductsList", err, reqParams)
+			return nil, err
+		}
+		common.LogResponse(ctx, "GetMongoDbProductList", output)
+		return output, nil
+	})
 	if err != nil {
 		resp.Diagnostics.AddError("READING ERROR", err.Error())
 		return
 	}
-	tflog.Info(ctx, "GetMongoDbProductList response="+common.MarshalUncheckedString(mongodbProductResp))
 
 	if mongodbProductResp == nil || len(mongodbProductResp.ProductList) < 1 {
 		resp.Diagnostics.AddError("READING ERROR", "no result.")
@@ -8,7 +8,6 @@ import (
 	"testing"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vmongodb"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -20,7 +19,7 @@ import (
 
 func TestAccResourceNcloudMongoDb_vpc_basic(t *testing.T) {
 	var mongodbInstance vmongodb.CloudMongoDbInstance
-	name := fmt.Sprintf("tf-mongodb-%s", sdkacctest.RandString(4))
+	name := fmt.Sprintf("tf-mongodb-%s", RandString(t, 4))
 	resourceName := "ncloud_mongodb.mongodb"
 	clusterTypeCode := "STAND_ALONE"
 
@@ -48,7 +47,7 @@ func TestAccResourceNcloudMongoDb_vpc_basic(t *testing.T) {
 
 func TestAccResourceNcloudMongoDb_vpc_sharding(t *testing.T) {
 	var mongodbInstance vmongodb.CloudMongoDbInstance
-	name := fmt.Sprintf("tf-mongodb-%s", sdkacctest.RandString(4))
+	name := fmt.Sprintf("tf-mongodb-%s", RandString(t, 4))
 	resourceName := "ncloud_mongodb.mongodb"
 
 	resource.Test(t, resource.TestCase{
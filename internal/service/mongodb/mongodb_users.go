@@ -17,7 +17,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
 )
@@ -157,7 +156,7 @@ func (r *mongodbUsersResource) Create(ctx context.Context, req resource.CreateRe
 		resp.Diagnostics.AddError("CREATING ERROR", err.Error())
 		return
 	}
-	tflog.Info(ctx, "CreateMongodbUserList response="+common.MarshalUncheckedString(response))
+	common.LogResponse(ctx, "CreateMongodbUserList", response)
 
 	if response == nil || *response.ReturnCode != "0" {
 		resp.Diagnostics.AddError("CREATING ERROR", "response invalid")
@@ -278,14 +277,14 @@ func (r *mongodbUsersResource) Delete(ctx context.Context, req resource.DeleteRe
 		CloudMongoDbInstanceNo: state.ID.ValueStringPointer(),
 		CloudMongoDbUserList:   convertToDeleteParameters(state.MongoDbUserSet),
 	}
-	tflog.Info(ctx, "DeleteMongodbUserList reqParams="+common.MarshalUncheckedString(reqParams))
+	ctx = common.LogCommonRequest(ctx, "DeleteMongodbUserList", reqParams)
 
 	response, err := r.config.Client.Vmongodb.V2Api.DeleteCloudMongoDbUserList(reqParams)
 	if err != nil {
 		resp.Diagnostics.AddError("DELETING ERROR", err.Error())
 		return
 	}
-	tflog.Info(ctx, "DeleteMongodbUserList response="+common.MarshalUncheckedString(response))
+	common.LogResponse(ctx, "DeleteMongodbUserList", response)
 
 	_, err = waitMongoDbCreated(ctx, r.config, state.ID.ValueString())
 	if err != nil {
@@ -440,7 +439,7 @@ func addOrChangeUserList(ctx context.Context, config *conn.ProviderConfig, id *s
 		if err != nil {
 			return err
 		}
-		tflog.Info(ctx, "ChangeCloudMongoDbUserList response="+common.MarshalUncheckedString(response))
+		common.LogResponse(ctx, "ChangeCloudMongoDbUserList", response)
 
 		if response == nil || *response.ReturnCode != "0" {
 			return fmt.Errorf("ChangeCloudMongoDbUserList response invalid")
@@ -463,7 +462,7 @@ func addOrChangeUserList(ctx context.Context, config *conn.ProviderConfig, id *s
 		if err != nil {
 			return err
 		}
-		tflog.Info(ctx, "AddCloudMongoDbUserList response="+common.MarshalUncheckedString(response))
+		common.LogResponse(ctx, "AddCloudMongoDbUserList", response)
 
 		if response == nil || *response.ReturnCode != "0" {
 			return fmt.Errorf("AddCloudMongoDbUserList response invalid")
@@ -501,13 +500,13 @@ func deleteUserList(ctx context.Context, config *conn.ProviderConfig, id *string
 			CloudMongoDbInstanceNo: id,
 			CloudMongoDbUserList:   deleteParameters,
 		}
-		tflog.Info(ctx, "DeleteMongodbUserList reqParams="+common.MarshalUncheckedString(reqParams))
+		ctx = common.LogCommonRequest(ctx, "DeleteMongodbUserList", reqParams)
 
 		response, err := config.Client.Vmongodb.V2Api.DeleteCloudMongoDbUserList(reqParams)
 		if err != nil {
 			return err
 		}
-		tflog.Info(ctx, "DeleteMongodbUserList response="+common.MarshalUncheckedString(response))
+		common.LogResponse(ctx, "DeleteMongodbUserList", response)
 
 		_, err = waitMongoDbUpdate(ctx, config, *id)
 		if err != nil {
@@ -12,7 +12,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
 )
@@ -141,13 +140,13 @@ func GetMongoDbUserAllList(ctx context.Context, config *conn.ProviderConfig, id
 		RegionCode:             &config.RegionCode,
 		CloudMongoDbInstanceNo: ncloud.String(id),
 	}
-	tflog.Info(ctx, "GetMongodbUserList reqParams="+common.MarshalUncheckedString(reqParams))
+	ctx = common.LogCommonRequest(ctx, "GetMongodbUserList", reqParams)
 
 	resp, err := config.Client.Vmongodb.V2Api.GetCloudMongoDbUserList(reqParams)
 	if err != nil {
 		return nil, err
 	}
-	tflog.Info(ctx, "GetMongodbUserList response="+common.MarshalUncheckedString(resp))
+	common.LogResponse(ctx, "GetMongodbUserList", resp)
 
 	if resp == nil || len(resp.CloudMongoDbUserList) < 1 {
 		return nil, nil
@@ -4,14 +4,13 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/acctest"
 )
 
 func TestAccDataSourceNcloudMongoDbUsers_vpc_basic(t *testing.T) {
-	testName := fmt.Sprintf("tf-mduser-%s", acctest.RandString(3))
+	testName := fmt.Sprintf("tf-mduser-%s", RandString(t, 3))
 	dataName := "data.ncloud_mongodb_users.all"
 
 	resource.Test(t, resource.TestCase{
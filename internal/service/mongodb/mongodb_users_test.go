@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -16,7 +15,7 @@ import (
 )
 
 func TestAccResourceNcloudMongoDbUsers_vpc_update(t *testing.T) {
-	testName := fmt.Sprintf("tf-monuser-%s", acctest.RandString(3))
+	testName := fmt.Sprintf("tf-monuser-%s", RandString(t, 3))
 	resourceName := "ncloud_mongodb_users.mongodb_users"
 	dbResourceName := "ncloud_mongodb.mongodb"
 
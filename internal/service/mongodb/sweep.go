@@ -0,0 +1,63 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vmongodb"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/paging"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/sweep"
+)
+
+// RegisterSweepers registers the sweepers of MongoDB instances.
+// Users are deleted with their instance.
+func RegisterSweepers() {
+	sweep.AddTestSweepers("ncloud_mongodb", &resource.Sweeper{
+		Name: "ncloud_mongodb",
+		F:    sweepMongoDbs,
+	})
+
+	sweep.AddChildTestSweepers("ncloud_mongodb",
+		"ncloud_mongodb_users",
+	)
+}
+
+func sweepMongoDbs(region string) error {
+	config, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+	if !config.SupportVPC {
+		return nil
+	}
+
+	cloudMongoDbInstanceList, err := paging.Pager[*vmongodb.CloudMongoDbInstance]{
+		ZeroBased: true,
+		Page: func(ctx context.Context, pageNo, pageSize int32) ([]*vmongodb.CloudMongoDbInstance, *int32, error) {
+			resp, err := config.Client.Vmongodb.V2Api.GetCloudMongoDbInstanceList(&vmongodb.GetCloudMongoDbInstanceListRequest{RegionCode: &config.RegionCode, PageNo: ncloud.Int32(pageNo), PageSize: ncloud.Int32(pageSize)})
+			if err != nil {
+				return nil, nil, err
+			}
+			return resp.CloudMongoDbInstanceList, resp.TotalRows, nil
+		},
+	}.All(context.Background())
+	if err != nil {
+		if sweep.SkipSweepError(err) {
+			return nil
+		}
+		return fmt.Errorf("listing MongoDB instances: %w", err)
+	}
+
+	var sweepables []sweep.Sweepable
+	for _, i := range cloudMongoDbInstanceList {
+		if !sweep.HasResourcePrefix(i.CloudMongoDbServiceName) {
+			continue
+		}
+		sweepables = append(sweepables, sweep.NewFrameworkSweepResource(NewMongoDbResource, config, *i.CloudMongoDbInstanceNo, nil))
+	}
+
+	return sweep.SweepOrchestrator(context.Background(), sweepables)
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"regexp"
-	"strings"
 	"time"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
@@ -26,13 +25,12 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/framework"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/service/vpc"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/waiter"
 )
 
 var (
@@ -315,7 +313,7 @@ func (r *mssqlResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	subnet, err := vpc.GetSubnetInstance(r.config, plan.SubnetNo.ValueString())
+	subnet, err := vpc.GetCachedSubnetInstance(ctx, r.config, plan.SubnetNo.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"CREATING ERROR",
@@ -378,7 +376,7 @@ func (r *mssqlResource) Create(ctx context.Context, req resource.CreateRequest,
 		resp.Diagnostics.AddError("CREATING ERROR", err.Error())
 		return
 	}
-	tflog.Info(ctx, "CreateMssql response="+common.MarshalUncheckedString(response))
+	common.LogResponse(ctx, "CreateMssql", response)
 
 	if response == nil || len(response.CloudMssqlInstanceList) < 1 {
 		resp.Diagnostics.AddError("CREATING ERROR", "response invalid")
@@ -441,14 +439,14 @@ func (r *mssqlResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		RegionCode:           &r.config.RegionCode,
 		CloudMssqlInstanceNo: state.ID.ValueStringPointer(),
 	}
-	tflog.Info(ctx, "DeleteMssql reqParams="+common.MarshalUncheckedString(reqParams))
+	ctx = common.LogCommonRequest(ctx, "DeleteMssql", reqParams)
 
 	response, err := r.config.Client.Vmssql.V2Api.DeleteCloudMssqlInstance(reqParams)
 	if err != nil {
 		resp.Diagnostics.AddError("DELETING ERROR", err.Error())
 		return
 	}
-	tflog.Info(ctx, "DeleteMssql response="+common.MarshalUncheckedString(response))
+	common.LogResponse(ctx, "DeleteMssql", response)
 
 	if err := waitMssqlDeletion(ctx, r.config, state.ID.ValueString()); err != nil {
 		resp.Diagnostics.AddError("WAITING FOR DELETE ERROR", err.Error())
@@ -464,15 +462,14 @@ func GetMssqlInstance(ctx context.Context, config *conn.ProviderConfig, no strin
 		RegionCode:           &config.RegionCode,
 		CloudMssqlInstanceNo: &no,
 	}
-	tflog.Info(ctx, "GetMssqlDetail reqParams="+common.MarshalUncheckedString(reqParams))
+	ctx = common.LogCommonRequest(ctx, "GetMssqlDetail", reqParams)
 
 	resp, err := config.Client.Vmssql.V2Api.GetCloudMssqlInstanceDetail(reqParams)
-	// If the lookup result is 0, it will respond with a 400 error with a 5001017 return code.
-	// MSSQL deleted, it will respond with a 400 error with a 5001269 return code.
-	if err != nil && !(strings.Contains(err.Error(), `"returnCode": "5001017"`)) && !strings.Contains(err.Error(), `"returnCode": "5001269"`) {
+	// If the lookup result is 0 or MSSQL is deleted, it will respond with a 400 error with a 5001017 or 5001269 return code.
+	if err != nil && !common.IsNotFound(err) {
 		return nil, err
 	}
-	tflog.Info(ctx, "GetMssqlDetail response="+common.MarshalUncheckedString(resp))
+	common.LogResponse(ctx, "GetMssqlDetail", resp)
 
 	if resp == nil || len(resp.CloudMssqlInstanceList) < 1 || len(resp.CloudMssqlInstanceList[0].CloudMssqlServerInstanceList) < 1 {
 		return nil, nil
@@ -483,10 +480,10 @@ func GetMssqlInstance(ctx context.Context, config *conn.ProviderConfig, no strin
 
 func waitMssqlCreation(ctx context.Context, config *conn.ProviderConfig, id string) (*vmssql.CloudMssqlInstance, error) {
 	var mssqlInstance *vmssql.CloudMssqlInstance
-	stateConf := &retry.StateChangeConf{
+	stateConf := &waiter.Config[any]{
 		Pending: []string{"creating", "settingUp"},
 		Target:  []string{"running"},
-		Refresh: func() (interface{}, string, error) {
+		Refresh: func(ctx context.Context) (any, string, error) {
 			instance, err := GetMssqlInstance(ctx, config, id)
 			mssqlInstance = instance
 			if err != nil {
@@ -510,11 +507,11 @@ func waitMssqlCreation(ctx context.Context, config *conn.ProviderConfig, id stri
 
 			return 0, "", fmt.Errorf("error occurred while waiting to create mssql")
 		},
-		Timeout:    90 * time.Minute,
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Timeout:     90 * time.Minute,
+		Delay:       2 * time.Second,
+		MinInterval: 3 * time.Second,
 	}
-	_, err := stateConf.WaitForState()
+	_, err := stateConf.WaitForState(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("error waiting for MssqlInstance state to be \"CREAT\": %s", err)
 	}
@@ -523,10 +520,10 @@ func waitMssqlCreation(ctx context.Context, config *conn.ProviderConfig, id stri
 }
 
 func waitMssqlDeletion(ctx context.Context, config *conn.ProviderConfig, id string) error {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &waiter.Config[any]{
 		Pending: []string{"deleting"},
 		Target:  []string{"deleted"},
-		Refresh: func() (interface{}, string, error) {
+		Refresh: func(ctx context.Context) (any, string, error) {
 			instance, err := GetMssqlInstance(ctx, config, id)
 			if err != nil {
 				return 0, "", err
@@ -545,12 +542,12 @@ func waitMssqlDeletion(ctx context.Context, config *conn.ProviderConfig, id stri
 
 			return 0, "", fmt.Errorf("error occurred while waiting to delete mssql")
 		},
-		Timeout:    conn.DefaultTimeout,
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Timeout:     conn.DefaultTimeout,
+		Delay:       2 * time.Second,
+		MinInterval: 3 * time.Second,
 	}
 
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForState(ctx); err != nil {
 		return fmt.Errorf("error waiting for mssql (%s) to become termintaing: %s", id, err)
 	}
 
@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"testing"
 
-	randacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/acctest"
@@ -15,7 +14,7 @@ import (
 func TestAccDataSourceNcloudMssql_vpc_basic(t *testing.T) {
 	dataName := "data.ncloud_mssql.test"
 	resourceName := "ncloud_mssql.mssql"
-	testMssqlName := fmt.Sprintf("tf-mssql-%s", randacctest.RandString(5))
+	testMssqlName := fmt.Sprintf("tf-mssql-%s", acctest.RandString(t, 5))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { TestAccPreCheck(t) },
@@ -10,7 +10,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
 )
@@ -110,14 +109,20 @@ func (m *mssqlImageProductsDataSource) Read(ctx context.Context, req datasource.
 	reqParams := &vmssql.GetCloudMssqlImageProductListRequest{
 		RegionCode: &m.config.RegionCode,
 	}
-	tflog.Info(ctx, "GetMssqlImageProductList reqParams="+common.MarshalUncheckedString(reqParams))
-
-	mssqlImageProductResp, err := m.config.Client.Vmssql.V2Api.GetCloudMssqlImageProductList(reqParams)
+	mssqlImageProductResp, err := conn.CachedLookup(m.config, conn.CatalogKey("vmssql.GetCloudMssqlImageProductList", reqParams), func() (*vmssql.GetCloudMssqlImageProductListResponse, error) {
+		ctx := common.LogCommonRequest(ctx, "GetMssqlImageProductList", reqParams)
+		output, err := m.config.Client.Vmssql.V2Api.GetCloudMssqlImageProductList(reqParams)
+		if err != nil {
+			common.LogErrorResponse(ctx, "GetMssqlImageProductList", err, reqParams)
+			return nil, err
+		}
+		common.LogResponse(ctx, "GetMssqlImageProductList", output)
+		return output, nil
+	})
 	if err != nil {
 		resp.Diagnostics.AddError("READING ERROR", err.Error())
 		return
 	}
-	tflog.Info(ctx, "GetMssqlImageProductList response="+common.MarshalUncheckedString(mssqlImageProductResp))
 
 	if mssqlImageProductResp == nil || len(mssqlImageProductResp.ProductList) < 1 {
 		resp.Diagnostics.AddError("READING ERROR", "no result.")
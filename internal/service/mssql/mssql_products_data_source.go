@@ -11,7 +11,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
 )
@@ -121,14 +120,20 @@ func (m *mssqlProductsDataSource) Read(ctx context.Context, req datasource.ReadR
 		RegionCode:                 &m.config.RegionCode,
 		CloudMssqlImageProductCode: data.CloudMssqlImageProductCode.ValueStringPointer(),
 	}
-	tflog.Info(ctx, "GetMssqlProductsList reqParams="+common.MarshalUncheckedString(reqParams))
-
-	mssqlProductResp, err := m.config.Client.Vmssql.V2Api.GetCloudMssqlProductList(reqParams)
+	mssqlProductResp, err := conn.CachedLookup(m.config, conn.CatalogKey("vmssql.GetCloudMssqlProductList", reqParams), func() (*vmssql.GetCloudMssqlProductListResponse, error) {
+		ctx := common.LogCommonRequest(ctx, "GetMssqlProductsList", reqParams)
+		output, err := m.config.Client.Vmssql.V2Api.GetCloudMssqlProductList(reqParams)
+		if err != nil {
+			common.LogErrorResponse(ctx, "GetMssqlProductsList", err, reqParams)
+			return nil, err
+		}
+		common.LogResponse(ctx, "GetMssqlProductsList", output)
+		return output, nil
+	})
 	if err != nil {
 		resp.Diagnostics.AddError("READING ERROR", err.Error())
 		return
 	}
-	tflog.Info(ctx, "GetMssqlProductsList response="+common.MarshalUncheckedString(mssqlProductResp))
 
 	if mssqlProductResp == nil || len(mssqlProductResp.ProductList) < 1 {
 		resp.Diagnostics.AddError("READING ERROR", "no result.")
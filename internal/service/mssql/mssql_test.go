@@ -7,7 +7,6 @@ import (
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vmssql"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -19,7 +18,7 @@ import (
 
 func TestAccResourceNcloudMssql_vpc_basic(t *testing.T) {
 	var mssqlInstance vmssql.CloudMssqlInstance
-	testMssqlName := fmt.Sprintf("tf-mssql-%s", acctest.RandString(5))
+	testMssqlName := fmt.Sprintf("tf-mssql-%s", RandString(t, 5))
 	resourceName := "ncloud_mssql.mssql"
 
 	resource.Test(t, resource.TestCase{
@@ -83,8 +82,7 @@ func testAccCheckCloudMssqlDestroy(s *terraform.State, provider *schema.Provider
 
 		cloudMssql, err := mssqlservice.GetMssqlInstance(context.Background(), config, rs.Primary.ID)
 		if err != nil {
-			commonErr, parseErr := GetCommonErrorBody(err)
-			if parseErr == nil && commonErr.ReturnCode == "5001269" {
+			if IsNotFound(err) {
 				return nil
 			}
 			return err
@@ -0,0 +1,58 @@
+package mssql
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vmssql"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/paging"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/sweep"
+)
+
+// RegisterSweepers registers the sweeper of MSSQL instances.
+func RegisterSweepers() {
+	sweep.AddTestSweepers("ncloud_mssql", &resource.Sweeper{
+		Name: "ncloud_mssql",
+		F:    sweepMssqls,
+	})
+}
+
+func sweepMssqls(region string) error {
+	config, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+	if !config.SupportVPC {
+		return nil
+	}
+
+	cloudMssqlInstanceList, err := paging.Pager[*vmssql.CloudMssqlInstance]{
+		ZeroBased: true,
+		Page: func(ctx context.Context, pageNo, pageSize int32) ([]*vmssql.CloudMssqlInstance, *int32, error) {
+			resp, err := config.Client.Vmssql.V2Api.GetCloudMssqlInstanceList(&vmssql.GetCloudMssqlInstanceListRequest{RegionCode: &config.RegionCode, PageNo: ncloud.Int32(pageNo), PageSize: ncloud.Int32(pageSize)})
+			if err != nil {
+				return nil, nil, err
+			}
+			return resp.CloudMssqlInstanceList, resp.TotalRows, nil
+		},
+	}.All(context.Background())
+	if err != nil {
+		if sweep.SkipSweepError(err) {
+			return nil
+		}
+		return fmt.Errorf("listing MSSQL instances: %w", err)
+	}
+
+	var sweepables []sweep.Sweepable
+	for _, i := range cloudMssqlInstanceList {
+		if !sweep.HasResourcePrefix(i.CloudMssqlServiceName) {
+			continue
+		}
+		sweepables = append(sweepables, sweep.NewFrameworkSweepResource(NewMssqlResource, config, *i.CloudMssqlInstanceNo, nil))
+	}
+
+	return sweep.SweepOrchestrator(context.Background(), sweepables)
+}
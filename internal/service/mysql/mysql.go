@@ -4,7 +4,7 @@ import (
 	"context"
 	"fmt"
 	"regexp"
-	"strings"
+	"slices"
 	"time"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
@@ -26,15 +26,15 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/framework"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/service/vpc"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/verify"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/verify/verifybool"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/verify/verifystring"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/waiter"
 )
 
 const (
@@ -49,6 +49,7 @@ var (
 	_ resource.Resource                = &mysqlResource{}
 	_ resource.ResourceWithConfigure   = &mysqlResource{}
 	_ resource.ResourceWithImportState = &mysqlResource{}
+	_ resource.ResourceWithModifyPlan  = &mysqlResource{}
 )
 
 func NewMysqlResource() resource.Resource {
@@ -375,6 +376,85 @@ func (r *mysqlResource) Configure(_ context.Context, req resource.ConfigureReque
 	r.config = config
 }
 
+// ModifyPlan checks the image product, product and engine version codes against the MySQL image products and
+// products of the region, so that an invalid code fails the plan rather than the creation.
+func (r *mysqlResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.config == nil || !r.config.SupportVPC {
+		return
+	}
+
+	imageProductCode, imageChanged, diags := framework.PlannedStringChange(ctx, req, path.Root("image_product_code"))
+	resp.Diagnostics.Append(diags...)
+	productCode, productChanged, diags := framework.PlannedStringChange(ctx, req, path.Root("product_code"))
+	resp.Diagnostics.Append(diags...)
+	engineVersionCode, engineVersionChanged, diags := framework.PlannedStringChange(ctx, req, path.Root("engine_version_code"))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || !(imageChanged || productChanged || engineVersionChanged) {
+		return
+	}
+
+	imageProducts, err := getMysqlImageProductList(ctx, r.config)
+	if err != nil {
+		resp.Diagnostics.AddWarning("PLAN VALIDATION SKIPPED", "unable to get the MySQL image products: "+err.Error())
+		return
+	}
+
+	scope := "region " + r.config.RegionCode
+	var imageProductCodes, engineVersionCodes []string
+	for _, p := range imageProducts {
+		imageProductCodes = append(imageProductCodes, ncloud.StringValue(p.ProductCode))
+		if v := ncloud.StringValue(p.EngineVersionCode); v != "" && !slices.Contains(engineVersionCodes, v) {
+			engineVersionCodes = append(engineVersionCodes, v)
+		}
+	}
+
+	if imageChanged {
+		if err := verify.ValidateCodeInList("image_product_code", imageProductCode, imageProductCodes, scope); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("image_product_code"), "INVALID IMAGE PRODUCT CODE", err.Error())
+			return
+		}
+	}
+
+	if engineVersionChanged {
+		if err := verify.ValidateCodeInList("engine_version_code", engineVersionCode, engineVersionCodes, scope); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("engine_version_code"), "INVALID ENGINE VERSION CODE", err.Error())
+		}
+	}
+
+	if !productChanged {
+		return
+	}
+
+	// the products depend on the image, any image is a candidate when the default image is used
+	var image types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("image_product_code"), &image)...)
+	if resp.Diagnostics.HasError() || image.IsUnknown() {
+		return
+	}
+	if !image.IsNull() {
+		imageProductCodes = []string{image.ValueString()}
+		scope = fmt.Sprintf("image %s of %s", image.ValueString(), scope)
+	}
+
+	var productCodes []string
+	for _, code := range imageProductCodes {
+		products, err := getMysqlProductList(ctx, r.config, code)
+		if err != nil {
+			resp.Diagnostics.AddWarning("PLAN VALIDATION SKIPPED", "unable to get the MySQL products: "+err.Error())
+			return
+		}
+		for _, p := range products {
+			if v := ncloud.StringValue(p.ProductCode); !slices.Contains(productCodes, v) {
+				productCodes = append(productCodes, v)
+			}
+		}
+	}
+
+	if err := verify.ValidateCodeInList("product_code", productCode, productCodes, scope); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("product_code"), "INVALID PRODUCT CODE", err.Error())
+	}
+}
+
 func (r *mysqlResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan mysqlResourceModel
 
@@ -391,7 +471,7 @@ func (r *mysqlResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	subnet, err := vpc.GetSubnetInstance(r.config, plan.SubnetNo.ValueString())
+	subnet, err := vpc.GetCachedSubnetInstance(ctx, r.config, plan.SubnetNo.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"CREATING ERROR",
@@ -537,7 +617,7 @@ func (r *mysqlResource) Create(ctx context.Context, req resource.CreateRequest,
 		resp.Diagnostics.AddError("CREATING ERROR", err.Error())
 		return
 	}
-	tflog.Info(ctx, "CreateMysql response="+common.MarshalUncheckedString(response))
+	common.LogResponse(ctx, "CreateMysql", response)
 
 	if response == nil || len(response.CloudMysqlInstanceList) < 1 {
 		resp.Diagnostics.AddError("CREATING ERROR", "response invalid")
@@ -611,14 +691,14 @@ func (r *mysqlResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		RegionCode:           &r.config.RegionCode,
 		CloudMysqlInstanceNo: state.ID.ValueStringPointer(),
 	}
-	tflog.Info(ctx, "DeleteMysql reqParams="+common.MarshalUncheckedString(reqParams))
+	ctx = common.LogCommonRequest(ctx, "DeleteMysql", reqParams)
 
 	response, err := r.config.Client.Vmysql.V2Api.DeleteCloudMysqlInstance(reqParams)
 	if err != nil {
 		resp.Diagnostics.AddError("DELETING ERROR", err.Error())
 		return
 	}
-	tflog.Info(ctx, "DeleteMysql response="+common.MarshalUncheckedString(response))
+	common.LogResponse(ctx, "DeleteMysql", response)
 
 	if err := waitMysqlDeletion(ctx, r.config, state.ID.ValueString()); err != nil {
 		resp.Diagnostics.AddError("WAITING FOR DELETE ERROR", err.Error())
@@ -634,13 +714,13 @@ func GetMysqlInstance(ctx context.Context, config *conn.ProviderConfig, no strin
 		RegionCode:           &config.RegionCode,
 		CloudMysqlInstanceNo: ncloud.String(no),
 	}
-	tflog.Info(ctx, "GetMysqlDetail reqParams="+common.MarshalUncheckedString(reqParams))
+	ctx = common.LogCommonRequest(ctx, "GetMysqlDetail", reqParams)
 
 	resp, err := config.Client.Vmysql.V2Api.GetCloudMysqlInstanceDetail(reqParams)
-	if err != nil && !CheckIfAlreadyDeleted(err) {
+	if err != nil && !common.IsNotFound(err) {
 		return nil, err
 	}
-	tflog.Info(ctx, "GetMysqlDetail response="+common.MarshalUncheckedString(resp))
+	common.LogResponse(ctx, "GetMysqlDetail", resp)
 
 	if resp == nil || len(resp.CloudMysqlInstanceList) < 1 || len(resp.CloudMysqlInstanceList[0].CloudMysqlServerInstanceList) < 1 {
 		return nil, nil
@@ -650,15 +730,13 @@ func GetMysqlInstance(ctx context.Context, config *conn.ProviderConfig, no strin
 }
 
 func waitMysqlCreation(ctx context.Context, config *conn.ProviderConfig, id string) (*vmysql.CloudMysqlInstance, error) {
-	var mysqlInstance *vmysql.CloudMysqlInstance
-	stateConf := &retry.StateChangeConf{
+	stateConf := &waiter.Config[*vmysql.CloudMysqlInstance]{
 		Pending: []string{CREATING, SETTING},
 		Target:  []string{RUNNING},
-		Refresh: func() (interface{}, string, error) {
+		Refresh: func(ctx context.Context) (*vmysql.CloudMysqlInstance, string, error) {
 			instance, err := GetMysqlInstance(ctx, config, id)
-			mysqlInstance = instance
 			if err != nil {
-				return 0, "", err
+				return nil, "", err
 			}
 
 			status := instance.CloudMysqlInstanceStatus.Code
@@ -676,13 +754,13 @@ func waitMysqlCreation(ctx context.Context, config *conn.ProviderConfig, id stri
 				return instance, RUNNING, nil
 			}
 
-			return 0, "", fmt.Errorf("error occurred while waiting to create mysql")
+			return nil, "", fmt.Errorf("error occurred while waiting to create mysql")
 		},
-		Timeout:    6 * conn.DefaultTimeout,
-		Delay:      3 * time.Minute,
-		MinTimeout: 3 * time.Second,
+		Timeout:     6 * conn.DefaultTimeout,
+		Delay:       3 * time.Minute,
+		MinInterval: 3 * time.Second,
 	}
-	_, err := stateConf.WaitForState()
+	mysqlInstance, err := stateConf.WaitForState(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("error waiting for MysqlInstance state to be \"CREAT\": %s", err)
 	}
@@ -691,10 +769,10 @@ func waitMysqlCreation(ctx context.Context, config *conn.ProviderConfig, id stri
 }
 
 func waitMysqlDeletion(ctx context.Context, config *conn.ProviderConfig, id string) error {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &waiter.Config[any]{
 		Pending: []string{DELETING},
 		Target:  []string{DELETED},
-		Refresh: func() (interface{}, string, error) {
+		Refresh: func(ctx context.Context) (any, string, error) {
 			instance, err := GetMysqlInstance(ctx, config, id)
 			if err != nil {
 				return 0, "", err
@@ -713,12 +791,12 @@ func waitMysqlDeletion(ctx context.Context, config *conn.ProviderConfig, id stri
 
 			return 0, "", fmt.Errorf("error occurred while waiting to delete mysql")
 		},
-		Timeout:    conn.DefaultTimeout,
-		Delay:      1 * time.Minute,
-		MinTimeout: 3 * time.Second,
+		Timeout:     conn.DefaultTimeout,
+		Delay:       1 * time.Minute,
+		MinInterval: 3 * time.Second,
 	}
 
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForState(ctx); err != nil {
 		return fmt.Errorf("error waiting for mysql (%s) to become termintaing: %s", id, err)
 	}
 
@@ -854,8 +932,3 @@ func listValueFromMysqlServerList(ctx context.Context, serverInatances []*vmysql
 
 	return types.ListValueFrom(ctx, types.ObjectType{AttrTypes: mysqlServer{}.attrTypes()}, serverList)
 }
-
-// If the lookup result is 0 or already deleted, it will respond with a 400 error with a 5001017 return code.
-func CheckIfAlreadyDeleted(err error) bool {
-	return strings.Contains(err.Error(), "5001017")
-}
@@ -20,10 +20,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/framework"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/paging"
 )
 
 var (
@@ -155,14 +155,14 @@ func (r *mysqlDatabasesResource) Create(ctx context.Context, req resource.Create
 		CloudMysqlDatabaseNameList: convertToStringList(plan.MysqlDatabaseList),
 	}
 
-	tflog.Info(ctx, "CreateMysqlDatabaseList reqParams="+common.MarshalUncheckedString(reqParams))
+	ctx = common.LogCommonRequest(ctx, "CreateMysqlDatabaseList", reqParams)
 
 	response, err := r.config.Client.Vmysql.V2Api.AddCloudMysqlDatabaseList(reqParams)
 	if err != nil {
 		resp.Diagnostics.AddError("CREATING ERROR", err.Error())
 		return
 	}
-	tflog.Info(ctx, "CreateMysqlDatabaseList response="+common.MarshalUncheckedString(response))
+	common.LogResponse(ctx, "CreateMysqlDatabaseList", response)
 
 	if response == nil || *response.ReturnCode != "0" {
 		resp.Diagnostics.AddError("CREATING ERROR", "response invalid")
@@ -243,44 +243,38 @@ func (r *mysqlDatabasesResource) Delete(ctx context.Context, req resource.Delete
 		CloudMysqlInstanceNo:       state.MysqlInstanceNo.ValueStringPointer(),
 		CloudMysqlDatabaseNameList: convertToStringList(state.MysqlDatabaseList),
 	}
-	tflog.Info(ctx, "DeleteMysqlDatabaseList reqParams="+common.MarshalUncheckedString(reqParams))
+	ctx = common.LogCommonRequest(ctx, "DeleteMysqlDatabaseList", reqParams)
 
 	response, err := r.config.Client.Vmysql.V2Api.DeleteCloudMysqlDatabaseList(reqParams)
 	if err != nil {
 		resp.Diagnostics.AddError("DELETING ERROR", err.Error())
 		return
 	}
-	tflog.Info(ctx, "DeleteMysqlDatabaseList response="+common.MarshalUncheckedString(response))
+	common.LogResponse(ctx, "DeleteMysqlDatabaseList", response)
 }
 
 func GetMysqlDatabaseList(ctx context.Context, config *conn.ProviderConfig, id string, dbs []string) ([]*vmysql.CloudMysqlDatabase, error) {
-	var allDbs []*vmysql.CloudMysqlDatabase
-	pageNo := int32(0)
-	pageSize := int32(100)
-	hasMore := true
-
-	for hasMore {
-		reqParams := &vmysql.GetCloudMysqlDatabaseListRequest{
-			RegionCode:           &config.RegionCode,
-			CloudMysqlInstanceNo: ncloud.String(id),
-			PageNo:               ncloud.Int32(pageNo),
-			PageSize:             ncloud.Int32(pageSize),
-		}
-		tflog.Info(ctx, "GetMysqlDatabaseList reqParams="+common.MarshalUncheckedString(reqParams))
-
-		resp, err := config.Client.Vmysql.V2Api.GetCloudMysqlDatabaseList(reqParams)
-		if err != nil {
-			return nil, err
-		}
-
-		if resp == nil {
-			break
-		}
+	reqParams := &vmysql.GetCloudMysqlDatabaseListRequest{
+		RegionCode:           &config.RegionCode,
+		CloudMysqlInstanceNo: ncloud.String(id),
+	}
 
-		allDbs = append(allDbs, resp.CloudMysqlDatabaseList...)
+	allDbs, err := paging.Pager[*vmysql.CloudMysqlDatabase]{
+		ZeroBased: true,
+		Page: func(ctx context.Context, pageNo, pageSize int32) ([]*vmysql.CloudMysqlDatabase, *int32, error) {
+			reqParams.PageNo = ncloud.Int32(pageNo)
+			reqParams.PageSize = ncloud.Int32(pageSize)
+			common.LogCommonRequest(ctx, "GetMysqlDatabaseList", reqParams)
 
-		hasMore = len(resp.CloudMysqlDatabaseList) == int(pageSize)
-		pageNo++
+			resp, err := config.Client.Vmysql.V2Api.GetCloudMysqlDatabaseList(reqParams)
+			if err != nil {
+				return nil, nil, err
+			}
+			return resp.CloudMysqlDatabaseList, resp.TotalRows, nil
+		},
+	}.All(ctx)
+	if err != nil {
+		return nil, err
 	}
 
 	dbMap := make(map[string]*vmysql.CloudMysqlDatabase)
@@ -301,7 +295,7 @@ func GetMysqlDatabaseList(ctx context.Context, config *conn.ProviderConfig, id s
 		return nil, nil
 	}
 
-	tflog.Info(ctx, "GetMysqlDatabaseList response="+common.MarshalUncheckedString(filteredDbs))
+	common.LogResponse(ctx, "GetMysqlDatabaseList", filteredDbs)
 
 	return filteredDbs, nil
 }
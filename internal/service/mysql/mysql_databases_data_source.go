@@ -12,9 +12,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/paging"
 )
 
 var (
@@ -134,40 +134,34 @@ func (d *mysqlDatabasesDataSource) Read(ctx context.Context, req datasource.Read
 }
 
 func GetMysqlDatabaseAllList(ctx context.Context, config *conn.ProviderConfig, id string) ([]*vmysql.CloudMysqlDatabase, error) {
-	var allDbs []*vmysql.CloudMysqlDatabase
-	pageNo := int32(0)
-	pageSize := int32(100)
-	hasMore := true
-
-	for hasMore {
-		reqParams := &vmysql.GetCloudMysqlDatabaseListRequest{
-			RegionCode:           &config.RegionCode,
-			CloudMysqlInstanceNo: ncloud.String(id),
-			PageNo:               ncloud.Int32(pageNo),
-			PageSize:             ncloud.Int32(pageSize),
-		}
-		tflog.Info(ctx, "GetMysqlDatabaseList reqParams="+common.MarshalUncheckedString(reqParams))
-
-		resp, err := config.Client.Vmysql.V2Api.GetCloudMysqlDatabaseList(reqParams)
-		if err != nil {
-			return nil, err
-		}
-
-		if resp == nil {
-			break
-		}
-
-		allDbs = append(allDbs, resp.CloudMysqlDatabaseList...)
-
-		hasMore = len(resp.CloudMysqlDatabaseList) == int(pageSize)
-		pageNo++
+	reqParams := &vmysql.GetCloudMysqlDatabaseListRequest{
+		RegionCode:           &config.RegionCode,
+		CloudMysqlInstanceNo: ncloud.String(id),
+	}
+
+	allDbs, err := paging.Pager[*vmysql.CloudMysqlDatabase]{
+		ZeroBased: true,
+		Page: func(ctx context.Context, pageNo, pageSize int32) ([]*vmysql.CloudMysqlDatabase, *int32, error) {
+			reqParams.PageNo = ncloud.Int32(pageNo)
+			reqParams.PageSize = ncloud.Int32(pageSize)
+			common.LogCommonRequest(ctx, "GetMysqlDatabaseList", reqParams)
+
+			resp, err := config.Client.Vmysql.V2Api.GetCloudMysqlDatabaseList(reqParams)
+			if err != nil {
+				return nil, nil, err
+			}
+			return resp.CloudMysqlDatabaseList, resp.TotalRows, nil
+		},
+	}.All(ctx)
+	if err != nil {
+		return nil, err
 	}
 
 	if len(allDbs) == 0 {
 		return nil, nil
 	}
 
-	tflog.Info(ctx, "GetMysqlDatabaseList response="+common.MarshalUncheckedString(allDbs))
+	common.LogResponse(ctx, "GetMysqlDatabaseList", allDbs)
 
 	return allDbs, nil
 }
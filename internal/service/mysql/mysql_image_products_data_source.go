@@ -9,7 +9,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
 )
@@ -109,24 +108,18 @@ func (m *mysqlImageProductsDataSource) Read(ctx context.Context, req datasource.
 		return
 	}
 
-	reqParams := &vmysql.GetCloudMysqlImageProductListRequest{
-		RegionCode: &m.config.RegionCode,
-	}
-	tflog.Info(ctx, "GetMysqlImageProductList reqParams="+common.MarshalUncheckedString(reqParams))
-
-	mysqlImageProductResp, err := m.config.Client.Vmysql.V2Api.GetCloudMysqlImageProductList(reqParams)
+	imageProducts, err := getMysqlImageProductList(ctx, m.config)
 	if err != nil {
 		resp.Diagnostics.AddError("READING ERROR", err.Error())
 		return
 	}
-	tflog.Info(ctx, "GetMysqlImageProductList response="+common.MarshalUncheckedString(mysqlImageProductResp))
 
-	if mysqlImageProductResp == nil || len(mysqlImageProductResp.ProductList) < 1 {
+	if len(imageProducts) < 1 {
 		resp.Diagnostics.AddError("READING ERROR", "no result.")
 		return
 	}
 
-	mysqlImageProductList := flattenMysqlImageProduct(mysqlImageProductResp.ProductList)
+	mysqlImageProductList := flattenMysqlImageProduct(imageProducts)
 	fillteredList := common.FilterModels(ctx, data.Filters, mysqlImageProductList)
 	data.refreshFromOutput(ctx, fillteredList)
 
@@ -142,6 +135,30 @@ func (m *mysqlImageProductsDataSource) Read(ctx context.Context, req datasource.
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
+func getMysqlImageProductList(ctx context.Context, config *conn.ProviderConfig) ([]*vmysql.CloudDbProduct, error) {
+	reqParams := &vmysql.GetCloudMysqlImageProductListRequest{
+		RegionCode: &config.RegionCode,
+	}
+	resp, err := conn.CachedLookup(config, conn.CatalogKey("vmysql.GetCloudMysqlImageProductList", reqParams), func() (*vmysql.GetCloudMysqlImageProductListResponse, error) {
+		ctx := common.LogCommonRequest(ctx, "GetMysqlImageProductList", reqParams)
+		resp, err := config.Client.Vmysql.V2Api.GetCloudMysqlImageProductList(reqParams)
+		if err != nil {
+			common.LogErrorResponse(ctx, "GetMysqlImageProductList", err, reqParams)
+			return nil, err
+		}
+		common.LogResponse(ctx, "GetMysqlImageProductList", resp)
+		return resp, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if resp == nil {
+		return nil, nil
+	}
+	return resp.ProductList, nil
+}
+
 func flattenMysqlImageProduct(list []*vmysql.CloudDbProduct) []*mysqlImageProduct {
 	var outputs []*mysqlImageProduct
 
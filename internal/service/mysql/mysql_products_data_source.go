@@ -2,7 +2,6 @@ package mysql
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"time"
 
@@ -11,7 +10,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
 )
@@ -45,6 +43,8 @@ func (m *mysqlProductsDataSource) Schema(ctx context.Context, req datasource.Sch
 			"output_file": schema.StringAttribute{
 				Optional: true,
 			},
+			"sort_by":     common.DataSourceSortByAttribute(),
+			"most_recent": common.DataSourceMostRecentAttribute(),
 			"product_list": schema.ListNestedAttribute{
 				Computed: true,
 				NestedObject: schema.NestedAttributeObject{
@@ -117,36 +117,24 @@ func (m *mysqlProductsDataSource) Read(ctx context.Context, req datasource.ReadR
 		return
 	}
 
-	reqParams := &vmysql.GetCloudMysqlProductListRequest{
-		RegionCode:                 &m.config.RegionCode,
-		CloudMysqlImageProductCode: data.CloudMysqlImageProductCode.ValueStringPointer(),
-	}
-	tflog.Info(ctx, "GetMysqlProductsList reqParams="+common.MarshalUncheckedString(reqParams))
-
-	mysqlProductResp, err := m.config.Client.Vmysql.V2Api.GetCloudMysqlProductList(reqParams)
+	products, err := getMysqlProductList(ctx, m.config, data.CloudMysqlImageProductCode.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("READING ERROR", err.Error())
 		return
 	}
-	tflog.Info(ctx, "GetMysqlProductsList response="+common.MarshalUncheckedString(mysqlProductResp))
 
-	if mysqlProductResp == nil || len(mysqlProductResp.ProductList) < 1 {
+	if len(products) < 1 {
 		resp.Diagnostics.AddError("READING ERROR", "no result.")
 		return
 	}
 
-	mysqlProductList := flattenMysqlProduct(mysqlProductResp.ProductList)
-	fillteredList := common.FilterModels(ctx, data.Filters, mysqlProductList)
+	mysqlProductList := flattenMysqlProduct(products)
+	fillteredList := common.QueryModels(ctx, data.Filters, data.SortBy, data.MostRecent, mysqlProductList)
 
 	data.refreshFromOutput(ctx, fillteredList)
 
 	if !data.OutputFile.IsNull() && data.OutputFile.String() != "" {
-		outputPath := data.OutputFile.ValueString()
-
-		if convertedList, err := convertProductsToJsonStruct(data.ProductList.Elements()); err != nil {
-			resp.Diagnostics.AddError("OUTPUT FILE ERROR", err.Error())
-			return
-		} else if err := common.WriteToFile(outputPath, convertedList); err != nil {
+		if err := common.WriteListToFile(ctx, data.OutputFile.ValueString(), data.ProductList); err != nil {
 			resp.Diagnostics.AddError("OUTPUT FILE ERROR", err.Error())
 			return
 		}
@@ -155,18 +143,29 @@ func (m *mysqlProductsDataSource) Read(ctx context.Context, req datasource.ReadR
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func convertProductsToJsonStruct(images []attr.Value) ([]mysqlProductsToJsonConvert, error) {
-	var mysqlProductsToConvert = []mysqlProductsToJsonConvert{}
-
-	for _, image := range images {
-		imageJasn := mysqlProductsToJsonConvert{}
-		if err := json.Unmarshal([]byte(image.String()), &imageJasn); err != nil {
+func getMysqlProductList(ctx context.Context, config *conn.ProviderConfig, imageProductCode string) ([]*vmysql.CloudDbProduct, error) {
+	reqParams := &vmysql.GetCloudMysqlProductListRequest{
+		RegionCode:                 &config.RegionCode,
+		CloudMysqlImageProductCode: &imageProductCode,
+	}
+	resp, err := conn.CachedLookup(config, conn.CatalogKey("vmysql.GetCloudMysqlProductList", reqParams), func() (*vmysql.GetCloudMysqlProductListResponse, error) {
+		ctx := common.LogCommonRequest(ctx, "GetMysqlProductsList", reqParams)
+		resp, err := config.Client.Vmysql.V2Api.GetCloudMysqlProductList(reqParams)
+		if err != nil {
+			common.LogErrorResponse(ctx, "GetMysqlProductsList", err, reqParams)
 			return nil, err
 		}
-		mysqlProductsToConvert = append(mysqlProductsToConvert, imageJasn)
+		common.LogResponse(ctx, "GetMysqlProductsList", resp)
+		return resp, nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return mysqlProductsToConvert, nil
+	if resp == nil {
+		return nil, nil
+	}
+	return resp.ProductList, nil
 }
 
 func flattenMysqlProduct(list []*vmysql.CloudDbProduct) []*mysqlProductModel {
@@ -192,6 +191,8 @@ type mysqlProductList struct {
 	CloudMysqlImageProductCode types.String `tfsdk:"image_product_code"`
 	ProductList                types.List   `tfsdk:"product_list"`
 	OutputFile                 types.String `tfsdk:"output_file"`
+	SortBy                     types.String `tfsdk:"sort_by"`
+	MostRecent                 types.Bool   `tfsdk:"most_recent"`
 	Filters                    types.Set    `tfsdk:"filter"`
 }
 
@@ -205,16 +206,6 @@ type mysqlProductModel struct {
 	MemorySize         types.Int64  `tfsdk:"memory_size"`
 	DiskType           types.String `tfsdk:"disk_type"`
 }
-type mysqlProductsToJsonConvert struct {
-	ProductCode        string `json:"product_code"`
-	ProductName        string `json:"product_name"`
-	ProductType        string `json:"product_type"`
-	ProductDescription string `json:"product_description"`
-	InfraResourceType  string `json:"infra_resource_type"`
-	CpuCount           int    `json:"cpu_count"`
-	MemorySize         int    `json:"memory_size"`
-	DiskType           string `json:"disk_type"`
-}
 
 func (m mysqlProductModel) attrTypes() map[string]attr.Type {
 	return map[string]attr.Type{
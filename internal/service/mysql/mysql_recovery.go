@@ -18,11 +18,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/framework"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/waiter"
 )
 
 var (
@@ -230,14 +229,14 @@ func (r *mysqlRecoveryResource) Create(ctx context.Context, req resource.CreateR
 		reqParams.SubnetNo = plan.SubnetNo.ValueStringPointer()
 	}
 
-	tflog.Info(ctx, "CreateMysqlRecovery reqParams="+common.MarshalUncheckedString(reqParams))
+	ctx = common.LogCommonRequest(ctx, "CreateMysqlRecovery", reqParams)
 
 	response, err := r.config.Client.Vmysql.V2Api.CreateCloudMysqlRecoveryInstance(reqParams)
 	if err != nil {
 		resp.Diagnostics.AddError("CREATING ERROR", err.Error())
 		return
 	}
-	tflog.Info(ctx, "CreateMysqlRecovery response="+common.MarshalUncheckedString(response))
+	common.LogResponse(ctx, "CreateMysqlRecovery", response)
 
 	if response == nil || len(response.CloudMysqlInstanceList) < 1 {
 		resp.Diagnostics.AddError("CREATING ERROR", "response valid")
@@ -311,14 +310,14 @@ func (r *mysqlRecoveryResource) Delete(ctx context.Context, req resource.DeleteR
 		RegionCode:                 &r.config.RegionCode,
 		CloudMysqlServerInstanceNo: state.ID.ValueStringPointer(),
 	}
-	tflog.Info(ctx, "DeleteMysqlRecovery reqParams="+common.MarshalUncheckedString(reqParams))
+	ctx = common.LogCommonRequest(ctx, "DeleteMysqlRecovery", reqParams)
 
 	response, err := r.config.Client.Vmysql.V2Api.DeleteCloudMysqlServerInstance(reqParams)
 	if err != nil {
 		resp.Diagnostics.AddError("DELETING ERROR", err.Error())
 		return
 	}
-	tflog.Info(ctx, "DeleteMysqlRecovery response="+common.MarshalUncheckedString(response))
+	common.LogResponse(ctx, "DeleteMysqlRecovery", response)
 
 	if err := waitMysqlRecoveryDeletion(ctx, r.config, state.MysqlInstanceNo.ValueString(), state.ID.ValueString()); err != nil {
 		resp.Diagnostics.AddError("WAITING FOR DELETE ERROR", err.Error())
@@ -327,10 +326,10 @@ func (r *mysqlRecoveryResource) Delete(ctx context.Context, req resource.DeleteR
 }
 
 func waitMysqlRecoveryDeletion(ctx context.Context, config *conn.ProviderConfig, instanceNo string, serverInstanceNo string) error {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &waiter.Config[any]{
 		Pending: []string{DELETING},
 		Target:  []string{DELETED},
-		Refresh: func() (interface{}, string, error) {
+		Refresh: func(ctx context.Context) (any, string, error) {
 			instance, err := GetMysqlRecovery(ctx, config, instanceNo, serverInstanceNo)
 			if err != nil {
 				return 0, "", err
@@ -348,12 +347,12 @@ func waitMysqlRecoveryDeletion(ctx context.Context, config *conn.ProviderConfig,
 
 			return 0, "", fmt.Errorf("error occurred while waiting to delete mysql recovery")
 		},
-		Timeout:    conn.DefaultTimeout,
-		Delay:      1 * time.Minute,
-		MinTimeout: 3 * time.Second,
+		Timeout:     conn.DefaultTimeout,
+		Delay:       1 * time.Minute,
+		MinInterval: 3 * time.Second,
 	}
 
-	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+	if _, err := stateConf.WaitForState(ctx); err != nil {
 		return fmt.Errorf("error waiting for mysql recovery (%s) to become terminating: %s", serverInstanceNo, err)
 	}
 
@@ -365,13 +364,13 @@ func GetMysqlRecovery(ctx context.Context, config *conn.ProviderConfig, instance
 		RegionCode:           &config.RegionCode,
 		CloudMysqlInstanceNo: ncloud.String(instanceNo),
 	}
-	tflog.Info(ctx, "GetMysqlDetail reqParams="+common.MarshalUncheckedString(reqParams))
+	ctx = common.LogCommonRequest(ctx, "GetMysqlDetail", reqParams)
 
 	resp, err := config.Client.Vmysql.V2Api.GetCloudMysqlInstanceDetail(reqParams)
-	if err != nil && !CheckIfAlreadyDeleted(err) {
+	if err != nil && !common.IsNotFound(err) {
 		return nil, err
 	}
-	tflog.Info(ctx, "GetMysqlDetail response="+common.MarshalUncheckedString(resp))
+	common.LogResponse(ctx, "GetMysqlDetail", resp)
 
 	if resp == nil || len(resp.CloudMysqlInstanceList) < 1 {
 		return nil, nil
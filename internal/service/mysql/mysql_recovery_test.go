@@ -9,7 +9,6 @@ import (
 	"time"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vmysql"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -20,7 +19,7 @@ import (
 
 func TestAccResourceNcloudMysqlRecovery_vpc_basic(t *testing.T) {
 	var mysqlServerInstance vmysql.CloudMysqlServerInstance
-	testName := fmt.Sprintf("tf-mysqlsv-%s", acctest.RandString(5))
+	testName := fmt.Sprintf("tf-mysqlsv-%s", RandString(t, 5))
 	resourceName := "ncloud_mysql_recovery.mysql_recovery"
 	testDate := time.Now().Format("20060102")
 
@@ -15,11 +15,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/framework"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/waiter"
 )
 
 var (
@@ -180,14 +179,14 @@ func (r *mysqlSlaveResource) Create(ctx context.Context, req resource.CreateRequ
 		reqParams.SubnetNo = plan.SubnetNo.ValueStringPointer()
 	}
 
-	tflog.Info(ctx, "CreateCloudMysqlSlave reqParams="+common.MarshalUncheckedString(reqParams))
+	ctx = common.LogCommonRequest(ctx, "CreateCloudMysqlSlave", reqParams)
 
 	response, err := r.config.Client.Vmysql.V2Api.CreateCloudMysqlSlaveInstance(reqParams)
 	if err != nil {
 		resp.Diagnostics.AddError("CREATING ERROR", err.Error())
 		return
 	}
-	tflog.Info(ctx, "CreateCloudMysqlSlave response="+common.MarshalUncheckedString(response))
+	common.LogResponse(ctx, "CreateCloudMysqlSlave", response)
 
 	if response == nil || len(response.CloudMysqlInstanceList) < 1 {
 		resp.Diagnostics.AddError("CREATING ERROR", "response valid")
@@ -261,14 +260,14 @@ func (r *mysqlSlaveResource) Delete(ctx context.Context, req resource.DeleteRequ
 		RegionCode:                 &r.config.RegionCode,
 		CloudMysqlServerInstanceNo: state.ID.ValueStringPointer(),
 	}
-	tflog.Info(ctx, "DeleteMysqlSlave reqParams="+common.MarshalUncheckedString(reqParams))
+	ctx = common.LogCommonRequest(ctx, "DeleteMysqlSlave", reqParams)
 
 	response, err := r.config.Client.Vmysql.V2Api.DeleteCloudMysqlServerInstance(reqParams)
 	if err != nil {
 		resp.Diagnostics.AddError("DELETING ERROR", err.Error())
 		return
 	}
-	tflog.Info(ctx, "DeleteMysqlSlave response="+common.MarshalUncheckedString(response))
+	common.LogResponse(ctx, "DeleteMysqlSlave", response)
 
 	if err := waitMysqlSlaveDeletion(ctx, r.config, state.MysqlInstanceNo.ValueString(), state.ID.ValueString()); err != nil {
 		resp.Diagnostics.AddError("WAITING FOR DELETE ERROR", err.Error())
@@ -278,10 +277,10 @@ func (r *mysqlSlaveResource) Delete(ctx context.Context, req resource.DeleteRequ
 
 func waitMysqlServerCreation(ctx context.Context, config *conn.ProviderConfig, instanceNo string, index int) ([]*vmysql.CloudMysqlServerInstance, error) {
 	var mysqlInstance []*vmysql.CloudMysqlServerInstance
-	stateConf := &retry.StateChangeConf{
+	stateConf := &waiter.Config[any]{
 		Pending: []string{CREATING, SETTING},
 		Target:  []string{RUNNING},
-		Refresh: func() (interface{}, string, error) {
+		Refresh: func(ctx context.Context) (any, string, error) {
 			instance, err := findMysqlServerByIndex(ctx, config, instanceNo, index)
 			mysqlInstance = instance
 			if err != nil {
@@ -299,12 +298,12 @@ func waitMysqlServerCreation(ctx context.Context, config *conn.ProviderConfig, i
 
 			return 0, "", fmt.Errorf("error occurred while waiting to create mysql slave")
 		},
-		Timeout:    6 * conn.DefaultTimeout,
-		Delay:      3 * time.Minute,
-		MinTimeout: 3 * time.Second,
+		Timeout:     6 * conn.DefaultTimeout,
+		Delay:       3 * time.Minute,
+		MinInterval: 3 * time.Second,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := stateConf.WaitForState(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("error waiting for mysql slave state to be \"running\": %s", err)
 	}
@@ -313,10 +312,10 @@ func waitMysqlServerCreation(ctx context.Context, config *conn.ProviderConfig, i
 }
 
 func waitMysqlSlaveDeletion(ctx context.Context, config *conn.ProviderConfig, instanceNo string, serverInstanceNo string) error {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &waiter.Config[any]{
 		Pending: []string{DELETING},
 		Target:  []string{DELETED},
-		Refresh: func() (interface{}, string, error) {
+		Refresh: func(ctx context.Context) (any, string, error) {
 			instance, err := GetMysqlSlave(ctx, config, instanceNo, serverInstanceNo)
 			if err != nil {
 				return 0, "", err
@@ -334,12 +333,12 @@ func waitMysqlSlaveDeletion(ctx context.Context, config *conn.ProviderConfig, in
 
 			return 0, "", fmt.Errorf("error occurred while waiting to delete mysql slave")
 		},
-		Timeout:    conn.DefaultTimeout,
-		Delay:      1 * time.Minute,
-		MinTimeout: 3 * time.Second,
+		Timeout:     conn.DefaultTimeout,
+		Delay:       1 * time.Minute,
+		MinInterval: 3 * time.Second,
 	}
 
-	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+	if _, err := stateConf.WaitForState(ctx); err != nil {
 		return fmt.Errorf("error waiting for mysql slave (%s) to become terminating: %s", serverInstanceNo, err)
 	}
 
@@ -351,13 +350,13 @@ func findMysqlServerByIndex(ctx context.Context, config *conn.ProviderConfig, in
 		RegionCode:           &config.RegionCode,
 		CloudMysqlInstanceNo: ncloud.String(instanceNo),
 	}
-	tflog.Info(ctx, "GetMysqlDetail reqParams="+common.MarshalUncheckedString(reqParams))
+	ctx = common.LogCommonRequest(ctx, "GetMysqlDetail", reqParams)
 
 	resp, err := config.Client.Vmysql.V2Api.GetCloudMysqlInstanceDetail(reqParams)
-	if err != nil && !CheckIfAlreadyDeleted(err) {
+	if err != nil && !common.IsNotFound(err) {
 		return nil, err
 	}
-	tflog.Info(ctx, "GetMysqlDetail response="+common.MarshalUncheckedString(resp))
+	common.LogResponse(ctx, "GetMysqlDetail", resp)
 
 	if resp == nil || len(resp.CloudMysqlInstanceList) < 1 {
 		return nil, fmt.Errorf("response is nil")
@@ -378,13 +377,13 @@ func GetMysqlSlave(ctx context.Context, config *conn.ProviderConfig, instanceNo
 		RegionCode:           &config.RegionCode,
 		CloudMysqlInstanceNo: ncloud.String(instanceNo),
 	}
-	tflog.Info(ctx, "GetMysqlDetail reqParams="+common.MarshalUncheckedString(reqParams))
+	ctx = common.LogCommonRequest(ctx, "GetMysqlDetail", reqParams)
 
 	resp, err := config.Client.Vmysql.V2Api.GetCloudMysqlInstanceDetail(reqParams)
-	if err != nil && !CheckIfAlreadyDeleted(err) {
+	if err != nil && !common.IsNotFound(err) {
 		return nil, err
 	}
-	tflog.Info(ctx, "GetMysqlDetail response="+common.MarshalUncheckedString(resp))
+	common.LogResponse(ctx, "GetMysqlDetail", resp)
 
 	if resp == nil || len(resp.CloudMysqlInstanceList) < 1 {
 		return nil, nil
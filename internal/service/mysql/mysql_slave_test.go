@@ -7,18 +7,18 @@ import (
 	"testing"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vmysql"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/acctest"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
 	mysqlservice "github.com/terraform-providers/terraform-provider-ncloud/internal/service/mysql"
 )
 
 func TestAccResourceNcloudMysqlSlave_vpc_basic(t *testing.T) {
 	var mysqlServerInstance vmysql.CloudMysqlServerInstance
-	testName := fmt.Sprintf("tf-mysqlsv-%s", acctest.RandString(5))
+	testName := fmt.Sprintf("tf-mysqlsv-%s", RandString(t, 5))
 	resourceName := "ncloud_mysql_slave.mysql_slave"
 
 	resource.Test(t, resource.TestCase{
@@ -109,7 +109,7 @@ func testAccCheckMysqlSlaveDestroy(s *terraform.State) error {
 			continue
 		}
 		instance, err := mysqlservice.GetMysqlSlave(context.Background(), config, rs.Primary.Attributes["mysql_instance_no"], rs.Primary.Attributes["id"])
-		if err != nil && !mysqlservice.CheckIfAlreadyDeleted(err) {
+		if err != nil && !common.IsNotFound(err) {
 			return nil
 		}
 
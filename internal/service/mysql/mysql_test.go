@@ -8,18 +8,18 @@ import (
 	"testing"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vmysql"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/acctest"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
 	mysqlservice "github.com/terraform-providers/terraform-provider-ncloud/internal/service/mysql"
 )
 
 func TestAccResourceNcloudMysql_vpc_basic(t *testing.T) {
 	var mysqlInstance vmysql.CloudMysqlInstance
-	testMysqlName := fmt.Sprintf("tf-mysql-%s", acctest.RandString(5))
+	testMysqlName := fmt.Sprintf("tf-mysql-%s", RandString(t, 5))
 	resourceName := "ncloud_mysql.mysql"
 
 	resource.Test(t, resource.TestCase{
@@ -51,7 +51,7 @@ func TestAccResourceNcloudMysql_vpc_basic(t *testing.T) {
 
 func TestAccResourceNcloudMysql_vpc_isHa(t *testing.T) {
 	var mysqlInstance vmysql.CloudMysqlInstance
-	testMysqlName := fmt.Sprintf("tf-mysql-%s", acctest.RandString(5))
+	testMysqlName := fmt.Sprintf("tf-mysql-%s", RandString(t, 5))
 	resourceName := "ncloud_mysql.mysql"
 
 	resource.Test(t, resource.TestCase{
@@ -76,7 +76,7 @@ func TestAccResourceNcloudMysql_vpc_isHa(t *testing.T) {
 
 func TestAccResourceNcloudMysql_vpc_isHa_options(t *testing.T) {
 	var mysqlInstance vmysql.CloudMysqlInstance
-	testMysqlName := fmt.Sprintf("tf-mysql-%s", acctest.RandString(5))
+	testMysqlName := fmt.Sprintf("tf-mysql-%s", RandString(t, 5))
 	resourceName := "ncloud_mysql.mysql"
 
 	resource.Test(t, resource.TestCase{
@@ -101,7 +101,7 @@ func TestAccResourceNcloudMysql_vpc_isHa_options(t *testing.T) {
 
 func TestAccResourceNcloudMysql_vpc_auto_backup(t *testing.T) {
 	var mysqlInstance vmysql.CloudMysqlInstance
-	testMysqlName := fmt.Sprintf("tf-mysql-%s", acctest.RandString(5))
+	testMysqlName := fmt.Sprintf("tf-mysql-%s", RandString(t, 5))
 	resourceName := "ncloud_mysql.mysql"
 
 	resource.Test(t, resource.TestCase{
@@ -125,7 +125,7 @@ func TestAccResourceNcloudMysql_vpc_auto_backup(t *testing.T) {
 
 func TestAccResourceNcloudMysql_vpc_not_auto_backup(t *testing.T) {
 	var mysqlInstance vmysql.CloudMysqlInstance
-	testMysqlName := fmt.Sprintf("tf-mysql-%s", acctest.RandString(5))
+	testMysqlName := fmt.Sprintf("tf-mysql-%s", RandString(t, 5))
 	resourceName := "ncloud_mysql.mysql"
 
 	resource.Test(t, resource.TestCase{
@@ -149,7 +149,7 @@ func TestAccResourceNcloudMysql_vpc_not_auto_backup(t *testing.T) {
 }
 
 func TestAccResourceNcloudMysql_error_case(t *testing.T) {
-	testMysqlName := fmt.Sprintf("tf-mysql-%s", acctest.RandString(5))
+	testMysqlName := fmt.Sprintf("tf-mysql-%s", RandString(t, 5))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { TestAccPreCheck(t) },
@@ -218,7 +218,7 @@ func testAccCheckMysqlDestroy(s *terraform.State) error {
 			continue
 		}
 		instance, err := mysqlservice.GetMysqlInstance(context.Background(), config, rs.Primary.ID)
-		if err != nil && !mysqlservice.CheckIfAlreadyDeleted(err) {
+		if err != nil && !common.IsNotFound(err) {
 			return err
 		}
 
@@ -21,10 +21,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/framework"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/paging"
 )
 
 var (
@@ -197,7 +197,7 @@ func (r *mysqlUsersResource) Create(ctx context.Context, req resource.CreateRequ
 		resp.Diagnostics.AddError("CREATING ERROR", err.Error())
 		return
 	}
-	tflog.Info(ctx, "CreateMysqlUserList response="+common.MarshalUncheckedString(response))
+	common.LogResponse(ctx, "CreateMysqlUserList", response)
 
 	if response == nil || *response.ReturnCode != "0" {
 		resp.Diagnostics.AddError("CREATING ERROR", "response invalid")
@@ -275,7 +275,7 @@ func (r *mysqlUsersResource) Update(ctx context.Context, req resource.UpdateRequ
 			resp.Diagnostics.AddError("UPDATE ERROR", err.Error())
 			return
 		}
-		tflog.Info(ctx, "ChangeCloudMysqlUserList response="+common.MarshalUncheckedString(response))
+		common.LogResponse(ctx, "ChangeCloudMysqlUserList", response)
 
 		if response == nil || *response.ReturnCode != "0" {
 			resp.Diagnostics.AddError("UPDATE ERROR", "response invalid")
@@ -327,44 +327,38 @@ func (r *mysqlUsersResource) Delete(ctx context.Context, req resource.DeleteRequ
 		CloudMysqlInstanceNo: state.MysqlInstanceNo.ValueStringPointer(),
 		CloudMysqlUserList:   convertToCloudMysqlUserKeyParameter(state.MysqlUserList),
 	}
-	tflog.Info(ctx, "DeleteMysqlUserList reqParams="+common.MarshalUncheckedString(reqParams))
+	ctx = common.LogCommonRequest(ctx, "DeleteMysqlUserList", reqParams)
 
 	response, err := r.config.Client.Vmysql.V2Api.DeleteCloudMysqlUserList(reqParams)
 	if err != nil {
 		resp.Diagnostics.AddError("DELETING ERROR", err.Error())
 		return
 	}
-	tflog.Info(ctx, "DeleteMysqlUserList response="+common.MarshalUncheckedString(response))
+	common.LogResponse(ctx, "DeleteMysqlUserList", response)
 }
 
 func GetMysqlUserList(ctx context.Context, config *conn.ProviderConfig, id string, users []string) ([]*vmysql.CloudMysqlUser, error) {
-	var allUsers []*vmysql.CloudMysqlUser
-	pageNo := int32(0)
-	pageSize := int32(100)
-	hasMore := true
-
-	for hasMore {
-		reqParams := &vmysql.GetCloudMysqlUserListRequest{
-			RegionCode:           &config.RegionCode,
-			CloudMysqlInstanceNo: ncloud.String(id),
-			PageNo:               ncloud.Int32(pageNo),
-			PageSize:             ncloud.Int32(pageSize),
-		}
-		tflog.Info(ctx, "GetMysqlUserList reqParams="+common.MarshalUncheckedString(reqParams))
-
-		resp, err := config.Client.Vmysql.V2Api.GetCloudMysqlUserList(reqParams)
-		if err != nil {
-			return nil, err
-		}
-
-		if resp == nil {
-			break
-		}
+	reqParams := &vmysql.GetCloudMysqlUserListRequest{
+		RegionCode:           &config.RegionCode,
+		CloudMysqlInstanceNo: ncloud.String(id),
+	}
 
-		allUsers = append(allUsers, resp.CloudMysqlUserList...)
+	allUsers, err := paging.Pager[*vmysql.CloudMysqlUser]{
+		ZeroBased: true,
+		Page: func(ctx context.Context, pageNo, pageSize int32) ([]*vmysql.CloudMysqlUser, *int32, error) {
+			reqParams.PageNo = ncloud.Int32(pageNo)
+			reqParams.PageSize = ncloud.Int32(pageSize)
+			common.LogCommonRequest(ctx, "GetMysqlUserList", reqParams)
 
-		hasMore = len(resp.CloudMysqlUserList) == int(pageSize)
-		pageNo++
+			resp, err := config.Client.Vmysql.V2Api.GetCloudMysqlUserList(reqParams)
+			if err != nil {
+				return nil, nil, err
+			}
+			return resp.CloudMysqlUserList, resp.TotalRows, nil
+		},
+	}.All(ctx)
+	if err != nil {
+		return nil, err
 	}
 
 	userMap := make(map[string]*vmysql.CloudMysqlUser)
@@ -385,7 +379,7 @@ func GetMysqlUserList(ctx context.Context, config *conn.ProviderConfig, id strin
 		return nil, nil
 	}
 
-	tflog.Info(ctx, "GetMysqlUserList response="+common.MarshalUncheckedString(filteredUsers))
+	common.LogResponse(ctx, "GetMysqlUserList", filteredUsers)
 
 	return filteredUsers, nil
 }
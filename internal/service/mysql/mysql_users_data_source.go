@@ -15,9 +15,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/paging"
 )
 
 var (
@@ -167,33 +167,27 @@ func (d *mysqlUsersDataSource) Read(ctx context.Context, req datasource.ReadRequ
 }
 
 func GetMysqlUserAllList(ctx context.Context, config *conn.ProviderConfig, id string) ([]*vmysql.CloudMysqlUser, error) {
-	var allUsers []*vmysql.CloudMysqlUser
-	pageNo := int32(0)
-	pageSize := int32(100)
-	hasMore := true
-
-	for hasMore {
-		reqParams := &vmysql.GetCloudMysqlUserListRequest{
-			RegionCode:           &config.RegionCode,
-			CloudMysqlInstanceNo: ncloud.String(id),
-			PageNo:               ncloud.Int32(pageNo),
-			PageSize:             ncloud.Int32(pageSize),
-		}
-		tflog.Info(ctx, "GetMysqlUserList reqParams="+common.MarshalUncheckedString(reqParams))
-
-		resp, err := config.Client.Vmysql.V2Api.GetCloudMysqlUserList(reqParams)
-		if err != nil {
-			return nil, err
-		}
-
-		if resp == nil {
-			break
-		}
-
-		allUsers = append(allUsers, resp.CloudMysqlUserList...)
+	reqParams := &vmysql.GetCloudMysqlUserListRequest{
+		RegionCode:           &config.RegionCode,
+		CloudMysqlInstanceNo: ncloud.String(id),
+	}
 
-		hasMore = len(resp.CloudMysqlUserList) == int(pageSize)
-		pageNo++
+	allUsers, err := paging.Pager[*vmysql.CloudMysqlUser]{
+		ZeroBased: true,
+		Page: func(ctx context.Context, pageNo, pageSize int32) ([]*vmysql.CloudMysqlUser, *int32, error) {
+			reqParams.PageNo = ncloud.Int32(pageNo)
+			reqParams.PageSize = ncloud.Int32(pageSize)
+			common.LogCommonRequest(ctx, "GetMysqlUserList", reqParams)
+
+			resp, err := config.Client.Vmysql.V2Api.GetCloudMysqlUserList(reqParams)
+			if err != nil {
+				return nil, nil, err
+			}
+			return resp.CloudMysqlUserList, resp.TotalRows, nil
+		},
+	}.All(ctx)
+	if err != nil {
+		return nil, err
 	}
 
 	if len(allUsers) == 0 {
@@ -201,7 +195,7 @@ func GetMysqlUserAllList(ctx context.Context, config *conn.ProviderConfig, id st
 	}
 
 	reverseUsers := common.ReverseList(allUsers)
-	tflog.Info(ctx, "GetMysqlUserList response="+common.MarshalUncheckedString(reverseUsers))
+	common.LogResponse(ctx, "GetMysqlUserList", reverseUsers)
 
 	return reverseUsers, nil
 }
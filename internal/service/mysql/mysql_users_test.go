@@ -6,17 +6,17 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/acctest"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
 	mysqlservice "github.com/terraform-providers/terraform-provider-ncloud/internal/service/mysql"
 )
 
 func TestAccResourceNcloudMysqlUsers_vpc_basic_update(t *testing.T) {
-	testName := fmt.Sprintf("tf-mysqluser-%s", acctest.RandString(5))
+	testName := fmt.Sprintf("tf-mysqluser-%s", RandString(t, 5))
 	resourceName := "ncloud_mysql_users.mysql_users"
 	testUserBefore := "test"
 	testUserAfter := "testuser"
@@ -103,7 +103,7 @@ func testAccCheckMysqlUsersDestroy(s *terraform.State) error {
 		}
 
 		instance, err := mysqlservice.GetMysqlUserList(context.Background(), config, rs.Primary.ID, []string{"testuser1", "testuser2"})
-		if err != nil && !mysqlservice.CheckIfAlreadyDeleted(err) {
+		if err != nil && !common.IsNotFound(err) {
 			return err
 		}
 
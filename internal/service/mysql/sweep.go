@@ -0,0 +1,66 @@
+package mysql
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vmysql"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/paging"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/sweep"
+)
+
+// RegisterSweepers registers the sweepers of MySQL instances.
+// Slaves, recoveries, users and databases are deleted with their instance.
+func RegisterSweepers() {
+	sweep.AddTestSweepers("ncloud_mysql", &resource.Sweeper{
+		Name: "ncloud_mysql",
+		F:    sweepMysqls,
+	})
+
+	sweep.AddChildTestSweepers("ncloud_mysql",
+		"ncloud_mysql_databases",
+		"ncloud_mysql_recovery",
+		"ncloud_mysql_slave",
+		"ncloud_mysql_users",
+	)
+}
+
+func sweepMysqls(region string) error {
+	config, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+	if !config.SupportVPC {
+		return nil
+	}
+
+	cloudMysqlInstanceList, err := paging.Pager[*vmysql.CloudMysqlInstance]{
+		ZeroBased: true,
+		Page: func(ctx context.Context, pageNo, pageSize int32) ([]*vmysql.CloudMysqlInstance, *int32, error) {
+			resp, err := config.Client.Vmysql.V2Api.GetCloudMysqlInstanceList(&vmysql.GetCloudMysqlInstanceListRequest{RegionCode: &config.RegionCode, PageNo: ncloud.Int32(pageNo), PageSize: ncloud.Int32(pageSize)})
+			if err != nil {
+				return nil, nil, err
+			}
+			return resp.CloudMysqlInstanceList, resp.TotalRows, nil
+		},
+	}.All(context.Background())
+	if err != nil {
+		if sweep.SkipSweepError(err) {
+			return nil
+		}
+		return fmt.Errorf("listing MySQL instances: %w", err)
+	}
+
+	var sweepables []sweep.Sweepable
+	for _, i := range cloudMysqlInstanceList {
+		if !sweep.HasResourcePrefix(i.CloudMysqlServiceName) {
+			continue
+		}
+		sweepables = append(sweepables, sweep.NewFrameworkSweepResource(NewMysqlResource, config, *i.CloudMysqlInstanceNo, nil))
+	}
+
+	return sweep.SweepOrchestrator(context.Background(), sweepables)
+}
@@ -1,6 +1,7 @@
 package nasvolume
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -9,23 +10,24 @@ import (
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/waiter"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/zone"
 )
 
 func ResourceNcloudNasVolume() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNcloudNasVolumeCreate,
-		Read:   resourceNcloudNasVolumeRead,
-		Update: resourceNcloudNasVolumeUpdate,
-		Delete: resourceNcloudNasVolumeDelete,
+		CreateContext: resourceNcloudNasVolumeCreate,
+		ReadContext:   resourceNcloudNasVolumeRead,
+		UpdateContext: resourceNcloudNasVolumeUpdate,
+		DeleteContext: resourceNcloudNasVolumeDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(conn.DefaultCreateTimeout),
@@ -73,9 +75,10 @@ func ResourceNcloudNasVolume() *schema.Resource {
 				ForceNew: true,
 			},
 			"cifs_user_password": {
-				Type:     schema.TypeString,
-				Optional: true,
-				ForceNew: true,
+				Type:      schema.TypeString,
+				Optional:  true,
+				ForceNew:  true,
+				Sensitive: true,
 			},
 			"description": {
 				Type:             schema.TypeString,
@@ -131,26 +134,26 @@ func ResourceNcloudNasVolume() *schema.Resource {
 	}
 }
 
-func resourceNcloudNasVolumeCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudNasVolumeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*conn.ProviderConfig)
 
-	id, err := createNasVolume(d, config)
+	id, err := createNasVolume(ctx, d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(ncloud.StringValue(id))
 	log.Printf("[INFO] NAS Volume ID: %s", d.Id())
 
-	return resourceNcloudNasVolumeRead(d, meta)
+	return resourceNcloudNasVolumeRead(ctx, d, meta)
 }
 
-func resourceNcloudNasVolumeRead(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudNasVolumeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*conn.ProviderConfig)
 
-	r, err := GetNasVolume(config, d.Id())
+	r, err := GetNasVolume(ctx, config, d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if r == nil {
@@ -165,56 +168,56 @@ func resourceNcloudNasVolumeRead(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
-func resourceNcloudNasVolumeDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudNasVolumeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*conn.ProviderConfig)
 
-	if err := deleteNasVolume(d, config, d.Id()); err != nil {
-		return err
+	if err := deleteNasVolume(ctx, d, config, d.Id()); err != nil {
+		return diag.FromErr(err)
 	}
 
 	d.SetId("")
 	return nil
 }
 
-func resourceNcloudNasVolumeUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudNasVolumeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*conn.ProviderConfig)
 
 	if d.HasChange("volume_size") {
-		if err := changeNasVolumeSize(d, config); err != nil {
-			return err
+		if err := changeNasVolumeSize(ctx, d, config); err != nil {
+			return diag.FromErr(err)
 		}
 	}
 
 	if d.HasChange("server_instance_no_list") || d.HasChange("custom_ip_list") {
-		if err := setNasVolumeAccessControl(d, config); err != nil {
-			return err
+		if err := setNasVolumeAccessControl(ctx, d, config); err != nil {
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceNcloudNasVolumeRead(d, meta)
+	return resourceNcloudNasVolumeRead(ctx, d, meta)
 }
 
-func GetNasVolume(config *conn.ProviderConfig, id string) (*NasVolume, error) {
+func GetNasVolume(ctx context.Context, config *conn.ProviderConfig, id string) (*NasVolume, error) {
 	if config.SupportVPC {
-		return getVpcNasVolume(config, id)
+		return getVpcNasVolume(ctx, config, id)
 	} else {
-		return getClassicNasVolume(config, id)
+		return getClassicNasVolume(ctx, config, id)
 	}
 }
 
-func getClassicNasVolume(config *conn.ProviderConfig, id string) (*NasVolume, error) {
+func getClassicNasVolume(ctx context.Context, config *conn.ProviderConfig, id string) (*NasVolume, error) {
 	reqParams := &server.GetNasVolumeInstanceListRequest{
 		NasVolumeInstanceNoList: []*string{ncloud.String(id)},
 	}
 
-	LogCommonRequest("getClassicNasVolume", reqParams)
+	ctx = LogCommonRequest(ctx, "getClassicNasVolume", reqParams)
 
 	resp, err := config.Client.Server.V2Api.GetNasVolumeInstanceList(reqParams)
 	if err != nil {
-		LogErrorResponse("getClassicNasVolume", err, reqParams)
+		LogErrorResponse(ctx, "getClassicNasVolume", err, reqParams)
 		return nil, err
 	}
-	LogResponse("getClassicNasVolume", resp)
+	LogResponse(ctx, "getClassicNasVolume", resp)
 
 	if len(resp.NasVolumeInstanceList) > 0 {
 		return convertClassicNasVolume(resp.NasVolumeInstanceList[0]), nil
@@ -247,19 +250,19 @@ func convertClassicNasVolume(inst *server.NasVolumeInstance) *NasVolume {
 	}
 }
 
-func getVpcNasVolume(config *conn.ProviderConfig, id string) (*NasVolume, error) {
+func getVpcNasVolume(ctx context.Context, config *conn.ProviderConfig, id string) (*NasVolume, error) {
 	reqParams := &vnas.GetNasVolumeInstanceDetailRequest{
 		RegionCode:          &config.RegionCode,
 		NasVolumeInstanceNo: ncloud.String(id),
 	}
 
-	LogCommonRequest("getVpcNasVolume", reqParams)
+	ctx = LogCommonRequest(ctx, "getVpcNasVolume", reqParams)
 	resp, err := config.Client.Vnas.V2Api.GetNasVolumeInstanceDetail(reqParams)
 	if err != nil {
-		LogErrorResponse("getVpcNasVolume", err, reqParams)
+		LogErrorResponse(ctx, "getVpcNasVolume", err, reqParams)
 		return nil, err
 	}
-	LogResponse("getVpcNasVolume", resp)
+	LogResponse(ctx, "getVpcNasVolume", resp)
 
 	if len(resp.NasVolumeInstanceList) > 0 {
 		return convertVpcNasVolume(resp.NasVolumeInstanceList[0]), nil
@@ -293,29 +296,29 @@ func convertVpcNasVolume(inst *vnas.NasVolumeInstance) *NasVolume {
 	}
 }
 
-func createNasVolume(d *schema.ResourceData, config *conn.ProviderConfig) (*string, error) {
+func createNasVolume(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) (*string, error) {
 	var id *string
 	var err error
 
 	if config.SupportVPC {
-		id, err = createVpcNasVolume(d, config)
+		id, err = createVpcNasVolume(ctx, d, config)
 	} else {
-		id, err = createClassicNasVolume(d, config)
+		id, err = createClassicNasVolume(ctx, d, config)
 	}
 
 	if err != nil {
 		return nil, err
 	}
 
-	if err := waitForNasVolumeCreation(d, config, *id); err != nil {
+	if err := waitForNasVolumeCreation(ctx, d, config, *id); err != nil {
 		return nil, err
 	}
 
 	return id, nil
 }
 
-func createClassicNasVolume(d *schema.ResourceData, config *conn.ProviderConfig) (*string, error) {
-	regionNo, err := conn.ParseRegionNoParameter(d)
+func createClassicNasVolume(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) (*string, error) {
+	regionNo, err := conn.ParseRegionNoParameter(config, d)
 	if err != nil {
 		return nil, err
 	}
@@ -339,15 +342,15 @@ func createClassicNasVolume(d *schema.ResourceData, config *conn.ProviderConfig)
 
 	resp, err := config.Client.Server.V2Api.CreateNasVolumeInstance(reqParams)
 	if err != nil {
-		LogErrorResponse("createClassicNasVolume", err, reqParams)
+		LogErrorResponse(ctx, "createClassicNasVolume", err, reqParams)
 		return nil, err
 	}
-	LogResponse("createClassicNasVolume", resp)
+	LogResponse(ctx, "createClassicNasVolume", resp)
 
 	return resp.NasVolumeInstanceList[0].NasVolumeInstanceNo, nil
 }
 
-func createVpcNasVolume(d *schema.ResourceData, config *conn.ProviderConfig) (*string, error) {
+func createVpcNasVolume(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) (*string, error) {
 	reqParams := &vnas.CreateNasVolumeInstanceRequest{
 		RegionCode:                      &config.RegionCode,
 		ZoneCode:                        StringPtrOrNil(d.GetOk("zone")),
@@ -364,20 +367,20 @@ func createVpcNasVolume(d *schema.ResourceData, config *conn.ProviderConfig) (*s
 
 	resp, err := config.Client.Vnas.V2Api.CreateNasVolumeInstance(reqParams)
 	if err != nil {
-		LogErrorResponse("createVpcNasVolume", err, reqParams)
+		LogErrorResponse(ctx, "createVpcNasVolume", err, reqParams)
 		return nil, err
 	}
-	LogResponse("createVpcNasVolume", resp)
+	LogResponse(ctx, "createVpcNasVolume", resp)
 
 	return resp.NasVolumeInstanceList[0].NasVolumeInstanceNo, nil
 }
 
-func waitForNasVolumeCreation(d *schema.ResourceData, config *conn.ProviderConfig, id string) error {
-	stateConf := &resource.StateChangeConf{
+func waitForNasVolumeCreation(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig, id string) error {
+	stateConf := &waiter.Config[any]{
 		Pending: []string{"INIT"},
 		Target:  []string{"CREAT"},
-		Refresh: func() (interface{}, string, error) {
-			instance, err := GetNasVolume(config, id)
+		Refresh: func(ctx context.Context) (any, string, error) {
+			instance, err := GetNasVolume(ctx, config, id)
 
 			if err != nil {
 				return 0, "", err
@@ -389,12 +392,12 @@ func waitForNasVolumeCreation(d *schema.ResourceData, config *conn.ProviderConfi
 
 			return instance, ncloud.StringValue(instance.Status), nil
 		},
-		Timeout:    d.Timeout(schema.TimeoutCreate),
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Timeout:     d.Timeout(schema.TimeoutCreate),
+		Delay:       2 * time.Second,
+		MinInterval: 3 * time.Second,
 	}
 
-	_, err := stateConf.WaitForState()
+	_, err := stateConf.WaitForState(ctx)
 	if err != nil {
 		return fmt.Errorf("error waiting for NasVolumeInstance state to be \"CREAT\": %s", err)
 	}
@@ -402,63 +405,63 @@ func waitForNasVolumeCreation(d *schema.ResourceData, config *conn.ProviderConfi
 	return nil
 }
 
-func deleteNasVolume(d *schema.ResourceData, config *conn.ProviderConfig, id string) error {
+func deleteNasVolume(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig, id string) error {
 	var err error
 
 	if config.SupportVPC {
-		err = deleteVpcNasVolume(config, id)
+		err = deleteVpcNasVolume(ctx, config, id)
 	} else {
-		err = deleteClassicNasVolume(config, id)
+		err = deleteClassicNasVolume(ctx, config, id)
 	}
 
 	if err != nil {
 		return err
 	}
 
-	if err := waitForNasVolumeDeletion(d, config, id); err != nil {
+	if err := waitForNasVolumeDeletion(ctx, d, config, id); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func deleteClassicNasVolume(config *conn.ProviderConfig, id string) error {
+func deleteClassicNasVolume(ctx context.Context, config *conn.ProviderConfig, id string) error {
 	reqParams := &server.DeleteNasVolumeInstanceRequest{NasVolumeInstanceNo: ncloud.String(id)}
-	LogCommonRequest("deleteClassicNasVolume", reqParams)
+	ctx = LogCommonRequest(ctx, "deleteClassicNasVolume", reqParams)
 
 	resp, err := config.Client.Server.V2Api.DeleteNasVolumeInstance(reqParams)
 	if err != nil {
-		LogErrorResponse("deleteClassicNasVolume", err, id)
+		LogErrorResponse(ctx, "deleteClassicNasVolume", err, id)
 		return err
 	}
-	LogResponse("deleteClassicNasVolume", resp)
+	LogResponse(ctx, "deleteClassicNasVolume", resp)
 
 	return nil
 }
 
-func deleteVpcNasVolume(config *conn.ProviderConfig, id string) error {
+func deleteVpcNasVolume(ctx context.Context, config *conn.ProviderConfig, id string) error {
 	reqParams := &vnas.DeleteNasVolumeInstancesRequest{
 		RegionCode:              &config.RegionCode,
 		NasVolumeInstanceNoList: []*string{ncloud.String(id)},
 	}
-	LogCommonRequest("deleteVpcNasVolume", reqParams)
+	ctx = LogCommonRequest(ctx, "deleteVpcNasVolume", reqParams)
 
 	resp, err := config.Client.Vnas.V2Api.DeleteNasVolumeInstances(reqParams)
 	if err != nil {
-		LogErrorResponse("deleteVpcNasVolume", err, id)
+		LogErrorResponse(ctx, "deleteVpcNasVolume", err, id)
 		return err
 	}
-	LogResponse("deleteVpcNasVolume", resp)
+	LogResponse(ctx, "deleteVpcNasVolume", resp)
 
 	return nil
 }
 
-func waitForNasVolumeDeletion(d *schema.ResourceData, config *conn.ProviderConfig, id string) error {
-	stateConf := &resource.StateChangeConf{
+func waitForNasVolumeDeletion(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig, id string) error {
+	stateConf := &waiter.Config[any]{
 		Pending: []string{"INIT", "CREAT"},
 		Target:  []string{"TERMT"},
-		Refresh: func() (interface{}, string, error) {
-			instance, err := GetNasVolume(config, id)
+		Refresh: func(ctx context.Context) (any, string, error) {
+			instance, err := GetNasVolume(ctx, config, id)
 
 			if err != nil {
 				return 0, "", err
@@ -470,12 +473,12 @@ func waitForNasVolumeDeletion(d *schema.ResourceData, config *conn.ProviderConfi
 
 			return instance, ncloud.StringValue(instance.Status), nil
 		},
-		Timeout:    d.Timeout(schema.TimeoutDelete),
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Timeout:     d.Timeout(schema.TimeoutDelete),
+		Delay:       2 * time.Second,
+		MinInterval: 3 * time.Second,
 	}
 
-	_, err := stateConf.WaitForState()
+	_, err := stateConf.WaitForState(ctx)
 	if err != nil {
 		return fmt.Errorf("error waiting for NasVolumeInstance state to be \"TERMT\": %s", err)
 	}
@@ -483,93 +486,93 @@ func waitForNasVolumeDeletion(d *schema.ResourceData, config *conn.ProviderConfi
 	return nil
 }
 
-func changeNasVolumeSize(d *schema.ResourceData, config *conn.ProviderConfig) error {
+func changeNasVolumeSize(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) error {
 	if config.SupportVPC {
-		return changeVpcNasVolumeSize(d, config)
+		return changeVpcNasVolumeSize(ctx, d, config)
 	} else {
-		return changeClassicNasVolumeSize(d, config)
+		return changeClassicNasVolumeSize(ctx, d, config)
 	}
 }
 
-func changeClassicNasVolumeSize(d *schema.ResourceData, config *conn.ProviderConfig) error {
+func changeClassicNasVolumeSize(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) error {
 	reqParams := &server.ChangeNasVolumeSizeRequest{
 		NasVolumeInstanceNo: ncloud.String(d.Id()),
 		VolumeSize:          Int32PtrOrNil(d.GetOk("volume_size")),
 	}
-	LogCommonRequest("changeClassicNasVolumeSize", reqParams)
+	ctx = LogCommonRequest(ctx, "changeClassicNasVolumeSize", reqParams)
 
 	resp, err := config.Client.Server.V2Api.ChangeNasVolumeSize(reqParams)
 	if err != nil {
-		LogErrorResponse("changeClassicNasVolumeSize", err, reqParams)
+		LogErrorResponse(ctx, "changeClassicNasVolumeSize", err, reqParams)
 		return err
 	}
-	LogResponse("changeClassicNasVolumeSize", resp)
+	LogResponse(ctx, "changeClassicNasVolumeSize", resp)
 
 	return nil
 }
 
-func changeVpcNasVolumeSize(d *schema.ResourceData, config *conn.ProviderConfig) error {
+func changeVpcNasVolumeSize(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) error {
 	reqParams := &vnas.ChangeNasVolumeSizeRequest{
 		RegionCode:          &config.RegionCode,
 		NasVolumeInstanceNo: ncloud.String(d.Id()),
 		VolumeSize:          Int32PtrOrNil(d.GetOk("volume_size")),
 	}
-	LogCommonRequest("changeVpcNasVolumeSize", reqParams)
+	ctx = LogCommonRequest(ctx, "changeVpcNasVolumeSize", reqParams)
 
 	resp, err := config.Client.Vnas.V2Api.ChangeNasVolumeSize(reqParams)
 	if err != nil {
-		LogErrorResponse("changeVpcNasVolumeSize", err, reqParams)
+		LogErrorResponse(ctx, "changeVpcNasVolumeSize", err, reqParams)
 		return err
 	}
-	LogResponse("changeVpcNasVolumeSize", resp)
+	LogResponse(ctx, "changeVpcNasVolumeSize", resp)
 
 	return nil
 }
 
-func setNasVolumeAccessControl(d *schema.ResourceData, config *conn.ProviderConfig) error {
+func setNasVolumeAccessControl(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) error {
 	if config.SupportVPC {
 		if d.HasChange("server_instance_no_list") {
-			return setVpcNasVolumeAccessControl(d, config)
+			return setVpcNasVolumeAccessControl(ctx, d, config)
 		}
 		return nil
 	} else {
-		return setClassicNasVolumeAccessControl(d, config)
+		return setClassicNasVolumeAccessControl(ctx, d, config)
 	}
 }
 
-func setClassicNasVolumeAccessControl(d *schema.ResourceData, config *conn.ProviderConfig) error {
+func setClassicNasVolumeAccessControl(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) error {
 	reqParams := &server.SetNasVolumeAccessControlRequest{
 		NasVolumeInstanceNo:   ncloud.String(d.Id()),
 		AccessControlRuleList: makeClassicNasAclParams(d),
 	}
 
-	LogCommonRequest("setClassicNasVolumeAccessControl", reqParams)
+	ctx = LogCommonRequest(ctx, "setClassicNasVolumeAccessControl", reqParams)
 
 	resp, err := config.Client.Server.V2Api.SetNasVolumeAccessControl(reqParams)
 	if err != nil {
-		LogErrorResponse("setClassicNasVolumeAccessControl", err, reqParams)
+		LogErrorResponse(ctx, "setClassicNasVolumeAccessControl", err, reqParams)
 		return err
 	}
-	LogResponse("setClassicNasVolumeAccessControl", resp)
+	LogResponse(ctx, "setClassicNasVolumeAccessControl", resp)
 
 	return nil
 }
 
-func setVpcNasVolumeAccessControl(d *schema.ResourceData, config *conn.ProviderConfig) error {
+func setVpcNasVolumeAccessControl(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) error {
 	reqParams := &vnas.SetNasVolumeAccessControlRequest{
 		RegionCode:            &config.RegionCode,
 		NasVolumeInstanceNo:   ncloud.String(d.Id()),
 		AccessControlRuleList: makeVpcNasAclParams(d),
 	}
 
-	LogCommonRequest("setVpcNasVolumeAccessControl", reqParams)
+	ctx = LogCommonRequest(ctx, "setVpcNasVolumeAccessControl", reqParams)
 
 	resp, err := config.Client.Vnas.V2Api.SetNasVolumeAccessControl(reqParams)
 	if err != nil {
-		LogErrorResponse("setVpcNasVolumeAccessControl", err, reqParams)
+		LogErrorResponse(ctx, "setVpcNasVolumeAccessControl", err, reqParams)
 		return err
 	}
-	LogResponse("setVpcNasVolumeAccessControl", resp)
+	LogResponse(ctx, "setVpcNasVolumeAccessControl", resp)
 
 	return nil
 }
@@ -1,10 +1,12 @@
 package nasvolume
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vnas"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
@@ -12,6 +14,7 @@ import (
 
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/paging"
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/verify"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/zone"
 )
@@ -48,19 +51,19 @@ func DataSourceNcloudNasVolume() *schema.Resource {
 		"filter": DataSourceFiltersSchema(),
 	}
 
-	return GetSingularDataSourceItemSchema(ResourceNcloudNasVolume(), fieldMap, dataSourceNcloudNasVolumeRead)
+	return GetSingularDataSourceItemSchemaContext(ResourceNcloudNasVolume(), fieldMap, dataSourceNcloudNasVolumeRead)
 }
 
-func dataSourceNcloudNasVolumeRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceNcloudNasVolumeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*conn.ProviderConfig)
 
-	instances, err := getNasVolumeList(d, config)
+	instances, err := getNasVolumeList(ctx, d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if len(instances) < 1 {
-		return fmt.Errorf("no results. please change search criteria and try again")
+		return diag.FromErr(fmt.Errorf("no results. please change search criteria and try again"))
 	}
 
 	resources := ConvertToArrayMap(instances)
@@ -69,7 +72,7 @@ func dataSourceNcloudNasVolumeRead(d *schema.ResourceData, meta interface{}) err
 	}
 
 	if err := ValidateOneResult(len(resources)); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(resources[0]["nas_volume_no"].(string))
@@ -78,18 +81,18 @@ func dataSourceNcloudNasVolumeRead(d *schema.ResourceData, meta interface{}) err
 	return nil
 }
 
-func getNasVolumeList(d *schema.ResourceData, config *conn.ProviderConfig) ([]*NasVolume, error) {
+func getNasVolumeList(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) ([]*NasVolume, error) {
 	if config.SupportVPC {
-		return getVpcNasVolumeList(d, config)
+		return getVpcNasVolumeList(ctx, d, config)
 	} else {
-		return getClassicNasVolumeList(d, config)
+		return getClassicNasVolumeList(ctx, d, config)
 	}
 }
 
-func getClassicNasVolumeList(d *schema.ResourceData, config *conn.ProviderConfig) ([]*NasVolume, error) {
+func getClassicNasVolumeList(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) ([]*NasVolume, error) {
 	client := config.Client
 
-	regionNo, err := conn.ParseRegionNoParameter(d)
+	regionNo, err := conn.ParseRegionNoParameter(config, d)
 	if err != nil {
 		return nil, err
 	}
@@ -111,14 +114,14 @@ func getClassicNasVolumeList(d *schema.ResourceData, config *conn.ProviderConfig
 		reqParams.NasVolumeInstanceNoList = []*string{ncloud.String(v.(string))}
 	}
 
-	LogCommonRequest("getClassicNasVolumeList", reqParams)
+	ctx = LogCommonRequest(ctx, "getClassicNasVolumeList", reqParams)
 
 	resp, err := client.Server.V2Api.GetNasVolumeInstanceList(reqParams)
 	if err != nil {
-		LogErrorResponse("getClassicNasVolumeList", err, reqParams)
+		LogErrorResponse(ctx, "getClassicNasVolumeList", err, reqParams)
 		return nil, err
 	}
-	LogResponse("getClassicNasVolumeList", resp)
+	LogResponse(ctx, "getClassicNasVolumeList", resp)
 
 	var list []*NasVolume
 	for _, r := range resp.NasVolumeInstanceList {
@@ -128,7 +131,7 @@ func getClassicNasVolumeList(d *schema.ResourceData, config *conn.ProviderConfig
 	return list, nil
 }
 
-func getVpcNasVolumeList(d *schema.ResourceData, config *conn.ProviderConfig) ([]*NasVolume, error) {
+func getVpcNasVolumeList(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) ([]*NasVolume, error) {
 	client := config.Client
 
 	reqParams := &vnas.GetNasVolumeInstanceListRequest{
@@ -143,17 +146,26 @@ func getVpcNasVolumeList(d *schema.ResourceData, config *conn.ProviderConfig) ([
 		reqParams.NasVolumeInstanceNoList = []*string{ncloud.String(v.(string))}
 	}
 
-	LogCommonRequest("getVpcNasVolumeList", reqParams)
+	nasVolumeInstanceList, err := paging.All(ctx, func(ctx context.Context, pageNo, pageSize int32) ([]*vnas.NasVolumeInstance, *int32, error) {
+		reqParams.PageNo = ncloud.Int32(pageNo)
+		reqParams.PageSize = ncloud.Int32(pageSize)
 
-	resp, err := client.Vnas.V2Api.GetNasVolumeInstanceList(reqParams)
+		ctx = LogCommonRequest(ctx, "getVpcNasVolumeList", reqParams)
+		resp, err := client.Vnas.V2Api.GetNasVolumeInstanceList(reqParams)
+		if err != nil {
+			LogErrorResponse(ctx, "getVpcNasVolumeList", err, reqParams)
+			return nil, nil, err
+		}
+		LogResponse(ctx, "getVpcNasVolumeList", resp)
+
+		return resp.NasVolumeInstanceList, resp.TotalRows, nil
+	})
 	if err != nil {
-		LogErrorResponse("getVpcNasVolumeList", err, reqParams)
 		return nil, err
 	}
-	LogResponse("getVpcNasVolumeList", resp)
 
 	var list []*NasVolume
-	for _, r := range resp.NasVolumeInstanceList {
+	for _, r := range nasVolumeInstanceList {
 		list = append(list, convertVpcNasVolume(r))
 	}
 
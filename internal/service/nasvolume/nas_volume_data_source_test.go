@@ -20,7 +20,7 @@ func TestAccDataSourceNcloudNasVolume_vpc_basic(t *testing.T) {
 func testAccDataSourceNcloudNasVolumeBasic(t *testing.T, isVpc bool) {
 	dataName := "data.ncloud_nas_volume.by_id"
 	resourceName := "ncloud_nas_volume.test"
-	postfix := GetTestPrefix()
+	postfix := GetTestPrefix(t)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { TestAccPreCheck(t) },
@@ -1,6 +1,7 @@
 package nasvolume_test
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"testing"
@@ -24,7 +25,7 @@ func TestAccResourceNcloudNasVolume_vpc_basic(t *testing.T) {
 
 func testAccResourceNcloudNasVolumeBasic(t *testing.T, isVpc bool) {
 	var volumeInstance nasvolume.NasVolume
-	postfix := GetTestPrefix()
+	postfix := GetTestPrefix(t)
 	resourceName := "ncloud_nas_volume.test"
 	provider := GetTestProvider(isVpc)
 
@@ -70,7 +71,7 @@ func TestAccResourceNcloudNasVolume_vpc_resize(t *testing.T) {
 func testAccResourceNcloudNasVolumeResize(t *testing.T, isVpc bool) {
 	var before nasvolume.NasVolume
 	var after nasvolume.NasVolume
-	postfix := GetTestPrefix()
+	postfix := GetTestPrefix(t)
 	resourceName := "ncloud_nas_volume.test"
 	provider := GetTestProvider(isVpc)
 
@@ -110,7 +111,7 @@ func TestAccResourceNcloudNasVolume_classic_changeAccessControl(t *testing.T) {
 
 	var before nasvolume.NasVolume
 	var after nasvolume.NasVolume
-	postfix := GetTestPrefix()
+	postfix := GetTestPrefix(t)
 	resourceName := "ncloud_nas_volume.test"
 
 	resource.Test(t, resource.TestCase{
@@ -146,7 +147,7 @@ func TestAccResourceNcloudNasVolume_classic_changeAccessControl(t *testing.T) {
 func TestAccResourceNcloudNasVolume_vpc_changeAccessControl(t *testing.T) {
 	var before nasvolume.NasVolume
 	var after nasvolume.NasVolume
-	postfix := GetTestPrefix()
+	postfix := GetTestPrefix(t)
 	resourceName := "ncloud_nas_volume.test"
 
 	resource.Test(t, resource.TestCase{
@@ -191,7 +192,7 @@ func testAccCheckNasVolumeExists(n string, i *nasvolume.NasVolume, provider *sch
 		}
 
 		config := provider.Meta().(*conn.ProviderConfig)
-		nasVolumeInstance, err := nasvolume.GetNasVolume(config, rs.Primary.ID)
+		nasVolumeInstance, err := nasvolume.GetNasVolume(context.Background(), config, rs.Primary.ID)
 		if err != nil {
 			return nil
 		}
@@ -212,7 +213,7 @@ func testAccCheckNasVolumeDestroy(s *terraform.State, provider *schema.Provider)
 		if rs.Type != "ncloud_nas_volume" {
 			continue
 		}
-		volumeInstance, err := nasvolume.GetNasVolume(config, rs.Primary.ID)
+		volumeInstance, err := nasvolume.GetNasVolume(context.Background(), config, rs.Primary.ID)
 		if volumeInstance == nil {
 			return nil
 		}
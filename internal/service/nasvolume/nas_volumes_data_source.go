@@ -1,8 +1,10 @@
 package nasvolume
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -12,7 +14,7 @@ import (
 
 func DataSourceNcloudNasVolumes() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNcloudNasVolumesRead,
+		ReadContext: dataSourceNcloudNasVolumesRead,
 
 		Schema: map[string]*schema.Schema{
 			"volume_allotment_protocol_type_code": {
@@ -63,12 +65,12 @@ func DataSourceNcloudNasVolumes() *schema.Resource {
 	}
 }
 
-func dataSourceNcloudNasVolumesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceNcloudNasVolumesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*conn.ProviderConfig)
 
-	instances, err := getNasVolumeList(d, config)
+	instances, err := getNasVolumeList(ctx, d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	resources := ConvertToArrayMap(instances)
@@ -78,7 +80,7 @@ func dataSourceNcloudNasVolumesRead(d *schema.ResourceData, meta interface{}) er
 	}
 
 	if len(resources) < 1 {
-		return fmt.Errorf("no results. please change search criteria and try again")
+		return diag.FromErr(fmt.Errorf("no results. please change search criteria and try again"))
 	}
 
 	var ids []string
@@ -88,15 +90,15 @@ func dataSourceNcloudNasVolumesRead(d *schema.ResourceData, meta interface{}) er
 
 	d.SetId(DataResourceIdHash(ids))
 	if err := d.Set("ids", ids); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("nas_volumes", resources); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
-		return WriteToFile(output.(string), d.Get("nas_volumes"))
+		return diag.FromErr(WriteToFile(output.(string), d.Get("nas_volumes")))
 	}
 
 	return nil
@@ -18,7 +18,7 @@ func TestAccDataSourceNcloudNasVolumes_vpc_basic(t *testing.T) {
 }
 
 func testAccDataSourceNcloudNasVolumesBasic(t *testing.T, isVpc bool) {
-	postfix := GetTestPrefix()
+	postfix := GetTestPrefix(t)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { TestAccPreCheck(t) },
@@ -0,0 +1,53 @@
+package nasvolume
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/sweep"
+)
+
+func RegisterSweepers() {
+	sweep.AddTestSweepers("ncloud_nas_volume", &resource.Sweeper{
+		Name: "ncloud_nas_volume",
+		F:    sweepNasVolumes,
+		Dependencies: []string{
+			"ncloud_server",
+		},
+	})
+}
+
+func sweepNasVolumes(region string) error {
+	ctx := context.Background()
+	config, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+
+	volumes, err := getNasVolumeList(ctx, DataSourceNcloudNasVolume().Data(nil), config)
+	if err != nil {
+		return fmt.Errorf("listing NAS volumes: %w", err)
+	}
+
+	var sweepables []sweep.Sweepable
+	for _, v := range volumes {
+		if v.VolumeName == nil {
+			continue
+		}
+
+		// volume names are the account prefix, "_" and the volume_name_postfix of the resource
+		postfix := *v.VolumeName
+		if i := strings.Index(postfix, "_"); i >= 0 {
+			postfix = postfix[i+1:]
+		}
+		if !sweep.HasResourcePrefix(&postfix) {
+			continue
+		}
+		sweepables = append(sweepables, sweep.NewSweepResource(ResourceNcloudNasVolume(), config, *v.NasVolumeInstanceNo, nil))
+	}
+
+	return sweep.SweepOrchestrator(ctx, sweepables)
+}
@@ -12,12 +12,12 @@ import (
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vnks"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/waiter"
 )
 
 const (
@@ -271,15 +271,15 @@ func resourceNcloudNKSClusterCreate(ctx context.Context, d *schema.ResourceData,
 		}
 	}
 
-	LogCommonRequest("resourceNcloudNKSClusterCreate", reqParams)
+	ctx = LogCommonRequest(ctx, "resourceNcloudNKSClusterCreate", reqParams)
 	resp, err := config.Client.Vnks.V2Api.ClustersPost(ctx, reqParams)
 	if err != nil {
-		LogErrorResponse("resourceNcloudNKSClusterCreate", err, reqParams)
+		LogErrorResponse(ctx, "resourceNcloudNKSClusterCreate", err, reqParams)
 		return diag.FromErr(err)
 	}
 	uuid := ncloud.StringValue(resp.Uuid)
 
-	LogResponse("resourceNcloudNKSClusterCreate", resp)
+	LogResponse(ctx, "resourceNcloudNKSClusterCreate", resp)
 	if err := waitForNKSClusterActive(ctx, d, config, uuid); err != nil {
 		return diag.FromErr(err)
 	}
@@ -288,11 +288,11 @@ func resourceNcloudNKSClusterCreate(ctx context.Context, d *schema.ResourceData,
 	if oidcReq != nil {
 		_, err = config.Client.Vnks.V2Api.ClustersUuidOidcPatch(ctx, oidcReq, resp.Uuid)
 		if err != nil {
-			LogErrorResponse("resourceNcloudNKSClusterCreate:oidc", err, oidcReq)
+			LogErrorResponse(ctx, "resourceNcloudNKSClusterCreate:oidc", err, oidcReq)
 			return diag.FromErr(err)
 		}
 
-		LogResponse("resourceNcloudNKSClusterCreateoidc:oidc", oidcReq)
+		LogResponse(ctx, "resourceNcloudNKSClusterCreateoidc:oidc", oidcReq)
 		if err := waitForNKSClusterActive(ctx, d, config, uuid); err != nil {
 			return diag.FromErr(err)
 		}
@@ -301,7 +301,7 @@ func resourceNcloudNKSClusterCreate(ctx context.Context, d *schema.ResourceData,
 	if ipAclReq != nil && !checkFinSite(config) {
 		_, err = config.Client.Vnks.V2Api.ClustersUuidIpAclPatch(ctx, ipAclReq, resp.Uuid)
 		if err != nil {
-			LogErrorResponse("resourceNcloudNKSClusterCreate:ipAcl", err, ipAclReq)
+			LogErrorResponse(ctx, "resourceNcloudNKSClusterCreate:ipAcl", err, ipAclReq)
 			return diag.FromErr(err)
 		}
 	}
@@ -316,6 +316,11 @@ func resourceNcloudNKSClusterRead(ctx context.Context, d *schema.ResourceData, m
 	}
 
 	cluster, err := GetNKSCluster(ctx, config, d.Id())
+	if IsNotFound(err) {
+		log.Printf("[WARN] NKS cluster %s was not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -398,11 +403,11 @@ func resourceNcloudNKSClusterUpdate(ctx context.Context, d *schema.ResourceData,
 		newVersion := StringPtrOrNil(d.GetOk("k8s_version"))
 		_, err := config.Client.Vnks.V2Api.ClustersUuidUpgradePatch(ctx, cluster.Uuid, newVersion, map[string]interface{}{})
 		if err != nil {
-			LogErrorResponse("resourceNcloudNKSClusterUpgrade", err, newVersion)
+			LogErrorResponse(ctx, "resourceNcloudNKSClusterUpgrade", err, newVersion)
 			return diag.FromErr(err)
 		}
 
-		LogResponse("resourceNcloudNKSClusterUpgrade", newVersion)
+		LogResponse(ctx, "resourceNcloudNKSClusterUpgrade", newVersion)
 		if err := waitForNKSClusterActive(ctx, d, config, *cluster.Uuid); err != nil {
 			return diag.FromErr(err)
 		}
@@ -415,11 +420,11 @@ func resourceNcloudNKSClusterUpdate(ctx context.Context, d *schema.ResourceData,
 
 		_, err = config.Client.Vnks.V2Api.ClustersUuidOidcPatch(ctx, oidcSpec, cluster.Uuid)
 		if err != nil {
-			LogErrorResponse("resourceNcloudNKSClusterOIDCPatch", err, oidcSpec)
+			LogErrorResponse(ctx, "resourceNcloudNKSClusterOIDCPatch", err, oidcSpec)
 			return diag.FromErr(err)
 		}
 
-		LogResponse("resourceNcloudNKSClusterOIDCPatch", oidcSpec)
+		LogResponse(ctx, "resourceNcloudNKSClusterOIDCPatch", oidcSpec)
 		if err := waitForNKSClusterActive(ctx, d, config, *cluster.Uuid); err != nil {
 			return diag.FromErr(err)
 		}
@@ -437,7 +442,7 @@ func resourceNcloudNKSClusterUpdate(ctx context.Context, d *schema.ResourceData,
 
 		_, err = config.Client.Vnks.V2Api.ClustersUuidIpAclPatch(ctx, ipAclReq, cluster.Uuid)
 		if err != nil {
-			LogErrorResponse("resourceNcloudNKSClusterIPAclPatch", err, ipAclReq)
+			LogErrorResponse(ctx, "resourceNcloudNKSClusterIPAclPatch", err, ipAclReq)
 			return diag.FromErr(err)
 		}
 	}
@@ -453,7 +458,7 @@ func resourceNcloudNKSClusterUpdate(ctx context.Context, d *schema.ResourceData,
 
 		_, err = config.Client.Vnks.V2Api.ClustersUuidLogPatch(ctx, logDto, cluster.Uuid)
 		if err != nil {
-			LogErrorResponse("resourceNcloudNKSClusterLogPatch", err, logDto)
+			LogErrorResponse(ctx, "resourceNcloudNKSClusterLogPatch", err, logDto)
 			return diag.FromErr(err)
 		}
 
@@ -464,7 +469,7 @@ func resourceNcloudNKSClusterUpdate(ctx context.Context, d *schema.ResourceData,
 		lbPrivateSubnetNo, _ := strconv.Atoi(d.Get("lb_private_subnet_no").(string))
 		_, err = config.Client.Vnks.V2Api.ClustersUuidLbSubnetPatch(ctx, cluster.Uuid, ncloud.Int32(int32(lbPrivateSubnetNo)), map[string]interface{}{"igwYn": ncloud.String("N")})
 		if err != nil {
-			LogErrorResponse("resourceNcloudNKSClusterLbPrivateSubnetPatch", err, lbPrivateSubnetNo)
+			LogErrorResponse(ctx, "resourceNcloudNKSClusterLbPrivateSubnetPatch", err, lbPrivateSubnetNo)
 			return diag.FromErr(err)
 		}
 
@@ -475,7 +480,7 @@ func resourceNcloudNKSClusterUpdate(ctx context.Context, d *schema.ResourceData,
 		lbPrivateSubnetNo, _ := strconv.Atoi(d.Get("lb_public_subnet_no").(string))
 		_, err = config.Client.Vnks.V2Api.ClustersUuidLbSubnetPatch(ctx, cluster.Uuid, ncloud.Int32(int32(lbPrivateSubnetNo)), map[string]interface{}{"igwYn": ncloud.String("Y")})
 		if err != nil {
-			LogErrorResponse("resourceNcloudNKSClusterLbPublicSubnetPatch", err, lbPrivateSubnetNo)
+			LogErrorResponse(ctx, "resourceNcloudNKSClusterLbPublicSubnetPatch", err, lbPrivateSubnetNo)
 			return diag.FromErr(err)
 		}
 
@@ -496,7 +501,7 @@ func resourceNcloudNKSClusterUpdate(ctx context.Context, d *schema.ResourceData,
 
 		_, err = config.Client.Vnks.V2Api.ClustersUuidAddSubnetPatch(ctx, subnets, cluster.Uuid)
 		if err != nil {
-			LogErrorResponse("resourceNcloudNKSClusterAddSubnetsPatch", err, subnets)
+			LogErrorResponse(ctx, "resourceNcloudNKSClusterAddSubnetsPatch", err, subnets)
 			return diag.FromErr(err)
 		}
 
@@ -515,9 +520,9 @@ func resourceNcloudNKSClusterDelete(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 
-	LogCommonRequest("resourceNcloudNKSClusterDelete", d.Id())
+	ctx = LogCommonRequest(ctx, "resourceNcloudNKSClusterDelete", d.Id())
 	if err := config.Client.Vnks.V2Api.ClustersUuidDelete(ctx, ncloud.String(d.Id())); err != nil {
-		LogErrorResponse("resourceNcloudNKSClusterDelete", err, d.Id())
+		LogErrorResponse(ctx, "resourceNcloudNKSClusterDelete", err, d.Id())
 		return diag.FromErr(err)
 	}
 
@@ -529,10 +534,10 @@ func resourceNcloudNKSClusterDelete(ctx context.Context, d *schema.ResourceData,
 }
 
 func waitForNKSClusterDeletion(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) error {
-	stateConf := &resource.StateChangeConf{
+	stateConf := &waiter.Config[any]{
 		Pending: []string{NKSStatusDeletingCode},
 		Target:  []string{NKSStatusNullCode, NKSStatusRunningCode}, // ToDo: remove runnig status after external autoscaler callback removed.
-		Refresh: func() (result interface{}, state string, err error) {
+		Refresh: func(ctx context.Context) (result any, state string, err error) {
 			cluster, err := getNKSClusterFromList(ctx, config, d.Id())
 			if err != nil {
 				return nil, "", err
@@ -542,36 +547,35 @@ func waitForNKSClusterDeletion(ctx context.Context, d *schema.ResourceData, conf
 			}
 			return cluster, ncloud.StringValue(cluster.Status), nil
 		},
-		Timeout:    d.Timeout(schema.TimeoutDelete),
-		MinTimeout: 3 * time.Second,
-		Delay:      5 * time.Second,
+		Timeout:     d.Timeout(schema.TimeoutDelete),
+		MinInterval: 3 * time.Second,
+		Delay:       5 * time.Second,
 	}
-	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+	if _, err := stateConf.WaitForState(ctx); err != nil {
 		return fmt.Errorf("Error waiting for NKS Cluster (%s) to become terminating: %s", d.Id(), err)
 	}
 	return nil
 }
 
 func waitForNKSClusterActive(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig, uuid string) error {
-	stateConf := &resource.StateChangeConf{
+	stateConf := &waiter.Config[*vnks.Cluster]{
 		Pending: []string{NKSStatusCreatingCode, NKSStatusWorkingCode},
 		Target:  []string{NKSStatusRunningCode, NKSStatusNoNodeCode},
-		Refresh: func() (result interface{}, state string, err error) {
+		Refresh: func(ctx context.Context) (*vnks.Cluster, string, error) {
 			cluster, err := GetNKSCluster(ctx, config, uuid)
 			if err != nil {
 				return nil, "", err
 			}
 			if cluster == nil {
-				return uuid, NKSStatusNullCode, nil
+				return nil, NKSStatusNullCode, nil
 			}
 			return cluster, ncloud.StringValue(cluster.Status), nil
-
 		},
-		Timeout:    d.Timeout(schema.TimeoutCreate),
-		MinTimeout: 3 * time.Second,
-		Delay:      5 * time.Second,
+		Timeout:     d.Timeout(schema.TimeoutCreate),
+		MinInterval: 3 * time.Second,
+		Delay:       5 * time.Second,
 	}
-	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+	if _, err := stateConf.WaitForState(ctx); err != nil {
 		return fmt.Errorf("error waiting for NKS Cluster (%s) to become activating: %s", uuid, err)
 	}
 	return nil
@@ -16,7 +16,7 @@ func TestAccDataSourceNcloudNKSCluster(t *testing.T) {
 
 	dataName := "data.ncloud_nks_cluster.cluster"
 	resourceName := "ncloud_nks_cluster.cluster"
-	name := GetTestClusterName()
+	name := GetTestClusterName(t)
 	nksInfo, err := getNKSTestInfo("XEN")
 	if err != nil {
 		t.Error(err)
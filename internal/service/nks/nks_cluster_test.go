@@ -50,7 +50,7 @@ func validateAcctestEnvironment(t *testing.T) {
 func TestAccResourceNcloudNKSCluster_basic_XEN(t *testing.T) {
 	validateAcctestEnvironment(t)
 
-	name := GetTestClusterName()
+	name := GetTestClusterName(t)
 
 	resourceName := "ncloud_nks_cluster.cluster"
 
@@ -80,7 +80,7 @@ func TestAccResourceNcloudNKSCluster_basic_XEN(t *testing.T) {
 func TestAccResourceNcloudNKSCluster_basic_KVM(t *testing.T) {
 	validateAcctestEnvironment(t)
 
-	name := GetTestClusterName()
+	name := GetTestClusterName(t)
 
 	resourceName := "ncloud_nks_cluster.cluster"
 
@@ -110,7 +110,7 @@ func TestAccResourceNcloudNKSCluster_basic_KVM(t *testing.T) {
 func TestAccResourceNcloudNKSCluster_public_network_XEN(t *testing.T) {
 	validateAcctestEnvironment(t)
 
-	name := GetTestClusterName()
+	name := GetTestClusterName(t)
 	resourceName := "ncloud_nks_cluster.cluster"
 
 	nksInfo, err := getNKSTestInfo("XEN")
@@ -134,7 +134,7 @@ func TestAccResourceNcloudNKSCluster_public_network_XEN(t *testing.T) {
 func TestAccResourceNcloudNKSCluster_public_network_KVM(t *testing.T) {
 	validateAcctestEnvironment(t)
 
-	name := GetTestClusterName()
+	name := GetTestClusterName(t)
 	resourceName := "ncloud_nks_cluster.cluster"
 
 	nksInfo, err := getNKSTestInfo("KVM")
@@ -158,7 +158,7 @@ func TestAccResourceNcloudNKSCluster_public_network_KVM(t *testing.T) {
 func TestAccResourceNcloudNKSCluster_Update_XEN(t *testing.T) {
 	validateAcctestEnvironment(t)
 
-	name := fmt.Sprintf("m3-%s", GetTestClusterName())
+	name := fmt.Sprintf("m3-%s", GetTestClusterName(t))
 	resourceName := "ncloud_nks_cluster.cluster"
 
 	nksInfo, err := getNKSTestInfo("XEN")
@@ -188,7 +188,7 @@ func TestAccResourceNcloudNKSCluster_Update_XEN(t *testing.T) {
 func TestAccResourceNcloudNKSCluster_Update_KVM(t *testing.T) {
 	validateAcctestEnvironment(t)
 
-	name := fmt.Sprintf("m3-%s", GetTestClusterName())
+	name := fmt.Sprintf("m3-%s", GetTestClusterName(t))
 	resourceName := "ncloud_nks_cluster.cluster"
 
 	nksInfo, err := getNKSTestInfo("KVM")
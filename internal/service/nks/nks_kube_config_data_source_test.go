@@ -15,7 +15,7 @@ func TestAccDataSourceNcloudNKSKubeConfig(t *testing.T) {
 
 	dataName := "data.ncloud_nks_kube_config.kube_config"
 	resourceName := "ncloud_nks_cluster.cluster"
-	name := GetTestClusterName()
+	name := GetTestClusterName(t)
 	nksInfo, err := getNKSTestInfo("XEN")
 	if err != nil {
 		t.Error(err)
@@ -38,6 +38,14 @@ func TestAccDataSourceNcloudNKSKubeConfig(t *testing.T) {
 }
 
 func testAccDataSourceNKSKubeConfigConfig(name string, loginKeyName string, auditLog bool, nksInfo *NKSTestInfo) string {
+	return testAccNKSKubeConfigClusterConfig(name, loginKeyName, auditLog, nksInfo) + `
+	data "ncloud_nks_kube_config" "kube_config" {
+		cluster_uuid = ncloud_nks_cluster.cluster.uuid
+	}
+`
+}
+
+func testAccNKSKubeConfigClusterConfig(name string, loginKeyName string, auditLog bool, nksInfo *NKSTestInfo) string {
 	var b bytes.Buffer
 	b.WriteString(fmt.Sprintf(`
 resource "ncloud_nks_cluster" "cluster" {
@@ -75,12 +83,6 @@ resource "ncloud_nks_cluster" "cluster" {
 
 	b.WriteString(`
 }
-`)
-
-	b.WriteString(`
-	data "ncloud_nks_kube_config" "kube_config" {
-		cluster_uuid = ncloud_nks_cluster.cluster.uuid
-	}
 `)
 	return b.String()
 }
@@ -0,0 +1,118 @@
+package nks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+)
+
+var (
+	_ ephemeral.EphemeralResource              = &nksKubeConfigEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &nksKubeConfigEphemeralResource{}
+)
+
+func NewNKSKubeConfigEphemeralResource() ephemeral.EphemeralResource {
+	return &nksKubeConfigEphemeralResource{}
+}
+
+type nksKubeConfigEphemeralResource struct {
+	config *conn.ProviderConfig
+}
+
+func (e *nksKubeConfigEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_nks_kube_config"
+}
+
+func (e *nksKubeConfigEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*conn.ProviderConfig)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	e.config = config
+}
+
+func (e *nksKubeConfigEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"cluster_uuid": schema.StringAttribute{
+				Required: true,
+			},
+			"host": schema.StringAttribute{
+				Computed: true,
+			},
+			"client_certificate": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"client_key": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"cluster_ca_certificate": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (e *nksKubeConfigEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data nksKubeConfigEphemeralResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !e.config.SupportVPC {
+		resp.Diagnostics.AddError(
+			"NOT SUPPORT CLASSIC",
+			common.NotSupportClassic("ephemeral resource `ncloud_nks_kube_config`").Error(),
+		)
+		return
+	}
+
+	kubeConfig, err := getNKSKubeConfig(ctx, e.config, data.ClusterUuid.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("READING ERROR", err.Error())
+		return
+	}
+
+	if kubeConfig == nil || len(kubeConfig.Clusters) == 0 {
+		resp.Diagnostics.AddError("READING ERROR", fmt.Sprintf("no kubeconfig for cluster %s", data.ClusterUuid.ValueString()))
+		return
+	}
+
+	data.Host = types.StringValue(kubeConfig.Clusters[0].Cluster.Server)
+	data.ClusterCaCertificate = types.StringValue(kubeConfig.Clusters[0].Cluster.ClusterCaCertificate)
+
+	if len(kubeConfig.Users) > 0 {
+		data.ClientCertificate = types.StringValue(kubeConfig.Users[0].User.ClientCertificateData)
+		data.ClientKey = types.StringValue(kubeConfig.Users[0].User.ClientKeyData)
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+type nksKubeConfigEphemeralResourceModel struct {
+	ClusterUuid          types.String `tfsdk:"cluster_uuid"`
+	Host                 types.String `tfsdk:"host"`
+	ClientCertificate    types.String `tfsdk:"client_certificate"`
+	ClientKey            types.String `tfsdk:"client_key"`
+	ClusterCaCertificate types.String `tfsdk:"cluster_ca_certificate"`
+}
@@ -0,0 +1,56 @@
+package nks_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	. "github.com/terraform-providers/terraform-provider-ncloud/internal/acctest"
+)
+
+func TestAccEphemeralNcloudNKSKubeConfig(t *testing.T) {
+	validateAcctestEnvironment(t)
+
+	resourceName := "ncloud_nks_cluster.cluster"
+	name := GetTestClusterName(t)
+	nksInfo, err := getNKSTestInfo("XEN")
+	if err != nil {
+		t.Error(err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEphemeralNKSKubeConfigConfig(name, TF_TEST_NKS_LOGIN_KEY, true, nksInfo),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "uuid"),
+					// The provisioner fails the apply when any opened kubeconfig value is empty
+					resource.TestCheckResourceAttrSet("terraform_data.kube_config", "id"),
+				),
+			},
+		},
+	})
+}
+
+// The kubeconfig is opened as an ephemeral resource and is never written to the state
+func testAccEphemeralNKSKubeConfigConfig(name string, loginKeyName string, auditLog bool, nksInfo *NKSTestInfo) string {
+	return testAccNKSKubeConfigClusterConfig(name, loginKeyName, auditLog, nksInfo) + `
+ephemeral "ncloud_nks_kube_config" "kube_config" {
+  cluster_uuid = ncloud_nks_cluster.cluster.uuid
+}
+
+resource "terraform_data" "kube_config" {
+  provisioner "local-exec" {
+    command = "test -n \"$HOST\" -a -n \"$CLIENT_CERTIFICATE\" -a -n \"$CLIENT_KEY\" -a -n \"$CLUSTER_CA_CERTIFICATE\""
+    environment = {
+      HOST                   = ephemeral.ncloud_nks_kube_config.kube_config.host
+      CLIENT_CERTIFICATE     = ephemeral.ncloud_nks_kube_config.kube_config.client_certificate
+      CLIENT_KEY             = ephemeral.ncloud_nks_kube_config.kube_config.client_key
+      CLUSTER_CA_CERTIFICATE = ephemeral.ncloud_nks_kube_config.kube_config.cluster_ca_certificate
+    }
+  }
+}
+`
+}
@@ -13,12 +13,13 @@ import (
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vnks"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/verify"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/waiter"
 )
 
 const (
@@ -65,6 +66,7 @@ func ResourceNcloudNKSNodePool() *schema.Resource {
 				_, removed, autoSelect := getSubnetDiff(old, new)
 				return len(removed) > 0 || autoSelect
 			}),
+			validateNKSNodePoolCodes,
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -269,14 +271,14 @@ func resourceNcloudNKSNodePoolCreate(ctx context.Context, d *schema.ResourceData
 		reqParams.Autoscale = expandNKSNodePoolAutoScale(d.Get("autoscale").([]interface{}))
 	}
 
-	LogCommonRequest("resourceNcloudNKSNodePoolCreate", reqParams)
+	ctx = LogCommonRequest(ctx, "resourceNcloudNKSNodePoolCreate", reqParams)
 	_, err := config.Client.Vnks.V2Api.ClustersUuidNodePoolPost(ctx, reqParams, ncloud.String(clusterUuid))
 	if err != nil {
-		LogErrorResponse("resourceNcloudNKSNodePoolCreate", err, reqParams)
+		LogErrorResponse(ctx, "resourceNcloudNKSNodePoolCreate", err, reqParams)
 		return diag.FromErr(err)
 	}
 
-	LogResponse("resourceNcloudNKSNodePoolCreate", reqParams)
+	LogResponse(ctx, "resourceNcloudNKSNodePoolCreate", reqParams)
 	if err := waitForNKSNodePoolActive(ctx, d, config, clusterUuid, ncloud.StringValue(reqParams.Name)); err != nil {
 		return diag.FromErr(err)
 	}
@@ -301,11 +303,11 @@ func resourceNcloudNKSNodePoolCreate(ctx context.Context, d *schema.ResourceData
 
 		_, err = config.Client.Vnks.V2Api.ClustersUuidNodePoolInstanceNoTaintsPut(ctx, nodePoolTaintReq, &clusterUuid, &instanceNo)
 		if err != nil {
-			LogErrorResponse("resourceNcloudNKSNodePoolCreate - put taints", err, nodePoolTaintReq)
+			LogErrorResponse(ctx, "resourceNcloudNKSNodePoolCreate - put taints", err, nodePoolTaintReq)
 			return diag.FromErr(err)
 		}
 
-		LogResponse("resourceNcloudNKSNodePoolCreate - put taints", reqParams)
+		LogResponse(ctx, "resourceNcloudNKSNodePoolCreate - put taints", reqParams)
 		if err := waitForNKSNodePoolActive(ctx, d, config, clusterUuid, ncloud.StringValue(reqParams.Name)); err != nil {
 			return diag.FromErr(err)
 		}
@@ -329,11 +331,11 @@ func resourceNcloudNKSNodePoolCreate(ctx context.Context, d *schema.ResourceData
 
 		_, err = config.Client.Vnks.V2Api.ClustersUuidNodePoolInstanceNoLabelsPut(ctx, labelsReq, &clusterUuid, &instanceNo)
 		if err != nil {
-			LogErrorResponse("resourceNcloudNKSNodePoolCreate - put labels", err, labelsReq)
+			LogErrorResponse(ctx, "resourceNcloudNKSNodePoolCreate - put labels", err, labelsReq)
 			return diag.FromErr(err)
 		}
 
-		LogResponse("resourceNcloudNKSNodePoolCreate - put labels", reqParams)
+		LogResponse(ctx, "resourceNcloudNKSNodePoolCreate - put labels", reqParams)
 		if err := waitForNKSNodePoolActive(ctx, d, config, clusterUuid, ncloud.StringValue(reqParams.Name)); err != nil {
 			return diag.FromErr(err)
 		}
@@ -353,6 +355,11 @@ func resourceNcloudNKSNodePoolRead(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 	nodePool, err := GetNKSNodePool(ctx, config, clusterUuid, nodePoolName)
+	if IsNotFound(err) {
+		log.Printf("[WARN] NKS node pool %s was not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -422,11 +429,11 @@ func resourceNcloudNKSNodePoolUpdate(ctx context.Context, d *schema.ResourceData
 	if d.HasChanges("k8s_version") {
 		_, err = config.Client.Vnks.V2Api.ClustersUuidNodePoolInstanceNoUpgradePatch(ctx, ncloud.String(clusterUuid), instanceNo, k8sVersion, map[string]interface{}{})
 		if err != nil {
-			LogErrorResponse("resourceNcloudNKSNodepoolUpgrade", err, k8sVersion)
+			LogErrorResponse(ctx, "resourceNcloudNKSNodepoolUpgrade", err, k8sVersion)
 			return diag.FromErr(err)
 		}
 
-		LogResponse("resourceNcloudNKSNodepoolUpgrade", k8sVersion)
+		LogResponse(ctx, "resourceNcloudNKSNodepoolUpgrade", k8sVersion)
 		if err := waitForNKSNodePoolActive(ctx, d, config, clusterUuid, nodePoolName); err != nil {
 			return diag.FromErr(err)
 		}
@@ -443,11 +450,11 @@ func resourceNcloudNKSNodePoolUpdate(ctx context.Context, d *schema.ResourceData
 
 		err := config.Client.Vnks.V2Api.ClustersUuidNodePoolInstanceNoPatch(ctx, reqParams, ncloud.String(clusterUuid), instanceNo)
 		if err != nil {
-			LogErrorResponse("resourceNcloudNKSNodePoolUpdate", err, reqParams)
+			LogErrorResponse(ctx, "resourceNcloudNKSNodePoolUpdate", err, reqParams)
 			return diag.FromErr(err)
 		}
 
-		LogResponse("resourceNcloudNKSNodePoolUpdate", reqParams)
+		LogResponse(ctx, "resourceNcloudNKSNodePoolUpdate", reqParams)
 		if err := waitForNKSNodePoolActive(ctx, d, config, clusterUuid, nodePoolName); err != nil {
 			return diag.FromErr(err)
 		}
@@ -460,11 +467,11 @@ func resourceNcloudNKSNodePoolUpdate(ctx context.Context, d *schema.ResourceData
 
 		_, err = config.Client.Vnks.V2Api.ClustersUuidNodePoolInstanceNoTaintsPut(ctx, nodePoolTaintReq, &clusterUuid, instanceNo)
 		if err != nil {
-			LogErrorResponse("resourceNcloudNKSNodePoolUpdate - put taints", err, nodePoolTaintReq)
+			LogErrorResponse(ctx, "resourceNcloudNKSNodePoolUpdate - put taints", err, nodePoolTaintReq)
 			return diag.FromErr(err)
 		}
 
-		LogResponse("resourceNcloudNKSNodePoolUpdate - put taints", nodePoolTaintReq)
+		LogResponse(ctx, "resourceNcloudNKSNodePoolUpdate - put taints", nodePoolTaintReq)
 		if err := waitForNKSNodePoolActive(ctx, d, config, clusterUuid, nodePoolName); err != nil {
 			return diag.FromErr(err)
 		}
@@ -481,11 +488,11 @@ func resourceNcloudNKSNodePoolUpdate(ctx context.Context, d *schema.ResourceData
 
 		_, err = config.Client.Vnks.V2Api.ClustersUuidNodePoolInstanceNoLabelsPut(ctx, labelsReq, &clusterUuid, instanceNo)
 		if err != nil {
-			LogErrorResponse("resourceNcloudNKSNodePoolUpdate - put labels", err, labelsReq)
+			LogErrorResponse(ctx, "resourceNcloudNKSNodePoolUpdate - put labels", err, labelsReq)
 			return diag.FromErr(err)
 		}
 
-		LogResponse("resourceNcloudNKSNodePoolUpdate - put labels", labelsReq)
+		LogResponse(ctx, "resourceNcloudNKSNodePoolUpdate - put labels", labelsReq)
 		if err := waitForNKSNodePoolActive(ctx, d, config, clusterUuid, nodePoolName); err != nil {
 			return diag.FromErr(err)
 		}
@@ -502,7 +509,7 @@ func resourceNcloudNKSNodePoolUpdate(ctx context.Context, d *schema.ResourceData
 
 		_, err = config.Client.Vnks.V2Api.ClustersUuidNodePoolInstanceNoSubnetsPatch(ctx, subnetReq, &clusterUuid, instanceNo)
 		if err != nil {
-			LogErrorResponse("resourceNcloudNKSNodePoolUpdate - addSubnets", err, subnetReq)
+			LogErrorResponse(ctx, "resourceNcloudNKSNodePoolUpdate - addSubnets", err, subnetReq)
 			return diag.FromErr(err)
 		}
 
@@ -527,9 +534,9 @@ func resourceNcloudNKSNodePoolDelete(ctx context.Context, d *schema.ResourceData
 		return diag.FromErr(err)
 	}
 
-	LogCommonRequest("resourceNcloudNKSNodePoolDelete", d.Id())
+	ctx = LogCommonRequest(ctx, "resourceNcloudNKSNodePoolDelete", d.Id())
 	if err := config.Client.Vnks.V2Api.ClustersUuidNodePoolInstanceNoDelete(ctx, ncloud.String(clusterUuid), instanceNo); err != nil {
-		LogErrorResponse("resourceNcloudNKSNodePoolDelete", err, instanceNo)
+		LogErrorResponse(ctx, "resourceNcloudNKSNodePoolDelete", err, instanceNo)
 		return diag.FromErr(err)
 	}
 
@@ -540,11 +547,86 @@ func resourceNcloudNKSNodePoolDelete(ctx context.Context, d *schema.ResourceData
 	return nil
 }
 
+// validateNKSNodePoolCodes checks software_code, product_code and server_spec_code against the server images and
+// products of the cluster's hypervisor and zone, so that an invalid code fails the plan rather than the creation
+func validateNKSNodePoolCodes(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	config := meta.(*conn.ProviderConfig)
+	if !config.SupportVPC {
+		return nil
+	}
+
+	changed := func(key string) bool {
+		return d.NewValueKnown(key) && d.HasChange(key) && d.Get(key).(string) != ""
+	}
+	softwareChanged := changed("software_code")
+	productChanged := changed("product_code")
+	specChanged := changed("server_spec_code")
+	if !softwareChanged && !productChanged && !specChanged {
+		return nil
+	}
+
+	var hypervisorCode, zoneCode *string
+	scope := "region " + config.RegionCode
+	if d.NewValueKnown("cluster_uuid") {
+		cluster, err := GetNKSCluster(ctx, config, d.Get("cluster_uuid").(string))
+		if err != nil || cluster == nil {
+			log.Printf("[WARN] skip validating the codes of the node pool, cluster not found: %v", err)
+			return nil
+		}
+		hypervisorCode = cluster.HypervisorCode
+		zoneCode = cluster.ZoneCode
+		scope = fmt.Sprintf("zone %s of %s", ncloud.StringValue(zoneCode), scope)
+	}
+
+	softwareCode := d.Get("software_code").(string)
+	if softwareChanged {
+		images, err := getNKSServerImageList(ctx, config, hypervisorCode)
+		if err != nil {
+			log.Printf("[WARN] skip validating the codes of the node pool: %s", err)
+			return nil
+		}
+		if err := verify.ValidateCodeInList("software_code", softwareCode, optionValues(images), scope); err != nil {
+			return err
+		}
+	}
+
+	if (!productChanged && !specChanged) || !d.NewValueKnown("software_code") || softwareCode == "" {
+		return nil
+	}
+
+	products, err := getNKSServerProductList(ctx, config, ncloud.String(softwareCode), zoneCode)
+	if err != nil {
+		log.Printf("[WARN] skip validating the codes of the node pool: %s", err)
+		return nil
+	}
+
+	scope = fmt.Sprintf("software %s of %s", softwareCode, scope)
+	for _, key := range []string{"product_code", "server_spec_code"} {
+		if !changed(key) {
+			continue
+		}
+		if err := verify.ValidateCodeInList(key, d.Get(key).(string), optionValues(products), scope); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// optionValues returns the values of the options listed by the NKS option APIs
+func optionValues(options []map[string]interface{}) []string {
+	var values []string
+	for _, o := range options {
+		values = append(values, o["value"].(string))
+	}
+	return values
+}
+
 func waitForNKSNodePoolDeletion(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) error {
-	stateConf := &resource.StateChangeConf{
+	stateConf := &waiter.Config[any]{
 		Pending: []string{NKSNodePoolStatusNodeScaleDown, NKSStatusDeletingCode},
 		Target:  []string{NKSStatusNullCode},
-		Refresh: func() (result interface{}, state string, err error) {
+		Refresh: func(ctx context.Context) (result any, state string, err error) {
 
 			clusterUuid, nodePoolName, err := NodePoolParseResourceID(d.Id())
 			if err != nil {
@@ -563,21 +645,21 @@ func waitForNKSNodePoolDeletion(ctx context.Context, d *schema.ResourceData, con
 			return np, ncloud.StringValue(np.Status), nil
 
 		},
-		Timeout:    d.Timeout(schema.TimeoutDelete),
-		MinTimeout: 3 * time.Second,
-		Delay:      5 * time.Second,
+		Timeout:     d.Timeout(schema.TimeoutDelete),
+		MinInterval: 3 * time.Second,
+		Delay:       5 * time.Second,
 	}
-	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+	if _, err := stateConf.WaitForState(ctx); err != nil {
 		return fmt.Errorf("Error waiting for NKS NodePool (%s) to become terminating: %s", d.Id(), err)
 	}
 	return nil
 }
 
 func waitForNKSNodePoolActive(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig, clusterUuid string, nodePoolName string) error {
-	stateConf := &resource.StateChangeConf{
+	stateConf := &waiter.Config[any]{
 		Pending: []string{NKSStatusCreatingCode, NKSNodePoolStatusNodeScaleOut, NKSNodePoolStatusNodeScaleDown, NKSNodePoolStatusUpgrade, NKSNodePoolStatusRotateNodeScaleOut, NKSNodePoolStatusRotateNodeScaleDown, NKSNodePoolStatusUpdate},
 		Target:  []string{NKSNodePoolStatusRunCode},
-		Refresh: func() (result interface{}, state string, err error) {
+		Refresh: func(ctx context.Context) (result any, state string, err error) {
 			np, err := GetNKSNodePool(ctx, config, clusterUuid, nodePoolName)
 			if err != nil {
 				return nil, "", err
@@ -588,11 +670,11 @@ func waitForNKSNodePoolActive(ctx context.Context, d *schema.ResourceData, confi
 			return np, ncloud.StringValue(np.Status), nil
 
 		},
-		Timeout:    d.Timeout(schema.TimeoutCreate),
-		MinTimeout: 3 * time.Second,
-		Delay:      5 * time.Second,
+		Timeout:     d.Timeout(schema.TimeoutCreate),
+		MinInterval: 3 * time.Second,
+		Delay:       5 * time.Second,
 	}
-	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+	if _, err := stateConf.WaitForState(ctx); err != nil {
 		return fmt.Errorf("error waiting for NKS NodePool (%s) to become activating: %s", nodePoolName, err)
 	}
 	return nil
@@ -616,7 +698,7 @@ func getNKSNodePools(ctx context.Context, config *conn.ProviderConfig, uuid stri
 	if err != nil {
 		return nil, err
 	}
-	LogResponse("getNKSNodePools", resp)
+	LogResponse(ctx, "getNKSNodePools", resp)
 
 	return resp.NodePool, nil
 }
@@ -15,7 +15,7 @@ func TestAccDataSourceNcloudNKSNodePool(t *testing.T) {
 
 	dataName := "data.ncloud_nks_node_pool.node_pool"
 	resourceName := "ncloud_nks_node_pool.node_pool"
-	clusterName := GetTestClusterName()
+	clusterName := GetTestClusterName(t)
 	nksInfo, err := getNKSTestInfo("XEN")
 	if err != nil {
 		t.Error(err)
@@ -20,7 +20,7 @@ import (
 func TestAccResourceNcloudNKSNodePool_basic_XEN(t *testing.T) {
 	validateAcctestEnvironment(t)
 
-	clusterName := GetTestClusterName()
+	clusterName := GetTestClusterName(t)
 	resourceName := "ncloud_nks_node_pool.node_pool"
 
 	nksInfo, err := getNKSTestInfo("XEN")
@@ -49,7 +49,7 @@ func TestAccResourceNcloudNKSNodePool_basic_XEN(t *testing.T) {
 func TestAccResourceNcloudNKSNodePool_basic_KVM(t *testing.T) {
 	validateAcctestEnvironment(t)
 
-	clusterName := GetTestClusterName()
+	clusterName := GetTestClusterName(t)
 	resourceName := "ncloud_nks_node_pool.node_pool"
 
 	nksInfo, err := getNKSTestInfo("KVM")
@@ -80,7 +80,7 @@ func TestAccResourceNcloudNKSNodePool_Update_XEN(t *testing.T) {
 
 	var nodePool vnks.NodePool
 
-	clusterName := fmt.Sprintf("m3-%s", GetTestClusterName())
+	clusterName := fmt.Sprintf("m3-%s", GetTestClusterName(t))
 	resourceName := "ncloud_nks_node_pool.node_pool"
 
 	nksInfo, err := getNKSTestInfo("XEN")
@@ -111,7 +111,7 @@ func TestAccResourceNcloudNKSNodePool_Update_KVM(t *testing.T) {
 	validateAcctestEnvironment(t)
 
 	var nodePool vnks.NodePool
-	clusterName := fmt.Sprintf("m3-%s", GetTestClusterName())
+	clusterName := fmt.Sprintf("m3-%s", GetTestClusterName(t))
 	resourceName := "ncloud_nks_node_pool.node_pool"
 
 	nksInfo, err := getNKSTestInfo("KVM")
@@ -142,7 +142,7 @@ func TestAccResourceNcloudNKSNodePool_Update_KVM(t *testing.T) {
 func TestAccResourceNcloudNKSNodePool_publicNetwork_XEN(t *testing.T) {
 	validateAcctestEnvironment(t)
 
-	clusterName := GetTestClusterName()
+	clusterName := GetTestClusterName(t)
 	resourceName := "ncloud_nks_node_pool.node_pool"
 
 	nksInfo, err := getNKSTestInfo("XEN")
@@ -165,7 +165,7 @@ func TestAccResourceNcloudNKSNodePool_publicNetwork_XEN(t *testing.T) {
 func TestAccResourceNcloudNKSNodePool_publicNetwork_KVM(t *testing.T) {
 	validateAcctestEnvironment(t)
 
-	clusterName := GetTestClusterName()
+	clusterName := GetTestClusterName(t)
 	resourceName := "ncloud_nks_node_pool.node_pool"
 
 	nksInfo, err := getNKSTestInfo("KVM")
@@ -13,7 +13,7 @@ import (
 func TestAccDataSourceNcloudNKSNodePools(t *testing.T) {
 	validateAcctestEnvironment(t)
 
-	clusterName := GetTestClusterName()
+	clusterName := GetTestClusterName(t)
 	nksInfo, err := getNKSTestInfo("XEN")
 	if err != nil {
 		t.Error(err)
@@ -6,7 +6,9 @@ import (
 	"time"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vnks"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/common"
@@ -15,7 +17,7 @@ import (
 
 func DataSourceNcloudNKSServerImages() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNcloudNKSServerImagesRead,
+		ReadContext: dataSourceNcloudNKSServerImagesRead,
 
 		Schema: map[string]*schema.Schema{
 			"filter": DataSourceFiltersSchema(),
@@ -43,15 +45,15 @@ func DataSourceNcloudNKSServerImages() *schema.Resource {
 	}
 }
 
-func dataSourceNcloudNKSServerImagesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceNcloudNKSServerImagesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*conn.ProviderConfig)
 	if !config.SupportVPC {
-		return NotSupportClassic("datasource `ncloud_nks_node_pool_server_images`")
+		return diag.FromErr(NotSupportClassic("datasource `ncloud_nks_node_pool_server_images`"))
 	}
 
-	resources, err := getNKSServerImages(config, d)
+	resources, err := getNKSServerImages(ctx, config, d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if f, ok := d.GetOk("filter"); ok {
@@ -60,32 +62,39 @@ func dataSourceNcloudNKSServerImagesRead(d *schema.ResourceData, meta interface{
 
 	d.SetId(time.Now().UTC().String())
 	if err := d.Set("images", resources); err != nil {
-		return fmt.Errorf("Error setting Codes: %s", err)
+		return diag.FromErr(fmt.Errorf("Error setting Codes: %s", err))
 	}
 
 	return nil
 
 }
 
-func getNKSServerImages(config *conn.ProviderConfig, d *schema.ResourceData) ([]map[string]interface{}, error) {
-
-	LogCommonRequest("GetNKSServerImages", "")
+func getNKSServerImages(ctx context.Context, config *conn.ProviderConfig, d *schema.ResourceData) ([]map[string]interface{}, error) {
 	hypervisorCode := StringPtrOrNil(d.GetOk("hypervisor_code"))
 
+	return getNKSServerImageList(ctx, config, hypervisorCode)
+}
+
+func getNKSServerImageList(ctx context.Context, config *conn.ProviderConfig, hypervisorCode *string) ([]map[string]interface{}, error) {
 	opt := make(map[string]interface{})
 	if hypervisorCode != nil {
 		opt["hypervisorCode"] = hypervisorCode
 	}
 
-	resp, err := config.Client.Vnks.V2Api.OptionServerImageGet(context.Background(), opt)
-
+	resp, err := conn.CachedLookup(config, conn.CatalogKey("vnks.OptionServerImageGet", opt), func() (*vnks.OptionsRes, error) {
+		ctx := LogCommonRequest(ctx, "GetNKSServerImages", "")
+		resp, err := config.Client.Vnks.V2Api.OptionServerImageGet(ctx, opt)
+		if err != nil {
+			LogErrorResponse(ctx, "GetNKSServerImages", err, "")
+			return nil, err
+		}
+		LogResponse(ctx, "GetNKSServerImages", resp)
+		return resp, nil
+	})
 	if err != nil {
-		LogErrorResponse("GetNKSServerImages", err, "")
 		return nil, err
 	}
 
-	LogResponse("GetNKSServerImages", resp)
-
 	resources := []map[string]interface{}{}
 
 	for _, r := range *resp {
@@ -7,6 +7,8 @@ import (
 	"time"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vnks"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/common"
@@ -15,7 +17,7 @@ import (
 
 func DataSourceNcloudNKSServerProducts() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNcloudNKSServerProductsRead,
+		ReadContext: dataSourceNcloudNKSServerProductsRead,
 		Schema: map[string]*schema.Schema{
 			"filter": DataSourceFiltersSchema(),
 			"products": {
@@ -129,16 +131,16 @@ func DataSourceNcloudNKSServerProducts() *schema.Resource {
 	}
 }
 
-func dataSourceNcloudNKSServerProductsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceNcloudNKSServerProductsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*conn.ProviderConfig)
 	if !config.SupportVPC {
-		return NotSupportClassic("datasource `ncloud_nks_server_products`")
+		return diag.FromErr(NotSupportClassic("datasource `ncloud_nks_server_products`"))
 	}
 
-	resources, err := getNKSServerProducts(config, d)
+	resources, err := getNKSServerProducts(ctx, config, d)
 	if err != nil {
-		LogErrorResponse("GetNKSServerProducts", err, "")
-		return err
+		LogErrorResponse(ctx, "GetNKSServerProducts", err, "")
+		return diag.FromErr(err)
 	}
 
 	if f, ok := d.GetOk("filter"); ok {
@@ -147,29 +149,38 @@ func dataSourceNcloudNKSServerProductsRead(d *schema.ResourceData, meta interfac
 
 	d.SetId(time.Now().UTC().String())
 	if err := d.Set("products", resources); err != nil {
-		return fmt.Errorf("Error setting Codes: %s", err)
+		return diag.FromErr(fmt.Errorf("Error setting Codes: %s", err))
 	}
 
 	return nil
 }
 
-func getNKSServerProducts(config *conn.ProviderConfig, d *schema.ResourceData) ([]map[string]interface{}, error) {
-	LogCommonRequest("GetNKSServerProducts", "")
-
+func getNKSServerProducts(ctx context.Context, config *conn.ProviderConfig, d *schema.ResourceData) ([]map[string]interface{}, error) {
 	softwareCode := StringPtrOrNil(d.GetOk("software_code"))
 	zoneCode := StringPtrOrNil(d.GetOk("zone"))
 
+	return getNKSServerProductList(ctx, config, softwareCode, zoneCode)
+}
+
+func getNKSServerProductList(ctx context.Context, config *conn.ProviderConfig, softwareCode, zoneCode *string) ([]map[string]interface{}, error) {
 	opt := make(map[string]interface{})
 	opt["zoneCode"] = zoneCode
-	resp, err := config.Client.Vnks.V2Api.OptionServerProductCodeGet(context.Background(), softwareCode, opt)
 
+	key := conn.CatalogKey("vnks.OptionServerProductCodeGet", map[string]interface{}{"softwareCode": softwareCode, "zoneCode": zoneCode})
+	resp, err := conn.CachedLookup(config, key, func() (*vnks.OptionsResForServerProduct, error) {
+		ctx := LogCommonRequest(ctx, "GetNKSServerProducts", "")
+		resp, err := config.Client.Vnks.V2Api.OptionServerProductCodeGet(ctx, softwareCode, opt)
+		if err != nil {
+			LogErrorResponse(ctx, "GetNKSServerProducts", err, "")
+			return nil, err
+		}
+		LogResponse(ctx, "GetNKSServerProducts", resp)
+		return resp, nil
+	})
 	if err != nil {
-		LogErrorResponse("GetNKSServerProducts", err, "")
 		return nil, err
 	}
 
-	LogResponse("GetNKSServerProducts", resp)
-
 	resources := []map[string]interface{}{}
 
 	for _, r := range *resp {
@@ -6,7 +6,9 @@ import (
 	"time"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vnks"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/common"
@@ -15,7 +17,7 @@ import (
 
 func DataSourceNcloudNKSVersions() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNcloudVersionsRead,
+		ReadContext: dataSourceNcloudVersionsRead,
 
 		Schema: map[string]*schema.Schema{
 			"filter": DataSourceFiltersSchema(),
@@ -43,15 +45,15 @@ func DataSourceNcloudNKSVersions() *schema.Resource {
 	}
 }
 
-func dataSourceNcloudVersionsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceNcloudVersionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*conn.ProviderConfig)
 	if !config.SupportVPC {
-		return NotSupportClassic("datasource `ncloud_nks_versions`")
+		return diag.FromErr(NotSupportClassic("datasource `ncloud_nks_versions`"))
 	}
 
-	resources, err := getNKSVersion(config, d)
+	resources, err := getNKSVersion(ctx, config, d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if f, ok := d.GetOk("filter"); ok {
@@ -60,16 +62,14 @@ func dataSourceNcloudVersionsRead(d *schema.ResourceData, meta interface{}) erro
 
 	d.SetId(time.Now().UTC().String())
 	if err := d.Set("versions", resources); err != nil {
-		return fmt.Errorf("Error setting Versions: %s", err)
+		return diag.FromErr(fmt.Errorf("Error setting Versions: %s", err))
 	}
 
 	return nil
 
 }
 
-func getNKSVersion(config *conn.ProviderConfig, d *schema.ResourceData) ([]map[string]interface{}, error) {
-
-	LogCommonRequest("GetNKSVersion", "")
+func getNKSVersion(ctx context.Context, config *conn.ProviderConfig, d *schema.ResourceData) ([]map[string]interface{}, error) {
 	hypervisorCode := StringPtrOrNil(d.GetOk("hypervisor_code"))
 
 	opt := make(map[string]interface{})
@@ -77,15 +77,20 @@ func getNKSVersion(config *conn.ProviderConfig, d *schema.ResourceData) ([]map[s
 		opt["hypervisorCode"] = hypervisorCode
 	}
 
-	resp, err := config.Client.Vnks.V2Api.OptionVersionGet(context.Background(), opt)
-
+	resp, err := conn.CachedLookup(config, conn.CatalogKey("vnks.OptionVersionGet", opt), func() (*vnks.OptionsRes, error) {
+		ctx := LogCommonRequest(ctx, "GetNKSVersion", "")
+		resp, err := config.Client.Vnks.V2Api.OptionVersionGet(ctx, opt)
+		if err != nil {
+			LogErrorResponse(ctx, "GetNKSVersion", err, "")
+			return nil, err
+		}
+		LogResponse(ctx, "GetNKSVersion", resp)
+		return resp, nil
+	})
 	if err != nil {
-		LogErrorResponse("GetNKSVersion", err, "")
 		return nil, err
 	}
 
-	LogResponse("GetNKSVersion", resp)
-
 	resources := []map[string]interface{}{}
 
 	for _, r := range *resp {
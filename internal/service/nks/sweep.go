@@ -0,0 +1,96 @@
+package nks
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/sweep"
+)
+
+func RegisterSweepers() {
+	sweep.AddTestSweepers("ncloud_nks_cluster", &resource.Sweeper{
+		Name: "ncloud_nks_cluster",
+		F:    sweepNKSClusters,
+		Dependencies: []string{
+			"ncloud_nks_node_pool",
+		},
+	})
+
+	sweep.AddTestSweepers("ncloud_nks_node_pool", &resource.Sweeper{
+		Name: "ncloud_nks_node_pool",
+		F:    sweepNKSNodePools,
+	})
+}
+
+func sweepNKSClusters(region string) error {
+	config, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+	if !config.SupportVPC {
+		return nil
+	}
+
+	ctx := context.Background()
+	clusters, err := GetNKSClusters(ctx, config)
+	if sweep.SkipSweepError(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("listing NKS clusters: %w", err)
+	}
+
+	var sweepables []sweep.Sweepable
+	for _, c := range clusters {
+		if !sweep.HasResourcePrefix(c.Name) {
+			continue
+		}
+		sweepables = append(sweepables, sweep.NewSweepResource(ResourceNcloudNKSCluster(), config, *c.Uuid, nil))
+	}
+
+	return sweep.SweepOrchestrator(ctx, sweepables)
+}
+
+// sweepNKSNodePools removes the node pools of the clusters of acceptance tests
+func sweepNKSNodePools(region string) error {
+	config, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+	if !config.SupportVPC {
+		return nil
+	}
+
+	ctx := context.Background()
+	clusters, err := GetNKSClusters(ctx, config)
+	if sweep.SkipSweepError(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("listing NKS clusters: %w", err)
+	}
+
+	var sweepables []sweep.Sweepable
+	for _, c := range clusters {
+		if !sweep.HasResourcePrefix(c.Name) {
+			continue
+		}
+
+		nodePools, err := getNKSNodePools(ctx, config, *c.Uuid)
+		if err != nil {
+			return fmt.Errorf("listing node pools of NKS cluster %s: %w", *c.Uuid, err)
+		}
+
+		for _, np := range nodePools {
+			sweepables = append(sweepables, sweep.NewSweepResource(ResourceNcloudNKSNodePool(), config, NodePoolCreateResourceID(*c.Uuid, *np.Name), map[string]interface{}{
+				"instance_no": strconv.Itoa(int(ncloud.Int32Value(np.InstanceNo))),
+			}))
+		}
+	}
+
+	return sweep.SweepOrchestrator(ctx, sweepables)
+}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/service/s3"
+	awsTypes "github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -16,11 +17,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/framework"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/waiter"
 )
 
 const (
@@ -56,7 +56,7 @@ func (o *bucketResource) Create(ctx context.Context, req resource.CreateRequest,
 		Bucket: plan.BucketName.ValueStringPointer(),
 	}
 
-	tflog.Info(ctx, "CreateObjectStorage reqParams="+common.MarshalUncheckedString(reqParams))
+	ctx = common.LogCommonRequest(ctx, "CreateObjectStorage", reqParams)
 
 	response, err := o.config.Client.ObjectStorage.CreateBucket(ctx, reqParams)
 	if err != nil {
@@ -68,7 +68,7 @@ func (o *bucketResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	tflog.Info(ctx, "CreateObjectStorage response="+common.MarshalUncheckedString(response))
+	common.LogResponse(ctx, "CreateObjectStorage", response)
 
 	err = waitBucketCreated(ctx, o.config, plan.BucketName.ValueString())
 	if err != nil {
@@ -96,7 +96,7 @@ func (o *bucketResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		Bucket: plan.BucketName.ValueStringPointer(),
 	}
 
-	tflog.Info(ctx, "DeleteBucket reqParams="+common.MarshalUncheckedString(reqParams))
+	ctx = common.LogCommonRequest(ctx, "DeleteBucket", reqParams)
 
 	response, err := o.config.Client.ObjectStorage.DeleteBucket(ctx, reqParams)
 	if err != nil {
@@ -104,7 +104,7 @@ func (o *bucketResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
-	tflog.Info(ctx, "DeleteBucket response="+common.MarshalUncheckedString(response))
+	common.LogResponse(ctx, "DeleteBucket", response)
 
 	if err := waitBucketDeleted(ctx, o.config, plan.BucketName.ValueString()); err != nil {
 		resp.Diagnostics.AddError("WAITING FOR DELETE ERROR", err.Error())
@@ -193,41 +193,41 @@ func (o *bucketResource) ImportState(ctx context.Context, req resource.ImportSta
 }
 
 func waitBucketCreated(ctx context.Context, config *conn.ProviderConfig, bucketName string) error {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &waiter.Config[*awsTypes.Bucket]{
 		Pending: []string{CREATING},
 		Target:  []string{CREATED},
-		Refresh: func() (interface{}, string, error) {
+		Refresh: func(ctx context.Context) (*awsTypes.Bucket, string, error) {
 
 			// Since HeadBucket does not work when bucket created immediately, use ListBuckets instead for check bucket creation operated successfully.
 			output, err := config.Client.ObjectStorage.ListBuckets(ctx, &s3.ListBucketsInput{})
 			if err != nil {
-				return 0, "", fmt.Errorf("ListBuckets is nil")
+				return nil, "", fmt.Errorf("ListBuckets is nil")
 			}
 
 			for _, bucket := range output.Buckets {
 				if *bucket.Name == bucketName {
-					return bucket, CREATED, nil
+					return &bucket, CREATED, nil
 				}
 			}
 
-			return output.Buckets, CREATING, nil
+			return nil, CREATING, nil
 		},
-		Timeout:    conn.DefaultTimeout,
-		Delay:      5 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Timeout:     conn.DefaultTimeout,
+		Delay:       5 * time.Second,
+		MinInterval: 3 * time.Second,
 	}
 
-	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+	if _, err := stateConf.WaitForState(ctx); err != nil {
 		return fmt.Errorf("error waiting for object storage (%s) to become terminating: %s", bucketName, err)
 	}
 	return nil
 }
 
 func waitBucketDeleted(ctx context.Context, config *conn.ProviderConfig, bucketName string) error {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &waiter.Config[any]{
 		Pending: []string{DELETING},
 		Target:  []string{DELETED},
-		Refresh: func() (interface{}, string, error) {
+		Refresh: func(ctx context.Context) (any, string, error) {
 			output, err := config.Client.ObjectStorage.ListBuckets(ctx, &s3.ListBucketsInput{})
 			if err != nil {
 				return 0, "", fmt.Errorf("ListBuckets is nil")
@@ -241,12 +241,12 @@ func waitBucketDeleted(ctx context.Context, config *conn.ProviderConfig, bucketN
 
 			return output.Buckets, DELETED, nil
 		},
-		Timeout:    2 * conn.DefaultTimeout,
-		Delay:      5 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Timeout:     2 * conn.DefaultTimeout,
+		Delay:       5 * time.Second,
+		MinInterval: 3 * time.Second,
 	}
 
-	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+	if _, err := stateConf.WaitForState(ctx); err != nil {
 		return fmt.Errorf("error waiting for object storage (%s) to become terminating: %s", bucketName, err)
 	}
 
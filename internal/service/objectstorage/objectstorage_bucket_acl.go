@@ -20,11 +20,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/framework"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/waiter"
 )
 
 const (
@@ -125,7 +124,7 @@ func (b *bucketACLResource) Create(ctx context.Context, req resource.CreateReque
 		ACL:    *plan.Rule,
 	}
 
-	tflog.Info(ctx, "PutBucketACL reqParams="+common.MarshalUncheckedString(reqParams))
+	ctx = common.LogCommonRequest(ctx, "PutBucketACL", reqParams)
 
 	response, err := b.config.Client.ObjectStorage.PutBucketAcl(ctx, reqParams)
 	if err != nil {
@@ -133,7 +132,7 @@ func (b *bucketACLResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
-	tflog.Info(ctx, "PutBucketACL response="+common.MarshalUncheckedString(response))
+	common.LogResponse(ctx, "PutBucketACL", response)
 
 	if err := waitBucketACLApplied(ctx, b.config, bucketName); err != nil {
 		resp.Diagnostics.AddError("CREATING ERROR", err.Error())
@@ -164,6 +163,11 @@ func (b *bucketACLResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	if plan.ID.IsNull() {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -188,7 +192,7 @@ func (b *bucketACLResource) Update(ctx context.Context, req resource.UpdateReque
 			ACL:    *plan.Rule,
 		}
 
-		tflog.Info(ctx, "PutBucketACL update operation reqParams="+common.MarshalUncheckedString(reqParams))
+		ctx = common.LogCommonRequest(ctx, "PutBucketACL", reqParams)
 
 		response, err := b.config.Client.ObjectStorage.PutBucketAcl(ctx, reqParams)
 		if err != nil {
@@ -196,7 +200,7 @@ func (b *bucketACLResource) Update(ctx context.Context, req resource.UpdateReque
 			return
 		}
 
-		tflog.Info(ctx, "PutBucketACL update operation response="+common.MarshalUncheckedString(response))
+		common.LogResponse(ctx, "PutBucketACL", response)
 
 		if err := waitBucketACLApplied(ctx, b.config, bucketName); err != nil {
 			resp.Diagnostics.AddError("UPDATING ERROR", err.Error())
@@ -239,10 +243,10 @@ func (b *bucketACLResource) ImportState(ctx context.Context, req resource.Import
 }
 
 func waitBucketACLApplied(ctx context.Context, config *conn.ProviderConfig, bucketName string) error {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &waiter.Config[any]{
 		Pending: []string{APPLYING},
 		Target:  []string{APPLIED},
-		Refresh: func() (interface{}, string, error) {
+		Refresh: func(ctx context.Context) (any, string, error) {
 			output, err := config.Client.ObjectStorage.GetBucketAcl(ctx, &s3.GetBucketAclInput{
 				Bucket: ncloud.String(bucketName),
 			})
@@ -257,12 +261,12 @@ func waitBucketACLApplied(ctx context.Context, config *conn.ProviderConfig, buck
 
 			return output, APPLYING, nil
 		},
-		Timeout:    conn.DefaultTimeout,
-		Delay:      5 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Timeout:     conn.DefaultTimeout,
+		Delay:       5 * time.Second,
+		MinInterval: 3 * time.Second,
 	}
 
-	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+	if _, err := stateConf.WaitForState(ctx); err != nil {
 		return fmt.Errorf("error waiting for bucket acl (%s) to be applied: %s", bucketName, err)
 	}
 	return nil
@@ -280,6 +284,10 @@ func (b *bucketACLResourceModel) refreshFromOutput(ctx context.Context, config *
 	output, err := config.Client.ObjectStorage.GetBucketAcl(ctx, &s3.GetBucketAclInput{
 		Bucket: ncloud.String(bucketName),
 	})
+	if common.IsNotFound(err) {
+		b.ID = types.StringNull()
+		return
+	}
 	if err != nil {
 		diag.AddError("GetBucketAcl ERROR", err.Error())
 		return
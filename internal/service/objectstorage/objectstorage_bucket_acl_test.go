@@ -8,7 +8,6 @@ import (
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	awsTypes "github.com/aws/aws-sdk-go-v2/service/s3/types"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -19,12 +18,12 @@ import (
 
 func TestAccResourceNcloudObjectStorage_bucket_acl_basic(t *testing.T) {
 	var aclOutput s3.GetBucketAclOutput
-	bucketName := fmt.Sprintf("tf-test-%s", acctest.RandString(5))
+	bucketName := fmt.Sprintf("tf-test-%s", RandString(t, 5))
 	aclOptions := []string{string(awsTypes.BucketCannedACLPrivate),
 		string(awsTypes.BucketCannedACLPublicRead),
 		string(awsTypes.BucketCannedACLPublicReadWrite),
 		string(awsTypes.BucketCannedACLAuthenticatedRead)}
-	acl := aclOptions[acctest.RandIntRange(0, len(aclOptions)-1)]
+	acl := aclOptions[RandIntRange(t, 0, len(aclOptions)-1)]
 	resourceName := "ncloud_objectstorage_bucket_acl.testing_acl"
 
 	resource.Test(t, resource.TestCase{
@@ -50,7 +49,7 @@ func TestAccResourceNcloudObjectStorage_bucket_acl_basic(t *testing.T) {
 
 func TestAccResourceNcloudObjectStorage_bucket_acl_update(t *testing.T) {
 	var aclOutput s3.GetBucketAclOutput
-	bucketName := fmt.Sprintf("tf-test-%s", acctest.RandString(5))
+	bucketName := fmt.Sprintf("tf-test-%s", RandString(t, 5))
 
 	acl := "public-read"
 	newACL := "private"
@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"testing"
 
-	randacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/acctest"
@@ -13,7 +12,7 @@ import (
 func TestAccDataSourceNcloudObjectStorage_bucket_basic(t *testing.T) {
 	dataName := "data.ncloud_objectstorage_bucket.by_name"
 	resourceName := "ncloud_objectstorage_bucket.testing_bucket"
-	testBucketName := fmt.Sprintf("tf-bucket-%s", randacctest.RandString(4))
+	testBucketName := fmt.Sprintf("tf-bucket-%s", RandString(t, 4))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { TestAccPreCheck(t) },
@@ -15,11 +15,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/framework"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/waiter"
 )
 
 var (
@@ -73,7 +72,7 @@ func (o *objectResource) Create(ctx context.Context, req resource.CreateRequest,
 		reqParams.WebsiteRedirectLocation = plan.WebsiteRedirectLocation.ValueStringPointer()
 	}
 
-	tflog.Info(ctx, "PutObject reqParams="+common.MarshalUncheckedString(reqParams))
+	ctx = common.LogCommonRequest(ctx, "PutObject", reqParams)
 
 	output, err := o.config.Client.ObjectStorage.PutObject(ctx, reqParams)
 	if err != nil {
@@ -85,7 +84,7 @@ func (o *objectResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	tflog.Info(ctx, "PutObject response="+common.MarshalUncheckedString(output))
+	common.LogResponse(ctx, "PutObject", output)
 
 	if err := waitObjectUploaded(ctx, o.config, plan.Bucket.ValueString(), plan.Key.ValueString()); err != nil {
 		resp.Diagnostics.AddError("CREATING ERROR", err.Error())
@@ -113,7 +112,7 @@ func (o *objectResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		Key:    plan.Key.ValueStringPointer(),
 	}
 
-	tflog.Info(ctx, "DeleteObject reqParams="+common.MarshalUncheckedString(reqParams))
+	ctx = common.LogCommonRequest(ctx, "DeleteObject", reqParams)
 
 	response, err := o.config.Client.ObjectStorage.DeleteObject(ctx, reqParams)
 	if err != nil {
@@ -121,7 +120,7 @@ func (o *objectResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
-	tflog.Info(ctx, "DeleteObject response="+common.MarshalUncheckedString(response))
+	common.LogResponse(ctx, "DeleteObject", response)
 
 	if err := waitObjectDeleted(ctx, o.config, plan.Bucket.ValueString(), plan.Key.ValueString()); err != nil {
 		resp.Diagnostics.AddError("WAITING FOR DELETE ERROR", err.Error())
@@ -145,6 +144,11 @@ func (o *objectResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
+	if plan.ID.IsNull() {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -253,7 +257,7 @@ func (o *objectResource) Update(ctx context.Context, req resource.UpdateRequest,
 			Key:    state.Key.ValueStringPointer(),
 		}
 
-		tflog.Info(ctx, "GetObject at update operation reqParams="+common.MarshalUncheckedString(getReqParams))
+		ctx = common.LogCommonRequest(ctx, "GetObject", getReqParams)
 
 		getOutput, err := o.config.Client.ObjectStorage.GetObject(ctx, getReqParams)
 		if err != nil {
@@ -265,7 +269,7 @@ func (o *objectResource) Update(ctx context.Context, req resource.UpdateRequest,
 			return
 		}
 
-		tflog.Info(ctx, "GetObject at update operation response="+common.MarshalUncheckedString(getOutput))
+		common.LogResponse(ctx, "GetObject", getOutput)
 
 		reqParams.Body = getOutput.Body
 	}
@@ -287,7 +291,7 @@ func (o *objectResource) Update(ctx context.Context, req resource.UpdateRequest,
 		reqParams.ContentType = plan.ContentType.ValueStringPointer()
 	}
 
-	tflog.Info(ctx, "PutObject at update operation reqParams="+common.MarshalUncheckedString(reqParams))
+	ctx = common.LogCommonRequest(ctx, "PutObject", reqParams)
 
 	output, err := o.config.Client.ObjectStorage.PutObject(ctx, reqParams)
 	if err != nil {
@@ -299,7 +303,7 @@ func (o *objectResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	tflog.Info(ctx, "PutObject at update operation response="+common.MarshalUncheckedString(output))
+	common.LogResponse(ctx, "PutObject", output)
 
 	if err := waitObjectUploaded(ctx, o.config, plan.Bucket.ValueString(), plan.Key.ValueString()); err != nil {
 		resp.Diagnostics.AddError("UPDATING ERROR", err.Error())
@@ -348,10 +352,10 @@ func (o *objectResource) Configure(_ context.Context, req resource.ConfigureRequ
 }
 
 func waitObjectUploaded(ctx context.Context, config *conn.ProviderConfig, bucketName, key string) error {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &waiter.Config[any]{
 		Pending: []string{CREATING},
 		Target:  []string{CREATED},
-		Refresh: func() (interface{}, string, error) {
+		Refresh: func(ctx context.Context) (any, string, error) {
 			output, err := config.Client.ObjectStorage.HeadObject(ctx, &s3.HeadObjectInput{
 				Bucket: &bucketName,
 				Key:    &key,
@@ -366,22 +370,22 @@ func waitObjectUploaded(ctx context.Context, config *conn.ProviderConfig, bucket
 
 			return output, CREATING, nil
 		},
-		Timeout:    conn.DefaultTimeout,
-		Delay:      5 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Timeout:     conn.DefaultTimeout,
+		Delay:       5 * time.Second,
+		MinInterval: 3 * time.Second,
 	}
 
-	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+	if _, err := stateConf.WaitForState(ctx); err != nil {
 		return fmt.Errorf("error waiting for object (%s) to be upload: %s", key, err)
 	}
 	return nil
 }
 
 func waitObjectDeleted(ctx context.Context, config *conn.ProviderConfig, bucketName, key string) error {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &waiter.Config[any]{
 		Pending: []string{DELETING},
 		Target:  []string{DELETED},
-		Refresh: func() (interface{}, string, error) {
+		Refresh: func(ctx context.Context) (any, string, error) {
 			output, err := config.Client.ObjectStorage.HeadObject(ctx, &s3.HeadObjectInput{
 				Bucket: &bucketName,
 				Key:    &key,
@@ -396,12 +400,12 @@ func waitObjectDeleted(ctx context.Context, config *conn.ProviderConfig, bucketN
 
 			return output, DELETED, nil
 		},
-		Timeout:    conn.DefaultTimeout,
-		Delay:      5 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Timeout:     conn.DefaultTimeout,
+		Delay:       5 * time.Second,
+		MinInterval: 3 * time.Second,
 	}
 
-	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+	if _, err := stateConf.WaitForState(ctx); err != nil {
 		return fmt.Errorf("error waiting for object (%s) to be upload: %s", key, err)
 	}
 	return nil
@@ -430,6 +434,10 @@ func (o *objectResourceModel) refreshFromOutput(ctx context.Context, config *con
 		Bucket: o.Bucket.ValueStringPointer(),
 		Key:    o.Key.ValueStringPointer(),
 	})
+	if common.IsNotFound(err) {
+		o.ID = types.StringNull()
+		return
+	}
 	if err != nil {
 		diag.AddError("HeadObject ERROR", err.Error())
 		return
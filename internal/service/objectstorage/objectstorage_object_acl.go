@@ -18,11 +18,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/framework"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/waiter"
 
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	awsTypes "github.com/aws/aws-sdk-go-v2/service/s3/types"
@@ -58,7 +57,7 @@ func (o *objectACLResource) Create(ctx context.Context, req resource.CreateReque
 		ACL:    *plan.Rule,
 	}
 
-	tflog.Info(ctx, "PutObjectACL reqParams="+common.MarshalUncheckedString(reqParams))
+	ctx = common.LogCommonRequest(ctx, "PutObjectACL", reqParams)
 
 	response, err := o.config.Client.ObjectStorage.PutObjectAcl(ctx, reqParams)
 	if err != nil {
@@ -66,7 +65,7 @@ func (o *objectACLResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
-	tflog.Info(ctx, "PutObjectACL response="+common.MarshalUncheckedString(response))
+	common.LogResponse(ctx, "PutObjectACL", response)
 
 	if err := waitObjectACLApplied(ctx, o.config, bucketName, key); err != nil {
 		resp.Diagnostics.AddError("CREATING ERROR", err.Error())
@@ -101,6 +100,11 @@ func (o *objectACLResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	if plan.ID.IsNull() {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -195,7 +199,7 @@ func (o *objectACLResource) Update(ctx context.Context, req resource.UpdateReque
 			ACL:    *plan.Rule,
 		}
 
-		tflog.Info(ctx, "PutObjectACL update operation reqParams="+common.MarshalUncheckedString(reqParams))
+		ctx = common.LogCommonRequest(ctx, "PutObjectACL", reqParams)
 
 		response, err := o.config.Client.ObjectStorage.PutObjectAcl(ctx, reqParams)
 		if err != nil {
@@ -203,7 +207,7 @@ func (o *objectACLResource) Update(ctx context.Context, req resource.UpdateReque
 			return
 		}
 
-		tflog.Info(ctx, "PutObjectACL update operation response="+common.MarshalUncheckedString(response))
+		common.LogResponse(ctx, "PutObjectACL", response)
 
 		if err := waitObjectACLApplied(ctx, o.config, bucketName, key); err != nil {
 			resp.Diagnostics.AddError("UPDATING ERROR", err.Error())
@@ -243,10 +247,10 @@ func (o *objectACLResource) Configure(_ context.Context, req resource.ConfigureR
 }
 
 func waitObjectACLApplied(ctx context.Context, config *conn.ProviderConfig, bucketName, key string) error {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &waiter.Config[any]{
 		Pending: []string{APPLYING},
 		Target:  []string{APPLIED},
-		Refresh: func() (interface{}, string, error) {
+		Refresh: func(ctx context.Context) (any, string, error) {
 			output, err := config.Client.ObjectStorage.GetObjectAcl(ctx, &s3.GetObjectAclInput{
 				Bucket: ncloud.String(bucketName),
 				Key:    ncloud.String(key),
@@ -261,12 +265,12 @@ func waitObjectACLApplied(ctx context.Context, config *conn.ProviderConfig, buck
 
 			return output, APPLYING, nil
 		},
-		Timeout:    conn.DefaultTimeout,
-		Delay:      5 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Timeout:     conn.DefaultTimeout,
+		Delay:       5 * time.Second,
+		MinInterval: 3 * time.Second,
 	}
 
-	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+	if _, err := stateConf.WaitForState(ctx); err != nil {
 		return fmt.Errorf("error waiting for object acl (%s) to be applied: %s", key, err)
 	}
 	return nil
@@ -288,6 +292,10 @@ func (o *objectACLResourceModel) refreshFromOutput(ctx context.Context, config *
 		Bucket: ncloud.String(bucketName),
 		Key:    ncloud.String(key),
 	})
+	if common.IsNotFound(err) {
+		o.ID = types.StringNull()
+		return
+	}
 	if err != nil {
 		diag.AddError("GetObjectAcl ERROR", err.Error())
 		return
@@ -8,7 +8,6 @@ import (
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -20,15 +19,15 @@ import (
 )
 
 func TestAccResourceNcloudObjectStorage_object_acl_basic(t *testing.T) {
-	bucketName := fmt.Sprintf("tf-bucket-%s", acctest.RandString(5))
-	sourceName := fmt.Sprintf("%s.md", acctest.RandString(5))
+	bucketName := fmt.Sprintf("tf-bucket-%s", RandString(t, 5))
+	sourceName := fmt.Sprintf("%s.md", RandString(t, 5))
 	key := "test/key/path" + sourceName
 	content := "content for file upload testing"
 	aclOptions := []string{string(awsTypes.ObjectCannedACLPrivate),
 		string(awsTypes.ObjectCannedACLPublicRead),
 		string(awsTypes.ObjectCannedACLPublicReadWrite),
 		string(awsTypes.ObjectCannedACLAuthenticatedRead)}
-	acl := aclOptions[acctest.RandIntRange(0, len(aclOptions)-1)]
+	acl := aclOptions[RandIntRange(t, 0, len(aclOptions)-1)]
 	resourceName := "ncloud_objectstorage_object_acl.testing_acl"
 
 	tmpFile := CreateTempFile(t, content, sourceName)
@@ -57,8 +56,8 @@ func TestAccResourceNcloudObjectStorage_object_acl_basic(t *testing.T) {
 }
 
 func TestAccResourceNcloudObjectStorage_object_acl_update(t *testing.T) {
-	bucketName := fmt.Sprintf("tf-bucket-%s", acctest.RandString(5))
-	sourceName := fmt.Sprintf("%s.md", acctest.RandString(5))
+	bucketName := fmt.Sprintf("tf-bucket-%s", RandString(t, 5))
+	sourceName := fmt.Sprintf("%s.md", RandString(t, 5))
 	key := "test/key/path" + sourceName
 	content := "content for file upload testing"
 
@@ -13,11 +13,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/framework"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/waiter"
 )
 
 var (
@@ -82,7 +81,7 @@ func (o *objectCopyResource) Create(ctx context.Context, req resource.CreateRequ
 		reqParams.WebsiteRedirectLocation = plan.WebsiteRedirectLocation.ValueStringPointer()
 	}
 
-	tflog.Info(ctx, "CopyObject reqParams="+common.MarshalUncheckedString(reqParams))
+	ctx = common.LogCommonRequest(ctx, "CopyObject", reqParams)
 
 	output, err := o.config.Client.ObjectStorage.CopyObject(ctx, reqParams)
 	if err != nil {
@@ -94,7 +93,7 @@ func (o *objectCopyResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
-	tflog.Info(ctx, "CopyObject response="+common.MarshalUncheckedString(output))
+	common.LogResponse(ctx, "CopyObject", output)
 
 	if err := waitObjectCopied(ctx, o.config, plan.Bucket.ValueString(), plan.Key.ValueString()); err != nil {
 		resp.Diagnostics.AddError("COPYING ERROR", err.Error())
@@ -121,7 +120,7 @@ func (o *objectCopyResource) Delete(ctx context.Context, req resource.DeleteRequ
 		Key:    plan.Key.ValueStringPointer(),
 	}
 
-	tflog.Info(ctx, "DeleteObject reqParams="+common.MarshalUncheckedString(reqParams))
+	ctx = common.LogCommonRequest(ctx, "DeleteObject", reqParams)
 
 	response, err := o.config.Client.ObjectStorage.DeleteObject(ctx, reqParams)
 	if err != nil {
@@ -129,7 +128,7 @@ func (o *objectCopyResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
-	tflog.Info(ctx, "DeleteObject response="+common.MarshalUncheckedString(response))
+	common.LogResponse(ctx, "DeleteObject", response)
 
 	if err := waitObjectCopyDeleted(ctx, o.config, plan.Bucket.ValueString(), plan.Key.ValueString()); err != nil {
 		resp.Diagnostics.AddError("WAITING FOR DELETE ERROR", err.Error())
@@ -168,6 +167,11 @@ func (o *objectCopyResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	if plan.ID.IsNull() {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -270,7 +274,7 @@ func (o *objectCopyResource) Update(ctx context.Context, req resource.UpdateRequ
 			reqParams.WebsiteRedirectLocation = plan.WebsiteRedirectLocation.ValueStringPointer()
 		}
 
-		tflog.Info(ctx, "CopyObject at update operation reqParams="+common.MarshalUncheckedString(reqParams))
+		ctx = common.LogCommonRequest(ctx, "CopyObject", reqParams)
 
 		output, err := o.config.Client.ObjectStorage.CopyObject(ctx, reqParams)
 		if err != nil {
@@ -282,7 +286,7 @@ func (o *objectCopyResource) Update(ctx context.Context, req resource.UpdateRequ
 			return
 		}
 
-		tflog.Info(ctx, "CopyObject at update operation response="+common.MarshalUncheckedString(output))
+		common.LogResponse(ctx, "CopyObject", output)
 
 		if err := waitObjectCopied(ctx, o.config, plan.Bucket.ValueString(), plan.Key.ValueString()); err != nil {
 			resp.Diagnostics.AddError("UPDATING ERROR", err.Error())
@@ -307,7 +311,7 @@ func (o *objectCopyResource) Update(ctx context.Context, req resource.UpdateRequ
 			Key:    state.Key.ValueStringPointer(),
 		}
 
-		tflog.Info(ctx, "GetObject at update operation reqParams="+common.MarshalUncheckedString(getReqParams))
+		ctx = common.LogCommonRequest(ctx, "GetObject", getReqParams)
 
 		getOutput, err := o.config.Client.ObjectStorage.GetObject(ctx, getReqParams)
 		if err != nil {
@@ -319,7 +323,7 @@ func (o *objectCopyResource) Update(ctx context.Context, req resource.UpdateRequ
 			return
 		}
 
-		tflog.Info(ctx, "GetObject at update operation response="+common.MarshalUncheckedString(getOutput))
+		common.LogResponse(ctx, "GetObject", getOutput)
 
 		reqParams := &s3.PutObjectInput{
 			Bucket: plan.Bucket.ValueStringPointer(),
@@ -329,7 +333,7 @@ func (o *objectCopyResource) Update(ctx context.Context, req resource.UpdateRequ
 			ContentType: plan.ContentType.ValueStringPointer(),
 		}
 
-		tflog.Info(ctx, "PutObject at update operation reqParams="+common.MarshalUncheckedString(reqParams))
+		ctx = common.LogCommonRequest(ctx, "PutObject", reqParams)
 
 		output, err := o.config.Client.ObjectStorage.PutObject(ctx, reqParams)
 		if err != nil {
@@ -341,7 +345,7 @@ func (o *objectCopyResource) Update(ctx context.Context, req resource.UpdateRequ
 			return
 		}
 
-		tflog.Info(ctx, "PutObject at update operation response="+common.MarshalUncheckedString(output))
+		common.LogResponse(ctx, "PutObject", output)
 
 		if err := waitObjectUploaded(ctx, o.config, plan.Bucket.ValueString(), plan.Key.ValueString()); err != nil {
 			resp.Diagnostics.AddError("UPDATING ERROR", err.Error())
@@ -358,10 +362,10 @@ func (o *objectCopyResource) Update(ctx context.Context, req resource.UpdateRequ
 }
 
 func waitObjectCopied(ctx context.Context, config *conn.ProviderConfig, bucketName string, key string) error {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &waiter.Config[any]{
 		Pending: []string{CREATING},
 		Target:  []string{CREATED},
-		Refresh: func() (interface{}, string, error) {
+		Refresh: func(ctx context.Context) (any, string, error) {
 			output, err := config.Client.ObjectStorage.HeadObject(ctx, &s3.HeadObjectInput{
 				Bucket: &bucketName,
 				Key:    &key,
@@ -376,22 +380,22 @@ func waitObjectCopied(ctx context.Context, config *conn.ProviderConfig, bucketNa
 
 			return output, CREATING, nil
 		},
-		Timeout:    conn.DefaultTimeout,
-		Delay:      5 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Timeout:     conn.DefaultTimeout,
+		Delay:       5 * time.Second,
+		MinInterval: 3 * time.Second,
 	}
 
-	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+	if _, err := stateConf.WaitForState(ctx); err != nil {
 		return fmt.Errorf("error waiting for object (%s) to be upload: %s", key, err)
 	}
 	return nil
 }
 
 func waitObjectCopyDeleted(ctx context.Context, config *conn.ProviderConfig, bucketName, key string) error {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &waiter.Config[any]{
 		Pending: []string{DELETING},
 		Target:  []string{DELETED},
-		Refresh: func() (interface{}, string, error) {
+		Refresh: func(ctx context.Context) (any, string, error) {
 			output, err := config.Client.ObjectStorage.HeadObject(ctx, &s3.HeadObjectInput{
 				Bucket: &bucketName,
 				Key:    &key,
@@ -406,12 +410,12 @@ func waitObjectCopyDeleted(ctx context.Context, config *conn.ProviderConfig, buc
 
 			return output, DELETED, nil
 		},
-		Timeout:    conn.DefaultTimeout,
-		Delay:      5 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Timeout:     conn.DefaultTimeout,
+		Delay:       5 * time.Second,
+		MinInterval: 3 * time.Second,
 	}
 
-	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+	if _, err := stateConf.WaitForState(ctx); err != nil {
 		return fmt.Errorf("error waiting for object (%s) to be upload: %s", key, err)
 	}
 	return nil
@@ -440,6 +444,10 @@ func (o *objectCopyResourceModel) refreshFromOutput(ctx context.Context, config
 		Bucket: o.Bucket.ValueStringPointer(),
 		Key:    o.Key.ValueStringPointer(),
 	})
+	if common.IsNotFound(err) {
+		o.ID = types.StringNull()
+		return
+	}
 	if err != nil {
 		diag.AddError("HeadObject ERROR", err.Error())
 		return
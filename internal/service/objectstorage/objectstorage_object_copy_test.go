@@ -9,7 +9,6 @@ import (
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -19,8 +18,8 @@ import (
 )
 
 func TestAccResourceNcloudObjectStorage_object_copy_basic(t *testing.T) {
-	bucketName := fmt.Sprintf("tf-bucket-%s", acctest.RandString(5))
-	sourceName := fmt.Sprintf("%s.md", acctest.RandString(5))
+	bucketName := fmt.Sprintf("tf-bucket-%s", RandString(t, 5))
+	sourceName := fmt.Sprintf("%s.md", RandString(t, 5))
 	resourceName := "ncloud_objectstorage_object_copy.testing_copy"
 	content := "content for file upload testing"
 	key := "test/key/" + sourceName
@@ -54,9 +53,9 @@ func TestAccResourceNcloudObjectStorage_object_copy_basic(t *testing.T) {
 }
 
 func TestAccResourceNcloudObjectStorage_object_copy_update_source(t *testing.T) {
-	bucketName := fmt.Sprintf("tf-bucket-%s", acctest.RandString(5))
+	bucketName := fmt.Sprintf("tf-bucket-%s", RandString(t, 5))
 	resourceName := "ncloud_objectstorage_object_copy.testing_copy"
-	sourceName := fmt.Sprintf("%s.md", acctest.RandString(5))
+	sourceName := fmt.Sprintf("%s.md", RandString(t, 5))
 	content := "content for file upload testing"
 	preObjectkey := "test/key/" + sourceName
 
@@ -103,9 +102,9 @@ func TestAccResourceNcloudObjectStorage_object_copy_update_source(t *testing.T)
 }
 
 func TestAccResourceNcloudObjectStorage_object_copy_update_content_type(t *testing.T) {
-	bucketName := fmt.Sprintf("tf-bucket-%s", acctest.RandString(5))
+	bucketName := fmt.Sprintf("tf-bucket-%s", RandString(t, 5))
 	resourceName := "ncloud_objectstorage_object_copy.testing_copy"
-	sourceName := fmt.Sprintf("%s.md", acctest.RandString(5))
+	sourceName := fmt.Sprintf("%s.md", RandString(t, 5))
 	content := "content for file upload testing"
 	key := "test/key/" + sourceName
 
@@ -6,14 +6,13 @@ import (
 	"regexp"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/acctest"
 )
 
 func TestAccDataSourceNcloudObjectStorage_object_basic(t *testing.T) {
-	bucket := fmt.Sprintf("tf-bucket-%s", acctest.RandString(5))
-	key := fmt.Sprintf("%s.md", acctest.RandString(5))
+	bucket := fmt.Sprintf("tf-bucket-%s", RandString(t, 5))
+	key := fmt.Sprintf("%s.md", RandString(t, 5))
 	dataName := "data.ncloud_objectstorage_object.by_id"
 	resourceName := "ncloud_objectstorage_object.testing_object"
 	content := "content for file upload testing"
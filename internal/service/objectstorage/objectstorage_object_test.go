@@ -9,7 +9,6 @@ import (
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -19,8 +18,8 @@ import (
 )
 
 func TestAccResourceNcloudObjectStorage_object_basic(t *testing.T) {
-	bucketName := fmt.Sprintf("tf-bucket-%s", acctest.RandString(5))
-	sourceName := fmt.Sprintf("%s.md", acctest.RandString(5))
+	bucketName := fmt.Sprintf("tf-bucket-%s", RandString(t, 5))
+	sourceName := fmt.Sprintf("%s.md", RandString(t, 5))
 	resourceName := "ncloud_objectstorage_object.testing_object"
 	content := "content for file upload testing"
 	key := "test/key/" + sourceName
@@ -55,9 +54,9 @@ func TestAccResourceNcloudObjectStorage_object_basic(t *testing.T) {
 }
 
 func TestAccResourceNcloudObjectStorage_object_update_source(t *testing.T) {
-	bucketName := fmt.Sprintf("tf-bucket-%s", acctest.RandString(5))
-	sourceName := fmt.Sprintf("%s.md", acctest.RandString(5))
-	newSourceName := fmt.Sprintf("%s.md", acctest.RandString(5))
+	bucketName := fmt.Sprintf("tf-bucket-%s", RandString(t, 5))
+	sourceName := fmt.Sprintf("%s.md", RandString(t, 5))
+	newSourceName := fmt.Sprintf("%s.md", RandString(t, 5))
 	key := "test/key/" + sourceName
 
 	content := "content for file upload testing"
@@ -107,8 +106,8 @@ func TestAccResourceNcloudObjectStorage_object_update_source(t *testing.T) {
 }
 
 func TestAccResourceNcloudObjectStorage_object_update_content_type(t *testing.T) {
-	bucketName := fmt.Sprintf("tf-bucket-%s", acctest.RandString(5))
-	sourceName := fmt.Sprintf("%s.md", acctest.RandString(5))
+	bucketName := fmt.Sprintf("tf-bucket-%s", RandString(t, 5))
+	sourceName := fmt.Sprintf("%s.md", RandString(t, 5))
 	content := "content for file upload testing"
 	resourceName := "ncloud_objectstorage_object.testing_object"
 	key := "test/key/" + sourceName
@@ -0,0 +1,93 @@
+package objectstorage
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	awsTypes "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/paging"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/sweep"
+)
+
+func RegisterSweepers() {
+	sweep.AddTestSweepers("ncloud_objectstorage_bucket", &resource.Sweeper{
+		Name: "ncloud_objectstorage_bucket",
+		F:    sweepBuckets,
+	})
+
+	sweep.AddChildTestSweepers("ncloud_objectstorage_bucket",
+		"ncloud_objectstorage_bucket_acl",
+		"ncloud_objectstorage_object",
+		"ncloud_objectstorage_object_acl",
+		"ncloud_objectstorage_object_copy",
+	)
+}
+
+// sweepBuckets empties the buckets of acceptance tests, then removes them
+func sweepBuckets(region string) error {
+	config, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+
+	ctx := context.Background()
+	resp, err := config.Client.ObjectStorage.ListBuckets(ctx, &s3.ListBucketsInput{})
+	if err != nil {
+		if sweep.SkipSweepError(err) {
+			return nil
+		}
+		return fmt.Errorf("listing buckets: %w", err)
+	}
+
+	var sweepables []sweep.Sweepable
+	for _, b := range resp.Buckets {
+		if !sweep.HasResourcePrefix(b.Name) {
+			continue
+		}
+
+		bucketName := *b.Name
+		sweepables = append(sweepables, &sweep.SweepFunc{
+			ID: fmt.Sprintf("objects of bucket %s", bucketName),
+			F: func(ctx context.Context) error {
+				return emptyBucket(ctx, config, bucketName)
+			},
+		})
+		sweepables = append(sweepables, sweep.NewFrameworkSweepResource(NewBucketResource, config, bucketName, map[string]string{
+			"bucket_name": bucketName,
+		}))
+	}
+
+	return sweep.SweepOrchestrator(ctx, sweepables)
+}
+
+func emptyBucket(ctx context.Context, config *conn.ProviderConfig, bucketName string) error {
+	objects := paging.ItemsByToken(ctx, func(ctx context.Context, token *string) ([]awsTypes.Object, *string, error) {
+		page, err := config.Client.ObjectStorage.ListObjectsV2(ctx, &s3.ListObjectsV2Input{
+			Bucket:            &bucketName,
+			ContinuationToken: token,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		return page.Contents, page.NextContinuationToken, nil
+	})
+
+	for o, err := range objects {
+		if err != nil {
+			return err
+		}
+
+		if _, err := config.Client.ObjectStorage.DeleteObject(ctx, &s3.DeleteObjectInput{
+			Bucket: &bucketName,
+			Key:    o.Key,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -4,13 +4,10 @@ import (
 	"context"
 	"fmt"
 	"regexp"
-	"strings"
 	"time"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vpostgresql"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
@@ -19,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/verify/verifybool"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/verify/verifyint64"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/verify/verifystring"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/waiter"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -572,7 +570,7 @@ func (r *postgresqlResource) Create(ctx context.Context, req resource.CreateRequ
 		resp.Diagnostics.AddError("CREATING ERROR", err.Error())
 		return
 	}
-	tflog.Info(ctx, "CreatePostgresql response="+common.MarshalUncheckedString(response))
+	common.LogResponse(ctx, "CreatePostgresql", response)
 
 	if response == nil || len(response.CloudPostgresqlInstanceList) < 1 {
 		resp.Diagnostics.AddError("CREATING ERROR", "response invalid")
@@ -646,14 +644,14 @@ func (r *postgresqlResource) Delete(ctx context.Context, req resource.DeleteRequ
 		RegionCode:                &r.config.RegionCode,
 		CloudPostgresqlInstanceNo: state.ID.ValueStringPointer(),
 	}
-	tflog.Info(ctx, "DeletePostgresql reqParams="+common.MarshalUncheckedString(reqParams))
+	ctx = common.LogCommonRequest(ctx, "DeletePostgresql", reqParams)
 
 	response, err := r.config.Client.Vpostgresql.V2Api.DeleteCloudPostgresqlInstance(reqParams)
 	if err != nil {
 		resp.Diagnostics.AddError("DELETING ERROR", err.Error())
 		return
 	}
-	tflog.Info(ctx, "DeletePostgresql response="+common.MarshalUncheckedString(response))
+	common.LogResponse(ctx, "DeletePostgresql", response)
 
 	if err := waitPostgresqlDeletion(ctx, r.config, state.ID.ValueString()); err != nil {
 		resp.Diagnostics.AddError("WAITING FOR DELETE ERROR", err.Error())
@@ -665,14 +663,14 @@ func GetPostgresqlInstance(ctx context.Context, config *conn.ProviderConfig, no
 		RegionCode:                &config.RegionCode,
 		CloudPostgresqlInstanceNo: ncloud.String(no),
 	}
-	tflog.Info(ctx, "GetPostgresqlDetail reqParams="+common.MarshalUncheckedString(reqParams))
+	ctx = common.LogCommonRequest(ctx, "GetPostgresqlDetail", reqParams)
 
 	resp, err := config.Client.Vpostgresql.V2Api.GetCloudPostgresqlInstanceDetail(reqParams)
 	// If the lookup result is 0 or already deleted, it will respond with a 400 error with a 5001017 return code.
-	if err != nil && !(strings.Contains(err.Error(), `"returnCode": "5001017"`)) {
+	if err != nil && !common.IsNotFound(err) {
 		return nil, err
 	}
-	tflog.Info(ctx, "GetPostgresqlDetail response="+common.MarshalUncheckedString(resp))
+	common.LogResponse(ctx, "GetPostgresqlDetail", resp)
 
 	if resp == nil || len(resp.CloudPostgresqlInstanceList) < 1 || len(resp.CloudPostgresqlInstanceList[0].CloudPostgresqlServerInstanceList) < 1 {
 		return nil, nil
@@ -683,10 +681,10 @@ func GetPostgresqlInstance(ctx context.Context, config *conn.ProviderConfig, no
 
 func WaitPostgresqlCreation(ctx context.Context, config *conn.ProviderConfig, id string) (*vpostgresql.CloudPostgresqlInstance, error) {
 	var postgresqlInstance *vpostgresql.CloudPostgresqlInstance
-	stateConf := &retry.StateChangeConf{
+	stateConf := &waiter.Config[any]{
 		Pending: []string{CREATING, SETTING},
 		Target:  []string{RUNNING},
-		Refresh: func() (interface{}, string, error) {
+		Refresh: func(ctx context.Context) (any, string, error) {
 			instance, err := GetPostgresqlInstance(ctx, config, id)
 			postgresqlInstance = instance
 			if err != nil {
@@ -714,11 +712,11 @@ func WaitPostgresqlCreation(ctx context.Context, config *conn.ProviderConfig, id
 
 			return 0, "", fmt.Errorf("error occurred while waiting to create postgresql")
 		},
-		Timeout:    6 * conn.DefaultTimeout,
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Timeout:     6 * conn.DefaultTimeout,
+		Delay:       2 * time.Second,
+		MinInterval: 3 * time.Second,
 	}
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := stateConf.WaitForState(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("error waiting for PostgresqlInstance state to be \"CREAT\": %s", err)
 	}
@@ -727,10 +725,10 @@ func WaitPostgresqlCreation(ctx context.Context, config *conn.ProviderConfig, id
 }
 
 func waitPostgresqlDeletion(ctx context.Context, config *conn.ProviderConfig, id string) error {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &waiter.Config[any]{
 		Pending: []string{DELETING},
 		Target:  []string{DELETED},
-		Refresh: func() (interface{}, string, error) {
+		Refresh: func(ctx context.Context) (any, string, error) {
 			instance, err := GetPostgresqlInstance(ctx, config, id)
 			if err != nil {
 				return 0, "", err
@@ -747,12 +745,12 @@ func waitPostgresqlDeletion(ctx context.Context, config *conn.ProviderConfig, id
 
 			return 0, "", fmt.Errorf("error occurred while waiting to delete postgresql")
 		},
-		Timeout:    2 * conn.DefaultTimeout,
-		Delay:      5 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Timeout:     2 * conn.DefaultTimeout,
+		Delay:       5 * time.Second,
+		MinInterval: 3 * time.Second,
 	}
 
-	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+	if _, err := stateConf.WaitForState(ctx); err != nil {
 		return fmt.Errorf("error waiting for postgresql (%s) to become termintaing: %s", id, err)
 	}
 
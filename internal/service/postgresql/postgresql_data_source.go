@@ -12,7 +12,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
 )
@@ -205,14 +204,14 @@ func (d *postgresqlDataSource) Read(ctx context.Context, req datasource.ReadRequ
 			RegionCode:                 &d.config.RegionCode,
 			CloudPostgresqlServiceName: data.ServiceName.ValueStringPointer(),
 		}
-		tflog.Info(ctx, "GetPostgresqlList reqParams="+common.MarshalUncheckedString(reqParams))
+		ctx = common.LogCommonRequest(ctx, "GetPostgresqlList", reqParams)
 
 		listResp, err := d.config.Client.Vpostgresql.V2Api.GetCloudPostgresqlInstanceList(reqParams)
 		if err != nil {
 			resp.Diagnostics.AddError("READING ERROR", err.Error())
 			return
 		}
-		tflog.Info(ctx, "GetPostgresqlList response="+common.MarshalUncheckedString(listResp))
+		common.LogResponse(ctx, "GetPostgresqlList", listResp)
 
 		if listResp == nil || len(listResp.CloudPostgresqlInstanceList) < 1 {
 			resp.Diagnostics.AddError("READING ERROR", "no result. please change search criteria and try again.")
@@ -20,7 +20,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
 )
@@ -158,14 +157,14 @@ func (r *postgresqlDatabasesResource) Create(ctx context.Context, req resource.C
 		CloudPostgresqlDatabaseList: convertToCloudPostgresqlDatabaseParameters(plan.PostgresqlDatabaseList),
 	}
 
-	tflog.Info(ctx, "CreatePostgresqlDatabaseList reqParams="+common.MarshalUncheckedString(reqParams))
+	ctx = common.LogCommonRequest(ctx, "CreatePostgresqlDatabaseList", reqParams)
 
 	response, err := r.config.Client.Vpostgresql.V2Api.AddCloudPostgresqlDatabaseList(reqParams)
 	if err != nil {
 		resp.Diagnostics.AddError("CREATING ERROR", err.Error())
 		return
 	}
-	tflog.Info(ctx, "CreatePostgresqlDatabaseList response="+common.MarshalUncheckedString(response))
+	common.LogResponse(ctx, "CreatePostgresqlDatabaseList", response)
 
 	if response == nil || *response.ReturnCode != "0" {
 		resp.Diagnostics.AddError("CREATING ERROR", "response invalid")
@@ -239,14 +238,14 @@ func (r *postgresqlDatabasesResource) Delete(ctx context.Context, req resource.D
 		CloudPostgresqlInstanceNo:   state.ID.ValueStringPointer(),
 		CloudPostgresqlDatabaseList: convertToCloudPostgresqlDatabaseKeyParameter(state.PostgresqlDatabaseList),
 	}
-	tflog.Info(ctx, "DeletePostgresqlDatabseList reqParams="+common.MarshalUncheckedString(reqParams))
+	ctx = common.LogCommonRequest(ctx, "DeletePostgresqlDatabseList", reqParams)
 
 	response, err := r.config.Client.Vpostgresql.V2Api.DeleteCloudPostgresqlDatabaseList(reqParams)
 	if err != nil {
 		resp.Diagnostics.AddError("DELETING ERROR", err.Error())
 		return
 	}
-	tflog.Info(ctx, "DeletePostgresqlDatabseList response="+common.MarshalUncheckedString(response))
+	common.LogResponse(ctx, "DeletePostgresqlDatabseList", response)
 
 	_, err = WaitPostgresqlCreation(ctx, r.config, state.ID.ValueString())
 	if err != nil {
@@ -260,7 +259,7 @@ func GetPostgresqlDatabaseList(ctx context.Context, config *conn.ProviderConfig,
 		RegionCode:                &config.RegionCode,
 		CloudPostgresqlInstanceNo: ncloud.String(id),
 	}
-	tflog.Info(ctx, "GetPostgresqlDatabaseList reqParams="+common.MarshalUncheckedString(reqParams))
+	ctx = common.LogCommonRequest(ctx, "GetPostgresqlDatabaseList", reqParams)
 
 	resp, err := config.Client.Vpostgresql.V2Api.GetCloudPostgresqlDatabaseList(reqParams)
 	if err != nil {
@@ -289,7 +288,7 @@ func GetPostgresqlDatabaseList(ctx context.Context, config *conn.ProviderConfig,
 		return nil, nil
 	}
 
-	tflog.Info(ctx, "GetPostgresqlDatabaseList response="+common.MarshalUncheckedString(resp))
+	common.LogResponse(ctx, "GetPostgresqlDatabaseList", resp)
 
 	return filteredDbs, nil
 }
@@ -12,7 +12,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
 )
@@ -135,14 +134,14 @@ func GetPostgresqlDatabaseAllList(ctx context.Context, config *conn.ProviderConf
 		RegionCode:                &config.RegionCode,
 		CloudPostgresqlInstanceNo: ncloud.String(id),
 	}
-	tflog.Info(ctx, "GetPostgresqlDatabaseList reqParams="+common.MarshalUncheckedString(reqParams))
+	ctx = common.LogCommonRequest(ctx, "GetPostgresqlDatabaseList", reqParams)
 
 	resp, err := config.Client.Vpostgresql.V2Api.GetCloudPostgresqlDatabaseList(reqParams)
 	if err != nil {
 		return nil, err
 	}
 
-	tflog.Info(ctx, "GetPostgresqlDatabaseList response="+common.MarshalUncheckedString(resp))
+	common.LogResponse(ctx, "GetPostgresqlDatabaseList", resp)
 
 	if resp == nil || len(resp.CloudPostgresqlDatabaseList) < 1 {
 		return nil, nil
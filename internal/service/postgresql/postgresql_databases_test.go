@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 
@@ -16,7 +15,7 @@ import (
 )
 
 func TestAccResourceNcloudPostgresqlDatabases_vpc_basic(t *testing.T) {
-	testName := fmt.Sprintf("tf-postgresqldb-%s", acctest.RandString(5))
+	testName := fmt.Sprintf("tf-postgresqldb-%s", RandString(t, 5))
 	resourceName := "ncloud_postgresql_databases.postgresql_db"
 	dbResourceName := "ncloud_postgresql.postgresql"
 
@@ -10,7 +10,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
 )
@@ -113,14 +112,20 @@ func (d *postgresqlImageProductsDataSource) Read(ctx context.Context, req dataso
 	reqParams := &vpostgresql.GetCloudPostgresqlImageProductListRequest{
 		RegionCode: &d.config.RegionCode,
 	}
-	tflog.Info(ctx, "GetPostgresqlImageProductList reqParams="+common.MarshalUncheckedString(reqParams))
-
-	postgresqlImageProductResp, err := d.config.Client.Vpostgresql.V2Api.GetCloudPostgresqlImageProductList(reqParams)
+	postgresqlImageProductResp, err := conn.CachedLookup(d.config, conn.CatalogKey("vpostgresql.GetCloudPostgresqlImageProductList", reqParams), func() (*vpostgresql.GetCloudPostgresqlImageProductListResponse, error) {
+		ctx := common.LogCommonRequest(ctx, "GetPostgresqlImageProductList", reqParams)
+		output, err := d.config.Client.Vpostgresql.V2Api.GetCloudPostgresqlImageProductList(reqParams)
+		if err != nil {
+			common.LogErrorResponse(ctx, "GetPostgresqlImageProductList", err, reqParams)
+			return nil, err
+		}
+		common.LogResponse(ctx, "GetPostgresqlImageProductList", output)
+		return output, nil
+	})
 	if err != nil {
 		resp.Diagnostics.AddError("READING ERROR", err.Error())
 		return
 	}
-	tflog.Info(ctx, "GetPostgresqlImageProductList response="+common.MarshalUncheckedString(postgresqlImageProductResp))
 
 	if postgresqlImageProductResp == nil || len(postgresqlImageProductResp.ProductList) < 1 {
 		resp.Diagnostics.AddError("READING ERROR", "no result.")
@@ -15,11 +15,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/framework"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/waiter"
 )
 
 var (
@@ -182,14 +181,14 @@ func (r *postgresqlReadReplicaResource) Create(ctx context.Context, req resource
 		reqParams.SubnetNo = plan.SubnetNo.ValueStringPointer()
 	}
 
-	tflog.Info(ctx, "CreateCloudPostgresqlReadReplica reqParams="+common.MarshalUncheckedString(reqParams))
+	ctx = common.LogCommonRequest(ctx, "CreateCloudPostgresqlReadReplica", reqParams)
 
 	response, err := r.config.Client.Vpostgresql.V2Api.CreateCloudPostgresqlReadReplicaInstance(reqParams)
 	if err != nil {
 		resp.Diagnostics.AddError("CREATING ERROR", err.Error())
 		return
 	}
-	tflog.Info(ctx, "CreateCloudPostgresqlReadReplica response="+common.MarshalUncheckedString(response))
+	common.LogResponse(ctx, "CreateCloudPostgresqlReadReplica", response)
 
 	if response == nil || len(response.CloudPostgresqlInstanceList) < 1 {
 		resp.Diagnostics.AddError("CREATING ERROR", "response invalid")
@@ -263,7 +262,7 @@ func (r *postgresqlReadReplicaResource) Delete(ctx context.Context, req resource
 		RegionCode:                      &r.config.RegionCode,
 		CloudPostgresqlServerInstanceNo: state.ID.ValueStringPointer(),
 	}
-	tflog.Info(ctx, "DeletePostgresqlReadReplica reqParams="+common.MarshalUncheckedString(reqParams))
+	ctx = common.LogCommonRequest(ctx, "DeletePostgresqlReadReplica", reqParams)
 
 	response, err := r.config.Client.Vpostgresql.V2Api.DeleteCloudPostgresqlReadReplicaInstance(reqParams)
 	if err != nil {
@@ -271,7 +270,7 @@ func (r *postgresqlReadReplicaResource) Delete(ctx context.Context, req resource
 		return
 	}
 
-	tflog.Info(ctx, "DeletePostgresqlReadReplica response="+common.MarshalUncheckedString(response))
+	common.LogResponse(ctx, "DeletePostgresqlReadReplica", response)
 
 	if err := waitPostgresqlReadReplicaDeletion(ctx, r.config, state.PostgresqlInstanceNo.ValueString(), state.ID.ValueString()); err != nil {
 		resp.Diagnostics.AddError("WAITING FOR DELETE ERROR", err.Error())
@@ -280,10 +279,10 @@ func (r *postgresqlReadReplicaResource) Delete(ctx context.Context, req resource
 
 func waitPostgresqlReadReplicaCreation(ctx context.Context, config *conn.ProviderConfig, instanceNo string, index int) ([]*vpostgresql.CloudPostgresqlServerInstance, error) {
 	var serverInstance []*vpostgresql.CloudPostgresqlServerInstance
-	stateConf := &retry.StateChangeConf{
+	stateConf := &waiter.Config[any]{
 		Pending: []string{CREATING, SETTING},
 		Target:  []string{RUNNING},
-		Refresh: func() (interface{}, string, error) {
+		Refresh: func(ctx context.Context) (any, string, error) {
 			instance, err := findPostgresqlReadReplicaServer(ctx, config, instanceNo, index)
 			serverInstance = instance
 			if err != nil {
@@ -301,12 +300,12 @@ func waitPostgresqlReadReplicaCreation(ctx context.Context, config *conn.Provide
 
 			return 0, "", fmt.Errorf("error occurred while waiting to create postgresql read replica")
 		},
-		Timeout:    6 * conn.DefaultTimeout,
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Timeout:     6 * conn.DefaultTimeout,
+		Delay:       2 * time.Second,
+		MinInterval: 3 * time.Second,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := stateConf.WaitForState(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("error waiting for postgresql read replica state to be \"CREAT\": %s", err)
 	}
@@ -315,10 +314,10 @@ func waitPostgresqlReadReplicaCreation(ctx context.Context, config *conn.Provide
 }
 
 func waitPostgresqlReadReplicaDeletion(ctx context.Context, config *conn.ProviderConfig, instanceNo string, serverInstanceNo string) error {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &waiter.Config[any]{
 		Pending: []string{DELETING},
 		Target:  []string{DELETED},
-		Refresh: func() (interface{}, string, error) {
+		Refresh: func(ctx context.Context) (any, string, error) {
 			instance, err := GetPostgresqlReadReplicaServer(ctx, config, instanceNo, serverInstanceNo)
 			if err != nil {
 				return 0, "", err
@@ -340,12 +339,12 @@ func waitPostgresqlReadReplicaDeletion(ctx context.Context, config *conn.Provide
 
 			return 0, "", fmt.Errorf("error occurred while waiting to delete postgresql read replica")
 		},
-		Timeout:    conn.DefaultTimeout,
-		Delay:      1 * time.Minute,
-		MinTimeout: 3 * time.Second,
+		Timeout:     conn.DefaultTimeout,
+		Delay:       1 * time.Minute,
+		MinInterval: 3 * time.Second,
 	}
 
-	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+	if _, err := stateConf.WaitForState(ctx); err != nil {
 		return fmt.Errorf("error waiting for postgresql read replica (%s) to become termintaing: %s", serverInstanceNo, err)
 	}
 
@@ -357,13 +356,13 @@ func findPostgresqlReadReplicaServer(ctx context.Context, config *conn.ProviderC
 		RegionCode:                &config.RegionCode,
 		CloudPostgresqlInstanceNo: ncloud.String(instanceNo),
 	}
-	tflog.Info(ctx, "GetPostgresqlDetail reqParams="+common.MarshalUncheckedString(reqParams))
+	ctx = common.LogCommonRequest(ctx, "GetPostgresqlDetail", reqParams)
 
 	resp, err := config.Client.Vpostgresql.V2Api.GetCloudPostgresqlInstanceDetail(reqParams)
-	if err != nil && !(strings.Contains(err.Error(), `"returnCode": "5001017"`)) {
+	if err != nil && !common.IsNotFound(err) {
 		return nil, err
 	}
-	tflog.Info(ctx, "GetPostgresqlDetail response="+common.MarshalUncheckedString(resp))
+	common.LogResponse(ctx, "GetPostgresqlDetail", resp)
 
 	if resp == nil || len(resp.CloudPostgresqlInstanceList) < 1 {
 		return nil, fmt.Errorf("response is nil")
@@ -384,13 +383,13 @@ func GetPostgresqlReadReplicaServer(ctx context.Context, config *conn.ProviderCo
 		RegionCode:                &config.RegionCode,
 		CloudPostgresqlInstanceNo: ncloud.String(instanceNo),
 	}
-	tflog.Info(ctx, "GetPostgresqlDetail reqParams="+common.MarshalUncheckedString(reqParams))
+	ctx = common.LogCommonRequest(ctx, "GetPostgresqlDetail", reqParams)
 
 	resp, err := config.Client.Vpostgresql.V2Api.GetCloudPostgresqlInstanceDetail(reqParams)
-	if err != nil && !(strings.Contains(err.Error(), `"returnCode": "5001017"`)) {
+	if err != nil && !common.IsNotFound(err) {
 		return nil, err
 	}
-	tflog.Info(ctx, "GetPostgresqlDetail response="+common.MarshalUncheckedString(resp))
+	common.LogResponse(ctx, "GetPostgresqlDetail", resp)
 
 	if resp == nil || len(resp.CloudPostgresqlInstanceList) < 1 {
 		return nil, nil
@@ -8,7 +8,6 @@ import (
 	"testing"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vpostgresql"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -19,7 +18,7 @@ import (
 
 func TestAccResourceNcloudPostgresqlReadReplica_vpc_basic(t *testing.T) {
 	var postgresqlServerInstance vpostgresql.CloudPostgresqlServerInstance
-	testName := fmt.Sprintf("tf-postgresqlrr-%s", acctest.RandString(5))
+	testName := fmt.Sprintf("tf-postgresqlrr-%s", RandString(t, 5))
 	resourceName := "ncloud_postgresql_read_replica.postgresql_rr"
 
 	resource.Test(t, resource.TestCase{
@@ -8,7 +8,6 @@ import (
 	"strings"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -21,7 +20,7 @@ import (
 
 func TestAccResourceNcloudPostgresql_vpc_basic(t *testing.T) {
 	var postgresqlInstance vpostgresql.CloudPostgresqlInstance
-	testPostgresqlName := fmt.Sprintf("tf-postgresql-%s", acctest.RandString(5))
+	testPostgresqlName := fmt.Sprintf("tf-postgresql-%s", RandString(t, 5))
 	resourceName := "ncloud_postgresql.postgresql"
 
 	resource.Test(t, resource.TestCase{
@@ -47,7 +46,7 @@ func TestAccResourceNcloudPostgresql_vpc_basic(t *testing.T) {
 // Available only `pub` and 'fin' site.
 func TestAccResourceNcloudPostgresql_vpc_multizone(t *testing.T) {
 	var postgresqlInstance vpostgresql.CloudPostgresqlInstance
-	testPostgresqlName := fmt.Sprintf("tf-postgresql-%s", acctest.RandString(5))
+	testPostgresqlName := fmt.Sprintf("tf-postgresql-%s", RandString(t, 5))
 	resourceName := "ncloud_postgresql.postgresql"
 
 	resource.Test(t, resource.TestCase{
@@ -71,7 +70,7 @@ func TestAccResourceNcloudPostgresql_vpc_multizone(t *testing.T) {
 }
 
 func TestAccResourceNcloudPostgresql_vpc_error(t *testing.T) {
-	testPostgresqlName := fmt.Sprintf("tf-postgresql-%s", acctest.RandString(5))
+	testPostgresqlName := fmt.Sprintf("tf-postgresql-%s", RandString(t, 5))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { TestAccPreCheck(t) },
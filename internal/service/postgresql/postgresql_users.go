@@ -21,7 +21,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/verify/verifystring"
@@ -185,7 +184,7 @@ func (r *postgresqlUsersResource) Create(ctx context.Context, req resource.Creat
 		resp.Diagnostics.AddError("CREATING ERROR", err.Error())
 		return
 	}
-	tflog.Info(ctx, "CreatePostgresqlUserList response="+common.MarshalUncheckedString(response))
+	common.LogResponse(ctx, "CreatePostgresqlUserList", response)
 
 	if response == nil || *response.ReturnCode != "0" {
 		resp.Diagnostics.AddError("CREATING ERROR", "response invalid")
@@ -264,7 +263,7 @@ func (r *postgresqlUsersResource) Update(ctx context.Context, req resource.Updat
 			resp.Diagnostics.AddError("UPDATE ERROR", err.Error())
 			return
 		}
-		tflog.Info(ctx, "ChangeCloudPostgresqlUserList response="+common.MarshalUncheckedString(response))
+		common.LogResponse(ctx, "ChangeCloudPostgresqlUserList", response)
 
 		if response == nil || *response.ReturnCode != "0" {
 			resp.Diagnostics.AddError("UPDATE ERROR", "response invalid")
@@ -315,14 +314,14 @@ func (r *postgresqlUsersResource) Delete(ctx context.Context, req resource.Delet
 		CloudPostgresqlInstanceNo: state.ID.ValueStringPointer(),
 		CloudPostgresqlUserList:   convertToCloudPostgresqlUserKeyParameter(state.PostgresqlUserList),
 	}
-	tflog.Info(ctx, "DeletePostgresqlUserList reqParams="+common.MarshalUncheckedString(reqParams))
+	ctx = common.LogCommonRequest(ctx, "DeletePostgresqlUserList", reqParams)
 
 	response, err := r.config.Client.Vpostgresql.V2Api.DeleteCloudPostgresqlUserList(reqParams)
 	if err != nil {
 		resp.Diagnostics.AddError("DELETING ERROR", err.Error())
 		return
 	}
-	tflog.Info(ctx, "DeletePostgresqlUserList response="+common.MarshalUncheckedString(response))
+	common.LogResponse(ctx, "DeletePostgresqlUserList", response)
 
 	_, err = WaitPostgresqlCreation(ctx, r.config, state.ID.ValueString())
 	if err != nil {
@@ -336,7 +335,7 @@ func GetPostgresqlUserList(ctx context.Context, config *conn.ProviderConfig, id
 		RegionCode:                &config.RegionCode,
 		CloudPostgresqlInstanceNo: ncloud.String(id),
 	}
-	tflog.Info(ctx, "GetPostgresqlUserList reqParams="+common.MarshalUncheckedString(reqParams))
+	ctx = common.LogCommonRequest(ctx, "GetPostgresqlUserList", reqParams)
 
 	resp, err := config.Client.Vpostgresql.V2Api.GetCloudPostgresqlUserList(reqParams)
 	if err != nil {
@@ -365,7 +364,7 @@ func GetPostgresqlUserList(ctx context.Context, config *conn.ProviderConfig, id
 		return nil, nil
 	}
 
-	tflog.Info(ctx, "GetPostgresqlUserList response="+common.MarshalUncheckedString(resp))
+	common.LogResponse(ctx, "GetPostgresqlUserList", resp)
 
 	return filteredUsers, nil
 }
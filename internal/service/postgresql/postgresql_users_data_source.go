@@ -12,7 +12,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
 )
@@ -141,14 +140,14 @@ func GetPostgresqlUserAllList(ctx context.Context, config *conn.ProviderConfig,
 		RegionCode:                &config.RegionCode,
 		CloudPostgresqlInstanceNo: ncloud.String(id),
 	}
-	tflog.Info(ctx, "GetPostgresqlUserList reqParams="+common.MarshalUncheckedString(reqParams))
+	ctx = common.LogCommonRequest(ctx, "GetPostgresqlUserList", reqParams)
 
 	resp, err := config.Client.Vpostgresql.V2Api.GetCloudPostgresqlUserList(reqParams)
 	if err != nil {
 		return nil, err
 	}
 
-	tflog.Info(ctx, "GetPostgresqlUserList response="+common.MarshalUncheckedString(resp))
+	common.LogResponse(ctx, "GetPostgresqlUserList", resp)
 
 	if resp == nil || len(resp.CloudPostgresqlUserList) < 1 {
 		return nil, nil
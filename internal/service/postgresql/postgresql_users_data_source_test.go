@@ -4,14 +4,13 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/acctest"
 )
 
 func TestAccDataSourceNcloudPostgresqlUsers_vpc_basic(t *testing.T) {
 	dataName := "data.ncloud_postgresql_users.all"
-	testPostgresqlName := fmt.Sprintf("tf-postgresql-%s", acctest.RandString(5))
+	testPostgresqlName := fmt.Sprintf("tf-postgresql-%s", RandString(t, 5))
 	dbResourceName := "ncloud_postgresql.postgresql"
 
 	resource.Test(t, resource.TestCase{
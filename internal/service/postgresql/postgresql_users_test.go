@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -16,7 +15,7 @@ import (
 )
 
 func TestAccResourceNcloudPostgresqlUsers_vpc_basic(t *testing.T) {
-	testName := fmt.Sprintf("tf-postgresquser-%s", acctest.RandString(5))
+	testName := fmt.Sprintf("tf-postgresquser-%s", RandString(t, 5))
 	resourceName := "ncloud_postgresql_users.postgresql_users"
 	dbResourceName := "ncloud_postgresql.postgresql"
 
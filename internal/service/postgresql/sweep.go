@@ -0,0 +1,65 @@
+package postgresql
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vpostgresql"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/paging"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/sweep"
+)
+
+// RegisterSweepers registers the sweepers of PostgreSQL instances.
+// Read replicas, users and databases are deleted with their instance.
+func RegisterSweepers() {
+	sweep.AddTestSweepers("ncloud_postgresql", &resource.Sweeper{
+		Name: "ncloud_postgresql",
+		F:    sweepPostgresqls,
+	})
+
+	sweep.AddChildTestSweepers("ncloud_postgresql",
+		"ncloud_postgresql_databases",
+		"ncloud_postgresql_read_replica",
+		"ncloud_postgresql_users",
+	)
+}
+
+func sweepPostgresqls(region string) error {
+	config, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+	if !config.SupportVPC {
+		return nil
+	}
+
+	cloudPostgresqlInstanceList, err := paging.Pager[*vpostgresql.CloudPostgresqlInstance]{
+		ZeroBased: true,
+		Page: func(ctx context.Context, pageNo, pageSize int32) ([]*vpostgresql.CloudPostgresqlInstance, *int32, error) {
+			resp, err := config.Client.Vpostgresql.V2Api.GetCloudPostgresqlInstanceList(&vpostgresql.GetCloudPostgresqlInstanceListRequest{RegionCode: &config.RegionCode, PageNo: ncloud.Int32(pageNo), PageSize: ncloud.Int32(pageSize)})
+			if err != nil {
+				return nil, nil, err
+			}
+			return resp.CloudPostgresqlInstanceList, resp.TotalRows, nil
+		},
+	}.All(context.Background())
+	if err != nil {
+		if sweep.SkipSweepError(err) {
+			return nil
+		}
+		return fmt.Errorf("listing PostgreSQL instances: %w", err)
+	}
+
+	var sweepables []sweep.Sweepable
+	for _, i := range cloudPostgresqlInstanceList {
+		if !sweep.HasResourcePrefix(i.CloudPostgresqlServiceName) {
+			continue
+		}
+		sweepables = append(sweepables, sweep.NewFrameworkSweepResource(NewPostgresqlResource, config, *i.CloudPostgresqlInstanceNo, nil))
+	}
+
+	return sweep.SweepOrchestrator(context.Background(), sweepables)
+}
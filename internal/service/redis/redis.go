@@ -5,16 +5,16 @@ import (
 	"errors"
 	"fmt"
 	"regexp"
-	"strings"
+	"slices"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/verify"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/waiter"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vredis"
@@ -36,6 +36,7 @@ var (
 	_ resource.Resource                = &redisResource{}
 	_ resource.ResourceWithConfigure   = &redisResource{}
 	_ resource.ResourceWithImportState = &redisResource{}
+	_ resource.ResourceWithModifyPlan  = &redisResource{}
 )
 
 func NewRedisResource() resource.Resource {
@@ -307,6 +308,85 @@ func (r *redisResource) Configure(_ context.Context, req resource.ConfigureReque
 	r.config = config
 }
 
+// ModifyPlan checks the image product, product and engine version codes against the Redis image products and
+// products of the region, so that an invalid code fails the plan rather than the creation.
+func (r *redisResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.config == nil || !r.config.SupportVPC {
+		return
+	}
+
+	imageProductCode, imageChanged, diags := framework.PlannedStringChange(ctx, req, path.Root("image_product_code"))
+	resp.Diagnostics.Append(diags...)
+	productCode, productChanged, diags := framework.PlannedStringChange(ctx, req, path.Root("product_code"))
+	resp.Diagnostics.Append(diags...)
+	engineVersionCode, engineVersionChanged, diags := framework.PlannedStringChange(ctx, req, path.Root("engine_version_code"))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || !(imageChanged || productChanged || engineVersionChanged) {
+		return
+	}
+
+	imageProducts, err := getRedisImageProductList(ctx, r.config)
+	if err != nil {
+		resp.Diagnostics.AddWarning("PLAN VALIDATION SKIPPED", "unable to get the Redis image products: "+err.Error())
+		return
+	}
+
+	scope := "region " + r.config.RegionCode
+	var imageProductCodes, engineVersionCodes []string
+	for _, p := range imageProducts {
+		imageProductCodes = append(imageProductCodes, ncloud.StringValue(p.ProductCode))
+		if v := ncloud.StringValue(p.EngineVersionCode); v != "" && !slices.Contains(engineVersionCodes, v) {
+			engineVersionCodes = append(engineVersionCodes, v)
+		}
+	}
+
+	if imageChanged {
+		if err := verify.ValidateCodeInList("image_product_code", imageProductCode, imageProductCodes, scope); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("image_product_code"), "INVALID IMAGE PRODUCT CODE", err.Error())
+			return
+		}
+	}
+
+	if engineVersionChanged {
+		if err := verify.ValidateCodeInList("engine_version_code", engineVersionCode, engineVersionCodes, scope); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("engine_version_code"), "INVALID ENGINE VERSION CODE", err.Error())
+		}
+	}
+
+	if !productChanged {
+		return
+	}
+
+	// the products depend on the image, any image is a candidate when the default image is used
+	var image types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("image_product_code"), &image)...)
+	if resp.Diagnostics.HasError() || image.IsUnknown() {
+		return
+	}
+	if !image.IsNull() {
+		imageProductCodes = []string{image.ValueString()}
+		scope = fmt.Sprintf("image %s of %s", image.ValueString(), scope)
+	}
+
+	var productCodes []string
+	for _, code := range imageProductCodes {
+		products, err := getRedisProductList(ctx, r.config, code)
+		if err != nil {
+			resp.Diagnostics.AddWarning("PLAN VALIDATION SKIPPED", "unable to get the Redis products: "+err.Error())
+			return
+		}
+		for _, p := range products {
+			if v := ncloud.StringValue(p.ProductCode); !slices.Contains(productCodes, v) {
+				productCodes = append(productCodes, v)
+			}
+		}
+	}
+
+	if err := verify.ValidateCodeInList("product_code", productCode, productCodes, scope); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("product_code"), "INVALID PRODUCT CODE", err.Error())
+	}
+}
+
 func (r *redisResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan redisResourceModel
 
@@ -461,7 +541,7 @@ func (r *redisResource) Create(ctx context.Context, req resource.CreateRequest,
 		resp.Diagnostics.AddError("CREATING ERROR", err.Error())
 		return
 	}
-	tflog.Info(ctx, "CreateCloudRedisInstance response="+common.MarshalUncheckedString(response))
+	common.LogResponse(ctx, "CreateCloudRedisInstance", response)
 
 	if response == nil || len(response.CloudRedisInstanceList) < 1 {
 		resp.Diagnostics.AddError("CREATING ERROR", "response invalid")
@@ -525,7 +605,7 @@ func (r *redisResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		CloudRedisInstanceNo: state.ID.ValueStringPointer(),
 	}
 
-	tflog.Info(ctx, "DeleteCloudRedis reqParams="+common.MarshalUncheckedString(reqParams))
+	ctx = common.LogCommonRequest(ctx, "DeleteCloudRedis", reqParams)
 
 	response, err := r.config.Client.Vredis.V2Api.DeleteCloudRedisInstance(reqParams)
 	if err != nil {
@@ -533,7 +613,7 @@ func (r *redisResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
-	tflog.Info(ctx, "DeleteCloudRedis response="+common.MarshalUncheckedString(response))
+	common.LogResponse(ctx, "DeleteCloudRedis", response)
 
 	if err := waitRedisDeleted(ctx, r.config, state.ID.ValueString()); err != nil {
 		resp.Diagnostics.AddError("WAITING FOR DELETE ERROR", err.Error())
@@ -549,14 +629,14 @@ func GetRedisDetail(ctx context.Context, config *conn.ProviderConfig, no string)
 		RegionCode:           &config.RegionCode,
 		CloudRedisInstanceNo: &no,
 	}
-	tflog.Info(ctx, "GetRedisDetail reqParams="+common.MarshalUncheckedString(reqParams))
+	ctx = common.LogCommonRequest(ctx, "GetRedisDetail", reqParams)
 
 	resp, err := config.Client.Vredis.V2Api.GetCloudRedisInstanceDetail(reqParams)
 	// If the lookup result is 0 or already deleted, it will respond with a 400 error with a 5001017 return code.
-	if err != nil && !(strings.Contains(err.Error(), `"returnCode": "5001017"`)) {
+	if err != nil && !common.IsNotFound(err) {
 		return nil, err
 	}
-	tflog.Info(ctx, "GetRedisDetail response="+common.MarshalUncheckedString(resp))
+	common.LogResponse(ctx, "GetRedisDetail", resp)
 
 	if resp == nil || len(resp.CloudRedisInstanceList) < 1 {
 		return nil, nil
@@ -566,10 +646,10 @@ func GetRedisDetail(ctx context.Context, config *conn.ProviderConfig, no string)
 }
 
 func waitRedisDeleted(ctx context.Context, config *conn.ProviderConfig, no string) error {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &waiter.Config[any]{
 		Pending: []string{"deleting"},
 		Target:  []string{"deleted"},
-		Refresh: func() (interface{}, string, error) {
+		Refresh: func(ctx context.Context) (any, string, error) {
 			resp, err := GetRedisDetail(ctx, config, no)
 			if err != nil {
 				return 0, "", err
@@ -581,12 +661,12 @@ func waitRedisDeleted(ctx context.Context, config *conn.ProviderConfig, no strin
 
 			return resp, "deleting", nil
 		},
-		Timeout:    conn.DefaultTimeout,
-		Delay:      5 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Timeout:     conn.DefaultTimeout,
+		Delay:       5 * time.Second,
+		MinInterval: 3 * time.Second,
 	}
 
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForState(ctx); err != nil {
 		return fmt.Errorf("error waiting for Redis (%s) to become termintaing: %s", no, err)
 	}
 
@@ -595,10 +675,10 @@ func waitRedisDeleted(ctx context.Context, config *conn.ProviderConfig, no strin
 
 func waitRedisCreated(ctx context.Context, config *conn.ProviderConfig, no string) (*vredis.CloudRedisInstance, error) {
 	var redisInstance *vredis.CloudRedisInstance
-	stateConf := &retry.StateChangeConf{
+	stateConf := &waiter.Config[any]{
 		Pending: []string{"creating", "settingUp"},
 		Target:  []string{"running"},
-		Refresh: func() (interface{}, string, error) {
+		Refresh: func(ctx context.Context) (any, string, error) {
 			resp, err := GetRedisDetail(ctx, config, no)
 			redisInstance = resp
 			if err != nil {
@@ -619,12 +699,12 @@ func waitRedisCreated(ctx context.Context, config *conn.ProviderConfig, no strin
 
 			return 0, "", fmt.Errorf("error occurred while waiting to create")
 		},
-		Timeout:    6 * conn.DefaultTimeout,
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Timeout:     6 * conn.DefaultTimeout,
+		Delay:       2 * time.Second,
+		MinInterval: 3 * time.Second,
 	}
 
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForState(ctx); err != nil {
 		return nil, fmt.Errorf("Error waiting for Redis (%s) to become available: %s", no, err)
 	}
 
@@ -8,10 +8,9 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/framework"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/waiter"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vredis"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -116,14 +115,14 @@ func (r *redisConfigGroupResource) Create(ctx context.Context, req resource.Crea
 		ConfigGroupDescription: plan.Description.ValueStringPointer(),
 	}
 
-	tflog.Info(ctx, "CreateCloudRedisConfigGroup reqParams="+common.MarshalUncheckedString(reqParams))
+	ctx = common.LogCommonRequest(ctx, "CreateCloudRedisConfigGroup", reqParams)
 
 	response, err := r.config.Client.Vredis.V2Api.CreateCloudRedisConfigGroup(reqParams)
 	if err != nil {
 		resp.Diagnostics.AddError("CREATING ERROR", err.Error())
 		return
 	}
-	tflog.Info(ctx, "CreateCloudRedisConfigGroup response="+common.MarshalUncheckedString(response))
+	common.LogResponse(ctx, "CreateCloudRedisConfigGroup", response)
 
 	if response == nil || len(response.CloudRedisConfigGroupList) < 1 {
 		resp.Diagnostics.AddError("CREATING ERROR", "response invalid")
@@ -201,7 +200,7 @@ func (r *redisConfigGroupResource) Delete(ctx context.Context, req resource.Dele
 		ConfigGroupNo: state.ID.ValueStringPointer(),
 	}
 
-	tflog.Info(ctx, "DeleteCloudRedisConfigGroup reqParams="+common.MarshalUncheckedString(reqParams))
+	ctx = common.LogCommonRequest(ctx, "DeleteCloudRedisConfigGroup", reqParams)
 
 	response, err := r.config.Client.Vredis.V2Api.DeleteCloudRedisConfigGroup(reqParams)
 	if err != nil {
@@ -209,7 +208,7 @@ func (r *redisConfigGroupResource) Delete(ctx context.Context, req resource.Dele
 		return
 	}
 
-	tflog.Info(ctx, "DeleteCloudRedisConfigGroup response="+common.MarshalUncheckedString(response))
+	common.LogResponse(ctx, "DeleteCloudRedisConfigGroup", response)
 
 	if err := waitRedisConfigGroupDeleted(ctx, r.config, state.Name.ValueString()); err != nil {
 		resp.Diagnostics.AddError("WAITING FOR DELETE ERROR", err.Error())
@@ -226,13 +225,13 @@ func GetRedisConfigGroup(ctx context.Context, config *conn.ProviderConfig, name
 		ConfigGroupName: &name,
 	}
 
-	tflog.Info(ctx, "GetRedisConfigGroup reqParams="+common.MarshalUncheckedString(reqParams))
+	ctx = common.LogCommonRequest(ctx, "GetRedisConfigGroup", reqParams)
 
 	resp, err := config.Client.Vredis.V2Api.GetCloudRedisConfigGroupList(reqParams)
 	if err != nil {
 		return nil, err
 	}
-	tflog.Info(ctx, "GetRedisConfigGroup response="+common.MarshalUncheckedString(resp))
+	common.LogResponse(ctx, "GetRedisConfigGroup", resp)
 
 	if resp == nil || len(resp.CloudRedisConfigGroupList) < 1 {
 		return nil, nil
@@ -255,10 +254,10 @@ func GetRedisConfigGroup(ctx context.Context, config *conn.ProviderConfig, name
 }
 
 func waitRedisConfigGroupDeleted(ctx context.Context, config *conn.ProviderConfig, name string) error {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &waiter.Config[any]{
 		Pending: []string{"deleting"},
 		Target:  []string{"deleted"},
-		Refresh: func() (interface{}, string, error) {
+		Refresh: func(ctx context.Context) (any, string, error) {
 			resp, err := GetRedisConfigGroup(ctx, config, name)
 			if err != nil {
 				return 0, "", err
@@ -274,12 +273,12 @@ func waitRedisConfigGroupDeleted(ctx context.Context, config *conn.ProviderConfi
 
 			return 0, "", fmt.Errorf("error occurred while waiting to delete")
 		},
-		Timeout:    conn.DefaultTimeout,
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Timeout:     conn.DefaultTimeout,
+		Delay:       2 * time.Second,
+		MinInterval: 3 * time.Second,
 	}
 
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForState(ctx); err != nil {
 		return fmt.Errorf("error waiting for Redis Config Group (%s) to become termintaing: %s", name, err)
 	}
 
@@ -288,10 +287,10 @@ func waitRedisConfigGroupDeleted(ctx context.Context, config *conn.ProviderConfi
 
 func waitRedisConfigGroupCreated(ctx context.Context, config *conn.ProviderConfig, name string) (*vredis.CloudRedisConfigGroup, error) {
 	var redisConfigGroup *vredis.CloudRedisConfigGroup
-	stateConf := &retry.StateChangeConf{
+	stateConf := &waiter.Config[any]{
 		Pending: []string{"creating", "settingUp"},
 		Target:  []string{"running"},
-		Refresh: func() (interface{}, string, error) {
+		Refresh: func(ctx context.Context) (any, string, error) {
 			resp, err := GetRedisConfigGroup(ctx, config, name)
 			redisConfigGroup = resp
 			if err != nil {
@@ -312,12 +311,12 @@ func waitRedisConfigGroupCreated(ctx context.Context, config *conn.ProviderConfi
 
 			return 0, "", fmt.Errorf("error occurred while waiting to create")
 		},
-		Timeout:    conn.DefaultTimeout,
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Timeout:     conn.DefaultTimeout,
+		Delay:       2 * time.Second,
+		MinInterval: 3 * time.Second,
 	}
 
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForState(ctx); err != nil {
 		return nil, fmt.Errorf("Error waiting for Redis Config Group (%s) to become available: %s", name, err)
 	}
 
@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/acctest"
 )
@@ -12,7 +11,7 @@ import (
 func TestAccDataSourceNcloudRedisConfigGroup_vpc_basic(t *testing.T) {
 	dataName := "data.ncloud_redis_config_group.by_name"
 	resourceName := "ncloud_redis_config_group.test"
-	testConfigGroupName := fmt.Sprintf("tf-test-%s", acctest.RandString(5))
+	testConfigGroupName := fmt.Sprintf("tf-test-%s", RandString(t, 5))
 	version := "7.0.13-simple"
 
 	resource.Test(t, resource.TestCase{
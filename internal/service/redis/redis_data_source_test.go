@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/acctest"
 )
@@ -12,7 +11,7 @@ import (
 func TestAccDataSourceNcloudRedis_vpc_basic(t *testing.T) {
 	dataName := "data.ncloud_redis.by_id"
 	resourceName := "ncloud_redis.test"
-	testRedisName := fmt.Sprintf("tf-redis-%s", acctest.RandString(5))
+	testRedisName := fmt.Sprintf("tf-redis-%s", RandString(t, 5))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { TestAccPreCheck(t) },
@@ -9,7 +9,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
 )
@@ -109,24 +108,18 @@ func (r *redisImageProductsDataSource) Read(ctx context.Context, req datasource.
 		return
 	}
 
-	reqParams := &vredis.GetCloudRedisImageProductListRequest{
-		RegionCode: &r.config.RegionCode,
-	}
-	tflog.Info(ctx, "GetRedisImageProductList reqParams="+common.MarshalUncheckedString(reqParams))
-
-	redisImageProductResp, err := r.config.Client.Vredis.V2Api.GetCloudRedisImageProductList(reqParams)
+	imageProducts, err := getRedisImageProductList(ctx, r.config)
 	if err != nil {
 		resp.Diagnostics.AddError("READING ERROR", err.Error())
 		return
 	}
-	tflog.Info(ctx, "GetRedisImageProductList response="+common.MarshalUncheckedString(redisImageProductResp))
 
-	if redisImageProductResp == nil || len(redisImageProductResp.ProductList) < 1 {
+	if len(imageProducts) < 1 {
 		resp.Diagnostics.AddError("READING ERROR", "no result.")
 		return
 	}
 
-	redisImageProductList := flattenRedisImageProduct(redisImageProductResp.ProductList)
+	redisImageProductList := flattenRedisImageProduct(imageProducts)
 	fillteredList := common.FilterModels(ctx, data.Filters, redisImageProductList)
 	data.refreshFromOutput(ctx, fillteredList)
 
@@ -142,6 +135,30 @@ func (r *redisImageProductsDataSource) Read(ctx context.Context, req datasource.
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
+func getRedisImageProductList(ctx context.Context, config *conn.ProviderConfig) ([]*vredis.Product, error) {
+	reqParams := &vredis.GetCloudRedisImageProductListRequest{
+		RegionCode: &config.RegionCode,
+	}
+	resp, err := conn.CachedLookup(config, conn.CatalogKey("vredis.GetCloudRedisImageProductList", reqParams), func() (*vredis.GetCloudRedisImageProductListResponse, error) {
+		ctx := common.LogCommonRequest(ctx, "GetRedisImageProductList", reqParams)
+		resp, err := config.Client.Vredis.V2Api.GetCloudRedisImageProductList(reqParams)
+		if err != nil {
+			common.LogErrorResponse(ctx, "GetRedisImageProductList", err, reqParams)
+			return nil, err
+		}
+		common.LogResponse(ctx, "GetRedisImageProductList", resp)
+		return resp, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if resp == nil {
+		return nil, nil
+	}
+	return resp.ProductList, nil
+}
+
 func flattenRedisImageProduct(list []*vredis.Product) []*redisImageProduct {
 	var outputs []*redisImageProduct
 
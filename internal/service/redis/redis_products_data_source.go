@@ -10,7 +10,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
 )
@@ -117,25 +116,18 @@ func (r *redisProductsDataSource) Read(ctx context.Context, req datasource.ReadR
 		return
 	}
 
-	reqParams := &vredis.GetCloudRedisProductListRequest{
-		RegionCode:                 &r.config.RegionCode,
-		CloudRedisImageProductCode: data.CloudRedisImageProductCode.ValueStringPointer(),
-	}
-	tflog.Info(ctx, "GetRedisProductList reqParams="+common.MarshalUncheckedString(reqParams))
-
-	redisProductResp, err := r.config.Client.Vredis.V2Api.GetCloudRedisProductList(reqParams)
+	products, err := getRedisProductList(ctx, r.config, data.CloudRedisImageProductCode.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("READING ERROR", err.Error())
 		return
 	}
-	tflog.Info(ctx, "GetRedisProductList response="+common.MarshalUncheckedString(redisProductResp))
 
-	if redisProductResp == nil || len(redisProductResp.ProductList) < 1 {
+	if len(products) < 1 {
 		resp.Diagnostics.AddError("READING ERROR", "no result.")
 		return
 	}
 
-	redisProductList := flattenRedisProduct(redisProductResp.ProductList)
+	redisProductList := flattenRedisProduct(products)
 	fillteredList := common.FilterModels(ctx, data.Filters, redisProductList)
 
 	data.refreshFromOutput(ctx, fillteredList)
@@ -169,6 +161,31 @@ func convertProductsToJsonStruct(images []attr.Value) ([]redisProductsToJsonConv
 	return redisProductsToConvert, nil
 }
 
+func getRedisProductList(ctx context.Context, config *conn.ProviderConfig, imageProductCode string) ([]*vredis.Product, error) {
+	reqParams := &vredis.GetCloudRedisProductListRequest{
+		RegionCode:                 &config.RegionCode,
+		CloudRedisImageProductCode: &imageProductCode,
+	}
+	resp, err := conn.CachedLookup(config, conn.CatalogKey("vredis.GetCloudRedisProductList", reqParams), func() (*vredis.GetCloudRedisProductListResponse, error) {
+		ctx := common.LogCommonRequest(ctx, "GetRedisProductList", reqParams)
+		resp, err := config.Client.Vredis.V2Api.GetCloudRedisProductList(reqParams)
+		if err != nil {
+			common.LogErrorResponse(ctx, "GetRedisProductList", err, reqParams)
+			return nil, err
+		}
+		common.LogResponse(ctx, "GetRedisProductList", resp)
+		return resp, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if resp == nil {
+		return nil, nil
+	}
+	return resp.ProductList, nil
+}
+
 func flattenRedisProduct(list []*vredis.Product) []*redisProductModel {
 	var outputs []*redisProductModel
 
@@ -9,7 +9,6 @@ import (
 	"testing"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vredis"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -20,7 +19,7 @@ import (
 
 func TestAccResourceNcloudRedis_vpc_basic(t *testing.T) {
 	var redisInstance vredis.CloudRedisInstance
-	testRedisName := fmt.Sprintf("tf-redis-%s", acctest.RandString(5))
+	testRedisName := fmt.Sprintf("tf-redis-%s", RandString(t, 5))
 	resourceName := "ncloud_redis.test"
 
 	resource.Test(t, resource.TestCase{
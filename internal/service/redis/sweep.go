@@ -0,0 +1,96 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vredis"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/paging"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/sweep"
+)
+
+// RegisterSweepers registers the sweepers of Redis instances and config groups.
+func RegisterSweepers() {
+	sweep.AddTestSweepers("ncloud_redis", &resource.Sweeper{
+		Name: "ncloud_redis",
+		F:    sweepRedis,
+	})
+
+	sweep.AddTestSweepers("ncloud_redis_config_group", &resource.Sweeper{
+		Name: "ncloud_redis_config_group",
+		F:    sweepRedisConfigGroups,
+		Dependencies: []string{
+			"ncloud_redis",
+		},
+	})
+}
+
+func sweepRedis(region string) error {
+	config, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+	if !config.SupportVPC {
+		return nil
+	}
+
+	cloudRedisInstanceList, err := paging.Pager[*vredis.CloudRedisInstance]{
+		ZeroBased: true,
+		Page: func(ctx context.Context, pageNo, pageSize int32) ([]*vredis.CloudRedisInstance, *int32, error) {
+			resp, err := config.Client.Vredis.V2Api.GetCloudRedisInstanceList(&vredis.GetCloudRedisInstanceListRequest{RegionCode: &config.RegionCode, PageNo: ncloud.Int32(pageNo), PageSize: ncloud.Int32(pageSize)})
+			if err != nil {
+				return nil, nil, err
+			}
+			return resp.CloudRedisInstanceList, resp.TotalRows, nil
+		},
+	}.All(context.Background())
+	if err != nil {
+		if sweep.SkipSweepError(err) {
+			return nil
+		}
+		return fmt.Errorf("listing Redis instances: %w", err)
+	}
+
+	var sweepables []sweep.Sweepable
+	for _, i := range cloudRedisInstanceList {
+		if !sweep.HasResourcePrefix(i.CloudRedisServiceName) {
+			continue
+		}
+		sweepables = append(sweepables, sweep.NewFrameworkSweepResource(NewRedisResource, config, *i.CloudRedisInstanceNo, nil))
+	}
+
+	return sweep.SweepOrchestrator(context.Background(), sweepables)
+}
+
+func sweepRedisConfigGroups(region string) error {
+	config, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+	if !config.SupportVPC {
+		return nil
+	}
+
+	resp, err := config.Client.Vredis.V2Api.GetCloudRedisConfigGroupList(&vredis.GetCloudRedisConfigGroupListRequest{RegionCode: &config.RegionCode})
+	if err != nil {
+		if sweep.SkipSweepError(err) {
+			return nil
+		}
+		return fmt.Errorf("listing Redis config groups: %w", err)
+	}
+
+	var sweepables []sweep.Sweepable
+	for _, g := range resp.CloudRedisConfigGroupList {
+		if !sweep.HasResourcePrefix(g.ConfigGroupName) {
+			continue
+		}
+		sweepables = append(sweepables, sweep.NewFrameworkSweepResource(NewRedisConfigGroupResource, config, *g.ConfigGroupNo, map[string]string{
+			"name": *g.ConfigGroupName,
+		}))
+	}
+
+	return sweep.SweepOrchestrator(context.Background(), sweepables)
+}
@@ -1,30 +1,31 @@
 package server
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
-
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
-	"github.com/terraform-providers/terraform-provider-ncloud/internal/service/vpc"
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/verify"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/waiter"
 )
 
 func ResourceNcloudAccessControlGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNcloudAccessControlGroupCreate,
-		Read:   resourceNcloudAccessControlGroupRead,
-		Delete: resourceNcloudAccessControlGroupDelete,
+		CreateContext: resourceNcloudAccessControlGroupCreate,
+		ReadContext:   resourceNcloudAccessControlGroupRead,
+		DeleteContext: resourceNcloudAccessControlGroupDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"vpc_no": {
@@ -58,27 +59,27 @@ func ResourceNcloudAccessControlGroup() *schema.Resource {
 	}
 }
 
-func resourceNcloudAccessControlGroupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudAccessControlGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*conn.ProviderConfig)
 
-	instance, err := createAccessControlGroup(d, config)
+	instance, err := createAccessControlGroup(ctx, d, config)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(*instance.AccessControlGroupNo)
 	log.Printf("[INFO] ACG ID: %s", d.Id())
 
-	return resourceNcloudAccessControlGroupRead(d, meta)
+	return resourceNcloudAccessControlGroupRead(ctx, d, meta)
 }
 
-func resourceNcloudAccessControlGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudAccessControlGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*conn.ProviderConfig)
 
-	instance, err := GetAccessControlGroup(config, d.Id())
+	instance, err := GetAccessControlGroup(ctx, config, d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if instance == nil {
@@ -96,37 +97,40 @@ func resourceNcloudAccessControlGroupRead(d *schema.ResourceData, meta interface
 	return nil
 }
 
-func resourceNcloudAccessControlGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudAccessControlGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*conn.ProviderConfig)
 
-	if err := DeleteAccessControlGroup(config, d.Id()); err != nil {
-		return err
+	if err := DeleteAccessControlGroup(ctx, config, d.Id()); err != nil {
+		return diag.FromErr(err)
 	}
 
 	return nil
 }
 
-func GetAccessControlGroup(config *conn.ProviderConfig, id string) (*vserver.AccessControlGroup, error) {
+func GetAccessControlGroup(ctx context.Context, config *conn.ProviderConfig, id string) (*vserver.AccessControlGroup, error) {
 	if config.SupportVPC {
-		return getVpcAccessControlGroup(config, id)
+		return getVpcAccessControlGroup(ctx, config, id)
 	}
 
 	return nil, NotSupportClassic("resource `ncloud_access_control_group`")
 }
 
-func getVpcAccessControlGroup(config *conn.ProviderConfig, id string) (*vserver.AccessControlGroup, error) {
+func getVpcAccessControlGroup(ctx context.Context, config *conn.ProviderConfig, id string) (*vserver.AccessControlGroup, error) {
 	reqParams := &vserver.GetAccessControlGroupDetailRequest{
 		RegionCode:           &config.RegionCode,
 		AccessControlGroupNo: ncloud.String(id),
 	}
 
-	LogCommonRequest("getVpcAccessControlGroup", reqParams)
+	ctx = LogCommonRequest(ctx, "getVpcAccessControlGroup", reqParams)
 	resp, err := config.Client.Vserver.V2Api.GetAccessControlGroupDetail(reqParams)
 	if err != nil {
-		LogErrorResponse("getVpcAccessControlGroup", err, reqParams)
+		if IsNotFound(err) {
+			return nil, nil
+		}
+		LogErrorResponse(ctx, "getVpcAccessControlGroup", err, reqParams)
 		return nil, err
 	}
-	LogResponse("getVpcAccessControlGroup", resp)
+	LogResponse(ctx, "getVpcAccessControlGroup", resp)
 
 	if len(resp.AccessControlGroupList) > 0 {
 		return resp.AccessControlGroupList[0], nil
@@ -135,15 +139,15 @@ func getVpcAccessControlGroup(config *conn.ProviderConfig, id string) (*vserver.
 	return nil, nil
 }
 
-func createAccessControlGroup(d *schema.ResourceData, config *conn.ProviderConfig) (*vserver.AccessControlGroup, error) {
+func createAccessControlGroup(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) (*vserver.AccessControlGroup, error) {
 	if config.SupportVPC {
-		return createVpcAccessControlGroup(d, config)
+		return createVpcAccessControlGroup(ctx, d, config)
 	}
 
 	return nil, NotSupportClassic("resource `ncloud_access_control_group`")
 }
 
-func createVpcAccessControlGroup(d *schema.ResourceData, config *conn.ProviderConfig) (*vserver.AccessControlGroup, error) {
+func createVpcAccessControlGroup(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) (*vserver.AccessControlGroup, error) {
 	reqParams := &vserver.CreateAccessControlGroupRequest{
 		RegionCode:                    &config.RegionCode,
 		VpcNo:                         ncloud.String(d.Get("vpc_no").(string)),
@@ -151,27 +155,27 @@ func createVpcAccessControlGroup(d *schema.ResourceData, config *conn.ProviderCo
 		AccessControlGroupDescription: StringPtrOrNil(d.GetOk("description")),
 	}
 
-	LogCommonRequest("createVpcAccessControlGroup", reqParams)
+	ctx = LogCommonRequest(ctx, "createVpcAccessControlGroup", reqParams)
 	resp, err := config.Client.Vserver.V2Api.CreateAccessControlGroup(reqParams)
 	if err != nil {
-		LogErrorResponse("createVpcAccessControlGroup", err, reqParams)
+		LogErrorResponse(ctx, "createVpcAccessControlGroup", err, reqParams)
 		return nil, err
 	}
-	LogResponse("createVpcAccessControlGroup", resp)
+	LogResponse(ctx, "createVpcAccessControlGroup", resp)
 
 	return resp.AccessControlGroupList[0], nil
 }
 
-func DeleteAccessControlGroup(config *conn.ProviderConfig, id string) error {
+func DeleteAccessControlGroup(ctx context.Context, config *conn.ProviderConfig, id string) error {
 	if config.SupportVPC {
-		return deleteVpcAccessControlGroup(config, id)
+		return deleteVpcAccessControlGroup(ctx, config, id)
 	}
 
 	return NotSupportClassic("resource `ncloud_access_control_group`")
 }
 
-func deleteVpcAccessControlGroup(config *conn.ProviderConfig, id string) error {
-	accessControlGroup, err := GetAccessControlGroup(config, id)
+func deleteVpcAccessControlGroup(ctx context.Context, config *conn.ProviderConfig, id string) error {
+	accessControlGroup, err := GetAccessControlGroup(ctx, config, id)
 	if err != nil {
 		return err
 	}
@@ -186,35 +190,36 @@ func deleteVpcAccessControlGroup(config *conn.ProviderConfig, id string) error {
 		AccessControlGroupNo: ncloud.String(id),
 	}
 
-	LogCommonRequest("deleteVpcAccessControlGroup", reqParams)
+	ctx = LogCommonRequest(ctx, "deleteVpcAccessControlGroup", reqParams)
 	resp, err := config.Client.Vserver.V2Api.DeleteAccessControlGroup(reqParams)
 	if err != nil {
-		LogErrorResponse("deleteVpcAccessControlGroup", err, reqParams)
+		LogErrorResponse(ctx, "deleteVpcAccessControlGroup", err, reqParams)
 		return err
 	}
-	LogResponse("deleteVpcAccessControlGroup", resp)
+	LogResponse(ctx, "deleteVpcAccessControlGroup", resp)
 
-	if err := waitForVpcAccessControlGroupDeletion(config, id); err != nil {
+	if err := waitForVpcAccessControlGroupDeletion(ctx, config, id); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func waitForVpcAccessControlGroupDeletion(config *conn.ProviderConfig, id string) error {
-	stateConf := &resource.StateChangeConf{
+func waitForVpcAccessControlGroupDeletion(ctx context.Context, config *conn.ProviderConfig, id string) error {
+	stateConf := &waiter.Config[*vserver.AccessControlGroup]{
 		Pending: []string{"RUN"},
 		Target:  []string{"TERMINATED"},
-		Refresh: func() (interface{}, string, error) {
-			instance, err := GetAccessControlGroup(config, id)
-			return vpc.VpcCommonStateRefreshFunc(instance, err, "AccessControlGroupStatus")
-		},
-		Timeout:    conn.DefaultTimeout,
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Refresh: waiter.StatusOf(func(ctx context.Context) (*vserver.AccessControlGroup, error) {
+			return GetAccessControlGroup(ctx, config, id)
+		}, func(instance *vserver.AccessControlGroup) string {
+			return ncloud.StringValue(common.GetCodePtrByCommonCode(instance.AccessControlGroupStatus))
+		}),
+		Timeout:     conn.DefaultTimeout,
+		Delay:       2 * time.Second,
+		MinInterval: 3 * time.Second,
 	}
 
-	_, err := stateConf.WaitForState()
+	_, err := stateConf.WaitForState(ctx)
 	if err != nil {
 		return fmt.Errorf("error waiting for Access Control Group (%s) to become terminated: %s", id, err)
 	}
@@ -222,20 +227,21 @@ func waitForVpcAccessControlGroupDeletion(config *conn.ProviderConfig, id string
 	return nil
 }
 
-func waitForVpcAccessControlGroupRunning(config *conn.ProviderConfig, id string) error {
-	stateConf := &resource.StateChangeConf{
+func waitForVpcAccessControlGroupRunning(ctx context.Context, config *conn.ProviderConfig, id string) error {
+	stateConf := &waiter.Config[*vserver.AccessControlGroup]{
 		Pending: []string{"SET"},
 		Target:  []string{"RUN"},
-		Refresh: func() (interface{}, string, error) {
-			instance, err := GetAccessControlGroup(config, id)
-			return vpc.VpcCommonStateRefreshFunc(instance, err, "AccessControlGroupStatus")
-		},
-		Timeout:    conn.DefaultTimeout,
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Refresh: waiter.StatusOf(func(ctx context.Context) (*vserver.AccessControlGroup, error) {
+			return GetAccessControlGroup(ctx, config, id)
+		}, func(instance *vserver.AccessControlGroup) string {
+			return ncloud.StringValue(common.GetCodePtrByCommonCode(instance.AccessControlGroupStatus))
+		}),
+		Timeout:     conn.DefaultTimeout,
+		Delay:       2 * time.Second,
+		MinInterval: 3 * time.Second,
 	}
 
-	_, err := stateConf.WaitForState()
+	_, err := stateConf.WaitForState(ctx)
 	if err != nil {
 		return fmt.Errorf("error waiting for Access Control Group (%s) to become running: %s", id, err)
 	}
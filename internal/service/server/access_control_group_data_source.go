@@ -1,7 +1,10 @@
 package server
 
 import (
+	"context"
+
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
@@ -9,12 +12,13 @@ import (
 
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/paging"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/verify"
 )
 
 func DataSourceNcloudAccessControlGroup() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNcloudAccessControlGroupRead,
+		ReadContext: dataSourceNcloudAccessControlGroupRead,
 
 		Schema: map[string]*schema.Schema{
 			"configuration_no": {
@@ -64,19 +68,19 @@ func DataSourceNcloudAccessControlGroup() *schema.Resource {
 	}
 }
 
-func dataSourceNcloudAccessControlGroupRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceNcloudAccessControlGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*conn.ProviderConfig)
 	var resources []map[string]interface{}
 	var err error
 
 	if config.SupportVPC {
-		resources, err = getVpcAccessControlGroupList(d, config)
+		resources, err = getVpcAccessControlGroupList(ctx, d, config)
 	} else {
-		resources, err = getClassicAccessControlGroupList(d, config)
+		resources, err = getClassicAccessControlGroupList(ctx, d, config)
 	}
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if f, ok := d.GetOk("filter"); ok {
@@ -84,7 +88,7 @@ func dataSourceNcloudAccessControlGroupRead(d *schema.ResourceData, meta interfa
 	}
 
 	if err := verify.ValidateOneResult(len(resources)); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	SetSingularResourceDataFromMap(d, resources[0])
@@ -92,7 +96,7 @@ func dataSourceNcloudAccessControlGroupRead(d *schema.ResourceData, meta interfa
 	return nil
 }
 
-func getVpcAccessControlGroupList(d *schema.ResourceData, config *conn.ProviderConfig) ([]map[string]interface{}, error) {
+func getVpcAccessControlGroupList(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) ([]map[string]interface{}, error) {
 	reqParams := &vserver.GetAccessControlGroupListRequest{
 		RegionCode:             &config.RegionCode,
 		AccessControlGroupName: StringPtrOrNil(d.GetOk("name")),
@@ -103,17 +107,26 @@ func getVpcAccessControlGroupList(d *schema.ResourceData, config *conn.ProviderC
 		reqParams.AccessControlGroupNoList = []*string{ncloud.String(v.(string))}
 	}
 
-	LogCommonRequest("getVpcAccessControlGroup", reqParams)
+	accessControlGroupList, err := paging.All(ctx, func(ctx context.Context, pageNo, pageSize int32) ([]*vserver.AccessControlGroup, *int32, error) {
+		reqParams.PageNo = ncloud.Int32(pageNo)
+		reqParams.PageSize = ncloud.Int32(pageSize)
+
+		ctx = LogCommonRequest(ctx, "getVpcAccessControlGroup", reqParams)
+		resp, err := config.Client.Vserver.V2Api.GetAccessControlGroupList(reqParams)
+		if err != nil {
+			LogErrorResponse(ctx, "getVpcAccessControlGroup", err, reqParams)
+			return nil, nil, err
+		}
+		LogResponse(ctx, "getVpcAccessControlGroup", resp)
 
-	resp, err := config.Client.Vserver.V2Api.GetAccessControlGroupList(reqParams)
+		return resp.AccessControlGroupList, resp.TotalRows, nil
+	})
 	if err != nil {
-		LogErrorResponse("getVpcAccessControlGroup", err, reqParams)
 		return nil, err
 	}
-	LogResponse("getVpcAccessControlGroup", resp)
 
 	var resources []map[string]interface{}
-	for _, r := range resp.AccessControlGroupList {
+	for _, r := range accessControlGroupList {
 		instance := map[string]interface{}{
 			"id":                      *r.AccessControlGroupNo,
 			"access_control_group_no": *r.AccessControlGroupNo,
@@ -129,7 +142,7 @@ func getVpcAccessControlGroupList(d *schema.ResourceData, config *conn.ProviderC
 	return resources, nil
 }
 
-func getClassicAccessControlGroupList(d *schema.ResourceData, config *conn.ProviderConfig) ([]map[string]interface{}, error) {
+func getClassicAccessControlGroupList(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) ([]map[string]interface{}, error) {
 	client := config.Client
 
 	reqParams := server.GetAccessControlGroupListRequest{
@@ -148,19 +161,26 @@ func getClassicAccessControlGroupList(d *schema.ResourceData, config *conn.Provi
 		reqParams.IsDefault = ncloud.Bool(v.(bool))
 	}
 
-	reqParams.PageNo = ncloud.Int32(1)
+	accessControlGroupList, err := paging.All(ctx, func(ctx context.Context, pageNo, pageSize int32) ([]*server.AccessControlGroup, *int32, error) {
+		reqParams.PageNo = ncloud.Int32(pageNo)
+		reqParams.PageSize = ncloud.Int32(pageSize)
 
-	LogCommonRequest("getClassicAccessControlGroupList", reqParams)
+		ctx = LogCommonRequest(ctx, "getClassicAccessControlGroupList", reqParams)
+		resp, err := client.Server.V2Api.GetAccessControlGroupList(&reqParams)
+		if err != nil {
+			LogErrorResponse(ctx, "getClassicAccessControlGroupList", err, reqParams)
+			return nil, nil, err
+		}
+		LogResponse(ctx, "getClassicAccessControlGroupList", resp)
 
-	resp, err := client.Server.V2Api.GetAccessControlGroupList(&reqParams)
+		return resp.AccessControlGroupList, resp.TotalRows, nil
+	})
 	if err != nil {
-		LogErrorResponse("getClassicAccessControlGroupList", err, reqParams)
 		return nil, err
 	}
-	LogResponse("getClassicAccessControlGroupList", resp)
 
 	var resources []map[string]interface{}
-	for _, r := range resp.AccessControlGroupList {
+	for _, r := range accessControlGroupList {
 		instance := map[string]interface{}{
 			"id":                      *r.AccessControlGroupConfigurationNo,
 			"access_control_group_no": *r.AccessControlGroupConfigurationNo,
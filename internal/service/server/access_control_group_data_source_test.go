@@ -5,8 +5,6 @@ import (
 	"regexp"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
-
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/acctest"
@@ -18,7 +16,7 @@ func TestAccDataSourceNcloudVpcAccessControlGroupBasic(t *testing.T) {
 	*/
 	t.Skip()
 
-	name := fmt.Sprintf("tf-ds-acg-basic-%s", acctest.RandString(5))
+	name := fmt.Sprintf("tf-ds-acg-basic-%s", RandString(t, 5))
 	dataName := "data.ncloud_access_control_group.by_id"
 	resourceName := "ncloud_access_control_group.test"
 
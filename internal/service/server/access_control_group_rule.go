@@ -1,473 +1,516 @@
 package server
 
 import (
+	"context"
 	"fmt"
-	"log"
 	"regexp"
 	"strconv"
-	"time"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
-
-	. "github.com/terraform-providers/terraform-provider-ncloud/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
-	. "github.com/terraform-providers/terraform-provider-ncloud/internal/verify"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/framework"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/verify"
+)
+
+var (
+	_ resource.Resource                 = &accessControlGroupRuleResource{}
+	_ resource.ResourceWithConfigure    = &accessControlGroupRuleResource{}
+	_ resource.ResourceWithUpgradeState = &accessControlGroupRuleResource{}
 )
 
-func ResourceNcloudAccessControlGroupRule() *schema.Resource {
-	return &schema.Resource{
-		Create: resourceNcloudAccessControlGroupRuleCreate,
-		Read:   resourceNcloudAccessControlGroupRuleRead,
-		Update: resourceNcloudAccessControlGroupRuleUpdate,
-		Delete: resourceNcloudAccessControlGroupRuleDelete,
-		Schema: map[string]*schema.Schema{
-			"access_control_group_no": {
-				Type:     schema.TypeString,
+func NewAccessControlGroupRuleResource() resource.Resource {
+	return &accessControlGroupRuleResource{}
+}
+
+type accessControlGroupRuleResource struct {
+	config *conn.ProviderConfig
+}
+
+func (a *accessControlGroupRuleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_access_control_group_rule"
+}
+
+func (a *accessControlGroupRuleResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = accessControlGroupRuleSchema(ctx)
+	resp.Schema.Version = 1
+}
+
+// accessControlGroupRuleSchema is the schema of the resource, unchanged since its SDKv2 implementation.
+// The rules stay blocks, as the SDKv2 implementation processed them in attributes-as-blocks mode.
+func accessControlGroupRuleSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": framework.IDAttribute(),
+			"access_control_group_no": schema.StringAttribute{
 				Required: true,
-				ForceNew: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
-			"inbound": {
-				Type:       schema.TypeSet,
-				Optional:   true,
-				ConfigMode: schema.SchemaConfigModeAttr,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"protocol": {
-							Type:     schema.TypeString,
-							Required: true,
-							ValidateDiagFunc: validation.ToDiagFunc(validation.All(
-								validation.StringMatch(regexp.MustCompile(`TCP|UDP|ICMP|\b([1-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-4])\b`), "only TCP, UDP, ICMP and 1-254 are valid values."),
-								validation.StringNotInSlice([]string{"1", "6", "17"}, false),
-							)),
-						},
-						"port_range": {
-							Type:             schema.TypeString,
-							Optional:         true,
-							ValidateDiagFunc: validation.ToDiagFunc(ValidatePortRange),
-							Default:          "",
-						},
-						"ip_block": {
-							Type:             schema.TypeString,
-							Optional:         true,
-							ValidateDiagFunc: validation.ToDiagFunc(validation.IsCIDRNetwork(0, 32)),
-							Default:          "",
-						},
-						"source_access_control_group_no": {
-							Type:     schema.TypeString,
-							Optional: true,
-							Default:  "",
-						},
-						"description": {
-							Type:             schema.TypeString,
-							Optional:         true,
-							ValidateDiagFunc: validation.ToDiagFunc(validation.StringLenBetween(0, 1000)),
-							Default:          "",
-						},
+		},
+		Blocks: map[string]schema.Block{
+			"inbound":  accessControlGroupRuleBlock(),
+			"outbound": accessControlGroupRuleBlock(),
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func accessControlGroupRuleBlock() schema.SetNestedBlock {
+	return schema.SetNestedBlock{
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"protocol": schema.StringAttribute{
+					Required: true,
+					Validators: []validator.String{
+						stringvalidator.RegexMatches(
+							regexp.MustCompile(`TCP|UDP|ICMP|\b([1-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-4])\b`),
+							"only TCP, UDP, ICMP and 1-254 are valid values.",
+						),
+						stringvalidator.NoneOf("1", "6", "17"),
 					},
 				},
-			},
-			"outbound": {
-				Type:       schema.TypeSet,
-				Optional:   true,
-				ConfigMode: schema.SchemaConfigModeAttr,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"protocol": {
-							Type:     schema.TypeString,
-							Required: true,
-							ValidateDiagFunc: validation.ToDiagFunc(validation.All(
-								validation.StringMatch(regexp.MustCompile(`TCP|UDP|ICMP|\b([1-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-4])\b`), "only TCP, UDP, ICMP and 1-254 are valid values."),
-								validation.StringNotInSlice([]string{"1", "6", "17"}, false),
-							)),
-						},
-						"port_range": {
-							Type:             schema.TypeString,
-							Optional:         true,
-							ValidateDiagFunc: validation.ToDiagFunc(ValidatePortRange),
-							Default:          "",
-						},
-						"ip_block": {
-							Type:             schema.TypeString,
-							Optional:         true,
-							ValidateDiagFunc: validation.ToDiagFunc(validation.IsCIDRNetwork(0, 32)),
-							Default:          "",
-						},
-						"source_access_control_group_no": {
-							Type:     schema.TypeString,
-							Optional: true,
-							Default:  "",
-						},
-						"description": {
-							Type:             schema.TypeString,
-							Optional:         true,
-							ValidateDiagFunc: validation.ToDiagFunc(validation.StringLenBetween(0, 1000)),
-							Default:          "",
-						},
+				"port_range": schema.StringAttribute{
+					Optional:   true,
+					Computed:   true,
+					Default:    stringdefault.StaticString(""),
+					Validators: verify.PortRangeValidator(),
+				},
+				"ip_block": schema.StringAttribute{
+					Optional:   true,
+					Computed:   true,
+					Default:    stringdefault.StaticString(""),
+					Validators: verify.CidrBlockValidator(),
+				},
+				"source_access_control_group_no": schema.StringAttribute{
+					Optional: true,
+					Computed: true,
+					Default:  stringdefault.StaticString(""),
+				},
+				"description": schema.StringAttribute{
+					Optional: true,
+					Computed: true,
+					Default:  stringdefault.StaticString(""),
+					Validators: []validator.String{
+						stringvalidator.LengthBetween(0, 1000),
 					},
 				},
 			},
 		},
-		Timeouts: &schema.ResourceTimeout{
-			Create: schema.DefaultTimeout(conn.DefaultCreateTimeout),
-			Update: schema.DefaultTimeout(conn.DefaultUpdateTimeout),
-			Delete: schema.DefaultTimeout(conn.DefaultTimeout),
-		},
 	}
 }
 
-func resourceNcloudAccessControlGroupRuleCreate(d *schema.ResourceData, meta interface{}) error {
-	config := meta.(*conn.ProviderConfig)
+func (a *accessControlGroupRuleResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		framework.SDKv2SchemaVersion: framework.UpgradeFromSDKv2[accessControlGroupRuleResourceModel](accessControlGroupRuleSchema(ctx)),
+	}
+}
+
+func (a *accessControlGroupRuleResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*conn.ProviderConfig)
 
-	if !config.SupportVPC {
-		return NotSupportClassic("resource `ncloud_access_control_group_rule`")
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
 	}
 
-	d.SetId(d.Get("access_control_group_no").(string))
-	log.Printf("[INFO] ACG ID: %s", d.Id())
+	a.config = config
+}
 
-	accessControlGroup, err := GetAccessControlGroup(config, d.Id())
+func (a *accessControlGroupRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan accessControlGroupRuleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !a.config.SupportVPC {
+		resp.Diagnostics.AddError(
+			"NOT SUPPORT CLASSIC",
+			"resource `ncloud_access_control_group_rule` does not support classic",
+		)
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, conn.DefaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	id := plan.AccessControlGroupNo.ValueString()
+	tflog.Info(ctx, "ACG ID", map[string]any{"id": id})
+
+	accessControlGroup, err := GetAccessControlGroup(ctx, a.config, id)
 	if err != nil {
-		return err
+		resp.Diagnostics.AddError("CREATING ERROR", err.Error())
+		return
 	}
 
 	if accessControlGroup == nil {
-		return fmt.Errorf("no matching Access Control Group: %s", d.Id())
+		resp.Diagnostics.AddError("CREATING ERROR", fmt.Sprintf("no matching Access Control Group: %s", id))
+		return
 	}
 
+	// the default access control group comes with rules, replaced by the rules of the resource
 	if *accessControlGroup.IsDefault {
-		rules, err := GetAccessControlGroupRuleList(config, d.Id())
+		rules, err := GetAccessControlGroupRuleList(ctx, a.config, id)
 		if err != nil {
-			errBody, _ := GetCommonErrorBody(err)
-			if errBody.ReturnCode == "1007000" { // Acg was not found
-				d.SetId("")
-			}
-			return err
+			resp.Diagnostics.AddError("CREATING ERROR", err.Error())
+			return
 		}
 
-		if len(rules) > 0 {
-			acgInRuleList, acgOutRuleList := makeRemoveInOutAccessControlGroupRule(rules)
-			if len(acgInRuleList) > 0 {
-				if err := removeAccessControlGroupRule(d, config, "inbound", accessControlGroup, acgInRuleList); err != nil {
-					return err
-				}
+		acgInRuleList, acgOutRuleList := makeRemoveInOutAccessControlGroupRule(rules)
+		if len(acgInRuleList) > 0 {
+			if err := removeAccessControlGroupRule(ctx, a.config, "inbound", accessControlGroup, acgInRuleList); err != nil {
+				resp.Diagnostics.AddError("CREATING ERROR", err.Error())
+				return
 			}
-			if len(acgOutRuleList) > 0 {
-				if err := removeAccessControlGroupRule(d, config, "outbound", accessControlGroup, acgOutRuleList); err != nil {
-					return err
-				}
+		}
+		if len(acgOutRuleList) > 0 {
+			if err := removeAccessControlGroupRule(ctx, a.config, "outbound", accessControlGroup, acgOutRuleList); err != nil {
+				resp.Diagnostics.AddError("CREATING ERROR", err.Error())
+				return
 			}
 		}
 	}
 
-	return resourceNcloudAccessControlGroupRuleUpdate(d, meta)
-}
-
-func resourceNcloudAccessControlGroupRuleRead(d *schema.ResourceData, meta interface{}) error {
-	config := meta.(*conn.ProviderConfig)
+	plan.ID = types.StringValue(id)
 
-	rules, err := GetAccessControlGroupRuleList(config, d.Id())
+	noRules := types.SetValueMust(types.ObjectType{AttrTypes: accessControlGroupRuleAttrTypes}, []attr.Value{})
 
-	if err != nil {
-		errBody, _ := GetCommonErrorBody(err)
-		if errBody.ReturnCode == "1007000" { // Acg was not found
-			d.SetId("")
-		}
-		return err
+	resp.Diagnostics.Append(updateAccessControlGroupRule(ctx, a.config, "inbound", accessControlGroup, noRules, plan.Inbound)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	if len(rules) == 0 {
-		d.SetId("")
-		return nil
+	resp.Diagnostics.Append(updateAccessControlGroupRule(ctx, a.config, "outbound", accessControlGroup, noRules, plan.Outbound)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	d.Set("access_control_group_no", d.Id())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
 
-	// Create empty set for getAccessControlGroupRuleList
-	iSet := schema.NewSet(schema.HashResource(ResourceNcloudAccessControlGroupRule().Schema["inbound"].Elem.(*schema.Resource)), []interface{}{})
-	oSet := schema.NewSet(schema.HashResource(ResourceNcloudAccessControlGroupRule().Schema["outbound"].Elem.(*schema.Resource)), []interface{}{})
+func (a *accessControlGroupRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state accessControlGroupRuleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	for _, r := range rules {
-		var protocol string
-		if allowedProtocolCodes[*r.ProtocolType.Code] {
-			protocol = *r.ProtocolType.Code
-		} else {
-			protocol = strconv.Itoa(int(*r.ProtocolType.Number))
+	rules, err := GetAccessControlGroupRuleList(ctx, a.config, state.ID.ValueString())
+	if err != nil {
+		if common.IsNotFound(err) {
+			tflog.Warn(ctx, "Access control group was not found, removing from state", map[string]any{"id": state.ID.ValueString()})
+			resp.State.RemoveResource(ctx)
+			return
 		}
+		resp.Diagnostics.AddError("READING ERROR", err.Error())
+		return
+	}
 
-		m := map[string]interface{}{
-			"protocol":                       protocol,
-			"port_range":                     *r.PortRange,
-			"ip_block":                       *r.IpBlock,
-			"source_access_control_group_no": *r.AccessControlGroupSequence,
-			"description":                    *r.AccessControlGroupRuleDescription,
-		}
+	if len(rules) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
 
-		if *r.AccessControlGroupRuleType.Code == "INBND" {
-			iSet.Add(m)
-		} else {
-			oSet.Add(m)
-		}
+	resp.Diagnostics.Append(state.refreshFromOutput(ctx, rules)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	// Only set data intersection between resource and list
-	if err := d.Set("inbound", iSet.List()); err != nil {
-		log.Printf("[WARN] Error setting inbound rule set for (%s): %s", d.Id(), err)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (a *accessControlGroupRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state accessControlGroupRuleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	if err := d.Set("outbound", oSet.List()); err != nil {
-		log.Printf("[WARN] Error setting outbound rule set for (%s): %s", d.Id(), err)
+	updateTimeout, diags := plan.Timeouts.Update(ctx, conn.DefaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	return nil
-}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
 
-func resourceNcloudAccessControlGroupRuleUpdate(d *schema.ResourceData, meta interface{}) error {
-	config := meta.(*conn.ProviderConfig)
+	if !plan.Inbound.Equal(state.Inbound) || !plan.Outbound.Equal(state.Outbound) {
+		accessControlGroup, err := GetAccessControlGroup(ctx, a.config, state.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("UPDATING ERROR", err.Error())
+			return
+		}
 
-	if d.HasChange("inbound") {
-		if err := updateAccessControlGroupRule(d, config, "inbound"); err != nil {
-			return err
+		if accessControlGroup == nil {
+			resp.Diagnostics.AddError("UPDATING ERROR", fmt.Sprintf("no matching Access Control Group: %s", state.ID.ValueString()))
+			return
+		}
+
+		resp.Diagnostics.Append(updateAccessControlGroupRule(ctx, a.config, "inbound", accessControlGroup, state.Inbound, plan.Inbound)...)
+		if resp.Diagnostics.HasError() {
+			return
 		}
-	}
 
-	if d.HasChange("outbound") {
-		if err := updateAccessControlGroupRule(d, config, "outbound"); err != nil {
-			return err
+		resp.Diagnostics.Append(updateAccessControlGroupRule(ctx, a.config, "outbound", accessControlGroup, state.Outbound, plan.Outbound)...)
+		if resp.Diagnostics.HasError() {
+			return
 		}
 	}
 
-	return resourceNcloudAccessControlGroupRuleRead(d, meta)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
-func resourceNcloudAccessControlGroupRuleDelete(d *schema.ResourceData, meta interface{}) error {
-	config := meta.(*conn.ProviderConfig)
+func (a *accessControlGroupRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state accessControlGroupRuleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	accessControlGroup, err := GetAccessControlGroup(config, d.Id())
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, conn.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	accessControlGroup, err := GetAccessControlGroup(ctx, a.config, state.ID.ValueString())
 	if err != nil {
-		return err
+		resp.Diagnostics.AddError("DELETING ERROR", err.Error())
+		return
 	}
 
 	if accessControlGroup == nil {
-		return fmt.Errorf("no matching Access Control Group: %s", d.Id())
+		resp.Diagnostics.AddError("DELETING ERROR", fmt.Sprintf("no matching Access Control Group: %s", state.ID.ValueString()))
+		return
 	}
 
-	i := d.Get("inbound").(*schema.Set)
-	o := d.Get("outbound").(*schema.Set)
-
-	if len(i.List()) > 0 {
-		if err := removeAccessControlGroupRule(d, config, "inbound", accessControlGroup, expandRemoveAccessControlGroupRule(i.List())); err != nil {
-			return err
-		}
-	}
+	noRules := types.SetValueMust(types.ObjectType{AttrTypes: accessControlGroupRuleAttrTypes}, []attr.Value{})
 
-	if len(o.List()) > 0 {
-		if err := removeAccessControlGroupRule(d, config, "outbound", accessControlGroup, expandRemoveAccessControlGroupRule(o.List())); err != nil {
-			return err
-		}
+	resp.Diagnostics.Append(updateAccessControlGroupRule(ctx, a.config, "inbound", accessControlGroup, state.Inbound, noRules)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	return nil
+	resp.Diagnostics.Append(updateAccessControlGroupRule(ctx, a.config, "outbound", accessControlGroup, state.Outbound, noRules)...)
 }
 
-func GetAccessControlGroupRuleList(config *conn.ProviderConfig, id string) ([]*vserver.AccessControlGroupRule, error) {
+func GetAccessControlGroupRuleList(ctx context.Context, config *conn.ProviderConfig, id string) ([]*vserver.AccessControlGroupRule, error) {
 	reqParams := &vserver.GetAccessControlGroupRuleListRequest{
 		RegionCode:           &config.RegionCode,
 		AccessControlGroupNo: ncloud.String(id),
 	}
 
-	LogCommonRequest("getAccessControlGroupRuleList", reqParams)
+	ctx = common.LogCommonRequest(ctx, "getAccessControlGroupRuleList", reqParams)
 	resp, err := config.Client.Vserver.V2Api.GetAccessControlGroupRuleList(reqParams)
 	if err != nil {
-		LogErrorResponse("getAccessControlGroupRuleList", err, reqParams)
+		common.LogErrorResponse(ctx, "getAccessControlGroupRuleList", err, reqParams)
 		return nil, err
 	}
-	LogResponse("getAccessControlGroupRuleList", resp)
+	common.LogResponse(ctx, "getAccessControlGroupRuleList", resp)
 
 	return resp.AccessControlGroupRuleList, nil
 }
 
-func updateAccessControlGroupRule(d *schema.ResourceData, config *conn.ProviderConfig, ruleType string) error {
-	o, n := d.GetChange(ruleType)
+// updateAccessControlGroupRule removes the rules of ruleType only in o, then adds the rules only in n
+func updateAccessControlGroupRule(ctx context.Context, config *conn.ProviderConfig, ruleType string, accessControlGroup *vserver.AccessControlGroup, o, n types.Set) diag.Diagnostics {
+	var diags diag.Diagnostics
 
-	if o == nil {
-		o = new(schema.Set)
+	var oldRules, newRules []accessControlGroupRuleModel
+	if !o.IsNull() && !o.IsUnknown() {
+		diags.Append(o.ElementsAs(ctx, &oldRules, false)...)
 	}
-	if n == nil {
-		n = new(schema.Set)
+	if !n.IsNull() && !n.IsUnknown() {
+		diags.Append(n.ElementsAs(ctx, &newRules, false)...)
 	}
-
-	os := o.(*schema.Set)
-	ns := n.(*schema.Set)
-
-	add := ns.Difference(os).List()
-	remove := os.Difference(ns).List()
-
-	accessControlGroup, err := GetAccessControlGroup(config, d.Id())
-	if err != nil {
-		return err
+	if diags.HasError() {
+		return diags
 	}
 
-	if accessControlGroup == nil {
-		return fmt.Errorf("no matching Access Control Group: %s", d.Id())
-	}
+	add := differenceAccessControlGroupRule(newRules, oldRules)
+	remove := differenceAccessControlGroupRule(oldRules, newRules)
 
 	removeAccessControlGroupRuleList := expandRemoveAccessControlGroupRule(remove)
 	addAccessControlGroupRuleList, err := expandAddAccessControlGroupRule(add)
 	if err != nil {
-		return err
+		diags.AddError("INVALID RULE", err.Error())
+		return diags
 	}
 
 	if len(removeAccessControlGroupRuleList) > 0 {
-		if err := removeAccessControlGroupRule(d, config, ruleType, accessControlGroup, removeAccessControlGroupRuleList); err != nil {
-			return err
+		if err := removeAccessControlGroupRule(ctx, config, ruleType, accessControlGroup, removeAccessControlGroupRuleList); err != nil {
+			diags.AddError("REMOVING RULE ERROR", err.Error())
+			return diags
 		}
 	}
 
 	if len(addAccessControlGroupRuleList) > 0 {
-		if err := addAccessControlGroupRule(d, config, ruleType, accessControlGroup, addAccessControlGroupRuleList); err != nil {
-			return err
+		if err := addAccessControlGroupRule(ctx, config, ruleType, accessControlGroup, addAccessControlGroupRuleList); err != nil {
+			diags.AddError("ADDING RULE ERROR", err.Error())
+			return diags
 		}
 	}
 
-	return nil
+	return diags
 }
 
-func addAccessControlGroupRule(d *schema.ResourceData, config *conn.ProviderConfig, ruleType string, accessControlGroup *vserver.AccessControlGroup, accessControlGroupRule []*vserver.AddAccessControlGroupRuleParameter) error {
+func addAccessControlGroupRule(ctx context.Context, config *conn.ProviderConfig, ruleType string, accessControlGroup *vserver.AccessControlGroup, accessControlGroupRule []*vserver.AddAccessControlGroupRuleParameter) error {
 	var reqParams interface{}
 	var resp interface{}
-
-	err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		var err error
-
-		var reqParams interface{}
-		if ruleType == "inbound" {
-			reqParams = &vserver.AddAccessControlGroupInboundRuleRequest{
-				RegionCode:                 &config.RegionCode,
-				AccessControlGroupNo:       ncloud.String(d.Id()),
-				VpcNo:                      accessControlGroup.VpcNo,
-				AccessControlGroupRuleList: accessControlGroupRule,
-			}
-
-			LogCommonRequest("AddAccessControlGroupInboundRule", reqParams)
-			resp, err = config.Client.Vserver.V2Api.AddAccessControlGroupInboundRule(reqParams.(*vserver.AddAccessControlGroupInboundRuleRequest))
-		} else {
-			reqParams = &vserver.AddAccessControlGroupOutboundRuleRequest{
-				RegionCode:                 &config.RegionCode,
-				AccessControlGroupNo:       ncloud.String(d.Id()),
-				VpcNo:                      accessControlGroup.VpcNo,
-				AccessControlGroupRuleList: accessControlGroupRule,
-			}
-
-			LogCommonRequest("AddAccessControlGroupOutboundRule", reqParams)
-			resp, err = config.Client.Vserver.V2Api.AddAccessControlGroupOutboundRule(reqParams.(*vserver.AddAccessControlGroupOutboundRuleRequest))
+	var err error
+
+	if ruleType == "inbound" {
+		reqParams = &vserver.AddAccessControlGroupInboundRuleRequest{
+			RegionCode:                 &config.RegionCode,
+			AccessControlGroupNo:       accessControlGroup.AccessControlGroupNo,
+			VpcNo:                      accessControlGroup.VpcNo,
+			AccessControlGroupRuleList: accessControlGroupRule,
 		}
 
-		if err != nil {
-			errBody, _ := GetCommonErrorBody(err)
-			if errBody.ReturnCode == ApiErrorAcgCantChangeSameTime {
-				LogErrorResponse("retry AddAccessControlGroupRule", err, reqParams)
-				time.Sleep(time.Second * 5)
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
+		ctx = common.LogCommonRequest(ctx, "AddAccessControlGroupInboundRule", reqParams)
+		resp, err = config.Client.Vserver.V2Api.AddAccessControlGroupInboundRule(reqParams.(*vserver.AddAccessControlGroupInboundRuleRequest))
+	} else {
+		reqParams = &vserver.AddAccessControlGroupOutboundRuleRequest{
+			RegionCode:                 &config.RegionCode,
+			AccessControlGroupNo:       accessControlGroup.AccessControlGroupNo,
+			VpcNo:                      accessControlGroup.VpcNo,
+			AccessControlGroupRuleList: accessControlGroupRule,
 		}
-		return nil
-	})
+
+		ctx = common.LogCommonRequest(ctx, "AddAccessControlGroupOutboundRule", reqParams)
+		resp, err = config.Client.Vserver.V2Api.AddAccessControlGroupOutboundRule(reqParams.(*vserver.AddAccessControlGroupOutboundRuleRequest))
+	}
 
 	if err != nil {
-		LogErrorResponse("AddAccessControlGroupRule", err, reqParams)
+		common.LogErrorResponse(ctx, "AddAccessControlGroupRule", err, reqParams)
 		return err
 	}
 
-	LogResponse("AddAccessControlGroupRule", resp)
+	common.LogResponse(ctx, "AddAccessControlGroupRule", resp)
 
-	if err = waitForVpcAccessControlGroupRunning(config, d.Id()); err != nil {
+	if err = waitForVpcAccessControlGroupRunning(ctx, config, *accessControlGroup.AccessControlGroupNo); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func removeAccessControlGroupRule(d *schema.ResourceData, config *conn.ProviderConfig, ruleType string, accessControlGroup *vserver.AccessControlGroup, accessControlGroupRule []*vserver.RemoveAccessControlGroupRuleParameter) error {
+func removeAccessControlGroupRule(ctx context.Context, config *conn.ProviderConfig, ruleType string, accessControlGroup *vserver.AccessControlGroup, accessControlGroupRule []*vserver.RemoveAccessControlGroupRuleParameter) error {
 	var reqParams interface{}
 	var resp interface{}
-
-	err := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
-		var err error
-
-		var reqParams interface{}
-		if ruleType == "inbound" {
-			reqParams = &vserver.RemoveAccessControlGroupInboundRuleRequest{
-				RegionCode:                 &config.RegionCode,
-				AccessControlGroupNo:       ncloud.String(d.Id()),
-				VpcNo:                      accessControlGroup.VpcNo,
-				AccessControlGroupRuleList: accessControlGroupRule,
-			}
-
-			LogCommonRequest("RemoveAccessControlGroupInboundRule", reqParams)
-			resp, err = config.Client.Vserver.V2Api.RemoveAccessControlGroupInboundRule(reqParams.(*vserver.RemoveAccessControlGroupInboundRuleRequest))
-		} else {
-			reqParams = &vserver.RemoveAccessControlGroupOutboundRuleRequest{
-				RegionCode:                 &config.RegionCode,
-				AccessControlGroupNo:       ncloud.String(d.Id()),
-				VpcNo:                      accessControlGroup.VpcNo,
-				AccessControlGroupRuleList: accessControlGroupRule,
-			}
-
-			LogCommonRequest("RemoveAccessControlGroupOutboundRule", reqParams)
-			resp, err = config.Client.Vserver.V2Api.RemoveAccessControlGroupOutboundRule(reqParams.(*vserver.RemoveAccessControlGroupOutboundRuleRequest))
+	var err error
+
+	if ruleType == "inbound" {
+		reqParams = &vserver.RemoveAccessControlGroupInboundRuleRequest{
+			RegionCode:                 &config.RegionCode,
+			AccessControlGroupNo:       accessControlGroup.AccessControlGroupNo,
+			VpcNo:                      accessControlGroup.VpcNo,
+			AccessControlGroupRuleList: accessControlGroupRule,
 		}
 
-		if err != nil {
-			errBody, _ := GetCommonErrorBody(err)
-			if errBody.ReturnCode == ApiErrorAcgCantChangeSameTime {
-				LogErrorResponse("retry RemoveAccessControlGroupRule", err, reqParams)
-				time.Sleep(time.Second * 5)
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
+		ctx = common.LogCommonRequest(ctx, "RemoveAccessControlGroupInboundRule", reqParams)
+		resp, err = config.Client.Vserver.V2Api.RemoveAccessControlGroupInboundRule(reqParams.(*vserver.RemoveAccessControlGroupInboundRuleRequest))
+	} else {
+		reqParams = &vserver.RemoveAccessControlGroupOutboundRuleRequest{
+			RegionCode:                 &config.RegionCode,
+			AccessControlGroupNo:       accessControlGroup.AccessControlGroupNo,
+			VpcNo:                      accessControlGroup.VpcNo,
+			AccessControlGroupRuleList: accessControlGroupRule,
 		}
-		return nil
-	})
+
+		ctx = common.LogCommonRequest(ctx, "RemoveAccessControlGroupOutboundRule", reqParams)
+		resp, err = config.Client.Vserver.V2Api.RemoveAccessControlGroupOutboundRule(reqParams.(*vserver.RemoveAccessControlGroupOutboundRuleRequest))
+	}
 
 	if err != nil {
-		LogErrorResponse("RemoveAccessControlGroupRule", err, reqParams)
+		common.LogErrorResponse(ctx, "RemoveAccessControlGroupRule", err, reqParams)
 		return err
 	}
 
-	LogResponse("RemoveAccessControlGroupRule", resp)
+	common.LogResponse(ctx, "RemoveAccessControlGroupRule", resp)
 
-	if err = waitForVpcAccessControlGroupRunning(config, d.Id()); err != nil {
+	if err = waitForVpcAccessControlGroupRunning(ctx, config, *accessControlGroup.AccessControlGroupNo); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func expandAddAccessControlGroupRule(rules []interface{}) ([]*vserver.AddAccessControlGroupRuleParameter, error) {
-	var acgRuleList []*vserver.AddAccessControlGroupRuleParameter
+// differenceAccessControlGroupRule returns the rules of a not in b
+func differenceAccessControlGroupRule(a, b []accessControlGroupRuleModel) []accessControlGroupRuleModel {
+	var diff []accessControlGroupRuleModel
+
+	for _, ra := range a {
+		found := false
+		for _, rb := range b {
+			if ra.equal(rb) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			diff = append(diff, ra)
+		}
+	}
+
+	return diff
+}
 
-	for _, vi := range rules {
-		m := vi.(map[string]interface{})
+func expandAddAccessControlGroupRule(rules []accessControlGroupRuleModel) ([]*vserver.AddAccessControlGroupRuleParameter, error) {
+	var acgRuleList []*vserver.AddAccessControlGroupRuleParameter
 
-		if len(m["ip_block"].(string)) == 0 && len(m["source_access_control_group_no"].(string)) == 0 {
+	for _, r := range rules {
+		if len(r.IpBlock.ValueString()) == 0 && len(r.SourceAccessControlGroupNo.ValueString()) == 0 {
 			return nil, fmt.Errorf("one of either `ip_block` or `source_access_control_group_no` is required")
 		}
 
-		if len(m["ip_block"].(string)) > 0 && len(m["source_access_control_group_no"].(string)) > 0 {
+		if len(r.IpBlock.ValueString()) > 0 && len(r.SourceAccessControlGroupNo.ValueString()) > 0 {
 			return nil, fmt.Errorf("cannot be specified with `ip_block` and `source_access_control_group_no`")
 		}
 
 		acgRule := &vserver.AddAccessControlGroupRuleParameter{
-			ProtocolTypeCode:                  ncloud.String(m["protocol"].(string)),
-			PortRange:                         ncloud.String(m["port_range"].(string)),
-			IpBlock:                           ncloud.String(m["ip_block"].(string)),
-			AccessControlGroupSequence:        ncloud.String(m["source_access_control_group_no"].(string)),
-			AccessControlGroupRuleDescription: ncloud.String(m["description"].(string)),
+			ProtocolTypeCode:                  ncloud.String(r.Protocol.ValueString()),
+			PortRange:                         ncloud.String(r.PortRange.ValueString()),
+			IpBlock:                           ncloud.String(r.IpBlock.ValueString()),
+			AccessControlGroupSequence:        ncloud.String(r.SourceAccessControlGroupNo.ValueString()),
+			AccessControlGroupRuleDescription: ncloud.String(r.Description.ValueString()),
 		}
 
 		acgRuleList = append(acgRuleList, acgRule)
@@ -476,17 +519,15 @@ func expandAddAccessControlGroupRule(rules []interface{}) ([]*vserver.AddAccessC
 	return acgRuleList, nil
 }
 
-func expandRemoveAccessControlGroupRule(rules []interface{}) []*vserver.RemoveAccessControlGroupRuleParameter {
+func expandRemoveAccessControlGroupRule(rules []accessControlGroupRuleModel) []*vserver.RemoveAccessControlGroupRuleParameter {
 	var acgRuleList []*vserver.RemoveAccessControlGroupRuleParameter
 
-	for _, vi := range rules {
-		m := vi.(map[string]interface{})
-
+	for _, r := range rules {
 		acgRule := &vserver.RemoveAccessControlGroupRuleParameter{
-			IpBlock:                    ncloud.String(m["ip_block"].(string)),
-			AccessControlGroupSequence: ncloud.String(m["source_access_control_group_no"].(string)),
-			ProtocolTypeCode:           ncloud.String(m["protocol"].(string)),
-			PortRange:                  ncloud.String(m["port_range"].(string)),
+			IpBlock:                    ncloud.String(r.IpBlock.ValueString()),
+			AccessControlGroupSequence: ncloud.String(r.SourceAccessControlGroupNo.ValueString()),
+			ProtocolTypeCode:           ncloud.String(r.Protocol.ValueString()),
+			PortRange:                  ncloud.String(r.PortRange.ValueString()),
 		}
 
 		acgRuleList = append(acgRuleList, acgRule)
@@ -522,3 +563,75 @@ var allowedProtocolCodes = map[string]bool{
 	"UDP":  true,
 	"ICMP": true,
 }
+
+type accessControlGroupRuleResourceModel struct {
+	ID                   types.String   `tfsdk:"id"`
+	AccessControlGroupNo types.String   `tfsdk:"access_control_group_no"`
+	Inbound              types.Set      `tfsdk:"inbound"`
+	Outbound             types.Set      `tfsdk:"outbound"`
+	Timeouts             timeouts.Value `tfsdk:"timeouts"`
+}
+
+type accessControlGroupRuleModel struct {
+	Protocol                   types.String `tfsdk:"protocol"`
+	PortRange                  types.String `tfsdk:"port_range"`
+	IpBlock                    types.String `tfsdk:"ip_block"`
+	SourceAccessControlGroupNo types.String `tfsdk:"source_access_control_group_no"`
+	Description                types.String `tfsdk:"description"`
+}
+
+var accessControlGroupRuleAttrTypes = map[string]attr.Type{
+	"protocol":                       types.StringType,
+	"port_range":                     types.StringType,
+	"ip_block":                       types.StringType,
+	"source_access_control_group_no": types.StringType,
+	"description":                    types.StringType,
+}
+
+func (r accessControlGroupRuleModel) equal(o accessControlGroupRuleModel) bool {
+	return r.Protocol.Equal(o.Protocol) &&
+		r.PortRange.Equal(o.PortRange) &&
+		r.IpBlock.Equal(o.IpBlock) &&
+		r.SourceAccessControlGroupNo.Equal(o.SourceAccessControlGroupNo) &&
+		r.Description.Equal(o.Description)
+}
+
+func (m *accessControlGroupRuleResourceModel) refreshFromOutput(ctx context.Context, rules []*vserver.AccessControlGroupRule) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.AccessControlGroupNo = m.ID
+
+	inbound := []accessControlGroupRuleModel{}
+	outbound := []accessControlGroupRuleModel{}
+
+	for _, r := range rules {
+		var protocol string
+		if allowedProtocolCodes[*r.ProtocolType.Code] {
+			protocol = *r.ProtocolType.Code
+		} else {
+			protocol = strconv.Itoa(int(*r.ProtocolType.Number))
+		}
+
+		rule := accessControlGroupRuleModel{
+			Protocol:                   types.StringValue(protocol),
+			PortRange:                  types.StringPointerValue(r.PortRange),
+			IpBlock:                    types.StringPointerValue(r.IpBlock),
+			SourceAccessControlGroupNo: types.StringPointerValue(r.AccessControlGroupSequence),
+			Description:                types.StringPointerValue(r.AccessControlGroupRuleDescription),
+		}
+
+		if *r.AccessControlGroupRuleType.Code == "INBND" {
+			inbound = append(inbound, rule)
+		} else {
+			outbound = append(outbound, rule)
+		}
+	}
+
+	var d diag.Diagnostics
+	m.Inbound, d = types.SetValueFrom(ctx, types.ObjectType{AttrTypes: accessControlGroupRuleAttrTypes}, inbound)
+	diags.Append(d...)
+	m.Outbound, d = types.SetValueFrom(ctx, types.ObjectType{AttrTypes: accessControlGroupRuleAttrTypes}, outbound)
+	diags.Append(d...)
+
+	return diags
+}
@@ -1,6 +1,7 @@
 package server_test
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"regexp"
@@ -8,7 +9,7 @@ import (
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 
@@ -19,7 +20,7 @@ import (
 
 func TestAccResourceNcloudAccessControlGroupRule_basic(t *testing.T) {
 	var AccessControlGroupRule []*vserver.AccessControlGroupRule
-	name := fmt.Sprintf("tf-acg-rule-basic-%s", acctest.RandString(5))
+	name := fmt.Sprintf("tf-acg-rule-basic-%s", RandString(t, 5))
 	resourceName := "ncloud_access_control_group_rule.acg_rule_foo"
 
 	resource.Test(t, resource.TestCase{
@@ -42,7 +43,7 @@ func TestAccResourceNcloudAccessControlGroupRule_basic(t *testing.T) {
 
 func TestAccResourceNcloudAccessControlGroupRule_disappears(t *testing.T) {
 	var AccessControlGroupRule []*vserver.AccessControlGroupRule
-	name := fmt.Sprintf("tf-nic-disappear-%s", acctest.RandString(5))
+	name := fmt.Sprintf("tf-nic-disappear-%s", RandString(t, 5))
 	resourceName := "ncloud_access_control_group_rule.test"
 
 	resource.Test(t, resource.TestCase{
@@ -62,6 +63,47 @@ func TestAccResourceNcloudAccessControlGroupRule_disappears(t *testing.T) {
 	})
 }
 
+// TestResourceNcloudAccessControlGroupRule_upgradeFromSDKv2 upgrades the state written by the SDKv2 implementation of the resource
+func TestResourceNcloudAccessControlGroupRule_upgradeFromSDKv2(t *testing.T) {
+	rawState := `{"access_control_group_no":"12345","id":"12345","inbound":[{"description":"","ip_block":"","port_range":"","protocol":"ICMP","source_access_control_group_no":"23456"},{"description":"ssh","ip_block":"0.0.0.0/0","port_range":"22","protocol":"TCP","source_access_control_group_no":""}],"outbound":[{"description":"","ip_block":"0.0.0.0/0","port_range":"1-65535","protocol":"TCP","source_access_control_group_no":""}],"timeouts":{"create":null,"delete":null,"update":null}}`
+
+	state := UpgradeResourceState(t, "ncloud_access_control_group_rule", 0, rawState)
+
+	ruleType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"protocol":                       tftypes.String,
+		"port_range":                     tftypes.String,
+		"ip_block":                       tftypes.String,
+		"source_access_control_group_no": tftypes.String,
+		"description":                    tftypes.String,
+	}}
+	rule := func(protocol, portRange, ipBlock, source, description string) tftypes.Value {
+		return tftypes.NewValue(ruleType, map[string]tftypes.Value{
+			"protocol":                       tftypes.NewValue(tftypes.String, protocol),
+			"port_range":                     tftypes.NewValue(tftypes.String, portRange),
+			"ip_block":                       tftypes.NewValue(tftypes.String, ipBlock),
+			"source_access_control_group_no": tftypes.NewValue(tftypes.String, source),
+			"description":                    tftypes.NewValue(tftypes.String, description),
+		})
+	}
+
+	expected := map[string]tftypes.Value{
+		"id":                      tftypes.NewValue(tftypes.String, "12345"),
+		"access_control_group_no": tftypes.NewValue(tftypes.String, "12345"),
+		"inbound": tftypes.NewValue(tftypes.Set{ElementType: ruleType}, []tftypes.Value{
+			rule("ICMP", "", "", "23456", ""),
+			rule("TCP", "22", "0.0.0.0/0", "", "ssh"),
+		}),
+		"outbound": tftypes.NewValue(tftypes.Set{ElementType: ruleType}, []tftypes.Value{
+			rule("TCP", "1-65535", "0.0.0.0/0", "", ""),
+		}),
+	}
+	for k, v := range expected {
+		if !state[k].Equal(v) {
+			t.Errorf("upgraded %s: expected %s, got %s", k, v, state[k])
+		}
+	}
+}
+
 func testAccResourceNcloudAccessControlGroupRuleConfig(name string) string {
 	return fmt.Sprintf(`
 resource "ncloud_vpc" "test" {
@@ -177,7 +219,7 @@ func testAccCheckAccessControlGroupRuleExists(n string, AccessControlGroupRule *
 
 		config := GetTestProvider(true).Meta().(*conn.ProviderConfig)
 
-		rules, err := server.GetAccessControlGroupRuleList(config, rs.Primary.ID)
+		rules, err := server.GetAccessControlGroupRuleList(context.Background(), config, rs.Primary.ID)
 		if err != nil {
 			return err
 		}
@@ -200,7 +242,7 @@ func testAccCheckAccessControlGroupRuleDestroy(s *terraform.State) error {
 			continue
 		}
 
-		instance, err := server.GetAccessControlGroup(config, rs.Primary.Attributes["access_control_group_no"])
+		instance, err := server.GetAccessControlGroup(context.Background(), config, rs.Primary.Attributes["access_control_group_no"])
 
 		if err != nil {
 			return err
@@ -224,7 +266,7 @@ func testAccCheckAccessControlGroupRuleDisappears(instance *[]*vserver.AccessCon
 
 		id := (*instance)[0].AccessControlGroupNo
 
-		accessControlGroup, err := server.GetAccessControlGroup(config, *id)
+		accessControlGroup, err := server.GetAccessControlGroup(context.Background(), config, *id)
 		if err != nil {
 			return err
 		}
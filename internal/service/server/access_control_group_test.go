@@ -1,6 +1,7 @@
 package server_test
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"regexp"
@@ -8,7 +9,6 @@ import (
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 
@@ -19,7 +19,7 @@ import (
 
 func TestAccResourceNcloudAccessControlGroup_basic(t *testing.T) {
 	var AccessControlGroup vserver.AccessControlGroup
-	name := fmt.Sprintf("tf-acg-basic-%s", acctest.RandString(5))
+	name := fmt.Sprintf("tf-acg-basic-%s", RandString(t, 5))
 	resourceName := "ncloud_access_control_group.foo"
 
 	resource.Test(t, resource.TestCase{
@@ -50,7 +50,7 @@ func TestAccResourceNcloudAccessControlGroup_basic(t *testing.T) {
 
 func TestAccResourceNcloudAccessControlGroup_disappears(t *testing.T) {
 	var AccessControlGroup vserver.AccessControlGroup
-	name := fmt.Sprintf("tf-nic-disappear-%s", acctest.RandString(5))
+	name := fmt.Sprintf("tf-nic-disappear-%s", RandString(t, 5))
 	resourceName := "ncloud_access_control_group.foo"
 
 	resource.Test(t, resource.TestCase{
@@ -97,7 +97,7 @@ func testAccCheckAccessControlGroupExists(n string, AccessControlGroup *vserver.
 		}
 
 		config := GetTestProvider(true).Meta().(*conn.ProviderConfig)
-		instance, err := server.GetAccessControlGroup(config, rs.Primary.ID)
+		instance, err := server.GetAccessControlGroup(context.Background(), config, rs.Primary.ID)
 		if err != nil {
 			return err
 		}
@@ -116,7 +116,7 @@ func testAccCheckAccessControlGroupDestroy(s *terraform.State) error {
 			continue
 		}
 
-		instance, err := server.GetAccessControlGroup(config, rs.Primary.ID)
+		instance, err := server.GetAccessControlGroup(context.Background(), config, rs.Primary.ID)
 
 		if err != nil {
 			return err
@@ -133,6 +133,6 @@ func testAccCheckAccessControlGroupDestroy(s *terraform.State) error {
 func testAccCheckAccessControlGroupDisappears(instance *vserver.AccessControlGroup) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		config := GetTestProvider(true).Meta().(*conn.ProviderConfig)
-		return server.DeleteAccessControlGroup(config, *instance.AccessControlGroupNo)
+		return server.DeleteAccessControlGroup(context.Background(), config, *instance.AccessControlGroupNo)
 	}
 }
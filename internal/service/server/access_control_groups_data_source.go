@@ -1,8 +1,10 @@
 package server
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/common"
@@ -11,7 +13,7 @@ import (
 
 func DataSourceNcloudAccessControlGroups() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNcloudAccessControlGroupsRead,
+		ReadContext: dataSourceNcloudAccessControlGroupsRead,
 
 		Schema: map[string]*schema.Schema{
 			"configuration_no_list": {
@@ -52,19 +54,19 @@ func DataSourceNcloudAccessControlGroups() *schema.Resource {
 	}
 }
 
-func dataSourceNcloudAccessControlGroupsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceNcloudAccessControlGroupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*conn.ProviderConfig)
 	var resources []map[string]interface{}
 	var err error
 
 	if config.SupportVPC {
-		resources, err = getVpcAccessControlGroupList(d, config)
+		resources, err = getVpcAccessControlGroupList(ctx, d, config)
 	} else {
-		resources, err = getClassicAccessControlGroupList(d, config)
+		resources, err = getClassicAccessControlGroupList(ctx, d, config)
 	}
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if f, ok := d.GetOk("filter"); ok {
@@ -72,10 +74,10 @@ func dataSourceNcloudAccessControlGroupsRead(d *schema.ResourceData, meta interf
 	}
 
 	if len(resources) < 1 {
-		return fmt.Errorf("no results. please change search criteria and try again")
+		return diag.FromErr(fmt.Errorf("no results. please change search criteria and try again"))
 	}
 
-	return accessControlGroupsAttributes(d, resources)
+	return diag.FromErr(accessControlGroupsAttributes(d, resources))
 }
 
 func accessControlGroupsAttributes(d *schema.ResourceData, accessControlGroups []map[string]interface{}) error {
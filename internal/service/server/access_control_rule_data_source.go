@@ -1,11 +1,13 @@
 package server
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -16,7 +18,7 @@ import (
 
 func DataSourceNcloudAccessControlRule() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNcloudAccessControlRuleRead,
+		ReadContext: dataSourceNcloudAccessControlRuleRead,
 
 		Schema: map[string]*schema.Schema{
 			"access_control_group_configuration_no": {
@@ -83,12 +85,12 @@ func DataSourceNcloudAccessControlRule() *schema.Resource {
 	}
 }
 
-func dataSourceNcloudAccessControlRuleRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceNcloudAccessControlRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*conn.ProviderConfig).Client
 	config := meta.(*conn.ProviderConfig)
 
 	if config.SupportVPC {
-		return NotSupportVpc("data source `ncloud_access_control_rule`")
+		return diag.FromErr(NotSupportVpc("data source `ncloud_access_control_rule`"))
 	}
 
 	var allAccessControlRuleList []*server.AccessControlRule
@@ -97,7 +99,7 @@ func dataSourceNcloudAccessControlRuleRead(d *schema.ResourceData, meta interfac
 	isDefaultGroup, isDefaultGroupOk := d.GetOk("is_default_group")
 
 	if !configNoOk && !acgNameOk && !isDefaultGroupOk {
-		return fmt.Errorf("either `access_control_group_configuration_no` or `access_control_group_name` or `is_default_group` must be defined")
+		return diag.FromErr(fmt.Errorf("either `access_control_group_configuration_no` or `access_control_group_name` or `is_default_group` must be defined"))
 	}
 
 	if !configNoOk {
@@ -108,22 +110,22 @@ func dataSourceNcloudAccessControlRuleRead(d *schema.ResourceData, meta interfac
 		if isDefaultGroupOk {
 			reqParams.IsDefault = ncloud.Bool(isDefaultGroup.(bool))
 		}
-		acgResp, err := getClassicAccessControlGroupList(d, meta.(*conn.ProviderConfig))
+		acgResp, err := getClassicAccessControlGroupList(ctx, d, meta.(*conn.ProviderConfig))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		for _, acg := range acgResp {
-			resp, err := getAccessControlRuleList(client, acg["access_control_group_no"].(string))
+			resp, err := getAccessControlRuleList(ctx, client, acg["access_control_group_no"].(string))
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 			allAccessControlRuleList = append(allAccessControlRuleList, resp.AccessControlRuleList...)
 		}
 	} else {
 		groupConfigNo := configNo.(string)
-		resp, err := getAccessControlRuleList(client, groupConfigNo)
+		resp, err := getAccessControlRuleList(ctx, client, groupConfigNo)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		allAccessControlRuleList = append(allAccessControlRuleList, resp.AccessControlRuleList...)
 	}
@@ -158,24 +160,24 @@ func dataSourceNcloudAccessControlRuleRead(d *schema.ResourceData, meta interfac
 	}
 
 	if err := verify.ValidateOneResult(len(filteredAccessControlRuleList)); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	accessControlRule = filteredAccessControlRuleList[0]
-	return accessControlRuleAttributes(d, accessControlRule)
+	return diag.FromErr(accessControlRuleAttributes(d, accessControlRule))
 }
 
-func getAccessControlRuleList(client *conn.NcloudAPIClient, groupConfigNo string) (*server.GetAccessControlRuleListResponse, error) {
+func getAccessControlRuleList(ctx context.Context, client *conn.NcloudAPIClient, groupConfigNo string) (*server.GetAccessControlRuleListResponse, error) {
 	reqParams := server.GetAccessControlRuleListRequest{
 		AccessControlGroupConfigurationNo: ncloud.String(groupConfigNo),
 	}
 
-	LogCommonRequest("GetAccessControlRuleList", reqParams)
+	ctx = LogCommonRequest(ctx, "GetAccessControlRuleList", reqParams)
 	resp, err := client.Server.V2Api.GetAccessControlRuleList(&reqParams)
 	if err != nil {
-		LogErrorResponse("GetAccessControlRuleList", err, groupConfigNo)
+		LogErrorResponse(ctx, "GetAccessControlRuleList", err, groupConfigNo)
 		return nil, err
 	}
-	LogCommonResponse("GetAccessControlRuleList", GetCommonResponse(resp))
+	LogCommonResponse(ctx, "GetAccessControlRuleList", GetCommonResponse(resp))
 	return resp, nil
 }
 
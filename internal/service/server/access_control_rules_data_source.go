@@ -1,12 +1,14 @@
 package server
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"time"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -16,7 +18,7 @@ import (
 
 func DataSourceNcloudAccessControlRules() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNcloudAccessControlRulesRead,
+		ReadContext: dataSourceNcloudAccessControlRulesRead,
 
 		Schema: map[string]*schema.Schema{
 			"access_control_group_configuration_no": {
@@ -45,12 +47,12 @@ func DataSourceNcloudAccessControlRules() *schema.Resource {
 	}
 }
 
-func dataSourceNcloudAccessControlRulesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceNcloudAccessControlRulesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*conn.ProviderConfig).Client
 	config := meta.(*conn.ProviderConfig)
 
 	if config.SupportVPC {
-		return NotSupportVpc("data source `ncloud_access_control_rules`")
+		return diag.FromErr(NotSupportVpc("data source `ncloud_access_control_rules`"))
 	}
 
 	d.SetId(time.Now().UTC().String())
@@ -58,14 +60,14 @@ func dataSourceNcloudAccessControlRulesRead(d *schema.ResourceData, meta interfa
 	id := d.Get("access_control_group_configuration_no").(string)
 	reqParams := server.GetAccessControlRuleListRequest{AccessControlGroupConfigurationNo: ncloud.String(id)}
 
-	LogCommonRequest("GetAccessControlRuleList", reqParams)
+	ctx = LogCommonRequest(ctx, "GetAccessControlRuleList", reqParams)
 
 	resp, err := client.Server.V2Api.GetAccessControlRuleList(&reqParams)
 	if err != nil {
-		LogErrorResponse("GetAccessControlRuleList", err, id)
-		return err
+		LogErrorResponse(ctx, "GetAccessControlRuleList", err, id)
+		return diag.FromErr(err)
 	}
-	LogCommonResponse("GetAccessControlRuleList", GetCommonResponse(resp))
+	LogCommonResponse(ctx, "GetAccessControlRuleList", GetCommonResponse(resp))
 
 	allAccessControlRuleList := resp.AccessControlRuleList
 	var filteredAccessControlRuleList []*server.AccessControlRule
@@ -82,10 +84,10 @@ func dataSourceNcloudAccessControlRulesRead(d *schema.ResourceData, meta interfa
 	}
 
 	if len(filteredAccessControlRuleList) < 1 {
-		return fmt.Errorf("no results. please change search criteria and try again")
+		return diag.FromErr(fmt.Errorf("no results. please change search criteria and try again"))
 	}
 
-	return accessControlRulesAttributes(d, filteredAccessControlRuleList)
+	return diag.FromErr(accessControlRulesAttributes(d, filteredAccessControlRuleList))
 }
 
 func accessControlRulesAttributes(d *schema.ResourceData, accessControlRules []*server.AccessControlRule) error {
@@ -1,6 +1,7 @@
 package server
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"time"
@@ -11,13 +12,14 @@ import (
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/waiter"
 )
 
 const (
@@ -40,12 +42,13 @@ const (
 
 func ResourceNcloudBlockStorage() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNcloudBlockStorageCreate,
-		Read:   resourceNcloudBlockStorageRead,
-		Update: resourceNcloudBlockStorageUpdate,
-		Delete: resourceNcloudBlockStorageDelete,
+		CreateContext: resourceNcloudBlockStorageCreate,
+		ReadContext:   resourceNcloudBlockStorageRead,
+		UpdateContext: resourceNcloudBlockStorageUpdate,
+		DeleteContext: resourceNcloudBlockStorageDelete,
+		CustomizeDiff: resourceNcloudBlockStorageCustomizeDiff,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -57,6 +60,7 @@ func ResourceNcloudBlockStorage() *schema.Resource {
 			"server_instance_no": {
 				Type:     schema.TypeString,
 				Optional: true,
+				Computed: true,
 			},
 			"size": {
 				Type:             schema.TypeInt,
@@ -165,30 +169,31 @@ func ResourceNcloudBlockStorage() *schema.Resource {
 	}
 }
 
-func resourceNcloudBlockStorageCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudBlockStorageCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*conn.ProviderConfig)
 
-	if len(d.Get("server_instance_no").(string)) == 0 {
-		return fmt.Errorf("'server_instance_no' has to be present when ncloud_block_storage is first created.")
+	// Only KVM block storages of VPC can be created without being attached, e.g. to be attached by ncloud_block_storage_attachment
+	if len(d.Get("server_instance_no").(string)) == 0 && !(config.SupportVPC && d.Get("hypervisor_type").(string) == BlockStorageHypervisorTypeKvm) {
+		return diag.FromErr(fmt.Errorf("'server_instance_no' has to be present when ncloud_block_storage is first created, unless `hypervisor_type` is `%s` in VPC environments.", BlockStorageHypervisorTypeKvm))
 	}
 
-	id, err := createBlockStorage(d, config)
+	id, err := createBlockStorage(ctx, d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(ncloud.StringValue(id))
 	log.Printf("[INFO] Block Storage ID: %s", d.Id())
 
-	return resourceNcloudBlockStorageRead(d, meta)
+	return resourceNcloudBlockStorageRead(ctx, d, meta)
 }
 
-func resourceNcloudBlockStorageRead(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudBlockStorageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*conn.ProviderConfig)
 
-	r, err := GetBlockStorage(config, d.Id())
+	r, err := GetBlockStorage(ctx, config, d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if r == nil {
@@ -201,31 +206,31 @@ func resourceNcloudBlockStorageRead(d *schema.ResourceData, meta interface{}) er
 	SetSingularResourceDataFromMapSchema(ResourceNcloudBlockStorage(), d, instance)
 
 	if err := d.Set("server_instance_no", r.ServerInstanceNo); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
 }
 
-func resourceNcloudBlockStorageDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudBlockStorageDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*conn.ProviderConfig)
 
-	if d.Get("stop_instance_before_detaching").(bool) {
+	if d.Get("stop_instance_before_detaching").(bool) && len(d.Get("server_instance_no").(string)) > 0 {
 		log.Printf("[INFO] Stopping Instance %s for destroying block storage", d.Get("server_instance_no").(string))
-		if err := stopThenWaitServerInstance(config, d.Get("server_instance_no").(string)); err != nil {
-			return err
+		if err := stopThenWaitServerInstance(ctx, config, d.Get("server_instance_no").(string)); err != nil {
+			return diag.FromErr(err)
 		}
 	}
 
-	if err := deleteBlockStorage(d, config, d.Id()); err != nil {
-		return err
+	if err := deleteBlockStorage(ctx, d, config, d.Id()); err != nil {
+		return diag.FromErr(err)
 	}
 
 	d.SetId("")
 	return nil
 }
 
-func resourceNcloudBlockStorageUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudBlockStorageUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*conn.ProviderConfig)
 
 	if d.HasChange("server_instance_no") {
@@ -235,84 +240,124 @@ func resourceNcloudBlockStorageUpdate(d *schema.ResourceData, meta interface{})
 		if len(o.(string)) > 0 {
 			if d.Get("stop_instance_before_detaching").(bool) {
 				log.Printf("[INFO] Start Instance %s after detaching block storage", o.(string))
-				if err := stopThenWaitServerInstance(config, o.(string)); err != nil {
-					return err
+				if err := stopThenWaitServerInstance(ctx, config, o.(string)); err != nil {
+					return diag.FromErr(err)
 				}
 			}
 
-			if err := detachBlockStorage(config, d.Id()); err != nil {
-				return err
+			if err := detachBlockStorage(ctx, config, d.Id()); err != nil {
+				return diag.FromErr(err)
 			}
 
-			if err := detachThenWaitServerInstance(config, o.(string)); err != nil {
-				return err
+			if err := detachThenWaitServerInstance(ctx, config, o.(string)); err != nil {
+				return diag.FromErr(err)
 			}
 		}
 
 		if len(n.(string)) > 0 {
-			if err := attachBlockStorage(d, config); err != nil {
-				return err
+			if err := attachBlockStorage(ctx, d, config); err != nil {
+				return diag.FromErr(err)
 			}
 		}
 	}
 
 	if d.HasChange("size") {
-		o, n := d.GetChange("size")
+		serverInstanceNo := d.Get("server_instance_no").(string)
 
-		if o.(int) >= n.(int) {
-			return fmt.Errorf("The storage size is only expandable, not shrinking. new size(%d) must be greater than the existing size(%d)", n, o)
-		}
+		// Block storages which can't be expanded online are detached from their server during the resize
+		detach := len(serverInstanceNo) > 0 && !isBlockStorageResizableOnline(config, d.Get("hypervisor_type").(string))
 
-		// If server instance attached block storage, detach first
-		if len(d.Get("server_instance_no").(string)) > 0 {
-			if d.Get("stop_instance_before_detaching").(bool) {
-				log.Printf("[INFO] Start Instance %s after detaching block storage", d.Get("server_instance_no").(string))
-				if err := stopThenWaitServerInstance(config, d.Get("server_instance_no").(string)); err != nil {
-					return err
+		// stop_instance_before_detaching is required by the plan for the detaching.
+		// A server stopped before, e.g. by its desired_status, stays stopped after the resize.
+		restart := false
+		if detach {
+			serverInstance, err := GetServerInstance(ctx, config, serverInstanceNo)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			if serverInstance != nil && ncloud.StringValue(serverInstance.ServerInstanceStatus) != "NSTOP" {
+				log.Printf("[INFO] Stopping Instance %s for resizing block storage", serverInstanceNo)
+				if err := stopThenWaitServerInstance(ctx, config, serverInstanceNo); err != nil {
+					return diag.FromErr(err)
 				}
+				restart = true
 			}
 
-			if err := detachBlockStorage(config, d.Id()); err != nil {
-				return err
+			if err := detachBlockStorage(ctx, config, d.Id()); err != nil {
+				return diag.FromErr(err)
 			}
 
-			if err := detachThenWaitServerInstance(config, d.Get("server_instance_no").(string)); err != nil {
-				return err
+			if err := detachThenWaitServerInstance(ctx, config, serverInstanceNo); err != nil {
+				return diag.FromErr(err)
 			}
 		}
 
-		if err := changeBlockStorageSize(d, config); err != nil {
-			return err
+		if err := changeBlockStorageSize(ctx, d, config); err != nil {
+			return diag.FromErr(err)
+		}
+
+		if detach {
+			if err := attachBlockStorage(ctx, d, config); err != nil {
+				return diag.FromErr(err)
+			}
 		}
 
-		if len(d.Get("server_instance_no").(string)) > 0 {
-			if err := attachBlockStorage(d, config); err != nil {
-				return err
+		if restart {
+			log.Printf("[INFO] Starting Instance %s after resizing block storage", serverInstanceNo)
+			if err := startThenWaitServerInstance(ctx, config, serverInstanceNo); err != nil {
+				return diag.FromErr(err)
 			}
 		}
 	}
 
 	if d.HasChange("return_protection") {
 		if !config.SupportVPC {
-			return fmt.Errorf("`return_protection` only available in VPC environments")
+			return diag.FromErr(fmt.Errorf("`return_protection` only available in VPC environments"))
 		}
 
-		if err := changeVpcBlockStorageReturnProtection(d, config); err != nil {
-			return err
+		if err := changeVpcBlockStorageReturnProtection(ctx, d, config); err != nil {
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceNcloudBlockStorageRead(d, meta)
+	return resourceNcloudBlockStorageRead(ctx, d, meta)
+}
+
+func resourceNcloudBlockStorageCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	config := meta.(*conn.ProviderConfig)
+
+	if d.Id() == "" || !d.HasChange("size") || !d.NewValueKnown("size") {
+		return nil
+	}
+
+	o, n := d.GetChange("size")
+	if n.(int) < o.(int) {
+		return fmt.Errorf("The storage size is only expandable, not shrinking. new size(%d) must be greater than the existing size(%d)", n, o)
+	}
+
+	serverInstanceNo := d.Get("server_instance_no").(string)
+	if len(serverInstanceNo) > 0 && !isBlockStorageResizableOnline(config, d.Get("hypervisor_type").(string)) && !d.Get("stop_instance_before_detaching").(bool) {
+		return fmt.Errorf("block storage %s is detached from server instance %s to be resized, which requires `stop_instance_before_detaching` to stop the server instance before", d.Id(), serverInstanceNo)
+	}
+
+	return nil
+}
+
+// isBlockStorageResizableOnline tells whether a block storage is expanded while attached to its server, which is
+// supported by the KVM block storages of VPC only
+func isBlockStorageResizableOnline(config *conn.ProviderConfig, hypervisorType string) bool {
+	return config.SupportVPC && hypervisorType == BlockStorageHypervisorTypeKvm
 }
 
-func createBlockStorage(d *schema.ResourceData, config *conn.ProviderConfig) (*string, error) {
+func createBlockStorage(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) (*string, error) {
 	var id *string
 	var err error
 
 	if config.SupportVPC {
-		id, err = createVpcBlockStorage(d, config)
+		id, err = createVpcBlockStorage(ctx, d, config)
 	} else {
-		id, err = createClassicBlockStorage(d, config)
+		id, err = createClassicBlockStorage(ctx, d, config)
 	}
 
 	if err != nil {
@@ -322,7 +367,7 @@ func createBlockStorage(d *schema.ResourceData, config *conn.ProviderConfig) (*s
 	return id, nil
 }
 
-func createClassicBlockStorage(d *schema.ResourceData, config *conn.ProviderConfig) (*string, error) {
+func createClassicBlockStorage(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) (*string, error) {
 	reqParams := &server.CreateBlockStorageInstanceRequest{
 		ServerInstanceNo:        ncloud.String(d.Get("server_instance_no").(string)),
 		BlockStorageSize:        ncloud.Int64(int64(d.Get("size").(int))),
@@ -331,24 +376,24 @@ func createClassicBlockStorage(d *schema.ResourceData, config *conn.ProviderConf
 		DiskDetailTypeCode:      StringPtrOrNil(d.GetOk("disk_detail_type")),
 	}
 
-	LogCommonRequest("createClassicBlockStorage", reqParams)
+	ctx = LogCommonRequest(ctx, "createClassicBlockStorage", reqParams)
 
 	resp, err := config.Client.Server.V2Api.CreateBlockStorageInstance(reqParams)
 	if err != nil {
-		LogErrorResponse("createClassicBlockStorage", err, reqParams)
+		LogErrorResponse(ctx, "createClassicBlockStorage", err, reqParams)
 		return nil, err
 	}
-	LogResponse("createClassicBlockStorage", resp)
+	LogResponse(ctx, "createClassicBlockStorage", resp)
 
 	instance := resp.BlockStorageInstanceList[0]
-	if err := waitForBlockStorageAttachment(config, *instance.BlockStorageInstanceNo); err != nil {
+	if err := waitForBlockStorageAttachment(ctx, config, *instance.BlockStorageInstanceNo, d.Timeout(schema.TimeoutCreate)); err != nil {
 		return nil, err
 	}
 
 	return instance.BlockStorageInstanceNo, nil
 }
 
-func createVpcBlockStorage(d *schema.ResourceData, config *conn.ProviderConfig) (*string, error) {
+func createVpcBlockStorage(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) (*string, error) {
 	reqParams := &vserver.CreateBlockStorageInstanceRequest{
 		RegionCode:                     &config.RegionCode,
 		BlockStorageSize:               ncloud.Int32(int32(d.Get("size").(int))),
@@ -371,13 +416,13 @@ func createVpcBlockStorage(d *schema.ResourceData, config *conn.ProviderConfig)
 
 	if (hypervisorType == BlockStorageHypervisorTypeXen) && ((volumeType == BlockStorageVolumeTypeFb1) || (volumeType == BlockStorageVolumeTypeCb1)) {
 		err := fmt.Errorf("Only `%s` and `%s` can be entered as `%s` hypervisor type", BlockStorageVolumeTypeSsd, BlockStorageVolumeTypeHdd, BlockStorageHypervisorTypeXen)
-		LogErrorResponse("createVpcBlockStorage", err, reqParams)
+		LogErrorResponse(ctx, "createVpcBlockStorage", err, reqParams)
 		return nil, err
 	}
 
 	if (hypervisorType == BlockStorageHypervisorTypeKvm) && ((volumeType == BlockStorageVolumeTypeHdd) || (volumeType == BlockStorageVolumeTypeSsd)) {
 		err := fmt.Errorf("Only `%s` and `%s` can be entered as `%s` hypervisor type", BlockStorageVolumeTypeCb1, BlockStorageVolumeTypeFb1, BlockStorageHypervisorTypeKvm)
-		LogErrorResponse("createVpcBlockStorage", err, reqParams)
+		LogErrorResponse(ctx, "createVpcBlockStorage", err, reqParams)
 		return nil, err
 	}
 
@@ -385,51 +430,54 @@ func createVpcBlockStorage(d *schema.ResourceData, config *conn.ProviderConfig)
 		zone := d.Get("zone").(string)
 		if len(zone) == 0 {
 			err := fmt.Errorf("`zone` is required for KVM type")
-			LogErrorResponse("createVpcBlockStorage", err, reqParams)
+			LogErrorResponse(ctx, "createVpcBlockStorage", err, reqParams)
 			return nil, err
 		}
+	}
 
-		server, err := GetServerInstance(config, d.Get("server_instance_no").(string))
+	if hypervisorType == BlockStorageHypervisorTypeKvm && len(d.Get("server_instance_no").(string)) > 0 {
+		zone := d.Get("zone").(string)
+		server, err := GetServerInstance(ctx, config, d.Get("server_instance_no").(string))
 		if err == nil && server == nil {
 			err = fmt.Errorf("fail to get serverInstance")
 		}
 		if err != nil {
-			LogErrorResponse("createVpcBlockStorage", err, reqParams)
+			LogErrorResponse(ctx, "createVpcBlockStorage", err, reqParams)
 			return nil, err
 		}
 
 		if *server.Zone != zone {
 			err := fmt.Errorf("Different from the server's zone code %s", *server.Zone)
-			LogErrorResponse("createVpcBlockStorage", err, reqParams)
+			LogErrorResponse(ctx, "createVpcBlockStorage", err, reqParams)
 			return nil, err
 		}
 	}
 
-	LogCommonRequest("createVpcBlockStorage", reqParams)
+	ctx = LogCommonRequest(ctx, "createVpcBlockStorage", reqParams)
 
 	resp, err := config.Client.Vserver.V2Api.CreateBlockStorageInstance(reqParams)
 	if err != nil {
-		LogErrorResponse("createVpcBlockStorage", err, reqParams)
+		LogErrorResponse(ctx, "createVpcBlockStorage", err, reqParams)
 		return nil, err
 	}
-	LogResponse("createVpcBlockStorage", resp)
+	LogResponse(ctx, "createVpcBlockStorage", resp)
 
 	if resp == nil || len(resp.BlockStorageInstanceList) < 1 {
 		err := fmt.Errorf("response invalid")
-		LogErrorResponse("createVpcBlockStorage", err, reqParams)
+		LogErrorResponse(ctx, "createVpcBlockStorage", err, reqParams)
 		return nil, err
 	}
 
 	instance := resp.BlockStorageInstanceList[0]
-	output, err := waitForBlockStorageCreation(config, *instance.BlockStorageInstanceNo)
+	output, err := waitForBlockStorageCreation(ctx, config, *instance.BlockStorageInstanceNo)
 	if err != nil {
-		LogErrorResponse("createVpcBlockStorage", err, reqParams)
+		LogErrorResponse(ctx, "createVpcBlockStorage", err, reqParams)
 		return nil, err
 	}
 
-	if *output.StatusName == BlockStorageStatusNameDetach {
+	if *output.StatusName == BlockStorageStatusNameDetach && len(d.Get("server_instance_no").(string)) > 0 {
 		d.SetId(*instance.BlockStorageInstanceNo)
-		if err := attachBlockStorage(d, config); err != nil {
+		if err := attachBlockStorage(ctx, d, config); err != nil {
 			return nil, err
 		}
 	}
@@ -437,27 +485,27 @@ func createVpcBlockStorage(d *schema.ResourceData, config *conn.ProviderConfig)
 	return instance.BlockStorageInstanceNo, nil
 }
 
-func GetBlockStorage(config *conn.ProviderConfig, id string) (*BlockStorage, error) {
+func GetBlockStorage(ctx context.Context, config *conn.ProviderConfig, id string) (*BlockStorage, error) {
 	if config.SupportVPC {
-		return getVpcBlockStorage(config, id)
+		return getVpcBlockStorage(ctx, config, id)
 	}
 
-	return getClassicBlockStorage(config, id)
+	return getClassicBlockStorage(ctx, config, id)
 }
 
-func getClassicBlockStorage(config *conn.ProviderConfig, id string) (*BlockStorage, error) {
+func getClassicBlockStorage(ctx context.Context, config *conn.ProviderConfig, id string) (*BlockStorage, error) {
 	reqParams := &server.GetBlockStorageInstanceListRequest{
 		BlockStorageInstanceNoList: ncloud.StringList([]string{id}),
 	}
 
-	LogCommonRequest("getClassicBlockStorage", reqParams)
+	ctx = LogCommonRequest(ctx, "getClassicBlockStorage", reqParams)
 
 	resp, err := config.Client.Server.V2Api.GetBlockStorageInstanceList(reqParams)
 	if err != nil {
-		LogErrorResponse("getClassicBlockStorage", err, reqParams)
+		LogErrorResponse(ctx, "getClassicBlockStorage", err, reqParams)
 		return nil, err
 	}
-	LogResponse("getClassicBlockStorage", resp)
+	LogResponse(ctx, "getClassicBlockStorage", resp)
 
 	if len(resp.BlockStorageInstanceList) > 0 {
 		inst := resp.BlockStorageInstanceList[0]
@@ -482,20 +530,20 @@ func getClassicBlockStorage(config *conn.ProviderConfig, id string) (*BlockStora
 	return nil, nil
 }
 
-func getVpcBlockStorage(config *conn.ProviderConfig, id string) (*BlockStorage, error) {
+func getVpcBlockStorage(ctx context.Context, config *conn.ProviderConfig, id string) (*BlockStorage, error) {
 	reqParams := &vserver.GetBlockStorageInstanceDetailRequest{
 		RegionCode:             &config.RegionCode,
 		BlockStorageInstanceNo: ncloud.String(id),
 	}
 
-	LogCommonRequest("getVpcBlockStorage", reqParams)
+	ctx = LogCommonRequest(ctx, "getVpcBlockStorage", reqParams)
 
 	resp, err := config.Client.Vserver.V2Api.GetBlockStorageInstanceDetail(reqParams)
 	if err != nil {
-		LogErrorResponse("getVpcBlockStorage", err, reqParams)
+		LogErrorResponse(ctx, "getVpcBlockStorage", err, reqParams)
 		return nil, err
 	}
-	LogResponse("getVpcBlockStorage", resp)
+	LogResponse(ctx, "getVpcBlockStorage", resp)
 
 	if len(resp.BlockStorageInstanceList) > 0 {
 		inst := resp.BlockStorageInstanceList[0]
@@ -528,25 +576,25 @@ func getVpcBlockStorage(config *conn.ProviderConfig, id string) (*BlockStorage,
 	return nil, nil
 }
 
-func deleteBlockStorage(d *schema.ResourceData, config *conn.ProviderConfig, id string) error {
+func deleteBlockStorage(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig, id string) error {
 
 	var err error
 
 	if config.SupportVPC {
-		err = deleteVpcBlockStorage(d, config, id)
+		err = deleteVpcBlockStorage(ctx, d, config, id)
 	} else {
-		err = deleteClassicBlockStorage(d, config, id)
+		err = deleteClassicBlockStorage(ctx, d, config, id)
 	}
 
 	if err != nil {
 		return err
 	}
 
-	stateConf := &retry.StateChangeConf{
+	stateConf := &waiter.Config[any]{
 		Pending: []string{BlockStorageStatusCodeCreate, BlockStorageStatusCodeInit, BlockStorageStatusCodeAttach},
 		Target:  []string{"TERMINATED"},
-		Refresh: func() (interface{}, string, error) {
-			instance, err := GetBlockStorage(config, id)
+		Refresh: func(ctx context.Context) (any, string, error) {
+			instance, err := GetBlockStorage(ctx, config, id)
 			if err != nil {
 				return 0, "", err
 			}
@@ -555,12 +603,12 @@ func deleteBlockStorage(d *schema.ResourceData, config *conn.ProviderConfig, id
 			}
 			return instance, ncloud.StringValue(instance.Status), nil
 		},
-		Timeout:    conn.DefaultTimeout,
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Timeout:     conn.DefaultTimeout,
+		Delay:       2 * time.Second,
+		MinInterval: 3 * time.Second,
 	}
 
-	_, err = stateConf.WaitForState()
+	_, err = stateConf.WaitForState(ctx)
 	if err != nil {
 		return fmt.Errorf("error waiting for BlockStorageInstance state to be \"TERMINATED\": %s", err)
 	}
@@ -568,103 +616,103 @@ func deleteBlockStorage(d *schema.ResourceData, config *conn.ProviderConfig, id
 	return nil
 }
 
-func deleteClassicBlockStorage(d *schema.ResourceData, config *conn.ProviderConfig, id string) error {
+func deleteClassicBlockStorage(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig, id string) error {
 	reqParams := server.DeleteBlockStorageInstancesRequest{
 		BlockStorageInstanceNoList: []*string{ncloud.String(id)},
 	}
 
-	LogCommonRequest("deleteClassicBlockStorage", reqParams)
+	ctx = LogCommonRequest(ctx, "deleteClassicBlockStorage", reqParams)
 
 	resp, err := config.Client.Server.V2Api.DeleteBlockStorageInstances(&reqParams)
 
 	if err != nil {
-		LogErrorResponse("deleteClassicBlockStorage", err, reqParams)
+		LogErrorResponse(ctx, "deleteClassicBlockStorage", err, reqParams)
 		return err
 	}
-	LogResponse("deleteClassicBlockStorage", resp)
+	LogResponse(ctx, "deleteClassicBlockStorage", resp)
 
 	return nil
 }
 
-func deleteVpcBlockStorage(d *schema.ResourceData, config *conn.ProviderConfig, id string) error {
+func deleteVpcBlockStorage(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig, id string) error {
 	reqParams := vserver.DeleteBlockStorageInstancesRequest{
 		RegionCode:                 &config.RegionCode,
 		BlockStorageInstanceNoList: []*string{ncloud.String(id)},
 	}
 
-	LogCommonRequest("deleteVpcBlockStorage", reqParams)
+	ctx = LogCommonRequest(ctx, "deleteVpcBlockStorage", reqParams)
 
 	resp, err := config.Client.Vserver.V2Api.DeleteBlockStorageInstances(&reqParams)
 
 	if err != nil {
-		LogErrorResponse("deleteVpcBlockStorage", err, reqParams)
+		LogErrorResponse(ctx, "deleteVpcBlockStorage", err, reqParams)
 		return err
 	}
-	LogResponse("deleteVpcBlockStorage", resp)
+	LogResponse(ctx, "deleteVpcBlockStorage", resp)
 
 	return nil
 }
 
-func detachBlockStorage(config *conn.ProviderConfig, id string) error {
+func detachBlockStorage(ctx context.Context, config *conn.ProviderConfig, id string) error {
 	var err error
 
 	if config.SupportVPC {
-		err = detachVpcBlockStorage(config, id)
+		err = detachVpcBlockStorage(ctx, config, id)
 	} else {
-		err = detachClassicBlockStorage(config, id)
+		err = detachClassicBlockStorage(ctx, config, id)
 	}
 
 	if err != nil {
 		return err
 	}
 
-	if err = waitForBlockStorageDetachment(config, id); err != nil {
+	if err = waitForBlockStorageDetachment(ctx, config, id, conn.DefaultUpdateTimeout); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func detachClassicBlockStorage(config *conn.ProviderConfig, id string) error {
+func detachClassicBlockStorage(ctx context.Context, config *conn.ProviderConfig, id string) error {
 	reqParams := &server.DetachBlockStorageInstancesRequest{
 		BlockStorageInstanceNoList: []*string{ncloud.String(id)},
 	}
 
-	LogCommonRequest("detachClassicBlockStorage", reqParams)
+	ctx = LogCommonRequest(ctx, "detachClassicBlockStorage", reqParams)
 
 	resp, err := config.Client.Server.V2Api.DetachBlockStorageInstances(reqParams)
 	if err != nil {
-		LogErrorResponse("detachClassicBlockStorage", err, reqParams)
+		LogErrorResponse(ctx, "detachClassicBlockStorage", err, reqParams)
 		return err
 	}
-	LogResponse("detachClassicBlockStorage", resp)
+	LogResponse(ctx, "detachClassicBlockStorage", resp)
 
 	return nil
 }
 
-func detachVpcBlockStorage(config *conn.ProviderConfig, id string) error {
+func detachVpcBlockStorage(ctx context.Context, config *conn.ProviderConfig, id string) error {
 	reqParams := &vserver.DetachBlockStorageInstancesRequest{
 		BlockStorageInstanceNoList: []*string{ncloud.String(id)},
 	}
 
-	LogCommonRequest("detachVpcBlockStorage", reqParams)
+	ctx = LogCommonRequest(ctx, "detachVpcBlockStorage", reqParams)
 
 	resp, err := config.Client.Vserver.V2Api.DetachBlockStorageInstances(reqParams)
 	if err != nil {
-		LogErrorResponse("detachVpcBlockStorage", err, reqParams)
+		LogErrorResponse(ctx, "detachVpcBlockStorage", err, reqParams)
 		return err
 	}
-	LogResponse("detachVpcBlockStorage", resp)
+	LogResponse(ctx, "detachVpcBlockStorage", resp)
 
 	return nil
 }
 
-func waitForBlockStorageDetachment(config *conn.ProviderConfig, id string) error {
-	stateConf := &retry.StateChangeConf{
+func waitForBlockStorageDetachment(ctx context.Context, config *conn.ProviderConfig, id string, timeout time.Duration) error {
+	stateConf := &waiter.Config[any]{
 		Pending: []string{BlockStorageStatusCodeAttach},
 		Target:  []string{BlockStorageStatusCodeCreate},
-		Refresh: func() (interface{}, string, error) {
-			instance, err := GetBlockStorage(config, id)
+		Refresh: func(ctx context.Context) (any, string, error) {
+			instance, err := GetBlockStorage(ctx, config, id)
 			if err != nil {
 				return 0, "", err
 			}
@@ -674,12 +722,12 @@ func waitForBlockStorageDetachment(config *conn.ProviderConfig, id string) error
 			}
 			return instance, ncloud.StringValue(instance.Status), nil
 		},
-		Timeout:    conn.DefaultUpdateTimeout,
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Timeout:     timeout,
+		Delay:       2 * time.Second,
+		MinInterval: 3 * time.Second,
 	}
 
-	_, err := stateConf.WaitForState()
+	_, err := stateConf.WaitForState(ctx)
 	if err != nil {
 		return fmt.Errorf("error waiting for BlockStorageInstance state to be \"CREAT\": %s", err)
 	}
@@ -687,68 +735,72 @@ func waitForBlockStorageDetachment(config *conn.ProviderConfig, id string) error
 	return nil
 }
 
-func attachBlockStorage(d *schema.ResourceData, config *conn.ProviderConfig) error {
+func attachBlockStorage(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) error {
+	return attachBlockStorageToServer(ctx, config, d.Id(), d.Get("server_instance_no").(string), conn.DefaultUpdateTimeout)
+}
+
+func attachBlockStorageToServer(ctx context.Context, config *conn.ProviderConfig, id string, serverInstanceNo string, timeout time.Duration) error {
 	var err error
 	if config.SupportVPC {
-		err = attachVpcBlockStorage(d, config)
+		err = attachVpcBlockStorage(ctx, config, id, serverInstanceNo)
 	} else {
-		err = attachClassicBlockStorage(d, config)
+		err = attachClassicBlockStorage(ctx, config, id, serverInstanceNo)
 	}
 
 	if err != nil {
 		return err
 	}
 
-	if err = waitForBlockStorageAttachment(config, d.Id()); err != nil {
+	if err = waitForBlockStorageAttachment(ctx, config, id, timeout); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func attachClassicBlockStorage(d *schema.ResourceData, config *conn.ProviderConfig) error {
+func attachClassicBlockStorage(ctx context.Context, config *conn.ProviderConfig, id string, serverInstanceNo string) error {
 	reqParams := &server.AttachBlockStorageInstanceRequest{
-		ServerInstanceNo:       ncloud.String(d.Get("server_instance_no").(string)),
-		BlockStorageInstanceNo: ncloud.String(d.Id()),
+		ServerInstanceNo:       ncloud.String(serverInstanceNo),
+		BlockStorageInstanceNo: ncloud.String(id),
 	}
 
-	LogCommonRequest("attachClassicBlockStorage", reqParams)
+	ctx = LogCommonRequest(ctx, "attachClassicBlockStorage", reqParams)
 
 	resp, err := config.Client.Server.V2Api.AttachBlockStorageInstance(reqParams)
 	if err != nil {
-		LogErrorResponse("attachClassicBlockStorage", err, reqParams)
+		LogErrorResponse(ctx, "attachClassicBlockStorage", err, reqParams)
 		return err
 	}
-	LogResponse("attachClassicBlockStorage", resp)
+	LogResponse(ctx, "attachClassicBlockStorage", resp)
 
 	return nil
 }
 
-func attachVpcBlockStorage(d *schema.ResourceData, config *conn.ProviderConfig) error {
+func attachVpcBlockStorage(ctx context.Context, config *conn.ProviderConfig, id string, serverInstanceNo string) error {
 	reqParams := &vserver.AttachBlockStorageInstanceRequest{
-		ServerInstanceNo:       ncloud.String(d.Get("server_instance_no").(string)),
-		BlockStorageInstanceNo: ncloud.String(d.Id()),
+		ServerInstanceNo:       ncloud.String(serverInstanceNo),
+		BlockStorageInstanceNo: ncloud.String(id),
 	}
 
-	LogCommonRequest("attachVpcBlockStorage", reqParams)
+	ctx = LogCommonRequest(ctx, "attachVpcBlockStorage", reqParams)
 
 	resp, err := config.Client.Vserver.V2Api.AttachBlockStorageInstance(reqParams)
 	if err != nil {
-		LogErrorResponse("attachVpcBlockStorage", err, reqParams)
+		LogErrorResponse(ctx, "attachVpcBlockStorage", err, reqParams)
 		return err
 	}
-	LogResponse("attachVpcBlockStorage", resp)
+	LogResponse(ctx, "attachVpcBlockStorage", resp)
 
 	return nil
 }
 
-func waitForBlockStorageCreation(config *conn.ProviderConfig, id string) (*BlockStorage, error) {
+func waitForBlockStorageCreation(ctx context.Context, config *conn.ProviderConfig, id string) (*BlockStorage, error) {
 	var blockStorageInstance *BlockStorage
-	stateConf := &retry.StateChangeConf{
+	stateConf := &waiter.Config[any]{
 		Pending: []string{BlockStorageStatusNameInit, BlockStorageStatusNameCreating, BlockStorageStatusNameAttaching},
 		Target:  []string{BlockStorageStatusNameAttach, BlockStorageStatusNameDetach},
-		Refresh: func() (interface{}, string, error) {
-			resp, err := GetBlockStorage(config, id)
+		Refresh: func(ctx context.Context) (any, string, error) {
+			resp, err := GetBlockStorage(ctx, config, id)
 			if err != nil {
 				return 0, "", err
 			}
@@ -760,24 +812,24 @@ func waitForBlockStorageCreation(config *conn.ProviderConfig, id string) (*Block
 
 			return resp, *resp.StatusName, nil
 		},
-		Timeout:    conn.DefaultTimeout,
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Timeout:     conn.DefaultTimeout,
+		Delay:       2 * time.Second,
+		MinInterval: 3 * time.Second,
 	}
 
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForState(ctx); err != nil {
 		return nil, fmt.Errorf("error waiting for BlockStorageInstance create: %s", err)
 	}
 
 	return blockStorageInstance, nil
 }
 
-func waitForBlockStorageAttachment(config *conn.ProviderConfig, id string) error {
-	stateConf := &retry.StateChangeConf{
+func waitForBlockStorageAttachment(ctx context.Context, config *conn.ProviderConfig, id string, timeout time.Duration) error {
+	stateConf := &waiter.Config[any]{
 		Pending: []string{BlockStorageStatusCodeInit, BlockStorageStatusCodeCreate},
 		Target:  []string{BlockStorageStatusCodeAttach},
-		Refresh: func() (interface{}, string, error) {
-			instance, err := GetBlockStorage(config, id)
+		Refresh: func(ctx context.Context) (any, string, error) {
+			instance, err := GetBlockStorage(ctx, config, id)
 			if err != nil {
 				return 0, "", err
 			}
@@ -787,12 +839,12 @@ func waitForBlockStorageAttachment(config *conn.ProviderConfig, id string) error
 			}
 			return instance, ncloud.StringValue(instance.Status), nil
 		},
-		Timeout:    conn.DefaultUpdateTimeout,
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Timeout:     timeout,
+		Delay:       2 * time.Second,
+		MinInterval: 3 * time.Second,
 	}
 
-	_, err := stateConf.WaitForState()
+	_, err := stateConf.WaitForState(ctx)
 	if err != nil {
 		return fmt.Errorf("error waiting for BlockStorageInstance state to be \"ATTAC\": %s", err)
 	}
@@ -800,88 +852,88 @@ func waitForBlockStorageAttachment(config *conn.ProviderConfig, id string) error
 	return nil
 }
 
-func changeBlockStorageSize(d *schema.ResourceData, config *conn.ProviderConfig) error {
+func changeBlockStorageSize(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) error {
 	var err error
 	if config.SupportVPC {
 		if d.Get("hypervisor_type").(string) == BlockStorageHypervisorTypeXen {
-			err = changeVpcBlockStorageVolumeSize(d, config)
+			err = changeVpcBlockStorageVolumeSize(ctx, d, config)
 		} else {
-			err = changeVpcBlockStorageInstance(d, config)
+			err = changeVpcBlockStorageInstance(ctx, d, config)
 		}
 	} else {
-		err = changeClassicBlockStorageSize(d, config)
+		err = changeClassicBlockStorageSize(ctx, d, config)
 	}
 
 	if err != nil {
 		return err
 	}
 
-	if err = waitForBlockStorageOperationIsNull(config, d.Id()); err != nil {
+	if err = waitForBlockStorageOperationIsNull(ctx, config, d.Id()); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func changeVpcBlockStorageVolumeSize(d *schema.ResourceData, config *conn.ProviderConfig) error {
+func changeVpcBlockStorageVolumeSize(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) error {
 	reqParams := &vserver.ChangeBlockStorageVolumeSizeRequest{
 		RegionCode:             &config.RegionCode,
 		BlockStorageInstanceNo: ncloud.String(d.Id()),
 		BlockStorageSize:       ncloud.Int32(int32(d.Get("size").(int))),
 	}
 
-	LogCommonRequest("changeVpcBlockStorageVolumeSize", reqParams)
+	ctx = LogCommonRequest(ctx, "changeVpcBlockStorageVolumeSize", reqParams)
 	resp, err := config.Client.Vserver.V2Api.ChangeBlockStorageVolumeSize(reqParams)
 	if err != nil {
-		LogErrorResponse("changeVpcBlockStorageVolumeSize", err, reqParams)
+		LogErrorResponse(ctx, "changeVpcBlockStorageVolumeSize", err, reqParams)
 		return err
 	}
-	LogResponse("changeVpcBlockStorageVolumeSize", resp)
+	LogResponse(ctx, "changeVpcBlockStorageVolumeSize", resp)
 
 	return nil
 }
 
-func changeVpcBlockStorageInstance(d *schema.ResourceData, config *conn.ProviderConfig) error {
+func changeVpcBlockStorageInstance(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) error {
 	reqParams := &vserver.ChangeBlockStorageInstanceRequest{
 		RegionCode:             &config.RegionCode,
 		BlockStorageInstanceNo: ncloud.String(d.Id()),
 		BlockStorageSize:       ncloud.Int32(int32(d.Get("size").(int))),
 	}
 
-	LogCommonRequest("changeVpcBlockStorageInstance", reqParams)
+	ctx = LogCommonRequest(ctx, "changeVpcBlockStorageInstance", reqParams)
 	resp, err := config.Client.Vserver.V2Api.ChangeBlockStorageInstance(reqParams)
 	if err != nil {
-		LogErrorResponse("changeVpcBlockStorageInstance", err, reqParams)
+		LogErrorResponse(ctx, "changeVpcBlockStorageInstance", err, reqParams)
 		return err
 	}
-	LogResponse("changeVpcBlockStorageInstance", resp)
+	LogResponse(ctx, "changeVpcBlockStorageInstance", resp)
 
 	return nil
 }
 
-func changeClassicBlockStorageSize(d *schema.ResourceData, config *conn.ProviderConfig) error {
+func changeClassicBlockStorageSize(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) error {
 	reqParams := &server.ChangeBlockStorageVolumeSizeRequest{
 		BlockStorageInstanceNo: ncloud.String(d.Id()),
 		BlockStorageSize:       ncloud.Int64(int64(d.Get("size").(int))),
 	}
 
-	LogCommonRequest("changeClassicBlockStorageSize", reqParams)
+	ctx = LogCommonRequest(ctx, "changeClassicBlockStorageSize", reqParams)
 	resp, err := config.Client.Server.V2Api.ChangeBlockStorageVolumeSize(reqParams)
 	if err != nil {
-		LogErrorResponse("changeClassicBlockStorageSize", err, reqParams)
+		LogErrorResponse(ctx, "changeClassicBlockStorageSize", err, reqParams)
 		return err
 	}
-	LogResponse("changeClassicBlockStorageSize", resp)
+	LogResponse(ctx, "changeClassicBlockStorageSize", resp)
 
 	return nil
 }
 
-func waitForBlockStorageOperationIsNull(config *conn.ProviderConfig, id string) error {
-	stateConf := &retry.StateChangeConf{
+func waitForBlockStorageOperationIsNull(ctx context.Context, config *conn.ProviderConfig, id string) error {
+	stateConf := &waiter.Config[any]{
 		Pending: []string{"CHNG"},
 		Target:  []string{"NULL"},
-		Refresh: func() (interface{}, string, error) {
-			instance, err := GetBlockStorage(config, id)
+		Refresh: func(ctx context.Context) (any, string, error) {
+			instance, err := GetBlockStorage(ctx, config, id)
 			if err != nil {
 				return 0, "", err
 			}
@@ -891,12 +943,12 @@ func waitForBlockStorageOperationIsNull(config *conn.ProviderConfig, id string)
 			}
 			return instance, ncloud.StringValue(instance.Operation), nil
 		},
-		Timeout:    conn.DefaultUpdateTimeout,
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Timeout:     conn.DefaultUpdateTimeout,
+		Delay:       2 * time.Second,
+		MinInterval: 3 * time.Second,
 	}
 
-	_, err := stateConf.WaitForState()
+	_, err := stateConf.WaitForState(ctx)
 	if err != nil {
 		return fmt.Errorf("error waiting for BlockStorageInstance operation to be \"NULL\": %s", err)
 	}
@@ -904,20 +956,20 @@ func waitForBlockStorageOperationIsNull(config *conn.ProviderConfig, id string)
 	return nil
 }
 
-func changeVpcBlockStorageReturnProtection(d *schema.ResourceData, config *conn.ProviderConfig) error {
+func changeVpcBlockStorageReturnProtection(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) error {
 	reqParams := &vserver.SetBlockStorageReturnProtectionRequest{
 		RegionCode:             &config.RegionCode,
 		BlockStorageInstanceNo: ncloud.String(d.Id()),
 		IsReturnProtection:     ncloud.Bool(d.Get("return_protection").(bool)),
 	}
 
-	LogCommonRequest("changeVpcBlockStorageReturnProtection", reqParams)
+	ctx = LogCommonRequest(ctx, "changeVpcBlockStorageReturnProtection", reqParams)
 	resp, err := config.Client.Vserver.V2Api.SetBlockStorageReturnProtection(reqParams)
 	if err != nil {
-		LogErrorResponse("changeVpcBlockStorageReturnProtection", err, reqParams)
+		LogErrorResponse(ctx, "changeVpcBlockStorageReturnProtection", err, reqParams)
 		return err
 	}
-	LogResponse("changeVpcBlockStorageReturnProtection", resp)
+	LogResponse(ctx, "changeVpcBlockStorageReturnProtection", resp)
 
 	return nil
 }
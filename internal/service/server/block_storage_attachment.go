@@ -0,0 +1,162 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+)
+
+func ResourceNcloudBlockStorageAttachment() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNcloudBlockStorageAttachmentCreate,
+		ReadContext:   resourceNcloudBlockStorageAttachmentRead,
+		UpdateContext: resourceNcloudBlockStorageAttachmentUpdate,
+		DeleteContext: resourceNcloudBlockStorageAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(conn.DefaultCreateTimeout),
+			Delete: schema.DefaultTimeout(6 * conn.DefaultTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"block_storage_no": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"server_instance_no": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"stop_instance_before_detaching": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"device_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceNcloudBlockStorageAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*conn.ProviderConfig)
+
+	id := d.Get("block_storage_no").(string)
+	serverInstanceNo := d.Get("server_instance_no").(string)
+
+	storage, err := GetBlockStorage(ctx, config, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if storage == nil {
+		return diag.FromErr(fmt.Errorf("no matching block storage: %s", id))
+	}
+
+	// The block storage is attached already, e.g. by its own server_instance_no
+	attached := ncloud.StringValue(storage.ServerInstanceNo)
+	if attached == serverInstanceNo {
+		d.SetId(id)
+		return resourceNcloudBlockStorageAttachmentRead(ctx, d, meta)
+	}
+
+	if attached != "" {
+		return diag.FromErr(fmt.Errorf("block storage %s is attached to another server instance %s", id, attached))
+	}
+
+	if err := attachBlockStorageToServer(ctx, config, id, serverInstanceNo, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(id)
+	log.Printf("[INFO] Block Storage %s attached to Server Instance %s", id, serverInstanceNo)
+
+	return resourceNcloudBlockStorageAttachmentRead(ctx, d, meta)
+}
+
+func resourceNcloudBlockStorageAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*conn.ProviderConfig)
+
+	storage, err := GetBlockStorage(ctx, config, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// A detached block storage, e.g. by the termination of its server, is no longer attached
+	if storage == nil || ncloud.StringValue(storage.ServerInstanceNo) == "" {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("block_storage_no", storage.BlockStorageInstanceNo)
+	d.Set("server_instance_no", storage.ServerInstanceNo)
+	d.Set("device_name", storage.DeviceName)
+
+	return nil
+}
+
+func resourceNcloudBlockStorageAttachmentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Only stop_instance_before_detaching can be updated, which is used when detaching
+	return resourceNcloudBlockStorageAttachmentRead(ctx, d, meta)
+}
+
+func resourceNcloudBlockStorageAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*conn.ProviderConfig)
+
+	storage, err := GetBlockStorage(ctx, config, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	serverInstanceNo := d.Get("server_instance_no").(string)
+	if storage == nil || ncloud.StringValue(storage.ServerInstanceNo) != serverInstanceNo {
+		d.SetId("")
+		return nil
+	}
+
+	serverInstance, err := GetServerInstance(ctx, config, serverInstanceNo)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The server being terminated detaches its block storages by itself
+	if serverInstance == nil {
+		d.SetId("")
+		return nil
+	}
+
+	if d.Get("stop_instance_before_detaching").(bool) && ncloud.StringValue(serverInstance.ServerInstanceStatus) != "NSTOP" {
+		log.Printf("[INFO] Stopping Instance %s for detaching block storage", serverInstanceNo)
+		if err := stopThenWaitServerInstance(ctx, config, serverInstanceNo); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if err := disconnectBlockStorage(ctx, config, storage); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := waitForDisconnectBlockStorage(ctx, config, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := detachThenWaitServerInstance(ctx, config, serverInstanceNo); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
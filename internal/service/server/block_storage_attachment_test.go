@@ -0,0 +1,127 @@
+package server_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	. "github.com/terraform-providers/terraform-provider-ncloud/internal/acctest"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/service/server"
+)
+
+func TestAccResourceNcloudBlockStorageAttachment_vpc_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-attach-%s", RandString(t, 5))
+	resourceName := "ncloud_block_storage_attachment.attachment"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckBlockStorageAttachmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBlockStorageAttachmentVpcConfig(name, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "block_storage_no", "ncloud_block_storage.storage", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "server_instance_no", "ncloud_server.first", "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "device_name"),
+				),
+			},
+			{
+				// Moves the block storage to another server without replacing the block storage
+				Config: testAccBlockStorageAttachmentVpcConfig(name, "second"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "server_instance_no", "ncloud_server.second", "id"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"stop_instance_before_detaching"},
+			},
+		},
+	})
+}
+
+func testAccCheckBlockStorageAttachmentDestroy(s *terraform.State) error {
+	config := GetTestProvider(true).Meta().(*conn.ProviderConfig)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ncloud_block_storage_attachment" {
+			continue
+		}
+		storage, err := server.GetBlockStorage(context.Background(), config, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if storage != nil && storage.ServerInstanceNo != nil && *storage.ServerInstanceNo != "" {
+			return fmt.Errorf("found attached block storage: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccBlockStorageAttachmentVpcConfig(name string, target string) string {
+	return fmt.Sprintf(`
+resource "ncloud_login_key" "loginkey" {
+	key_name = "%[1]s-key"
+}
+
+resource "ncloud_vpc" "test" {
+	name               = "%[1]s"
+	ipv4_cidr_block    = "10.5.0.0/16"
+}
+
+resource "ncloud_subnet" "test" {
+	vpc_no             = ncloud_vpc.test.vpc_no
+	name               = "%[1]s"
+	subnet             = "10.5.0.0/24"
+	zone               = "KR-2"
+	network_acl_no     = ncloud_vpc.test.default_network_acl_no
+	subnet_type        = "PUBLIC"
+	usage_type         = "GEN"
+}
+
+data "ncloud_server_image_numbers" "server_images" {
+	hypervisor_type = "KVM"
+	filter {
+        name = "name"
+        values = ["ubuntu-22.04-base"]
+    }
+}
+
+resource "ncloud_server" "first" {
+	subnet_no = ncloud_subnet.test.id
+	name = "%[1]s-1"
+	server_image_number = data.ncloud_server_image_numbers.server_images.image_number_list.0.server_image_number
+	server_spec_code = "s2-g3"
+	login_key_name = ncloud_login_key.loginkey.key_name
+}
+
+resource "ncloud_server" "second" {
+	subnet_no = ncloud_subnet.test.id
+	name = "%[1]s-2"
+	server_image_number = data.ncloud_server_image_numbers.server_images.image_number_list.0.server_image_number
+	server_spec_code = "s2-g3"
+	login_key_name = ncloud_login_key.loginkey.key_name
+}
+
+resource "ncloud_block_storage" "storage" {
+	name = "%[1]s-tf"
+	size = "10"
+	hypervisor_type = "KVM"
+	volume_type = "CB1"
+	zone = "KR-2"
+}
+
+resource "ncloud_block_storage_attachment" "attachment" {
+	block_storage_no   = ncloud_block_storage.storage.id
+	server_instance_no = ncloud_server.%[2]s.id
+}
+`, name, target)
+}
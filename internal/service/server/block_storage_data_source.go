@@ -1,16 +1,19 @@
 package server
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/paging"
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/verify"
 )
 
@@ -29,19 +32,19 @@ func DataSourceNcloudBlockStorage() *schema.Resource {
 		"filter": DataSourceFiltersSchema(),
 	}
 
-	return GetSingularDataSourceItemSchema(ResourceNcloudBlockStorage(), fieldMap, dataSourceNcloudBlockStorageRead)
+	return GetSingularDataSourceItemSchemaContext(ResourceNcloudBlockStorage(), fieldMap, dataSourceNcloudBlockStorageRead)
 }
 
-func dataSourceNcloudBlockStorageRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceNcloudBlockStorageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*conn.ProviderConfig)
 
-	instances, err := getBlockStorageList(d, config)
+	instances, err := getBlockStorageList(ctx, d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if len(instances) < 1 {
-		return fmt.Errorf("no results. please change search criteria and try again")
+		return diag.FromErr(fmt.Errorf("no results. please change search criteria and try again"))
 	}
 
 	resources := ConvertToArrayMap(instances)
@@ -50,7 +53,7 @@ func dataSourceNcloudBlockStorageRead(d *schema.ResourceData, meta interface{})
 	}
 
 	if err := ValidateOneResult(len(resources)); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(resources[0]["block_storage_no"].(string))
@@ -59,16 +62,16 @@ func dataSourceNcloudBlockStorageRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func getBlockStorageList(d *schema.ResourceData, config *conn.ProviderConfig) ([]*BlockStorage, error) {
+func getBlockStorageList(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) ([]*BlockStorage, error) {
 	if config.SupportVPC {
-		return getVpcBlockStorageList(d, config)
+		return getVpcBlockStorageList(ctx, d, config)
 	}
 
-	return getClassicBlockStorageList(d, config)
+	return getClassicBlockStorageList(ctx, d, config)
 }
 
-func getClassicBlockStorageList(d *schema.ResourceData, config *conn.ProviderConfig) ([]*BlockStorage, error) {
-	regionNo, err := conn.ParseRegionNoParameter(d)
+func getClassicBlockStorageList(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) ([]*BlockStorage, error) {
+	regionNo, err := conn.ParseRegionNoParameter(config, d)
 	if err != nil {
 		return nil, err
 	}
@@ -82,17 +85,26 @@ func getClassicBlockStorageList(d *schema.ResourceData, config *conn.ProviderCon
 		reqParams.BlockStorageInstanceNoList = []*string{ncloud.String(v.(string))}
 	}
 
-	LogCommonRequest("getClassicBlockStorageList", reqParams)
+	blockStorageInstanceList, err := paging.All(ctx, func(ctx context.Context, pageNo, pageSize int32) ([]*server.BlockStorageInstance, *int32, error) {
+		reqParams.PageNo = ncloud.Int32(pageNo)
+		reqParams.PageSize = ncloud.Int32(pageSize)
 
-	resp, err := config.Client.Server.V2Api.GetBlockStorageInstanceList(reqParams)
+		ctx = LogCommonRequest(ctx, "getClassicBlockStorageList", reqParams)
+		resp, err := config.Client.Server.V2Api.GetBlockStorageInstanceList(reqParams)
+		if err != nil {
+			LogErrorResponse(ctx, "getClassicBlockStorageList", err, reqParams)
+			return nil, nil, err
+		}
+		LogResponse(ctx, "getClassicBlockStorageList", resp)
+
+		return resp.BlockStorageInstanceList, resp.TotalRows, nil
+	})
 	if err != nil {
-		LogErrorResponse("getClassicBlockStorageList", err, reqParams)
 		return nil, err
 	}
-	LogResponse("getClassicBlockStorageList", resp)
 
 	var list []*BlockStorage
-	for _, r := range resp.BlockStorageInstanceList {
+	for _, r := range blockStorageInstanceList {
 		instance := &BlockStorage{
 			BlockStorageInstanceNo:  r.BlockStorageInstanceNo,
 			ServerInstanceNo:        r.ServerInstanceNo,
@@ -114,7 +126,7 @@ func getClassicBlockStorageList(d *schema.ResourceData, config *conn.ProviderCon
 	return list, nil
 }
 
-func getVpcBlockStorageList(d *schema.ResourceData, config *conn.ProviderConfig) ([]*BlockStorage, error) {
+func getVpcBlockStorageList(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) ([]*BlockStorage, error) {
 	reqParams := &vserver.GetBlockStorageInstanceListRequest{
 		RegionCode:       &config.RegionCode,
 		ServerInstanceNo: StringPtrOrNil(d.GetOk("server_instance_no")),
@@ -124,17 +136,26 @@ func getVpcBlockStorageList(d *schema.ResourceData, config *conn.ProviderConfig)
 		reqParams.BlockStorageInstanceNoList = []*string{ncloud.String(v.(string))}
 	}
 
-	LogCommonRequest("getVpcBlockStorageList", reqParams)
+	blockStorageInstanceList, err := paging.All(ctx, func(ctx context.Context, pageNo, pageSize int32) ([]*vserver.BlockStorageInstance, *int32, error) {
+		reqParams.PageNo = ncloud.Int32(pageNo)
+		reqParams.PageSize = ncloud.Int32(pageSize)
+
+		ctx = LogCommonRequest(ctx, "getVpcBlockStorageList", reqParams)
+		resp, err := config.Client.Vserver.V2Api.GetBlockStorageInstanceList(reqParams)
+		if err != nil {
+			LogErrorResponse(ctx, "getVpcBlockStorage", err, reqParams)
+			return nil, nil, err
+		}
+		LogResponse(ctx, "getVpcBlockStorageList", resp)
 
-	resp, err := config.Client.Vserver.V2Api.GetBlockStorageInstanceList(reqParams)
+		return resp.BlockStorageInstanceList, resp.TotalRows, nil
+	})
 	if err != nil {
-		LogErrorResponse("getVpcBlockStorage", err, reqParams)
 		return nil, err
 	}
-	LogResponse("getVpcBlockStorageList", resp)
 
 	var list []*BlockStorage
-	for _, r := range resp.BlockStorageInstanceList {
+	for _, r := range blockStorageInstanceList {
 		instance := &BlockStorage{
 			BlockStorageInstanceNo:  r.BlockStorageInstanceNo,
 			ServerInstanceNo:        r.ServerInstanceNo,
@@ -1,6 +1,7 @@
 package server
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -9,12 +10,13 @@ import (
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/waiter"
 )
 
 const (
@@ -25,12 +27,12 @@ const (
 
 func ResourceNcloudBlockStorageSnapshot() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNcloudBlockStorageSnapshotCreate,
-		Read:   resourceNcloudBlockStorageSnapshotRead,
-		Update: resourceNcloudBlockStorageSnapshotUpdate,
-		Delete: resourceNcloudBlockStorageSnapshotDelete,
+		CreateContext: resourceNcloudBlockStorageSnapshotCreate,
+		ReadContext:   resourceNcloudBlockStorageSnapshotRead,
+		UpdateContext: resourceNcloudBlockStorageSnapshotUpdate,
+		DeleteContext: resourceNcloudBlockStorageSnapshotDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -112,36 +114,36 @@ func ResourceNcloudBlockStorageSnapshot() *schema.Resource {
 	}
 }
 
-func resourceNcloudBlockStorageSnapshotCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudBlockStorageSnapshotCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var err error
 	config := meta.(*conn.ProviderConfig)
 
 	if config.SupportVPC {
-		err = createVpcBlockStorageSnapshot(d, config)
+		err = createVpcBlockStorageSnapshot(ctx, d, config)
 	} else {
-		err = createClassicBlockStorageSnapshot(d, config)
+		err = createClassicBlockStorageSnapshot(ctx, d, config)
 	}
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceNcloudBlockStorageSnapshotRead(d, meta)
+	return resourceNcloudBlockStorageSnapshotRead(ctx, d, meta)
 }
 
-func resourceNcloudBlockStorageSnapshotRead(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudBlockStorageSnapshotRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var err error
 	var r *BlockStorageSnapshot
 	config := meta.(*conn.ProviderConfig)
 
 	if config.SupportVPC {
-		r, err = GetVpcBlockStorageSnapshotDetail(config, d.Id())
+		r, err = GetVpcBlockStorageSnapshotDetail(ctx, config, d.Id())
 	} else {
-		r, err = GetClassicBlockStorageSnapshotInstance(config, d.Id())
+		r, err = GetClassicBlockStorageSnapshotInstance(ctx, config, d.Id())
 	}
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if r == nil {
@@ -168,29 +170,29 @@ func resourceNcloudBlockStorageSnapshotRead(d *schema.ResourceData, meta interfa
 	return nil
 }
 
-func resourceNcloudBlockStorageSnapshotUpdate(d *schema.ResourceData, meta interface{}) error {
-	return resourceNcloudBlockStorageSnapshotRead(d, meta)
+func resourceNcloudBlockStorageSnapshotUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceNcloudBlockStorageSnapshotRead(ctx, d, meta)
 }
 
-func resourceNcloudBlockStorageSnapshotDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudBlockStorageSnapshotDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var err error
 	config := meta.(*conn.ProviderConfig)
 
 	if config.SupportVPC {
-		err = deleteVpcBlockStorageSnapshot(config, d.Id())
+		err = deleteVpcBlockStorageSnapshot(ctx, config, d.Id())
 	} else {
-		err = deleteClassicBlockStorageSnapshot(config, d.Id())
+		err = deleteClassicBlockStorageSnapshot(ctx, config, d.Id())
 	}
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId("")
 	return nil
 }
 
-func createVpcBlockStorageSnapshot(d *schema.ResourceData, config *conn.ProviderConfig) error {
+func createVpcBlockStorageSnapshot(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) error {
 	reqParams := &vserver.CreateBlockStorageSnapshotInstanceRequest{
 		RegionCode:                      &config.RegionCode,
 		OriginalBlockStorageInstanceNo:  ncloud.String(d.Get("block_storage_instance_no").(string)),
@@ -198,25 +200,25 @@ func createVpcBlockStorageSnapshot(d *schema.ResourceData, config *conn.Provider
 		BlockStorageSnapshotDescription: StringPtrOrNil(d.GetOk("description")),
 	}
 
-	LogCommonRequest("createVpcBlockStorageSnapshot", reqParams)
+	ctx = LogCommonRequest(ctx, "createVpcBlockStorageSnapshot", reqParams)
 
 	resp, err := config.Client.Vserver.V2Api.CreateBlockStorageSnapshotInstance(reqParams)
 	if err != nil {
-		LogErrorResponse("createVpcBlockStorageSnapshot", err, reqParams)
+		LogErrorResponse(ctx, "createVpcBlockStorageSnapshot", err, reqParams)
 		return err
 	}
-	LogResponse("createVpcBlockStorageSnapshot", resp)
+	LogResponse(ctx, "createVpcBlockStorageSnapshot", resp)
 
 	if resp == nil || len(resp.BlockStorageSnapshotInstanceList) < 1 {
 		err := fmt.Errorf("response invalid")
-		LogErrorResponse("createVpcBlockStorageSnapshot", err, reqParams)
+		LogErrorResponse(ctx, "createVpcBlockStorageSnapshot", err, reqParams)
 		return err
 	}
 
 	instance := resp.BlockStorageSnapshotInstanceList[0]
-	err = waitForBlockStorageSnapshotCreation(config, *instance.BlockStorageSnapshotInstanceNo)
+	err = waitForBlockStorageSnapshotCreation(ctx, config, *instance.BlockStorageSnapshotInstanceNo)
 	if err != nil {
-		LogErrorResponse("createVpcBlockStorageSnapshot", err, reqParams)
+		LogErrorResponse(ctx, "createVpcBlockStorageSnapshot", err, reqParams)
 		return err
 	}
 	d.SetId(*instance.BlockStorageSnapshotInstanceNo)
@@ -224,12 +226,12 @@ func createVpcBlockStorageSnapshot(d *schema.ResourceData, config *conn.Provider
 	return nil
 }
 
-func waitForBlockStorageSnapshotCreation(config *conn.ProviderConfig, id string) error {
-	stateConf := &retry.StateChangeConf{
+func waitForBlockStorageSnapshotCreation(ctx context.Context, config *conn.ProviderConfig, id string) error {
+	stateConf := &waiter.Config[any]{
 		Pending: []string{BlockStorageSnapshotStatusCodeInit},
 		Target:  []string{BlockStorageSnapshotStatusCodeCreate},
-		Refresh: func() (interface{}, string, error) {
-			resp, err := GetVpcBlockStorageSnapshotDetail(config, id)
+		Refresh: func(ctx context.Context) (any, string, error) {
+			resp, err := GetVpcBlockStorageSnapshotDetail(ctx, config, id)
 			if err != nil {
 				return 0, "", err
 			}
@@ -240,37 +242,37 @@ func waitForBlockStorageSnapshotCreation(config *conn.ProviderConfig, id string)
 
 			return resp, *resp.Status, nil
 		},
-		Timeout:    conn.DefaultCreateTimeout,
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Timeout:     conn.DefaultCreateTimeout,
+		Delay:       2 * time.Second,
+		MinInterval: 3 * time.Second,
 	}
 
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForState(ctx); err != nil {
 		return fmt.Errorf("Error waiting for BlockStorageSnapshotInstance state to be \"CREAT\": %s", err)
 	}
 
 	return nil
 }
 
-func createClassicBlockStorageSnapshot(d *schema.ResourceData, config *conn.ProviderConfig) error {
+func createClassicBlockStorageSnapshot(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) error {
 	reqParams := buildRequestBlockStorageSnapshotInstance(d)
-	LogCommonRequest("createClassicBlockStorageSnapshot", reqParams)
+	ctx = LogCommonRequest(ctx, "createClassicBlockStorageSnapshot", reqParams)
 
 	resp, err := config.Client.Server.V2Api.CreateBlockStorageSnapshotInstance(reqParams)
 	if err != nil {
-		LogErrorResponse("createClassicBlockStorageSnapshot", err, reqParams)
+		LogErrorResponse(ctx, "createClassicBlockStorageSnapshot", err, reqParams)
 		return err
 	}
-	LogResponse("createClassicBlockStorageSnapshot", resp)
+	LogResponse(ctx, "createClassicBlockStorageSnapshot", resp)
 
 	blockStorageSnapshotInstance := resp.BlockStorageSnapshotInstanceList[0]
 	blockStorageSnapshotInstanceNo := ncloud.StringValue(blockStorageSnapshotInstance.BlockStorageSnapshotInstanceNo)
 
-	stateConf := &retry.StateChangeConf{
+	stateConf := &waiter.Config[any]{
 		Pending: []string{BlockStorageSnapshotStatusCodeInit},
 		Target:  []string{BlockStorageSnapshotStatusCodeCreate},
-		Refresh: func() (interface{}, string, error) {
-			instance, err := GetClassicBlockStorageSnapshotInstance(config, blockStorageSnapshotInstanceNo)
+		Refresh: func(ctx context.Context) (any, string, error) {
+			instance, err := GetClassicBlockStorageSnapshotInstance(ctx, config, blockStorageSnapshotInstanceNo)
 			if err != nil {
 				return 0, "", err
 			}
@@ -280,12 +282,12 @@ func createClassicBlockStorageSnapshot(d *schema.ResourceData, config *conn.Prov
 			}
 			return instance, *instance.Status, nil
 		},
-		Timeout:    conn.DefaultCreateTimeout,
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Timeout:     conn.DefaultCreateTimeout,
+		Delay:       2 * time.Second,
+		MinInterval: 3 * time.Second,
 	}
 
-	_, err = stateConf.WaitForState()
+	_, err = stateConf.WaitForState(ctx)
 	if err != nil {
 		return fmt.Errorf("Error waiting for BlockStorageSnapshotInstance state to be \"CREAT\": %s", err)
 	}
@@ -310,19 +312,19 @@ func buildRequestBlockStorageSnapshotInstance(d *schema.ResourceData) *server.Cr
 	return reqParams
 }
 
-func GetClassicBlockStorageSnapshotInstance(config *conn.ProviderConfig, blockStorageSnapshotInstanceNo string) (*BlockStorageSnapshot, error) {
+func GetClassicBlockStorageSnapshotInstance(ctx context.Context, config *conn.ProviderConfig, blockStorageSnapshotInstanceNo string) (*BlockStorageSnapshot, error) {
 	reqParams := &server.GetBlockStorageSnapshotInstanceListRequest{
 		BlockStorageSnapshotInstanceNoList: []*string{ncloud.String(blockStorageSnapshotInstanceNo)},
 	}
 
-	LogCommonRequest("getClassicBlockStorageSnapshotInstanceList", reqParams)
+	ctx = LogCommonRequest(ctx, "getClassicBlockStorageSnapshotInstanceList", reqParams)
 
 	resp, err := config.Client.Server.V2Api.GetBlockStorageSnapshotInstanceList(reqParams)
 	if err != nil {
-		LogErrorResponse("getClassicBlockStorageSnapshotInstanceList", err, reqParams)
+		LogErrorResponse(ctx, "getClassicBlockStorageSnapshotInstanceList", err, reqParams)
 		return nil, err
 	}
-	LogResponse("getClassicBlockStorageSnapshotInstanceList", resp)
+	LogResponse(ctx, "getClassicBlockStorageSnapshotInstanceList", resp)
 
 	if len(resp.BlockStorageSnapshotInstanceList) > 0 {
 		inst := resp.BlockStorageSnapshotInstanceList[0]
@@ -348,19 +350,19 @@ func GetClassicBlockStorageSnapshotInstance(config *conn.ProviderConfig, blockSt
 	return nil, nil
 }
 
-func GetVpcBlockStorageSnapshotDetail(config *conn.ProviderConfig, blockStorageSnapshotInstanceNo string) (*BlockStorageSnapshot, error) {
+func GetVpcBlockStorageSnapshotDetail(ctx context.Context, config *conn.ProviderConfig, blockStorageSnapshotInstanceNo string) (*BlockStorageSnapshot, error) {
 	reqParams := &vserver.GetBlockStorageSnapshotInstanceDetailRequest{
 		BlockStorageSnapshotInstanceNo: ncloud.String(blockStorageSnapshotInstanceNo),
 	}
 
-	LogCommonRequest("GetVpcBlockStorageSnapshotDetail", reqParams)
+	ctx = LogCommonRequest(ctx, "GetVpcBlockStorageSnapshotDetail", reqParams)
 
 	resp, err := config.Client.Vserver.V2Api.GetBlockStorageSnapshotInstanceDetail(reqParams)
 	if err != nil {
-		LogErrorResponse("GetVpcBlockStorageSnapshotDetail", err, reqParams)
+		LogErrorResponse(ctx, "GetVpcBlockStorageSnapshotDetail", err, reqParams)
 		return nil, err
 	}
-	LogResponse("GetVpcBlockStorageSnapshotDetail", resp)
+	LogResponse(ctx, "GetVpcBlockStorageSnapshotDetail", resp)
 
 	if len(resp.BlockStorageSnapshotInstanceList) > 0 {
 		inst := resp.BlockStorageSnapshotInstanceList[0]
@@ -383,36 +385,36 @@ func GetVpcBlockStorageSnapshotDetail(config *conn.ProviderConfig, blockStorageS
 	return nil, nil
 }
 
-func deleteVpcBlockStorageSnapshot(config *conn.ProviderConfig, id string) error {
+func deleteVpcBlockStorageSnapshot(ctx context.Context, config *conn.ProviderConfig, id string) error {
 	reqParams := &vserver.DeleteBlockStorageSnapshotInstancesRequest{
 		RegionCode:                         &config.RegionCode,
 		BlockStorageSnapshotInstanceNoList: []*string{ncloud.String(id)},
 	}
 
-	LogCommonRequest("deleteVpcBlockStorageSnapshot", reqParams)
+	ctx = LogCommonRequest(ctx, "deleteVpcBlockStorageSnapshot", reqParams)
 
 	resp, err := config.Client.Vserver.V2Api.DeleteBlockStorageSnapshotInstances(reqParams)
 	if err != nil {
-		LogErrorResponse("deleteVpcBlockStorageSnapshot", err, reqParams)
+		LogErrorResponse(ctx, "deleteVpcBlockStorageSnapshot", err, reqParams)
 		return err
 	}
-	LogResponse("deleteVpcBlockStorageSnapshot", resp)
+	LogResponse(ctx, "deleteVpcBlockStorageSnapshot", resp)
 
-	err = waitForBlockStorageSnapshotDelete(config, id)
+	err = waitForBlockStorageSnapshotDelete(ctx, config, id)
 	if err != nil {
-		LogErrorResponse("deleteVpcBlockStorageSnapshot", err, reqParams)
+		LogErrorResponse(ctx, "deleteVpcBlockStorageSnapshot", err, reqParams)
 		return err
 	}
 
 	return nil
 }
 
-func waitForBlockStorageSnapshotDelete(config *conn.ProviderConfig, id string) error {
-	stateConf := &retry.StateChangeConf{
+func waitForBlockStorageSnapshotDelete(ctx context.Context, config *conn.ProviderConfig, id string) error {
+	stateConf := &waiter.Config[any]{
 		Pending: []string{BlockStorageSnapshotStatusCodeCreate},
 		Target:  []string{BlockStorageSnapshotStatusCodeTerminated},
-		Refresh: func() (interface{}, string, error) {
-			resp, err := GetVpcBlockStorageSnapshotDetail(config, id)
+		Refresh: func(ctx context.Context) (any, string, error) {
+			resp, err := GetVpcBlockStorageSnapshotDetail(ctx, config, id)
 			if err != nil {
 				return 0, "", err
 			}
@@ -423,37 +425,37 @@ func waitForBlockStorageSnapshotDelete(config *conn.ProviderConfig, id string) e
 
 			return resp, *resp.Status, nil
 		},
-		Timeout:    conn.DefaultTimeout,
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Timeout:     conn.DefaultTimeout,
+		Delay:       2 * time.Second,
+		MinInterval: 3 * time.Second,
 	}
 
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForState(ctx); err != nil {
 		return fmt.Errorf("Error waiting for BlockStorageSnapshotInstance state to be \"TERMINATED\": %s", err)
 	}
 
 	return nil
 }
 
-func deleteClassicBlockStorageSnapshot(config *conn.ProviderConfig, id string) error {
+func deleteClassicBlockStorageSnapshot(ctx context.Context, config *conn.ProviderConfig, id string) error {
 	reqParams := server.DeleteBlockStorageSnapshotInstancesRequest{
 		BlockStorageSnapshotInstanceNoList: []*string{ncloud.String(id)},
 	}
 
-	LogCommonRequest("DeleteBlockStorageSnapshotInstances", reqParams)
+	ctx = LogCommonRequest(ctx, "DeleteBlockStorageSnapshotInstances", reqParams)
 
 	resp, err := config.Client.Server.V2Api.DeleteBlockStorageSnapshotInstances(&reqParams)
 	if err != nil {
-		LogErrorResponse("DeleteBlockStorageSnapshotInstances", err, []*string{ncloud.String(id)})
+		LogErrorResponse(ctx, "DeleteBlockStorageSnapshotInstances", err, []*string{ncloud.String(id)})
 		return err
 	}
-	LogResponse("DeleteBlockStorageSnapshotInstances", resp)
+	LogResponse(ctx, "DeleteBlockStorageSnapshotInstances", resp)
 
-	stateConf := &retry.StateChangeConf{
+	stateConf := &waiter.Config[any]{
 		Pending: []string{BlockStorageSnapshotStatusCodeCreate},
 		Target:  []string{BlockStorageSnapshotStatusCodeTerminated},
-		Refresh: func() (interface{}, string, error) {
-			instance, err := GetClassicBlockStorageSnapshotInstance(config, id)
+		Refresh: func(ctx context.Context) (any, string, error) {
+			instance, err := GetClassicBlockStorageSnapshotInstance(ctx, config, id)
 			if err != nil {
 				return 0, "", err
 			}
@@ -462,12 +464,12 @@ func deleteClassicBlockStorageSnapshot(config *conn.ProviderConfig, id string) e
 			}
 			return instance, *instance.Status, nil
 		},
-		Timeout:    conn.DefaultTimeout,
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Timeout:     conn.DefaultTimeout,
+		Delay:       2 * time.Second,
+		MinInterval: 3 * time.Second,
 	}
 
-	_, err = stateConf.WaitForState()
+	_, err = stateConf.WaitForState(ctx)
 	if err != nil {
 		return fmt.Errorf("Error waiting for BlockStorageSnapshotInstance state to be \"TERMINATED\": %s", err)
 	}
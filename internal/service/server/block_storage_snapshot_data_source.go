@@ -1,21 +1,24 @@
 package server
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/paging"
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/verify"
 )
 
 func DataSourceNcloudBlockStorageSnapshot() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNcloudBlockStorageSnapshotRead,
+		ReadContext: dataSourceNcloudBlockStorageSnapshotRead,
 
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -49,15 +52,15 @@ func DataSourceNcloudBlockStorageSnapshot() *schema.Resource {
 	}
 }
 
-func dataSourceNcloudBlockStorageSnapshotRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceNcloudBlockStorageSnapshotRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*conn.ProviderConfig)
-	instances, err := GetBlockStorageSnapshot(d, config)
+	instances, err := GetBlockStorageSnapshot(ctx, d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if len(instances) < 1 {
-		return fmt.Errorf("no results. please change search criteria and try again")
+		return diag.FromErr(fmt.Errorf("no results. please change search criteria and try again"))
 	}
 
 	resources := ConvertToArrayMap(instances)
@@ -66,7 +69,7 @@ func dataSourceNcloudBlockStorageSnapshotRead(d *schema.ResourceData, meta inter
 	}
 
 	if err := ValidateOneResult(len(resources)); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(resources[0]["snapshot_no"].(string))
@@ -75,16 +78,16 @@ func dataSourceNcloudBlockStorageSnapshotRead(d *schema.ResourceData, meta inter
 	return nil
 }
 
-func GetBlockStorageSnapshot(d *schema.ResourceData, config *conn.ProviderConfig) ([]*BlockStorageSnapshot, error) {
+func GetBlockStorageSnapshot(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) ([]*BlockStorageSnapshot, error) {
 	if config.SupportVPC {
-		return getVpcBlockStorageSnapshot(d, config)
+		return getVpcBlockStorageSnapshot(ctx, d, config)
 	}
 
-	return getClassicBlockStorageSnapshot(d, config)
+	return getClassicBlockStorageSnapshot(ctx, d, config)
 }
 
-func getClassicBlockStorageSnapshot(d *schema.ResourceData, config *conn.ProviderConfig) ([]*BlockStorageSnapshot, error) {
-	regionNo, err := conn.ParseRegionNoParameter(d)
+func getClassicBlockStorageSnapshot(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) ([]*BlockStorageSnapshot, error) {
+	regionNo, err := conn.ParseRegionNoParameter(config, d)
 	if err != nil {
 		return nil, err
 	}
@@ -101,16 +104,26 @@ func getClassicBlockStorageSnapshot(d *schema.ResourceData, config *conn.Provide
 		reqParams.BlockStorageSnapshotInstanceNoList = []*string{ncloud.String(v.(string))}
 	}
 
-	LogCommonRequest("getClassicBlockStorageSnapshot", reqParams)
-	resp, err := config.Client.Server.V2Api.GetBlockStorageSnapshotInstanceList(reqParams)
+	blockStorageSnapshotInstanceList, err := paging.All(ctx, func(ctx context.Context, pageNo, pageSize int32) ([]*server.BlockStorageSnapshotInstance, *int32, error) {
+		reqParams.PageNo = ncloud.Int32(pageNo)
+		reqParams.PageSize = ncloud.Int32(pageSize)
+
+		ctx = LogCommonRequest(ctx, "getClassicBlockStorageSnapshot", reqParams)
+		resp, err := config.Client.Server.V2Api.GetBlockStorageSnapshotInstanceList(reqParams)
+		if err != nil {
+			LogErrorResponse(ctx, "getClassicBlockStorageSnapshot", err, reqParams)
+			return nil, nil, err
+		}
+		LogResponse(ctx, "getClassicBlockStorageSnapshot", resp)
+
+		return resp.BlockStorageSnapshotInstanceList, resp.TotalRows, nil
+	})
 	if err != nil {
-		LogErrorResponse("getClassicBlockStorageSnapshot", err, reqParams)
 		return nil, err
 	}
-	LogResponse("getClassicBlockStorageSnapshot", resp)
 
 	var list []*BlockStorageSnapshot
-	for _, r := range resp.BlockStorageSnapshotInstanceList {
+	for _, r := range blockStorageSnapshotInstanceList {
 		list = append(list, convertClassicSnapshotInstance(r))
 	}
 
@@ -131,7 +144,7 @@ func convertClassicSnapshotInstance(r *server.BlockStorageSnapshotInstance) *Blo
 	}
 }
 
-func getVpcBlockStorageSnapshot(d *schema.ResourceData, config *conn.ProviderConfig) ([]*BlockStorageSnapshot, error) {
+func getVpcBlockStorageSnapshot(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) ([]*BlockStorageSnapshot, error) {
 	reqParams := &vserver.GetBlockStorageSnapshotInstanceListRequest{
 		RegionCode: &config.RegionCode,
 	}
@@ -144,16 +157,26 @@ func getVpcBlockStorageSnapshot(d *schema.ResourceData, config *conn.ProviderCon
 		reqParams.BlockStorageSnapshotInstanceNoList = []*string{ncloud.String(v.(string))}
 	}
 
-	LogCommonRequest("getVpcBlockStorageSnapshot", reqParams)
-	resp, err := config.Client.Vserver.V2Api.GetBlockStorageSnapshotInstanceList(reqParams)
+	blockStorageSnapshotInstanceList, err := paging.All(ctx, func(ctx context.Context, pageNo, pageSize int32) ([]*vserver.BlockStorageSnapshotInstance, *int32, error) {
+		reqParams.PageNo = ncloud.Int32(pageNo)
+		reqParams.PageSize = ncloud.Int32(pageSize)
+
+		ctx = LogCommonRequest(ctx, "getVpcBlockStorageSnapshot", reqParams)
+		resp, err := config.Client.Vserver.V2Api.GetBlockStorageSnapshotInstanceList(reqParams)
+		if err != nil {
+			LogErrorResponse(ctx, "getVpcBlockStorageSnapshot", err, reqParams)
+			return nil, nil, err
+		}
+		LogResponse(ctx, "getVpcBlockStorageSnapshot", resp)
+
+		return resp.BlockStorageSnapshotInstanceList, resp.TotalRows, nil
+	})
 	if err != nil {
-		LogErrorResponse("getVpcBlockStorageSnapshot", err, reqParams)
 		return nil, err
 	}
-	LogResponse("getVpcBlockStorageSnapshot", resp)
 
 	var list []*BlockStorageSnapshot
-	for _, r := range resp.BlockStorageSnapshotInstanceList {
+	for _, r := range blockStorageSnapshotInstanceList {
 		list = append(list, convertVpcSnapshotInstance(r))
 	}
 
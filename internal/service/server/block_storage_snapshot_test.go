@@ -1,13 +1,13 @@
 package server_test
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
 	"testing"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -19,7 +19,7 @@ import (
 )
 
 func TestAccResourceNcloudBlockStorageSnapshot_vpc_basic(t *testing.T) {
-	name := fmt.Sprintf("tf-snap-%s", acctest.RandString(5))
+	name := fmt.Sprintf("tf-snap-%s", RandString(t, 5))
 	resourceName := "ncloud_block_storage_snapshot.snapshot"
 	hypervisorType := "KVM"
 	serverSpec := "s2-g3"
@@ -55,7 +55,7 @@ func testAccCheckVpcBlockStorageSnapshotDestroy(s *terraform.State) error {
 		if rs.Type != "ncloud_block_storage_snapshot" {
 			continue
 		}
-		snapshot, err := server.GetVpcBlockStorageSnapshotDetail(config, rs.Primary.ID)
+		snapshot, err := server.GetVpcBlockStorageSnapshotDetail(context.Background(), config, rs.Primary.ID)
 
 		if err != nil {
 			return err
@@ -73,7 +73,7 @@ func testAccCheckVpcBlockStorageSnapshotDestroy(s *terraform.State) error {
 //nolint:unused
 func ignore_TestAccResourceNcloudBlockStorageSnapshotBasic(t *testing.T) {
 	var snapshotInstance *serverservice.BlockStorageSnapshot
-	prefix := GetTestPrefix()
+	prefix := GetTestPrefix(t)
 	testLoginKeyName := prefix + "-key"
 	testServerInstanceName := prefix + "-vm"
 	testBlockStorageName := prefix + "-storage"
@@ -132,7 +132,7 @@ func testAccCheckBlockStorageSnapshotExistsWithProvider(n string, i *serverservi
 
 		provider := providerF()
 		config := provider.Meta().(*conn.ProviderConfig)
-		snapshot, err := serverservice.GetClassicBlockStorageSnapshotInstance(config, rs.Primary.ID)
+		snapshot, err := serverservice.GetClassicBlockStorageSnapshotInstance(context.Background(), config, rs.Primary.ID)
 		log.Printf("[DEBUG] testAccCheckBlockStorageSnapshotExistsWithProvider snapshot %#v", snapshot)
 
 		if err != nil {
@@ -162,7 +162,7 @@ func testAccCheckBlockStorageSnapshotDestroyWithProvider(s *terraform.State, pro
 			continue
 		}
 		log.Printf("[DEBUG] testAccCheckBlockStorageSnapshotDestroyWithProvider getBlockStorageSnapshotInstance %s", rs.Primary.ID)
-		snapshot, err := serverservice.GetClassicBlockStorageSnapshotInstance(config, rs.Primary.ID)
+		snapshot, err := serverservice.GetClassicBlockStorageSnapshotInstance(context.Background(), config, rs.Primary.ID)
 		log.Printf("[DEBUG] testAccCheckBlockStorageSnapshotDestroyWithProvider snapshot %#v", snapshot)
 		if snapshot == nil {
 			return nil
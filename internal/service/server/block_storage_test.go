@@ -1,12 +1,11 @@
 package server_test
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
-
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -21,7 +20,7 @@ func TestAccResourceNcloudBlockStorage_classic_basic(t *testing.T) {
 	t.Skip()
 
 	var storageInstance server.BlockStorage
-	name := fmt.Sprintf("tf-storage-basic-%s", acctest.RandString(5))
+	name := fmt.Sprintf("tf-storage-basic-%s", RandString(t, 5))
 	resourceName := "ncloud_block_storage.storage"
 
 	resource.Test(t, resource.TestCase{
@@ -61,7 +60,7 @@ func TestAccResourceNcloudBlockStorage_classic_basic(t *testing.T) {
 
 func TestAccResourceNcloudBlockStorage_vpc_basic(t *testing.T) {
 	var storageInstance server.BlockStorage
-	name := fmt.Sprintf("tf-storage-basic-%s", acctest.RandString(5))
+	name := fmt.Sprintf("tf-storage-basic-%s", RandString(t, 5))
 	resourceName := "ncloud_block_storage.storage"
 
 	resource.Test(t, resource.TestCase{
@@ -98,7 +97,7 @@ func TestAccResourceNcloudBlockStorage_vpc_basic(t *testing.T) {
 
 func TestAccResourceNcloudBlockStorage_vpc_kvm(t *testing.T) {
 	var storageInstance server.BlockStorage
-	name := fmt.Sprintf("tf-storage-kvm-%s", acctest.RandString(5))
+	name := fmt.Sprintf("tf-storage-kvm-%s", RandString(t, 5))
 	resourceName := "ncloud_block_storage.storage"
 	zone := "KR-2"
 	volumeType := "CB1"
@@ -138,7 +137,7 @@ func TestAccResourceNcloudBlockStorage_classic_ChangeServerInstance(t *testing.T
 	t.Skip()
 
 	var storageInstance server.BlockStorage
-	name := fmt.Sprintf("tf-storage-update-%s", acctest.RandString(5))
+	name := fmt.Sprintf("tf-storage-update-%s", RandString(t, 5))
 	resourceName := "ncloud_block_storage.storage"
 
 	resource.Test(t, resource.TestCase{
@@ -166,7 +165,7 @@ func TestAccResourceNcloudBlockStorage_classic_ChangeServerInstance(t *testing.T
 
 func TestAccResourceNcloudBlockStorage_vpc_ChangeServerInstance(t *testing.T) {
 	var storageInstance server.BlockStorage
-	name := fmt.Sprintf("tf-storage-update-%s", acctest.RandString(5))
+	name := fmt.Sprintf("tf-storage-update-%s", RandString(t, 5))
 	resourceName := "ncloud_block_storage.storage"
 
 	resource.Test(t, resource.TestCase{
@@ -195,7 +194,7 @@ func TestAccResourceNcloudBlockStorage_classic_size(t *testing.T) {
 	t.Skip()
 
 	var storageInstance server.BlockStorage
-	name := fmt.Sprintf("tf-storage-size-%s", acctest.RandString(5))
+	name := fmt.Sprintf("tf-storage-size-%s", RandString(t, 5))
 	resourceName := "ncloud_block_storage.storage"
 
 	resource.Test(t, resource.TestCase{
@@ -206,29 +205,33 @@ func TestAccResourceNcloudBlockStorage_classic_size(t *testing.T) {
 		},
 		Steps: []resource.TestStep{
 			{
-				Config:      testAccBlockStorageClassicConfigWithSize(name, 5),
+				Config:      testAccBlockStorageClassicConfigWithSize(name, 5, false),
 				ExpectError: regexp.MustCompile(`expected size to be at least \(10\), got 5`),
 			},
 			{
-				Config: testAccBlockStorageClassicConfigWithSize(name, 10),
+				Config: testAccBlockStorageClassicConfigWithSize(name, 10, false),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckBlockStorageExistsWithProvider(resourceName, &storageInstance, GetTestProvider(false)),
 					resource.TestCheckResourceAttr(resourceName, "size", "10"),
 				),
 			},
 			{
-				Config: testAccBlockStorageClassicConfigWithSize(name, 20),
+				Config:      testAccBlockStorageClassicConfigWithSize(name, 20, false),
+				ExpectError: regexp.MustCompile("requires `stop_instance_before_detaching`"),
+			},
+			{
+				Config: testAccBlockStorageClassicConfigWithSize(name, 20, true),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckBlockStorageExistsWithProvider(resourceName, &storageInstance, GetTestProvider(false)),
 					resource.TestCheckResourceAttr(resourceName, "size", "20"),
 				),
 			},
 			{
-				Config:      testAccBlockStorageClassicConfigWithSize(name, 10),
+				Config:      testAccBlockStorageClassicConfigWithSize(name, 10, false),
 				ExpectError: regexp.MustCompile("The storage size is only expandable, not shrinking."),
 			},
 			{
-				Config: testAccBlockStorageClassicConfigWithSize(name, 2000),
+				Config: testAccBlockStorageClassicConfigWithSize(name, 2000, true),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckBlockStorageExistsWithProvider(resourceName, &storageInstance, GetTestProvider(false)),
 					resource.TestCheckResourceAttr(resourceName, "size", "2000"),
@@ -240,7 +243,7 @@ func TestAccResourceNcloudBlockStorage_classic_size(t *testing.T) {
 
 func TestAccResourceNcloudBlockStorage_vpc_size(t *testing.T) {
 	var storageInstance server.BlockStorage
-	name := fmt.Sprintf("tf-storage-size-%s", acctest.RandString(5))
+	name := fmt.Sprintf("tf-storage-size-%s", RandString(t, 5))
 	resourceName := "ncloud_block_storage.storage"
 
 	resource.Test(t, resource.TestCase{
@@ -249,29 +252,33 @@ func TestAccResourceNcloudBlockStorage_vpc_size(t *testing.T) {
 		CheckDestroy:             testAccCheckBlockStorageDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config:      testAccBlockStorageVpcConfigWithSize(name+acctest.RandString(5), 5),
+				Config:      testAccBlockStorageVpcConfigWithSize(name+RandString(t, 5), 5, false),
 				ExpectError: regexp.MustCompile(`expected size to be at least \(10\), got 5`),
 			},
 			{
-				Config: testAccBlockStorageVpcConfigWithSize(name, 10),
+				Config: testAccBlockStorageVpcConfigWithSize(name, 10, false),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckBlockStorageExistsWithProvider(resourceName, &storageInstance, GetTestProvider(true)),
 					resource.TestCheckResourceAttr(resourceName, "size", "10"),
 				),
 			},
 			{
-				Config: testAccBlockStorageVpcConfigWithSize(name, 20),
+				Config:      testAccBlockStorageVpcConfigWithSize(name, 20, false),
+				ExpectError: regexp.MustCompile("requires `stop_instance_before_detaching`"),
+			},
+			{
+				Config: testAccBlockStorageVpcConfigWithSize(name, 20, true),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckBlockStorageExistsWithProvider(resourceName, &storageInstance, GetTestProvider(true)),
 					resource.TestCheckResourceAttr(resourceName, "size", "20"),
 				),
 			},
 			{
-				Config:      testAccBlockStorageVpcConfigWithSize(name, 10),
+				Config:      testAccBlockStorageVpcConfigWithSize(name, 10, false),
 				ExpectError: regexp.MustCompile("The storage size is only expandable, not shrinking."),
 			},
 			{
-				Config: testAccBlockStorageVpcConfigWithSize(name+acctest.RandString(5), 2000),
+				Config: testAccBlockStorageVpcConfigWithSize(name+RandString(t, 5), 2000, true),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckBlockStorageExistsWithProvider(resourceName, &storageInstance, GetTestProvider(true)),
 					resource.TestCheckResourceAttr(resourceName, "size", "2000"),
@@ -281,6 +288,47 @@ func TestAccResourceNcloudBlockStorage_vpc_size(t *testing.T) {
 	})
 }
 
+func TestAccResourceNcloudBlockStorage_vpc_kvmSize(t *testing.T) {
+	var before, after server.BlockStorage
+	name := fmt.Sprintf("tf-storage-kvm-%s", RandString(t, 5))
+	resourceName := "ncloud_block_storage.storage"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckBlockStorageDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBlockStorageVpcConfigKvmWithSize(name, "KR-2", "CB1", 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageExistsWithProvider(resourceName, &before, GetTestProvider(true)),
+					resource.TestCheckResourceAttr(resourceName, "size", "10"),
+				),
+			},
+			{
+				// KVM block storages are expanded in place, while attached to the server
+				Config: testAccBlockStorageVpcConfigKvmWithSize(name, "KR-2", "CB1", 20),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageExistsWithProvider(resourceName, &after, GetTestProvider(true)),
+					resource.TestCheckResourceAttr(resourceName, "size", "20"),
+					resource.TestCheckResourceAttr(resourceName, "status", "ATTAC"),
+					func(*terraform.State) error {
+						if *before.BlockStorageInstanceNo != *after.BlockStorageInstanceNo {
+							return fmt.Errorf("block storage was replaced: %s, %s", *before.BlockStorageInstanceNo, *after.BlockStorageInstanceNo)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				Config:      testAccBlockStorageVpcConfigKvmWithSize(name, "KR-2", "CB1", 10),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("The storage size is only expandable, not shrinking."),
+			},
+		},
+	})
+}
+
 func testAccCheckBlockStorageExistsWithProvider(n string, i *server.BlockStorage, provider *schema.Provider) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -293,7 +341,7 @@ func testAccCheckBlockStorageExistsWithProvider(n string, i *server.BlockStorage
 		}
 
 		config := provider.Meta().(*conn.ProviderConfig)
-		storage, err := server.GetBlockStorage(config, rs.Primary.ID)
+		storage, err := server.GetBlockStorage(context.Background(), config, rs.Primary.ID)
 		if err != nil {
 			return nil
 		}
@@ -318,7 +366,7 @@ func testAccCheckBlockStorageDestroyWithProvider(s *terraform.State, provider *s
 		if rs.Type != "ncloud_block_storage" {
 			continue
 		}
-		blockStorage, err := server.GetBlockStorage(config, rs.Primary.ID)
+		blockStorage, err := server.GetBlockStorage(context.Background(), config, rs.Primary.ID)
 
 		if blockStorage == nil {
 			continue
@@ -334,7 +382,7 @@ func testAccCheckBlockStorageDestroyWithProvider(s *terraform.State, provider *s
 	return nil
 }
 
-func testAccBlockStorageClassicConfigWithSize(name string, size int) string {
+func testAccBlockStorageClassicConfigWithSize(name string, size int, stopBeforeDetaching bool) string {
 	return fmt.Sprintf(`
 resource "ncloud_login_key" "loginkey" {
     key_name = "%[1]s-key"
@@ -351,15 +399,16 @@ resource "ncloud_block_storage" "storage" {
     server_instance_no = ncloud_server.server.id
     name = "%[1]s-tf"
     size = "%[2]d"
+    stop_instance_before_detaching = %[3]t
 }
-`, name, size)
+`, name, size, stopBeforeDetaching)
 }
 
 func testAccBlockStorageClassicConfig(name string) string {
-	return testAccBlockStorageClassicConfigWithSize(name, 10)
+	return testAccBlockStorageClassicConfigWithSize(name, 10, false)
 }
 
-func testAccBlockStorageVpcConfigWithSize(name string, size int) string {
+func testAccBlockStorageVpcConfigWithSize(name string, size int, stopBeforeDetaching bool) string {
 	return fmt.Sprintf(`
 resource "ncloud_login_key" "loginkey" {
 	key_name = "%[1]s-key"
@@ -401,12 +450,13 @@ resource "ncloud_block_storage" "storage" {
 	size = "%[2]d"
 	hypervisor_type = "XEN"
 	volume_type = "SSD"
+	stop_instance_before_detaching = %[3]t
 }
-`, name, size)
+`, name, size, stopBeforeDetaching)
 }
 
 func testAccBlockStorageVpcConfig(name string) string {
-	return testAccBlockStorageVpcConfigWithSize(name, 10)
+	return testAccBlockStorageVpcConfigWithSize(name, 10, false)
 }
 func testAccBlockStorageClassicConfigUpdate(name, serverInstanceNo string) string {
 	return fmt.Sprintf(`
@@ -489,6 +539,10 @@ resource "ncloud_block_storage" "storage" {
 }
 
 func testAccBlockStorageVpcConfigKvm(name string, zone string, volumeType string) string {
+	return testAccBlockStorageVpcConfigKvmWithSize(name, zone, volumeType, 10)
+}
+
+func testAccBlockStorageVpcConfigKvmWithSize(name string, zone string, volumeType string, size int) string {
 	return fmt.Sprintf(`
 resource "ncloud_login_key" "loginkey" {
 	key_name = "%[1]s-key"
@@ -528,10 +582,10 @@ resource "ncloud_server" "server" {
 resource "ncloud_block_storage" "storage" {
 	server_instance_no = ncloud_server.server.id
 	name = "%[1]s-tf"
-	size = "10"
+	size = "%[4]d"
 	hypervisor_type = "KVM"
 	volume_type = "%[3]s"
 	zone = "%[2]s"
 }
-`, name, zone, volumeType)
+`, name, zone, volumeType, size)
 }
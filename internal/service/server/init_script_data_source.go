@@ -3,6 +3,7 @@ package server
 import (
 	"context"
 	"fmt"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -14,6 +15,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/framework"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/paging"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/verify"
 )
 
@@ -113,11 +115,23 @@ func (i *initScriptDataSource) Read(ctx context.Context, req datasource.ReadRequ
 		reqParams.InitScriptName = data.Name.ValueStringPointer()
 	}
 
-	tflog.Info(ctx, "GetVpcInitScriptList", map[string]any{
-		"reqParams": common.MarshalUncheckedString(reqParams),
-	})
-	initScriptResp, err := i.config.Client.Vserver.V2Api.GetInitScriptList(reqParams)
+	initScripts, err := paging.All(ctx, func(ctx context.Context, pageNo, pageSize int32) ([]*vserver.InitScript, *int32, error) {
+		reqParams.PageNo = ncloud.Int32(pageNo)
+		reqParams.PageSize = ncloud.Int32(pageSize)
+
+		tflog.Info(ctx, "GetVpcInitScriptList", map[string]any{
+			"reqParams": common.MarshalUncheckedString(reqParams),
+		})
+		initScriptResp, err := i.config.Client.Vserver.V2Api.GetInitScriptList(reqParams)
+		if err != nil {
+			return nil, nil, err
+		}
+		tflog.Info(ctx, "GetVpcInitScriptList response", map[string]any{
+			"initScriptResponse": common.MarshalUncheckedString(initScriptResp),
+		})
 
+		return initScriptResp.InitScriptList, initScriptResp.TotalRows, nil
+	})
 	if err != nil {
 		var diags diag.Diagnostics
 		diags.AddError(
@@ -127,11 +141,8 @@ func (i *initScriptDataSource) Read(ctx context.Context, req datasource.ReadRequ
 		resp.Diagnostics.Append(diags...)
 		return
 	}
-	tflog.Info(ctx, "GetVpcInitScriptList response", map[string]any{
-		"initScriptResponse": common.MarshalUncheckedString(initScriptResp),
-	})
 
-	initScriptList, diags := flattenNatGateways(initScriptResp.InitScriptList)
+	initScriptList, diags := flattenNatGateways(initScripts)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
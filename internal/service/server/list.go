@@ -1,6 +1,8 @@
 package server
 
 import (
+	"sort"
+
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
 
@@ -36,6 +38,43 @@ func expandTagListParams(tl []interface{}) ([]*server.InstanceTagParameter, erro
 	return tagList, nil
 }
 
+func expandInstanceTagParameters(tags map[string]string) []*server.InstanceTagParameter {
+	keys := make([]string, 0, len(tags))
+	for k := range tags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	tagList := make([]*server.InstanceTagParameter, 0, len(keys))
+	for _, k := range keys {
+		tagList = append(tagList, &server.InstanceTagParameter{
+			TagKey:   ncloud.String(k),
+			TagValue: ncloud.String(tags[k]),
+		})
+	}
+
+	return tagList
+}
+
+// flattenServerInstanceTags returns the instance tags except the ones managed by tag_list
+func flattenServerInstanceTags(instanceTags []*server.InstanceTag, tagList []interface{}) map[string]string {
+	managed := map[string]bool{}
+	for _, v := range tagList {
+		if tag, ok := v.(map[string]interface{}); ok {
+			managed[tag["tag_key"].(string)] = true
+		}
+	}
+
+	tags := map[string]string{}
+	for _, tag := range instanceTags {
+		if key := ncloud.StringValue(tag.TagKey); !managed[key] {
+			tags[key] = ncloud.StringValue(tag.TagValue)
+		}
+	}
+
+	return tags
+}
+
 func flattenMapByKey(i interface{}, key string) *string {
 	m := ConvertToMap(i)
 	if m[key] != nil {
@@ -132,3 +132,19 @@ func TestFlattenMapByKey(t *testing.T) {
 		t.Fatalf("result expected 'test' but was %s", *result)
 	}
 }
+
+func TestFlattenServerInstanceTags(t *testing.T) {
+	instanceTags := []*server.InstanceTag{
+		{TagKey: ncloud.String("env"), TagValue: ncloud.String("prod")},
+		{TagKey: ncloud.String("legacy"), TagValue: ncloud.String("yes")},
+	}
+	tagList := []interface{}{
+		map[string]interface{}{"tag_key": "legacy", "tag_value": "yes"},
+	}
+
+	result := flattenServerInstanceTags(instanceTags, tagList)
+
+	if len(result) != 1 || result["env"] != "prod" {
+		t.Fatalf("expected only the tags not managed by tag_list, got %#v", result)
+	}
+}
@@ -11,7 +11,6 @@ import (
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	sdkresource "github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -24,6 +23,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/framework"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/waiter"
 )
 
 var (
@@ -126,7 +126,7 @@ func (l *loginKeyResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
-	output, err := waitForNcloudLoginKeyCreation(l.config, *keyName)
+	output, err := waitForNcloudLoginKeyCreation(ctx, l.config, *keyName)
 	if err != nil {
 		resp.Diagnostics.AddError("waiting for LoginKey creation", err.Error())
 		return
@@ -215,13 +215,13 @@ func createVpcLoginKey(ctx context.Context, config *conn.ProviderConfig, keyName
 	return resp.PrivateKey, err
 }
 
-func waitForNcloudLoginKeyCreation(config *conn.ProviderConfig, keyName string) (*LoginKey, error) {
+func waitForNcloudLoginKeyCreation(ctx context.Context, config *conn.ProviderConfig, keyName string) (*LoginKey, error) {
 	var loginkey *LoginKey
 
-	stateConf := &sdkresource.StateChangeConf{
+	stateConf := &waiter.Config[any]{
 		Pending: []string{""},
 		Target:  []string{"OK"},
-		Refresh: func() (interface{}, string, error) {
+		Refresh: func(ctx context.Context) (any, string, error) {
 			resp, err := GetLoginKey(config, keyName)
 			loginkey = resp
 			if err != nil {
@@ -234,12 +234,12 @@ func waitForNcloudLoginKeyCreation(config *conn.ProviderConfig, keyName string)
 
 			return resp, "", nil
 		},
-		Timeout:    conn.DefaultTimeout,
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Timeout:     conn.DefaultTimeout,
+		Delay:       2 * time.Second,
+		MinInterval: 3 * time.Second,
 	}
 
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForState(ctx); err != nil {
 		return nil, fmt.Errorf("error waiting for Loginkey (%s) to become available: %s", keyName, err)
 	}
 
@@ -321,7 +321,7 @@ func deleteClassicLoginKey(ctx context.Context, config *conn.ProviderConfig, key
 
 	resp, err := config.Client.Server.V2Api.DeleteLoginKey(reqParams)
 	if err != nil {
-		common.LogErrorResponse("deleteClassicLoginKey", err, keyName)
+		common.LogErrorResponse(ctx, "deleteClassicLoginKey", err, keyName)
 		return err
 	}
 
@@ -329,10 +329,10 @@ func deleteClassicLoginKey(ctx context.Context, config *conn.ProviderConfig, key
 		"deleteClassicLoginKeyResponse": common.MarshalUncheckedString(resp),
 	})
 
-	stateConf := &sdkresource.StateChangeConf{
+	stateConf := &waiter.Config[any]{
 		Pending: []string{""},
 		Target:  []string{"OK"},
-		Refresh: func() (interface{}, string, error) {
+		Refresh: func(ctx context.Context) (any, string, error) {
 			resp, err := getClassicLoginKey(config, keyName)
 			if err != nil {
 				return 0, "", err
@@ -344,12 +344,12 @@ func deleteClassicLoginKey(ctx context.Context, config *conn.ProviderConfig, key
 
 			return resp, "", nil
 		},
-		Timeout:    conn.DefaultTimeout,
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Timeout:     conn.DefaultTimeout,
+		Delay:       2 * time.Second,
+		MinInterval: 3 * time.Second,
 	}
 
-	_, err = stateConf.WaitForState()
+	_, err = stateConf.WaitForState(ctx)
 	if err != nil {
 		return fmt.Errorf("error waiting to delete LoginKey: %v", err)
 	}
@@ -365,17 +365,17 @@ func deleteVpcLoginKey(ctx context.Context, config *conn.ProviderConfig, keyName
 
 	resp, err := config.Client.Vserver.V2Api.DeleteLoginKeys(reqParams)
 	if err != nil {
-		common.LogErrorResponse("deleteVpcLoginKey", err, keyName)
+		common.LogErrorResponse(ctx, "deleteVpcLoginKey", err, keyName)
 		return err
 	}
 	tflog.Info(ctx, "DeleteVpcLoginKey response", map[string]any{
 		"deleteVpcLoginKeyResponse": common.MarshalUncheckedString(resp),
 	})
 
-	stateConf := &sdkresource.StateChangeConf{
+	stateConf := &waiter.Config[any]{
 		Pending: []string{""},
 		Target:  []string{"OK"},
-		Refresh: func() (interface{}, string, error) {
+		Refresh: func(ctx context.Context) (any, string, error) {
 			resp, err := getVpcLoginKey(config, keyName)
 			if err != nil {
 				return 0, "", err
@@ -387,12 +387,12 @@ func deleteVpcLoginKey(ctx context.Context, config *conn.ProviderConfig, keyName
 
 			return resp, "", nil
 		},
-		Timeout:    conn.DefaultTimeout,
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Timeout:     conn.DefaultTimeout,
+		Delay:       2 * time.Second,
+		MinInterval: 3 * time.Second,
 	}
 
-	_, err = stateConf.WaitForState()
+	_, err = stateConf.WaitForState(ctx)
 	if err != nil {
 		return fmt.Errorf("error waiting to delete LoginKey: %v", err)
 	}
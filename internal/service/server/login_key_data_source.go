@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -13,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/paging"
 )
 
 var (
@@ -90,7 +92,7 @@ func (d *loginKeyDataSource) Read(ctx context.Context, req datasource.ReadReques
 		return
 	}
 
-	output, err := GetLoginKeyList(d.config)
+	output, err := GetLoginKeyList(ctx, d.config)
 	if err != nil {
 		resp.Diagnostics.AddError("READING ERROR", err.Error())
 		return
@@ -194,27 +196,37 @@ func (d *loginKeyModel) refreshFromOutput(output *loginKeyStruct) {
 	d.CreateDate = types.StringPointerValue(output.CreateDate)
 }
 
-func GetLoginKeyList(config *conn.ProviderConfig) ([]*loginKeyStruct, error) {
+func GetLoginKeyList(ctx context.Context, config *conn.ProviderConfig) ([]*loginKeyStruct, error) {
 	if config.SupportVPC {
-		return getVpcLoginKeyList(config)
+		return getVpcLoginKeyList(ctx, config)
 	} else {
-		return getClassicLoginKeyList(config)
+		return getClassicLoginKeyList(ctx, config)
 	}
 }
 
-func getVpcLoginKeyList(config *conn.ProviderConfig) ([]*loginKeyStruct, error) {
-	resp, err := config.Client.Vserver.V2Api.GetLoginKeyList(&vserver.GetLoginKeyListRequest{})
+func getVpcLoginKeyList(ctx context.Context, config *conn.ProviderConfig) ([]*loginKeyStruct, error) {
+	reqParams := &vserver.GetLoginKeyListRequest{}
 
+	loginKeyList, err := paging.All(ctx, func(ctx context.Context, pageNo, pageSize int32) ([]*vserver.LoginKey, *int32, error) {
+		reqParams.PageNo = ncloud.Int32(pageNo)
+		reqParams.PageSize = ncloud.Int32(pageSize)
+
+		resp, err := config.Client.Vserver.V2Api.GetLoginKeyList(reqParams)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.LoginKeyList, resp.TotalRows, nil
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	if len(resp.LoginKeyList) < 1 {
+	if len(loginKeyList) < 1 {
 		return nil, nil
 	}
 
 	var loginKeys []*loginKeyStruct
-	for _, l := range resp.LoginKeyList {
+	for _, l := range loginKeyList {
 		loginKeys = append(loginKeys, &loginKeyStruct{
 			KeyName:     l.KeyName,
 			Fingerprint: l.Fingerprint,
@@ -225,19 +237,29 @@ func getVpcLoginKeyList(config *conn.ProviderConfig) ([]*loginKeyStruct, error)
 	return loginKeys, nil
 }
 
-func getClassicLoginKeyList(config *conn.ProviderConfig) ([]*loginKeyStruct, error) {
-	resp, err := config.Client.Server.V2Api.GetLoginKeyList(&server.GetLoginKeyListRequest{})
+func getClassicLoginKeyList(ctx context.Context, config *conn.ProviderConfig) ([]*loginKeyStruct, error) {
+	reqParams := &server.GetLoginKeyListRequest{}
 
+	loginKeyList, err := paging.All(ctx, func(ctx context.Context, pageNo, pageSize int32) ([]*server.LoginKey, *int32, error) {
+		reqParams.PageNo = ncloud.Int32(pageNo)
+		reqParams.PageSize = ncloud.Int32(pageSize)
+
+		resp, err := config.Client.Server.V2Api.GetLoginKeyList(reqParams)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.LoginKeyList, resp.TotalRows, nil
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	if len(resp.LoginKeyList) < 1 {
+	if len(loginKeyList) < 1 {
 		return nil, nil
 	}
 
 	var loginKeys []*loginKeyStruct
-	for _, l := range resp.LoginKeyList {
+	for _, l := range loginKeyList {
 		loginKeys = append(loginKeys, &loginKeyStruct{
 			KeyName:     l.KeyName,
 			Fingerprint: l.Fingerprint,
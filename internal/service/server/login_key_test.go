@@ -24,7 +24,7 @@ func TestAccResourceNcloudLoginKey_vpc_basic(t *testing.T) {
 
 func testAccResourceNcloudLoginKeyBasic(t *testing.T, isVpc bool) {
 	var loginKey *server.LoginKey
-	prefix := GetTestPrefix()
+	prefix := GetTestPrefix(t)
 	testKeyName := prefix + "-key"
 
 	testCheck := func() func(*terraform.State) error {
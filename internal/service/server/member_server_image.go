@@ -0,0 +1,532 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	. "github.com/terraform-providers/terraform-provider-ncloud/internal/common"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/waiter"
+)
+
+const (
+	MemberServerImageStatusCodeInit       = "INIT"
+	MemberServerImageStatusCodeCreate     = "CREAT"
+	MemberServerImageStatusCodeTerminated = "TERMINATED"
+)
+
+func ResourceNcloudMemberServerImage() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNcloudMemberServerImageCreate,
+		ReadContext:   resourceNcloudMemberServerImageRead,
+		UpdateContext: resourceNcloudMemberServerImageUpdate,
+		DeleteContext: resourceNcloudMemberServerImageDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(conn.DefaultCreateTimeout),
+			Delete: schema.DefaultTimeout(conn.DefaultTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"server_instance_no": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Server instance No to create the image of.",
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.All(
+					validation.StringLenBetween(3, 30),
+					validation.StringMatch(regexp.MustCompile(`^[a-z]+[a-z0-9-]+[a-z0-9]$`), "Allows only lowercase letters(a-z), numbers, hyphen (-). Must start with an alphabetic character, must end with an English letter or number"),
+				)),
+				Description: "Member server image name to create. default : Ncloud assigns default values.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Member server image description to create.",
+			},
+			"stop_server_before_create": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Stop the running server before creating the image, for a consistent file system, and start it again afterward.",
+			},
+			"shared_login_id_list": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Login IDs of the accounts the image is shared with.",
+			},
+
+			"original_server_image_product_code": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Original server image product code",
+			},
+			"block_storage_total_rows": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Member server image block storage total rows",
+			},
+			"block_storage_total_size": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Member server image block storage total size",
+			},
+			"share_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Share status code of the member server image",
+			},
+			"create_date": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Creation date of the member server image",
+			},
+		},
+	}
+}
+
+func resourceNcloudMemberServerImageCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	config := meta.(*conn.ProviderConfig)
+	serverInstanceNo := d.Get("server_instance_no").(string)
+
+	restart := false
+	if d.Get("stop_server_before_create").(bool) {
+		serverInstance, err := GetServerInstance(ctx, config, serverInstanceNo)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if serverInstance == nil {
+			return diag.Errorf("fail to get Server instance, %s doesn't exist", serverInstanceNo)
+		}
+
+		if ncloud.StringValue(serverInstance.ServerInstanceStatus) != "NSTOP" {
+			log.Printf("[INFO] Stopping Instance %q for member server image creation", serverInstanceNo)
+			if err := stopThenWaitServerInstance(ctx, config, serverInstanceNo); err != nil {
+				return diag.FromErr(err)
+			}
+			restart = true
+		}
+	}
+
+	// Brings the stopped server back up whether or not the image has been created
+	if restart {
+		defer func() {
+			log.Printf("[INFO] Start Instance %q after member server image creation", serverInstanceNo)
+			if err := startThenWaitServerInstance(ctx, config, serverInstanceNo); err != nil {
+				diags = append(diags, diag.FromErr(err)...)
+			}
+		}()
+	}
+
+	var id *string
+	var err error
+	if config.SupportVPC {
+		id, err = createVpcMemberServerImage(ctx, d, config)
+	} else {
+		id, err = createClassicMemberServerImage(ctx, d, config)
+	}
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(ncloud.StringValue(id))
+
+	if err := waitForMemberServerImageCreation(ctx, config, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if v, ok := d.GetOk("shared_login_id_list"); ok && v.(*schema.Set).Len() > 0 {
+		if err := setMemberServerImageSharingPermission(ctx, config, d.Id(), ExpandStringSet(v.(*schema.Set))); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceNcloudMemberServerImageRead(ctx, d, meta)
+}
+
+func resourceNcloudMemberServerImageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*conn.ProviderConfig)
+
+	r, err := GetMemberServerImage(ctx, config, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if r == nil {
+		log.Printf("unable to find resource: %s", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("server_instance_no", ncloud.StringValue(r.OriginalServerInstanceNo))
+	d.Set("name", ncloud.StringValue(r.MemberServerImageName))
+	d.Set("description", ncloud.StringValue(r.MemberServerImageDescription))
+	d.Set("shared_login_id_list", ncloud.StringListValue(r.SharedLoginIdList))
+	d.Set("original_server_image_product_code", ncloud.StringValue(r.OriginalServerImageProductCode))
+	d.Set("block_storage_total_rows", ncloud.Int32Value(r.BlockStorageTotalRows))
+	d.Set("block_storage_total_size", ncloud.Int64Value(r.BlockStorageTotalSize))
+	d.Set("share_status", ncloud.StringValue(r.ShareStatus))
+	d.Set("create_date", ncloud.StringValue(r.CreateDate))
+
+	return nil
+}
+
+func resourceNcloudMemberServerImageUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*conn.ProviderConfig)
+
+	if d.HasChange("shared_login_id_list") {
+		o, n := d.GetChange("shared_login_id_list")
+
+		var err error
+		if n.(*schema.Set).Len() > 0 {
+			err = setMemberServerImageSharingPermission(ctx, config, d.Id(), ExpandStringSet(n.(*schema.Set)))
+		} else {
+			err = removeMemberServerImageSharingPermission(ctx, config, d.Id(), ExpandStringSet(o.(*schema.Set)))
+		}
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceNcloudMemberServerImageRead(ctx, d, meta)
+}
+
+func resourceNcloudMemberServerImageDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var err error
+	config := meta.(*conn.ProviderConfig)
+
+	if config.SupportVPC {
+		err = deleteVpcMemberServerImage(ctx, config, d.Id())
+	} else {
+		err = deleteClassicMemberServerImage(ctx, config, d.Id())
+	}
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := waitForMemberServerImageDeletion(ctx, config, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func createVpcMemberServerImage(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) (*string, error) {
+	reqParams := &vserver.CreateMemberServerImageInstanceRequest{
+		RegionCode:                   &config.RegionCode,
+		ServerInstanceNo:             ncloud.String(d.Get("server_instance_no").(string)),
+		MemberServerImageName:        StringPtrOrNil(d.GetOk("name")),
+		MemberServerImageDescription: StringPtrOrNil(d.GetOk("description")),
+	}
+
+	ctx = LogCommonRequest(ctx, "createVpcMemberServerImage", reqParams)
+	resp, err := config.Client.Vserver.V2Api.CreateMemberServerImageInstance(reqParams)
+	if err != nil {
+		LogErrorResponse(ctx, "createVpcMemberServerImage", err, reqParams)
+		return nil, err
+	}
+	LogResponse(ctx, "createVpcMemberServerImage", resp)
+
+	if resp == nil || len(resp.MemberServerImageInstanceList) < 1 {
+		return nil, fmt.Errorf("response invalid")
+	}
+
+	return resp.MemberServerImageInstanceList[0].MemberServerImageInstanceNo, nil
+}
+
+func createClassicMemberServerImage(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) (*string, error) {
+	reqParams := &server.CreateMemberServerImageRequest{
+		ServerInstanceNo:             ncloud.String(d.Get("server_instance_no").(string)),
+		MemberServerImageName:        StringPtrOrNil(d.GetOk("name")),
+		MemberServerImageDescription: StringPtrOrNil(d.GetOk("description")),
+	}
+
+	ctx = LogCommonRequest(ctx, "createClassicMemberServerImage", reqParams)
+	resp, err := config.Client.Server.V2Api.CreateMemberServerImage(reqParams)
+	if err != nil {
+		LogErrorResponse(ctx, "createClassicMemberServerImage", err, reqParams)
+		return nil, err
+	}
+	LogResponse(ctx, "createClassicMemberServerImage", resp)
+
+	if resp == nil || len(resp.MemberServerImageList) < 1 {
+		return nil, fmt.Errorf("response invalid")
+	}
+
+	return resp.MemberServerImageList[0].MemberServerImageNo, nil
+}
+
+func waitForMemberServerImageCreation(ctx context.Context, config *conn.ProviderConfig, id string, timeout time.Duration) error {
+	stateConf := &waiter.Config[*MemberServerImage]{
+		Pending: []string{MemberServerImageStatusCodeInit},
+		Target:  []string{MemberServerImageStatusCodeCreate},
+		Refresh: func(ctx context.Context) (*MemberServerImage, string, error) {
+			image, err := GetMemberServerImage(ctx, config, id)
+			if err != nil {
+				return nil, "", err
+			}
+
+			if image == nil {
+				return nil, "", fmt.Errorf("fail to get member server image, %s doesn't exist", id)
+			}
+
+			return image, ncloud.StringValue(image.Status), nil
+		},
+		Timeout:     timeout,
+		Delay:       5 * time.Second,
+		MinInterval: 5 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(ctx); err != nil {
+		return fmt.Errorf("error waiting for member server image state to be \"CREAT\": %s", err)
+	}
+
+	return nil
+}
+
+func waitForMemberServerImageDeletion(ctx context.Context, config *conn.ProviderConfig, id string, timeout time.Duration) error {
+	stateConf := &waiter.Config[*MemberServerImage]{
+		Pending: []string{MemberServerImageStatusCodeInit, MemberServerImageStatusCodeCreate},
+		Target:  []string{MemberServerImageStatusCodeTerminated},
+		Refresh: func(ctx context.Context) (*MemberServerImage, string, error) {
+			image, err := GetMemberServerImage(ctx, config, id)
+			if err != nil {
+				return nil, "", err
+			}
+
+			if image == nil {
+				return &MemberServerImage{}, MemberServerImageStatusCodeTerminated, nil
+			}
+
+			return image, ncloud.StringValue(image.Status), nil
+		},
+		Timeout:     timeout,
+		Delay:       2 * time.Second,
+		MinInterval: 3 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(ctx); err != nil {
+		return fmt.Errorf("error waiting for member server image state to be \"TERMINATED\": %s", err)
+	}
+
+	return nil
+}
+
+func GetMemberServerImage(ctx context.Context, config *conn.ProviderConfig, id string) (*MemberServerImage, error) {
+	if config.SupportVPC {
+		return getVpcMemberServerImageDetail(ctx, config, id)
+	}
+	return getClassicMemberServerImageDetail(ctx, config, id)
+}
+
+func getVpcMemberServerImageDetail(ctx context.Context, config *conn.ProviderConfig, id string) (*MemberServerImage, error) {
+	reqParams := &vserver.GetMemberServerImageInstanceDetailRequest{
+		RegionCode:                  &config.RegionCode,
+		MemberServerImageInstanceNo: ncloud.String(id),
+	}
+
+	ctx = LogCommonRequest(ctx, "getVpcMemberServerImageDetail", reqParams)
+	resp, err := config.Client.Vserver.V2Api.GetMemberServerImageInstanceDetail(reqParams)
+	if err != nil {
+		if IsNotFound(err) {
+			return nil, nil
+		}
+		LogErrorResponse(ctx, "getVpcMemberServerImageDetail", err, reqParams)
+		return nil, err
+	}
+	LogResponse(ctx, "getVpcMemberServerImageDetail", resp)
+
+	if len(resp.MemberServerImageInstanceList) < 1 {
+		return nil, nil
+	}
+
+	r := resp.MemberServerImageInstanceList[0]
+	return &MemberServerImage{
+		MemberServerImageNo:            r.MemberServerImageInstanceNo,
+		MemberServerImageName:          r.MemberServerImageName,
+		MemberServerImageDescription:   r.MemberServerImageDescription,
+		OriginalServerInstanceNo:       r.OriginalServerInstanceNo,
+		OriginalServerImageProductCode: r.OriginalServerImageProductCode,
+		Status:                         GetCodePtrByCommonCode(r.MemberServerImageInstanceStatus),
+		BlockStorageTotalRows:          r.MemberServerImageBlockStorageTotalRows,
+		BlockStorageTotalSize:          r.MemberServerImageBlockStorageTotalSize,
+		ShareStatus:                    GetCodePtrByCommonCode(r.ShareStatus),
+		SharedLoginIdList:              r.SharedLoginIdList,
+		CreateDate:                     r.CreateDate,
+	}, nil
+}
+
+func getClassicMemberServerImageDetail(ctx context.Context, config *conn.ProviderConfig, id string) (*MemberServerImage, error) {
+	reqParams := &server.GetMemberServerImageListRequest{
+		RegionNo:                &config.RegionNo,
+		MemberServerImageNoList: []*string{ncloud.String(id)},
+	}
+
+	ctx = LogCommonRequest(ctx, "getClassicMemberServerImageDetail", reqParams)
+	resp, err := config.Client.Server.V2Api.GetMemberServerImageList(reqParams)
+	if err != nil {
+		LogErrorResponse(ctx, "getClassicMemberServerImageDetail", err, reqParams)
+		return nil, err
+	}
+	LogResponse(ctx, "getClassicMemberServerImageDetail", resp)
+
+	if len(resp.MemberServerImageList) < 1 {
+		return nil, nil
+	}
+
+	r := resp.MemberServerImageList[0]
+	return &MemberServerImage{
+		MemberServerImageNo:            r.MemberServerImageNo,
+		MemberServerImageName:          r.MemberServerImageName,
+		MemberServerImageDescription:   r.MemberServerImageDescription,
+		OriginalServerInstanceNo:       r.OriginalServerInstanceNo,
+		OriginalServerImageProductCode: r.OriginalServerImageProductCode,
+		Status:                         GetCodePtrByCommonCode(r.MemberServerImageStatus),
+		BlockStorageTotalRows:          r.MemberServerImageBlockStorageTotalRows,
+		BlockStorageTotalSize:          r.MemberServerImageBlockStorageTotalSize,
+		ShareStatus:                    GetCodePtrByCommonCode(r.ShareStatus),
+		SharedLoginIdList:              r.SharedLoginIdList,
+		CreateDate:                     r.CreateDate,
+	}, nil
+}
+
+// setMemberServerImageSharingPermission replaces the accounts the image is shared with
+func setMemberServerImageSharingPermission(ctx context.Context, config *conn.ProviderConfig, id string, loginIds []*string) error {
+	if config.SupportVPC {
+		reqParams := &vserver.SetMemberServerImageSharingPermissionRequest{
+			RegionCode:                  &config.RegionCode,
+			MemberServerImageInstanceNo: ncloud.String(id),
+			TargetLoginIdList:           loginIds,
+		}
+
+		ctx = LogCommonRequest(ctx, "setVpcMemberServerImageSharingPermission", reqParams)
+		resp, err := config.Client.Vserver.V2Api.SetMemberServerImageSharingPermission(reqParams)
+		if err != nil {
+			LogErrorResponse(ctx, "setVpcMemberServerImageSharingPermission", err, reqParams)
+			return err
+		}
+		LogResponse(ctx, "setVpcMemberServerImageSharingPermission", resp)
+		return nil
+	}
+
+	reqParams := &server.SetMemberServerImageSharingPermissionRequest{
+		MemberServerImageNo: ncloud.String(id),
+		TargetLoginIdList:   loginIds,
+	}
+
+	ctx = LogCommonRequest(ctx, "setClassicMemberServerImageSharingPermission", reqParams)
+	resp, err := config.Client.Server.V2Api.SetMemberServerImageSharingPermission(reqParams)
+	if err != nil {
+		LogErrorResponse(ctx, "setClassicMemberServerImageSharingPermission", err, reqParams)
+		return err
+	}
+	LogResponse(ctx, "setClassicMemberServerImageSharingPermission", resp)
+	return nil
+}
+
+func removeMemberServerImageSharingPermission(ctx context.Context, config *conn.ProviderConfig, id string, loginIds []*string) error {
+	if config.SupportVPC {
+		reqParams := &vserver.RemoveMemberServerImageSharingPermissionRequest{
+			RegionCode:                  &config.RegionCode,
+			MemberServerImageInstanceNo: ncloud.String(id),
+			TargetLoginIdList:           loginIds,
+		}
+
+		ctx = LogCommonRequest(ctx, "removeVpcMemberServerImageSharingPermission", reqParams)
+		resp, err := config.Client.Vserver.V2Api.RemoveMemberServerImageSharingPermission(reqParams)
+		if err != nil {
+			LogErrorResponse(ctx, "removeVpcMemberServerImageSharingPermission", err, reqParams)
+			return err
+		}
+		LogResponse(ctx, "removeVpcMemberServerImageSharingPermission", resp)
+		return nil
+	}
+
+	reqParams := &server.RemoveMemberServerImageSharingPermissionRequest{
+		MemberServerImageNo: ncloud.String(id),
+		TargetLoginIdList:   loginIds,
+	}
+
+	ctx = LogCommonRequest(ctx, "removeClassicMemberServerImageSharingPermission", reqParams)
+	resp, err := config.Client.Server.V2Api.RemoveMemberServerImageSharingPermission(reqParams)
+	if err != nil {
+		LogErrorResponse(ctx, "removeClassicMemberServerImageSharingPermission", err, reqParams)
+		return err
+	}
+	LogResponse(ctx, "removeClassicMemberServerImageSharingPermission", resp)
+	return nil
+}
+
+func deleteVpcMemberServerImage(ctx context.Context, config *conn.ProviderConfig, id string) error {
+	reqParams := &vserver.DeleteMemberServerImageInstancesRequest{
+		RegionCode:                      &config.RegionCode,
+		MemberServerImageInstanceNoList: []*string{ncloud.String(id)},
+	}
+
+	ctx = LogCommonRequest(ctx, "deleteVpcMemberServerImage", reqParams)
+	resp, err := config.Client.Vserver.V2Api.DeleteMemberServerImageInstances(reqParams)
+	if err != nil {
+		LogErrorResponse(ctx, "deleteVpcMemberServerImage", err, reqParams)
+		return err
+	}
+	LogResponse(ctx, "deleteVpcMemberServerImage", resp)
+
+	return nil
+}
+
+func deleteClassicMemberServerImage(ctx context.Context, config *conn.ProviderConfig, id string) error {
+	reqParams := &server.DeleteMemberServerImagesRequest{
+		MemberServerImageNoList: []*string{ncloud.String(id)},
+	}
+
+	ctx = LogCommonRequest(ctx, "deleteClassicMemberServerImage", reqParams)
+	resp, err := config.Client.Server.V2Api.DeleteMemberServerImages(reqParams)
+	if err != nil {
+		LogErrorResponse(ctx, "deleteClassicMemberServerImage", err, reqParams)
+		return err
+	}
+	LogResponse(ctx, "deleteClassicMemberServerImage", resp)
+
+	return nil
+}
+
+// MemberServerImage Dto for member server image
+type MemberServerImage struct {
+	MemberServerImageNo            *string   `json:"member_server_image_no,omitempty"`
+	MemberServerImageName          *string   `json:"name,omitempty"`
+	MemberServerImageDescription   *string   `json:"description,omitempty"`
+	OriginalServerInstanceNo       *string   `json:"server_instance_no,omitempty"`
+	OriginalServerImageProductCode *string   `json:"original_server_image_product_code,omitempty"`
+	Status                         *string   `json:"status,omitempty"`
+	BlockStorageTotalRows          *int32    `json:"block_storage_total_rows,omitempty"`
+	BlockStorageTotalSize          *int64    `json:"block_storage_total_size,omitempty"`
+	ShareStatus                    *string   `json:"share_status,omitempty"`
+	SharedLoginIdList              []*string `json:"shared_login_id_list,omitempty"`
+	CreateDate                     *string   `json:"create_date,omitempty"`
+}
@@ -1,19 +1,24 @@
 package server
 
 import (
+	"context"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/paging"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/verify"
 )
 
 func DataSourceNcloudMemberServerImage() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNcloudMemberServerImageRead,
+		ReadContext: dataSourceNcloudMemberServerImageRead,
 
 		Schema: map[string]*schema.Schema{
 			"no_list": {
@@ -112,19 +117,19 @@ func DataSourceNcloudMemberServerImage() *schema.Resource {
 	}
 }
 
-func dataSourceNcloudMemberServerImageRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceNcloudMemberServerImageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*conn.ProviderConfig)
 	var resources []map[string]interface{}
 	var err error
 
 	if config.SupportVPC {
-		resources, err = getVpcMemberServerImage(d, config)
+		resources, err = getVpcMemberServerImage(ctx, d, config)
 	} else {
-		resources, err = getClassicMemberServerImage(d, config)
+		resources, err = getClassicMemberServerImage(ctx, d, config)
 	}
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if f, ok := d.GetOk("filter"); ok {
@@ -132,7 +137,7 @@ func dataSourceNcloudMemberServerImageRead(d *schema.ResourceData, meta interfac
 	}
 
 	if err := verify.ValidateOneResult(len(resources)); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	SetSingularResourceDataFromMap(d, resources[0])
@@ -140,7 +145,7 @@ func dataSourceNcloudMemberServerImageRead(d *schema.ResourceData, meta interfac
 	return nil
 }
 
-func getClassicMemberServerImage(d *schema.ResourceData, config *conn.ProviderConfig) ([]map[string]interface{}, error) {
+func getClassicMemberServerImage(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) ([]map[string]interface{}, error) {
 	client := config.Client
 	regionNo := config.RegionNo
 
@@ -156,18 +161,27 @@ func getClassicMemberServerImage(d *schema.ResourceData, config *conn.ProviderCo
 		reqParams.PlatformTypeCodeList = ExpandStringInterfaceList(platformTypeCodeList.([]interface{}))
 	}
 
-	LogCommonRequest("getClassicMemberServerImage", reqParams)
+	memberServerImageList, err := paging.All(ctx, func(ctx context.Context, pageNo, pageSize int32) ([]*server.MemberServerImage, *int32, error) {
+		reqParams.PageNo = ncloud.Int32(pageNo)
+		reqParams.PageSize = ncloud.Int32(pageSize)
+
+		ctx = LogCommonRequest(ctx, "getClassicMemberServerImage", reqParams)
+		resp, err := client.Server.V2Api.GetMemberServerImageList(reqParams)
+		if err != nil {
+			LogErrorResponse(ctx, "getClassicMemberServerImage", err, reqParams)
+			return nil, nil, err
+		}
+		LogCommonResponse(ctx, "getClassicMemberServerImage", GetCommonResponse(resp))
 
-	resp, err := client.Server.V2Api.GetMemberServerImageList(reqParams)
+		return resp.MemberServerImageList, resp.TotalRows, nil
+	})
 	if err != nil {
-		LogErrorResponse("getClassicMemberServerImage", err, reqParams)
 		return nil, err
 	}
-	LogCommonResponse("getClassicMemberServerImage", GetCommonResponse(resp))
 
 	resources := []map[string]interface{}{}
 
-	for _, r := range resp.MemberServerImageList {
+	for _, r := range memberServerImageList {
 		instance := map[string]interface{}{
 			"id":                                    *r.MemberServerImageNo,
 			"no":                                    *r.MemberServerImageNo,
@@ -197,7 +211,7 @@ func getClassicMemberServerImage(d *schema.ResourceData, config *conn.ProviderCo
 	return resources, nil
 }
 
-func getVpcMemberServerImage(d *schema.ResourceData, config *conn.ProviderConfig) ([]map[string]interface{}, error) {
+func getVpcMemberServerImage(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) ([]map[string]interface{}, error) {
 	client := config.Client
 	regionCode := config.RegionCode
 
@@ -213,18 +227,27 @@ func getVpcMemberServerImage(d *schema.ResourceData, config *conn.ProviderConfig
 		reqParams.PlatformTypeCodeList = ExpandStringInterfaceList(platformTypeCodeList.([]interface{}))
 	}
 
-	LogCommonRequest("getVpcMemberServerImage", reqParams)
+	memberServerImageInstanceList, err := paging.All(ctx, func(ctx context.Context, pageNo, pageSize int32) ([]*vserver.MemberServerImageInstance, *int32, error) {
+		reqParams.PageNo = ncloud.Int32(pageNo)
+		reqParams.PageSize = ncloud.Int32(pageSize)
+
+		ctx = LogCommonRequest(ctx, "getVpcMemberServerImage", reqParams)
+		resp, err := client.Vserver.V2Api.GetMemberServerImageInstanceList(reqParams)
+		if err != nil {
+			LogErrorResponse(ctx, "getVpcMemberServerImage", err, reqParams)
+			return nil, nil, err
+		}
+		LogCommonResponse(ctx, "getVpcMemberServerImage", GetCommonResponse(resp))
 
-	resp, err := client.Vserver.V2Api.GetMemberServerImageInstanceList(reqParams)
+		return resp.MemberServerImageInstanceList, resp.TotalRows, nil
+	})
 	if err != nil {
-		LogErrorResponse("getVpcMemberServerImage", err, reqParams)
 		return nil, err
 	}
-	LogCommonResponse("getVpcMemberServerImage", GetCommonResponse(resp))
 
 	resources := []map[string]interface{}{}
 
-	for _, r := range resp.MemberServerImageInstanceList {
+	for _, r := range memberServerImageInstanceList {
 		instance := map[string]interface{}{
 			"id":                                 *r.MemberServerImageInstanceNo,
 			"no":                                 *r.MemberServerImageInstanceNo,
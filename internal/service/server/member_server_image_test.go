@@ -0,0 +1,108 @@
+package server_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	. "github.com/terraform-providers/terraform-provider-ncloud/internal/acctest"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	serverservice "github.com/terraform-providers/terraform-provider-ncloud/internal/service/server"
+)
+
+func TestAccResourceNcloudMemberServerImage_vpc_basic(t *testing.T) {
+	testServerName := GetTestServerName(t)
+	resourceName := "ncloud_member_server_image.image"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckMemberServerImageDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMemberServerImageVpcConfig(testServerName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(resourceName, "id", regexp.MustCompile(`^\d+$`)),
+					resource.TestCheckResourceAttr(resourceName, "name", testServerName+"-image"),
+					resource.TestCheckResourceAttrPair(resourceName, "server_instance_no", "ncloud_server.server", "id"),
+					resource.TestCheckResourceAttrPair("ncloud_server.from_image", "member_server_image_no", resourceName, "id"),
+					resource.TestCheckResourceAttr("ncloud_server.server", "desired_status", "running"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"stop_server_before_create"},
+			},
+		},
+	})
+}
+
+func testAccCheckMemberServerImageDestroy(s *terraform.State) error {
+	config := GetTestProvider(true).Meta().(*conn.ProviderConfig)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ncloud_member_server_image" {
+			continue
+		}
+		image, err := serverservice.GetMemberServerImage(context.Background(), config, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if image != nil {
+			return fmt.Errorf("member server image still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccMemberServerImageVpcConfig(testServerName string) string {
+	return fmt.Sprintf(`
+resource "ncloud_login_key" "loginkey" {
+	key_name = "%[1]s-key"
+}
+
+resource "ncloud_vpc" "test" {
+	name               = "%[1]s"
+	ipv4_cidr_block    = "10.5.0.0/16"
+}
+
+resource "ncloud_subnet" "test" {
+	vpc_no             = ncloud_vpc.test.vpc_no
+	name               = "%[1]s"
+	subnet             = "10.5.0.0/24"
+	zone               = "KR-2"
+	network_acl_no     = ncloud_vpc.test.default_network_acl_no
+	subnet_type        = "PUBLIC"
+	usage_type         = "GEN"
+}
+
+resource "ncloud_server" "server" {
+	subnet_no = ncloud_subnet.test.id
+	name = "%[1]s"
+	server_image_product_code = "SW.VSVR.OS.LNX64.ROCKY.0810.B050"
+	server_product_code = "SVR.VSVR.STAND.C002.M008.NET.HDD.B050.G002"
+	login_key_name = ncloud_login_key.loginkey.key_name
+}
+
+resource "ncloud_member_server_image" "image" {
+	server_instance_no        = ncloud_server.server.id
+	name                      = "%[1]s-image"
+	stop_server_before_create = true
+}
+
+resource "ncloud_server" "from_image" {
+	subnet_no = ncloud_subnet.test.id
+	name = "%[1]s-2"
+	member_server_image_no = ncloud_member_server_image.image.id
+	server_product_code = "SVR.VSVR.STAND.C002.M008.NET.HDD.B050.G002"
+	login_key_name = ncloud_login_key.loginkey.key_name
+}
+`, testServerName)
+}
@@ -1,8 +1,10 @@
 package server
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -12,7 +14,7 @@ import (
 
 func DataSourceNcloudMemberServerImages() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNcloudMemberServerImagesRead,
+		ReadContext: dataSourceNcloudMemberServerImagesRead,
 
 		Schema: map[string]*schema.Schema{
 			"no_list": {
@@ -57,19 +59,19 @@ func DataSourceNcloudMemberServerImages() *schema.Resource {
 	}
 }
 
-func dataSourceNcloudMemberServerImagesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceNcloudMemberServerImagesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*conn.ProviderConfig)
 	var resources []map[string]interface{}
 	var err error
 
 	if config.SupportVPC {
-		resources, err = getVpcMemberServerImage(d, config)
+		resources, err = getVpcMemberServerImage(ctx, d, config)
 	} else {
-		resources, err = getClassicMemberServerImage(d, config)
+		resources, err = getClassicMemberServerImage(ctx, d, config)
 	}
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if f, ok := d.GetOk("filter"); ok {
@@ -77,10 +79,10 @@ func dataSourceNcloudMemberServerImagesRead(d *schema.ResourceData, meta interfa
 	}
 
 	if len(resources) < 1 {
-		return fmt.Errorf("no results. please change search criteria and try again")
+		return diag.FromErr(fmt.Errorf("no results. please change search criteria and try again"))
 	}
 
-	return memberServerImagesAttributes(d, resources)
+	return diag.FromErr(memberServerImagesAttributes(d, resources))
 }
 
 func memberServerImagesAttributes(d *schema.ResourceData, memberServerImages []map[string]interface{}) error {
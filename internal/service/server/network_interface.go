@@ -1,21 +1,24 @@
 package server
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/service/vpc"
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/verify"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/waiter"
 )
 
 const (
@@ -28,12 +31,12 @@ const (
 
 func ResourceNcloudNetworkInterface() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNcloudNetworkInterfaceCreate,
-		Read:   resourceNcloudNetworkInterfaceRead,
-		Update: resourceNcloudNetworkInterfaceUpdate,
-		Delete: resourceNcloudNetworkInterfaceDelete,
+		CreateContext: resourceNcloudNetworkInterfaceCreate,
+		ReadContext:   resourceNcloudNetworkInterfaceRead,
+		UpdateContext: resourceNcloudNetworkInterfaceUpdate,
+		DeleteContext: resourceNcloudNetworkInterfaceDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"subnet_no": {
@@ -95,33 +98,33 @@ func ResourceNcloudNetworkInterface() *schema.Resource {
 	}
 }
 
-func resourceNcloudNetworkInterfaceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudNetworkInterfaceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*conn.ProviderConfig)
 
-	instance, err := createNetworkInterface(d, config)
+	instance, err := createNetworkInterface(ctx, d, config)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(*instance.NetworkInterfaceNo)
 	log.Printf("[INFO] Network Interface ID: %s", d.Id())
 
 	if v, ok := d.GetOk("server_instance_no"); ok && v != "" {
-		if err := waitForNetworkInterfaceAttachment(config, d.Id()); err != nil {
-			return err
+		if err := waitForNetworkInterfaceAttachment(ctx, config, d.Id()); err != nil {
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceNcloudNetworkInterfaceRead(d, meta)
+	return resourceNcloudNetworkInterfaceRead(ctx, d, meta)
 }
 
-func resourceNcloudNetworkInterfaceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudNetworkInterfaceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*conn.ProviderConfig)
 
-	instance, err := GetNetworkInterface(config, d.Id())
+	instance, err := GetNetworkInterface(ctx, config, d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if instance == nil {
@@ -147,148 +150,134 @@ func resourceNcloudNetworkInterfaceRead(d *schema.ResourceData, meta interface{}
 	return nil
 }
 
-func resourceNcloudNetworkInterfaceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudNetworkInterfaceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*conn.ProviderConfig)
 
 	if d.HasChange("server_instance_no") {
 		o, n := d.GetChange("server_instance_no")
 		if len(o.(string)) > 0 {
-			if err := detachNetworkInterface(d, config, o.(string)); err != nil {
-				return err
+			if err := detachNetworkInterface(ctx, d, config, o.(string)); err != nil {
+				return diag.FromErr(err)
 			}
 		}
 
 		if len(n.(string)) > 0 {
-			if err := attachNetworkInterface(d, config); err != nil {
-				return err
+			if err := attachNetworkInterface(ctx, d, config); err != nil {
+				return diag.FromErr(err)
 			}
 		}
 	}
 
 	if d.HasChange("access_control_groups") {
 		o, n := d.GetChange("access_control_groups")
-		os := o.(*schema.Set)
-		ns := n.(*schema.Set)
+		if err := updateNetworkInterfaceAccessControlGroups(ctx, config, d.Id(), o.(*schema.Set), n.(*schema.Set)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
 
-		add := ns.Difference(os).List()
-		remove := os.Difference(ns).List()
+	return resourceNcloudNetworkInterfaceRead(ctx, d, meta)
+}
 
-		removeAcgList := ExpandStringInterfaceList(remove)
-		addAcgList := ExpandStringInterfaceList(add)
+// updateNetworkInterfaceAccessControlGroups changes the ACGs of the network interface id from the set o to the set n
+func updateNetworkInterfaceAccessControlGroups(ctx context.Context, config *conn.ProviderConfig, id string, o, n *schema.Set) error {
+	addAcgList := ExpandStringInterfaceList(n.Difference(o).List())
+	removeAcgList := ExpandStringInterfaceList(o.Difference(n).List())
 
-		// First do add ACG prevent error '[1002035] At least one Acg must remain on the network interface.'
-		if len(addAcgList) > 0 {
-			if err := addNetworkInterfaceAccessControlGroup(d, config, addAcgList); err != nil {
-				return err
-			}
+	// First do add ACG prevent error '[1002035] At least one Acg must remain on the network interface.'
+	if len(addAcgList) > 0 {
+		if err := addNetworkInterfaceAccessControlGroup(ctx, config, id, addAcgList); err != nil {
+			return err
 		}
+	}
 
-		if len(removeAcgList) > 0 {
-			if err := removeNetworkInterfaceAccessControlGroup(d, config, removeAcgList); err != nil {
-				return err
-			}
+	if len(removeAcgList) > 0 {
+		if err := removeNetworkInterfaceAccessControlGroup(ctx, config, id, removeAcgList); err != nil {
+			return err
 		}
 	}
 
-	return resourceNcloudNetworkInterfaceRead(d, meta)
+	return nil
 }
 
-func removeNetworkInterfaceAccessControlGroup(d *schema.ResourceData, config *conn.ProviderConfig, accessControlGroupNoList []*string) error {
-	var resp *vserver.RemoveNetworkInterfaceAccessControlGroupResponse
-	var reqParams *vserver.RemoveNetworkInterfaceAccessControlGroupRequest
-
-	err := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
-		var err error
-		reqParams = &vserver.RemoveNetworkInterfaceAccessControlGroupRequest{
-			RegionCode:               &config.RegionCode,
-			AccessControlGroupNoList: accessControlGroupNoList,
-			NetworkInterfaceNo:       ncloud.String(d.Id()),
-		}
+func removeNetworkInterfaceAccessControlGroup(ctx context.Context, config *conn.ProviderConfig, id string, accessControlGroupNoList []*string) error {
+	reqParams := &vserver.RemoveNetworkInterfaceAccessControlGroupRequest{
+		RegionCode:               &config.RegionCode,
+		AccessControlGroupNoList: accessControlGroupNoList,
+		NetworkInterfaceNo:       ncloud.String(id),
+	}
 
-		LogCommonRequest("RemoveNetworkInterfaceAccessControlGroup", reqParams)
-		resp, err = config.Client.Vserver.V2Api.RemoveNetworkInterfaceAccessControlGroup(reqParams)
-
-		if err != nil {
-			errBody, _ := GetCommonErrorBody(err)
-			if errBody.ReturnCode == ApiErrorNetworkInterfaceAtLeastOneAcgMustRemain {
-				LogErrorResponse("retry RemoveNetworkInterfaceAccessControlGroup", err, reqParams)
-				time.Sleep(time.Second * 5)
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		return nil
-	})
+	ctx = LogCommonRequest(ctx, "RemoveNetworkInterfaceAccessControlGroup", reqParams)
+	resp, err := config.Client.Vserver.V2Api.RemoveNetworkInterfaceAccessControlGroup(reqParams)
 
 	if err != nil {
-		LogErrorResponse("RemoveNetworkInterfaceAccessControlGroup", err, reqParams)
+		LogErrorResponse(ctx, "RemoveNetworkInterfaceAccessControlGroup", err, reqParams)
 		return err
 	}
 
-	LogResponse("RemoveNetworkInterfaceAccessControlGroup", resp)
+	LogResponse(ctx, "RemoveNetworkInterfaceAccessControlGroup", resp)
 
-	if err = waitForVpcNetworkInterfaceState(config, d.Id(), []string{NetworkInterfaceStateSet}, []string{NetworkInterfaceStateNotUsed, NetworkInterfaceStateUsed}); err != nil {
+	if err = waitForVpcNetworkInterfaceState(ctx, config, id, []string{NetworkInterfaceStateSet}, []string{NetworkInterfaceStateNotUsed, NetworkInterfaceStateUsed}); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func addNetworkInterfaceAccessControlGroup(d *schema.ResourceData, config *conn.ProviderConfig, accessControlGroupNoList []*string) error {
+func addNetworkInterfaceAccessControlGroup(ctx context.Context, config *conn.ProviderConfig, id string, accessControlGroupNoList []*string) error {
 	reqParams := &vserver.AddNetworkInterfaceAccessControlGroupRequest{
 		RegionCode:               &config.RegionCode,
 		AccessControlGroupNoList: accessControlGroupNoList,
-		NetworkInterfaceNo:       ncloud.String(d.Id()),
+		NetworkInterfaceNo:       ncloud.String(id),
 	}
 
-	LogCommonRequest("AddNetworkInterfaceAccessControlGroup", reqParams)
+	ctx = LogCommonRequest(ctx, "AddNetworkInterfaceAccessControlGroup", reqParams)
 	resp, err := config.Client.Vserver.V2Api.AddNetworkInterfaceAccessControlGroup(reqParams)
 
 	if err != nil {
-		LogErrorResponse("AddNetworkInterfaceAccessControlGroup", err, reqParams)
+		LogErrorResponse(ctx, "AddNetworkInterfaceAccessControlGroup", err, reqParams)
 		return err
 	}
 
-	LogResponse("AddNetworkInterfaceAccessControlGroup", resp)
+	LogResponse(ctx, "AddNetworkInterfaceAccessControlGroup", resp)
 
-	if err = waitForVpcNetworkInterfaceState(config, d.Id(), []string{NetworkInterfaceStateSet}, []string{NetworkInterfaceStateNotUsed, NetworkInterfaceStateUsed}); err != nil {
+	if err = waitForVpcNetworkInterfaceState(ctx, config, id, []string{NetworkInterfaceStateSet}, []string{NetworkInterfaceStateNotUsed, NetworkInterfaceStateUsed}); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func resourceNcloudNetworkInterfaceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudNetworkInterfaceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*conn.ProviderConfig)
 
-	if err := DeleteNetworkInterface(config, d.Id()); err != nil {
-		return err
+	if err := DeleteNetworkInterface(ctx, config, d.Id()); err != nil {
+		return diag.FromErr(err)
 	}
 
 	return nil
 }
 
-func GetNetworkInterface(config *conn.ProviderConfig, id string) (*vserver.NetworkInterface, error) {
+func GetNetworkInterface(ctx context.Context, config *conn.ProviderConfig, id string) (*vserver.NetworkInterface, error) {
 	if config.SupportVPC {
-		return getVpcNetworkInterface(config, id)
+		return getVpcNetworkInterface(ctx, config, id)
 	}
 
 	return nil, NotSupportClassic("resource `ncloud_network_interface`")
 }
 
-func getVpcNetworkInterface(config *conn.ProviderConfig, id string) (*vserver.NetworkInterface, error) {
+func getVpcNetworkInterface(ctx context.Context, config *conn.ProviderConfig, id string) (*vserver.NetworkInterface, error) {
 	reqParams := &vserver.GetNetworkInterfaceDetailRequest{
 		RegionCode:         &config.RegionCode,
 		NetworkInterfaceNo: ncloud.String(id),
 	}
 
-	LogCommonRequest("getVpcNetworkInterface", reqParams)
+	ctx = LogCommonRequest(ctx, "getVpcNetworkInterface", reqParams)
 	resp, err := config.Client.Vserver.V2Api.GetNetworkInterfaceDetail(reqParams)
 	if err != nil {
-		LogErrorResponse("getVpcNetworkInterface", err, reqParams)
+		LogErrorResponse(ctx, "getVpcNetworkInterface", err, reqParams)
 		return nil, err
 	}
-	LogResponse("getVpcNetworkInterface", resp)
+	LogResponse(ctx, "getVpcNetworkInterface", resp)
 
 	if len(resp.NetworkInterfaceList) > 0 {
 		return resp.NetworkInterfaceList[0], nil
@@ -297,16 +286,16 @@ func getVpcNetworkInterface(config *conn.ProviderConfig, id string) (*vserver.Ne
 	return nil, nil
 }
 
-func createNetworkInterface(d *schema.ResourceData, config *conn.ProviderConfig) (*vserver.NetworkInterface, error) {
+func createNetworkInterface(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) (*vserver.NetworkInterface, error) {
 	if config.SupportVPC {
-		return createVpcNetworkInterface(d, config)
+		return createVpcNetworkInterface(ctx, d, config)
 	} else {
 		return nil, NotSupportClassic("resource `ncloud_network_interface`")
 	}
 }
 
-func createVpcNetworkInterface(d *schema.ResourceData, config *conn.ProviderConfig) (*vserver.NetworkInterface, error) {
-	subnet, err := vpc.GetSubnetInstance(config, d.Get("subnet_no").(string))
+func createVpcNetworkInterface(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) (*vserver.NetworkInterface, error) {
+	subnet, err := vpc.GetCachedSubnetInstance(ctx, config, d.Get("subnet_no").(string))
 	if err != nil {
 		return nil, err
 	}
@@ -326,51 +315,51 @@ func createVpcNetworkInterface(d *schema.ResourceData, config *conn.ProviderConf
 		Ip:                          StringPtrOrNil(d.GetOk("private_ip")),
 	}
 
-	LogCommonRequest("createVpcNetworkInterface", reqParams)
+	ctx = LogCommonRequest(ctx, "createVpcNetworkInterface", reqParams)
 	resp, err := config.Client.Vserver.V2Api.CreateNetworkInterface(reqParams)
 	if err != nil {
-		LogErrorResponse("createVpcNetworkInterface", err, reqParams)
+		LogErrorResponse(ctx, "createVpcNetworkInterface", err, reqParams)
 		return nil, err
 	}
-	LogResponse("createVpcNetworkInterface", resp)
+	LogResponse(ctx, "createVpcNetworkInterface", resp)
 
 	return resp.NetworkInterfaceList[0], nil
 }
 
-func DeleteNetworkInterface(config *conn.ProviderConfig, id string) error {
+func DeleteNetworkInterface(ctx context.Context, config *conn.ProviderConfig, id string) error {
 	if config.SupportVPC {
-		return deleteVpcNetworkInterface(config, id)
+		return deleteVpcNetworkInterface(ctx, config, id)
 	}
 
 	return NotSupportClassic("resource `ncloud_network_interface`")
 }
 
-func deleteVpcNetworkInterface(config *conn.ProviderConfig, id string) error {
+func deleteVpcNetworkInterface(ctx context.Context, config *conn.ProviderConfig, id string) error {
 	reqParams := &vserver.DeleteNetworkInterfaceRequest{
 		RegionCode:         &config.RegionCode,
 		NetworkInterfaceNo: ncloud.String(id),
 	}
 
-	LogCommonRequest("deleteVpcNetworkInterface", reqParams)
+	ctx = LogCommonRequest(ctx, "deleteVpcNetworkInterface", reqParams)
 	resp, err := config.Client.Vserver.V2Api.DeleteNetworkInterface(reqParams)
 	if err != nil {
-		LogErrorResponse("deleteVpcNetworkInterface", err, reqParams)
+		LogErrorResponse(ctx, "deleteVpcNetworkInterface", err, reqParams)
 		return err
 	}
-	LogResponse("deleteVpcNetworkInterface", resp)
+	LogResponse(ctx, "deleteVpcNetworkInterface", resp)
 
-	if err := waitForVpcNetworkInterfaceState(config, id, []string{NetworkInterfaceStateUsed, NetworkInterfaceStateNotUsed, NetworkInterfaceStateUnSet}, []string{NetworkInterfaceStateTerminated}); err != nil {
+	if err := waitForVpcNetworkInterfaceState(ctx, config, id, []string{NetworkInterfaceStateUsed, NetworkInterfaceStateNotUsed, NetworkInterfaceStateUnSet}, []string{NetworkInterfaceStateTerminated}); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func attachNetworkInterface(d *schema.ResourceData, config *conn.ProviderConfig) error {
+func attachNetworkInterface(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) error {
 	var err error
 
 	if config.SupportVPC {
-		err = attachVpcNetworkInterface(d, config)
+		err = attachVpcNetworkInterface(ctx, d, config)
 	} else {
 		err = NotSupportClassic("resource `ncloud_network_interface`")
 	}
@@ -379,12 +368,12 @@ func attachNetworkInterface(d *schema.ResourceData, config *conn.ProviderConfig)
 		return err
 	}
 
-	_ = waitForPublicIpDisassociate(d, config)
+	_ = waitForPublicIpDisassociate(ctx, d, config)
 
 	return nil
 }
 
-func attachVpcNetworkInterface(d *schema.ResourceData, config *conn.ProviderConfig) error {
+func attachVpcNetworkInterface(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) error {
 	reqParams := &vserver.AttachNetworkInterfaceRequest{
 		RegionCode:         &config.RegionCode,
 		NetworkInterfaceNo: ncloud.String(d.Id()),
@@ -392,23 +381,23 @@ func attachVpcNetworkInterface(d *schema.ResourceData, config *conn.ProviderConf
 		ServerInstanceNo:   ncloud.String(d.Get("server_instance_no").(string)),
 	}
 
-	LogCommonRequest("attachVpcNetworkInterface", reqParams)
+	ctx = LogCommonRequest(ctx, "attachVpcNetworkInterface", reqParams)
 
 	resp, err := config.Client.Vserver.V2Api.AttachNetworkInterface(reqParams)
 	if err != nil {
-		LogErrorResponse("attachVpcNetworkInterface", err, d.Id())
+		LogErrorResponse(ctx, "attachVpcNetworkInterface", err, d.Id())
 		return err
 	}
-	LogCommonResponse("attachVpcNetworkInterface", GetCommonResponse(resp))
+	LogCommonResponse(ctx, "attachVpcNetworkInterface", GetCommonResponse(resp))
 
-	if err := waitForNetworkInterfaceAttachment(config, d.Id()); err != nil {
+	if err := waitForNetworkInterfaceAttachment(ctx, config, d.Id()); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func waitForPublicIpDisassociate(d *schema.ResourceData, config *conn.ProviderConfig) error {
+func waitForPublicIpDisassociate(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) error {
 	reqParams := &vserver.GetServerInstanceDetailRequest{
 		RegionCode:       &config.RegionCode,
 		ServerInstanceNo: ncloud.String(d.Get("server_instance_no").(string)),
@@ -424,7 +413,7 @@ func waitForPublicIpDisassociate(d *schema.ResourceData, config *conn.ProviderCo
 	}
 
 	if publicIpNo := *resp.ServerInstanceList[0].PublicIpInstanceNo; publicIpNo != "" {
-		if err := waitForPublicIpDisassociation(config, publicIpNo); err != nil {
+		if err := waitForPublicIpDisassociation(ctx, config, publicIpNo); err != nil {
 			return err
 		}
 	}
@@ -432,11 +421,11 @@ func waitForPublicIpDisassociate(d *schema.ResourceData, config *conn.ProviderCo
 	return nil
 }
 
-func detachNetworkInterface(d *schema.ResourceData, config *conn.ProviderConfig, serverInstanceNo string) error {
+func detachNetworkInterface(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig, serverInstanceNo string) error {
 	var err error
 
 	if config.SupportVPC {
-		err = detachVpcNetworkInterface(d, config, serverInstanceNo)
+		err = detachVpcNetworkInterface(ctx, d, config, serverInstanceNo)
 	} else {
 		err = NotSupportClassic("resource `ncloud_network_interface`")
 	}
@@ -448,7 +437,7 @@ func detachNetworkInterface(d *schema.ResourceData, config *conn.ProviderConfig,
 	return nil
 }
 
-func detachVpcNetworkInterface(d *schema.ResourceData, config *conn.ProviderConfig, serverInstanceNo string) error {
+func detachVpcNetworkInterface(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig, serverInstanceNo string) error {
 	reqParams := &vserver.DetachNetworkInterfaceRequest{
 		RegionCode:         &config.RegionCode,
 		NetworkInterfaceNo: ncloud.String(d.Id()),
@@ -456,27 +445,27 @@ func detachVpcNetworkInterface(d *schema.ResourceData, config *conn.ProviderConf
 		ServerInstanceNo:   ncloud.String(serverInstanceNo),
 	}
 
-	LogCommonRequest("detachVpcNetworkInterface", reqParams)
+	ctx = LogCommonRequest(ctx, "detachVpcNetworkInterface", reqParams)
 
 	resp, err := config.Client.Vserver.V2Api.DetachNetworkInterface(reqParams)
 	if err != nil {
-		LogErrorResponse("detachVpcNetworkInterface", err, d.Id())
+		LogErrorResponse(ctx, "detachVpcNetworkInterface", err, d.Id())
 		return err
 	}
-	LogCommonResponse("detachVpcNetworkInterface", GetCommonResponse(resp))
+	LogCommonResponse(ctx, "detachVpcNetworkInterface", GetCommonResponse(resp))
 
-	if err := waitForVpcNetworkInterfaceState(config, d.Id(), []string{NetworkInterfaceStateUnSet}, []string{NetworkInterfaceStateNotUsed}); err != nil {
+	if err := waitForVpcNetworkInterfaceState(ctx, config, d.Id(), []string{NetworkInterfaceStateUnSet}, []string{NetworkInterfaceStateNotUsed}); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func waitForNetworkInterfaceAttachment(config *conn.ProviderConfig, id string) error {
+func waitForNetworkInterfaceAttachment(ctx context.Context, config *conn.ProviderConfig, id string) error {
 	var err error
 
 	if config.SupportVPC {
-		err = waitForVpcNetworkInterfaceState(config, id, []string{NetworkInterfaceStateSet}, []string{NetworkInterfaceStateUsed})
+		err = waitForVpcNetworkInterfaceState(ctx, config, id, []string{NetworkInterfaceStateSet}, []string{NetworkInterfaceStateUsed})
 	} else {
 		err = NotSupportClassic("resource `ncloud_network_interface`")
 	}
@@ -488,20 +477,21 @@ func waitForNetworkInterfaceAttachment(config *conn.ProviderConfig, id string) e
 	return nil
 }
 
-func waitForVpcNetworkInterfaceState(config *conn.ProviderConfig, id string, pending []string, target []string) error {
-	stateConf := &resource.StateChangeConf{
+func waitForVpcNetworkInterfaceState(ctx context.Context, config *conn.ProviderConfig, id string, pending []string, target []string) error {
+	stateConf := &waiter.Config[*vserver.NetworkInterface]{
 		Pending: pending,
 		Target:  target,
-		Refresh: func() (interface{}, string, error) {
-			instance, err := GetNetworkInterface(config, id)
-			return vpc.VpcCommonStateRefreshFunc(instance, err, "NetworkInterfaceStatus")
-		},
-		Timeout:    conn.DefaultTimeout,
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Refresh: waiter.StatusOf(func(ctx context.Context) (*vserver.NetworkInterface, error) {
+			return GetNetworkInterface(ctx, config, id)
+		}, func(instance *vserver.NetworkInterface) string {
+			return ncloud.StringValue(common.GetCodePtrByCommonCode(instance.NetworkInterfaceStatus))
+		}),
+		Timeout:     conn.DefaultTimeout,
+		Delay:       2 * time.Second,
+		MinInterval: 3 * time.Second,
 	}
 
-	_, err := stateConf.WaitForState()
+	_, err := stateConf.WaitForState(ctx)
 	if err != nil {
 		return fmt.Errorf("error waiting for Network Interface (%s) to become (%v): %s", id, target, err)
 	}
@@ -1,12 +1,16 @@
 package server
 
 import (
+	"context"
+
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/paging"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/verify"
 )
 
@@ -30,26 +34,26 @@ func DataSourceNcloudNetworkInterface() *schema.Resource {
 		"filter": DataSourceFiltersSchema(),
 	}
 
-	return GetSingularDataSourceItemSchema(ResourceNcloudNetworkInterface(), fieldMap, dataSourceNcloudNetworkInterfaceRead)
+	return GetSingularDataSourceItemSchemaContext(ResourceNcloudNetworkInterface(), fieldMap, dataSourceNcloudNetworkInterfaceRead)
 }
 
-func dataSourceNcloudNetworkInterfaceRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceNcloudNetworkInterfaceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*conn.ProviderConfig)
 	var resources []map[string]interface{}
 	var err error
 
 	if config.SupportVPC {
-		resources, err = getVpcNetworkInterfaceListFiltered(d, config)
+		resources, err = getVpcNetworkInterfaceListFiltered(ctx, d, config)
 	} else {
-		return NotSupportClassic("data source `ncloud_network_interface`")
+		return diag.FromErr(NotSupportClassic("data source `ncloud_network_interface`"))
 	}
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := verify.ValidateOneResult(len(resources)); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	SetSingularResourceDataFromMap(d, resources[0])
@@ -57,7 +61,7 @@ func dataSourceNcloudNetworkInterfaceRead(d *schema.ResourceData, meta interface
 	return nil
 }
 
-func getVpcNetworkInterfaceListFiltered(d *schema.ResourceData, config *conn.ProviderConfig) ([]map[string]interface{}, error) {
+func getVpcNetworkInterfaceListFiltered(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) ([]map[string]interface{}, error) {
 	reqParams := &vserver.GetNetworkInterfaceListRequest{
 		RegionCode:           &config.RegionCode,
 		Ip:                   StringPtrOrNil(d.GetOk("private_ip")),
@@ -68,18 +72,27 @@ func getVpcNetworkInterfaceListFiltered(d *schema.ResourceData, config *conn.Pro
 		reqParams.NetworkInterfaceNoList = []*string{ncloud.String(v.(string))}
 	}
 
-	LogCommonRequest("getVpcNetworkInterfaceList", reqParams)
-	resp, err := config.Client.Vserver.V2Api.GetNetworkInterfaceList(reqParams)
+	networkInterfaceList, err := paging.All(ctx, func(ctx context.Context, pageNo, pageSize int32) ([]*vserver.NetworkInterface, *int32, error) {
+		reqParams.PageNo = ncloud.Int32(pageNo)
+		reqParams.PageSize = ncloud.Int32(pageSize)
+
+		ctx = LogCommonRequest(ctx, "getVpcNetworkInterfaceList", reqParams)
+		resp, err := config.Client.Vserver.V2Api.GetNetworkInterfaceList(reqParams)
+		if err != nil {
+			LogErrorResponse(ctx, "getVpcNetworkInterfaceList", err, reqParams)
+			return nil, nil, err
+		}
+		LogResponse(ctx, "getVpcNetworkInterfaceList", resp)
 
+		return resp.NetworkInterfaceList, resp.TotalRows, nil
+	})
 	if err != nil {
-		LogErrorResponse("getVpcNetworkInterfaceList", err, reqParams)
 		return nil, err
 	}
-	LogResponse("getVpcNetworkInterfaceList", resp)
 
 	var resources []map[string]interface{}
 
-	for _, r := range resp.NetworkInterfaceList {
+	for _, r := range networkInterfaceList {
 		instance := map[string]interface{}{
 			"id":                   *r.NetworkInterfaceNo,
 			"network_interface_no": *r.NetworkInterfaceNo,
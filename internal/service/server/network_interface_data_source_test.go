@@ -4,15 +4,13 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
-
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/acctest"
 )
 
 func TestAccDataSourceNcloudNetworkInterfaceBasic(t *testing.T) {
-	name := fmt.Sprintf("tf-ds-nic-basic-%s", acctest.RandString(5))
+	name := fmt.Sprintf("tf-ds-nic-basic-%s", RandString(t, 5))
 	resourceName := "ncloud_network_interface.foo"
 	dataName := "data.ncloud_network_interface.by_id"
 
@@ -41,7 +39,7 @@ func TestAccDataSourceNcloudNetworkInterfaceBasic(t *testing.T) {
 }
 
 func TestAccDataSourceNcloudNetworkInterfaceFilter(t *testing.T) {
-	name := fmt.Sprintf("tf-ds-nic-filter-%s", acctest.RandString(5))
+	name := fmt.Sprintf("tf-ds-nic-filter-%s", RandString(t, 5))
 	resourceName := "ncloud_network_interface.foo"
 	dataName := "data.ncloud_network_interface.by_filter"
 
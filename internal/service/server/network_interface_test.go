@@ -1,6 +1,7 @@
 package server_test
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"regexp"
@@ -8,7 +9,6 @@ import (
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 
@@ -19,7 +19,7 @@ import (
 
 func TestAccresourceNcloudNetworkInterface_basic(t *testing.T) {
 	var networkInterface vserver.NetworkInterface
-	name := fmt.Sprintf("tf-nic-basic-%s", acctest.RandString(5))
+	name := fmt.Sprintf("tf-nic-basic-%s", RandString(t, 5))
 	resourceName := "ncloud_network_interface.foo"
 
 	resource.Test(t, resource.TestCase{
@@ -54,7 +54,7 @@ func TestAccresourceNcloudNetworkInterface_basic(t *testing.T) {
 func TestAccresourceNcloudNetworkInterface_update(t *testing.T) {
 	var networkInterface vserver.NetworkInterface
 	resourceName := "ncloud_network_interface.foo"
-	name := fmt.Sprintf("tf-nic-update-%s", acctest.RandString(5))
+	name := fmt.Sprintf("tf-nic-update-%s", RandString(t, 5))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { TestAccPreCheck(t) },
@@ -81,7 +81,7 @@ func TestAccresourceNcloudNetworkInterface_update(t *testing.T) {
 
 func TestAccresourceNcloudNetworkInterface_disappears(t *testing.T) {
 	var networkInterface vserver.NetworkInterface
-	name := fmt.Sprintf("tf-nic-disappear-%s", acctest.RandString(5))
+	name := fmt.Sprintf("tf-nic-disappear-%s", RandString(t, 5))
 	resourceName := "ncloud_network_interface.foo"
 
 	resource.Test(t, resource.TestCase{
@@ -180,7 +180,7 @@ func testAccCheckNetworkInterfaceExists(n string, NetworkInterface *vserver.Netw
 		}
 
 		config := GetTestProvider(true).Meta().(*conn.ProviderConfig)
-		instance, err := server.GetNetworkInterface(config, rs.Primary.ID)
+		instance, err := server.GetNetworkInterface(context.Background(), config, rs.Primary.ID)
 		if err != nil {
 			return err
 		}
@@ -199,7 +199,7 @@ func testAccCheckNetworkInterfaceDestroy(s *terraform.State) error {
 			continue
 		}
 
-		instance, err := server.GetNetworkInterface(config, rs.Primary.ID)
+		instance, err := server.GetNetworkInterface(context.Background(), config, rs.Primary.ID)
 
 		if err != nil {
 			return err
@@ -216,6 +216,6 @@ func testAccCheckNetworkInterfaceDestroy(s *terraform.State) error {
 func testAccCheckNetworkInterfaceDisappears(instance *vserver.NetworkInterface) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		config := GetTestProvider(true).Meta().(*conn.ProviderConfig)
-		return server.DeleteNetworkInterface(config, *instance.NetworkInterfaceNo)
+		return server.DeleteNetworkInterface(context.Background(), config, *instance.NetworkInterfaceNo)
 	}
 }
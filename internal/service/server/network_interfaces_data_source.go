@@ -1,10 +1,12 @@
 package server
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/common"
@@ -13,7 +15,7 @@ import (
 
 func DataSourceNcloudNetworkInterfaces() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNcloudNetworkInterfacesRead,
+		ReadContext: dataSourceNcloudNetworkInterfacesRead,
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -36,28 +38,28 @@ func DataSourceNcloudNetworkInterfaces() *schema.Resource {
 	}
 }
 
-func dataSourceNcloudNetworkInterfacesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceNcloudNetworkInterfacesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*conn.ProviderConfig)
 	var resources []map[string]interface{}
 	var err error
 
 	if config.SupportVPC {
-		resources, err = getVpcNetworkInterfaceListFiltered(d, config)
+		resources, err = getVpcNetworkInterfaceListFiltered(ctx, d, config)
 	} else {
-		return NotSupportClassic("data source `ncloud_network_interface`")
+		return diag.FromErr(NotSupportClassic("data source `ncloud_network_interface`"))
 	}
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if len(resources) == 0 {
-		return errors.New("no matching Network Interfaces found")
+		return diag.FromErr(errors.New("no matching Network Interfaces found"))
 	}
 
 	d.SetId(time.Now().UTC().String())
 	if err := d.Set("network_interfaces", resources); err != nil {
-		return fmt.Errorf("error setting Network Interfaces: %s", err)
+		return diag.FromErr(fmt.Errorf("error setting Network Interfaces: %s", err))
 	}
 
 	return nil
@@ -4,8 +4,6 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
-
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/acctest"
@@ -34,7 +32,7 @@ func TestAccDataSourceNcloudNetworkInterfaces_basic(t *testing.T) {
 }
 
 func TestAccDataSourceNcloudNetworkInterfaces_privateIp(t *testing.T) {
-	name := fmt.Sprintf("tf-ds-nic-basic-%s", acctest.RandString(5))
+	name := fmt.Sprintf("tf-ds-nic-basic-%s", RandString(t, 5))
 	resourceName := "ncloud_network_interface.foo"
 	dataName := "data.ncloud_network_interfaces.by_private_ip"
 
@@ -62,7 +60,7 @@ func TestAccDataSourceNcloudNetworkInterfaces_privateIp(t *testing.T) {
 }
 
 func TestAccDataSourceNcloudNetworkInterfaces_filter(t *testing.T) {
-	name := fmt.Sprintf("tf-nic-filter-%s", acctest.RandString(5))
+	name := fmt.Sprintf("tf-nic-filter-%s", RandString(t, 5))
 	resourceName := "ncloud_network_interface.foo"
 	dataName := "data.ncloud_network_interfaces.by_filter"
 
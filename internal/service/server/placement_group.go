@@ -1,139 +1,213 @@
 package server
 
 import (
-	"log"
+	"context"
+	"fmt"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
-
-	. "github.com/terraform-providers/terraform-provider-ncloud/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/framework"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/verify"
 )
 
-func ResourceNcloudPlacementGroup() *schema.Resource {
-	return &schema.Resource{
-		Create: resourceNcloudPlacementGroupCreate,
-		Read:   resourceNcloudPlacementGroupRead,
-		Delete: resourceNcloudPlacementGroupDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
-		Schema: map[string]*schema.Schema{
-			"name": {
-				Type:             schema.TypeString,
-				Optional:         true,
-				Computed:         true,
-				ForceNew:         true,
-				ValidateDiagFunc: validation.ToDiagFunc(verify.ValidateInstanceName),
+var (
+	_ resource.Resource                 = &placementGroupResource{}
+	_ resource.ResourceWithConfigure    = &placementGroupResource{}
+	_ resource.ResourceWithImportState  = &placementGroupResource{}
+	_ resource.ResourceWithUpgradeState = &placementGroupResource{}
+)
+
+func NewPlacementGroupResource() resource.Resource {
+	return &placementGroupResource{}
+}
+
+type placementGroupResource struct {
+	config *conn.ProviderConfig
+}
+
+func (p *placementGroupResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_placement_group"
+}
+
+func (p *placementGroupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = placementGroupSchema()
+	resp.Schema.Version = 1
+}
+
+// placementGroupSchema is the schema of the resource, unchanged since its SDKv2 implementation
+func placementGroupSchema() schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": framework.IDAttribute(),
+			"name": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: verify.InstanceNameValidator(),
 			},
-			"placement_group_type": {
-				Type:             schema.TypeString,
-				Optional:         true,
-				Computed:         true,
-				ForceNew:         true,
-				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"AA"}, false)),
+			"placement_group_type": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("AA"),
+				},
 			},
-			"placement_group_no": {
-				Type:     schema.TypeString,
+			"placement_group_no": schema.StringAttribute{
 				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 		},
 	}
 }
 
-func resourceNcloudPlacementGroupCreate(d *schema.ResourceData, meta interface{}) error {
-	config := meta.(*conn.ProviderConfig)
+func (p *placementGroupResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		framework.SDKv2SchemaVersion: framework.UpgradeFromSDKv2[placementGroupResourceModel](placementGroupSchema()),
+	}
+}
 
-	if !config.SupportVPC {
-		return NotSupportClassic("resource `ncloud_placement_group`")
+func (p *placementGroupResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
 	}
 
-	reqParams := &vserver.CreatePlacementGroupRequest{
-		RegionCode: &config.RegionCode,
+	config, ok := req.ProviderData.(*conn.ProviderConfig)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
 	}
 
-	if v, ok := d.GetOk("name"); ok {
-		reqParams.PlacementGroupName = ncloud.String(v.(string))
+	p.config = config
+}
+
+func (p *placementGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan placementGroupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	if v, ok := d.GetOk("placement_group_type"); ok {
-		reqParams.PlacementGroupTypeCode = ncloud.String(v.(string))
+	if !p.config.SupportVPC {
+		resp.Diagnostics.AddError(
+			"NOT SUPPORT CLASSIC",
+			"resource `ncloud_placement_group` does not support classic",
+		)
+		return
 	}
 
-	LogCommonRequest("CreatePlacementGroup", reqParams)
-	resp, err := config.Client.Vserver.V2Api.CreatePlacementGroup(reqParams)
-	if err != nil {
-		LogErrorResponse("CreatePlacementGroup", err, reqParams)
-		return err
+	reqParams := &vserver.CreatePlacementGroupRequest{
+		RegionCode: &p.config.RegionCode,
+	}
+	if !plan.Name.IsNull() && !plan.Name.IsUnknown() {
+		reqParams.PlacementGroupName = plan.Name.ValueStringPointer()
+	}
+	if !plan.PlacementGroupType.IsNull() && !plan.PlacementGroupType.IsUnknown() {
+		reqParams.PlacementGroupTypeCode = plan.PlacementGroupType.ValueStringPointer()
 	}
 
-	LogResponse("CreatePlacementGroup", resp)
+	ctx = common.LogCommonRequest(ctx, "CreatePlacementGroup", reqParams)
 
-	instance := resp.PlacementGroupList[0]
-	d.SetId(*instance.PlacementGroupNo)
+	response, err := p.config.Client.Vserver.V2Api.CreatePlacementGroup(reqParams)
+	if err != nil {
+		resp.Diagnostics.AddError("CREATING ERROR", err.Error())
+		return
+	}
 
-	log.Printf("[INFO] Placement Group ID: %s", d.Id())
+	common.LogResponse(ctx, "CreatePlacementGroup", response)
 
-	return resourceNcloudPlacementGroupRead(d, meta)
+	plan.refreshFromOutput(response.PlacementGroupList[0])
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
-func resourceNcloudPlacementGroupRead(d *schema.ResourceData, meta interface{}) error {
-	config := meta.(*conn.ProviderConfig)
+func (p *placementGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state placementGroupResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	instance, err := GetPlacementGroupInstance(config, d.Id())
+	output, err := GetPlacementGroupInstance(ctx, p.config, state.ID.ValueString())
 	if err != nil {
-		return err
+		resp.Diagnostics.AddError("READING ERROR", err.Error())
+		return
 	}
 
-	if instance == nil {
-		d.SetId("")
-		return nil
+	if output == nil {
+		resp.State.RemoveResource(ctx)
+		return
 	}
 
-	d.SetId(*instance.PlacementGroupNo)
-	d.Set("placement_group_no", instance.PlacementGroupNo)
-	d.Set("placement_group_type", instance.PlacementGroupType.Code)
-	d.Set("name", instance.PlacementGroupName)
+	state.refreshFromOutput(output)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
 
-	return nil
+func (p *placementGroupResource) Update(_ context.Context, _ resource.UpdateRequest, _ *resource.UpdateResponse) {
 }
 
-func resourceNcloudPlacementGroupDelete(d *schema.ResourceData, meta interface{}) error {
-	config := meta.(*conn.ProviderConfig)
+func (p *placementGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state placementGroupResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	reqParams := &vserver.DeletePlacementGroupRequest{
-		RegionCode:       &config.RegionCode,
-		PlacementGroupNo: ncloud.String(d.Get("placement_group_no").(string)),
+		RegionCode:       &p.config.RegionCode,
+		PlacementGroupNo: state.ID.ValueStringPointer(),
 	}
 
-	LogCommonRequest("DeletePlacementGroup", reqParams)
-	resp, err := config.Client.Vserver.V2Api.DeletePlacementGroup(reqParams)
+	ctx = common.LogCommonRequest(ctx, "DeletePlacementGroup", reqParams)
+
+	response, err := p.config.Client.Vserver.V2Api.DeletePlacementGroup(reqParams)
 	if err != nil {
-		LogErrorResponse("DeletePlacementGroup", err, reqParams)
-		return err
+		resp.Diagnostics.AddError("DELETING ERROR", err.Error())
+		return
 	}
 
-	LogResponse("DeletePlacementGroup", resp)
+	common.LogResponse(ctx, "DeletePlacementGroup", response)
+}
 
-	return nil
+func (p *placementGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
-func GetPlacementGroupInstance(config *conn.ProviderConfig, id string) (*vserver.PlacementGroup, error) {
+func GetPlacementGroupInstance(ctx context.Context, config *conn.ProviderConfig, id string) (*vserver.PlacementGroup, error) {
 	reqParams := &vserver.GetPlacementGroupDetailRequest{
 		RegionCode:       &config.RegionCode,
 		PlacementGroupNo: ncloud.String(id),
 	}
 
-	LogCommonRequest("GetPlacementGroupDetail", reqParams)
+	ctx = common.LogCommonRequest(ctx, "GetPlacementGroupDetail", reqParams)
 	resp, err := config.Client.Vserver.V2Api.GetPlacementGroupDetail(reqParams)
 	if err != nil {
-		LogErrorResponse("GetPlacementGroupDetail", err, reqParams)
+		common.LogErrorResponse(ctx, "GetPlacementGroupDetail", err, reqParams)
 		return nil, err
 	}
-	LogResponse("GetPlacementGroupDetail", resp)
+	common.LogResponse(ctx, "GetPlacementGroupDetail", resp)
 
 	if len(resp.PlacementGroupList) > 0 {
 		return resp.PlacementGroupList[0], nil
@@ -141,3 +215,17 @@ func GetPlacementGroupInstance(config *conn.ProviderConfig, id string) (*vserver
 
 	return nil, nil
 }
+
+type placementGroupResourceModel struct {
+	ID                 types.String `tfsdk:"id"`
+	Name               types.String `tfsdk:"name"`
+	PlacementGroupType types.String `tfsdk:"placement_group_type"`
+	PlacementGroupNo   types.String `tfsdk:"placement_group_no"`
+}
+
+func (m *placementGroupResourceModel) refreshFromOutput(output *vserver.PlacementGroup) {
+	m.ID = types.StringPointerValue(output.PlacementGroupNo)
+	m.Name = types.StringPointerValue(output.PlacementGroupName)
+	m.PlacementGroupType = types.StringPointerValue(output.PlacementGroupType.Code)
+	m.PlacementGroupNo = types.StringPointerValue(output.PlacementGroupNo)
+}
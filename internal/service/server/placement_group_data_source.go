@@ -1,111 +1,150 @@
 package server
 
 import (
-	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"context"
+	"fmt"
+
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 
-	. "github.com/terraform-providers/terraform-provider-ncloud/internal/common"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/verify"
 )
 
-func DataSourceNcloudPlacementGroup() *schema.Resource {
-	fieldMap := map[string]*schema.Schema{
-		"id": {
-			Type:     schema.TypeString,
-			Optional: true,
-			Computed: true,
-		},
-		"name": {
-			Type:             schema.TypeString,
-			Optional:         true,
-			Computed:         true,
-			ValidateDiagFunc: validation.ToDiagFunc(verify.ValidateInstanceName),
-		},
-		"placement_group_type": {
-			Type:             schema.TypeString,
-			Optional:         true,
-			Computed:         true,
-			ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"AA"}, false)),
-		},
-		"filter": DataSourceFiltersSchema(),
-	}
+var (
+	_ datasource.DataSource              = &placementGroupDataSource{}
+	_ datasource.DataSourceWithConfigure = &placementGroupDataSource{}
+)
 
-	return GetSingularDataSourceItemSchema(ResourceNcloudPlacementGroup(), fieldMap, dataSourceNcloudPlacementGroupRead)
+func NewPlacementGroupDataSource() datasource.DataSource {
+	return &placementGroupDataSource{}
 }
 
-func dataSourceNcloudPlacementGroupRead(d *schema.ResourceData, meta interface{}) error {
-	config := meta.(*conn.ProviderConfig)
-
-	if !config.SupportVPC {
-		return NotSupportClassic("data source `ncloud_placement_group`")
-	}
+type placementGroupDataSource struct {
+	config *conn.ProviderConfig
+}
 
-	resources, err := getPlacementGroupListFiltered(d, config)
+func (p *placementGroupDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_placement_group"
+}
 
-	if err != nil {
-		return err
+func (p *placementGroupDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"name": schema.StringAttribute{
+				Optional:   true,
+				Computed:   true,
+				Validators: verify.InstanceNameValidator(),
+			},
+			"placement_group_type": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("AA"),
+				},
+			},
+			"placement_group_no": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"filter": common.DataSourceFiltersBlock(),
+		},
 	}
+}
 
-	if err := verify.ValidateOneResult(len(resources)); err != nil {
-		return err
+func (p *placementGroupDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
 	}
 
-	SetSingularResourceDataFromMap(d, resources[0])
+	config, ok := req.ProviderData.(*conn.ProviderConfig)
 
-	return nil
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	p.config = config
 }
 
-func getPlacementGroupList(d *schema.ResourceData, config *conn.ProviderConfig) (*vserver.GetPlacementGroupListResponse, error) {
-	reqParams := &vserver.GetPlacementGroupListRequest{
-		RegionCode: &config.RegionCode,
+func (p *placementGroupDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if !p.config.SupportVPC {
+		resp.Diagnostics.AddError(
+			"NOT SUPPORT CLASSIC",
+			"data source `ncloud_placement_group` does not support classic",
+		)
+		return
 	}
 
-	if v, ok := d.GetOk("id"); ok {
-		reqParams.PlacementGroupNoList = []*string{ncloud.String(v.(string))}
+	var data placementGroupDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	if v, ok := d.GetOk("name"); ok {
-		reqParams.PlacementGroupName = ncloud.String(v.(string))
+	reqParams := &vserver.GetPlacementGroupListRequest{
+		RegionCode: &p.config.RegionCode,
+	}
+	if !data.ID.IsNull() && !data.ID.IsUnknown() {
+		reqParams.PlacementGroupNoList = []*string{data.ID.ValueStringPointer()}
+	}
+	if !data.Name.IsNull() && !data.Name.IsUnknown() {
+		reqParams.PlacementGroupName = data.Name.ValueStringPointer()
 	}
 
-	LogCommonRequest("GetPlacementGroupList", reqParams)
-	resp, err := config.Client.Vserver.V2Api.GetPlacementGroupList(reqParams)
+	ctx = common.LogCommonRequest(ctx, "GetPlacementGroupList", reqParams)
 
+	response, err := p.config.Client.Vserver.V2Api.GetPlacementGroupList(reqParams)
 	if err != nil {
-		LogErrorResponse("GetPlacementGroupList", err, reqParams)
-		return nil, err
+		resp.Diagnostics.AddError("READING ERROR", err.Error())
+		return
 	}
 
-	LogResponse("GetPlacementGroupList", resp)
-	return resp, nil
-}
-
-func getPlacementGroupListFiltered(d *schema.ResourceData, config *conn.ProviderConfig) ([]map[string]interface{}, error) {
-	resp, err := getPlacementGroupList(d, config)
+	common.LogResponse(ctx, "GetPlacementGroupList", response)
 
-	if err != nil {
-		return nil, err
+	var placementGroups []*placementGroupDataSourceModel
+	for _, r := range response.PlacementGroupList {
+		var output placementGroupDataSourceModel
+		output.refreshFromOutput(r)
+		placementGroups = append(placementGroups, &output)
 	}
 
-	resources := []map[string]interface{}{}
+	filtered := common.FilterModels(ctx, data.Filters, placementGroups)
+	if err := verify.ValidateOneResult(len(filtered)); err != nil {
+		resp.Diagnostics.AddError("READING ERROR", err.Error())
+		return
+	}
 
-	for _, r := range resp.PlacementGroupList {
-		instance := map[string]interface{}{
-			"id":                   *r.PlacementGroupNo,
-			"placement_group_no":   *r.PlacementGroupNo,
-			"name":                 *r.PlacementGroupName,
-			"placement_group_type": *r.PlacementGroupType.Code,
-		}
+	state := filtered[0]
+	state.Filters = data.Filters
 
-		resources = append(resources, instance)
-	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
 
-	if f, ok := d.GetOk("filter"); ok {
-		resources = ApplyFilters(f.(*schema.Set), resources, ResourceNcloudPlacementGroup().Schema)
-	}
+type placementGroupDataSourceModel struct {
+	ID                 types.String `tfsdk:"id"`
+	Name               types.String `tfsdk:"name"`
+	PlacementGroupType types.String `tfsdk:"placement_group_type"`
+	PlacementGroupNo   types.String `tfsdk:"placement_group_no"`
+	Filters            types.Set    `tfsdk:"filter"`
+}
 
-	return resources, nil
+func (m *placementGroupDataSourceModel) refreshFromOutput(output *vserver.PlacementGroup) {
+	m.ID = types.StringPointerValue(output.PlacementGroupNo)
+	m.Name = types.StringPointerValue(output.PlacementGroupName)
+	m.PlacementGroupType = types.StringPointerValue(output.PlacementGroupType.Code)
+	m.PlacementGroupNo = types.StringPointerValue(output.PlacementGroupNo)
 }
@@ -4,14 +4,13 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/acctest"
 )
 
 func TestAccDataSourceNcloudPlacementGroup_basic(t *testing.T) {
-	name := fmt.Sprintf("tf-pl-group-data-%s", acctest.RandString(5))
+	name := fmt.Sprintf("tf-pl-group-data-%s", RandString(t, 5))
 	resourceName := "ncloud_placement_group.foo"
 	dataName := "data.ncloud_placement_group.by_id"
 	dataNameFilter := "data.ncloud_placement_group.by_filter"
@@ -1,13 +1,13 @@
 package server_test
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"regexp"
 	"testing"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 
@@ -19,7 +19,7 @@ import (
 func TestAccResourceNcloudPlacementGroup_basic(t *testing.T) {
 	var PlacementGroup vserver.PlacementGroup
 	resourceName := "ncloud_placement_group.test"
-	name := fmt.Sprintf("tf-pl-group-basic-%s", acctest.RandString(5))
+	name := fmt.Sprintf("tf-pl-group-basic-%s", RandString(t, 5))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { TestAccPreCheck(t) },
@@ -47,7 +47,7 @@ func TestAccResourceNcloudPlacementGroup_basic(t *testing.T) {
 func TestAccResourceNcloudPlacementGroup_disappears(t *testing.T) {
 	var PlacementGroup vserver.PlacementGroup
 	resourceName := "ncloud_placement_group.test"
-	name := fmt.Sprintf("tf-pl-group-disappear-%s", acctest.RandString(5))
+	name := fmt.Sprintf("tf-pl-group-disappear-%s", RandString(t, 5))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { TestAccPreCheck(t) },
@@ -69,7 +69,7 @@ func TestAccResourceNcloudPlacementGroup_disappears(t *testing.T) {
 func TestAccResourceNcloudPlacementGroup_updateName(t *testing.T) {
 	var PlacementGroup vserver.PlacementGroup
 	resourceName := "ncloud_placement_group.test"
-	name := fmt.Sprintf("tf-pl-group-update-%s", acctest.RandString(5))
+	name := fmt.Sprintf("tf-pl-group-update-%s", RandString(t, 5))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { TestAccPreCheck(t) },
@@ -111,7 +111,7 @@ func testAccCheckPlacementGroupExists(n string, PlacementGroup *vserver.Placemen
 		}
 
 		config := GetTestProvider(true).Meta().(*conn.ProviderConfig)
-		instance, err := server.GetPlacementGroupInstance(config, rs.Primary.ID)
+		instance, err := server.GetPlacementGroupInstance(context.Background(), config, rs.Primary.ID)
 		if err != nil {
 			return err
 		}
@@ -130,7 +130,7 @@ func testAccCheckPlacementGroupDestroy(s *terraform.State) error {
 			continue
 		}
 
-		instance, err := server.GetPlacementGroupInstance(config, rs.Primary.ID)
+		instance, err := server.GetPlacementGroupInstance(context.Background(), config, rs.Primary.ID)
 
 		if err != nil {
 			return err
@@ -1,15 +1,15 @@
 package server
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strconv"
 	"strings"
-	"time"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -20,12 +20,12 @@ import (
 
 func ResourceNcloudPortForwadingRule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNcloudPortForwardingRuleCreate,
-		Read:   resourceNcloudPortForwardingRuleRead,
-		Update: resourceNcloudPortForwardingRuleUpdate,
-		Delete: resourceNcloudPortForwardingRuleDelete,
+		CreateContext: resourceNcloudPortForwardingRuleCreate,
+		ReadContext:   resourceNcloudPortForwardingRuleRead,
+		UpdateContext: resourceNcloudPortForwardingRuleUpdate,
+		DeleteContext: resourceNcloudPortForwardingRuleDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(conn.DefaultCreateTimeout),
@@ -74,12 +74,12 @@ func ResourceNcloudPortForwadingRule() *schema.Resource {
 	}
 }
 
-func resourceNcloudPortForwardingRuleCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudPortForwardingRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*conn.ProviderConfig)
 
-	portForwardingConfigurationNo, err := getPortForwardingConfigurationNo(d, meta)
+	portForwardingConfigurationNo, err := getPortForwardingConfigurationNo(ctx, d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var portForwardingExternalPort int32
@@ -92,9 +92,9 @@ func resourceNcloudPortForwardingRuleCreate(d *schema.ResourceData, meta interfa
 	}
 
 	serverInstanceNo := d.Get("server_instance_no").(string)
-	zoneNo, err := getServerZoneNo(config, serverInstanceNo)
+	zoneNo, err := getServerZoneNo(ctx, config, serverInstanceNo)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	newPortForwardingRuleId := PortForwardingRuleId(portForwardingConfigurationNo, zoneNo, portForwardingExternalPort)
@@ -111,43 +111,29 @@ func resourceNcloudPortForwardingRuleCreate(d *schema.ResourceData, meta interfa
 		},
 	}
 
-	var resp *server.AddPortForwardingRulesResponse
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		var err error
-		LogCommonRequest("AddPortForwardingRules", reqParams)
-		resp, err = config.Client.Server.V2Api.AddPortForwardingRules(reqParams)
-		if err != nil {
-			errBody, _ := GetCommonErrorBody(err)
-			if ContainsInStringList(errBody.ReturnCode, []string{ApiErrorUnknown, ApiErrorPortForwardingObjectInOperation}) {
-				LogErrorResponse("retry AddPortForwardingRules", err, reqParams)
-				time.Sleep(time.Second * 5)
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		LogResponse("AddPortForwardingRules", resp)
-		return nil
-	})
-
+	ctx = LogCommonRequest(ctx, "AddPortForwardingRules", reqParams)
+	resp, err := config.Client.Server.V2Api.AddPortForwardingRules(reqParams)
 	if err != nil {
-		LogErrorResponse("AddPortForwardingRules", err, reqParams)
-		return err
+		LogErrorResponse(ctx, "AddPortForwardingRules", err, reqParams)
+		return diag.FromErr(err)
 	}
+	LogResponse(ctx, "AddPortForwardingRules", resp)
+
 	d.SetId(newPortForwardingRuleId)
-	return resourceNcloudPortForwardingRuleRead(d, meta)
+	return resourceNcloudPortForwardingRuleRead(ctx, d, meta)
 }
 
-func resourceNcloudPortForwardingRuleRead(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudPortForwardingRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*conn.ProviderConfig).Client
 
 	_, zoneNo, portForwardingExternalPort, err := ParsePortForwardingRuleId(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	resp, err := getPortForwardingRuleList(client, zoneNo)
+	resp, err := getPortForwardingRuleList(ctx, client, zoneNo)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var portForwardingRule *server.PortForwardingRule
@@ -175,16 +161,16 @@ func resourceNcloudPortForwardingRuleRead(d *schema.ResourceData, meta interface
 	return nil
 }
 
-func resourceNcloudPortForwardingRuleUpdate(d *schema.ResourceData, meta interface{}) error {
-	return resourceNcloudPortForwardingRuleRead(d, meta)
+func resourceNcloudPortForwardingRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceNcloudPortForwardingRuleRead(ctx, d, meta)
 }
 
-func resourceNcloudPortForwardingRuleDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudPortForwardingRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*conn.ProviderConfig).Client
 
-	portForwardingConfigurationNo, err := getPortForwardingConfigurationNo(d, meta)
+	portForwardingConfigurationNo, err := getPortForwardingConfigurationNo(ctx, d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	var portForwardingExternalPort int32
 	if v, ok := d.GetOk("port_forwarding_external_port"); ok {
@@ -207,28 +193,14 @@ func resourceNcloudPortForwardingRuleDelete(d *schema.ResourceData, meta interfa
 		},
 	}
 
-	var resp *server.DeletePortForwardingRulesResponse
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		var err error
-		LogCommonRequest("DeletePortForwardingRules", reqParams)
-		resp, err = client.Server.V2Api.DeletePortForwardingRules(reqParams)
-		if err != nil {
-			errBody, _ := GetCommonErrorBody(err)
-			if ContainsInStringList(errBody.ReturnCode, []string{ApiErrorUnknown, ApiErrorPortForwardingObjectInOperation}) {
-				LogErrorResponse("retry DeletePortForwardingRules", err, reqParams)
-				time.Sleep(time.Second * 5)
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		LogResponse("DeletePortForwardingRules", resp)
-		return nil
-	})
-
+	ctx = LogCommonRequest(ctx, "DeletePortForwardingRules", reqParams)
+	resp, err := client.Server.V2Api.DeletePortForwardingRules(reqParams)
 	if err != nil {
-		LogErrorResponse("DeletePortForwardingRules", err, reqParams)
-		return err
+		LogErrorResponse(ctx, "DeletePortForwardingRules", err, reqParams)
+		return diag.FromErr(err)
 	}
+	LogResponse(ctx, "DeletePortForwardingRules", resp)
+
 	d.SetId("")
 	return nil
 }
@@ -249,7 +221,7 @@ func ParsePortForwardingRuleId(id string) (string, string, int32, error) {
 	return "", "", 0, fmt.Errorf("unexpected format for ID (%[1]s), expected port_forwarding_configuration_no:zone_no:port_forwarding_external_port", id)
 }
 
-func getPortForwardingConfigurationNo(d *schema.ResourceData, meta interface{}) (string, error) {
+func getPortForwardingConfigurationNo(ctx context.Context, d *schema.ResourceData, meta interface{}) (string, error) {
 	config := meta.(*conn.ProviderConfig)
 
 	paramPortForwardingConfigurationNo, ok := d.GetOk("port_forwarding_configuration_no")
@@ -257,7 +229,7 @@ func getPortForwardingConfigurationNo(d *schema.ResourceData, meta interface{})
 	if ok {
 		portForwardingConfigurationNo = paramPortForwardingConfigurationNo.(string)
 	} else {
-		resp, err := getPortForwardingConfigurationList(d, config)
+		resp, err := getPortForwardingConfigurationList(ctx, d, config)
 		if err != nil {
 			return "", err
 		}
@@ -271,41 +243,41 @@ func getPortForwardingConfigurationNo(d *schema.ResourceData, meta interface{})
 	return portForwardingConfigurationNo, nil
 }
 
-func getPortForwardingConfigurationList(d *schema.ResourceData, config *conn.ProviderConfig) (*server.GetPortForwardingConfigurationListResponse, error) {
+func getPortForwardingConfigurationList(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) (*server.GetPortForwardingConfigurationListResponse, error) {
 	reqParams := &server.GetPortForwardingConfigurationListRequest{
 		RegionNo:             ncloud.String(config.RegionNo),
 		ServerInstanceNoList: []*string{ncloud.String(d.Get("server_instance_no").(string))},
 	}
-	LogCommonRequest("GetPortForwardingConfigurationList", reqParams)
+	ctx = LogCommonRequest(ctx, "GetPortForwardingConfigurationList", reqParams)
 
 	resp, err := config.Client.Server.V2Api.GetPortForwardingConfigurationList(reqParams)
 	if err != nil {
-		LogErrorResponse("GetPortForwardingConfigurationList", err, reqParams)
+		LogErrorResponse(ctx, "GetPortForwardingConfigurationList", err, reqParams)
 		return nil, err
 	}
-	LogResponse("GetPortForwardingConfigurationList", resp)
+	LogResponse(ctx, "GetPortForwardingConfigurationList", resp)
 
 	return resp, nil
 }
 
-func getPortForwardingRuleList(client *conn.NcloudAPIClient, zoneNo string) (*server.GetPortForwardingRuleListResponse, error) {
+func getPortForwardingRuleList(ctx context.Context, client *conn.NcloudAPIClient, zoneNo string) (*server.GetPortForwardingRuleListResponse, error) {
 	reqParams := &server.GetPortForwardingRuleListRequest{
 		ZoneNo: ncloud.String(zoneNo),
 	}
-	LogCommonRequest("GetPortForwardingRuleList", reqParams)
+	ctx = LogCommonRequest(ctx, "GetPortForwardingRuleList", reqParams)
 
 	resp, err := client.Server.V2Api.GetPortForwardingRuleList(reqParams)
 	if err != nil {
-		LogErrorResponse("GetPortForwardingRuleList", err, reqParams)
+		LogErrorResponse(ctx, "GetPortForwardingRuleList", err, reqParams)
 		return nil, err
 	}
-	LogResponse("GetPortForwardingRuleList", resp)
+	LogResponse(ctx, "GetPortForwardingRuleList", resp)
 
 	return resp, nil
 }
 
-func GetPortForwardingRule(client *conn.NcloudAPIClient, zoneNo string, portForwardingExternalPort int32) (*server.PortForwardingRule, error) {
-	resp, err := getPortForwardingRuleList(client, zoneNo)
+func GetPortForwardingRule(ctx context.Context, client *conn.NcloudAPIClient, zoneNo string, portForwardingExternalPort int32) (*server.PortForwardingRule, error) {
+	resp, err := getPortForwardingRuleList(ctx, client, zoneNo)
 	if err != nil {
 		return nil, err
 	}
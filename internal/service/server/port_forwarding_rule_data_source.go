@@ -1,11 +1,13 @@
 package server
 
 import (
+	"context"
 	"log"
 	"strconv"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -17,7 +19,7 @@ import (
 
 func DataSourceNcloudPortForwardingRule() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNcloudPortForwardingRuleRead,
+		ReadContext: dataSourceNcloudPortForwardingRuleRead,
 
 		Schema: map[string]*schema.Schema{
 			// Deprecated
@@ -71,34 +73,34 @@ func DataSourceNcloudPortForwardingRule() *schema.Resource {
 	}
 }
 
-func dataSourceNcloudPortForwardingRuleRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceNcloudPortForwardingRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*conn.ProviderConfig)
 	client := config.Client
 
 	if config.SupportVPC {
-		return NotSupportVpc("data source `ncloud_port_forwarding_rule`")
+		return diag.FromErr(NotSupportVpc("data source `ncloud_port_forwarding_rule`"))
 	}
 
-	regionNo, err := conn.ParseRegionNoParameter(d)
+	regionNo, err := conn.ParseRegionNoParameter(config, d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	zoneNo, err := zone.ParseZoneNoParameter(config, d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	reqParams := &server.GetPortForwardingRuleListRequest{
 		RegionNo: regionNo,
 		ZoneNo:   zoneNo,
 	}
 
-	LogCommonRequest("GetPortForwardingRuleList", reqParams)
+	ctx = LogCommonRequest(ctx, "GetPortForwardingRuleList", reqParams)
 	resp, err := client.Server.V2Api.GetPortForwardingRuleList(reqParams)
 	if err != nil {
-		LogErrorResponse("GetPortForwardingRuleList", err, reqParams)
-		return err
+		LogErrorResponse(ctx, "GetPortForwardingRuleList", err, reqParams)
+		return diag.FromErr(err)
 	}
-	LogResponse("GetPortForwardingRuleList", resp)
+	LogResponse(ctx, "GetPortForwardingRuleList", resp)
 
 	allPortForwardingRules := resp.PortForwardingRuleList
 	var filteredPortForwardingRuleList []*server.PortForwardingRule
@@ -122,11 +124,11 @@ func dataSourceNcloudPortForwardingRuleRead(d *schema.ResourceData, meta interfa
 	}
 
 	if err := verify.ValidateOneResult(len(filteredPortForwardingRuleList)); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	portForwardingRule = filteredPortForwardingRuleList[0]
 
-	return portForwardingRuleAttributes(d, resp.PortForwardingRuleList[0].PortForwardingConfigurationNo, portForwardingRule)
+	return diag.FromErr(portForwardingRuleAttributes(d, resp.PortForwardingRuleList[0].PortForwardingConfigurationNo, portForwardingRule))
 }
 
 func portForwardingRuleAttributes(d *schema.ResourceData, portForwardingConfigurationNo *string, rule *server.PortForwardingRule) error {
@@ -1,6 +1,7 @@
 package server_test
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"math/rand"
@@ -9,7 +10,6 @@ import (
 	"time"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -34,7 +34,7 @@ func TestAccResourceNcloudPortForwardingRuleBasic(t *testing.T) {
 		CheckDestroy:             testAccCheckPortForwardingRuleDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccPortForwardingRuleBasicConfig(externalPort),
+				Config: testAccPortForwardingRuleBasicConfig(GetTestPrefix(t), externalPort),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckPortForwardingRuleExists("ncloud_port_forwarding_rule.test", &portForwarding),
 					resource.TestCheckResourceAttr(
@@ -67,7 +67,7 @@ func generateExternalPort(min, max int32) int32 {
 func ignore_TestAccResourceNcloudPortForwardingRuleExistingServer(t *testing.T) {
 	var portForwarding server.PortForwardingRule
 
-	externalPort := acctest.RandIntRange(1024, 65534+1024)
+	externalPort := RandIntRange(t, 1024, 65534+1024)
 	log.Printf("[DEBUG] externalPort: %d", externalPort)
 
 	resource.Test(t, resource.TestCase{
@@ -119,7 +119,7 @@ func testAccCheckPortForwardingRuleExistsWithProvider(n string, i *server.PortFo
 			return err
 		}
 
-		portForwardingRule, err := serverservice.GetPortForwardingRule(client, zoneNo, portForwardingExternalPort)
+		portForwardingRule, err := serverservice.GetPortForwardingRule(context.Background(), client, zoneNo, portForwardingExternalPort)
 		if err != nil {
 			return err
 		}
@@ -149,7 +149,7 @@ func testAccCheckPortForwardingRuleDestroyWithProvider(s *terraform.State, provi
 			return err
 		}
 
-		rule, err := serverservice.GetPortForwardingRule(client, zoneNo, portForwardingExternalPort)
+		rule, err := serverservice.GetPortForwardingRule(context.Background(), client, zoneNo, portForwardingExternalPort)
 		if rule == nil {
 			return nil
 		}
@@ -164,8 +164,7 @@ func testAccCheckPortForwardingRuleDestroyWithProvider(s *terraform.State, provi
 	return nil
 }
 
-func testAccPortForwardingRuleBasicConfig(externalPort int) string {
-	prefix := GetTestPrefix()
+func testAccPortForwardingRuleBasicConfig(prefix string, externalPort int) string {
 	testServerName := prefix + "-vm"
 	return fmt.Sprintf(`
 resource "ncloud_login_key" "loginkey" {
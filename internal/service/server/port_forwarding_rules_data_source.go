@@ -1,11 +1,13 @@
 package server
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -16,7 +18,7 @@ import (
 
 func DataSourceNcloudPortForwardingRules() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNcloudPortForwardingRulesRead,
+		ReadContext: dataSourceNcloudPortForwardingRulesRead,
 
 		Schema: map[string]*schema.Schema{
 			// Deprecated
@@ -85,34 +87,34 @@ func DataSourceNcloudPortForwardingRules() *schema.Resource {
 	}
 }
 
-func dataSourceNcloudPortForwardingRulesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceNcloudPortForwardingRulesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*conn.ProviderConfig)
 	client := config.Client
 
 	if config.SupportVPC {
-		return NotSupportVpc("data source `ncloud_port_forwarding_rules`")
+		return diag.FromErr(NotSupportVpc("data source `ncloud_port_forwarding_rules`"))
 	}
 
-	regionNo, err := conn.ParseRegionNoParameter(d)
+	regionNo, err := conn.ParseRegionNoParameter(config, d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	zoneNo, err := zone.ParseZoneNoParameter(config, d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	reqParams := &server.GetPortForwardingRuleListRequest{
 		RegionNo: regionNo,
 		ZoneNo:   zoneNo,
 	}
 
-	LogCommonRequest("GetPortForwardingRuleList", reqParams)
+	ctx = LogCommonRequest(ctx, "GetPortForwardingRuleList", reqParams)
 	resp, err := client.Server.V2Api.GetPortForwardingRuleList(reqParams)
 	if err != nil {
-		LogErrorResponse("GetPortForwardingRuleList", err, reqParams)
-		return err
+		LogErrorResponse(ctx, "GetPortForwardingRuleList", err, reqParams)
+		return diag.FromErr(err)
 	}
-	LogCommonResponse("GetPortForwardingRuleList", GetCommonResponse(resp))
+	LogCommonResponse(ctx, "GetPortForwardingRuleList", GetCommonResponse(resp))
 
 	allPortForwardingRules := resp.PortForwardingRuleList
 	var filteredPortForwardingRuleList []*server.PortForwardingRule
@@ -129,9 +131,9 @@ func dataSourceNcloudPortForwardingRulesRead(d *schema.ResourceData, meta interf
 	}
 
 	if len(filteredPortForwardingRuleList) < 1 {
-		return fmt.Errorf("no results. please change search criteria and try again")
+		return diag.FromErr(fmt.Errorf("no results. please change search criteria and try again"))
 	}
-	return portForwardingRulesAttributes(d, resp.PortForwardingRuleList[0].PortForwardingConfigurationNo, filteredPortForwardingRuleList)
+	return diag.FromErr(portForwardingRulesAttributes(d, resp.PortForwardingRuleList[0].PortForwardingConfigurationNo, filteredPortForwardingRuleList))
 }
 
 func portForwardingRulesAttributes(d *schema.ResourceData, portForwardingConfigurationNo *string, portForwardingRuleList []*server.PortForwardingRule) error {
@@ -9,24 +9,25 @@ import (
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/verify"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/waiter"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/zone"
 )
 
 func ResourceNcloudPublicIpInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNcloudPublicIpCreate,
-		Read:   resourceNcloudPublicIpRead,
-		Update: resourceNcloudPublicIpUpdate,
-		Delete: resourceNcloudPublicIpDelete,
+		CreateContext: resourceNcloudPublicIpCreate,
+		ReadContext:   resourceNcloudPublicIpRead,
+		UpdateContext: resourceNcloudPublicIpUpdate,
+		DeleteContext: resourceNcloudPublicIpDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		CustomizeDiff: resourceNcloudPublicIpCustomizeDiff,
 		Schema: map[string]*schema.Schema{
@@ -77,40 +78,40 @@ func ResourceNcloudPublicIpInstance() *schema.Resource {
 	}
 }
 
-func resourceNcloudPublicIpCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudPublicIpCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*conn.ProviderConfig)
 	var publicIpInstanceNo *string
 	var err error
 
 	if config.SupportVPC {
-		publicIpInstanceNo, err = createVpcPublicIp(d, config)
+		publicIpInstanceNo, err = createVpcPublicIp(ctx, d, config)
 	} else {
-		publicIpInstanceNo, err = createClassicPublicIp(d, config)
+		publicIpInstanceNo, err = createClassicPublicIp(ctx, d, config)
 	}
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(ncloud.StringValue(publicIpInstanceNo))
 	log.Printf("[INFO] Public IP ID: %s", d.Id())
 
 	if v, ok := d.GetOk("server_instance_no"); ok && v != "" {
-		if err := waitForPublicIpAssociation(config, d.Id()); err != nil {
-			return err
+		if err := waitForPublicIpAssociation(ctx, config, d.Id()); err != nil {
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceNcloudPublicIpRead(d, meta)
+	return resourceNcloudPublicIpRead(ctx, d, meta)
 }
 
-func resourceNcloudPublicIpRead(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudPublicIpRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*conn.ProviderConfig)
 
-	resource, err := GetPublicIp(config, d.Id())
+	resource, err := GetPublicIp(ctx, config, d.Id())
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if resource == nil {
@@ -121,77 +122,66 @@ func resourceNcloudPublicIpRead(d *schema.ResourceData, meta interface{}) error
 	instance := ConvertToMap(resource)
 	SetSingularResourceDataFromMapSchema(ResourceNcloudPublicIpInstance(), d, instance)
 	if err := d.Set("public_ip_no", resource.PublicIpInstanceNo); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("server_instance_no", resource.ServerInstanceNo); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
 }
 
-func resourceNcloudPublicIpDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudPublicIpDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*conn.ProviderConfig)
 	var err error
 
 	// Check associated public ip
-	if associated, err := checkAssociatedPublicIP(config, d.Id()); associated {
+	if associated, err := checkAssociatedPublicIP(ctx, config, d.Id()); associated {
 		// if associated public ip, disassociated the public ip
-		if err := disassociatedPublicIp(config, d.Id()); err != nil {
-			return err
+		if err := disassociatedPublicIp(ctx, config, d.Id()); err != nil {
+			return diag.FromErr(err)
 		}
 	} else if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if config.SupportVPC {
-		err = deleteVpcPublicIp(d, config)
+		err = deleteVpcPublicIp(ctx, d, config)
 	} else {
-		err = deleteClassicPublicIp(d, config)
+		err = deleteClassicPublicIp(ctx, d, config)
 	}
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId("")
 	return nil
 }
 
-func resourceNcloudPublicIpUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudPublicIpUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*conn.ProviderConfig)
 
 	if d.HasChange("server_instance_no") {
 		o, n := d.GetChange("server_instance_no")
 		if len(o.(string)) > 0 {
-			if err := disassociatedPublicIp(config, d.Id()); err != nil {
-				return err
+			if err := disassociatedPublicIp(ctx, config, d.Id()); err != nil {
+				return diag.FromErr(err)
 			}
 		}
 
 		if len(n.(string)) > 0 {
-			if err := resource.Retry(time.Minute, func() *resource.RetryError {
-				if err := associatedPublicIp(d, config); err != nil {
-					errBody, _ := GetCommonErrorBody(err)
-					if errBody.ReturnCode == "1003016" {
-						time.Sleep(time.Second * 1)
-						return resource.RetryableError(err)
-					}
-					return resource.NonRetryableError(err)
-				}
-				return nil
-			}); err != nil {
-				return err
+			if err := associatedPublicIp(ctx, d, config); err != nil {
+				return diag.FromErr(err)
 			}
-
 		}
 	}
 
-	return resourceNcloudPublicIpRead(d, meta)
+	return resourceNcloudPublicIpRead(ctx, d, meta)
 }
 
-func createClassicPublicIp(d *schema.ResourceData, config *conn.ProviderConfig) (*string, error) {
+func createClassicPublicIp(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) (*string, error) {
 	client := config.Client
 
 	zoneNo, err := zone.ParseZoneNoParameter(config, d)
@@ -206,21 +196,21 @@ func createClassicPublicIp(d *schema.ResourceData, config *conn.ProviderConfig)
 		PublicIpDescription: StringPtrOrNil(d.GetOk("description")),
 	}
 
-	LogCommonRequest("createClassicPublicIp", reqParams)
+	ctx = LogCommonRequest(ctx, "createClassicPublicIp", reqParams)
 
 	resp, err := client.Server.V2Api.CreatePublicIpInstance(reqParams)
 	if err != nil {
-		LogErrorResponse("createClassicPublicIp", err, reqParams)
+		LogErrorResponse(ctx, "createClassicPublicIp", err, reqParams)
 		return nil, err
 	}
-	LogResponse("createClassicPublicIp", resp)
+	LogResponse(ctx, "createClassicPublicIp", resp)
 
 	publicIPInstance := resp.PublicIpInstanceList[0]
 
 	return publicIPInstance.PublicIpInstanceNo, nil
 }
 
-func createVpcPublicIp(d *schema.ResourceData, config *conn.ProviderConfig) (*string, error) {
+func createVpcPublicIp(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) (*string, error) {
 	client := config.Client
 
 	reqParams := &vserver.CreatePublicIpInstanceRequest{
@@ -229,40 +219,40 @@ func createVpcPublicIp(d *schema.ResourceData, config *conn.ProviderConfig) (*st
 		PublicIpDescription: StringPtrOrNil(d.GetOk("description")),
 	}
 
-	LogCommonRequest("createVpcPublicIp", reqParams)
+	ctx = LogCommonRequest(ctx, "createVpcPublicIp", reqParams)
 
 	resp, err := client.Vserver.V2Api.CreatePublicIpInstance(reqParams)
 	if err != nil {
-		LogErrorResponse("createVpcPublicIp", err, reqParams)
+		LogErrorResponse(ctx, "createVpcPublicIp", err, reqParams)
 		return nil, err
 	}
-	LogResponse("createVpcPublicIp", resp)
+	LogResponse(ctx, "createVpcPublicIp", resp)
 
 	publicIPInstance := resp.PublicIpInstanceList[0]
 
 	return publicIPInstance.PublicIpInstanceNo, nil
 }
 
-func deleteClassicPublicIp(d *schema.ResourceData, config *conn.ProviderConfig) error {
+func deleteClassicPublicIp(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) error {
 	client := config.Client
 
 	reqParams := &server.DeletePublicIpInstancesRequest{
 		PublicIpInstanceNoList: []*string{ncloud.String(d.Id())},
 	}
 
-	LogCommonRequest("deleteClassicPublicIp", reqParams)
+	ctx = LogCommonRequest(ctx, "deleteClassicPublicIp", reqParams)
 
 	resp, err := client.Server.V2Api.DeletePublicIpInstances(reqParams)
 	if err != nil {
-		LogErrorResponse("deleteClassicPublicIp", err, reqParams)
+		LogErrorResponse(ctx, "deleteClassicPublicIp", err, reqParams)
 		return err
 	}
-	LogResponse("deleteClassicPublicIp", resp)
+	LogResponse(ctx, "deleteClassicPublicIp", resp)
 
 	return nil
 }
 
-func deleteVpcPublicIp(d *schema.ResourceData, config *conn.ProviderConfig) error {
+func deleteVpcPublicIp(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) error {
 	client := config.Client
 
 	reqParams := &vserver.DeletePublicIpInstanceRequest{
@@ -270,25 +260,25 @@ func deleteVpcPublicIp(d *schema.ResourceData, config *conn.ProviderConfig) erro
 		PublicIpInstanceNo: ncloud.String(d.Id()),
 	}
 
-	LogCommonRequest("deleteVpcPublicIp", reqParams)
+	ctx = LogCommonRequest(ctx, "deleteVpcPublicIp", reqParams)
 
 	resp, err := client.Vserver.V2Api.DeletePublicIpInstance(reqParams)
 	if err != nil {
-		LogErrorResponse("deleteVpcPublicIp", err, reqParams)
+		LogErrorResponse(ctx, "deleteVpcPublicIp", err, reqParams)
 		return err
 	}
-	LogResponse("deleteVpcPublicIp", resp)
+	LogResponse(ctx, "deleteVpcPublicIp", resp)
 
 	return nil
 }
 
-func GetPublicIp(config *conn.ProviderConfig, id string) (*PublicIpInstance, error) {
+func GetPublicIp(ctx context.Context, config *conn.ProviderConfig, id string) (*PublicIpInstance, error) {
 	var r *PublicIpInstance
 	var err error
 	if config.SupportVPC {
-		r, err = getVpcPublicIp(config, id)
+		r, err = getVpcPublicIp(ctx, config, id)
 	} else {
-		r, err = getClassicPublicIp(config, id)
+		r, err = getClassicPublicIp(ctx, config, id)
 	}
 
 	if err != nil {
@@ -298,7 +288,7 @@ func GetPublicIp(config *conn.ProviderConfig, id string) (*PublicIpInstance, err
 	return r, nil
 }
 
-func getClassicPublicIp(config *conn.ProviderConfig, id string) (*PublicIpInstance, error) {
+func getClassicPublicIp(ctx context.Context, config *conn.ProviderConfig, id string) (*PublicIpInstance, error) {
 	client := config.Client
 	regionNo := config.RegionNo
 
@@ -307,14 +297,14 @@ func getClassicPublicIp(config *conn.ProviderConfig, id string) (*PublicIpInstan
 		PublicIpInstanceNoList: []*string{ncloud.String(id)},
 	}
 
-	LogCommonRequest("getClassicPublicIp", reqParams)
+	ctx = LogCommonRequest(ctx, "getClassicPublicIp", reqParams)
 	resp, err := client.Server.V2Api.GetPublicIpInstanceList(reqParams)
 
 	if err != nil {
-		LogErrorResponse("getClassicPublicIp", err, reqParams)
+		LogErrorResponse(ctx, "getClassicPublicIp", err, reqParams)
 		return nil, err
 	}
-	LogResponse("getClassicPublicIp", resp)
+	LogResponse(ctx, "getClassicPublicIp", resp)
 
 	if len(resp.PublicIpInstanceList) == 0 {
 		return nil, nil
@@ -344,7 +334,7 @@ func getClassicPublicIp(config *conn.ProviderConfig, id string) (*PublicIpInstan
 	return p, nil
 }
 
-func getVpcPublicIp(config *conn.ProviderConfig, id string) (*PublicIpInstance, error) {
+func getVpcPublicIp(ctx context.Context, config *conn.ProviderConfig, id string) (*PublicIpInstance, error) {
 	client := config.Client
 	regionCode := config.RegionCode
 
@@ -353,14 +343,14 @@ func getVpcPublicIp(config *conn.ProviderConfig, id string) (*PublicIpInstance,
 		PublicIpInstanceNoList: []*string{ncloud.String(id)},
 	}
 
-	LogCommonRequest("getVpcPublicIp", reqParams)
+	ctx = LogCommonRequest(ctx, "getVpcPublicIp", reqParams)
 	resp, err := client.Vserver.V2Api.GetPublicIpInstanceList(reqParams)
 
 	if err != nil {
-		LogErrorResponse("getVpcPublicIp", err, reqParams)
+		LogErrorResponse(ctx, "getVpcPublicIp", err, reqParams)
 		return nil, err
 	}
-	LogResponse("getVpcPublicIp", resp)
+	LogResponse(ctx, "getVpcPublicIp", resp)
 
 	if len(resp.PublicIpInstanceList) == 0 {
 		return nil, nil
@@ -387,8 +377,8 @@ func getVpcPublicIp(config *conn.ProviderConfig, id string) (*PublicIpInstance,
 	return p, nil
 }
 
-func checkAssociatedPublicIP(config *conn.ProviderConfig, id string) (bool, error) {
-	instance, err := GetPublicIp(config, id)
+func checkAssociatedPublicIP(ctx context.Context, config *conn.ProviderConfig, id string) (bool, error) {
+	instance, err := GetPublicIp(ctx, config, id)
 
 	if err != nil {
 		return false, err
@@ -401,66 +391,66 @@ func checkAssociatedPublicIP(config *conn.ProviderConfig, id string) (bool, erro
 	return instance.ServerInstanceNo != nil && *instance.ServerInstanceNo != "", nil
 }
 
-func disassociatedPublicIp(config *conn.ProviderConfig, id string) error {
+func disassociatedPublicIp(ctx context.Context, config *conn.ProviderConfig, id string) error {
 	var err error
 
 	if config.SupportVPC {
-		err = disassociatedVpcPublicIp(config, id)
+		err = disassociatedVpcPublicIp(ctx, config, id)
 	} else {
-		err = disassociatedClassicPublicIp(config, id)
+		err = disassociatedClassicPublicIp(ctx, config, id)
 	}
 
 	if err != nil {
 		return err
 	}
 
-	if err := waitForPublicIpDisassociation(config, id); err != nil {
+	if err := waitForPublicIpDisassociation(ctx, config, id); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func disassociatedClassicPublicIp(config *conn.ProviderConfig, id string) error {
+func disassociatedClassicPublicIp(ctx context.Context, config *conn.ProviderConfig, id string) error {
 	reqParams := &server.DisassociatePublicIpFromServerInstanceRequest{PublicIpInstanceNo: ncloud.String(id)}
 
-	LogCommonRequest("disassociatedClassicPublicIP", reqParams)
+	ctx = LogCommonRequest(ctx, "disassociatedClassicPublicIP", reqParams)
 
 	resp, err := config.Client.Server.V2Api.DisassociatePublicIpFromServerInstance(reqParams)
 	if err != nil {
-		LogErrorResponse("disassociatedClassicPublicIP", err, id)
+		LogErrorResponse(ctx, "disassociatedClassicPublicIP", err, id)
 		return err
 	}
-	LogCommonResponse("disassociatedClassicPublicIP", GetCommonResponse(resp))
+	LogCommonResponse(ctx, "disassociatedClassicPublicIP", GetCommonResponse(resp))
 
 	return nil
 }
 
-func disassociatedVpcPublicIp(config *conn.ProviderConfig, id string) error {
+func disassociatedVpcPublicIp(ctx context.Context, config *conn.ProviderConfig, id string) error {
 	reqParams := &vserver.DisassociatePublicIpFromServerInstanceRequest{
 		RegionCode:         &config.RegionCode,
 		PublicIpInstanceNo: ncloud.String(id),
 	}
 
-	LogCommonRequest("disassociatedVpcPublicIp", reqParams)
+	ctx = LogCommonRequest(ctx, "disassociatedVpcPublicIp", reqParams)
 
 	resp, err := config.Client.Vserver.V2Api.DisassociatePublicIpFromServerInstance(reqParams)
 	if err != nil {
-		LogErrorResponse("disassociatedVpcPublicIp", err, id)
+		LogErrorResponse(ctx, "disassociatedVpcPublicIp", err, id)
 		return err
 	}
-	LogCommonResponse("disassociatedVpcPublicIp", GetCommonResponse(resp))
+	LogCommonResponse(ctx, "disassociatedVpcPublicIp", GetCommonResponse(resp))
 
 	return nil
 }
 
-func waitForPublicIpDisassociation(config *conn.ProviderConfig, id string) error {
-	stateConf := &resource.StateChangeConf{
+func waitForPublicIpDisassociation(ctx context.Context, config *conn.ProviderConfig, id string) error {
+	stateConf := &waiter.Config[any]{
 		Pending: []string{"NOT OK"},
 		Target:  []string{"OK"},
-		Refresh: func() (interface{}, string, error) {
-			isAssociated, err := checkAssociatedPublicIP(config, id)
-			opCode, opErr := getPublicIpInstanceOperationCode(config, id)
+		Refresh: func(ctx context.Context) (any, string, error) {
+			isAssociated, err := checkAssociatedPublicIP(ctx, config, id)
+			opCode, opErr := getPublicIpInstanceOperationCode(ctx, config, id)
 
 			if err != nil || opErr != nil {
 				return 0, "", err
@@ -472,12 +462,12 @@ func waitForPublicIpDisassociation(config *conn.ProviderConfig, id string) error
 
 			return nil, "NOT OK", nil
 		},
-		Timeout:    conn.DefaultTimeout,
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Timeout:     conn.DefaultTimeout,
+		Delay:       2 * time.Second,
+		MinInterval: 3 * time.Second,
 	}
 
-	_, err := stateConf.WaitForState()
+	_, err := stateConf.WaitForState(ctx)
 	if err != nil {
 		return fmt.Errorf("Error waiting for Public IP (%s) to become disassociation: %s", id, err)
 	}
@@ -485,20 +475,20 @@ func waitForPublicIpDisassociation(config *conn.ProviderConfig, id string) error
 	return nil
 }
 
-func getPublicIpInstanceOperationCode(config *conn.ProviderConfig, id string) (string, error) {
-	instance, err := GetPublicIp(config, id)
+func getPublicIpInstanceOperationCode(ctx context.Context, config *conn.ProviderConfig, id string) (string, error) {
+	instance, err := GetPublicIp(ctx, config, id)
 	if err != nil {
 		return "", err
 	}
 	return *instance.PublicIpInstanceOperationCode, nil
 }
 
-func waitForPublicIpAssociation(config *conn.ProviderConfig, id string) error {
-	stateConf := &resource.StateChangeConf{
+func waitForPublicIpAssociation(ctx context.Context, config *conn.ProviderConfig, id string) error {
+	stateConf := &waiter.Config[any]{
 		Pending: []string{"NOT OK"},
 		Target:  []string{"OK"},
-		Refresh: func() (interface{}, string, error) {
-			isAssociated, err := checkAssociatedPublicIP(config, id)
+		Refresh: func(ctx context.Context) (any, string, error) {
+			isAssociated, err := checkAssociatedPublicIP(ctx, config, id)
 
 			if err != nil {
 				return 0, "", err
@@ -510,12 +500,12 @@ func waitForPublicIpAssociation(config *conn.ProviderConfig, id string) error {
 
 			return nil, "NOT OK", nil
 		},
-		Timeout:    conn.DefaultTimeout,
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Timeout:     conn.DefaultTimeout,
+		Delay:       2 * time.Second,
+		MinInterval: 3 * time.Second,
 	}
 
-	_, err := stateConf.WaitForState()
+	_, err := stateConf.WaitForState(ctx)
 	if err != nil {
 		return fmt.Errorf("Error waiting for Public IP (%s) to become association: %s", id, err)
 	}
@@ -523,59 +513,59 @@ func waitForPublicIpAssociation(config *conn.ProviderConfig, id string) error {
 	return nil
 }
 
-func associatedPublicIp(d *schema.ResourceData, config *conn.ProviderConfig) error {
+func associatedPublicIp(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) error {
 	var err error
 
 	if config.SupportVPC {
-		err = associatedVpcPublicIp(d, config)
+		err = associatedVpcPublicIp(ctx, d, config)
 	} else {
-		err = associatedClassicPublicIp(d, config)
+		err = associatedClassicPublicIp(ctx, d, config)
 	}
 
 	if err != nil {
 		return err
 	}
 
-	if err := waitForPublicIpAssociation(config, d.Id()); err != nil {
+	if err := waitForPublicIpAssociation(ctx, config, d.Id()); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func associatedClassicPublicIp(d *schema.ResourceData, config *conn.ProviderConfig) error {
+func associatedClassicPublicIp(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) error {
 	reqParams := &server.AssociatePublicIpWithServerInstanceRequest{
 		PublicIpInstanceNo: ncloud.String(d.Id()),
 		ServerInstanceNo:   ncloud.String(d.Get("server_instance_no").(string)),
 	}
 
-	LogCommonRequest("associatedClassicPublicIp", reqParams)
+	ctx = LogCommonRequest(ctx, "associatedClassicPublicIp", reqParams)
 
 	resp, err := config.Client.Server.V2Api.AssociatePublicIpWithServerInstance(reqParams)
 	if err != nil {
-		LogErrorResponse("associatedClassicPublicIp", err, d.Id())
+		LogErrorResponse(ctx, "associatedClassicPublicIp", err, d.Id())
 		return err
 	}
-	LogCommonResponse("associatedClassicPublicIp", GetCommonResponse(resp))
+	LogCommonResponse(ctx, "associatedClassicPublicIp", GetCommonResponse(resp))
 
 	return nil
 }
 
-func associatedVpcPublicIp(d *schema.ResourceData, config *conn.ProviderConfig) error {
+func associatedVpcPublicIp(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) error {
 	reqParams := &vserver.AssociatePublicIpWithServerInstanceRequest{
 		RegionCode:         &config.RegionCode,
 		PublicIpInstanceNo: ncloud.String(d.Id()),
 		ServerInstanceNo:   ncloud.String(d.Get("server_instance_no").(string)),
 	}
 
-	LogCommonRequest("associatedVpcPublicIp", reqParams)
+	ctx = LogCommonRequest(ctx, "associatedVpcPublicIp", reqParams)
 
 	resp, err := config.Client.Vserver.V2Api.AssociatePublicIpWithServerInstance(reqParams)
 	if err != nil {
-		LogErrorResponse("associatedVpcPublicIp", err, d.Id())
+		LogErrorResponse(ctx, "associatedVpcPublicIp", err, d.Id())
 		return err
 	}
-	LogCommonResponse("associatedVpcPublicIp", GetCommonResponse(resp))
+	LogCommonResponse(ctx, "associatedVpcPublicIp", GetCommonResponse(resp))
 
 	return nil
 }
@@ -1,21 +1,25 @@
 package server
 
 import (
+	"context"
+
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/paging"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/verify"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/zone"
 )
 
 func DataSourceNcloudPublicIp() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNcloudPublicIpRead,
+		ReadContext: dataSourceNcloudPublicIpRead,
 
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -112,20 +116,20 @@ func DataSourceNcloudPublicIp() *schema.Resource {
 	}
 }
 
-func dataSourceNcloudPublicIpRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceNcloudPublicIpRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*conn.ProviderConfig)
 
 	var resources []map[string]interface{}
 	var err error
 
 	if config.SupportVPC {
-		resources, err = getVpcPublicIpList(d, meta.(*conn.ProviderConfig))
+		resources, err = getVpcPublicIpList(ctx, d, meta.(*conn.ProviderConfig))
 	} else {
-		resources, err = getClassicPublicIpList(d, meta.(*conn.ProviderConfig))
+		resources, err = getClassicPublicIpList(ctx, d, meta.(*conn.ProviderConfig))
 	}
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if f, ok := d.GetOk("filter"); ok {
@@ -133,7 +137,7 @@ func dataSourceNcloudPublicIpRead(d *schema.ResourceData, meta interface{}) erro
 	}
 
 	if err := verify.ValidateOneResult(len(resources)); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	SetSingularResourceDataFromMapSchema(DataSourceNcloudPublicIp(), d, resources[0])
@@ -141,7 +145,7 @@ func dataSourceNcloudPublicIpRead(d *schema.ResourceData, meta interface{}) erro
 	return nil
 }
 
-func getClassicPublicIpList(d *schema.ResourceData, config *conn.ProviderConfig) ([]map[string]interface{}, error) {
+func getClassicPublicIpList(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) ([]map[string]interface{}, error) {
 	client := config.Client
 	regionNo := config.RegionNo
 
@@ -158,17 +162,26 @@ func getClassicPublicIpList(d *schema.ResourceData, config *conn.ProviderConfig)
 		reqParams.PublicIpInstanceNoList = []*string{ncloud.String(v.(string))}
 	}
 
-	LogCommonRequest("getClassicPublicIpList", reqParams)
-	resp, err := client.Server.V2Api.GetPublicIpInstanceList(reqParams)
+	publicIpInstanceList, err := paging.All(ctx, func(ctx context.Context, pageNo, pageSize int32) ([]*server.PublicIpInstance, *int32, error) {
+		reqParams.PageNo = ncloud.Int32(pageNo)
+		reqParams.PageSize = ncloud.Int32(pageSize)
+
+		ctx = LogCommonRequest(ctx, "getClassicPublicIpList", reqParams)
+		resp, err := client.Server.V2Api.GetPublicIpInstanceList(reqParams)
+		if err != nil {
+			LogErrorResponse(ctx, "getClassicPublicIpList", err, reqParams)
+			return nil, nil, err
+		}
+		LogCommonResponse(ctx, "getClassicPublicIpList", GetCommonResponse(resp))
 
+		return resp.PublicIpInstanceList, resp.TotalRows, nil
+	})
 	if err != nil {
-		LogErrorResponse("getClassicPublicIpList", err, reqParams)
 		return nil, err
 	}
-	LogCommonResponse("getClassicPublicIpList", GetCommonResponse(resp))
 
 	var resources []map[string]interface{}
-	for _, r := range resp.PublicIpInstanceList {
+	for _, r := range publicIpInstanceList {
 		instance := map[string]interface{}{
 			"id":                 *r.PublicIpInstanceNo,
 			"instance_no":        *r.PublicIpInstanceNo,
@@ -202,7 +215,7 @@ func getClassicPublicIpList(d *schema.ResourceData, config *conn.ProviderConfig)
 	return resources, nil
 }
 
-func getVpcPublicIpList(d *schema.ResourceData, config *conn.ProviderConfig) ([]map[string]interface{}, error) {
+func getVpcPublicIpList(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) ([]map[string]interface{}, error) {
 	client := config.Client
 	regionCode := config.RegionCode
 
@@ -218,17 +231,26 @@ func getVpcPublicIpList(d *schema.ResourceData, config *conn.ProviderConfig) ([]
 		reqParams.PublicIpInstanceNoList = []*string{ncloud.String(v.(string))}
 	}
 
-	LogCommonRequest("getVpcPublicIpList", reqParams)
-	resp, err := client.Vserver.V2Api.GetPublicIpInstanceList(reqParams)
+	publicIpInstanceList, err := paging.All(ctx, func(ctx context.Context, pageNo, pageSize int32) ([]*vserver.PublicIpInstance, *int32, error) {
+		reqParams.PageNo = ncloud.Int32(pageNo)
+		reqParams.PageSize = ncloud.Int32(pageSize)
+
+		ctx = LogCommonRequest(ctx, "getVpcPublicIpList", reqParams)
+		resp, err := client.Vserver.V2Api.GetPublicIpInstanceList(reqParams)
+		if err != nil {
+			LogErrorResponse(ctx, "getVpcPublicIpList", err, reqParams)
+			return nil, nil, err
+		}
+		LogCommonResponse(ctx, "getVpcPublicIpList", GetCommonResponse(resp))
 
+		return resp.PublicIpInstanceList, resp.TotalRows, nil
+	})
 	if err != nil {
-		LogErrorResponse("getVpcPublicIpList", err, reqParams)
 		return nil, err
 	}
-	LogCommonResponse("getVpcPublicIpList", GetCommonResponse(resp))
 
 	var resources []map[string]interface{}
-	for _, r := range resp.PublicIpInstanceList {
+	for _, r := range publicIpInstanceList {
 		instance := map[string]interface{}{
 			"id":                 *r.PublicIpInstanceNo,
 			"public_ip_no":       *r.PublicIpInstanceNo,
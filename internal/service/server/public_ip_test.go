@@ -1,11 +1,11 @@
 package server_test
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -21,7 +21,7 @@ func TestAccResourceNcloudPublicIpInstance_classic_basic(t *testing.T) {
 	t.Skip()
 
 	var instance *server.PublicIpInstance
-	description := fmt.Sprintf("test-public-ip-basic-%s", acctest.RandString(5))
+	description := fmt.Sprintf("test-public-ip-basic-%s", RandString(t, 5))
 	resourceName := "ncloud_public_ip.public_ip"
 
 	resource.Test(t, resource.TestCase{
@@ -54,7 +54,7 @@ func TestAccResourceNcloudPublicIpInstance_classic_basic(t *testing.T) {
 func TestAccResourceNcloudPublicIpInstance_vpc_basic(t *testing.T) {
 	var instance *server.PublicIpInstance
 
-	name := fmt.Sprintf("test-public-ip-basic-%s", acctest.RandString(5))
+	name := fmt.Sprintf("test-public-ip-basic-%s", RandString(t, 5))
 	resourceName := "ncloud_public_ip.public_ip"
 
 	resource.Test(t, resource.TestCase{
@@ -89,8 +89,8 @@ func TestAccResourceNcloudPublicIpInstance_classic_updateServerInstanceNo(t *tes
 	t.Skip()
 
 	var instance *server.PublicIpInstance
-	serverNameFoo := fmt.Sprintf("test-public-ip-foo-%s", acctest.RandString(5))
-	serverNameBar := fmt.Sprintf("test-public-ip-bar-%s", acctest.RandString(5))
+	serverNameFoo := fmt.Sprintf("test-public-ip-foo-%s", RandString(t, 5))
+	serverNameBar := fmt.Sprintf("test-public-ip-bar-%s", RandString(t, 5))
 	resourceName := "ncloud_public_ip.public_ip"
 
 	resource.Test(t, resource.TestCase{
@@ -122,8 +122,8 @@ func TestAccResourceNcloudPublicIpInstance_classic_updateServerInstanceNo(t *tes
 
 func TestAccResourceNcloudPublicIpInstance_vpc_updateServerInstanceNo(t *testing.T) {
 	var instance *server.PublicIpInstance
-	serverNameFoo := fmt.Sprintf("test-public-ip-foo-%s", acctest.RandString(5))
-	serverNameBar := fmt.Sprintf("test-public-ip-bar-%s", acctest.RandString(5))
+	serverNameFoo := fmt.Sprintf("test-public-ip-foo-%s", RandString(t, 5))
+	serverNameBar := fmt.Sprintf("test-public-ip-bar-%s", RandString(t, 5))
 	resourceName := "ncloud_public_ip.public_ip"
 
 	resource.Test(t, resource.TestCase{
@@ -166,7 +166,7 @@ func testAccCheckPublicIpInstanceExists(n string, i *server.PublicIpInstance, pr
 
 		config := provider.Meta().(*conn.ProviderConfig)
 
-		instance, err := server.GetPublicIp(config, rs.Primary.ID)
+		instance, err := server.GetPublicIp(context.Background(), config, rs.Primary.ID)
 
 		if err != nil {
 			return nil
@@ -190,7 +190,7 @@ func testAccCheckPublicIpInstanceDestroy(s *terraform.State, provider *schema.Pr
 			continue
 		}
 
-		instance, err := server.GetPublicIp(config, rs.Primary.ID)
+		instance, err := server.GetPublicIp(context.Background(), config, rs.Primary.ID)
 
 		if err != nil {
 			return err
@@ -1,9 +1,12 @@
 package server
 
 import (
+	"context"
+
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/common"
@@ -12,7 +15,7 @@ import (
 
 func DataSourceNcloudRootPassword() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNcloudRootPasswordRead,
+		ReadContext: dataSourceNcloudRootPasswordRead,
 
 		Schema: map[string]*schema.Schema{
 			"server_instance_no": {
@@ -33,12 +36,12 @@ func DataSourceNcloudRootPassword() *schema.Resource {
 	}
 }
 
-func dataSourceNcloudRootPasswordRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceNcloudRootPasswordRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*conn.ProviderConfig)
 
-	rootPassword, err := getRootPassword(d, config)
+	rootPassword, err := getRootPassword(ctx, config, d.Get("server_instance_no").(string), d.Get("private_key").(string))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(d.Get("server_instance_no").(string))
@@ -47,45 +50,45 @@ func dataSourceNcloudRootPasswordRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func getRootPassword(d *schema.ResourceData, config *conn.ProviderConfig) (*string, error) {
+func getRootPassword(ctx context.Context, config *conn.ProviderConfig, serverInstanceNo, privateKey string) (*string, error) {
 	if config.SupportVPC {
-		return getVpcRootPassword(d, config)
+		return getVpcRootPassword(ctx, config, serverInstanceNo, privateKey)
 	} else {
-		return getClassicRootPassword(d, config)
+		return getClassicRootPassword(ctx, config, serverInstanceNo, privateKey)
 	}
 }
 
-func getClassicRootPassword(d *schema.ResourceData, config *conn.ProviderConfig) (*string, error) {
+func getClassicRootPassword(ctx context.Context, config *conn.ProviderConfig, serverInstanceNo, privateKey string) (*string, error) {
 	reqParams := &server.GetRootPasswordRequest{
-		ServerInstanceNo: ncloud.String(d.Get("server_instance_no").(string)),
-		PrivateKey:       ncloud.String(d.Get("private_key").(string)),
+		ServerInstanceNo: ncloud.String(serverInstanceNo),
+		PrivateKey:       ncloud.String(privateKey),
 	}
 
-	LogCommonRequest("getClassicRootPassword", reqParams)
+	ctx = LogCommonRequest(ctx, "getClassicRootPassword", reqParams)
 	resp, err := config.Client.Server.V2Api.GetRootPassword(reqParams)
 	if err != nil {
-		LogErrorResponse("getClassicRootPassword", err, reqParams)
+		LogErrorResponse(ctx, "getClassicRootPassword", err, reqParams)
 		return nil, err
 	}
-	LogCommonResponse("getClassicRootPassword", GetCommonResponse(resp))
+	LogCommonResponse(ctx, "getClassicRootPassword", GetCommonResponse(resp))
 
 	return resp.RootPassword, nil
 }
 
-func getVpcRootPassword(d *schema.ResourceData, config *conn.ProviderConfig) (*string, error) {
+func getVpcRootPassword(ctx context.Context, config *conn.ProviderConfig, serverInstanceNo, privateKey string) (*string, error) {
 	reqParams := &vserver.GetRootPasswordRequest{
 		RegionCode:       &config.RegionCode,
-		ServerInstanceNo: ncloud.String(d.Get("server_instance_no").(string)),
-		PrivateKey:       ncloud.String(d.Get("private_key").(string)),
+		ServerInstanceNo: ncloud.String(serverInstanceNo),
+		PrivateKey:       ncloud.String(privateKey),
 	}
 
-	LogCommonRequest("getVpcRootPassword", reqParams)
+	ctx = LogCommonRequest(ctx, "getVpcRootPassword", reqParams)
 	resp, err := config.Client.Vserver.V2Api.GetRootPassword(reqParams)
 	if err != nil {
-		LogErrorResponse("getVpcRootPassword", err, reqParams)
+		LogErrorResponse(ctx, "getVpcRootPassword", err, reqParams)
 		return nil, err
 	}
-	LogCommonResponse("getVpcRootPassword", GetCommonResponse(resp))
+	LogCommonResponse(ctx, "getVpcRootPassword", GetCommonResponse(resp))
 
 	return resp.RootPassword, nil
 }
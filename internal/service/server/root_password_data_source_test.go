@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/acctest"
@@ -15,7 +14,7 @@ func TestAccDataSourceNcloudRootPassword_classic_basic(t *testing.T) {
 	t.Skip()
 
 	resourceName := "data.ncloud_root_password.default"
-	name := fmt.Sprintf("tf-passwd-basic-%s", acctest.RandString(5))
+	name := fmt.Sprintf("tf-passwd-basic-%s", RandString(t, 5))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { TestAccPreCheck(t) },
@@ -34,7 +33,7 @@ func TestAccDataSourceNcloudRootPassword_classic_basic(t *testing.T) {
 
 func TestAccDataSourceNcloudRootPassword_vpc_basic(t *testing.T) {
 	resourceName := "data.ncloud_root_password.default"
-	name := fmt.Sprintf("tf-passwd-basic-%s", acctest.RandString(5))
+	name := fmt.Sprintf("tf-passwd-basic-%s", RandString(t, 5))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { TestAccPreCheck(t) },
@@ -1,6 +1,7 @@
 package server
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
@@ -11,8 +12,7 @@ import (
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -21,18 +21,25 @@ import (
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/service/vpc"
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/verify"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/waiter"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/zone"
 )
 
+const (
+	ServerDesiredStatusRunning = "running"
+	ServerDesiredStatusStopped = "stopped"
+)
+
 func ResourceNcloudServer() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNcloudServerCreate,
-		Read:   resourceNcloudServerRead,
-		Update: resourceNcloudServerUpdate,
-		Delete: resourceNcloudServerDelete,
+		CreateContext: resourceNcloudServerCreate,
+		ReadContext:   resourceNcloudServerRead,
+		UpdateContext: resourceNcloudServerUpdate,
+		DeleteContext: resourceNcloudServerDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: resourceNcloudServerCustomizeDiff,
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(conn.DefaultCreateTimeout),
 			Delete: schema.DefaultTimeout(conn.DefaultTimeout),
@@ -82,7 +89,6 @@ func ResourceNcloudServer() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 				Computed: true,
-				ForceNew: true,
 			},
 			"login_key_name": {
 				Type:     schema.TypeString,
@@ -95,6 +101,12 @@ func ResourceNcloudServer() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"desired_status": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{ServerDesiredStatusRunning, ServerDesiredStatusStopped}, false)),
+			},
 			// Deprecated
 			"internet_line_type": {
 				Type:             schema.TypeString,
@@ -107,7 +119,6 @@ func ResourceNcloudServer() *schema.Resource {
 			"fee_system_type_code": {
 				Type:     schema.TypeString,
 				Optional: true,
-				ForceNew: true,
 			},
 			"zone": {
 				Type:     schema.TypeString,
@@ -150,6 +161,8 @@ func ResourceNcloudServer() *schema.Resource {
 					},
 				},
 			},
+			"tags":     TagsSchema(),
+			"tags_all": TagsSchemaComputed(),
 			"subnet_no": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -192,6 +205,15 @@ func ResourceNcloudServer() *schema.Resource {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
+						"access_control_groups": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type:             schema.TypeString,
+								ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+							},
+						},
 					},
 				},
 			},
@@ -275,27 +297,33 @@ func ResourceNcloudServer() *schema.Resource {
 	}
 }
 
-func resourceNcloudServerCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudServerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*conn.ProviderConfig)
 
-	id, err := createServerInstance(d, config)
+	id, err := createServerInstance(ctx, d, config)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(ncloud.StringValue(id))
 	log.Printf("[INFO] Server instance ID: %s", d.Id())
 
-	return resourceNcloudServerRead(d, meta)
+	if d.Get("desired_status").(string) == ServerDesiredStatusStopped {
+		if err := updateServerDesiredStatus(ctx, d, config); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceNcloudServerRead(ctx, d, meta)
 }
 
-func resourceNcloudServerRead(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudServerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*conn.ProviderConfig)
 
-	r, err := GetServerInstance(config, d.Id())
+	r, err := GetServerInstance(ctx, config, d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if r == nil {
@@ -304,21 +332,32 @@ func resourceNcloudServerRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if config.SupportVPC {
-		_ = buildNetworkInterfaceList(config, r)
+		_ = buildNetworkInterfaceList(ctx, config, r)
 	}
 
 	instance := ConvertToMap(r)
 
 	SetSingularResourceDataFromMapSchema(ResourceNcloudServer(), d, instance)
 
+	// the servers in transition keep their desired status, so that an interrupted stop or start is planned again
+	if status := serverDesiredStatus(r.ServerInstanceStatus); status != "" {
+		d.Set("desired_status", status)
+	}
+
+	if !config.SupportVPC {
+		tagsAll := flattenServerInstanceTags(r.InstanceTagList, d.Get("tag_list").([]interface{}))
+		d.Set("tags_all", FlattenTags(tagsAll))
+		d.Set("tags", FlattenTags(IgnoreDefaultTags(tagsAll, config.DefaultTags, ExpandTags(d.Get("tags").(map[string]interface{})))))
+	}
+
 	return nil
 }
 
-func resourceNcloudServerDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudServerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*conn.ProviderConfig)
-	serverInstance, err := GetServerInstance(config, d.Id())
+	serverInstance, err := GetServerInstance(ctx, config, d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if serverInstance == nil {
@@ -328,66 +367,253 @@ func resourceNcloudServerDelete(d *schema.ResourceData, meta interface{}) error
 
 	if ncloud.StringValue(serverInstance.ServerInstanceStatus) != "NSTOP" {
 		log.Printf("[INFO] Stopping Instance %q for terminate", d.Id())
-		if err := stopThenWaitServerInstance(config, d.Id()); err != nil {
-			return err
+		if err := stopThenWaitServerInstance(ctx, config, d.Id()); err != nil {
+			return diag.FromErr(err)
 		}
 	}
 
-	blockStorageList, err := getAdditionalBlockStorageList(config, d.Id())
+	blockStorageList, err := getAdditionalBlockStorageList(ctx, config, d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if len(blockStorageList) > 0 {
 		for _, blockStorage := range blockStorageList {
-			if err := disconnectBlockStorage(config, blockStorage); err != nil {
-				return err
+			if err := disconnectBlockStorage(ctx, config, blockStorage); err != nil {
+				return diag.FromErr(err)
 			}
 
-			if err := waitForDisconnectBlockStorage(config, *blockStorage.BlockStorageInstanceNo); err != nil {
-				return err
+			if err := waitForDisconnectBlockStorage(ctx, config, *blockStorage.BlockStorageInstanceNo, 6*conn.DefaultTimeout); err != nil {
+				return diag.FromErr(err)
 			}
 		}
 
-		if err := detachThenWaitServerInstance(config, d.Id()); err != nil {
-			return err
+		if err := detachThenWaitServerInstance(ctx, config, d.Id()); err != nil {
+			return diag.FromErr(err)
 		}
 	}
 
-	if err := terminateThenWaitServerInstance(config, d.Id()); err != nil {
-		return err
+	if err := terminateThenWaitServerInstance(ctx, config, d.Id()); err != nil {
+		return diag.FromErr(err)
 	}
 	d.SetId("")
 	return nil
 }
 
-func resourceNcloudServerUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudServerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*conn.ProviderConfig)
 
 	if d.HasChange("server_product_code") || d.HasChange("server_spec_code") {
-		if err := updateServerInstanceSpec(d, config); err != nil {
-			return err
+		if err := updateServerInstanceSpec(ctx, d, config); err != nil {
+			return diag.FromErr(err)
 		}
 	}
 
 	if d.HasChange("is_protect_server_termination") {
-		if err := updateServerProtectionTermination(d, config); err != nil {
+		if err := updateServerProtectionTermination(ctx, d, config); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("placement_group_no") {
+		if err := updateServerPlacementGroup(ctx, d, config); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("network_interface") {
+		if err := updateServerNetworkInterfaceAccessControlGroups(ctx, d, config); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("desired_status") {
+		if err := updateServerDesiredStatus(ctx, d, config); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if !config.SupportVPC && d.HasChanges("tags", "tags_all") {
+		if err := updateClassicServerInstanceTags(ctx, d, config); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceNcloudServerRead(ctx, d, meta)
+}
+
+func resourceNcloudServerCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	config := meta.(*conn.ProviderConfig)
+
+	if err := validateServerProductCodes(ctx, d, config); err != nil {
+		return err
+	}
+
+	if err := validateServerInPlaceChanges(d, config); err != nil {
+		return err
+	}
+
+	// Instance tags are only supported by the classic server API, default_tags are ignored on vpc
+	if config.SupportVPC {
+		if len(d.Get("tags").(map[string]interface{})) > 0 {
+			return NotSupportVpc("`tags` of ncloud_server")
+		}
+		return nil
+	}
+
+	if err := SetTagsDiff(ctx, d, meta); err != nil {
+		return err
+	}
+
+	tagsAll := MergeTags(config.DefaultTags, ExpandTags(d.Get("tags").(map[string]interface{})))
+	for _, v := range d.Get("tag_list").([]interface{}) {
+		if tag, ok := v.(map[string]interface{}); ok {
+			if _, exists := tagsAll[tag["tag_key"].(string)]; exists {
+				return fmt.Errorf("tag key `%s` can't be set in both `tag_list` and `tags` (or provider default_tags)", tag["tag_key"])
+			}
+		}
+	}
+
+	return nil
+}
+
+// serverCreateOnlyAttributes can only be set when the server is created, the server API has no call to change them.
+// Unlike the other arguments which can't be changed, they don't replace the server, which wouldn't be expected for
+// such a change.
+var serverCreateOnlyAttributes = []string{"description", "fee_system_type_code"}
+
+// validateServerInPlaceChanges fails the plan of the changes which can't be applied to an existing server. The
+// arguments which replace the server are shown by the plan as forcing the replacement.
+func validateServerInPlaceChanges(d *schema.ResourceDiff, config *conn.ProviderConfig) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	for _, key := range serverCreateOnlyAttributes {
+		if !d.HasChange(key) || !d.NewValueKnown(key) {
+			continue
+		}
+		// fee_system_type_code isn't returned by the API, it is empty in the state of an imported server
+		if o, _ := d.GetChange(key); key == "fee_system_type_code" && o.(string) == "" {
+			continue
+		}
+		return fmt.Errorf("`%s` of ncloud_server %s can't be changed, the server API can only set it when the server instance is created. Revert it to keep the server instance", key, d.Id())
+	}
+
+	if d.HasChange("placement_group_no") && !config.SupportVPC {
+		return NotSupportClassic("`placement_group_no` of ncloud_server")
+	}
+
+	if d.HasChange("network_interface") && !config.SupportVPC {
+		return NotSupportClassic("`network_interface` of ncloud_server")
+	}
+
+	return nil
+}
+
+// validateServerProductCodes checks server_image_product_code and server_product_code against the server image
+// products of the region and the server products of the image and zone, so that an invalid code fails the plan
+// rather than the creation
+func validateServerProductCodes(ctx context.Context, d *schema.ResourceDiff, config *conn.ProviderConfig) error {
+	changed := func(key string) bool {
+		return d.NewValueKnown(key) && d.HasChange(key) && d.Get(key).(string) != ""
+	}
+	imageChanged := changed("server_image_product_code")
+	productChanged := changed("server_product_code")
+	if !imageChanged && !productChanged {
+		return nil
+	}
+
+	imageProductCode := d.Get("server_image_product_code").(string)
+	scope := "region " + config.RegionCode
+	if imageChanged {
+		var images []map[string]interface{}
+		var err error
+		if config.SupportVPC {
+			images, err = getVpcServerImageProducts(ctx, config, &vserver.GetServerImageProductListRequest{RegionCode: &config.RegionCode})
+		} else {
+			images, err = getClassicServerImageProducts(ctx, config, &server.GetServerImageProductListRequest{RegionNo: &config.RegionNo})
+		}
+		if err != nil {
+			log.Printf("[WARN] skip validating the codes of the server: %s", err)
+			return nil
+		}
+		if err := ValidateCodeInList("server_image_product_code", imageProductCode, productCodes(images), scope); err != nil {
 			return err
 		}
 	}
 
-	return resourceNcloudServerRead(d, meta)
+	// the server products are listed by image, there is nothing to check against for a member server image
+	if !productChanged || !d.NewValueKnown("server_image_product_code") || imageProductCode == "" {
+		return nil
+	}
+
+	zoneCode := getServerZoneCodeForDiff(ctx, d, config)
+	if zoneCode != "" {
+		scope = fmt.Sprintf("zone %s of %s", zoneCode, scope)
+	}
+
+	var products []map[string]interface{}
+	var err error
+	if config.SupportVPC {
+		products, err = getVpcServerProducts(ctx, config, &vserver.GetServerProductListRequest{
+			RegionCode:             &config.RegionCode,
+			ZoneCode:               StringPtrOrNil(zoneCode, zoneCode != ""),
+			ServerImageProductCode: ncloud.String(imageProductCode),
+		})
+	} else {
+		reqParams := &server.GetServerProductListRequest{
+			RegionNo:               &config.RegionNo,
+			ServerImageProductCode: ncloud.String(imageProductCode),
+		}
+		if zoneCode != "" {
+			reqParams.ZoneNo = ncloud.String(zone.GetZoneNoByCode(config, zoneCode))
+		}
+		products, err = getClassicServerProducts(ctx, config, reqParams)
+	}
+	if err != nil {
+		log.Printf("[WARN] skip validating the codes of the server: %s", err)
+		return nil
+	}
+
+	scope = fmt.Sprintf("image %s of %s", imageProductCode, scope)
+	return ValidateCodeInList("server_product_code", d.Get("server_product_code").(string), productCodes(products), scope)
+}
+
+// getServerZoneCodeForDiff returns the zone of the server, from its subnet on VPC, or "" when it is not known yet
+func getServerZoneCodeForDiff(ctx context.Context, d *schema.ResourceDiff, config *conn.ProviderConfig) string {
+	if v, ok := d.GetOk("zone"); ok && d.NewValueKnown("zone") {
+		return v.(string)
+	}
+
+	if !config.SupportVPC || !d.NewValueKnown("subnet_no") || d.Get("subnet_no").(string) == "" {
+		return ""
+	}
+
+	subnet, err := vpc.GetCachedSubnetInstance(ctx, config, d.Get("subnet_no").(string))
+	if err != nil || subnet == nil {
+		return ""
+	}
+	return ncloud.StringValue(subnet.ZoneCode)
 }
 
-func createServerInstance(d *schema.ResourceData, config *conn.ProviderConfig) (*string, error) {
+func productCodes(products []map[string]interface{}) []string {
+	var codes []string
+	for _, p := range products {
+		codes = append(codes, p["product_code"].(string))
+	}
+	return codes
+}
+
+func createServerInstance(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) (*string, error) {
 	if config.SupportVPC {
-		return createVpcServerInstance(d, config)
+		return createVpcServerInstance(ctx, d, config)
 	}
 
-	return createClassicServerInstance(d, config)
+	return createClassicServerInstance(ctx, d, config)
 }
 
-func createClassicServerInstance(d *schema.ResourceData, config *conn.ProviderConfig) (*string, error) {
+func createClassicServerInstance(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) (*string, error) {
 	zoneNo, err := zone.ParseZoneNoParameter(config, d)
 	if err != nil {
 		return nil, err
@@ -411,42 +637,31 @@ func createClassicServerInstance(d *schema.ResourceData, config *conn.ProviderCo
 		reqParams.InstanceTagList = instanceTagList
 	}
 
+	tagsAll := MergeTags(config.DefaultTags, ExpandTags(d.Get("tags").(map[string]interface{})))
+	reqParams.InstanceTagList = append(reqParams.InstanceTagList, expandInstanceTagParameters(tagsAll)...)
+
 	if param, ok := d.GetOk("access_control_group_configuration_no_list"); ok {
 		reqParams.AccessControlGroupConfigurationNoList = ExpandStringInterfaceList(param.([]interface{}))
 	}
 
-	var resp *server.CreateServerInstancesResponse
-	err = resource.Retry(10*time.Minute, func() *resource.RetryError {
-		var err error
-		LogCommonRequest("createClassicServerInstance", reqParams)
-		resp, err = config.Client.Server.V2Api.CreateServerInstances(reqParams)
-		if err != nil {
-			errBody, _ := GetCommonErrorBody(err)
-			if ContainsInStringList(errBody.ReturnCode, []string{ApiErrorUnknown, ApiErrorAuthorityParameter, ApiErrorServerObjectInOperation, ApiErrorPreviousServersHaveNotBeenEntirelyTerminated}) {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		LogResponse("createClassicServerInstance", resp)
-		return nil
-	})
-
+	ctx = LogCommonRequest(ctx, "createClassicServerInstance", reqParams)
+	resp, err := config.Client.Server.V2Api.CreateServerInstances(reqParams)
 	if err != nil {
-		LogErrorResponse("createClassicServerInstance", err, reqParams)
+		LogErrorResponse(ctx, "createClassicServerInstance", err, reqParams)
 		return nil, err
 	}
-	LogResponse("createClassicServerInstance", resp)
+	LogResponse(ctx, "createClassicServerInstance", resp)
 
 	serverInstance := resp.ServerInstanceList[0]
 
-	if err := waitStateNcloudServerForCreation(config, *serverInstance.ServerInstanceNo); err != nil {
+	if err := waitStateNcloudServerForCreation(ctx, config, *serverInstance.ServerInstanceNo); err != nil {
 		return nil, err
 	}
 
 	return serverInstance.ServerInstanceNo, nil
 }
 
-func createVpcServerInstance(d *schema.ResourceData, config *conn.ProviderConfig) (*string, error) {
+func createVpcServerInstance(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) (*string, error) {
 	if _, ok := d.GetOk("subnet_no"); !ok {
 		return nil, ErrorRequiredArgOnVpc("subnet_no")
 	}
@@ -459,7 +674,7 @@ func createVpcServerInstance(d *schema.ResourceData, config *conn.ProviderConfig
 		return nil, NotSupportVpc("`user_data` of ncloud_server")
 	}
 
-	subnet, err := vpc.GetSubnetInstance(config, d.Get("subnet_no").(string))
+	subnet, err := vpc.GetCachedSubnetInstance(ctx, config, d.Get("subnet_no").(string))
 	if err != nil {
 		return nil, err
 	}
@@ -488,7 +703,7 @@ func createVpcServerInstance(d *schema.ResourceData, config *conn.ProviderConfig
 	}
 
 	if networkInterfaceList, ok := d.GetOk("network_interface"); !ok {
-		defaultAcgNo, err := vpc.GetDefaultAccessControlGroup(config, *subnet.VpcNo)
+		defaultAcgNo, err := vpc.GetDefaultAccessControlGroup(ctx, config, *subnet.VpcNo)
 		if err != nil {
 			return nil, err
 		}
@@ -505,7 +720,7 @@ func createVpcServerInstance(d *schema.ResourceData, config *conn.ProviderConfig
 			order := m["order"].(int)
 			networkInterfaceNo := m["network_interface_no"].(string)
 
-			networkInterface, err := GetNetworkInterface(config, networkInterfaceNo)
+			networkInterface, err := GetNetworkInterface(ctx, config, networkInterfaceNo)
 			if err != nil {
 				return nil, err
 			}
@@ -514,6 +729,19 @@ func createVpcServerInstance(d *schema.ResourceData, config *conn.ProviderConfig
 				return nil, fmt.Errorf("no matching network interface [%s] found", networkInterfaceNo)
 			}
 
+			if acgs, ok := m["access_control_groups"].(*schema.Set); ok && acgs.Len() > 0 {
+				current := schema.NewSet(schema.HashString, nil)
+				for _, acg := range networkInterface.AccessControlGroupNoList {
+					current.Add(ncloud.StringValue(acg))
+				}
+
+				if !current.Equal(acgs) {
+					if err := updateNetworkInterfaceAccessControlGroups(ctx, config, networkInterfaceNo, current, acgs); err != nil {
+						return nil, err
+					}
+				}
+			}
+
 			niParam := &vserver.NetworkInterfaceParameter{
 				NetworkInterfaceOrder: ncloud.Int32(int32(order)),
 				NetworkInterfaceNo:    networkInterface.NetworkInterfaceNo,
@@ -524,27 +752,27 @@ func createVpcServerInstance(d *schema.ResourceData, config *conn.ProviderConfig
 		}
 	}
 
-	LogCommonRequest("createVpcServerInstance", reqParams)
+	ctx = LogCommonRequest(ctx, "createVpcServerInstance", reqParams)
 	resp, err := config.Client.Vserver.V2Api.CreateServerInstances(reqParams)
 	if err != nil {
-		LogErrorResponse("createVpcServerInstance", err, reqParams)
+		LogErrorResponse(ctx, "createVpcServerInstance", err, reqParams)
 		return nil, err
 	}
-	LogResponse("createVpcServerInstance", resp)
+	LogResponse(ctx, "createVpcServerInstance", resp)
 	serverInstance := resp.ServerInstanceList[0]
 
-	if err := waitStateNcloudServerForCreation(config, *serverInstance.ServerInstanceNo); err != nil {
+	if err := waitStateNcloudServerForCreation(ctx, config, *serverInstance.ServerInstanceNo); err != nil {
 		return nil, err
 	}
 
-	blockStorageList, err := getVpcBasicBlockStorageList(config, *serverInstance.ServerInstanceNo)
+	blockStorageList, err := getVpcBasicBlockStorageList(ctx, config, *serverInstance.ServerInstanceNo)
 	if err != nil {
 		return nil, err
 	}
 
 	if len(blockStorageList) > 0 {
 		for _, blockStorage := range blockStorageList {
-			if err := waitForAttachedBlockStorage(config, *blockStorage.BlockStorageInstanceNo); err != nil {
+			if err := waitForAttachedBlockStorage(ctx, config, *blockStorage.BlockStorageInstanceNo); err != nil {
 				return nil, err
 			}
 		}
@@ -553,28 +781,28 @@ func createVpcServerInstance(d *schema.ResourceData, config *conn.ProviderConfig
 	return serverInstance.ServerInstanceNo, nil
 }
 
-func waitStateNcloudServerForCreation(config *conn.ProviderConfig, id string) error {
-	stateConf := &retry.StateChangeConf{
+func waitStateNcloudServerForCreation(ctx context.Context, config *conn.ProviderConfig, id string) error {
+	stateConf := &waiter.Config[*ServerInstance]{
 		Pending: []string{"INIT", "CREAT"},
 		Target:  []string{"RUN"},
-		Refresh: func() (interface{}, string, error) {
-			instance, err := GetServerInstance(config, id)
+		Refresh: func(ctx context.Context) (*ServerInstance, string, error) {
+			instance, err := GetServerInstance(ctx, config, id)
 			if err != nil {
-				return 0, "", err
+				return nil, "", err
 			}
 
 			if instance == nil {
-				return 0, "", fmt.Errorf("fail to get Server instance, %s doesn't exist", id)
+				return nil, "", fmt.Errorf("fail to get Server instance, %s doesn't exist", id)
 			}
 
 			return instance, ncloud.StringValue(instance.ServerInstanceStatus), nil
 		},
-		Timeout:    conn.DefaultCreateTimeout,
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Timeout:     conn.DefaultCreateTimeout,
+		Delay:       2 * time.Second,
+		MinInterval: 3 * time.Second,
 	}
 
-	_, err := stateConf.WaitForState()
+	_, err := stateConf.WaitForState(ctx)
 	if err != nil {
 		return fmt.Errorf("error waiting for ServerInstance state to be \"RUN\": %s", err)
 	}
@@ -582,8 +810,8 @@ func waitStateNcloudServerForCreation(config *conn.ProviderConfig, id string) er
 	return nil
 }
 
-func updateServerInstanceSpec(d *schema.ResourceData, config *conn.ProviderConfig) error {
-	serverInstance, err := GetServerInstance(config, d.Id())
+func updateServerInstanceSpec(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) error {
+	serverInstance, err := GetServerInstance(ctx, config, d.Id())
 	if err != nil {
 		return err
 	}
@@ -594,40 +822,190 @@ func updateServerInstanceSpec(d *schema.ResourceData, config *conn.ProviderConfi
 
 	log.Printf("[INFO] Stopping Instance %q for server_product_code change", d.Id())
 	if ncloud.StringValue(serverInstance.ServerInstanceStatus) != "NSTOP" {
-		if err := stopThenWaitServerInstance(config, d.Id()); err != nil {
+		if err := stopThenWaitServerInstance(ctx, config, d.Id()); err != nil {
 			return err
 		}
 	}
 
-	if err := changeServerInstanceSpec(d, config); err != nil {
+	if err := changeServerInstanceSpec(ctx, d, config); err != nil {
 		return err
 	}
 
+	if d.Get("desired_status").(string) == ServerDesiredStatusStopped {
+		return nil
+	}
+
 	log.Printf("[INFO] Start Instance %q for server_product_code change", d.Id())
-	if err := startThenWaitServerInstance(config, d.Id()); err != nil {
+	if err := startThenWaitServerInstance(ctx, config, d.Id()); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func changeServerInstanceSpec(d *schema.ResourceData, config *conn.ProviderConfig) error {
+// updateServerDesiredStatus stops or starts the server to bring it to its desired_status
+func updateServerDesiredStatus(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) error {
+	serverInstance, err := GetServerInstance(ctx, config, d.Id())
+	if err != nil {
+		return err
+	}
+
+	if serverInstance == nil {
+		return fmt.Errorf("fail to get Server instance, %s doesn't exist", d.Id())
+	}
+
+	desiredStatus := d.Get("desired_status").(string)
+	if serverDesiredStatus(serverInstance.ServerInstanceStatus) == desiredStatus {
+		return nil
+	}
+
+	switch desiredStatus {
+	case ServerDesiredStatusStopped:
+		log.Printf("[INFO] Stopping Instance %q for desired_status", d.Id())
+		return stopThenWaitServerInstance(ctx, config, d.Id())
+	case ServerDesiredStatusRunning:
+		log.Printf("[INFO] Starting Instance %q for desired_status", d.Id())
+		return startThenWaitServerInstance(ctx, config, d.Id())
+	}
+
+	return nil
+}
+
+// updateServerPlacementGroup moves the server from its placement group to the new placement_group_no. The server is
+// stopped during the change.
+func updateServerPlacementGroup(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) error {
+	serverInstance, err := GetServerInstance(ctx, config, d.Id())
+	if err != nil {
+		return err
+	}
+
+	if serverInstance == nil {
+		return fmt.Errorf("fail to get Server instance, %s doesn't exist", d.Id())
+	}
+
+	if ncloud.StringValue(serverInstance.ServerInstanceStatus) != "NSTOP" {
+		log.Printf("[INFO] Stopping Instance %q for placement_group_no change", d.Id())
+		if err := stopThenWaitServerInstance(ctx, config, d.Id()); err != nil {
+			return err
+		}
+	}
+
+	o, n := d.GetChange("placement_group_no")
+	if len(o.(string)) > 0 {
+		if err := removeVpcServerPlacementGroup(ctx, config, d.Id(), o.(string)); err != nil {
+			return err
+		}
+	}
+
+	if len(n.(string)) > 0 {
+		if err := addVpcServerPlacementGroup(ctx, config, d.Id(), n.(string)); err != nil {
+			return err
+		}
+	}
+
+	if d.Get("desired_status").(string) == ServerDesiredStatusStopped {
+		return nil
+	}
+
+	log.Printf("[INFO] Start Instance %q for placement_group_no change", d.Id())
+	return startThenWaitServerInstance(ctx, config, d.Id())
+}
+
+func addVpcServerPlacementGroup(ctx context.Context, config *conn.ProviderConfig, id string, placementGroupNo string) error {
+	reqParams := &vserver.AddPlacementGroupServerInstanceRequest{
+		RegionCode:       &config.RegionCode,
+		PlacementGroupNo: ncloud.String(placementGroupNo),
+		ServerInstanceNo: ncloud.String(id),
+	}
+
+	ctx = LogCommonRequest(ctx, "addVpcServerPlacementGroup", reqParams)
+	resp, err := config.Client.Vserver.V2Api.AddPlacementGroupServerInstance(reqParams)
+	if err != nil {
+		LogErrorResponse(ctx, "addVpcServerPlacementGroup", err, reqParams)
+		return err
+	}
+	LogResponse(ctx, "addVpcServerPlacementGroup", resp)
+
+	return nil
+}
+
+func removeVpcServerPlacementGroup(ctx context.Context, config *conn.ProviderConfig, id string, placementGroupNo string) error {
+	reqParams := &vserver.RemovePlacementGroupServerInstanceRequest{
+		RegionCode:       &config.RegionCode,
+		PlacementGroupNo: ncloud.String(placementGroupNo),
+		ServerInstanceNo: ncloud.String(id),
+	}
+
+	ctx = LogCommonRequest(ctx, "removeVpcServerPlacementGroup", reqParams)
+	resp, err := config.Client.Vserver.V2Api.RemovePlacementGroupServerInstance(reqParams)
+	if err != nil {
+		LogErrorResponse(ctx, "removeVpcServerPlacementGroup", err, reqParams)
+		return err
+	}
+	LogResponse(ctx, "removeVpcServerPlacementGroup", resp)
+
+	return nil
+}
+
+// updateServerNetworkInterfaceAccessControlGroups changes the ACGs of each network interface of the server. The
+// network interfaces themselves can't change, their network_interface_no and order replace the server.
+func updateServerNetworkInterfaceAccessControlGroups(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) error {
+	o, n := d.GetChange("network_interface")
+	oldList := o.([]interface{})
+
+	for i, v := range n.([]interface{}) {
+		if i >= len(oldList) {
+			break
+		}
+		ni, ok := v.(map[string]interface{})
+		oldNi, oldOk := oldList[i].(map[string]interface{})
+		if !ok || !oldOk {
+			continue
+		}
+
+		oldAcgs, _ := oldNi["access_control_groups"].(*schema.Set)
+		newAcgs, _ := ni["access_control_groups"].(*schema.Set)
+		// ACGs left unset in the configuration are kept
+		if oldAcgs == nil || newAcgs == nil || newAcgs.Len() == 0 || oldAcgs.Equal(newAcgs) {
+			continue
+		}
+
+		if err := updateNetworkInterfaceAccessControlGroups(ctx, config, ni["network_interface_no"].(string), oldAcgs, newAcgs); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// serverDesiredStatus returns the desired_status matching the status of the server, empty while it's in transition
+func serverDesiredStatus(status *string) string {
+	switch ncloud.StringValue(status) {
+	case "RUN":
+		return ServerDesiredStatusRunning
+	case "NSTOP":
+		return ServerDesiredStatusStopped
+	}
+	return ""
+}
+
+func changeServerInstanceSpec(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) error {
 	var err error
 	if config.SupportVPC {
-		err = changeVpcServerInstanceSpec(d, config)
+		err = changeVpcServerInstanceSpec(ctx, d, config)
 	} else {
-		err = changeClassicServerInstanceSpec(d, config)
+		err = changeClassicServerInstanceSpec(ctx, d, config)
 	}
 
 	if err != nil {
 		return err
 	}
 
-	stateConf := &retry.StateChangeConf{
+	stateConf := &waiter.Config[any]{
 		Pending: []string{"CHNG"},
 		Target:  []string{"NULL"},
-		Refresh: func() (interface{}, string, error) {
-			instance, err := GetServerInstance(config, d.Id())
+		Refresh: func(ctx context.Context) (any, string, error) {
+			instance, err := GetServerInstance(ctx, config, d.Id())
 
 			if err != nil {
 				return 0, "", err
@@ -639,12 +1017,12 @@ func changeServerInstanceSpec(d *schema.ResourceData, config *conn.ProviderConfi
 
 			return instance, ncloud.StringValue(instance.ServerInstanceOperation), nil
 		},
-		Timeout:    conn.DefaultTimeout,
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Timeout:     conn.DefaultTimeout,
+		Delay:       2 * time.Second,
+		MinInterval: 3 * time.Second,
 	}
 
-	_, err = stateConf.WaitForState()
+	_, err = stateConf.WaitForState(ctx)
 	if err != nil {
 		return fmt.Errorf("error waiting for ServerInstance operation to be \"NULL\": %s", err)
 	}
@@ -652,24 +1030,24 @@ func changeServerInstanceSpec(d *schema.ResourceData, config *conn.ProviderConfi
 	return nil
 }
 
-func changeClassicServerInstanceSpec(d *schema.ResourceData, config *conn.ProviderConfig) error {
+func changeClassicServerInstanceSpec(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) error {
 	reqParams := &server.ChangeServerInstanceSpecRequest{
 		ServerInstanceNo:  ncloud.String(d.Get("instance_no").(string)),
 		ServerProductCode: ncloud.String(d.Get("server_product_code").(string)),
 	}
 
-	LogCommonRequest("changeClassicServerInstanceSpec", reqParams)
+	ctx = LogCommonRequest(ctx, "changeClassicServerInstanceSpec", reqParams)
 	resp, err := config.Client.Server.V2Api.ChangeServerInstanceSpec(reqParams)
 	if err != nil {
-		LogErrorResponse("changeClassicServerInstanceSpec", err, reqParams)
+		LogErrorResponse(ctx, "changeClassicServerInstanceSpec", err, reqParams)
 		return err
 	}
-	LogCommonResponse("changeClassicServerInstanceSpec", GetCommonResponse(resp))
+	LogCommonResponse(ctx, "changeClassicServerInstanceSpec", GetCommonResponse(resp))
 
 	return nil
 }
 
-func changeVpcServerInstanceSpec(d *schema.ResourceData, config *conn.ProviderConfig) error {
+func changeVpcServerInstanceSpec(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) error {
 	reqParams := &vserver.ChangeServerInstanceSpecRequest{
 		RegionCode:       &config.RegionCode,
 		ServerInstanceNo: ncloud.String(d.Get("instance_no").(string)),
@@ -682,77 +1060,122 @@ func changeVpcServerInstanceSpec(d *schema.ResourceData, config *conn.ProviderCo
 		reqParams.ServerSpecCode = ncloud.String(d.Get("server_spec_code").(string))
 	}
 
-	LogCommonRequest("changeVpcServerInstanceSpec", reqParams)
+	ctx = LogCommonRequest(ctx, "changeVpcServerInstanceSpec", reqParams)
 	resp, err := config.Client.Vserver.V2Api.ChangeServerInstanceSpec(reqParams)
 	if err != nil {
-		LogErrorResponse("ChangeServerInstanceSpec", err, reqParams)
+		LogErrorResponse(ctx, "ChangeServerInstanceSpec", err, reqParams)
 		return err
 	}
-	LogResponse("changeVpcServerInstanceSpec", resp)
+	LogResponse(ctx, "changeVpcServerInstanceSpec", resp)
 
 	return nil
 }
 
-func updateServerProtectionTermination(d *schema.ResourceData, config *conn.ProviderConfig) error {
+func updateClassicServerInstanceTags(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) error {
+	o, _ := d.GetChange("tags_all")
+	oldTags := ExpandTags(o.(map[string]interface{}))
+	newTags := MergeTags(config.DefaultTags, ExpandTags(d.Get("tags").(map[string]interface{})))
+
+	create, remove := DiffTags(oldTags, newTags)
+
+	if len(remove) > 0 {
+		removeTags := map[string]string{}
+		for _, k := range remove {
+			removeTags[k] = oldTags[k]
+		}
+
+		reqParams := &server.DeleteInstanceTagsRequest{
+			InstanceNoList:  []*string{ncloud.String(d.Id())},
+			InstanceTagList: expandInstanceTagParameters(removeTags),
+		}
+
+		ctx = LogCommonRequest(ctx, "deleteClassicServerInstanceTags", reqParams)
+		resp, err := config.Client.Server.V2Api.DeleteInstanceTags(reqParams)
+		if err != nil {
+			LogErrorResponse(ctx, "deleteClassicServerInstanceTags", err, reqParams)
+			return err
+		}
+		LogResponse(ctx, "deleteClassicServerInstanceTags", resp)
+	}
+
+	if len(create) > 0 {
+		reqParams := &server.CreateInstanceTagsRequest{
+			InstanceNoList:  []*string{ncloud.String(d.Id())},
+			InstanceTagList: expandInstanceTagParameters(create),
+		}
+
+		ctx = LogCommonRequest(ctx, "createClassicServerInstanceTags", reqParams)
+		resp, err := config.Client.Server.V2Api.CreateInstanceTags(reqParams)
+		if err != nil {
+			LogErrorResponse(ctx, "createClassicServerInstanceTags", err, reqParams)
+			return err
+		}
+		LogResponse(ctx, "createClassicServerInstanceTags", resp)
+	}
+
+	return nil
+}
+
+func updateServerProtectionTermination(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) error {
 	if config.SupportVPC {
-		return updateVpcServerProtectionTermination(d, config)
+		return updateVpcServerProtectionTermination(ctx, d, config)
 	}
 
-	return updateClassicServerProtectionTermination(d, config)
+	return updateClassicServerProtectionTermination(ctx, d, config)
 }
 
-func updateVpcServerProtectionTermination(d *schema.ResourceData, config *conn.ProviderConfig) error {
+func updateVpcServerProtectionTermination(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) error {
 	reqParams := &vserver.SetProtectServerTerminationRequest{
 		RegionCode:                 &config.RegionCode,
 		ServerInstanceNo:           ncloud.String(d.Id()),
 		IsProtectServerTermination: ncloud.Bool(d.Get("is_protect_server_termination").(bool)),
 	}
 
-	LogCommonRequest("SetProtectServerTermination", reqParams)
+	ctx = LogCommonRequest(ctx, "SetProtectServerTermination", reqParams)
 	resp, err := config.Client.Vserver.V2Api.SetProtectServerTermination(reqParams)
 	if err != nil {
-		LogErrorResponse("SetProtectServerTermination", err, reqParams)
+		LogErrorResponse(ctx, "SetProtectServerTermination", err, reqParams)
 		return err
 	}
-	LogResponse("SetProtectServerTermination", resp)
+	LogResponse(ctx, "SetProtectServerTermination", resp)
 
 	return nil
 }
 
-func updateClassicServerProtectionTermination(d *schema.ResourceData, config *conn.ProviderConfig) error {
+func updateClassicServerProtectionTermination(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) error {
 	reqParams := &server.SetProtectServerTerminationRequest{
 		ServerInstanceNo:           ncloud.String(d.Id()),
 		IsProtectServerTermination: ncloud.Bool(d.Get("is_protect_server_termination").(bool)),
 	}
 
-	LogCommonRequest("SetProtectServerTermination", reqParams)
+	ctx = LogCommonRequest(ctx, "SetProtectServerTermination", reqParams)
 	resp, err := config.Client.Server.V2Api.SetProtectServerTermination(reqParams)
 	if err != nil {
-		LogErrorResponse("SetProtectServerTermination", err, reqParams)
+		LogErrorResponse(ctx, "SetProtectServerTermination", err, reqParams)
 		return err
 	}
-	LogResponse("SetProtectServerTermination", resp)
+	LogResponse(ctx, "SetProtectServerTermination", resp)
 
 	return nil
 }
 
-func startThenWaitServerInstance(config *conn.ProviderConfig, id string) error {
+func startThenWaitServerInstance(ctx context.Context, config *conn.ProviderConfig, id string) error {
 	var err error
 	if config.SupportVPC {
-		err = startVpcServerInstance(config, id)
+		err = startVpcServerInstance(ctx, config, id)
 	} else {
-		err = startClassicServerInstance(config, id)
+		err = startClassicServerInstance(ctx, config, id)
 	}
 
 	if err != nil {
 		return err
 	}
 
-	stateConf := &retry.StateChangeConf{
+	stateConf := &waiter.Config[any]{
 		Pending: []string{"NSTOP"},
 		Target:  []string{"RUN"},
-		Refresh: func() (interface{}, string, error) {
-			instance, err := GetServerInstance(config, id)
+		Refresh: func(ctx context.Context) (any, string, error) {
+			instance, err := GetServerInstance(ctx, config, id)
 			if err != nil {
 				return 0, "", err
 			}
@@ -763,12 +1186,12 @@ func startThenWaitServerInstance(config *conn.ProviderConfig, id string) error {
 
 			return instance, ncloud.StringValue(instance.ServerInstanceStatus), nil
 		},
-		Timeout:    conn.DefaultTimeout,
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Timeout:     conn.DefaultTimeout,
+		Delay:       2 * time.Second,
+		MinInterval: 3 * time.Second,
 	}
 
-	_, err = stateConf.WaitForState()
+	_, err = stateConf.WaitForState(ctx)
 	if err != nil {
 		return fmt.Errorf("error waiting for ServerInstance state to be \"RUN\": %s", err)
 	}
@@ -776,59 +1199,59 @@ func startThenWaitServerInstance(config *conn.ProviderConfig, id string) error {
 	return nil
 }
 
-func startClassicServerInstance(config *conn.ProviderConfig, id string) error {
+func startClassicServerInstance(ctx context.Context, config *conn.ProviderConfig, id string) error {
 	reqParams := &server.StartServerInstancesRequest{
 		ServerInstanceNoList: []*string{ncloud.String(id)},
 	}
-	LogCommonRequest("startClassicServerInstance", reqParams)
+	ctx = LogCommonRequest(ctx, "startClassicServerInstance", reqParams)
 	resp, err := config.Client.Server.V2Api.StartServerInstances(reqParams)
 	if err != nil {
-		LogErrorResponse("startClassicServerInstance", err, reqParams)
+		LogErrorResponse(ctx, "startClassicServerInstance", err, reqParams)
 		return err
 	}
-	LogResponse("startClassicServerInstance", resp)
+	LogResponse(ctx, "startClassicServerInstance", resp)
 
 	return nil
 }
 
-func startVpcServerInstance(config *conn.ProviderConfig, id string) error {
+func startVpcServerInstance(ctx context.Context, config *conn.ProviderConfig, id string) error {
 	reqParams := &vserver.StartServerInstancesRequest{
 		RegionCode:           &config.RegionCode,
 		ServerInstanceNoList: []*string{ncloud.String(id)},
 	}
-	LogCommonRequest("startVpcServerInstance", reqParams)
+	ctx = LogCommonRequest(ctx, "startVpcServerInstance", reqParams)
 	resp, err := config.Client.Vserver.V2Api.StartServerInstances(reqParams)
 	if err != nil {
-		LogErrorResponse("startVpcServerInstance", err, reqParams)
+		LogErrorResponse(ctx, "startVpcServerInstance", err, reqParams)
 		return err
 	}
-	LogResponse("startVpcServerInstance", resp)
+	LogResponse(ctx, "startVpcServerInstance", resp)
 
 	return nil
 }
 
-func GetServerInstance(config *conn.ProviderConfig, id string) (*ServerInstance, error) {
+func GetServerInstance(ctx context.Context, config *conn.ProviderConfig, id string) (*ServerInstance, error) {
 	if config.SupportVPC {
-		return getVpcServerInstance(config, id)
+		return getVpcServerInstance(ctx, config, id)
 	}
 
-	return getClassicServerInstance(config, id)
+	return getClassicServerInstance(ctx, config, id)
 }
 
-func getClassicServerInstance(config *conn.ProviderConfig, id string) (*ServerInstance, error) {
+func getClassicServerInstance(ctx context.Context, config *conn.ProviderConfig, id string) (*ServerInstance, error) {
 	reqParams := &server.GetServerInstanceListRequest{
 		ServerInstanceNoList: []*string{ncloud.String(id)},
 	}
 
-	LogCommonRequest("getClassicServerInstance", reqParams)
+	ctx = LogCommonRequest(ctx, "getClassicServerInstance", reqParams)
 	resp, err := config.Client.Server.V2Api.GetServerInstanceList(reqParams)
 
 	if err != nil {
-		LogErrorResponse("getClassicServerInstance", err, reqParams)
+		LogErrorResponse(ctx, "getClassicServerInstance", err, reqParams)
 		return nil, err
 	}
 
-	LogResponse("getClassicServerInstance", resp)
+	LogResponse(ctx, "getClassicServerInstance", resp)
 
 	if len(resp.ServerInstanceList) == 0 {
 		return nil, nil
@@ -875,21 +1298,21 @@ func convertClassicServerInstance(r *server.ServerInstance) *ServerInstance {
 	}
 }
 
-func getVpcServerInstance(config *conn.ProviderConfig, id string) (*ServerInstance, error) {
+func getVpcServerInstance(ctx context.Context, config *conn.ProviderConfig, id string) (*ServerInstance, error) {
 	reqParams := &vserver.GetServerInstanceDetailRequest{
 		RegionCode:       &config.RegionCode,
 		ServerInstanceNo: ncloud.String(id),
 	}
 
-	LogCommonRequest("getVpcServerInstance", reqParams)
+	ctx = LogCommonRequest(ctx, "getVpcServerInstance", reqParams)
 	resp, err := config.Client.Vserver.V2Api.GetServerInstanceDetail(reqParams)
 
 	if err != nil {
-		LogErrorResponse("getVpcServerInstance", err, reqParams)
+		LogErrorResponse(ctx, "getVpcServerInstance", err, reqParams)
 		return nil, err
 	}
 
-	LogResponse("getVpcServerInstance", resp)
+	LogResponse(ctx, "getVpcServerInstance", resp)
 
 	if resp == nil || len(resp.ServerInstanceList) == 0 {
 		return nil, nil
@@ -944,9 +1367,9 @@ func convertVcpServerInstance(r *vserver.ServerInstance) *ServerInstance {
 	return instance
 }
 
-func buildNetworkInterfaceList(config *conn.ProviderConfig, r *ServerInstance) error {
+func buildNetworkInterfaceList(ctx context.Context, config *conn.ProviderConfig, r *ServerInstance) error {
 	for _, ni := range r.NetworkInterfaceList {
-		networkInterface, err := GetNetworkInterface(config, *ni.NetworkInterfaceNo)
+		networkInterface, err := GetNetworkInterface(ctx, config, *ni.NetworkInterfaceNo)
 
 		if err != nil {
 			return err
@@ -967,19 +1390,20 @@ func buildNetworkInterfaceList(config *conn.ProviderConfig, r *ServerInstance) e
 		ni.SubnetNo = networkInterface.SubnetNo
 		ni.NetworkInterfaceNo = networkInterface.NetworkInterfaceNo
 		ni.Order = ncloud.Int32(int32(order))
+		ni.AccessControlGroups = networkInterface.AccessControlGroupNoList
 	}
 
 	return nil
 }
 
-func stopThenWaitServerInstance(config *conn.ProviderConfig, id string) error {
+func stopThenWaitServerInstance(ctx context.Context, config *conn.ProviderConfig, id string) error {
 	var err error
 
-	stateConf := &retry.StateChangeConf{
+	stateConf := &waiter.Config[any]{
 		Pending: []string{"SETUP"},
 		Target:  []string{"NULL"},
-		Refresh: func() (interface{}, string, error) {
-			instance, err := GetServerInstance(config, id)
+		Refresh: func(ctx context.Context) (any, string, error) {
+			instance, err := GetServerInstance(ctx, config, id)
 			if err != nil {
 				return 0, "", err
 			}
@@ -990,31 +1414,31 @@ func stopThenWaitServerInstance(config *conn.ProviderConfig, id string) error {
 
 			return instance, ncloud.StringValue(instance.ServerInstanceOperation), nil
 		},
-		Timeout:    conn.DefaultStopTimeout,
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Timeout:     conn.DefaultStopTimeout,
+		Delay:       2 * time.Second,
+		MinInterval: 3 * time.Second,
 	}
 
-	_, err = stateConf.WaitForState()
+	_, err = stateConf.WaitForState(ctx)
 	if err != nil {
 		return fmt.Errorf("error waiting for ServerInstance operation to be \"NULL\": %s", err)
 	}
 
 	if config.SupportVPC {
-		err = stopVpcServerInstance(config, id)
+		err = stopVpcServerInstance(ctx, config, id)
 	} else {
-		err = stopClassicServerInstance(config, id)
+		err = stopClassicServerInstance(ctx, config, id)
 	}
 
 	if err != nil {
 		return err
 	}
 
-	stateConf = &retry.StateChangeConf{
+	stateConf = &waiter.Config[any]{
 		Pending: []string{"RUN"},
 		Target:  []string{"NSTOP"},
-		Refresh: func() (interface{}, string, error) {
-			instance, err := GetServerInstance(config, id)
+		Refresh: func(ctx context.Context) (any, string, error) {
+			instance, err := GetServerInstance(ctx, config, id)
 			if err != nil {
 				return 0, "", err
 			}
@@ -1025,12 +1449,12 @@ func stopThenWaitServerInstance(config *conn.ProviderConfig, id string) error {
 
 			return instance, ncloud.StringValue(instance.ServerInstanceStatus), nil
 		},
-		Timeout:    conn.DefaultStopTimeout,
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Timeout:     conn.DefaultStopTimeout,
+		Delay:       2 * time.Second,
+		MinInterval: 3 * time.Second,
 	}
 
-	_, err = stateConf.WaitForState()
+	_, err = stateConf.WaitForState(ctx)
 	if err != nil {
 		return fmt.Errorf("error waiting for ServerInstance state to be \"NSTOP\": %s", err)
 	}
@@ -1038,12 +1462,12 @@ func stopThenWaitServerInstance(config *conn.ProviderConfig, id string) error {
 	return nil
 }
 
-func detachThenWaitServerInstance(config *conn.ProviderConfig, id string) error {
-	stateConf := &retry.StateChangeConf{
+func detachThenWaitServerInstance(ctx context.Context, config *conn.ProviderConfig, id string) error {
+	stateConf := &waiter.Config[any]{
 		Pending: []string{"SETUP"},
 		Target:  []string{"NULL"},
-		Refresh: func() (interface{}, string, error) {
-			instance, err := GetServerInstance(config, id)
+		Refresh: func(ctx context.Context) (any, string, error) {
+			instance, err := GetServerInstance(ctx, config, id)
 			if err != nil {
 				return 0, "", err
 			}
@@ -1061,12 +1485,12 @@ func detachThenWaitServerInstance(config *conn.ProviderConfig, id string) error
 
 			return instance, ncloud.StringValue(instance.ServerInstanceOperation), nil
 		},
-		Timeout:    conn.DefaultStopTimeout,
-		Delay:      5 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Timeout:     conn.DefaultStopTimeout,
+		Delay:       5 * time.Second,
+		MinInterval: 3 * time.Second,
 	}
 
-	_, err := stateConf.WaitForState()
+	_, err := stateConf.WaitForState(ctx)
 	if err != nil {
 		return fmt.Errorf("error waiting for ServerInstance operation to be \"NULL\": %s", err)
 	}
@@ -1074,54 +1498,54 @@ func detachThenWaitServerInstance(config *conn.ProviderConfig, id string) error
 	return nil
 }
 
-func stopClassicServerInstance(config *conn.ProviderConfig, id string) error {
+func stopClassicServerInstance(ctx context.Context, config *conn.ProviderConfig, id string) error {
 	reqParams := &server.StopServerInstancesRequest{
 		ServerInstanceNoList: []*string{ncloud.String(id)},
 	}
-	LogCommonRequest("stopClassicServerInstance", reqParams)
+	ctx = LogCommonRequest(ctx, "stopClassicServerInstance", reqParams)
 	resp, err := config.Client.Server.V2Api.StopServerInstances(reqParams)
 	if err != nil {
-		LogErrorResponse("stopClassicServerInstance", err, reqParams)
+		LogErrorResponse(ctx, "stopClassicServerInstance", err, reqParams)
 		return err
 	}
-	LogResponse("stopClassicServerInstance", resp)
+	LogResponse(ctx, "stopClassicServerInstance", resp)
 
 	return nil
 }
 
-func stopVpcServerInstance(config *conn.ProviderConfig, id string) error {
+func stopVpcServerInstance(ctx context.Context, config *conn.ProviderConfig, id string) error {
 	reqParams := &vserver.StopServerInstancesRequest{
 		RegionCode:           &config.RegionCode,
 		ServerInstanceNoList: []*string{ncloud.String(id)},
 	}
-	LogCommonRequest("stopVpcServerInstance", reqParams)
+	ctx = LogCommonRequest(ctx, "stopVpcServerInstance", reqParams)
 	resp, err := config.Client.Vserver.V2Api.StopServerInstances(reqParams)
 	if err != nil {
-		LogErrorResponse("stopVpcServerInstance", err, reqParams)
+		LogErrorResponse(ctx, "stopVpcServerInstance", err, reqParams)
 		return err
 	}
-	LogResponse("stopVpcServerInstance", resp)
+	LogResponse(ctx, "stopVpcServerInstance", resp)
 
 	return nil
 }
 
-func terminateThenWaitServerInstance(config *conn.ProviderConfig, id string) error {
+func terminateThenWaitServerInstance(ctx context.Context, config *conn.ProviderConfig, id string) error {
 	var err error
 	if config.SupportVPC {
-		err = terminateVpcServerInstance(config, id)
+		err = terminateVpcServerInstance(ctx, config, id)
 	} else {
-		err = terminateClassicServerInstance(config, id)
+		err = terminateClassicServerInstance(ctx, config, id)
 	}
 
 	if err != nil {
 		return err
 	}
 
-	stateConf := &retry.StateChangeConf{
+	stateConf := &waiter.Config[any]{
 		Pending: []string{"NSTOP"},
 		Target:  []string{"TERMINATED"},
-		Refresh: func() (interface{}, string, error) {
-			instance, err := GetServerInstance(config, id)
+		Refresh: func(ctx context.Context) (any, string, error) {
+			instance, err := GetServerInstance(ctx, config, id)
 
 			if err != nil {
 				return 0, "", err
@@ -1131,12 +1555,12 @@ func terminateThenWaitServerInstance(config *conn.ProviderConfig, id string) err
 			}
 			return instance, ncloud.StringValue(instance.ServerInstanceStatus), nil
 		},
-		Timeout:    conn.DefaultTimeout,
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Timeout:     conn.DefaultTimeout,
+		Delay:       2 * time.Second,
+		MinInterval: 3 * time.Second,
 	}
 
-	_, err = stateConf.WaitForState()
+	_, err = stateConf.WaitForState(ctx)
 	if err != nil {
 		return fmt.Errorf("error waiting for ServerInstance state to be \"TERMINATED\": %s", err)
 	}
@@ -1144,63 +1568,49 @@ func terminateThenWaitServerInstance(config *conn.ProviderConfig, id string) err
 	return nil
 }
 
-func terminateClassicServerInstance(config *conn.ProviderConfig, id string) error {
+func terminateClassicServerInstance(ctx context.Context, config *conn.ProviderConfig, id string) error {
 	reqParams := &server.TerminateServerInstancesRequest{
 		ServerInstanceNoList: []*string{ncloud.String(id)},
 	}
 
-	var resp *server.TerminateServerInstancesResponse
-	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
-		var err error
-		LogCommonRequest("terminateClassicServerInstance", reqParams)
-		resp, err = config.Client.Server.V2Api.TerminateServerInstances(reqParams)
-		if err != nil {
-			errBody, _ := GetCommonErrorBody(err)
-			if ContainsInStringList(errBody.ReturnCode, []string{ApiErrorUnknown, ApiErrorServerObjectInOperation2}) {
-				LogErrorResponse("retry terminateClassicServerInstance", err, reqParams)
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		LogResponse("terminateClassicServerInstance", resp)
-		return nil
-	})
-
+	ctx = LogCommonRequest(ctx, "terminateClassicServerInstance", reqParams)
+	resp, err := config.Client.Server.V2Api.TerminateServerInstances(reqParams)
 	if err != nil {
-		LogErrorResponse("terminateClassicServerInstance", err, reqParams)
+		LogErrorResponse(ctx, "terminateClassicServerInstance", err, reqParams)
 		return err
 	}
+	LogResponse(ctx, "terminateClassicServerInstance", resp)
 
 	return nil
 }
 
-func terminateVpcServerInstance(config *conn.ProviderConfig, id string) error {
+func terminateVpcServerInstance(ctx context.Context, config *conn.ProviderConfig, id string) error {
 	reqParams := &vserver.TerminateServerInstancesRequest{
 		RegionCode:           &config.RegionCode,
 		ServerInstanceNoList: []*string{ncloud.String(id)},
 	}
 
-	LogCommonRequest("terminateVpcServerInstance", reqParams)
+	ctx = LogCommonRequest(ctx, "terminateVpcServerInstance", reqParams)
 	resp, err := config.Client.Vserver.V2Api.TerminateServerInstances(reqParams)
-	LogResponse("terminateVpcServerInstance", resp)
+	LogResponse(ctx, "terminateVpcServerInstance", resp)
 
 	if err != nil {
-		LogErrorResponse("terminateVpcServerInstance", err, reqParams)
+		LogErrorResponse(ctx, "terminateVpcServerInstance", err, reqParams)
 		return err
 	}
 
 	return nil
 }
 
-func getAdditionalBlockStorageList(config *conn.ProviderConfig, id string) ([]*BlockStorage, error) {
+func getAdditionalBlockStorageList(ctx context.Context, config *conn.ProviderConfig, id string) ([]*BlockStorage, error) {
 	if config.SupportVPC {
-		return getVpcAdditionalBlockStorageList(config, id)
+		return getVpcAdditionalBlockStorageList(ctx, config, id)
 	} else {
-		return getClassicAdditionalBlockStorageList(config, id)
+		return getClassicAdditionalBlockStorageList(ctx, config, id)
 	}
 }
 
-func getVpcAdditionalBlockStorageList(config *conn.ProviderConfig, id string) ([]*BlockStorage, error) {
+func getVpcAdditionalBlockStorageList(ctx context.Context, config *conn.ProviderConfig, id string) ([]*BlockStorage, error) {
 	resp, err := config.Client.Vserver.V2Api.GetBlockStorageInstanceList(&vserver.GetBlockStorageInstanceListRequest{
 		RegionCode:               &config.RegionCode,
 		ServerInstanceNo:         ncloud.String(id),
@@ -1211,7 +1621,7 @@ func getVpcAdditionalBlockStorageList(config *conn.ProviderConfig, id string) ([
 		return nil, err
 	}
 
-	LogResponse("getVpcAdditionalBlockStorageList", resp)
+	LogResponse(ctx, "getVpcAdditionalBlockStorageList", resp)
 
 	if len(resp.BlockStorageInstanceList) < 1 {
 		return nil, nil
@@ -1225,7 +1635,7 @@ func getVpcAdditionalBlockStorageList(config *conn.ProviderConfig, id string) ([
 	return blockStorageList, nil
 }
 
-func getVpcBasicBlockStorageList(config *conn.ProviderConfig, id string) ([]*BlockStorage, error) {
+func getVpcBasicBlockStorageList(ctx context.Context, config *conn.ProviderConfig, id string) ([]*BlockStorage, error) {
 	resp, err := config.Client.Vserver.V2Api.GetBlockStorageInstanceList(&vserver.GetBlockStorageInstanceListRequest{
 		RegionCode:       &config.RegionCode,
 		ServerInstanceNo: ncloud.String(id),
@@ -1235,7 +1645,7 @@ func getVpcBasicBlockStorageList(config *conn.ProviderConfig, id string) ([]*Blo
 		return nil, err
 	}
 
-	LogResponse("getVpcBasicBlockStorageList", resp)
+	LogResponse(ctx, "getVpcBasicBlockStorageList", resp)
 
 	if len(resp.BlockStorageInstanceList) < 1 {
 		return nil, nil
@@ -1249,7 +1659,7 @@ func getVpcBasicBlockStorageList(config *conn.ProviderConfig, id string) ([]*Blo
 	return blockStorageList, nil
 }
 
-func getClassicAdditionalBlockStorageList(config *conn.ProviderConfig, id string) ([]*BlockStorage, error) {
+func getClassicAdditionalBlockStorageList(ctx context.Context, config *conn.ProviderConfig, id string) ([]*BlockStorage, error) {
 	resp, err := config.Client.Server.V2Api.GetBlockStorageInstanceList(&server.GetBlockStorageInstanceListRequest{
 		RegionNo:                 &config.RegionCode,
 		ServerInstanceNo:         ncloud.String(id),
@@ -1260,7 +1670,7 @@ func getClassicAdditionalBlockStorageList(config *conn.ProviderConfig, id string
 		return nil, err
 	}
 
-	LogResponse("getClassicAdditionalBlockStorageList", resp)
+	LogResponse(ctx, "getClassicAdditionalBlockStorageList", resp)
 
 	if len(resp.BlockStorageInstanceList) < 1 {
 		return nil, nil
@@ -1311,15 +1721,15 @@ func convertClassicBlockStorage(storage *server.BlockStorageInstance) *BlockStor
 	}
 }
 
-func disconnectBlockStorage(config *conn.ProviderConfig, storage *BlockStorage) error {
+func disconnectBlockStorage(ctx context.Context, config *conn.ProviderConfig, storage *BlockStorage) error {
 	if config.SupportVPC {
-		return disconnectVpcBlockStorage(config, storage)
+		return disconnectVpcBlockStorage(ctx, config, storage)
 	} else {
-		return disconnectClassicBlockStorage(config, storage)
+		return disconnectClassicBlockStorage(ctx, config, storage)
 	}
 }
 
-func disconnectVpcBlockStorage(config *conn.ProviderConfig, storage *BlockStorage) error {
+func disconnectVpcBlockStorage(ctx context.Context, config *conn.ProviderConfig, storage *BlockStorage) error {
 	_, err := config.Client.Vserver.V2Api.DetachBlockStorageInstances(&vserver.DetachBlockStorageInstancesRequest{
 		RegionCode:                 &config.RegionCode,
 		BlockStorageInstanceNoList: []*string{storage.BlockStorageInstanceNo},
@@ -1332,7 +1742,7 @@ func disconnectVpcBlockStorage(config *conn.ProviderConfig, storage *BlockStorag
 	return nil
 }
 
-func disconnectClassicBlockStorage(config *conn.ProviderConfig, storage *BlockStorage) error {
+func disconnectClassicBlockStorage(ctx context.Context, config *conn.ProviderConfig, storage *BlockStorage) error {
 	_, err := config.Client.Server.V2Api.DetachBlockStorageInstances(&server.DetachBlockStorageInstancesRequest{
 		BlockStorageInstanceNoList: []*string{storage.BlockStorageInstanceNo},
 	})
@@ -1344,12 +1754,17 @@ func disconnectClassicBlockStorage(config *conn.ProviderConfig, storage *BlockSt
 	return nil
 }
 
-func waitForDisconnectBlockStorage(config *conn.ProviderConfig, no string) error {
-	stateConf := &retry.StateChangeConf{
+func waitForDisconnectBlockStorage(ctx context.Context, config *conn.ProviderConfig, no string, timeout time.Duration) error {
+	// Classic block storages have no status name, their status code goes back to CREAT when detached
+	if !config.SupportVPC {
+		return waitForBlockStorageDetachment(ctx, config, no, timeout)
+	}
+
+	stateConf := &waiter.Config[any]{
 		Pending: []string{BlockStorageStatusNameAttach},
 		Target:  []string{BlockStorageStatusNameDetach},
-		Refresh: func() (interface{}, string, error) {
-			resp, err := GetBlockStorage(config, no)
+		Refresh: func(ctx context.Context) (any, string, error) {
+			resp, err := GetBlockStorage(ctx, config, no)
 			if err != nil {
 				return 0, "", err
 			}
@@ -1366,24 +1781,24 @@ func waitForDisconnectBlockStorage(config *conn.ProviderConfig, no string) error
 
 			return 0, "", fmt.Errorf("error occurred while waiting to detached")
 		},
-		Timeout:    6 * conn.DefaultTimeout,
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Timeout:     timeout,
+		Delay:       2 * time.Second,
+		MinInterval: 3 * time.Second,
 	}
 
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForState(ctx); err != nil {
 		return fmt.Errorf("Error waiting for BlockStorage (%s) to become available: %s", no, err)
 	}
 
 	return nil
 }
 
-func waitForAttachedBlockStorage(config *conn.ProviderConfig, no string) error {
-	stateConf := &retry.StateChangeConf{
+func waitForAttachedBlockStorage(ctx context.Context, config *conn.ProviderConfig, no string) error {
+	stateConf := &waiter.Config[any]{
 		Pending: []string{BlockStorageStatusNameInit, BlockStorageStatusNameOptimizing},
 		Target:  []string{BlockStorageStatusNameAttach},
-		Refresh: func() (interface{}, string, error) {
-			resp, err := GetBlockStorage(config, no)
+		Refresh: func(ctx context.Context) (any, string, error) {
+			resp, err := GetBlockStorage(ctx, config, no)
 			if err != nil {
 				return 0, "", err
 			}
@@ -1402,20 +1817,20 @@ func waitForAttachedBlockStorage(config *conn.ProviderConfig, no string) error {
 
 			return 0, "", fmt.Errorf("error occurred while waiting to attached")
 		},
-		Timeout:    6 * conn.DefaultTimeout,
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Timeout:     6 * conn.DefaultTimeout,
+		Delay:       2 * time.Second,
+		MinInterval: 3 * time.Second,
 	}
 
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForState(ctx); err != nil {
 		return fmt.Errorf("Error waiting for BlockStorage (%s) to become available: %s", no, err)
 	}
 
 	return nil
 }
 
-func getServerZoneNo(config *conn.ProviderConfig, serverInstanceNo string) (string, error) {
-	instance, err := GetServerInstance(config, serverInstanceNo)
+func getServerZoneNo(ctx context.Context, config *conn.ProviderConfig, serverInstanceNo string) (string, error) {
+	instance, err := GetServerInstance(ctx, config, serverInstanceNo)
 	if err != nil || instance == nil || instance.ZoneNo == nil {
 		return "", err
 	}
@@ -1467,8 +1882,9 @@ type ServerInstance struct {
 
 // ServerInstanceNetworkInterface network interface model in server instance
 type ServerInstanceNetworkInterface struct {
-	Order              *int32  `json:"order,omitempty"`
-	NetworkInterfaceNo *string `json:"network_interface_no,omitempty"`
-	PrivateIp          *string `json:"private_ip,omitempty"`
-	SubnetNo           *string `json:"subnet_no,omitempty"`
+	Order               *int32    `json:"order,omitempty"`
+	NetworkInterfaceNo  *string   `json:"network_interface_no,omitempty"`
+	PrivateIp           *string   `json:"private_ip,omitempty"`
+	SubnetNo            *string   `json:"subnet_no,omitempty"`
+	AccessControlGroups []*string `json:"access_control_groups,omitempty"`
 }
@@ -1,15 +1,18 @@
 package server
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/paging"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/verify"
 )
 
@@ -23,19 +26,19 @@ func DataSourceNcloudServer() *schema.Resource {
 		"filter": DataSourceFiltersSchema(),
 	}
 
-	return GetSingularDataSourceItemSchema(ResourceNcloudServer(), fieldMap, dataSourceNcloudServerRead)
+	return GetSingularDataSourceItemSchemaContext(ResourceNcloudServer(), fieldMap, dataSourceNcloudServerRead)
 }
 
-func dataSourceNcloudServerRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceNcloudServerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*conn.ProviderConfig)
 
-	instances, err := getServerList(d, config)
+	instances, err := getServerList(ctx, d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if len(instances) < 1 {
-		return fmt.Errorf("no results. please change search criteria and try again")
+		return diag.FromErr(fmt.Errorf("no results. please change search criteria and try again"))
 	}
 
 	resources := ConvertToArrayMap(instances)
@@ -44,7 +47,7 @@ func dataSourceNcloudServerRead(d *schema.ResourceData, meta interface{}) error
 	}
 
 	if err := verify.ValidateOneResult(len(resources)); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(resources[0]["instance_no"].(string))
@@ -52,16 +55,16 @@ func dataSourceNcloudServerRead(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
-func getServerList(d *schema.ResourceData, config *conn.ProviderConfig) ([]*ServerInstance, error) {
+func getServerList(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) ([]*ServerInstance, error) {
 	if config.SupportVPC {
-		return getVpcServerList(d, config)
+		return getVpcServerList(ctx, d, config)
 	} else {
-		return getClassicServerList(d, config)
+		return getClassicServerList(ctx, d, config)
 	}
 }
 
-func getClassicServerList(d *schema.ResourceData, config *conn.ProviderConfig) ([]*ServerInstance, error) {
-	regionNo, err := conn.ParseRegionNoParameter(d)
+func getClassicServerList(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) ([]*ServerInstance, error) {
+	regionNo, err := conn.ParseRegionNoParameter(config, d)
 	if err != nil {
 		return nil, err
 	}
@@ -74,25 +77,33 @@ func getClassicServerList(d *schema.ResourceData, config *conn.ProviderConfig) (
 		reqParams.ServerInstanceNoList = []*string{ncloud.String(v.(string))}
 	}
 
-	LogCommonRequest("getClassicServerList", reqParams)
-	resp, err := config.Client.Server.V2Api.GetServerInstanceList(reqParams)
+	serverInstanceList, err := paging.All(ctx, func(ctx context.Context, pageNo, pageSize int32) ([]*server.ServerInstance, *int32, error) {
+		reqParams.PageNo = ncloud.Int32(pageNo)
+		reqParams.PageSize = ncloud.Int32(pageSize)
 
+		ctx = LogCommonRequest(ctx, "getClassicServerList", reqParams)
+		resp, err := config.Client.Server.V2Api.GetServerInstanceList(reqParams)
+		if err != nil {
+			LogErrorResponse(ctx, "getClassicServerList", err, reqParams)
+			return nil, nil, err
+		}
+		LogResponse(ctx, "getClassicServerList", resp)
+
+		return resp.ServerInstanceList, resp.TotalRows, nil
+	})
 	if err != nil {
-		LogErrorResponse("getClassicServerList", err, reqParams)
 		return nil, err
 	}
 
-	LogResponse("getClassicServerList", resp)
-
 	var list []*ServerInstance
-	for _, r := range resp.ServerInstanceList {
+	for _, r := range serverInstanceList {
 		list = append(list, convertClassicServerInstance(r))
 	}
 
 	return list, nil
 }
 
-func getVpcServerList(d *schema.ResourceData, config *conn.ProviderConfig) ([]*ServerInstance, error) {
+func getVpcServerList(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) ([]*ServerInstance, error) {
 	client := config.Client
 
 	reqParams := &vserver.GetServerInstanceListRequest{
@@ -103,17 +114,26 @@ func getVpcServerList(d *schema.ResourceData, config *conn.ProviderConfig) ([]*S
 		reqParams.ServerInstanceNoList = []*string{ncloud.String(v.(string))}
 	}
 
-	LogCommonRequest("getVpcServerList", reqParams)
+	serverInstanceList, err := paging.All(ctx, func(ctx context.Context, pageNo, pageSize int32) ([]*vserver.ServerInstance, *int32, error) {
+		reqParams.PageNo = ncloud.Int32(pageNo)
+		reqParams.PageSize = ncloud.Int32(pageSize)
+
+		ctx = LogCommonRequest(ctx, "getVpcServerList", reqParams)
+		resp, err := client.Vserver.V2Api.GetServerInstanceList(reqParams)
+		if err != nil {
+			LogErrorResponse(ctx, "getVpcServerList", err, reqParams)
+			return nil, nil, err
+		}
+		LogResponse(ctx, "getVpcServerList", resp)
 
-	resp, err := client.Vserver.V2Api.GetServerInstanceList(reqParams)
+		return resp.ServerInstanceList, resp.TotalRows, nil
+	})
 	if err != nil {
-		LogErrorResponse("getVpcServerList", err, reqParams)
 		return nil, err
 	}
-	LogResponse("getVpcServerList", resp)
 
 	var list []*ServerInstance
-	for _, r := range resp.ServerInstanceList {
+	for _, r := range serverInstanceList {
 		list = append(list, convertVcpServerInstance(r))
 	}
 
@@ -13,7 +13,7 @@ import (
 func TestAccDataSourceNcloudServer_vpc_basic(t *testing.T) {
 	dataName := "data.ncloud_server.by_id"
 	resourceName := "ncloud_server.server"
-	testServerName := GetTestServerName()
+	testServerName := GetTestServerName(t)
 	specCode := "s2-g3"
 
 	resource.Test(t, resource.TestCase{
@@ -58,7 +58,7 @@ func TestAccDataSourceNcloudServer_classic_basic(t *testing.T) {
 
 	dataName := "data.ncloud_server.by_id"
 	resourceName := "ncloud_server.server"
-	testServerName := GetTestServerName()
+	testServerName := GetTestServerName(t)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { TestAccPreCheck(t) },
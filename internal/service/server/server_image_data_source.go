@@ -1,11 +1,13 @@
 package server
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -16,7 +18,7 @@ import (
 
 func DataSourceNcloudServerImage() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNcloudServerImageRead,
+		ReadContext: dataSourceNcloudServerImageRead,
 
 		Schema: map[string]*schema.Schema{
 			"product_code": {
@@ -82,15 +84,15 @@ func DataSourceNcloudServerImage() *schema.Resource {
 	}
 }
 
-func dataSourceNcloudServerImageRead(d *schema.ResourceData, meta interface{}) error {
-	resources, err := getServerImageProductListFiltered(d, meta.(*conn.ProviderConfig))
+func dataSourceNcloudServerImageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	resources, err := getServerImageProductListFiltered(ctx, d, meta.(*conn.ProviderConfig))
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := verify.ValidateOneResult(len(resources)); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	SetSingularResourceDataFromMap(d, resources[0])
@@ -98,14 +100,14 @@ func dataSourceNcloudServerImageRead(d *schema.ResourceData, meta interface{}) e
 	return nil
 }
 
-func getServerImageProductListFiltered(d *schema.ResourceData, config *conn.ProviderConfig) ([]map[string]interface{}, error) {
+func getServerImageProductListFiltered(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) ([]map[string]interface{}, error) {
 	var resources []map[string]interface{}
 	var err error
 
 	if config.SupportVPC {
-		resources, err = getVpcServerImageProductList(d, config)
+		resources, err = getVpcServerImageProductList(ctx, d, config)
 	} else {
-		resources, err = getClassicServerImageProductList(d, config)
+		resources, err = getClassicServerImageProductList(ctx, d, config)
 	}
 
 	if err != nil {
@@ -119,8 +121,7 @@ func getServerImageProductListFiltered(d *schema.ResourceData, config *conn.Prov
 	return resources, nil
 }
 
-func getClassicServerImageProductList(d *schema.ResourceData, config *conn.ProviderConfig) ([]map[string]interface{}, error) {
-	client := config.Client
+func getClassicServerImageProductList(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) ([]map[string]interface{}, error) {
 	regionNo := config.RegionNo
 
 	reqParams := &server.GetServerImageProductListRequest{
@@ -133,13 +134,25 @@ func getClassicServerImageProductList(d *schema.ResourceData, config *conn.Provi
 		reqParams.PlatformTypeCodeList = []*string{ncloud.String(v.(string))}
 	}
 
-	LogCommonRequest("GetServerImageProductList", reqParams)
-	resp, err := client.Server.V2Api.GetServerImageProductList(reqParams)
+	return getClassicServerImageProducts(ctx, config, reqParams)
+}
+
+func getClassicServerImageProducts(ctx context.Context, config *conn.ProviderConfig, reqParams *server.GetServerImageProductListRequest) ([]map[string]interface{}, error) {
+	client := config.Client
+
+	resp, err := conn.CachedLookup(config, conn.CatalogKey("server.GetServerImageProductList", reqParams), func() (*server.GetServerImageProductListResponse, error) {
+		ctx := LogCommonRequest(ctx, "GetServerImageProductList", reqParams)
+		resp, err := client.Server.V2Api.GetServerImageProductList(reqParams)
+		if err != nil {
+			LogErrorResponse(ctx, "GetServerImageProductList", err, reqParams)
+			return nil, err
+		}
+		LogResponse(ctx, "GetServerImageProductList", resp)
+		return resp, nil
+	})
 	if err != nil {
-		LogErrorResponse("GetServerImageProductList", err, reqParams)
 		return nil, err
 	}
-	LogResponse("GetServerImageProductList", resp)
 
 	var resources []map[string]interface{}
 
@@ -166,8 +179,7 @@ func getClassicServerImageProductList(d *schema.ResourceData, config *conn.Provi
 	return resources, nil
 }
 
-func getVpcServerImageProductList(d *schema.ResourceData, config *conn.ProviderConfig) ([]map[string]interface{}, error) {
-	client := config.Client
+func getVpcServerImageProductList(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) ([]map[string]interface{}, error) {
 	regionCode := config.RegionCode
 
 	reqParams := &vserver.GetServerImageProductListRequest{
@@ -180,13 +192,25 @@ func getVpcServerImageProductList(d *schema.ResourceData, config *conn.ProviderC
 		reqParams.PlatformTypeCodeList = []*string{ncloud.String(v.(string))}
 	}
 
-	LogCommonRequest("GetServerImageProductList", reqParams)
-	resp, err := client.Vserver.V2Api.GetServerImageProductList(reqParams)
+	return getVpcServerImageProducts(ctx, config, reqParams)
+}
+
+func getVpcServerImageProducts(ctx context.Context, config *conn.ProviderConfig, reqParams *vserver.GetServerImageProductListRequest) ([]map[string]interface{}, error) {
+	client := config.Client
+
+	resp, err := conn.CachedLookup(config, conn.CatalogKey("vserver.GetServerImageProductList", reqParams), func() (*vserver.GetServerImageProductListResponse, error) {
+		ctx := LogCommonRequest(ctx, "GetServerImageProductList", reqParams)
+		resp, err := client.Vserver.V2Api.GetServerImageProductList(reqParams)
+		if err != nil {
+			LogErrorResponse(ctx, "GetServerImageProductList", err, reqParams)
+			return nil, err
+		}
+		LogResponse(ctx, "GetServerImageProductList", resp)
+		return resp, nil
+	})
 	if err != nil {
-		LogErrorResponse("GetServerImageProductList", err, reqParams)
 		return nil, err
 	}
-	LogResponse("GetServerImageProductList", resp)
 
 	var resources []map[string]interface{}
 
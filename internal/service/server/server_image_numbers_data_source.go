@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -13,9 +14,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/paging"
 )
 
 var (
@@ -167,21 +168,30 @@ func (d *serverImageNumbersDataSource) Read(ctx context.Context, req datasource.
 		ServerImageName:    data.ServerImageName.ValueStringPointer(),
 		HypervisorCodeList: []*string{data.HypervisorType.ValueStringPointer()},
 	}
-	tflog.Info(ctx, "GetServerImageListRequest reqParams="+common.MarshalUncheckedString(reqParams))
+	serverImageList, err := paging.All(ctx, func(ctx context.Context, pageNo, pageSize int32) ([]*vserver.ServerImage, *int32, error) {
+		reqParams.PageNo = ncloud.Int32(pageNo)
+		reqParams.PageSize = ncloud.Int32(pageSize)
+
+		ctx = common.LogCommonRequest(ctx, "GetServerImageListRequest", reqParams)
+		imageNoResp, err := d.config.Client.Vserver.V2Api.GetServerImageList(reqParams)
+		if err != nil {
+			return nil, nil, err
+		}
+		common.LogResponse(ctx, "GetServerImageListRequest", imageNoResp)
 
-	imageNoResp, err := d.config.Client.Vserver.V2Api.GetServerImageList(reqParams)
+		return imageNoResp.ServerImageList, imageNoResp.TotalRows, nil
+	})
 	if err != nil {
 		resp.Diagnostics.AddError("READING ERROR", err.Error())
 		return
 	}
-	tflog.Info(ctx, "GetServerImageListRequest response="+common.MarshalUncheckedString(imageNoResp))
 
-	if imageNoResp == nil || len(imageNoResp.ServerImageList) < 1 {
+	if len(serverImageList) < 1 {
 		resp.Diagnostics.AddError("READING ERROR", "no result.")
 		return
 	}
 
-	imagesNoList, diags := flattenServerImageList(ctx, imageNoResp.ServerImageList)
+	imagesNoList, diags := flattenServerImageList(ctx, serverImageList)
 	if diags.HasError() {
 		resp.Diagnostics.AddError("READING ERROR", "flattenServerImageList error")
 		return
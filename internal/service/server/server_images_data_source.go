@@ -1,8 +1,10 @@
 package server
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -12,7 +14,7 @@ import (
 
 func DataSourceNcloudServerImages() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNcloudServerImagesRead,
+		ReadContext: dataSourceNcloudServerImagesRead,
 
 		Schema: map[string]*schema.Schema{
 			"product_code": {
@@ -74,18 +76,18 @@ func DataSourceNcloudServerImages() *schema.Resource {
 	}
 }
 
-func dataSourceNcloudServerImagesRead(d *schema.ResourceData, meta interface{}) error {
-	resources, err := getServerImageProductListFiltered(d, meta.(*conn.ProviderConfig))
+func dataSourceNcloudServerImagesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	resources, err := getServerImageProductListFiltered(ctx, d, meta.(*conn.ProviderConfig))
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if len(resources) < 1 {
-		return fmt.Errorf("no results. please change search criteria and try again")
+		return diag.FromErr(fmt.Errorf("no results. please change search criteria and try again"))
 	}
 
-	return serverImagesAttributes(d, resources)
+	return diag.FromErr(serverImagesAttributes(d, resources))
 }
 
 func serverImagesAttributes(d *schema.ResourceData, resources []map[string]interface{}) error {
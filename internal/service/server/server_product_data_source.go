@@ -1,11 +1,13 @@
 package server
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -17,7 +19,7 @@ import (
 
 func DataSourceNcloudServerProduct() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNcloudServerProductRead,
+		ReadContext: dataSourceNcloudServerProductRead,
 
 		Schema: map[string]*schema.Schema{
 			"server_image_product_code": {
@@ -95,15 +97,15 @@ func DataSourceNcloudServerProduct() *schema.Resource {
 	}
 }
 
-func dataSourceNcloudServerProductRead(d *schema.ResourceData, meta interface{}) error {
-	resources, err := getServerProductListFiltered(d, meta.(*conn.ProviderConfig))
+func dataSourceNcloudServerProductRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	resources, err := getServerProductListFiltered(ctx, d, meta.(*conn.ProviderConfig))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := verify.ValidateOneResult(len(resources)); err != nil {
 
-		return err
+		return diag.FromErr(err)
 	}
 
 	SetSingularResourceDataFromMap(d, resources[0])
@@ -111,14 +113,14 @@ func dataSourceNcloudServerProductRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func getServerProductListFiltered(d *schema.ResourceData, config *conn.ProviderConfig) ([]map[string]interface{}, error) {
+func getServerProductListFiltered(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) ([]map[string]interface{}, error) {
 	var resources []map[string]interface{}
 	var err error
 
 	if config.SupportVPC {
-		resources, err = getVpcServerProductList(d, config)
+		resources, err = getVpcServerProductList(ctx, d, config)
 	} else {
-		resources, err = getClassicServerProductList(d, config)
+		resources, err = getClassicServerProductList(ctx, d, config)
 	}
 
 	if err != nil {
@@ -132,8 +134,7 @@ func getServerProductListFiltered(d *schema.ResourceData, config *conn.ProviderC
 	return resources, nil
 }
 
-func getClassicServerProductList(d *schema.ResourceData, config *conn.ProviderConfig) ([]map[string]interface{}, error) {
-	client := config.Client
+func getClassicServerProductList(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) ([]map[string]interface{}, error) {
 	regionNo := config.RegionNo
 
 	zoneNo, err := zone.ParseZoneNoParameter(config, d)
@@ -148,13 +149,25 @@ func getClassicServerProductList(d *schema.ResourceData, config *conn.ProviderCo
 		ZoneNo:                 zoneNo,
 	}
 
-	LogCommonRequest("getClassicServerProductList", reqParams)
-	resp, err := client.Server.V2Api.GetServerProductList(reqParams)
+	return getClassicServerProducts(ctx, config, reqParams)
+}
+
+func getClassicServerProducts(ctx context.Context, config *conn.ProviderConfig, reqParams *server.GetServerProductListRequest) ([]map[string]interface{}, error) {
+	client := config.Client
+
+	resp, err := conn.CachedLookup(config, conn.CatalogKey("server.GetServerProductList", reqParams), func() (*server.GetServerProductListResponse, error) {
+		ctx := LogCommonRequest(ctx, "getClassicServerProductList", reqParams)
+		resp, err := client.Server.V2Api.GetServerProductList(reqParams)
+		if err != nil {
+			LogErrorResponse(ctx, "getClassicServerProductList", err, reqParams)
+			return nil, err
+		}
+		LogResponse(ctx, "getClassicServerProductList", resp)
+		return resp, nil
+	})
 	if err != nil {
-		LogErrorResponse("getClassicServerProductList", err, reqParams)
 		return nil, err
 	}
-	LogResponse("getClassicServerProductList", resp)
 
 	var resources []map[string]interface{}
 
@@ -179,8 +192,7 @@ func getClassicServerProductList(d *schema.ResourceData, config *conn.ProviderCo
 	return resources, nil
 }
 
-func getVpcServerProductList(d *schema.ResourceData, config *conn.ProviderConfig) ([]map[string]interface{}, error) {
-	client := config.Client
+func getVpcServerProductList(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) ([]map[string]interface{}, error) {
 	regionCode := config.RegionCode
 
 	reqParams := &vserver.GetServerProductListRequest{
@@ -191,13 +203,25 @@ func getVpcServerProductList(d *schema.ResourceData, config *conn.ProviderConfig
 		ZoneCode:               StringPtrOrNil(d.GetOk("zone")),
 	}
 
-	LogCommonRequest("getVpcServerProductList", reqParams)
-	resp, err := client.Vserver.V2Api.GetServerProductList(reqParams)
+	return getVpcServerProducts(ctx, config, reqParams)
+}
+
+func getVpcServerProducts(ctx context.Context, config *conn.ProviderConfig, reqParams *vserver.GetServerProductListRequest) ([]map[string]interface{}, error) {
+	client := config.Client
+
+	resp, err := conn.CachedLookup(config, conn.CatalogKey("vserver.GetServerProductList", reqParams), func() (*vserver.GetServerProductListResponse, error) {
+		ctx := LogCommonRequest(ctx, "getVpcServerProductList", reqParams)
+		resp, err := client.Vserver.V2Api.GetServerProductList(reqParams)
+		if err != nil {
+			LogErrorResponse(ctx, "getVpcServerProductList", err, reqParams)
+			return nil, err
+		}
+		LogResponse(ctx, "getVpcServerProductList", resp)
+		return resp, nil
+	})
 	if err != nil {
-		LogErrorResponse("getVpcServerProductList", err, reqParams)
 		return nil, err
 	}
-	LogResponse("getVpcServerProductList", resp)
 
 	var resources []map[string]interface{}
 
@@ -1,8 +1,10 @@
 package server
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -12,7 +14,7 @@ import (
 
 func DataSourceNcloudServerProducts() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNcloudServerProductsRead,
+		ReadContext: dataSourceNcloudServerProductsRead,
 
 		Schema: map[string]*schema.Schema{
 			"product_code": {
@@ -67,18 +69,18 @@ func DataSourceNcloudServerProducts() *schema.Resource {
 	}
 }
 
-func dataSourceNcloudServerProductsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceNcloudServerProductsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var resources []map[string]interface{}
 	var err error
 
 	if meta.(*conn.ProviderConfig).SupportVPC {
-		resources, err = getVpcServerProductList(d, meta.(*conn.ProviderConfig))
+		resources, err = getVpcServerProductList(ctx, d, meta.(*conn.ProviderConfig))
 	} else {
-		resources, err = getClassicServerProductList(d, meta.(*conn.ProviderConfig))
+		resources, err = getClassicServerProductList(ctx, d, meta.(*conn.ProviderConfig))
 	}
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if f, ok := d.GetOk("filter"); ok {
@@ -86,10 +88,10 @@ func dataSourceNcloudServerProductsRead(d *schema.ResourceData, meta interface{}
 	}
 
 	if len(resources) < 1 {
-		return fmt.Errorf("no results. please change search criteria and try again")
+		return diag.FromErr(fmt.Errorf("no results. please change search criteria and try again"))
 	}
 
-	return serverProductsAttributes(d, resources)
+	return diag.FromErr(serverProductsAttributes(d, resources))
 }
 
 func serverProductsAttributes(d *schema.ResourceData, serverProduct []map[string]interface{}) error {
@@ -11,7 +11,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
 )
@@ -135,14 +134,20 @@ func (d *serverSpecsDataSource) Read(ctx context.Context, req datasource.ReadReq
 	reqParams := &vserver.GetServerSpecListRequest{
 		RegionCode: &d.config.RegionCode,
 	}
-	tflog.Info(ctx, "GetServerSpecListRequest reqParams="+common.MarshalUncheckedString(reqParams))
-
-	specResp, err := d.config.Client.Vserver.V2Api.GetServerSpecList(reqParams)
+	specResp, err := conn.CachedLookup(d.config, conn.CatalogKey("vserver.GetServerSpecList", reqParams), func() (*vserver.GetServerSpecListResponse, error) {
+		ctx := common.LogCommonRequest(ctx, "GetServerSpecListRequest", reqParams)
+		output, err := d.config.Client.Vserver.V2Api.GetServerSpecList(reqParams)
+		if err != nil {
+			common.LogErrorResponse(ctx, "GetServerSpecListRequest", err, reqParams)
+			return nil, err
+		}
+		common.LogResponse(ctx, "GetServerSpecListRequest", output)
+		return output, nil
+	})
 	if err != nil {
 		resp.Diagnostics.AddError("READING ERROR", err.Error())
 		return
 	}
-	tflog.Info(ctx, "GetServerSpecListRequest response="+common.MarshalUncheckedString(specResp))
 
 	if specResp == nil || len(specResp.ServerSpecList) < 1 {
 		resp.Diagnostics.AddError("READING ERROR", "no result.")
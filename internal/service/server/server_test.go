@@ -1,6 +1,7 @@
 package server_test
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"testing"
@@ -21,7 +22,7 @@ func TestAccResourceNcloudServer_classic_basic(t *testing.T) {
 	t.Skip()
 
 	var serverInstance serverservice.ServerInstance
-	testServerName := GetTestServerName()
+	testServerName := GetTestServerName(t)
 	resourceName := "ncloud_server.server"
 	productCode := "SPSVRSTAND000004" // vCPU 2EA, Memory 4GB, Disk 50GB
 
@@ -79,7 +80,7 @@ func TestAccResourceNcloudServer_classic_basic(t *testing.T) {
 
 func TestAccResourceNcloudServer_vpc_basic(t *testing.T) {
 	var serverInstance serverservice.ServerInstance
-	testServerName := GetTestServerName()
+	testServerName := GetTestServerName(t)
 	resourceName := "ncloud_server.server"
 	productCode := "SVR.VSVR.STAND.C002.M008.NET.HDD.B050.G002"
 
@@ -127,7 +128,7 @@ func TestAccResourceNcloudServer_vpc_basic(t *testing.T) {
 
 func TestAccResourceNcloudServer_vpc_kvm(t *testing.T) {
 	var serverInstance serverservice.ServerInstance
-	testServerName := GetTestServerName()
+	testServerName := GetTestServerName(t)
 	resourceName := "ncloud_server.server"
 	specCode := "s2-g3"
 
@@ -168,7 +169,7 @@ func TestAccResourceNcloudServer_vpc_kvm(t *testing.T) {
 
 func TestAccResourceNcloudServer_vpc_networkInterface(t *testing.T) {
 	var serverInstance serverservice.ServerInstance
-	testServerName := GetTestServerName()
+	testServerName := GetTestServerName(t)
 	resourceName := "ncloud_server.server"
 	productCode := "SVR.VSVR.STAND.C002.M008.NET.HDD.B050.G002"
 
@@ -220,7 +221,7 @@ func TestAccResourceNcloudServer_classic_changeSpec(t *testing.T) {
 
 	var before serverservice.ServerInstance
 	var after serverservice.ServerInstance
-	testServerName := GetTestServerName()
+	testServerName := GetTestServerName(t)
 	resourceName := "ncloud_server.server"
 	productCode := "SPSVRSTAND000004"       // vCPU 2EA, Memory 4GB, Disk 50GB
 	targetProductCode := "SPSVRSTAND000005" // vCPU 4EA, Memory 8GB, Disk 50GB
@@ -259,7 +260,7 @@ func TestAccResourceNcloudServer_classic_changeSpec(t *testing.T) {
 func TestAccResourceNcloudServer_vpc_changeSpec(t *testing.T) {
 	var before serverservice.ServerInstance
 	var after serverservice.ServerInstance
-	testServerName := GetTestServerName()
+	testServerName := GetTestServerName(t)
 	resourceName := "ncloud_server.server"
 	productCode := "SVR.VSVR.STAND.C002.M008.NET.HDD.B050.G002"       // vCPU 2EA, Memory 8GB, Disk 50GB
 	targetProductCode := "SVR.VSVR.STAND.C004.M016.NET.HDD.B050.G002" // vCPU 4EA, Memory 16GB, Disk 50GB
@@ -293,6 +294,81 @@ func TestAccResourceNcloudServer_vpc_changeSpec(t *testing.T) {
 	})
 }
 
+func TestAccResourceNcloudServer_vpc_desiredStatus(t *testing.T) {
+	var before serverservice.ServerInstance
+	var after serverservice.ServerInstance
+	testServerName := GetTestServerName(t)
+	resourceName := "ncloud_server.server"
+	productCode := "SVR.VSVR.STAND.C002.M008.NET.HDD.B050.G002"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckServerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServerVpcConfigDesiredStatus(testServerName, productCode, "stopped"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServerExistsWithProvider(resourceName, &before, GetTestProvider(true)),
+					resource.TestCheckResourceAttr(resourceName, "desired_status", "stopped"),
+				),
+			},
+			{
+				Config: testAccServerVpcConfigDesiredStatus(testServerName, productCode, "running"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServerExistsWithProvider(resourceName, &after, GetTestProvider(true)),
+					resource.TestCheckResourceAttr(resourceName, "desired_status", "running"),
+					testAccCheckInstanceNotRecreated(t, &before, &after),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccResourceNcloudServer_vpc_inPlaceUpdate(t *testing.T) {
+	var before serverservice.ServerInstance
+	var after serverservice.ServerInstance
+	testServerName := GetTestServerName(t)
+	resourceName := "ncloud_server.server"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckServerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServerVpcConfigInPlaceUpdate(testServerName, "before", "", "ncloud_vpc.test.default_access_control_group_no"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServerExistsWithProvider(resourceName, &before, GetTestProvider(true)),
+					resource.TestCheckResourceAttr(resourceName, "description", "before"),
+					resource.TestCheckResourceAttr(resourceName, "placement_group_no", ""),
+					resource.TestCheckResourceAttr(resourceName, "network_interface.0.access_control_groups.#", "1"),
+				),
+			},
+			{
+				Config: testAccServerVpcConfigInPlaceUpdate(testServerName, "before", "ncloud_placement_group.test.id", "ncloud_vpc.test.default_access_control_group_no, ncloud_access_control_group.test.id"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServerExistsWithProvider(resourceName, &after, GetTestProvider(true)),
+					testAccCheckInstanceNotRecreated(t, &before, &after),
+					resource.TestCheckResourceAttrPair(resourceName, "placement_group_no", "ncloud_placement_group.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "network_interface.0.access_control_groups.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "desired_status", "running"),
+				),
+			},
+			{
+				Config:      testAccServerVpcConfigInPlaceUpdate(testServerName, "after", "ncloud_placement_group.test.id", "ncloud_vpc.test.default_access_control_group_no, ncloud_access_control_group.test.id"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("`description` of ncloud_server \\d+ can't be changed"),
+			},
+		},
+	})
+}
+
 func TestConvertToMap(t *testing.T) {
 	i := &serverservice.ServerInstance{
 		ZoneNo:                     ncloud.String("KR-1"),
@@ -361,7 +437,7 @@ func testAccCheckServerExistsWithProvider(n string, i *serverservice.ServerInsta
 		}
 
 		config := provider.Meta().(*conn.ProviderConfig)
-		instance, err := serverservice.GetServerInstance(config, rs.Primary.ID)
+		instance, err := serverservice.GetServerInstance(context.Background(), config, rs.Primary.ID)
 		if err != nil {
 			return nil
 		}
@@ -395,7 +471,7 @@ func testAccCheckInstanceDestroyWithProvider(s *terraform.State, provider *schem
 		if rs.Type != "ncloud_server" {
 			continue
 		}
-		instance, err := serverservice.GetServerInstance(config, rs.Primary.ID)
+		instance, err := serverservice.GetServerInstance(context.Background(), config, rs.Primary.ID)
 
 		if err != nil {
 			return err
@@ -478,6 +554,38 @@ resource "ncloud_server" "server" {
 `, testServerName, productCode)
 }
 
+func testAccServerVpcConfigDesiredStatus(testServerName, productCode, desiredStatus string) string {
+	return fmt.Sprintf(`
+resource "ncloud_login_key" "loginkey" {
+	key_name = "%[1]s-key"
+}
+
+resource "ncloud_vpc" "test" {
+	name               = "%[1]s"
+	ipv4_cidr_block    = "10.5.0.0/16"
+}
+
+resource "ncloud_subnet" "test" {
+	vpc_no             = ncloud_vpc.test.vpc_no
+	name               = "%[1]s"
+	subnet             = "10.5.0.0/24"
+	zone               = "KR-2"
+	network_acl_no     = ncloud_vpc.test.default_network_acl_no
+	subnet_type        = "PUBLIC"
+	usage_type         = "GEN"
+}
+
+resource "ncloud_server" "server" {
+	subnet_no = ncloud_subnet.test.id
+	name = "%[1]s"
+	server_image_product_code = "SW.VSVR.OS.LNX64.ROCKY.0810.B050"
+	server_product_code = "%[2]s"
+	login_key_name = ncloud_login_key.loginkey.key_name
+	desired_status = "%[3]s"
+}
+`, testServerName, productCode, desiredStatus)
+}
+
 func testAccServerVpcConfigNetworkInterface(testServerName, productCode string) string {
 	return fmt.Sprintf(`
 resource "ncloud_login_key" "loginkey" {
@@ -540,6 +648,69 @@ resource "ncloud_server" "server" {
 `, testServerName, productCode)
 }
 
+func testAccServerVpcConfigInPlaceUpdate(testServerName, description, placementGroupNo, accessControlGroups string) string {
+	if placementGroupNo == "" {
+		placementGroupNo = "null"
+	}
+
+	return fmt.Sprintf(`
+resource "ncloud_login_key" "loginkey" {
+	key_name = "%[1]s-key"
+}
+
+resource "ncloud_vpc" "test" {
+	name               = "%[1]s"
+	ipv4_cidr_block    = "10.5.0.0/16"
+}
+
+resource "ncloud_subnet" "test" {
+	vpc_no             = ncloud_vpc.test.vpc_no
+	name               = "%[1]s"
+	subnet             = "10.5.0.0/24"
+	zone               = "KR-2"
+	network_acl_no     = ncloud_vpc.test.default_network_acl_no
+	subnet_type        = "PUBLIC"
+	usage_type         = "GEN"
+}
+
+resource "ncloud_access_control_group" "test" {
+	name               = "%[1]s"
+	vpc_no             = ncloud_vpc.test.id
+}
+
+resource "ncloud_placement_group" "test" {
+	name               = "%[1]s"
+}
+
+resource "ncloud_network_interface" "eth0" {
+	name                  = "%[1]s-eth-0"
+	subnet_no             = ncloud_subnet.test.id
+	access_control_groups = [ncloud_vpc.test.default_access_control_group_no]
+
+	# the ACGs are managed by the server
+	lifecycle {
+		ignore_changes = [access_control_groups]
+	}
+}
+
+resource "ncloud_server" "server" {
+	subnet_no = ncloud_subnet.test.id
+	name = "%[1]s"
+	description = "%[2]s"
+	server_image_product_code = "SW.VSVR.OS.LNX64.ROCKY.0810.B050"
+	server_product_code = "SVR.VSVR.STAND.C002.M008.NET.HDD.B050.G002"
+	login_key_name = ncloud_login_key.loginkey.key_name
+	placement_group_no = %[3]s
+
+	network_interface {
+		order = 0
+		network_interface_no = ncloud_network_interface.eth0.id
+		access_control_groups = [%[4]s]
+	}
+}
+`, testServerName, description, placementGroupNo, accessControlGroups)
+}
+
 func testAccServerClassicConfig(testServerName, productCode string) string {
 	return fmt.Sprintf(`
 resource "ncloud_login_key" "loginkey" {
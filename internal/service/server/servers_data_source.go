@@ -1,8 +1,10 @@
 package server
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/common"
@@ -11,7 +13,7 @@ import (
 
 func DataSourceNcloudServers() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNcloudServersRead,
+		ReadContext: dataSourceNcloudServersRead,
 		Schema: map[string]*schema.Schema{
 			"ids": {
 				Type:     schema.TypeSet,
@@ -19,34 +21,37 @@ func DataSourceNcloudServers() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
-			"filter": DataSourceFiltersSchema(),
+			"filter":      DataSourceFiltersSchema(),
+			"sort_by":     DataSourceSortBySchema(),
+			"most_recent": DataSourceMostRecentSchema(),
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
 		},
 	}
 }
 
-func dataSourceNcloudServersRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceNcloudServersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*conn.ProviderConfig)
 
-	instances, err := getServerList(d, config)
+	instances, err := getServerList(ctx, d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if len(instances) < 1 {
-		return fmt.Errorf("no results. there is no available server resource")
+		return diag.FromErr(fmt.Errorf("no results. there is no available server resource"))
 	}
 
 	if values, ok := d.GetOk("ids"); ok {
-		return readServersIDs(d, values.(*schema.Set).List(), instances)
+		return diag.FromErr(readServersIDs(d, values.(*schema.Set).List(), instances))
 	}
 
-	resources := ConvertToArrayMap(instances)
-	if f, ok := d.GetOk("filter"); ok {
-		resources = ApplyFilters(f.(*schema.Set), resources, DataSourceNcloudServer().Schema)
-	}
+	resources := ApplyDataSourceQuery(d, ConvertToArrayMap(instances), DataSourceNcloudServer().Schema)
 
 	if len(resources) == 0 {
-		return fmt.Errorf("no results with filter. there is no available server resource")
+		return diag.FromErr(fmt.Errorf("no results with filter. there is no available server resource"))
 	}
 
 	var ids []string
@@ -60,6 +65,10 @@ func dataSourceNcloudServersRead(d *schema.ResourceData, meta interface{}) error
 
 	d.SetId(DataResourceIdHash(ids))
 	d.Set("ids", ids)
+
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		return diag.FromErr(WriteToFile(output.(string), resources))
+	}
 	return nil
 }
 
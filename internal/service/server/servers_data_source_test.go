@@ -17,8 +17,8 @@ const (
 )
 
 func TestAccDataSourceNcloudServers_vpc_basic(t *testing.T) {
-	testServerName := GetTestServerName()
-	testServerName2 := GetTestServerName()
+	testServerName := GetTestServerName(t)
+	testServerName2 := GetTestServerName(t)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { TestAccPreCheck(t) },
@@ -43,8 +43,8 @@ func TestAccDataSourceNcloudServers_classic_basic(t *testing.T) {
 	// Images are all deprecated in Classic
 	t.Skip()
 
-	testServerName := GetTestServerName()
-	testServerName2 := GetTestServerName()
+	testServerName := GetTestServerName(t)
+	testServerName2 := GetTestServerName(t)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { TestAccPreCheck(t) },
@@ -0,0 +1,388 @@
+package server
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/paging"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/sweep"
+)
+
+func RegisterSweepers() {
+	sweep.AddTestSweepers("ncloud_server", &resource.Sweeper{
+		Name: "ncloud_server",
+		F:    sweepServers,
+		Dependencies: []string{
+			"ncloud_public_ip",
+			"ncloud_block_storage",
+		},
+	})
+
+	sweep.AddTestSweepers("ncloud_block_storage", &resource.Sweeper{
+		Name: "ncloud_block_storage",
+		F:    sweepBlockStorages,
+		Dependencies: []string{
+			"ncloud_block_storage_snapshot",
+		},
+	})
+
+	sweep.AddTestSweepers("ncloud_block_storage_snapshot", &resource.Sweeper{
+		Name: "ncloud_block_storage_snapshot",
+		F:    sweepBlockStorageSnapshots,
+	})
+
+	sweep.AddTestSweepers("ncloud_member_server_image", &resource.Sweeper{
+		Name: "ncloud_member_server_image",
+		F:    sweepMemberServerImages,
+	})
+
+	sweep.AddTestSweepers("ncloud_public_ip", &resource.Sweeper{
+		Name: "ncloud_public_ip",
+		F:    sweepPublicIps,
+	})
+
+	sweep.AddTestSweepers("ncloud_network_interface", &resource.Sweeper{
+		Name: "ncloud_network_interface",
+		F:    sweepNetworkInterfaces,
+		Dependencies: []string{
+			"ncloud_server",
+		},
+	})
+
+	sweep.AddTestSweepers("ncloud_access_control_group", &resource.Sweeper{
+		Name: "ncloud_access_control_group",
+		F:    sweepAccessControlGroups,
+		Dependencies: []string{
+			"ncloud_server",
+			"ncloud_network_interface",
+			"ncloud_lb",
+			"ncloud_auto_scaling_group",
+			"ncloud_nks_cluster",
+		},
+	})
+
+	sweep.AddTestSweepers("ncloud_placement_group", &resource.Sweeper{
+		Name: "ncloud_placement_group",
+		F:    sweepPlacementGroups,
+		Dependencies: []string{
+			"ncloud_server",
+		},
+	})
+
+	sweep.AddTestSweepers("ncloud_login_key", &resource.Sweeper{
+		Name: "ncloud_login_key",
+		F:    sweepLoginKeys,
+		Dependencies: []string{
+			"ncloud_server",
+			"ncloud_launch_configuration",
+			"ncloud_nks_cluster",
+		},
+	})
+
+	sweep.AddTestSweepers("ncloud_init_script", &resource.Sweeper{
+		Name: "ncloud_init_script",
+		F:    sweepInitScripts,
+		Dependencies: []string{
+			"ncloud_server",
+			"ncloud_launch_configuration",
+		},
+	})
+
+	sweep.AddChildTestSweepers("ncloud_access_control_group",
+		"ncloud_access_control_group_rule",
+	)
+
+	sweep.AddChildTestSweepers("ncloud_server",
+		"ncloud_port_forwarding_rule",
+		"ncloud_block_storage_attachment",
+	)
+}
+
+func sweepServers(region string) error {
+	ctx := context.Background()
+	config, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+
+	servers, err := getServerList(ctx, DataSourceNcloudServer().Data(nil), config)
+	if err != nil {
+		return fmt.Errorf("listing servers: %w", err)
+	}
+
+	var sweepables []sweep.Sweepable
+	for _, s := range servers {
+		if !sweep.HasResourcePrefix(s.ServerName) {
+			continue
+		}
+		sweepables = append(sweepables, sweep.NewSweepResource(ResourceNcloudServer(), config, *s.ServerInstanceNo, nil))
+	}
+
+	return sweep.SweepOrchestrator(ctx, sweepables)
+}
+
+func sweepBlockStorages(region string) error {
+	ctx := context.Background()
+	config, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+
+	blockStorages, err := getBlockStorageList(ctx, DataSourceNcloudBlockStorage().Data(nil), config)
+	if err != nil {
+		return fmt.Errorf("listing block storages: %w", err)
+	}
+
+	var sweepables []sweep.Sweepable
+	for _, b := range blockStorages {
+		// base block storages are deleted with their server
+		if ncloud.StringValue(b.BlockStorageType) == "BASIC" || !sweep.HasResourcePrefix(b.BlockStorageName) {
+			continue
+		}
+		sweepables = append(sweepables, sweep.NewSweepResource(ResourceNcloudBlockStorage(), config, *b.BlockStorageInstanceNo, map[string]interface{}{
+			"server_instance_no": ncloud.StringValue(b.ServerInstanceNo),
+		}))
+	}
+
+	return sweep.SweepOrchestrator(ctx, sweepables)
+}
+
+func sweepBlockStorageSnapshots(region string) error {
+	config, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+	if !config.SupportVPC {
+		return nil
+	}
+
+	blockStorageSnapshotInstanceList, err := paging.All(context.Background(), func(ctx context.Context, pageNo, pageSize int32) ([]*vserver.BlockStorageSnapshotInstance, *int32, error) {
+		resp, err := config.Client.Vserver.V2Api.GetBlockStorageSnapshotInstanceList(&vserver.GetBlockStorageSnapshotInstanceListRequest{RegionCode: &config.RegionCode, PageNo: ncloud.Int32(pageNo), PageSize: ncloud.Int32(pageSize)})
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.BlockStorageSnapshotInstanceList, resp.TotalRows, nil
+	})
+	if err != nil {
+		return fmt.Errorf("listing block storage snapshots: %w", err)
+	}
+
+	var sweepables []sweep.Sweepable
+	for _, s := range blockStorageSnapshotInstanceList {
+		if !sweep.HasResourcePrefix(s.BlockStorageSnapshotName) {
+			continue
+		}
+		sweepables = append(sweepables, sweep.NewSweepResource(ResourceNcloudBlockStorageSnapshot(), config, *s.BlockStorageSnapshotInstanceNo, nil))
+	}
+
+	return sweep.SweepOrchestrator(context.Background(), sweepables)
+}
+
+func sweepMemberServerImages(region string) error {
+	ctx := context.Background()
+	config, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+
+	var images []map[string]interface{}
+	if config.SupportVPC {
+		images, err = getVpcMemberServerImage(ctx, DataSourceNcloudMemberServerImages().Data(nil), config)
+	} else {
+		images, err = getClassicMemberServerImage(ctx, DataSourceNcloudMemberServerImages().Data(nil), config)
+	}
+	if err != nil {
+		return fmt.Errorf("listing member server images: %w", err)
+	}
+
+	var sweepables []sweep.Sweepable
+	for _, image := range images {
+		if !sweep.HasResourcePrefix(ncloud.String(image["name"].(string))) {
+			continue
+		}
+		sweepables = append(sweepables, sweep.NewSweepResource(ResourceNcloudMemberServerImage(), config, image["id"].(string), nil))
+	}
+
+	return sweep.SweepOrchestrator(ctx, sweepables)
+}
+
+// sweepPublicIps removes the public IPs of the servers of acceptance tests, and those described with the prefix.
+// Public IPs have no name.
+func sweepPublicIps(region string) error {
+	config, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+	if !config.SupportVPC {
+		return nil
+	}
+
+	publicIpInstanceList, err := paging.All(context.Background(), func(ctx context.Context, pageNo, pageSize int32) ([]*vserver.PublicIpInstance, *int32, error) {
+		resp, err := config.Client.Vserver.V2Api.GetPublicIpInstanceList(&vserver.GetPublicIpInstanceListRequest{RegionCode: &config.RegionCode, PageNo: ncloud.Int32(pageNo), PageSize: ncloud.Int32(pageSize)})
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.PublicIpInstanceList, resp.TotalRows, nil
+	})
+	if err != nil {
+		return fmt.Errorf("listing public IPs: %w", err)
+	}
+
+	var sweepables []sweep.Sweepable
+	for _, ip := range publicIpInstanceList {
+		if !sweep.HasResourcePrefix(ip.ServerName) && !sweep.HasResourcePrefix(ip.PublicIpDescription) {
+			continue
+		}
+		sweepables = append(sweepables, sweep.NewSweepResource(ResourceNcloudPublicIpInstance(), config, *ip.PublicIpInstanceNo, nil))
+	}
+
+	return sweep.SweepOrchestrator(context.Background(), sweepables)
+}
+
+func sweepNetworkInterfaces(region string) error {
+	config, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+	if !config.SupportVPC {
+		return nil
+	}
+
+	networkInterfaceList, err := paging.All(context.Background(), func(ctx context.Context, pageNo, pageSize int32) ([]*vserver.NetworkInterface, *int32, error) {
+		resp, err := config.Client.Vserver.V2Api.GetNetworkInterfaceList(&vserver.GetNetworkInterfaceListRequest{RegionCode: &config.RegionCode, PageNo: ncloud.Int32(pageNo), PageSize: ncloud.Int32(pageSize)})
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.NetworkInterfaceList, resp.TotalRows, nil
+	})
+	if err != nil {
+		return fmt.Errorf("listing network interfaces: %w", err)
+	}
+
+	var sweepables []sweep.Sweepable
+	for _, nic := range networkInterfaceList {
+		// default network interfaces are deleted with their server
+		if ncloud.BoolValue(nic.IsDefault) || !sweep.HasResourcePrefix(nic.NetworkInterfaceName) {
+			continue
+		}
+		sweepables = append(sweepables, sweep.NewSweepResource(ResourceNcloudNetworkInterface(), config, *nic.NetworkInterfaceNo, nil))
+	}
+
+	return sweep.SweepOrchestrator(context.Background(), sweepables)
+}
+
+func sweepAccessControlGroups(region string) error {
+	config, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+	if !config.SupportVPC {
+		return nil
+	}
+
+	accessControlGroupList, err := paging.All(context.Background(), func(ctx context.Context, pageNo, pageSize int32) ([]*vserver.AccessControlGroup, *int32, error) {
+		resp, err := config.Client.Vserver.V2Api.GetAccessControlGroupList(&vserver.GetAccessControlGroupListRequest{RegionCode: &config.RegionCode, PageNo: ncloud.Int32(pageNo), PageSize: ncloud.Int32(pageSize)})
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.AccessControlGroupList, resp.TotalRows, nil
+	})
+	if err != nil {
+		return fmt.Errorf("listing access control groups: %w", err)
+	}
+
+	var sweepables []sweep.Sweepable
+	for _, acg := range accessControlGroupList {
+		// default access control groups are deleted with their VPC
+		if ncloud.BoolValue(acg.IsDefault) || !sweep.HasResourcePrefix(acg.AccessControlGroupName) {
+			continue
+		}
+		sweepables = append(sweepables, sweep.NewSweepResource(ResourceNcloudAccessControlGroup(), config, *acg.AccessControlGroupNo, nil))
+	}
+
+	return sweep.SweepOrchestrator(context.Background(), sweepables)
+}
+
+func sweepPlacementGroups(region string) error {
+	config, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+	if !config.SupportVPC {
+		return nil
+	}
+
+	resp, err := config.Client.Vserver.V2Api.GetPlacementGroupList(&vserver.GetPlacementGroupListRequest{RegionCode: &config.RegionCode})
+	if err != nil {
+		return fmt.Errorf("listing placement groups: %w", err)
+	}
+
+	var sweepables []sweep.Sweepable
+	for _, pg := range resp.PlacementGroupList {
+		if !sweep.HasResourcePrefix(pg.PlacementGroupName) {
+			continue
+		}
+		sweepables = append(sweepables, sweep.NewFrameworkSweepResource(NewPlacementGroupResource, config, *pg.PlacementGroupNo, nil))
+	}
+
+	return sweep.SweepOrchestrator(context.Background(), sweepables)
+}
+
+func sweepLoginKeys(region string) error {
+	config, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+
+	loginKeys, err := GetLoginKeyList(context.Background(), config)
+	if err != nil {
+		return fmt.Errorf("listing login keys: %w", err)
+	}
+
+	var sweepables []sweep.Sweepable
+	for _, k := range loginKeys {
+		if !sweep.HasResourcePrefix(k.KeyName) {
+			continue
+		}
+		sweepables = append(sweepables, sweep.NewFrameworkSweepResource(NewLoginKeyResource, config, *k.KeyName, map[string]string{
+			"key_name": *k.KeyName,
+		}))
+	}
+
+	return sweep.SweepOrchestrator(context.Background(), sweepables)
+}
+
+func sweepInitScripts(region string) error {
+	config, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+	if !config.SupportVPC {
+		return nil
+	}
+
+	initScriptList, err := paging.All(context.Background(), func(ctx context.Context, pageNo, pageSize int32) ([]*vserver.InitScript, *int32, error) {
+		resp, err := config.Client.Vserver.V2Api.GetInitScriptList(&vserver.GetInitScriptListRequest{RegionCode: &config.RegionCode, PageNo: ncloud.Int32(pageNo), PageSize: ncloud.Int32(pageSize)})
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.InitScriptList, resp.TotalRows, nil
+	})
+	if err != nil {
+		return fmt.Errorf("listing init scripts: %w", err)
+	}
+
+	var sweepables []sweep.Sweepable
+	for _, s := range initScriptList {
+		if !sweep.HasResourcePrefix(s.InitScriptName) {
+			continue
+		}
+		sweepables = append(sweepables, sweep.NewFrameworkSweepResource(NewInitScriptResource, config, *s.InitScriptNo, nil))
+	}
+
+	return sweep.SweepOrchestrator(context.Background(), sweepables)
+}
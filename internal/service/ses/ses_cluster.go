@@ -11,12 +11,12 @@ import (
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vses2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/waiter"
 )
 
 const (
@@ -317,12 +317,12 @@ func resourceNcloudSESClusterCreate(ctx context.Context, d *schema.ResourceData,
 
 	resp, _, err := config.Client.Vses.V2Api.CreateClusterUsingPOST(ctx, *reqParams)
 	if err != nil {
-		LogErrorResponse("resourceNcloudSESClusterCreate", err, reqParams)
+		LogErrorResponse(ctx, "resourceNcloudSESClusterCreate", err, reqParams)
 		return diag.FromErr(err)
 	}
 	id := strconv.Itoa(int(ncloud.Int32Value(resp.Result.ServiceGroupInstanceNo)))
 
-	LogResponse("resourceNcloudSESClusterCreate", resp)
+	LogResponse(ctx, "resourceNcloudSESClusterCreate", resp)
 	if err := waitForSESClusterActive(ctx, d, config, id); err != nil {
 		return diag.FromErr(err)
 	}
@@ -337,6 +337,11 @@ func resourceNcloudSESClusterRead(ctx context.Context, d *schema.ResourceData, m
 	}
 
 	cluster, err := GetSESCluster(ctx, config, d.Id())
+	if IsNotFound(err) {
+		log.Printf("[WARN] SES cluster %s was not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -461,7 +466,7 @@ func checkSearchEngineChanged(ctx context.Context, d *schema.ResourceData, confi
 		oldSearchEngineMap := o.([]interface{})[0].(map[string]interface{})
 		newSearchEngineMap := n.([]interface{})[0].(map[string]interface{})
 		if oldSearchEngineMap["user_password"] != newSearchEngineMap["user_password"] {
-			LogCommonRequest("resourceNcloudSESClusterUpdate", d.Id())
+			LogCommonRequest(ctx, "resourceNcloudSESClusterUpdate", d.Id())
 			if err := waitForSESClusterActive(ctx, d, config, d.Id()); err != nil {
 				return fmt.Errorf("error waiting for SES Cluster (%s) to become activating: %s", d.Id(), err)
 			}
@@ -471,7 +476,7 @@ func checkSearchEngineChanged(ctx context.Context, d *schema.ResourceData, confi
 			}
 
 			if _, _, err := config.Client.Vses.V2Api.ResetSearchEngineUserPasswordUsingPOST(ctx, d.Id(), reqParams); err != nil {
-				LogErrorResponse("resourceNcloudSESClusterResetSearchEngineUserPassword", err, d.Id())
+				LogErrorResponse(ctx, "resourceNcloudSESClusterResetSearchEngineUserPassword", err, d.Id())
 				return fmt.Errorf("error Reset Search Engine User Password with Cluster (%s) : %s", d.Id(), err)
 			}
 
@@ -494,7 +499,7 @@ func checkDataNodeChanged(ctx context.Context, d *schema.ResourceData, config *c
 		newDataNodeCount := *Int32PtrOrNil(newDataNodeMap["count"], true)
 
 		if oldDataNodeCount < newDataNodeCount {
-			LogCommonRequest("resourceNcloudSESClusterUpdate", d.Id())
+			LogCommonRequest(ctx, "resourceNcloudSESClusterUpdate", d.Id())
 			if err := waitForSESClusterActive(ctx, d, config, d.Id()); err != nil {
 				return fmt.Errorf("error waiting for SES Cluster (%s) to become activating: %s", d.Id(), err)
 			}
@@ -504,7 +509,7 @@ func checkDataNodeChanged(ctx context.Context, d *schema.ResourceData, config *c
 			}
 
 			if _, _, err := config.Client.Vses.V2Api.AddNodesInClusterUsingPOST(ctx, d.Id(), reqParams); err != nil {
-				LogErrorResponse("resourceNcloudSESClusterAddNodes", err, d.Id())
+				LogErrorResponse(ctx, "resourceNcloudSESClusterAddNodes", err, d.Id())
 				return fmt.Errorf("error Add Nodes to SES Cluster (%s) : %s", d.Id(), err)
 			}
 
@@ -512,7 +517,7 @@ func checkDataNodeChanged(ctx context.Context, d *schema.ResourceData, config *c
 				return fmt.Errorf("error waiting for SES Cluster (%s) to become activating: %s", d.Id(), err)
 			}
 		} else if oldDataNodeCount > newDataNodeCount {
-			LogErrorResponse("resourceNcloudSESClusterAddNodes", nil, d.Id())
+			LogErrorResponse(ctx, "resourceNcloudSESClusterAddNodes", nil, d.Id())
 			return fmt.Errorf("data node count cannot be decreased")
 		}
 	}
@@ -532,7 +537,7 @@ func checkNodeProductCodeChanged(ctx context.Context, d *schema.ResourceData, co
 		}
 
 		if _, _, err := config.Client.Vses.V2Api.ChangeSpecNodeUsingPOST1(ctx, d.Id(), reqParams); err != nil {
-			LogErrorResponse("resourceNcloudSESClusterChangeSpec", nil, d.Id())
+			LogErrorResponse(ctx, "resourceNcloudSESClusterChangeSpec", nil, d.Id())
 			return fmt.Errorf("error Change Node Product Code (%s) : %s", d.Id(), err)
 		}
 
@@ -569,9 +574,9 @@ func resourceNcloudSESClusterDelete(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 
-	LogCommonRequest("resourceNcloudSESClusterDelete", d.Id())
+	ctx = LogCommonRequest(ctx, "resourceNcloudSESClusterDelete", d.Id())
 	if _, _, err := config.Client.Vses.V2Api.DeleteClusterUsingDELETE(ctx, d.Id()); err != nil {
-		LogErrorResponse("resourceNcloudSESClusterDelete", err, d.Id())
+		LogErrorResponse(ctx, "resourceNcloudSESClusterDelete", err, d.Id())
 		return diag.FromErr(err)
 	}
 
@@ -583,10 +588,10 @@ func resourceNcloudSESClusterDelete(ctx context.Context, d *schema.ResourceData,
 }
 
 func waitForSESClusterDeletion(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) error {
-	stateConf := &resource.StateChangeConf{
+	stateConf := &waiter.Config[any]{
 		Pending: []string{SESStatusRunningCode, SESStatusDeletingCode},
 		Target:  []string{SESStatusReturnCode},
-		Refresh: func() (result interface{}, state string, err error) {
+		Refresh: func(ctx context.Context) (result any, state string, err error) {
 			cluster, err := GetSESCluster(ctx, config, d.Id())
 			if err != nil {
 				return nil, "", err
@@ -596,21 +601,21 @@ func waitForSESClusterDeletion(ctx context.Context, d *schema.ResourceData, conf
 			}
 			return cluster, ncloud.StringValue(cluster.ClusterStatus), nil
 		},
-		Timeout:    d.Timeout(schema.TimeoutDelete),
-		MinTimeout: 3 * time.Second,
-		Delay:      2 * time.Second,
+		Timeout:     d.Timeout(schema.TimeoutDelete),
+		MinInterval: 3 * time.Second,
+		Delay:       2 * time.Second,
 	}
-	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+	if _, err := stateConf.WaitForState(ctx); err != nil {
 		return fmt.Errorf("Error waiting for SES Cluster (%s) to become terminating: %s", d.Id(), err)
 	}
 	return nil
 }
 
 func waitForSESClusterActive(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig, id string) error {
-	stateConf := &resource.StateChangeConf{
+	stateConf := &waiter.Config[any]{
 		Pending: []string{SESStatusCreatingCode, SESStatusChangingCode},
 		Target:  []string{SESStatusRunningCode},
-		Refresh: func() (result interface{}, state string, err error) {
+		Refresh: func(ctx context.Context) (result any, state string, err error) {
 			cluster, err := GetSESCluster(ctx, config, id)
 			if err != nil {
 				return nil, "", err
@@ -621,11 +626,11 @@ func waitForSESClusterActive(ctx context.Context, d *schema.ResourceData, config
 			return cluster, ncloud.StringValue(cluster.ClusterStatus), nil
 
 		},
-		Timeout:    d.Timeout(schema.TimeoutCreate),
-		MinTimeout: 3 * time.Second,
-		Delay:      2 * time.Second,
+		Timeout:     d.Timeout(schema.TimeoutCreate),
+		MinInterval: 3 * time.Second,
+		Delay:       2 * time.Second,
 	}
-	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+	if _, err := stateConf.WaitForState(ctx); err != nil {
 		return fmt.Errorf("error waiting for SES Cluster (%s) to become activating: %s", id, err)
 	}
 	return nil
@@ -637,7 +642,7 @@ func GetSESCluster(ctx context.Context, config *conn.ProviderConfig, id string)
 	if err != nil {
 		return nil, err
 	}
-	LogResponse("GetSESCluster", resp)
+	LogResponse(ctx, "GetSESCluster", resp)
 
 	return resp.Result, nil
 }
@@ -648,7 +653,7 @@ func getSESClusters(ctx context.Context, config *conn.ProviderConfig) (*vses2.Ge
 	if err != nil {
 		return nil, err
 	}
-	LogResponse("GetSESClusterList", resp)
+	LogResponse(ctx, "GetSESClusterList", resp)
 
 	return resp.Result, nil
 }
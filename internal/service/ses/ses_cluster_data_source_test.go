@@ -13,7 +13,7 @@ import (
 func TestAccDataSourceNcloudSESCluster(t *testing.T) {
 	dataName := "data.ncloud_ses_cluster.cluster"
 	resourceName := "ncloud_ses_cluster.cluster"
-	testClusterName := GetTestClusterName()
+	testClusterName := GetTestClusterName(t)
 	searchEngineVersionCode := "133"
 	region := os.Getenv("NCLOUD_REGION")
 
@@ -20,7 +20,7 @@ const TF_TEST_SES_LOGIN_KEY = "tf-ses-login-key"
 func TestAccResourceNcloudSESCluster_basic(t *testing.T) {
 	var cluster vses2.OpenApiGetClusterInfoResponseVo
 	resourceName := "ncloud_ses_cluster.cluster"
-	testClusterName := GetTestClusterName()
+	testClusterName := GetTestClusterName(t)
 	searchEngineVersionCode := "133"
 	region := os.Getenv("NCLOUD_REGION")
 
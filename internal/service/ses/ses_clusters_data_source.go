@@ -49,7 +49,7 @@ func dataSourceNcloudSESClustersRead(ctx context.Context, d *schema.ResourceData
 
 	clusters, err := getSESClusters(ctx, config)
 	if err != nil {
-		LogErrorResponse("GetSESClusters", err, "")
+		LogErrorResponse(ctx, "GetSESClusters", err, "")
 		return diag.FromErr(err)
 	}
 
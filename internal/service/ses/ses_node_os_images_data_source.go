@@ -7,6 +7,7 @@ import (
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/common"
@@ -15,7 +16,7 @@ import (
 
 func DataSourceNcloudSESNodeOsImage() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNcloudSESNodeOsImageRead,
+		ReadContext: dataSourceNcloudSESNodeOsImageRead,
 
 		Schema: map[string]*schema.Schema{
 			"filter": DataSourceFiltersSchema(),
@@ -39,15 +40,15 @@ func DataSourceNcloudSESNodeOsImage() *schema.Resource {
 	}
 }
 
-func dataSourceNcloudSESNodeOsImageRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceNcloudSESNodeOsImageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*conn.ProviderConfig)
 	if !config.SupportVPC {
-		return NotSupportClassic("datasource `ncloud_ses_node_os_image`")
+		return diag.FromErr(NotSupportClassic("datasource `ncloud_ses_node_os_image`"))
 	}
 
-	resources, err := getSESNodeOsImage(config)
+	resources, err := getSESNodeOsImage(ctx, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if f, ok := d.GetOk("filter"); ok {
@@ -56,24 +57,24 @@ func dataSourceNcloudSESNodeOsImageRead(d *schema.ResourceData, meta interface{}
 
 	d.SetId(time.Now().UTC().String())
 	if err := d.Set("images", resources); err != nil {
-		return fmt.Errorf("Error setting Codes: %s", err)
+		return diag.FromErr(fmt.Errorf("Error setting Codes: %s", err))
 	}
 
 	return nil
 
 }
 
-func getSESNodeOsImage(config *conn.ProviderConfig) ([]map[string]interface{}, error) {
+func getSESNodeOsImage(ctx context.Context, config *conn.ProviderConfig) ([]map[string]interface{}, error) {
 
-	LogCommonRequest("GetSESNodeOsImage", "")
+	ctx = LogCommonRequest(ctx, "GetSESNodeOsImage", "")
 	resp, _, err := config.Client.Vses.V2Api.GetOsProductListUsingGET(context.Background())
 
 	if err != nil {
-		LogErrorResponse("GetSESNodeOsImage", err, "")
+		LogErrorResponse(ctx, "GetSESNodeOsImage", err, "")
 		return nil, err
 	}
 
-	LogResponse("GetSESNodeOsImage", resp)
+	LogResponse(ctx, "GetSESNodeOsImage", resp)
 
 	resources := []map[string]interface{}{}
 
@@ -8,6 +8,7 @@ import (
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vses2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/common"
@@ -16,7 +17,7 @@ import (
 
 func DataSourceNcloudSESNodeProduct() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNcloudSESNodeProductRead,
+		ReadContext: dataSourceNcloudSESNodeProductRead,
 		Schema: map[string]*schema.Schema{
 			"filter": DataSourceFiltersSchema(),
 			"codes": {
@@ -55,16 +56,16 @@ func DataSourceNcloudSESNodeProduct() *schema.Resource {
 	}
 }
 
-func dataSourceNcloudSESNodeProductRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceNcloudSESNodeProductRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*conn.ProviderConfig)
 	if !config.SupportVPC {
-		return NotSupportClassic("datasource `ncloud_ses_node_products`")
+		return diag.FromErr(NotSupportClassic("datasource `ncloud_ses_node_products`"))
 	}
 
-	resources, err := getSESNodeProduct(config, d)
+	resources, err := getSESNodeProduct(ctx, config, d)
 	if err != nil {
-		LogErrorResponse("GetSESNodeProduct", err, "")
-		return err
+		LogErrorResponse(ctx, "GetSESNodeProduct", err, "")
+		return diag.FromErr(err)
 	}
 
 	if f, ok := d.GetOk("filter"); ok {
@@ -73,14 +74,14 @@ func dataSourceNcloudSESNodeProductRead(d *schema.ResourceData, meta interface{}
 
 	d.SetId(time.Now().UTC().String())
 	if err := d.Set("codes", resources); err != nil {
-		return fmt.Errorf("Error setting Codes: %s", err)
+		return diag.FromErr(fmt.Errorf("Error setting Codes: %s", err))
 	}
 
 	return nil
 }
 
-func getSESNodeProduct(config *conn.ProviderConfig, d *schema.ResourceData) ([]map[string]interface{}, error) {
-	LogCommonRequest("GetSESSoftwareProduct", "")
+func getSESNodeProduct(ctx context.Context, config *conn.ProviderConfig, d *schema.ResourceData) ([]map[string]interface{}, error) {
+	LogCommonRequest(ctx, "GetSESSoftwareProduct", "")
 
 	reqParams := &vses2.V2ApiGetNodeProductListWithGetMethodUsingGETOpts{
 		SoftwareProductCode: *StringPtrOrNil(d.GetOk("os_image_code")),
@@ -89,11 +90,11 @@ func getSESNodeProduct(config *conn.ProviderConfig, d *schema.ResourceData) ([]m
 	resp, _, err := config.Client.Vses.V2Api.GetNodeProductListWithGetMethodUsingGET(context.Background(), reqParams)
 
 	if err != nil {
-		LogErrorResponse("GetSESNodeProduct", err, "")
+		LogErrorResponse(ctx, "GetSESNodeProduct", err, "")
 		return nil, err
 	}
 
-	LogResponse("GetSESNodeProduct", resp)
+	LogResponse(ctx, "GetSESNodeProduct", resp)
 
 	resources := []map[string]interface{}{}
 
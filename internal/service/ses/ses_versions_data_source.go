@@ -8,6 +8,7 @@ import (
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/common"
@@ -16,7 +17,7 @@ import (
 
 func DataSourceNcloudSESVersions() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNcloudSESVersionsRead,
+		ReadContext: dataSourceNcloudSESVersionsRead,
 
 		Schema: map[string]*schema.Schema{
 			"filter": DataSourceFiltersSchema(),
@@ -48,15 +49,15 @@ func DataSourceNcloudSESVersions() *schema.Resource {
 	}
 }
 
-func dataSourceNcloudSESVersionsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceNcloudSESVersionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*conn.ProviderConfig)
 	if !config.SupportVPC {
-		return NotSupportClassic("datasource `ncloud_ses_versions`")
+		return diag.FromErr(NotSupportClassic("datasource `ncloud_ses_versions`"))
 	}
 
-	resources, err := getSESVersion(config)
+	resources, err := getSESVersion(ctx, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if f, ok := d.GetOk("filter"); ok {
@@ -65,24 +66,24 @@ func dataSourceNcloudSESVersionsRead(d *schema.ResourceData, meta interface{}) e
 
 	d.SetId(time.Now().UTC().String())
 	if err := d.Set("versions", resources); err != nil {
-		return fmt.Errorf("Error setting Versions: %s", err)
+		return diag.FromErr(fmt.Errorf("Error setting Versions: %s", err))
 	}
 
 	return nil
 
 }
 
-func getSESVersion(config *conn.ProviderConfig) ([]map[string]interface{}, error) {
+func getSESVersion(ctx context.Context, config *conn.ProviderConfig) ([]map[string]interface{}, error) {
 
-	LogCommonRequest("GetSESVersion", "")
+	ctx = LogCommonRequest(ctx, "GetSESVersion", "")
 	resp, _, err := config.Client.Vses.V2Api.GetSearchEngineVersionListUsingGET(context.Background())
 
 	if err != nil {
-		LogErrorResponse("GetSESVersion", err, "")
+		LogErrorResponse(ctx, "GetSESVersion", err, "")
 		return nil, err
 	}
 
-	LogResponse("GetSESVersion", resp)
+	LogResponse(ctx, "GetSESVersion", resp)
 
 	resources := []map[string]interface{}{}
 
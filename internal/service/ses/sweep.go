@@ -0,0 +1,49 @@
+package ses
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/sweep"
+)
+
+func RegisterSweepers() {
+	sweep.AddTestSweepers("ncloud_ses_cluster", &resource.Sweeper{
+		Name: "ncloud_ses_cluster",
+		F:    sweepSESClusters,
+	})
+}
+
+func sweepSESClusters(region string) error {
+	config, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+	if !config.SupportVPC {
+		return nil
+	}
+
+	ctx := context.Background()
+	clusters, err := getSESClusters(ctx, config)
+	if err != nil {
+		if sweep.SkipSweepError(err) {
+			return nil
+		}
+		return fmt.Errorf("listing SES clusters: %w", err)
+	}
+	if clusters == nil {
+		return nil
+	}
+
+	var sweepables []sweep.Sweepable
+	for _, c := range clusters.AllowedClusters {
+		if !sweep.HasResourcePrefix(c.ClusterName) {
+			continue
+		}
+		sweepables = append(sweepables, sweep.NewSweepResource(ResourceNcloudSESCluster(), config, *c.ServiceGroupInstanceNo, nil))
+	}
+
+	return sweep.SweepOrchestrator(ctx, sweepables)
+}
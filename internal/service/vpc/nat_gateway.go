@@ -11,9 +11,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/framework"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/waiter"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vpc"
@@ -177,14 +176,14 @@ func (n *natGatewayResource) Create(ctx context.Context, req resource.CreateRequ
 		reqParams.PrivateIp = plan.PrivateIp.ValueStringPointer()
 	}
 
-	tflog.Info(ctx, "CreateNatGateway reqParams="+common.MarshalUncheckedString(reqParams))
+	ctx = common.LogCommonRequest(ctx, "CreateNatGateway", reqParams)
 
 	response, err := n.config.Client.Vpc.V2Api.CreateNatGatewayInstance(reqParams)
 	if err != nil {
 		resp.Diagnostics.AddError("CREATING ERROR", err.Error())
 		return
 	}
-	tflog.Info(ctx, "CreateNatGateway response="+common.MarshalUncheckedString(response))
+	common.LogResponse(ctx, "CreateNatGateway", response)
 
 	natGatewayInstance := response.NatGatewayInstanceList[0]
 	plan.ID = types.StringPointerValue(natGatewayInstance.NatGatewayInstanceNo)
@@ -243,14 +242,14 @@ func (n *natGatewayResource) Update(ctx context.Context, req resource.UpdateRequ
 			NatGatewayInstanceNo:  state.NatGatewayNo.ValueStringPointer(),
 			NatGatewayDescription: plan.Description.ValueStringPointer(),
 		}
-		tflog.Info(ctx, "SetNatGatewayDescription reqParams="+common.MarshalUncheckedString(reqParams))
+		ctx = common.LogCommonRequest(ctx, "SetNatGatewayDescription", reqParams)
 
 		response, err := n.config.Client.Vpc.V2Api.SetNatGatewayDescription(reqParams)
 		if err != nil {
 			resp.Diagnostics.AddError("UPDATE ERROR", err.Error())
 			return
 		}
-		tflog.Info(ctx, "SetNatGatewayDescription response="+common.MarshalUncheckedString(response))
+		common.LogResponse(ctx, "SetNatGatewayDescription", response)
 
 		output, err := GetNatGatewayInstance(ctx, n.config, state.ID.ValueString())
 		if err != nil {
@@ -276,14 +275,14 @@ func (n *natGatewayResource) Delete(ctx context.Context, req resource.DeleteRequ
 		RegionCode:           &n.config.RegionCode,
 		NatGatewayInstanceNo: state.NatGatewayNo.ValueStringPointer(),
 	}
-	tflog.Info(ctx, "DeleteNatGateway reqParams="+common.MarshalUncheckedString(reqParams))
+	ctx = common.LogCommonRequest(ctx, "DeleteNatGateway", reqParams)
 
 	response, err := n.config.Client.Vpc.V2Api.DeleteNatGatewayInstance(reqParams)
 	if err != nil {
 		resp.Diagnostics.AddError("DELETING ERROR", err.Error())
 		return
 	}
-	tflog.Info(ctx, "DeleteNatGateway response="+common.MarshalUncheckedString(response))
+	common.LogResponse(ctx, "DeleteNatGateway", response)
 
 	if err := WaitForNcloudNatGatewayDeletion(ctx, n.config, state.ID.ValueString()); err != nil {
 		resp.Diagnostics.AddError("WAITING FOR DELETE ERROR", err.Error())
@@ -291,21 +290,21 @@ func (n *natGatewayResource) Delete(ctx context.Context, req resource.DeleteRequ
 }
 
 func waitForNcloudNatGatewayCreation(ctx context.Context, config *conn.ProviderConfig, id string) (*vpc.NatGatewayInstance, error) {
-	var natGatewayInstance *vpc.NatGatewayInstance
-	stateConf := &retry.StateChangeConf{
+	stateConf := &waiter.Config[*vpc.NatGatewayInstance]{
 		Pending: []string{"INIT", "CREATING"},
 		Target:  []string{"RUN"},
-		Refresh: func() (interface{}, string, error) {
-			instance, err := GetNatGatewayInstance(ctx, config, id)
-			natGatewayInstance = instance
-			return VpcCommonStateRefreshFunc(instance, err, "NatGatewayInstanceStatus")
-		},
-		Timeout:    conn.DefaultCreateTimeout,
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Refresh: waiter.StatusOf(func(ctx context.Context) (*vpc.NatGatewayInstance, error) {
+			return GetNatGatewayInstance(ctx, config, id)
+		}, func(instance *vpc.NatGatewayInstance) string {
+			return ncloud.StringValue(common.GetCodePtrByCommonCode(instance.NatGatewayInstanceStatus))
+		}),
+		Timeout:     conn.DefaultCreateTimeout,
+		Delay:       2 * time.Second,
+		MinInterval: 3 * time.Second,
 	}
 
-	if _, err := stateConf.WaitForState(); err != nil {
+	natGatewayInstance, err := stateConf.WaitForState(ctx)
+	if err != nil {
 		return nil, fmt.Errorf("Error waiting for NAT GATEWAY (%s) to become available: %s", id, err)
 	}
 
@@ -313,19 +312,20 @@ func waitForNcloudNatGatewayCreation(ctx context.Context, config *conn.ProviderC
 }
 
 func WaitForNcloudNatGatewayDeletion(ctx context.Context, config *conn.ProviderConfig, id string) error {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &waiter.Config[*vpc.NatGatewayInstance]{
 		Pending: []string{"RUN", "TERMTING"},
 		Target:  []string{"TERMINATED"},
-		Refresh: func() (interface{}, string, error) {
-			instance, err := GetNatGatewayInstance(ctx, config, id)
-			return VpcCommonStateRefreshFunc(instance, err, "NatGatewayInstanceStatus")
-		},
-		Timeout:    conn.DefaultTimeout,
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Refresh: waiter.StatusOf(func(ctx context.Context) (*vpc.NatGatewayInstance, error) {
+			return GetNatGatewayInstance(ctx, config, id)
+		}, func(instance *vpc.NatGatewayInstance) string {
+			return ncloud.StringValue(common.GetCodePtrByCommonCode(instance.NatGatewayInstanceStatus))
+		}),
+		Timeout:     conn.DefaultTimeout,
+		Delay:       2 * time.Second,
+		MinInterval: 3 * time.Second,
 	}
 
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForState(ctx); err != nil {
 		return fmt.Errorf("Error waiting for NAT Gateway (%s) to become termintaing: %s", id, err)
 	}
 
@@ -337,14 +337,14 @@ func GetNatGatewayInstance(ctx context.Context, config *conn.ProviderConfig, id
 		RegionCode:           &config.RegionCode,
 		NatGatewayInstanceNo: ncloud.String(id),
 	}
-	tflog.Info(ctx, "GetNatGatewayInstanceDetail reqParams="+common.MarshalUncheckedString(reqParams))
+	ctx = common.LogCommonRequest(ctx, "GetNatGatewayInstanceDetail", reqParams)
 
 	resp, err := config.Client.Vpc.V2Api.GetNatGatewayInstanceDetail(reqParams)
 	if err != nil {
-		common.LogErrorResponse("GetNatGatewayInstanceDetail", err, reqParams)
+		common.LogErrorResponse(ctx, "GetNatGatewayInstanceDetail", err, reqParams)
 		return nil, err
 	}
-	tflog.Info(ctx, "GetNatGatewayInstanceDetail response="+common.MarshalUncheckedString(resp))
+	common.LogResponse(ctx, "GetNatGatewayInstanceDetail", resp)
 
 	if resp == nil || len(resp.NatGatewayInstanceList) < 1 {
 		return nil, nil
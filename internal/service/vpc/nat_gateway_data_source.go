@@ -4,15 +4,16 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vpc"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/paging"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/verify"
 )
 
@@ -130,16 +131,25 @@ func (n *natGatewayDataSource) Read(ctx context.Context, req datasource.ReadRequ
 	if !data.VpcName.IsNull() && !data.VpcName.IsUnknown() {
 		reqParams.VpcName = data.VpcName.ValueStringPointer()
 	}
-	tflog.Info(ctx, "GetNatGatewayList reqParams="+common.MarshalUncheckedString(reqParams))
-
-	natGatewayResp, err := n.config.Client.Vpc.V2Api.GetNatGatewayInstanceList(reqParams)
+	natGatewayInstanceList, err := paging.All(ctx, func(ctx context.Context, pageNo, pageSize int32) ([]*vpc.NatGatewayInstance, *int32, error) {
+		reqParams.PageNo = ncloud.Int32(pageNo)
+		reqParams.PageSize = ncloud.Int32(pageSize)
+
+		ctx = common.LogCommonRequest(ctx, "GetNatGatewayList", reqParams)
+		natGatewayResp, err := n.config.Client.Vpc.V2Api.GetNatGatewayInstanceList(reqParams)
+		if err != nil {
+			return nil, nil, err
+		}
+		common.LogResponse(ctx, "GetNatGatewayList", natGatewayResp)
+
+		return natGatewayResp.NatGatewayInstanceList, natGatewayResp.TotalRows, nil
+	})
 	if err != nil {
 		resp.Diagnostics.AddError("READING ERROR", err.Error())
 		return
 	}
-	tflog.Info(ctx, "GetNatGatewayList response="+common.MarshalUncheckedString(natGatewayResp))
 
-	natGatewayList, diags := flattenNatGateways(natGatewayResp.NatGatewayInstanceList)
+	natGatewayList, diags := flattenNatGateways(natGatewayInstanceList)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/acctest"
@@ -13,7 +12,7 @@ import (
 func TestAccDataSourceNcloudNatGateway_basic(t *testing.T) {
 	resourceName := "ncloud_nat_gateway.nat_gateway"
 	dataName := "data.ncloud_nat_gateway.by_id"
-	name := fmt.Sprintf("tf-data-testacc-nat-%s", acctest.RandString(5))
+	name := fmt.Sprintf("tf-data-testacc-nat-%s", RandString(t, 5))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { TestAccPreCheck(t) },
@@ -1,29 +1,32 @@
 package vpc
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vpc"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/verify"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/waiter"
 )
 
 func ResourceNcloudNetworkACL() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNcloudNetworkACLCreate,
-		Read:   resourceNcloudNetworkACLRead,
-		Update: resourceNcloudNetworkACLUpdate,
-		Delete: resourceNcloudNetworkACLDelete,
+		CreateContext: resourceNcloudNetworkACLCreate,
+		ReadContext:   resourceNcloudNetworkACLRead,
+		UpdateContext: resourceNcloudNetworkACLUpdate,
+		DeleteContext: resourceNcloudNetworkACLDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -56,11 +59,11 @@ func ResourceNcloudNetworkACL() *schema.Resource {
 	}
 }
 
-func resourceNcloudNetworkACLCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudNetworkACLCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*conn.ProviderConfig)
 
 	if !config.SupportVPC {
-		return NotSupportClassic("resource `ncloud_network_acl`")
+		return diag.FromErr(NotSupportClassic("resource `ncloud_network_acl`"))
 	}
 
 	reqParams := &vpc.CreateNetworkAclRequest{
@@ -76,32 +79,32 @@ func resourceNcloudNetworkACLCreate(d *schema.ResourceData, meta interface{}) er
 		reqParams.NetworkAclDescription = ncloud.String(v.(string))
 	}
 
-	LogCommonRequest("CreateNetworkAcl", reqParams)
+	ctx = LogCommonRequest(ctx, "CreateNetworkAcl", reqParams)
 	resp, err := config.Client.Vpc.V2Api.CreateNetworkAcl(reqParams)
 	if err != nil {
-		LogErrorResponse("CreateNetworkAcl", err, reqParams)
-		return err
+		LogErrorResponse(ctx, "CreateNetworkAcl", err, reqParams)
+		return diag.FromErr(err)
 	}
 
-	LogResponse("CreateNetworkAcl", resp)
+	LogResponse(ctx, "CreateNetworkAcl", resp)
 
 	instance := resp.NetworkAclList[0]
 	d.SetId(*instance.NetworkAclNo)
 	log.Printf("[INFO] Network ACL ID: %s", d.Id())
 
-	if err := waitForNcloudNetworkACLCreation(config, d.Id()); err != nil {
-		return err
+	if err := waitForNcloudNetworkACLCreation(ctx, config, d.Id()); err != nil {
+		return diag.FromErr(err)
 	}
 
-	return resourceNcloudNetworkACLRead(d, meta)
+	return resourceNcloudNetworkACLRead(ctx, d, meta)
 }
 
-func resourceNcloudNetworkACLRead(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudNetworkACLRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*conn.ProviderConfig)
 
-	instance, err := GetNetworkACLInstance(config, d.Id())
+	instance, err := GetNetworkACLInstance(ctx, config, d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if instance == nil {
@@ -119,19 +122,19 @@ func resourceNcloudNetworkACLRead(d *schema.ResourceData, meta interface{}) erro
 	return nil
 }
 
-func resourceNcloudNetworkACLUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudNetworkACLUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*conn.ProviderConfig)
 
 	if d.HasChange("description") {
-		if err := setNetworkACLDescription(d, config); err != nil {
-			return err
+		if err := setNetworkACLDescription(ctx, d, config); err != nil {
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceNcloudNetworkACLRead(d, meta)
+	return resourceNcloudNetworkACLRead(ctx, d, meta)
 }
 
-func resourceNcloudNetworkACLDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudNetworkACLDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*conn.ProviderConfig)
 
 	reqParams := &vpc.DeleteNetworkAclRequest{
@@ -139,75 +142,77 @@ func resourceNcloudNetworkACLDelete(d *schema.ResourceData, meta interface{}) er
 		NetworkAclNo: ncloud.String(d.Get("network_acl_no").(string)),
 	}
 
-	LogCommonRequest("DeleteNetworkAcl", reqParams)
+	ctx = LogCommonRequest(ctx, "DeleteNetworkAcl", reqParams)
 	resp, err := config.Client.Vpc.V2Api.DeleteNetworkAcl(reqParams)
 	if err != nil {
-		LogErrorResponse("DeleteNetworkAcl", err, reqParams)
-		return err
+		LogErrorResponse(ctx, "DeleteNetworkAcl", err, reqParams)
+		return diag.FromErr(err)
 	}
 
-	LogResponse("DeleteNetworkAcl", resp)
+	LogResponse(ctx, "DeleteNetworkAcl", resp)
 
-	if err := WaitForNcloudNetworkACLDeletion(config, d.Id()); err != nil {
-		return err
+	if err := WaitForNcloudNetworkACLDeletion(ctx, config, d.Id()); err != nil {
+		return diag.FromErr(err)
 	}
 
 	return nil
 }
 
-func waitForNcloudNetworkACLCreation(config *conn.ProviderConfig, id string) error {
-	stateConf := &resource.StateChangeConf{
+func waitForNcloudNetworkACLCreation(ctx context.Context, config *conn.ProviderConfig, id string) error {
+	stateConf := &waiter.Config[*vpc.NetworkAcl]{
 		Pending: []string{"INIT", "CREATING"},
 		Target:  []string{"RUN"},
-		Refresh: func() (interface{}, string, error) {
-			instance, err := GetNetworkACLInstance(config, id)
-			return VpcCommonStateRefreshFunc(instance, err, "NetworkAclStatus")
-		},
-		Timeout:    conn.DefaultCreateTimeout,
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Refresh: waiter.StatusOf(func(ctx context.Context) (*vpc.NetworkAcl, error) {
+			return GetNetworkACLInstance(ctx, config, id)
+		}, func(instance *vpc.NetworkAcl) string {
+			return ncloud.StringValue(common.GetCodePtrByCommonCode(instance.NetworkAclStatus))
+		}),
+		Timeout:     conn.DefaultCreateTimeout,
+		Delay:       2 * time.Second,
+		MinInterval: 3 * time.Second,
 	}
 
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForState(ctx); err != nil {
 		return fmt.Errorf("Error waiting for Network ACL (%s) to become available: %s", id, err)
 	}
 
 	return nil
 }
 
-func WaitForNcloudNetworkACLDeletion(config *conn.ProviderConfig, id string) error {
-	stateConf := &resource.StateChangeConf{
+func WaitForNcloudNetworkACLDeletion(ctx context.Context, config *conn.ProviderConfig, id string) error {
+	stateConf := &waiter.Config[*vpc.NetworkAcl]{
 		Pending: []string{"RUN", "TERMTING"},
 		Target:  []string{"TERMINATED"},
-		Refresh: func() (interface{}, string, error) {
-			instance, err := GetNetworkACLInstance(config, id)
-			return VpcCommonStateRefreshFunc(instance, err, "NetworkAclStatus")
-		},
-		Timeout:    conn.DefaultTimeout,
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Refresh: waiter.StatusOf(func(ctx context.Context) (*vpc.NetworkAcl, error) {
+			return GetNetworkACLInstance(ctx, config, id)
+		}, func(instance *vpc.NetworkAcl) string {
+			return ncloud.StringValue(common.GetCodePtrByCommonCode(instance.NetworkAclStatus))
+		}),
+		Timeout:     conn.DefaultTimeout,
+		Delay:       2 * time.Second,
+		MinInterval: 3 * time.Second,
 	}
 
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForState(ctx); err != nil {
 		return fmt.Errorf("Error waiting for Network ACL (%s) to become termintaing: %s", id, err)
 	}
 
 	return nil
 }
 
-func GetNetworkACLInstance(config *conn.ProviderConfig, id string) (*vpc.NetworkAcl, error) {
+func GetNetworkACLInstance(ctx context.Context, config *conn.ProviderConfig, id string) (*vpc.NetworkAcl, error) {
 	reqParams := &vpc.GetNetworkAclDetailRequest{
 		RegionCode:   &config.RegionCode,
 		NetworkAclNo: ncloud.String(id),
 	}
 
-	LogCommonRequest("GetNetworkAclDetail", reqParams)
+	ctx = LogCommonRequest(ctx, "GetNetworkAclDetail", reqParams)
 	resp, err := config.Client.Vpc.V2Api.GetNetworkAclDetail(reqParams)
 	if err != nil {
-		LogErrorResponse("GetNetworkAclDetail", err, reqParams)
+		LogErrorResponse(ctx, "GetNetworkAclDetail", err, reqParams)
 		return nil, err
 	}
-	LogResponse("GetNetworkAclDetail", resp)
+	LogResponse(ctx, "GetNetworkAclDetail", resp)
 
 	if len(resp.NetworkAclList) > 0 {
 		instance := resp.NetworkAclList[0]
@@ -217,20 +222,20 @@ func GetNetworkACLInstance(config *conn.ProviderConfig, id string) (*vpc.Network
 	return nil, nil
 }
 
-func setNetworkACLDescription(d *schema.ResourceData, config *conn.ProviderConfig) error {
+func setNetworkACLDescription(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) error {
 	reqParams := &vpc.SetNetworkAclDescriptionRequest{
 		RegionCode:            &config.RegionCode,
 		NetworkAclNo:          ncloud.String(d.Id()),
 		NetworkAclDescription: StringPtrOrNil(d.GetOk("description")),
 	}
 
-	LogCommonRequest("setNetworkAclDescription", reqParams)
+	ctx = LogCommonRequest(ctx, "setNetworkAclDescription", reqParams)
 	resp, err := config.Client.Vpc.V2Api.SetNetworkAclDescription(reqParams)
 	if err != nil {
-		LogErrorResponse("setNetworkAclDescription", err, reqParams)
+		LogErrorResponse(ctx, "setNetworkAclDescription", err, reqParams)
 		return err
 	}
-	LogResponse("setNetworkAclDescription", resp)
+	LogResponse(ctx, "setNetworkAclDescription", resp)
 
 	return nil
 }
@@ -1,199 +1,311 @@
 package vpc
 
 import (
+	"context"
 	"fmt"
-	"log"
 	"time"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
-
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vpc"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-
-	. "github.com/terraform-providers/terraform-provider-ncloud/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/framework"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/verify"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/waiter"
 )
 
-func ResourceNcloudNetworkACLDenyAllowGroup() *schema.Resource {
-	return &schema.Resource{
-		Create: resourceNcloudNetworkACLDenyAllowGroupCreate,
-		Read:   resourceNcloudNetworkACLDenyAllowGroupRead,
-		Update: resourceNcloudNetworkACLDenyAllowGroupUpdate,
-		Delete: resourceNcloudNetworkACLDenyAllowGroupDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
-		Schema: map[string]*schema.Schema{
-			"network_acl_deny_allow_group_no": {
-				Type:     schema.TypeString,
+var (
+	_ resource.Resource                 = &networkACLDenyAllowGroupResource{}
+	_ resource.ResourceWithConfigure    = &networkACLDenyAllowGroupResource{}
+	_ resource.ResourceWithImportState  = &networkACLDenyAllowGroupResource{}
+	_ resource.ResourceWithUpgradeState = &networkACLDenyAllowGroupResource{}
+)
+
+func NewNetworkACLDenyAllowGroupResource() resource.Resource {
+	return &networkACLDenyAllowGroupResource{}
+}
+
+type networkACLDenyAllowGroupResource struct {
+	config *conn.ProviderConfig
+}
+
+func (n *networkACLDenyAllowGroupResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_network_acl_deny_allow_group"
+}
+
+func (n *networkACLDenyAllowGroupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = networkACLDenyAllowGroupSchema()
+	resp.Schema.Version = 1
+}
+
+// networkACLDenyAllowGroupSchema is the schema of the resource, unchanged since its SDKv2 implementation
+func networkACLDenyAllowGroupSchema() schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": framework.IDAttribute(),
+			"network_acl_deny_allow_group_no": schema.StringAttribute{
 				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
-			"vpc_no": {
-				Type:     schema.TypeString,
+			"vpc_no": schema.StringAttribute{
 				Required: true,
-				ForceNew: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
-			"name": {
-				Type:             schema.TypeString,
-				ForceNew:         true,
-				Optional:         true,
-				Computed:         true,
-				ValidateDiagFunc: validation.ToDiagFunc(verify.ValidateInstanceName),
+			"name": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: verify.InstanceNameValidator(),
 			},
-			"description": {
-				Type:             schema.TypeString,
-				Optional:         true,
-				Computed:         true,
-				ValidateDiagFunc: validation.ToDiagFunc(validation.StringLenBetween(0, 1000)),
+			"description": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(0, 1000),
+				},
 			},
-			"ip_list": {
-				Type:     schema.TypeSet,
-				Elem:     &schema.Schema{Type: schema.TypeString},
-				Set:      schema.HashString,
-				MaxItems: 100,
-				Required: true,
+			"ip_list": schema.SetAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtMost(100),
+				},
 			},
 		},
 	}
 }
 
-func resourceNcloudNetworkACLDenyAllowGroupCreate(d *schema.ResourceData, meta interface{}) error {
-	config := meta.(*conn.ProviderConfig)
+func (n *networkACLDenyAllowGroupResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		framework.SDKv2SchemaVersion: framework.UpgradeFromSDKv2[networkACLDenyAllowGroupResourceModel](networkACLDenyAllowGroupSchema()),
+	}
+}
+
+func (n *networkACLDenyAllowGroupResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*conn.ProviderConfig)
 
-	if !config.SupportVPC {
-		return NotSupportClassic("resource `ncloud_network_acl_deny_allow_group`")
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
 	}
 
-	reqParams := &vpc.CreateNetworkAclDenyAllowGroupRequest{
-		RegionCode: &config.RegionCode,
-		VpcNo:      ncloud.String(d.Get("vpc_no").(string))}
+	n.config = config
+}
 
-	if v, ok := d.GetOk("name"); ok {
-		reqParams.NetworkAclDenyAllowGroupName = ncloud.String(v.(string))
+func (n *networkACLDenyAllowGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan networkACLDenyAllowGroupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	if v, ok := d.GetOk("description"); ok {
-		reqParams.NetworkAclDenyAllowGroupDescription = ncloud.String(v.(string))
+	if !n.config.SupportVPC {
+		resp.Diagnostics.AddError(
+			"NOT SUPPORT CLASSIC",
+			"resource `ncloud_network_acl_deny_allow_group` does not support classic",
+		)
+		return
+	}
+
+	reqParams := &vpc.CreateNetworkAclDenyAllowGroupRequest{
+		RegionCode: &n.config.RegionCode,
+		VpcNo:      plan.VpcNo.ValueStringPointer(),
+	}
+	if !plan.Name.IsNull() && !plan.Name.IsUnknown() {
+		reqParams.NetworkAclDenyAllowGroupName = plan.Name.ValueStringPointer()
 	}
+	if !plan.Description.IsNull() && !plan.Description.IsUnknown() {
+		reqParams.NetworkAclDenyAllowGroupDescription = plan.Description.ValueStringPointer()
+	}
+
+	ctx = common.LogCommonRequest(ctx, "CreateNetworkAclDenyAllowGroup", reqParams)
 
-	LogCommonRequest("CreateNetworkAclDenyAllowGroup", reqParams)
-	resp, err := config.Client.Vpc.V2Api.CreateNetworkAclDenyAllowGroup(reqParams)
+	response, err := n.config.Client.Vpc.V2Api.CreateNetworkAclDenyAllowGroup(reqParams)
 	if err != nil {
-		LogErrorResponse("CreateNetworkAclDenyAllowGroup", err, reqParams)
-		return err
+		resp.Diagnostics.AddError("CREATING ERROR", err.Error())
+		return
 	}
 
-	LogResponse("CreateNetworkAclDenyAllowGroup", resp)
+	common.LogResponse(ctx, "CreateNetworkAclDenyAllowGroup", response)
 
-	instance := resp.NetworkAclDenyAllowGroupList[0]
-	d.SetId(*instance.NetworkAclDenyAllowGroupNo)
-	log.Printf("[INFO] Network ACL DenyAllowGroup ID: %s", d.Id())
+	id := *response.NetworkAclDenyAllowGroupList[0].NetworkAclDenyAllowGroupNo
+	plan.ID = types.StringValue(id)
 
-	if err := waitForVpcNetworkAclDenyAllowGroupState(config, d.Id(), []string{InstanceStatusInit, InstanceStatusCreate}, []string{InstanceStatusRunning}, conn.DefaultCreateTimeout); err != nil {
-		return err
+	if err := waitForVpcNetworkAclDenyAllowGroupState(ctx, n.config, id, []string{common.InstanceStatusInit, common.InstanceStatusCreate}, []string{common.InstanceStatusRunning}, conn.DefaultCreateTimeout); err != nil {
+		resp.Diagnostics.AddError("WAITING FOR CREATION ERROR", err.Error())
+		return
 	}
 
-	if err := setNetworkAclDenyAllowGroupIpList(d, config); err != nil {
-		return err
+	ipList, diags := expandIpList(ctx, plan.IpList)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	if err := waitForVpcNetworkAclDenyAllowGroupState(config, d.Id(), []string{InstanceStatusSetting}, []string{InstanceStatusRunning}, conn.DefaultCreateTimeout); err != nil {
-		return err
+	if err := setNetworkAclDenyAllowGroupIpList(ctx, n.config, id, ipList); err != nil {
+		resp.Diagnostics.AddError("CREATING ERROR", err.Error())
+		return
+	}
+
+	if err := waitForVpcNetworkAclDenyAllowGroupState(ctx, n.config, id, []string{common.InstanceStatusSetting}, []string{common.InstanceStatusRunning}, conn.DefaultCreateTimeout); err != nil {
+		resp.Diagnostics.AddError("WAITING FOR CREATION ERROR", err.Error())
+		return
 	}
 
-	return resourceNcloudNetworkACLDenyAllowGroupRead(d, meta)
+	resp.Diagnostics.Append(n.refresh(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
-func resourceNcloudNetworkACLDenyAllowGroupRead(d *schema.ResourceData, meta interface{}) error {
-	config := meta.(*conn.ProviderConfig)
+func (n *networkACLDenyAllowGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state networkACLDenyAllowGroupResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	instance, err := GetNetworkAclDenyAllowGroupDetail(config, d.Id())
+	output, err := GetNetworkAclDenyAllowGroupDetail(ctx, n.config, state.ID.ValueString())
 	if err != nil {
-		return err
+		resp.Diagnostics.AddError("READING ERROR", err.Error())
+		return
 	}
 
-	if instance == nil {
-		d.SetId("")
-		return nil
+	if output == nil {
+		resp.State.RemoveResource(ctx)
+		return
 	}
 
-	m := map[string]interface{}{
-		"id":                              *instance.NetworkAclDenyAllowGroupNo,
-		"network_acl_deny_allow_group_no": *instance.NetworkAclDenyAllowGroupNo,
-		"vpc_no":                          *instance.VpcNo,
-		"name":                            *instance.NetworkAclDenyAllowGroupName,
-		"description":                     *instance.NetworkAclDenyAllowGroupDescription,
-		"ip_list":                         instance.IpList,
+	resp.Diagnostics.Append(state.refreshFromOutput(ctx, output)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (n *networkACLDenyAllowGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state networkACLDenyAllowGroupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	SetSingularResourceDataFromMapSchema(ResourceNcloudNetworkACLDenyAllowGroup(), d, m)
+	id := state.ID.ValueString()
 
-	return nil
-}
-
-func resourceNcloudNetworkACLDenyAllowGroupUpdate(d *schema.ResourceData, meta interface{}) error {
-	config := meta.(*conn.ProviderConfig)
+	if !plan.IpList.Equal(state.IpList) {
+		ipList, diags := expandIpList(ctx, plan.IpList)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 
-	if d.HasChange("ip_list") {
-		if err := setNetworkAclDenyAllowGroupIpList(d, config); err != nil {
-			return err
+		if err := setNetworkAclDenyAllowGroupIpList(ctx, n.config, id, ipList); err != nil {
+			resp.Diagnostics.AddError("UPDATING ERROR", err.Error())
+			return
 		}
 	}
 
-	if d.HasChange("description") {
-		if err := setNetworkAclDenyAllowGroupDescription(d, config); err != nil {
-			return err
+	if !plan.Description.IsUnknown() && !plan.Description.Equal(state.Description) {
+		if err := setNetworkAclDenyAllowGroupDescription(ctx, n.config, id, plan.Description.ValueStringPointer()); err != nil {
+			resp.Diagnostics.AddError("UPDATING ERROR", err.Error())
+			return
 		}
 	}
 
-	if err := waitForVpcNetworkAclDenyAllowGroupState(config, d.Id(), []string{InstanceStatusSetting}, []string{InstanceStatusRunning}, conn.DefaultTimeout); err != nil {
-		return err
+	if err := waitForVpcNetworkAclDenyAllowGroupState(ctx, n.config, id, []string{common.InstanceStatusSetting}, []string{common.InstanceStatusRunning}, conn.DefaultTimeout); err != nil {
+		resp.Diagnostics.AddError("WAITING FOR UPDATE ERROR", err.Error())
+		return
 	}
 
-	return resourceNcloudNetworkACLDenyAllowGroupRead(d, meta)
+	resp.Diagnostics.Append(n.refresh(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
-func resourceNcloudNetworkACLDenyAllowGroupDelete(d *schema.ResourceData, meta interface{}) error {
-	config := meta.(*conn.ProviderConfig)
+func (n *networkACLDenyAllowGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state networkACLDenyAllowGroupResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	reqParams := &vpc.DeleteNetworkAclDenyAllowGroupRequest{
-		RegionCode:                 &config.RegionCode,
-		NetworkAclDenyAllowGroupNo: ncloud.String(d.Id()),
+		RegionCode:                 &n.config.RegionCode,
+		NetworkAclDenyAllowGroupNo: state.ID.ValueStringPointer(),
 	}
 
-	LogCommonRequest("DeleteNetworkAclDenyAllowGroup", reqParams)
-	resp, err := config.Client.Vpc.V2Api.DeleteNetworkAclDenyAllowGroup(reqParams)
+	ctx = common.LogCommonRequest(ctx, "DeleteNetworkAclDenyAllowGroup", reqParams)
+
+	response, err := n.config.Client.Vpc.V2Api.DeleteNetworkAclDenyAllowGroup(reqParams)
 	if err != nil {
-		LogErrorResponse("DeleteNetworkAclDenyAllowGroup", err, reqParams)
-		return err
+		resp.Diagnostics.AddError("DELETING ERROR", err.Error())
+		return
 	}
 
-	LogResponse("DeleteNetworkAclDenyAllowGroup", resp)
+	common.LogResponse(ctx, "DeleteNetworkAclDenyAllowGroup", response)
 
-	if err := waitForVpcNetworkAclDenyAllowGroupState(config, d.Id(), []string{InstanceStatusRunning, InstanceStatusTerminating}, []string{InstanceStatusTerminated}, conn.DefaultTimeout); err != nil {
-		return err
+	if err := waitForVpcNetworkAclDenyAllowGroupState(ctx, n.config, state.ID.ValueString(), []string{common.InstanceStatusRunning, common.InstanceStatusTerminating}, []string{common.InstanceStatusTerminated}, conn.DefaultTimeout); err != nil {
+		resp.Diagnostics.AddError("WAITING FOR DELETE ERROR", err.Error())
 	}
+}
 
-	return nil
+func (n *networkACLDenyAllowGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
-func waitForVpcNetworkAclDenyAllowGroupState(config *conn.ProviderConfig, id string, pending []string, target []string, timeout time.Duration) error {
-	stateConf := &resource.StateChangeConf{
+// refresh reads the group of m after it is created or updated
+func (n *networkACLDenyAllowGroupResource) refresh(ctx context.Context, m *networkACLDenyAllowGroupResourceModel) (diags diag.Diagnostics) {
+	output, err := GetNetworkAclDenyAllowGroupDetail(ctx, n.config, m.ID.ValueString())
+	if err != nil {
+		diags.AddError("READING ERROR", err.Error())
+		return diags
+	}
+	if output == nil {
+		diags.AddError("READING ERROR", fmt.Sprintf("network ACL deny-allow group %s not found", m.ID.ValueString()))
+		return diags
+	}
+
+	return m.refreshFromOutput(ctx, output)
+}
+
+func waitForVpcNetworkAclDenyAllowGroupState(ctx context.Context, config *conn.ProviderConfig, id string, pending []string, target []string, timeout time.Duration) error {
+	stateConf := &waiter.Config[*vpc.NetworkAclDenyAllowGroup]{
 		Pending: pending,
 		Target:  target,
-		Refresh: func() (interface{}, string, error) {
-			instance, err := GetNetworkAclDenyAllowGroupDetail(config, id)
-			return VpcCommonStateRefreshFunc(instance, err, "NetworkAclDenyAllowGroupStatus")
-		},
-		Timeout:    timeout,
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Refresh: waiter.StatusOf(func(ctx context.Context) (*vpc.NetworkAclDenyAllowGroup, error) {
+			return GetNetworkAclDenyAllowGroupDetail(ctx, config, id)
+		}, func(instance *vpc.NetworkAclDenyAllowGroup) string {
+			return ncloud.StringValue(common.GetCodePtrByCommonCode(instance.NetworkAclDenyAllowGroupStatus))
+		}),
+		Timeout:     timeout,
+		Delay:       2 * time.Second,
+		MinInterval: 3 * time.Second,
 	}
 
-	_, err := stateConf.WaitForState()
+	_, err := stateConf.WaitForState(ctx)
 	if err != nil {
 		return fmt.Errorf("error waiting for NetworkAclDenyAllowGroupStatus (%s) to become (%v): %s", id, target, err)
 	}
@@ -201,19 +313,19 @@ func waitForVpcNetworkAclDenyAllowGroupState(config *conn.ProviderConfig, id str
 	return nil
 }
 
-func GetNetworkAclDenyAllowGroupDetail(config *conn.ProviderConfig, id string) (*vpc.NetworkAclDenyAllowGroup, error) {
+func GetNetworkAclDenyAllowGroupDetail(ctx context.Context, config *conn.ProviderConfig, id string) (*vpc.NetworkAclDenyAllowGroup, error) {
 	reqParams := &vpc.GetNetworkAclDenyAllowGroupDetailRequest{
 		RegionCode:                 &config.RegionCode,
 		NetworkAclDenyAllowGroupNo: &id,
 	}
 
-	LogCommonRequest("GetNetworkAclDenyAllowGroupDetail", reqParams)
+	ctx = common.LogCommonRequest(ctx, "GetNetworkAclDenyAllowGroupDetail", reqParams)
 	resp, err := config.Client.Vpc.V2Api.GetNetworkAclDenyAllowGroupDetail(reqParams)
 	if err != nil {
-		LogErrorResponse("GetNetworkAclDenyAllowGroupDetail", err, reqParams)
+		common.LogErrorResponse(ctx, "GetNetworkAclDenyAllowGroupDetail", err, reqParams)
 		return nil, err
 	}
-	LogResponse("GetNetworkAclDenyAllowGroupDetail", resp)
+	common.LogResponse(ctx, "GetNetworkAclDenyAllowGroupDetail", resp)
 
 	if len(resp.NetworkAclDenyAllowGroupList) > 0 {
 		instance := resp.NetworkAclDenyAllowGroupList[0]
@@ -223,38 +335,69 @@ func GetNetworkAclDenyAllowGroupDetail(config *conn.ProviderConfig, id string) (
 	return nil, nil
 }
 
-func setNetworkAclDenyAllowGroupDescription(d *schema.ResourceData, config *conn.ProviderConfig) error {
+func setNetworkAclDenyAllowGroupDescription(ctx context.Context, config *conn.ProviderConfig, id string, description *string) error {
 	reqParams := &vpc.SetNetworkAclDenyAllowGroupDescriptionRequest{
 		RegionCode:                          &config.RegionCode,
-		NetworkAclDenyAllowGroupNo:          ncloud.String(d.Id()),
-		NetworkAclDenyAllowGroupDescription: StringPtrOrNil(d.GetOk("description")),
+		NetworkAclDenyAllowGroupNo:          ncloud.String(id),
+		NetworkAclDenyAllowGroupDescription: description,
 	}
 
-	LogCommonRequest("SetNetworkAclDenyAllowGroupDescription", reqParams)
+	ctx = common.LogCommonRequest(ctx, "SetNetworkAclDenyAllowGroupDescription", reqParams)
+
 	resp, err := config.Client.Vpc.V2Api.SetNetworkAclDenyAllowGroupDescription(reqParams)
 	if err != nil {
-		LogErrorResponse("SetNetworkAclDenyAllowGroupDescription", err, reqParams)
 		return err
 	}
-	LogResponse("SetNetworkAclDenyAllowGroupDescription", resp)
+
+	common.LogResponse(ctx, "SetNetworkAclDenyAllowGroupDescription", resp)
 
 	return nil
 }
 
-func setNetworkAclDenyAllowGroupIpList(d *schema.ResourceData, config *conn.ProviderConfig) error {
+func setNetworkAclDenyAllowGroupIpList(ctx context.Context, config *conn.ProviderConfig, id string, ipList []*string) error {
 	reqParams := &vpc.SetNetworkAclDenyAllowGroupIpListRequest{
 		RegionCode:                 &config.RegionCode,
-		NetworkAclDenyAllowGroupNo: ncloud.String(d.Id()),
-		IpList:                     ExpandStringSet(d.Get("ip_list").(*schema.Set)),
+		NetworkAclDenyAllowGroupNo: ncloud.String(id),
+		IpList:                     ipList,
 	}
 
-	LogCommonRequest("SetNetworkAclDenyAllowGroupIpList", reqParams)
+	ctx = common.LogCommonRequest(ctx, "SetNetworkAclDenyAllowGroupIpList", reqParams)
+
 	resp, err := config.Client.Vpc.V2Api.SetNetworkAclDenyAllowGroupIpList(reqParams)
 	if err != nil {
-		LogErrorResponse("SetNetworkAclDenyAllowGroupIpList", err, reqParams)
 		return err
 	}
-	LogResponse("SetNetworkAclDenyAllowGroupIpList", resp)
+
+	common.LogResponse(ctx, "SetNetworkAclDenyAllowGroupIpList", resp)
 
 	return nil
 }
+
+func expandIpList(ctx context.Context, ipList types.Set) ([]*string, diag.Diagnostics) {
+	var ips []string
+	diags := ipList.ElementsAs(ctx, &ips, false)
+
+	return ncloud.StringList(ips), diags
+}
+
+type networkACLDenyAllowGroupResourceModel struct {
+	ID                         types.String `tfsdk:"id"`
+	NetworkAclDenyAllowGroupNo types.String `tfsdk:"network_acl_deny_allow_group_no"`
+	VpcNo                      types.String `tfsdk:"vpc_no"`
+	Name                       types.String `tfsdk:"name"`
+	Description                types.String `tfsdk:"description"`
+	IpList                     types.Set    `tfsdk:"ip_list"`
+}
+
+func (m *networkACLDenyAllowGroupResourceModel) refreshFromOutput(ctx context.Context, output *vpc.NetworkAclDenyAllowGroup) diag.Diagnostics {
+	m.ID = types.StringPointerValue(output.NetworkAclDenyAllowGroupNo)
+	m.NetworkAclDenyAllowGroupNo = types.StringPointerValue(output.NetworkAclDenyAllowGroupNo)
+	m.VpcNo = types.StringPointerValue(output.VpcNo)
+	m.Name = types.StringPointerValue(output.NetworkAclDenyAllowGroupName)
+	m.Description = types.StringPointerValue(output.NetworkAclDenyAllowGroupDescription)
+
+	ipList, diags := types.SetValueFrom(ctx, types.StringType, ncloud.StringListValue(output.IpList))
+	m.IpList = ipList
+
+	return diags
+}
@@ -1,13 +1,13 @@
 package vpc_test
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"regexp"
 	"testing"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vpc"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 
@@ -18,7 +18,7 @@ import (
 
 func TestAccResourceNcloudNetworkACLDenyAllowGroup_basic(t *testing.T) {
 	var networkAclDenyAllowGroup vpc.NetworkAclDenyAllowGroup
-	name := fmt.Sprintf("tf-nacl-allow-basic-%s", acctest.RandString(5))
+	name := fmt.Sprintf("tf-nacl-allow-basic-%s", RandString(t, 5))
 	resourceName := "ncloud_network_acl_deny_allow_group.this"
 
 	resource.Test(t, resource.TestCase{
@@ -47,7 +47,7 @@ func TestAccResourceNcloudNetworkACLDenyAllowGroup_basic(t *testing.T) {
 
 func TestAccResourceNcloudNetworkACLDenyAllowGroup_disappears(t *testing.T) {
 	var networkAclDenyAllowGroup vpc.NetworkAclDenyAllowGroup
-	name := fmt.Sprintf("tf-nacl-allow-ds-%s", acctest.RandString(5))
+	name := fmt.Sprintf("tf-nacl-allow-ds-%s", RandString(t, 5))
 	resourceName := "ncloud_network_acl_deny_allow_group.this"
 
 	resource.Test(t, resource.TestCase{
@@ -69,7 +69,7 @@ func TestAccResourceNcloudNetworkACLDenyAllowGroup_disappears(t *testing.T) {
 
 func TestAccResourceNcloudNetworkACLDenyAllowGroup_update(t *testing.T) {
 	var networkAclDenyAllowGroup vpc.NetworkAclDenyAllowGroup
-	name := fmt.Sprintf("tf-nacl-allow-update-%s", acctest.RandString(5))
+	name := fmt.Sprintf("tf-nacl-allow-update-%s", RandString(t, 5))
 	resourceName := "ncloud_network_acl_deny_allow_group.this"
 
 	resource.Test(t, resource.TestCase{
@@ -102,7 +102,7 @@ func TestAccResourceNcloudNetworkACLDenyAllowGroup_update(t *testing.T) {
 
 func TestAccResourceNcloudNetworkACLDenyAllowGroup_description(t *testing.T) {
 	var networkAclDenyAllowGroup vpc.NetworkAclDenyAllowGroup
-	name := fmt.Sprintf("tf-nacl-allow-desc-%s", acctest.RandString(5))
+	name := fmt.Sprintf("tf-nacl-allow-desc-%s", RandString(t, 5))
 	resourceName := "ncloud_network_acl_deny_allow_group.this"
 
 	resource.Test(t, resource.TestCase{
@@ -181,7 +181,7 @@ func testAccCheckNetworkACLDenyAllowGroupExists(n string, networkACL *vpc.Networ
 		}
 
 		config := GetTestProvider(true).Meta().(*conn.ProviderConfig)
-		instance, err := vpcservice.GetNetworkAclDenyAllowGroupDetail(config, rs.Primary.ID)
+		instance, err := vpcservice.GetNetworkAclDenyAllowGroupDetail(context.Background(), config, rs.Primary.ID)
 		if err != nil {
 			return err
 		}
@@ -200,7 +200,7 @@ func testAccCheckNetworkACLDenyAllowGroupDestroy(s *terraform.State) error {
 			continue
 		}
 
-		instance, err := vpcservice.GetNetworkAclDenyAllowGroupDetail(config, rs.Primary.ID)
+		instance, err := vpcservice.GetNetworkAclDenyAllowGroupDetail(context.Background(), config, rs.Primary.ID)
 
 		if err != nil {
 			return err
@@ -225,7 +225,7 @@ func testAccCheckNetworkACLDenyAllowGroupDisappears(instance *vpc.NetworkAclDeny
 
 		_, err := config.Client.Vpc.V2Api.DeleteNetworkAclDenyAllowGroup(reqParams)
 
-		if err := vpcservice.WaitForNcloudNetworkACLDeletion(config, *instance.NetworkAclDenyAllowGroupNo); err != nil {
+		if err := vpcservice.WaitForNcloudNetworkACLDeletion(context.Background(), config, *instance.NetworkAclDenyAllowGroupNo); err != nil {
 			return err
 		}
 
@@ -1,20 +1,23 @@
 package vpc
 
 import (
+	"context"
 	"fmt"
 	"time"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vpc"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/paging"
 )
 
 func DataSourceNcloudNetworkACLDenyAllowGroups() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNcloudNetworkACLDenyAllowGroupsRead,
+		ReadContext: dataSourceNcloudNetworkACLDenyAllowGroupsRead,
 
 		Schema: map[string]*schema.Schema{
 			"network_acl_deny_allow_group_no_list": {
@@ -34,17 +37,51 @@ func DataSourceNcloudNetworkACLDenyAllowGroups() *schema.Resource {
 			"network_acl_deny_allow_groups": {
 				Type:     schema.TypeList,
 				Computed: true,
-				Elem:     GetDataSourceItemSchema(ResourceNcloudNetworkACLDenyAllowGroup()),
+				Elem:     dataSourceNcloudNetworkACLDenyAllowGroupItem(),
 			},
 		},
 	}
 }
 
-func dataSourceNcloudNetworkACLDenyAllowGroupsRead(d *schema.ResourceData, meta interface{}) error {
+// dataSourceNcloudNetworkACLDenyAllowGroupItem is the schema of the groups, the attributes of the resource
+func dataSourceNcloudNetworkACLDenyAllowGroupItem() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"network_acl_deny_allow_group_no": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"vpc_no": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ip_list": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+		},
+	}
+}
+
+func dataSourceNcloudNetworkACLDenyAllowGroupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*conn.ProviderConfig)
 
 	if !config.SupportVPC {
-		return NotSupportClassic("data source `ncloud_network_acl_deny_allow_groups`")
+		return diag.FromErr(NotSupportClassic("data source `ncloud_network_acl_deny_allow_groups`"))
 	}
 
 	reqParams := &vpc.GetNetworkAclDenyAllowGroupListRequest{
@@ -63,22 +100,31 @@ func dataSourceNcloudNetworkACLDenyAllowGroupsRead(d *schema.ResourceData, meta
 		reqParams.VpcNo = ncloud.String(v.(string))
 	}
 
-	LogCommonRequest("GetNetworkAclDenyAllowGroupList", reqParams)
-	resp, err := config.Client.Vpc.V2Api.GetNetworkAclDenyAllowGroupList(reqParams)
+	networkAclDenyAllowGroupList, err := paging.All(ctx, func(ctx context.Context, pageNo, pageSize int32) ([]*vpc.NetworkAclDenyAllowGroup, *int32, error) {
+		reqParams.PageNo = ncloud.Int32(pageNo)
+		reqParams.PageSize = ncloud.Int32(pageSize)
+
+		ctx = LogCommonRequest(ctx, "GetNetworkAclDenyAllowGroupList", reqParams)
+		resp, err := config.Client.Vpc.V2Api.GetNetworkAclDenyAllowGroupList(reqParams)
+		if err != nil {
+			LogErrorResponse(ctx, "GetNetworkAclDenyAllowGroupList", err, reqParams)
+			return nil, nil, err
+		}
+		LogResponse(ctx, "GetNetworkAclDenyAllowGroupList", resp)
 
+		return resp.NetworkAclDenyAllowGroupList, resp.TotalRows, nil
+	})
 	if err != nil {
-		LogErrorResponse("GetNetworkAclDenyAllowGroupList", err, reqParams)
-		return err
+		return diag.FromErr(err)
 	}
-	LogResponse("GetNetworkAclDenyAllowGroupList", resp)
 
-	if resp == nil || len(resp.NetworkAclDenyAllowGroupList) == 0 {
-		return fmt.Errorf("no matching NetworkAclDenyAllowGroup found")
+	if len(networkAclDenyAllowGroupList) == 0 {
+		return diag.FromErr(fmt.Errorf("no matching NetworkAclDenyAllowGroup found"))
 	}
 
 	var resources []map[string]interface{}
 
-	for _, r := range resp.NetworkAclDenyAllowGroupList {
+	for _, r := range networkAclDenyAllowGroupList {
 		m := map[string]interface{}{
 			"id":                              *r.NetworkAclDenyAllowGroupNo,
 			"network_acl_deny_allow_group_no": *r.NetworkAclDenyAllowGroupNo,
@@ -88,8 +134,8 @@ func dataSourceNcloudNetworkACLDenyAllowGroupsRead(d *schema.ResourceData, meta
 		}
 
 		// only can get `ip_list` data from `getNetworkAclDenyAllowGroupDetail`
-		if g, err := GetNetworkAclDenyAllowGroupDetail(config, *r.NetworkAclDenyAllowGroupNo); err != nil {
-			return err
+		if g, err := GetNetworkAclDenyAllowGroupDetail(ctx, config, *r.NetworkAclDenyAllowGroupNo); err != nil {
+			return diag.FromErr(err)
 		} else {
 			m["ip_list"] = g.IpList
 		}
@@ -98,12 +144,12 @@ func dataSourceNcloudNetworkACLDenyAllowGroupsRead(d *schema.ResourceData, meta
 	}
 
 	if f, ok := d.GetOk("filter"); ok {
-		resources = ApplyFilters(f.(*schema.Set), resources, ResourceNcloudNetworkACLDenyAllowGroup().Schema)
+		resources = ApplyFilters(f.(*schema.Set), resources, dataSourceNcloudNetworkACLDenyAllowGroupItem().Schema)
 	}
 
 	d.SetId(time.Now().UTC().String())
 	if err := d.Set("network_acl_deny_allow_groups", resources); err != nil {
-		return fmt.Errorf("error setting NetworkAclDenyAllowGroups: %s", err)
+		return diag.FromErr(fmt.Errorf("error setting NetworkAclDenyAllowGroups: %s", err))
 	}
 
 	return nil
@@ -4,14 +4,13 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/acctest"
 )
 
 func TestAccDataSourceNcloudNetworkACLDenyAllowGroups_basic(t *testing.T) {
-	name := fmt.Sprintf("tf-ds-nacl-allow-basic-%s", acctest.RandString(5))
+	name := fmt.Sprintf("tf-ds-nacl-allow-basic-%s", RandString(t, 5))
 	resourceName := "ncloud_network_acl_deny_allow_group.this"
 	dataName := "data.ncloud_network_acl_deny_allow_groups.by_id"
 
@@ -1,28 +1,31 @@
 package vpc
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vpc"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/verify"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/waiter"
 )
 
 func ResourceNcloudNetworkACLRule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNcloudNetworkACLRuleCreate,
-		Read:   resourceNcloudNetworkACLRuleRead,
-		Update: resourceNcloudNetworkACLRuleUpdate,
-		Delete: resourceNcloudNetworkACLRuleDelete,
+		CreateContext: resourceNcloudNetworkACLRuleCreate,
+		ReadContext:   resourceNcloudNetworkACLRuleRead,
+		UpdateContext: resourceNcloudNetworkACLRuleUpdate,
+		DeleteContext: resourceNcloudNetworkACLRuleDelete,
 		Schema: map[string]*schema.Schema{
 			"network_acl_no": {
 				Type:     schema.TypeString,
@@ -123,29 +126,28 @@ func ResourceNcloudNetworkACLRule() *schema.Resource {
 	}
 }
 
-func resourceNcloudNetworkACLRuleCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudNetworkACLRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*conn.ProviderConfig)
 
 	if !config.SupportVPC {
-		return NotSupportClassic("resource `ncloud_network_acl_rule`")
+		return diag.FromErr(NotSupportClassic("resource `ncloud_network_acl_rule`"))
 	}
 
 	d.SetId(d.Get("network_acl_no").(string))
 	log.Printf("[INFO] Network ACL ID: %s", d.Id())
 
-	return resourceNcloudNetworkACLRuleUpdate(d, meta)
+	return resourceNcloudNetworkACLRuleUpdate(ctx, d, meta)
 }
 
-func resourceNcloudNetworkACLRuleRead(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudNetworkACLRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*conn.ProviderConfig)
 
-	rules, err := GetNetworkACLRuleList(config, d.Id())
+	rules, err := GetNetworkACLRuleList(ctx, config, d.Id())
 	if err != nil {
-		errBody, _ := GetCommonErrorBody(err)
-		if errBody.ReturnCode == "1011002" { // You cannot access the appropriate Network ACL
+		if HasReturnCode(err, "1011002") { // You cannot access the appropriate Network ACL
 			d.SetId("")
 		}
-		return err
+		return diag.FromErr(err)
 	}
 
 	if len(rules) == 0 {
@@ -189,85 +191,86 @@ func resourceNcloudNetworkACLRuleRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func resourceNcloudNetworkACLRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudNetworkACLRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*conn.ProviderConfig)
 
 	if d.HasChange("inbound") {
-		if err := updateNetworkACLRule(d, config, "inbound"); err != nil {
-			return err
+		if err := updateNetworkACLRule(ctx, d, config, "inbound"); err != nil {
+			return diag.FromErr(err)
 		}
 	}
 
 	if d.HasChange("outbound") {
-		if err := updateNetworkACLRule(d, config, "outbound"); err != nil {
-			return err
+		if err := updateNetworkACLRule(ctx, d, config, "outbound"); err != nil {
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceNcloudNetworkACLRuleRead(d, meta)
+	return resourceNcloudNetworkACLRuleRead(ctx, d, meta)
 }
 
-func resourceNcloudNetworkACLRuleDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudNetworkACLRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*conn.ProviderConfig)
 
 	i := d.Get("inbound").(*schema.Set)
 	o := d.Get("outbound").(*schema.Set)
 
-	_ = waitForNcloudNetworkACLRunning(config, d.Id())
+	_ = waitForNcloudNetworkACLRunning(ctx, config, d.Id())
 
 	if len(i.List()) > 0 {
-		if err := removeNetworkACLRule(d, config, "inbound", expandRemoveNetworkAclRule(i.List())); err != nil {
-			return err
+		if err := removeNetworkACLRule(ctx, d, config, "inbound", expandRemoveNetworkAclRule(i.List())); err != nil {
+			return diag.FromErr(err)
 		}
 	}
 
 	if len(o.List()) > 0 {
-		if err := removeNetworkACLRule(d, config, "outbound", expandRemoveNetworkAclRule(o.List())); err != nil {
-			return err
+		if err := removeNetworkACLRule(ctx, d, config, "outbound", expandRemoveNetworkAclRule(o.List())); err != nil {
+			return diag.FromErr(err)
 		}
 	}
 
 	return nil
 }
 
-func waitForNcloudNetworkACLRunning(config *conn.ProviderConfig, id string) error {
-	stateConf := &resource.StateChangeConf{
+func waitForNcloudNetworkACLRunning(ctx context.Context, config *conn.ProviderConfig, id string) error {
+	stateConf := &waiter.Config[*vpc.NetworkAcl]{
 		Pending: []string{"SET"},
 		Target:  []string{"RUN"},
-		Refresh: func() (interface{}, string, error) {
-			instance, err := GetNetworkACLInstance(config, id)
-			return VpcCommonStateRefreshFunc(instance, err, "NetworkAclStatus")
-		},
-		Timeout:    conn.DefaultTimeout,
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Refresh: waiter.StatusOf(func(ctx context.Context) (*vpc.NetworkAcl, error) {
+			return GetNetworkACLInstance(ctx, config, id)
+		}, func(instance *vpc.NetworkAcl) string {
+			return ncloud.StringValue(common.GetCodePtrByCommonCode(instance.NetworkAclStatus))
+		}),
+		Timeout:     conn.DefaultTimeout,
+		Delay:       2 * time.Second,
+		MinInterval: 3 * time.Second,
 	}
 
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForState(ctx); err != nil {
 		return fmt.Errorf("error waiting for Network ACL (%s) to become termintaing: %s", id, err)
 	}
 
 	return nil
 }
 
-func GetNetworkACLRuleList(config *conn.ProviderConfig, id string) ([]*vpc.NetworkAclRule, error) {
+func GetNetworkACLRuleList(ctx context.Context, config *conn.ProviderConfig, id string) ([]*vpc.NetworkAclRule, error) {
 	reqParams := &vpc.GetNetworkAclRuleListRequest{
 		RegionCode:   &config.RegionCode,
 		NetworkAclNo: ncloud.String(id),
 	}
 
-	LogCommonRequest("GetNetworkAclRuleList", reqParams)
+	ctx = LogCommonRequest(ctx, "GetNetworkAclRuleList", reqParams)
 	resp, err := config.Client.Vpc.V2Api.GetNetworkAclRuleList(reqParams)
 	if err != nil {
-		LogErrorResponse("GetNetworkAclRuleList", err, reqParams)
+		LogErrorResponse(ctx, "GetNetworkAclRuleList", err, reqParams)
 		return nil, err
 	}
-	LogResponse("GetNetworkAclRuleList", resp)
+	LogResponse(ctx, "GetNetworkAclRuleList", resp)
 
 	return resp.NetworkAclRuleList, nil
 }
 
-func updateNetworkACLRule(d *schema.ResourceData, config *conn.ProviderConfig, ruleType string) error {
+func updateNetworkACLRule(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig, ruleType string) error {
 	o, n := d.GetChange(ruleType)
 
 	if o == nil {
@@ -287,13 +290,13 @@ func updateNetworkACLRule(d *schema.ResourceData, config *conn.ProviderConfig, r
 	addNetworkACLRuleList := expandAddNetworkAclRule(add)
 
 	if len(removeNetworkACLRuleList) > 0 {
-		if err := removeNetworkACLRule(d, config, ruleType, removeNetworkACLRuleList); err != nil {
+		if err := removeNetworkACLRule(ctx, d, config, ruleType, removeNetworkACLRuleList); err != nil {
 			return err
 		}
 	}
 
 	if len(addNetworkACLRuleList) > 0 {
-		if err := addNetworkACLRule(d, config, ruleType, addNetworkACLRuleList); err != nil {
+		if err := addNetworkACLRule(ctx, d, config, ruleType, addNetworkACLRuleList); err != nil {
 			return err
 		}
 	}
@@ -301,106 +304,78 @@ func updateNetworkACLRule(d *schema.ResourceData, config *conn.ProviderConfig, r
 	return nil
 }
 
-func addNetworkACLRule(d *schema.ResourceData, config *conn.ProviderConfig, ruleType string, addNetworkRuleList []*vpc.AddNetworkAclRuleParameter) error {
+func addNetworkACLRule(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig, ruleType string, addNetworkRuleList []*vpc.AddNetworkAclRuleParameter) error {
 	var reqParams interface{}
 	var resp interface{}
+	var err error
 
-	err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		var err error
-
-		if ruleType == "inbound" {
-			reqParams = &vpc.AddNetworkAclInboundRuleRequest{
-				RegionCode:         &config.RegionCode,
-				NetworkAclNo:       ncloud.String(d.Id()),
-				NetworkAclRuleList: addNetworkRuleList,
-			}
-
-			LogCommonRequest("AddNetworkAclInboundRule", reqParams)
-			resp, err = config.Client.Vpc.V2Api.AddNetworkAclInboundRule(reqParams.(*vpc.AddNetworkAclInboundRuleRequest))
-		} else {
-			reqParams = &vpc.AddNetworkAclOutboundRuleRequest{
-				RegionCode:         &config.RegionCode,
-				NetworkAclNo:       ncloud.String(d.Id()),
-				NetworkAclRuleList: addNetworkRuleList,
-			}
-
-			LogCommonRequest("AddNetworkAclOutboundRule", reqParams)
-			resp, err = config.Client.Vpc.V2Api.AddNetworkAclOutboundRule(reqParams.(*vpc.AddNetworkAclOutboundRuleRequest))
+	if ruleType == "inbound" {
+		reqParams = &vpc.AddNetworkAclInboundRuleRequest{
+			RegionCode:         &config.RegionCode,
+			NetworkAclNo:       ncloud.String(d.Id()),
+			NetworkAclRuleList: addNetworkRuleList,
 		}
 
-		if err != nil {
-			errBody, _ := GetCommonErrorBody(err)
-			if ContainsInStringList(errBody.ReturnCode, []string{ApiErrorNetworkAclCantAccessaApropriate, ApiErrorNetworkAclRuleChangeIngRules}) {
-				LogErrorResponse("retry AddNetworkAclRule", err, reqParams)
-				time.Sleep(time.Second * 5)
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
+		ctx = LogCommonRequest(ctx, "AddNetworkAclInboundRule", reqParams)
+		resp, err = config.Client.Vpc.V2Api.AddNetworkAclInboundRule(reqParams.(*vpc.AddNetworkAclInboundRuleRequest))
+	} else {
+		reqParams = &vpc.AddNetworkAclOutboundRuleRequest{
+			RegionCode:         &config.RegionCode,
+			NetworkAclNo:       ncloud.String(d.Id()),
+			NetworkAclRuleList: addNetworkRuleList,
 		}
-		return nil
-	})
+
+		ctx = LogCommonRequest(ctx, "AddNetworkAclOutboundRule", reqParams)
+		resp, err = config.Client.Vpc.V2Api.AddNetworkAclOutboundRule(reqParams.(*vpc.AddNetworkAclOutboundRuleRequest))
+	}
 
 	if err != nil {
-		LogErrorResponse("AddNetworkAclRule", err, reqParams)
+		LogErrorResponse(ctx, "AddNetworkAclRule", err, reqParams)
 		return err
 	}
 
-	LogResponse("AddNetworkAclRule", resp)
+	LogResponse(ctx, "AddNetworkAclRule", resp)
 
-	if err = waitForNcloudNetworkACLRunning(config, d.Id()); err != nil {
+	if err = waitForNcloudNetworkACLRunning(ctx, config, d.Id()); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func removeNetworkACLRule(d *schema.ResourceData, config *conn.ProviderConfig, ruleType string, removeNetworkRuleList []*vpc.RemoveNetworkAclRuleParameter) error {
+func removeNetworkACLRule(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig, ruleType string, removeNetworkRuleList []*vpc.RemoveNetworkAclRuleParameter) error {
 	var reqParams interface{}
 	var resp interface{}
+	var err error
 
-	err := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
-		var err error
-
-		if ruleType == "inbound" {
-			reqParams = &vpc.RemoveNetworkAclInboundRuleRequest{
-				RegionCode:         &config.RegionCode,
-				NetworkAclNo:       ncloud.String(d.Id()),
-				NetworkAclRuleList: removeNetworkRuleList,
-			}
-
-			LogCommonRequest("RemoveNetworkAclInboundRule", reqParams)
-			resp, err = config.Client.Vpc.V2Api.RemoveNetworkAclInboundRule(reqParams.(*vpc.RemoveNetworkAclInboundRuleRequest))
-		} else {
-			reqParams = &vpc.RemoveNetworkAclOutboundRuleRequest{
-				RegionCode:         &config.RegionCode,
-				NetworkAclNo:       ncloud.String(d.Id()),
-				NetworkAclRuleList: removeNetworkRuleList,
-			}
-
-			LogCommonRequest("RemoveNetworkAclOutboundRule", reqParams)
-			resp, err = config.Client.Vpc.V2Api.RemoveNetworkAclOutboundRule(reqParams.(*vpc.RemoveNetworkAclOutboundRuleRequest))
+	if ruleType == "inbound" {
+		reqParams = &vpc.RemoveNetworkAclInboundRuleRequest{
+			RegionCode:         &config.RegionCode,
+			NetworkAclNo:       ncloud.String(d.Id()),
+			NetworkAclRuleList: removeNetworkRuleList,
 		}
 
-		if err != nil {
-			errBody, _ := GetCommonErrorBody(err)
-			if ContainsInStringList(errBody.ReturnCode, []string{ApiErrorNetworkAclCantAccessaApropriate, ApiErrorNetworkAclRuleChangeIngRules}) {
-				LogErrorResponse("retry RemoveNetworkAclRule", err, reqParams)
-				time.Sleep(time.Second * 5)
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
+		ctx = LogCommonRequest(ctx, "RemoveNetworkAclInboundRule", reqParams)
+		resp, err = config.Client.Vpc.V2Api.RemoveNetworkAclInboundRule(reqParams.(*vpc.RemoveNetworkAclInboundRuleRequest))
+	} else {
+		reqParams = &vpc.RemoveNetworkAclOutboundRuleRequest{
+			RegionCode:         &config.RegionCode,
+			NetworkAclNo:       ncloud.String(d.Id()),
+			NetworkAclRuleList: removeNetworkRuleList,
 		}
-		return nil
-	})
+
+		ctx = LogCommonRequest(ctx, "RemoveNetworkAclOutboundRule", reqParams)
+		resp, err = config.Client.Vpc.V2Api.RemoveNetworkAclOutboundRule(reqParams.(*vpc.RemoveNetworkAclOutboundRuleRequest))
+	}
 
 	if err != nil {
-		LogErrorResponse("RemoveNetworkAclRule", err, reqParams)
+		LogErrorResponse(ctx, "RemoveNetworkAclRule", err, reqParams)
 		return err
 	}
 
-	LogResponse("RemoveNetworkAclRule", resp)
+	LogResponse(ctx, "RemoveNetworkAclRule", resp)
 
-	if err = waitForNcloudNetworkACLRunning(config, d.Id()); err != nil {
+	if err = waitForNcloudNetworkACLRunning(ctx, config, d.Id()); err != nil {
 		return err
 	}
 
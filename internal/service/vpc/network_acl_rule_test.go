@@ -1,13 +1,13 @@
 package vpc_test
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"regexp"
 	"testing"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vpc"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 
@@ -21,7 +21,7 @@ func TestAccResourceNcloudNetworkACLRule_basic(t *testing.T) {
 	var networkACLRule []*vpc.NetworkAclRule
 
 	resourceName := "ncloud_network_acl_rule.nacl_rule"
-	name := fmt.Sprintf("test-network-acl-rule-%s", acctest.RandString(5))
+	name := fmt.Sprintf("test-network-acl-rule-%s", RandString(t, 5))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { TestAccPreCheck(t) },
@@ -44,7 +44,7 @@ func TestAccResourceNcloudNetworkACLRule_basic(t *testing.T) {
 func TestAccResourceNcloudNetworkACLRule_AssociatedSubnet(t *testing.T) {
 	var networkACLRule []*vpc.NetworkAclRule
 
-	name := fmt.Sprintf("test-nacl-rule-subnet-%s", acctest.RandString(5))
+	name := fmt.Sprintf("test-nacl-rule-subnet-%s", RandString(t, 5))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { TestAccPreCheck(t) },
@@ -64,7 +64,7 @@ func TestAccResourceNcloudNetworkACLRule_AssociatedSubnet(t *testing.T) {
 func TestAccResourceNcloudNetworkACLRule_disappears(t *testing.T) {
 	var networkACLRule []*vpc.NetworkAclRule
 
-	name := fmt.Sprintf("test-network-acl-rule-%s", acctest.RandString(5))
+	name := fmt.Sprintf("test-network-acl-rule-%s", RandString(t, 5))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { TestAccPreCheck(t) },
@@ -236,7 +236,7 @@ func testAccCheckNetworkACLRuleExists(n string, networkACLRule *[]*vpc.NetworkAc
 
 		config := GetTestProvider(true).Meta().(*conn.ProviderConfig)
 
-		rules, err := vpcservice.GetNetworkACLRuleList(config, rs.Primary.ID)
+		rules, err := vpcservice.GetNetworkACLRuleList(context.Background(), config, rs.Primary.ID)
 
 		if err != nil {
 			return err
@@ -260,9 +260,8 @@ func testAccCheckNetworkACLRuleDestroy(s *terraform.State) error {
 			continue
 		}
 
-		rules, err := vpcservice.GetNetworkACLRuleList(config, rs.Primary.Attributes["network_acl_no"])
-		errBody, _ := common.GetCommonErrorBody(err)
-		if errBody.ReturnCode == common.ApiErrorNetworkAclCantAccessaApropriate {
+		rules, err := vpcservice.GetNetworkACLRuleList(context.Background(), config, rs.Primary.Attributes["network_acl_no"])
+		if common.HasReturnCode(err, common.ApiErrorNetworkAclCantAccessaApropriate) {
 			return nil
 		}
 
@@ -1,13 +1,13 @@
 package vpc_test
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"regexp"
 	"testing"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vpc"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 
@@ -18,7 +18,7 @@ import (
 
 func TestAccResourceNcloudNetworkACL_basic(t *testing.T) {
 	var networkACL vpc.NetworkAcl
-	name := fmt.Sprintf("test-network-acl-basic-%s", acctest.RandString(5))
+	name := fmt.Sprintf("test-network-acl-basic-%s", RandString(t, 5))
 	resourceName := "ncloud_network_acl.nacl"
 
 	resource.Test(t, resource.TestCase{
@@ -46,7 +46,7 @@ func TestAccResourceNcloudNetworkACL_basic(t *testing.T) {
 
 func TestAccResourceNcloudNetworkACL_disappears(t *testing.T) {
 	var networkACL vpc.NetworkAcl
-	name := fmt.Sprintf("test-network-acl-basic-%s", acctest.RandString(5))
+	name := fmt.Sprintf("test-network-acl-basic-%s", RandString(t, 5))
 	resourceName := "ncloud_network_acl.nacl"
 
 	resource.Test(t, resource.TestCase{
@@ -68,7 +68,7 @@ func TestAccResourceNcloudNetworkACL_disappears(t *testing.T) {
 
 func TestAccResourceNcloudNetworkACL_onlyRequiredParam(t *testing.T) {
 	var networkACL vpc.NetworkAcl
-	name := fmt.Sprintf("test-network-acl-basic-%s", acctest.RandString(5))
+	name := fmt.Sprintf("test-network-acl-basic-%s", RandString(t, 5))
 	resourceName := "ncloud_network_acl.nacl"
 
 	resource.Test(t, resource.TestCase{
@@ -96,7 +96,7 @@ func TestAccResourceNcloudNetworkACL_onlyRequiredParam(t *testing.T) {
 
 func TestAccResourceNcloudNetworkACL_updateName(t *testing.T) {
 	var networkACL vpc.NetworkAcl
-	name := fmt.Sprintf("test-network-acl-basic-%s", acctest.RandString(5))
+	name := fmt.Sprintf("test-network-acl-basic-%s", RandString(t, 5))
 	resourceName := "ncloud_network_acl.nacl"
 
 	resource.Test(t, resource.TestCase{
@@ -121,7 +121,7 @@ func TestAccResourceNcloudNetworkACL_updateName(t *testing.T) {
 
 func TestAccResourceNcloudNetworkACL_description(t *testing.T) {
 	var networkACL vpc.NetworkAcl
-	name := fmt.Sprintf("test-network-acl-desc-%s", acctest.RandString(5))
+	name := fmt.Sprintf("test-network-acl-desc-%s", RandString(t, 5))
 	resourceName := "ncloud_network_acl.nacl"
 
 	resource.Test(t, resource.TestCase{
@@ -196,7 +196,7 @@ func testAccCheckNetworkACLExists(n string, networkACL *vpc.NetworkAcl) resource
 		}
 
 		config := GetTestProvider(true).Meta().(*conn.ProviderConfig)
-		instance, err := vpcservice.GetNetworkACLInstance(config, rs.Primary.ID)
+		instance, err := vpcservice.GetNetworkACLInstance(context.Background(), config, rs.Primary.ID)
 		if err != nil {
 			return err
 		}
@@ -215,7 +215,7 @@ func testAccCheckNetworkACLDestroy(s *terraform.State) error {
 			continue
 		}
 
-		instance, err := vpcservice.GetNetworkACLInstance(config, rs.Primary.ID)
+		instance, err := vpcservice.GetNetworkACLInstance(context.Background(), config, rs.Primary.ID)
 
 		if err != nil {
 			return err
@@ -240,7 +240,7 @@ func testAccCheckNetworkACLDisappears(instance *vpc.NetworkAcl) resource.TestChe
 
 		_, err := config.Client.Vpc.V2Api.DeleteNetworkAcl(reqParams)
 
-		if err := vpcservice.WaitForNcloudNetworkACLDeletion(config, *instance.NetworkAclNo); err != nil {
+		if err := vpcservice.WaitForNcloudNetworkACLDeletion(context.Background(), config, *instance.NetworkAclNo); err != nil {
 			return err
 		}
 
@@ -1,20 +1,23 @@
 package vpc
 
 import (
+	"context"
 	"fmt"
 	"time"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vpc"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/paging"
 )
 
 func DataSourceNcloudNetworkAcls() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNcloudNetworkAclsRead,
+		ReadContext: dataSourceNcloudNetworkAclsRead,
 
 		Schema: map[string]*schema.Schema{
 			"network_acl_no_list": {
@@ -44,11 +47,11 @@ func DataSourceNcloudNetworkAcls() *schema.Resource {
 	}
 }
 
-func dataSourceNcloudNetworkAclsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceNcloudNetworkAclsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*conn.ProviderConfig)
 
 	if !config.SupportVPC {
-		return NotSupportClassic("data source `ncloud_network_acls`")
+		return diag.FromErr(NotSupportClassic("data source `ncloud_network_acls`"))
 	}
 
 	reqParams := &vpc.GetNetworkAclListRequest{
@@ -72,22 +75,31 @@ func dataSourceNcloudNetworkAclsRead(d *schema.ResourceData, meta interface{}) e
 		reqParams.VpcNo = ncloud.String(v.(string))
 	}
 
-	LogCommonRequest("GetNetworkAclList", reqParams)
-	resp, err := config.Client.Vpc.V2Api.GetNetworkAclList(reqParams)
+	networkAclList, err := paging.All(ctx, func(ctx context.Context, pageNo, pageSize int32) ([]*vpc.NetworkAcl, *int32, error) {
+		reqParams.PageNo = ncloud.Int32(pageNo)
+		reqParams.PageSize = ncloud.Int32(pageSize)
 
+		ctx = LogCommonRequest(ctx, "GetNetworkAclList", reqParams)
+		resp, err := config.Client.Vpc.V2Api.GetNetworkAclList(reqParams)
+		if err != nil {
+			LogErrorResponse(ctx, "GetNetworkAclList", err, reqParams)
+			return nil, nil, err
+		}
+		LogResponse(ctx, "GetNetworkAclList", resp)
+
+		return resp.NetworkAclList, resp.TotalRows, nil
+	})
 	if err != nil {
-		LogErrorResponse("GetNetworkAclList", err, reqParams)
-		return err
+		return diag.FromErr(err)
 	}
-	LogResponse("GetNetworkAclList", resp)
 
-	if resp == nil || len(resp.NetworkAclList) == 0 {
-		return fmt.Errorf("no matching Network ACL found")
+	if len(networkAclList) == 0 {
+		return diag.FromErr(fmt.Errorf("no matching Network ACL found"))
 	}
 
 	var resources []map[string]interface{}
 
-	for _, r := range resp.NetworkAclList {
+	for _, r := range networkAclList {
 		instance := map[string]interface{}{
 			"id":             *r.NetworkAclNo,
 			"network_acl_no": *r.NetworkAclNo,
@@ -106,7 +118,7 @@ func dataSourceNcloudNetworkAclsRead(d *schema.ResourceData, meta interface{}) e
 
 	d.SetId(time.Now().UTC().String())
 	if err := d.Set("network_acls", resources); err != nil {
-		return fmt.Errorf("Error setting Network ACLs: %s", err)
+		return diag.FromErr(fmt.Errorf("Error setting Network ACLs: %s", err))
 	}
 
 	return nil
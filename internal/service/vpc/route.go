@@ -2,6 +2,7 @@ package vpc
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -9,20 +10,22 @@ import (
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vpc"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/waiter"
 )
 
 func ResourceNcloudRoute() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNcloudRouteCreate,
-		Read:   resourceNcloudRouteRead,
-		Update: resourceNcloudRouteUpdate,
-		Delete: resourceNcloudRouteDelete,
+		CreateContext: resourceNcloudRouteCreate,
+		ReadContext:   resourceNcloudRouteRead,
+		UpdateContext: resourceNcloudRouteUpdate,
+		DeleteContext: resourceNcloudRouteDelete,
 		Importer: &schema.ResourceImporter{
 			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 				idParts := strings.Split(d.Id(), ":")
@@ -77,20 +80,20 @@ func ResourceNcloudRoute() *schema.Resource {
 	}
 }
 
-func resourceNcloudRouteCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudRouteCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*conn.ProviderConfig)
 
 	if !config.SupportVPC {
-		return NotSupportClassic("resource `resource_ncloud_route`")
+		return diag.FromErr(NotSupportClassic("resource `resource_ncloud_route`"))
 	}
 
-	routeTable, err := GetRouteTableInstance(config, d.Get("route_table_no").(string))
+	routeTable, err := GetRouteTableInstance(ctx, config, d.Get("route_table_no").(string))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if routeTable == nil {
-		return fmt.Errorf("No matching route table: %s", d.Get("route_table_no"))
+		return diag.FromErr(fmt.Errorf("No matching route table: %s", d.Get("route_table_no")))
 	}
 
 	routeParams := &vpc.RouteParameter{
@@ -107,49 +110,32 @@ func resourceNcloudRouteCreate(d *schema.ResourceData, meta interface{}) error {
 		RouteList:    []*vpc.RouteParameter{routeParams},
 	}
 
-	var resp *vpc.AddRouteResponse
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		var err error
-
-		LogCommonRequest("AddRoute", reqParams)
-		resp, err = config.Client.Vpc.V2Api.AddRoute(reqParams)
-
-		if err != nil {
-			errBody, _ := GetCommonErrorBody(err)
-			if errBody.ReturnCode == "1017013" {
-				LogErrorResponse("retry add Route", err, reqParams)
-				time.Sleep(time.Second * 5)
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		return nil
-	})
-
+	ctx = LogCommonRequest(ctx, "AddRoute", reqParams)
+	resp, err := config.Client.Vpc.V2Api.AddRoute(reqParams)
 	if err != nil {
-		LogErrorResponse("AddRoute", err, reqParams)
-		return err
+		LogErrorResponse(ctx, "AddRoute", err, reqParams)
+		return diag.FromErr(err)
 	}
 
-	LogResponse("AddRoute", resp)
+	LogResponse(ctx, "AddRoute", resp)
 
 	d.SetId(routeRuleHash(d.Get("route_table_no").(string), d.Get("destination_cidr_block").(string)))
 
 	log.Printf("[INFO] Route ID: %s", d.Id())
 
-	if err := WaitForNcloudRouteTableUpdate(config, d.Get("route_table_no").(string)); err != nil {
-		return err
+	if err := WaitForNcloudRouteTableUpdate(ctx, config, d.Get("route_table_no").(string)); err != nil {
+		return diag.FromErr(err)
 	}
 
-	return resourceNcloudRouteRead(d, meta)
+	return resourceNcloudRouteRead(ctx, d, meta)
 }
 
-func resourceNcloudRouteRead(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudRouteRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*conn.ProviderConfig)
 
-	routeTable, err := GetRouteTableInstance(config, d.Get("route_table_no").(string))
+	routeTable, err := GetRouteTableInstance(ctx, config, d.Get("route_table_no").(string))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if routeTable != nil {
@@ -159,13 +145,14 @@ func resourceNcloudRouteRead(d *schema.ResourceData, meta interface{}) error {
 		return nil
 	}
 
-	instance, err := getRouteInstance(config, d)
+	instance, err := getRouteInstance(ctx, config, d)
 	if err != nil {
-		errBody, _ := GetCommonErrorBody(err)
-		if errBody.ReturnCode == "1017007" { // Route Table was not found
+		if IsNotFound(err) {
+			log.Printf("[WARN] Route table of route %s was not found, removing from state", d.Id())
 			d.SetId("")
+			return nil
 		}
-		return err
+		return diag.FromErr(err)
 	}
 
 	if instance == nil {
@@ -184,11 +171,11 @@ func resourceNcloudRouteRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceNcloudRouteUpdate(d *schema.ResourceData, meta interface{}) error {
-	return resourceNcloudRouteRead(d, meta)
+func resourceNcloudRouteUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceNcloudRouteRead(ctx, d, meta)
 }
 
-func resourceNcloudRouteDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudRouteDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*conn.ProviderConfig)
 
 	routeParams := &vpc.RouteParameter{
@@ -205,73 +192,57 @@ func resourceNcloudRouteDelete(d *schema.ResourceData, meta interface{}) error {
 		RouteList:    []*vpc.RouteParameter{routeParams},
 	}
 
-	var resp *vpc.RemoveRouteResponse
-	err := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
-		var err error
-
-		LogCommonRequest("RemoveRoute", reqParams)
-		resp, err = config.Client.Vpc.V2Api.RemoveRoute(reqParams)
-
-		if err != nil {
-			errBody, _ := GetCommonErrorBody(err)
-			if errBody.ReturnCode == "1017013" {
-				LogErrorResponse("retry remove Route", err, reqParams)
-				time.Sleep(time.Second * 5)
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		return nil
-	})
-
+	ctx = LogCommonRequest(ctx, "RemoveRoute", reqParams)
+	resp, err := config.Client.Vpc.V2Api.RemoveRoute(reqParams)
 	if err != nil {
-		LogErrorResponse("RemoveRoute", err, reqParams)
-		return err
+		LogErrorResponse(ctx, "RemoveRoute", err, reqParams)
+		return diag.FromErr(err)
 	}
 
-	LogResponse("RemoveRoute", resp)
+	LogResponse(ctx, "RemoveRoute", resp)
 
-	if err := WaitForNcloudRouteTableUpdate(config, d.Get("route_table_no").(string)); err != nil {
-		return err
+	if err := WaitForNcloudRouteTableUpdate(ctx, config, d.Get("route_table_no").(string)); err != nil {
+		return diag.FromErr(err)
 	}
 
 	return nil
 }
 
-func WaitForNcloudRouteTableUpdate(config *conn.ProviderConfig, id string) error {
-	stateConf := &resource.StateChangeConf{
+func WaitForNcloudRouteTableUpdate(ctx context.Context, config *conn.ProviderConfig, id string) error {
+	stateConf := &waiter.Config[*vpc.RouteTable]{
 		Pending: []string{"SET"},
 		Target:  []string{"RUN"},
-		Refresh: func() (interface{}, string, error) {
-			instance, err := GetRouteTableInstance(config, id)
-			return VpcCommonStateRefreshFunc(instance, err, "RouteTableStatus")
-		},
-		Timeout:    conn.DefaultTimeout,
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Refresh: waiter.StatusOf(func(ctx context.Context) (*vpc.RouteTable, error) {
+			return GetRouteTableInstance(ctx, config, id)
+		}, func(instance *vpc.RouteTable) string {
+			return ncloud.StringValue(common.GetCodePtrByCommonCode(instance.RouteTableStatus))
+		}),
+		Timeout:     conn.DefaultTimeout,
+		Delay:       2 * time.Second,
+		MinInterval: 3 * time.Second,
 	}
 
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForState(ctx); err != nil {
 		return fmt.Errorf("Error waiting for Route Table (%s) to become running: %s", id, err)
 	}
 
 	return nil
 }
 
-func getRouteInstance(config *conn.ProviderConfig, d *schema.ResourceData) (*vpc.Route, error) {
+func getRouteInstance(ctx context.Context, config *conn.ProviderConfig, d *schema.ResourceData) (*vpc.Route, error) {
 	reqParams := &vpc.GetRouteListRequest{
 		RegionCode:   &config.RegionCode,
 		VpcNo:        ncloud.String(d.Get("vpc_no").(string)),
 		RouteTableNo: ncloud.String(d.Get("route_table_no").(string)),
 	}
 
-	LogCommonRequest("GetRouteList", reqParams)
+	ctx = LogCommonRequest(ctx, "GetRouteList", reqParams)
 	resp, err := config.Client.Vpc.V2Api.GetRouteList(reqParams)
 	if err != nil {
-		LogErrorResponse("GetRouteList", err, reqParams)
+		LogErrorResponse(ctx, "GetRouteList", err, reqParams)
 		return nil, err
 	}
-	LogResponse("GetRouteList", resp)
+	LogResponse(ctx, "GetRouteList", resp)
 
 	if resp.RouteList != nil {
 		for _, i := range resp.RouteList {
@@ -1,29 +1,32 @@
 package vpc
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vpc"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/verify"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/waiter"
 )
 
 func ResourceNcloudRouteTable() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNcloudRouteTableCreate,
-		Read:   resourceNcloudRouteTableRead,
-		Update: resourceNcloudRouteTableUpdate,
-		Delete: resourceNcloudRouteTableDelete,
+		CreateContext: resourceNcloudRouteTableCreate,
+		ReadContext:   resourceNcloudRouteTableRead,
+		UpdateContext: resourceNcloudRouteTableUpdate,
+		DeleteContext: resourceNcloudRouteTableDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"vpc_no": {
@@ -62,11 +65,11 @@ func ResourceNcloudRouteTable() *schema.Resource {
 	}
 }
 
-func resourceNcloudRouteTableCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudRouteTableCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*conn.ProviderConfig)
 
 	if !config.SupportVPC {
-		return NotSupportClassic("resource `ncloud_route_table`")
+		return diag.FromErr(NotSupportClassic("resource `ncloud_route_table`"))
 	}
 
 	reqParams := &vpc.CreateRouteTableRequest{
@@ -83,33 +86,33 @@ func resourceNcloudRouteTableCreate(d *schema.ResourceData, meta interface{}) er
 		reqParams.RouteTableDescription = ncloud.String(v.(string))
 	}
 
-	LogCommonRequest("CreateRouteTable", reqParams)
+	ctx = LogCommonRequest(ctx, "CreateRouteTable", reqParams)
 	resp, err := config.Client.Vpc.V2Api.CreateRouteTable(reqParams)
 	if err != nil {
-		LogErrorResponse("CreateRouteTable", err, reqParams)
-		return err
+		LogErrorResponse(ctx, "CreateRouteTable", err, reqParams)
+		return diag.FromErr(err)
 	}
 
-	LogResponse("CreateRouteTable", resp)
+	LogResponse(ctx, "CreateRouteTable", resp)
 
 	instance := resp.RouteTableList[0]
 	d.SetId(*instance.RouteTableNo)
 
 	log.Printf("[INFO] Route Table ID: %s", d.Id())
 
-	if err := waitForNcloudRouteTableCreation(config, d.Id()); err != nil {
-		return err
+	if err := waitForNcloudRouteTableCreation(ctx, config, d.Id()); err != nil {
+		return diag.FromErr(err)
 	}
 
-	return resourceNcloudRouteTableRead(d, meta)
+	return resourceNcloudRouteTableRead(ctx, d, meta)
 }
 
-func resourceNcloudRouteTableRead(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudRouteTableRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*conn.ProviderConfig)
 
-	instance, err := GetRouteTableInstance(config, d.Id())
+	instance, err := GetRouteTableInstance(ctx, config, d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if instance == nil {
@@ -128,19 +131,19 @@ func resourceNcloudRouteTableRead(d *schema.ResourceData, meta interface{}) erro
 	return nil
 }
 
-func resourceNcloudRouteTableUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudRouteTableUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*conn.ProviderConfig)
 
 	if d.HasChange("description") {
-		if err := setRouteTableDescription(d, config); err != nil {
-			return err
+		if err := setRouteTableDescription(ctx, d, config); err != nil {
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceNcloudRouteTableRead(d, meta)
+	return resourceNcloudRouteTableRead(ctx, d, meta)
 }
 
-func resourceNcloudRouteTableDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudRouteTableDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*conn.ProviderConfig)
 
 	reqParams := &vpc.DeleteRouteTableRequest{
@@ -148,75 +151,80 @@ func resourceNcloudRouteTableDelete(d *schema.ResourceData, meta interface{}) er
 		RouteTableNo: ncloud.String(d.Get("route_table_no").(string)),
 	}
 
-	LogCommonRequest("DeleteRouteTable", reqParams)
+	ctx = LogCommonRequest(ctx, "DeleteRouteTable", reqParams)
 	resp, err := config.Client.Vpc.V2Api.DeleteRouteTable(reqParams)
 	if err != nil {
-		LogErrorResponse("DeleteRouteTable", err, reqParams)
-		return err
+		LogErrorResponse(ctx, "DeleteRouteTable", err, reqParams)
+		return diag.FromErr(err)
 	}
 
-	LogResponse("DeleteRouteTable", resp)
+	LogResponse(ctx, "DeleteRouteTable", resp)
 
-	if err := WaitForNcloudRouteTableDeletion(config, d.Id()); err != nil {
-		return err
+	if err := WaitForNcloudRouteTableDeletion(ctx, config, d.Id()); err != nil {
+		return diag.FromErr(err)
 	}
 
 	return nil
 }
 
-func waitForNcloudRouteTableCreation(config *conn.ProviderConfig, id string) error {
-	stateConf := &resource.StateChangeConf{
+func waitForNcloudRouteTableCreation(ctx context.Context, config *conn.ProviderConfig, id string) error {
+	stateConf := &waiter.Config[*vpc.RouteTable]{
 		Pending: []string{"INIT", "CREATING"},
 		Target:  []string{"RUN"},
-		Refresh: func() (interface{}, string, error) {
-			instance, err := GetRouteTableInstance(config, id)
-			return VpcCommonStateRefreshFunc(instance, err, "RouteTableStatus")
-		},
-		Timeout:    conn.DefaultTimeout,
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Refresh: waiter.StatusOf(func(ctx context.Context) (*vpc.RouteTable, error) {
+			return GetRouteTableInstance(ctx, config, id)
+		}, func(instance *vpc.RouteTable) string {
+			return ncloud.StringValue(common.GetCodePtrByCommonCode(instance.RouteTableStatus))
+		}),
+		Timeout:     conn.DefaultTimeout,
+		Delay:       2 * time.Second,
+		MinInterval: 3 * time.Second,
 	}
 
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForState(ctx); err != nil {
 		return fmt.Errorf("Error waiting for Route Table (%s) to become running: %s", id, err)
 	}
 
 	return nil
 }
 
-func WaitForNcloudRouteTableDeletion(config *conn.ProviderConfig, id string) error {
-	stateConf := &resource.StateChangeConf{
+func WaitForNcloudRouteTableDeletion(ctx context.Context, config *conn.ProviderConfig, id string) error {
+	stateConf := &waiter.Config[*vpc.RouteTable]{
 		Pending: []string{"RUN", "TERMTING"},
 		Target:  []string{"TERMINATED"},
-		Refresh: func() (interface{}, string, error) {
-			instance, err := GetRouteTableInstance(config, id)
-			return VpcCommonStateRefreshFunc(instance, err, "RouteTableStatus")
-		},
-		Timeout:    conn.DefaultTimeout,
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Refresh: waiter.StatusOf(func(ctx context.Context) (*vpc.RouteTable, error) {
+			return GetRouteTableInstance(ctx, config, id)
+		}, func(instance *vpc.RouteTable) string {
+			return ncloud.StringValue(common.GetCodePtrByCommonCode(instance.RouteTableStatus))
+		}),
+		Timeout:     conn.DefaultTimeout,
+		Delay:       2 * time.Second,
+		MinInterval: 3 * time.Second,
 	}
 
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForState(ctx); err != nil {
 		return fmt.Errorf("Error waiting for Route Table (%s) to become termintaing: %s", id, err)
 	}
 
 	return nil
 }
 
-func GetRouteTableInstance(config *conn.ProviderConfig, id string) (*vpc.RouteTable, error) {
+func GetRouteTableInstance(ctx context.Context, config *conn.ProviderConfig, id string) (*vpc.RouteTable, error) {
 	reqParams := &vpc.GetRouteTableDetailRequest{
 		RegionCode:   &config.RegionCode,
 		RouteTableNo: ncloud.String(id),
 	}
 
-	LogCommonRequest("GetRouteTableDetail", reqParams)
+	ctx = LogCommonRequest(ctx, "GetRouteTableDetail", reqParams)
 	resp, err := config.Client.Vpc.V2Api.GetRouteTableDetail(reqParams)
 	if err != nil {
-		LogErrorResponse("GetRouteTableDetail", err, reqParams)
+		if IsNotFound(err) {
+			return nil, nil
+		}
+		LogErrorResponse(ctx, "GetRouteTableDetail", err, reqParams)
 		return nil, err
 	}
-	LogResponse("GetRouteTableDetail", resp)
+	LogResponse(ctx, "GetRouteTableDetail", resp)
 
 	if len(resp.RouteTableList) > 0 {
 		return resp.RouteTableList[0], nil
@@ -225,20 +233,20 @@ func GetRouteTableInstance(config *conn.ProviderConfig, id string) (*vpc.RouteTa
 	return nil, nil
 }
 
-func setRouteTableDescription(d *schema.ResourceData, config *conn.ProviderConfig) error {
+func setRouteTableDescription(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) error {
 	reqParams := &vpc.SetRouteTableDescriptionRequest{
 		RegionCode:            &config.RegionCode,
 		RouteTableNo:          ncloud.String(d.Id()),
 		RouteTableDescription: StringPtrOrNil(d.GetOk("description")),
 	}
 
-	LogCommonRequest("setRouteTableDescription", reqParams)
+	ctx = LogCommonRequest(ctx, "setRouteTableDescription", reqParams)
 	resp, err := config.Client.Vpc.V2Api.SetRouteTableDescription(reqParams)
 	if err != nil {
-		LogErrorResponse("setRouteTableDescription", err, reqParams)
+		LogErrorResponse(ctx, "setRouteTableDescription", err, reqParams)
 		return err
 	}
-	LogResponse("setRouteTableDescription", resp)
+	LogResponse(ctx, "setRouteTableDescription", resp)
 
 	return nil
 }
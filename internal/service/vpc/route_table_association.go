@@ -1,6 +1,7 @@
 package vpc
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -8,19 +9,21 @@ import (
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vpc"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/waiter"
 )
 
 func ResourceNcloudRouteTableAssociation() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNcloudRouteTableAssociationCreate,
-		Read:   resourceNcloudRouteTableAssociationRead,
-		Update: resourceNcloudRouteTableAssociationUpdate,
-		Delete: resourceNcloudRouteTableAssociationDelete,
+		CreateContext: resourceNcloudRouteTableAssociationCreate,
+		ReadContext:   resourceNcloudRouteTableAssociationRead,
+		UpdateContext: resourceNcloudRouteTableAssociationUpdate,
+		DeleteContext: resourceNcloudRouteTableAssociationDelete,
 		Importer: &schema.ResourceImporter{
 			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 				routeTableNo, subnetNo, err := convInstanceID(d.Id())
@@ -48,20 +51,20 @@ func ResourceNcloudRouteTableAssociation() *schema.Resource {
 	}
 }
 
-func resourceNcloudRouteTableAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudRouteTableAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*conn.ProviderConfig)
 
 	if !config.SupportVPC {
-		return NotSupportClassic("resource `ncloud_route_table_association`")
+		return diag.FromErr(NotSupportClassic("resource `ncloud_route_table_association`"))
 	}
 
-	routeTable, err := GetRouteTableInstance(config, d.Get("route_table_no").(string))
+	routeTable, err := GetRouteTableInstance(ctx, config, d.Get("route_table_no").(string))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if routeTable == nil {
-		return fmt.Errorf("No matching route table: %s", d.Get("route_table_no"))
+		return diag.FromErr(fmt.Errorf("No matching route table: %s", d.Get("route_table_no")))
 	}
 
 	reqParams := &vpc.AddRouteTableSubnetRequest{
@@ -71,41 +74,41 @@ func resourceNcloudRouteTableAssociationCreate(d *schema.ResourceData, meta inte
 		SubnetNoList: []*string{ncloud.String(d.Get("subnet_no").(string))},
 	}
 
-	LogCommonRequest("AddRouteTableSubnet", reqParams)
+	ctx = LogCommonRequest(ctx, "AddRouteTableSubnet", reqParams)
 	resp, err := config.Client.Vpc.V2Api.AddRouteTableSubnet(reqParams)
 	if err != nil {
-		LogErrorResponse("AddRouteTableSubnet", err, reqParams)
-		return err
+		LogErrorResponse(ctx, "AddRouteTableSubnet", err, reqParams)
+		return diag.FromErr(err)
 	}
 
-	LogResponse("AddRouteTableSubnet", resp)
+	LogResponse(ctx, "AddRouteTableSubnet", resp)
 
 	d.SetId(convAssociationID(d.Get("route_table_no").(string), d.Get("subnet_no").(string)))
 
 	log.Printf("[INFO] Association ID: %s", d.Id())
 
-	if err := WaitForNcloudRouteTableAssociationTableUpdate(config, d.Get("route_table_no").(string)); err != nil {
-		return err
+	if err := WaitForNcloudRouteTableAssociationTableUpdate(ctx, config, d.Get("route_table_no").(string)); err != nil {
+		return diag.FromErr(err)
 	}
 
-	return resourceNcloudRouteTableAssociationRead(d, meta)
+	return resourceNcloudRouteTableAssociationRead(ctx, d, meta)
 }
 
-func resourceNcloudRouteTableAssociationRead(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudRouteTableAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*conn.ProviderConfig)
 
-	routeTable, err := GetRouteTableInstance(config, d.Get("route_table_no").(string))
+	routeTable, err := GetRouteTableInstance(ctx, config, d.Get("route_table_no").(string))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if routeTable == nil {
-		return fmt.Errorf("No matching route table: %s", d.Get("route_table_no"))
+		return diag.FromErr(fmt.Errorf("No matching route table: %s", d.Get("route_table_no")))
 	}
 
-	instance, err := GetRouteTableAssociationInstance(config, d.Id())
+	instance, err := GetRouteTableAssociationInstance(ctx, config, d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if instance == nil {
@@ -120,20 +123,20 @@ func resourceNcloudRouteTableAssociationRead(d *schema.ResourceData, meta interf
 	return nil
 }
 
-func resourceNcloudRouteTableAssociationUpdate(d *schema.ResourceData, meta interface{}) error {
-	return resourceNcloudRouteTableAssociationRead(d, meta)
+func resourceNcloudRouteTableAssociationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceNcloudRouteTableAssociationRead(ctx, d, meta)
 }
 
-func resourceNcloudRouteTableAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudRouteTableAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*conn.ProviderConfig)
 
-	routeTable, err := GetRouteTableInstance(config, d.Get("route_table_no").(string))
+	routeTable, err := GetRouteTableInstance(ctx, config, d.Get("route_table_no").(string))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if routeTable == nil {
-		return fmt.Errorf("No matching route table: %s", d.Get("route_table_no"))
+		return diag.FromErr(fmt.Errorf("No matching route table: %s", d.Get("route_table_no")))
 	}
 
 	reqParams := &vpc.RemoveRouteTableSubnetRequest{
@@ -143,43 +146,44 @@ func resourceNcloudRouteTableAssociationDelete(d *schema.ResourceData, meta inte
 		SubnetNoList: []*string{ncloud.String(d.Get("subnet_no").(string))},
 	}
 
-	LogCommonRequest("RemoveRouteTableSubnet", reqParams)
+	ctx = LogCommonRequest(ctx, "RemoveRouteTableSubnet", reqParams)
 	resp, err := config.Client.Vpc.V2Api.RemoveRouteTableSubnet(reqParams)
 	if err != nil {
-		LogErrorResponse("RemoveRouteTableSubnet", err, reqParams)
-		return err
+		LogErrorResponse(ctx, "RemoveRouteTableSubnet", err, reqParams)
+		return diag.FromErr(err)
 	}
 
-	LogResponse("RemoveRouteTableSubnet", resp)
+	LogResponse(ctx, "RemoveRouteTableSubnet", resp)
 
-	if err := WaitForNcloudRouteTableAssociationTableUpdate(config, d.Get("route_table_no").(string)); err != nil {
-		return err
+	if err := WaitForNcloudRouteTableAssociationTableUpdate(ctx, config, d.Get("route_table_no").(string)); err != nil {
+		return diag.FromErr(err)
 	}
 
 	return nil
 }
 
-func WaitForNcloudRouteTableAssociationTableUpdate(config *conn.ProviderConfig, id string) error {
-	stateConf := &resource.StateChangeConf{
+func WaitForNcloudRouteTableAssociationTableUpdate(ctx context.Context, config *conn.ProviderConfig, id string) error {
+	stateConf := &waiter.Config[*vpc.RouteTable]{
 		Pending: []string{"SET"},
 		Target:  []string{"RUN"},
-		Refresh: func() (interface{}, string, error) {
-			instance, err := GetRouteTableInstance(config, id)
-			return VpcCommonStateRefreshFunc(instance, err, "RouteTableStatus")
-		},
-		Timeout:    conn.DefaultTimeout,
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Refresh: waiter.StatusOf(func(ctx context.Context) (*vpc.RouteTable, error) {
+			return GetRouteTableInstance(ctx, config, id)
+		}, func(instance *vpc.RouteTable) string {
+			return ncloud.StringValue(common.GetCodePtrByCommonCode(instance.RouteTableStatus))
+		}),
+		Timeout:     conn.DefaultTimeout,
+		Delay:       2 * time.Second,
+		MinInterval: 3 * time.Second,
 	}
 
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForState(ctx); err != nil {
 		return fmt.Errorf("Error waiting for Route Table (%s) to become running: %s", id, err)
 	}
 
 	return nil
 }
 
-func GetRouteTableAssociationInstance(config *conn.ProviderConfig, id string) (*vpc.Subnet, error) {
+func GetRouteTableAssociationInstance(ctx context.Context, config *conn.ProviderConfig, id string) (*vpc.Subnet, error) {
 	routeTableNo, subnetNo, err := convInstanceID(id)
 
 	if err != nil {
@@ -191,13 +195,13 @@ func GetRouteTableAssociationInstance(config *conn.ProviderConfig, id string) (*
 		RouteTableNo: ncloud.String(routeTableNo),
 	}
 
-	LogCommonRequest("GetRouteTableSubnetList", reqParams)
+	ctx = LogCommonRequest(ctx, "GetRouteTableSubnetList", reqParams)
 	resp, err := config.Client.Vpc.V2Api.GetRouteTableSubnetList(reqParams)
 	if err != nil {
-		LogErrorResponse("GetRouteTableSubnetList", err, reqParams)
+		LogErrorResponse(ctx, "GetRouteTableSubnetList", err, reqParams)
 		return nil, err
 	}
-	LogResponse("GetRouteTableSubnetList", resp)
+	LogResponse(ctx, "GetRouteTableSubnetList", resp)
 
 	if resp.SubnetList != nil {
 		for _, i := range resp.SubnetList {
@@ -1,13 +1,13 @@
 package vpc_test
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"testing"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vpc"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 
@@ -21,7 +21,7 @@ func TestAccresourceNcloudRouteTableAssociation_basic(t *testing.T) {
 	var association vpc.Subnet
 	var routeTableNo string
 
-	name := fmt.Sprintf("test-assoc-basic-%s", acctest.RandString(5))
+	name := fmt.Sprintf("test-assoc-basic-%s", RandString(t, 5))
 	resourceName := "ncloud_route_table_association.test"
 
 	resource.Test(t, resource.TestCase{
@@ -50,7 +50,7 @@ func TestAccresourceNcloudRouteTableAssociation_disappears(t *testing.T) {
 	var association vpc.Subnet
 	var routeTableNo string
 
-	name := fmt.Sprintf("test-route-disappear-%s", acctest.RandString(5))
+	name := fmt.Sprintf("test-route-disappear-%s", RandString(t, 5))
 	resourceName := "ncloud_route_table_association.test"
 
 	resource.Test(t, resource.TestCase{
@@ -120,13 +120,13 @@ func testAccCheckRouteTableAssociationExists(n string, subnet *vpc.Subnet, route
 			RouteTableNo: ncloud.String(rs.Primary.Attributes["route_table_no"]),
 		}
 
-		LogCommonRequest("GetRouteTableSubnetList", reqParams)
+		LogCommonRequest(context.Background(), "GetRouteTableSubnetList", reqParams)
 		resp, err := config.Client.Vpc.V2Api.GetRouteTableSubnetList(reqParams)
 		if err != nil {
-			LogErrorResponse("GetRouteTableSubnetList", err, reqParams)
+			LogErrorResponse(context.Background(), "GetRouteTableSubnetList", err, reqParams)
 			return fmt.Errorf("Not found: %s", n)
 		}
-		LogResponse("GetRouteTableSubnetList", resp)
+		LogResponse(context.Background(), "GetRouteTableSubnetList", resp)
 
 		if resp.SubnetList != nil {
 			for _, i := range resp.SubnetList {
@@ -162,7 +162,7 @@ func testAccCheckRouteTableAssociationDestroy(s *terraform.State) error {
 			continue
 		}
 
-		routeTable, err := vpcservice.GetRouteTableInstance(config, rs.Primary.Attributes["route_table_no"])
+		routeTable, err := vpcservice.GetRouteTableInstance(context.Background(), config, rs.Primary.Attributes["route_table_no"])
 
 		if err != nil {
 			return err
@@ -172,7 +172,7 @@ func testAccCheckRouteTableAssociationDestroy(s *terraform.State) error {
 			return nil
 		}
 
-		instance, err := vpcservice.GetRouteTableAssociationInstance(config, rs.Primary.ID)
+		instance, err := vpcservice.GetRouteTableAssociationInstance(context.Background(), config, rs.Primary.ID)
 
 		if err != nil {
 			return err
@@ -190,7 +190,7 @@ func testAccCheckRouteTableAssociationDisappears(instance *vpc.Subnet, routeTabl
 	return func(s *terraform.State) error {
 		config := GetTestProvider(true).Meta().(*conn.ProviderConfig)
 
-		routeTable, err := vpcservice.GetRouteTableInstance(config, *routeTableNo)
+		routeTable, err := vpcservice.GetRouteTableInstance(context.Background(), config, *routeTableNo)
 		if err != nil {
 			return err
 		}
@@ -208,7 +208,7 @@ func testAccCheckRouteTableAssociationDisappears(instance *vpc.Subnet, routeTabl
 
 		_, err = config.Client.Vpc.V2Api.RemoveRouteTableSubnet(reqParams)
 
-		if err := vpcservice.WaitForNcloudRouteTableAssociationTableUpdate(config, *routeTableNo); err != nil {
+		if err := vpcservice.WaitForNcloudRouteTableAssociationTableUpdate(context.Background(), config, *routeTableNo); err != nil {
 			return err
 		}
 
@@ -1,12 +1,16 @@
 package vpc
 
 import (
+	"context"
+
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vpc"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/paging"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/verify"
 )
 
@@ -35,24 +39,24 @@ func DataSourceNcloudRouteTable() *schema.Resource {
 		"filter": DataSourceFiltersSchema(),
 	}
 
-	return GetSingularDataSourceItemSchema(ResourceNcloudRouteTable(), fieldMap, dataSourceNcloudRouteTableRead)
+	return GetSingularDataSourceItemSchemaContext(ResourceNcloudRouteTable(), fieldMap, dataSourceNcloudRouteTableRead)
 }
 
-func dataSourceNcloudRouteTableRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceNcloudRouteTableRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*conn.ProviderConfig)
 
 	if !config.SupportVPC {
-		return NotSupportClassic("data source `ncloud_route_table`")
+		return diag.FromErr(NotSupportClassic("data source `ncloud_route_table`"))
 	}
 
-	resources, err := getRouteTableListFiltered(d, config)
+	resources, err := getRouteTableListFiltered(ctx, d, config)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := verify.ValidateOneResult(len(resources)); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	SetSingularResourceDataFromMap(d, resources[0])
@@ -60,7 +64,7 @@ func dataSourceNcloudRouteTableRead(d *schema.ResourceData, meta interface{}) er
 	return nil
 }
 
-func getRouteTableList(d *schema.ResourceData, config *conn.ProviderConfig) (*vpc.GetRouteTableListResponse, error) {
+func getRouteTableList(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) ([]*vpc.RouteTable, error) {
 	reqParams := &vpc.GetRouteTableListRequest{
 		RegionCode: &config.RegionCode,
 	}
@@ -81,20 +85,28 @@ func getRouteTableList(d *schema.ResourceData, config *conn.ProviderConfig) (*vp
 		reqParams.RouteTableNoList = []*string{ncloud.String(v.(string))}
 	}
 
-	LogCommonRequest("GetRouteTableList", reqParams)
-	resp, err := config.Client.Vpc.V2Api.GetRouteTableList(reqParams)
+	routeTableList, err := paging.All(ctx, func(ctx context.Context, pageNo, pageSize int32) ([]*vpc.RouteTable, *int32, error) {
+		reqParams.PageNo = ncloud.Int32(pageNo)
+		reqParams.PageSize = ncloud.Int32(pageSize)
+
+		ctx = LogCommonRequest(ctx, "GetRouteTableList", reqParams)
+		resp, err := config.Client.Vpc.V2Api.GetRouteTableList(reqParams)
+		if err != nil {
+			LogErrorResponse(ctx, "GetRouteTableList", err, reqParams)
+			return nil, nil, err
+		}
+		LogResponse(ctx, "GetRouteTableList", resp)
 
+		return resp.RouteTableList, resp.TotalRows, nil
+	})
 	if err != nil {
-		LogErrorResponse("GetRouteTableList", err, reqParams)
 		return nil, err
 	}
-
-	LogResponse("GetRouteTableList", resp)
-	return resp, nil
+	return routeTableList, nil
 }
 
-func getRouteTableListFiltered(d *schema.ResourceData, config *conn.ProviderConfig) ([]map[string]interface{}, error) {
-	resp, err := getRouteTableList(d, config)
+func getRouteTableListFiltered(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) ([]map[string]interface{}, error) {
+	routeTableList, err := getRouteTableList(ctx, d, config)
 
 	if err != nil {
 		return nil, err
@@ -102,7 +114,7 @@ func getRouteTableListFiltered(d *schema.ResourceData, config *conn.ProviderConf
 
 	resources := []map[string]interface{}{}
 
-	for _, r := range resp.RouteTableList {
+	for _, r := range routeTableList {
 		instance := map[string]interface{}{
 			"id":                    *r.RouteTableNo,
 			"route_table_no":        *r.RouteTableNo,
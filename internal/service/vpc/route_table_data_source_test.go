@@ -4,14 +4,13 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/acctest"
 )
 
 func TestAccDataSourceNcloudRouteTable_basic(t *testing.T) {
-	name := fmt.Sprintf("test-table-basic-%s", acctest.RandString(5))
+	name := fmt.Sprintf("test-table-basic-%s", RandString(t, 5))
 	resourceName := "ncloud_route_table.foo"
 	dataName := "data.ncloud_route_table.by_id"
 
@@ -1,13 +1,13 @@
 package vpc_test
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"regexp"
 	"testing"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vpc"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 
@@ -19,7 +19,7 @@ import (
 func TestAccResourceNcloudRouteTable_basic(t *testing.T) {
 	var routeTable vpc.RouteTable
 	resourceName := "ncloud_route_table.foo"
-	name := fmt.Sprintf("test-table-basic-%s", acctest.RandString(5))
+	name := fmt.Sprintf("test-table-basic-%s", RandString(t, 5))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { TestAccPreCheck(t) },
@@ -47,7 +47,7 @@ func TestAccResourceNcloudRouteTable_basic(t *testing.T) {
 func TestAccResourceNcloudRouteTable_disappears(t *testing.T) {
 	var routeTable vpc.RouteTable
 	resourceName := "ncloud_route_table.foo"
-	name := fmt.Sprintf("test-table-disappear-%s", acctest.RandString(5))
+	name := fmt.Sprintf("test-table-disappear-%s", RandString(t, 5))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { TestAccPreCheck(t) },
@@ -69,7 +69,7 @@ func TestAccResourceNcloudRouteTable_disappears(t *testing.T) {
 func TestAccResourceNcloudRouteTable_onlyRequiredParam(t *testing.T) {
 	var routeTable vpc.RouteTable
 	resourceName := "ncloud_route_table.foo"
-	name := fmt.Sprintf("test-table-required-%s", acctest.RandString(5))
+	name := fmt.Sprintf("test-table-required-%s", RandString(t, 5))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { TestAccPreCheck(t) },
@@ -97,7 +97,7 @@ func TestAccResourceNcloudRouteTable_onlyRequiredParam(t *testing.T) {
 func TestAccResourceNcloudRouteTable_updateName(t *testing.T) {
 	var routeTable vpc.RouteTable
 	resourceName := "ncloud_route_table.foo"
-	name := fmt.Sprintf("test-table-update-%s", acctest.RandString(5))
+	name := fmt.Sprintf("test-table-update-%s", RandString(t, 5))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { TestAccPreCheck(t) },
@@ -122,7 +122,7 @@ func TestAccResourceNcloudRouteTable_updateName(t *testing.T) {
 func TestAccResourceNcloudRouteTable_description(t *testing.T) {
 	var routeTable vpc.RouteTable
 	resourceName := "ncloud_route_table.foo"
-	name := fmt.Sprintf("test-table-desc-%s", acctest.RandString(5))
+	name := fmt.Sprintf("test-table-desc-%s", RandString(t, 5))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { TestAccPreCheck(t) },
@@ -198,7 +198,7 @@ func testAccCheckRouteTableExists(n string, routeTable *vpc.RouteTable) resource
 		}
 
 		config := GetTestProvider(true).Meta().(*conn.ProviderConfig)
-		instance, err := vpcservice.GetRouteTableInstance(config, rs.Primary.ID)
+		instance, err := vpcservice.GetRouteTableInstance(context.Background(), config, rs.Primary.ID)
 		if err != nil {
 			return err
 		}
@@ -217,7 +217,7 @@ func testAccCheckRouteTableDestroy(s *terraform.State) error {
 			continue
 		}
 
-		instance, err := vpcservice.GetRouteTableInstance(config, rs.Primary.ID)
+		instance, err := vpcservice.GetRouteTableInstance(context.Background(), config, rs.Primary.ID)
 
 		if err != nil {
 			return err
@@ -242,7 +242,7 @@ func testAccCheckRouteTableDisappears(instance *vpc.RouteTable) resource.TestChe
 
 		_, err := config.Client.Vpc.V2Api.DeleteRouteTable(reqParams)
 
-		if err := vpcservice.WaitForNcloudRouteTableDeletion(config, *instance.RouteTableNo); err != nil {
+		if err := vpcservice.WaitForNcloudRouteTableDeletion(context.Background(), config, *instance.RouteTableNo); err != nil {
 			return err
 		}
 
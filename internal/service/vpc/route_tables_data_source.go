@@ -1,9 +1,11 @@
 package vpc
 
 import (
+	"context"
 	"fmt"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/common"
@@ -12,7 +14,7 @@ import (
 
 func DataSourceNcloudRouteTables() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNcloudRouteTablesRead,
+		ReadContext: dataSourceNcloudRouteTablesRead,
 
 		Schema: map[string]*schema.Schema{
 			"vpc_no": {
@@ -37,22 +39,22 @@ func DataSourceNcloudRouteTables() *schema.Resource {
 	}
 }
 
-func dataSourceNcloudRouteTablesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceNcloudRouteTablesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*conn.ProviderConfig)
 
 	if !config.SupportVPC {
-		return NotSupportClassic("data source `ncloud_route_tables`")
+		return diag.FromErr(NotSupportClassic("data source `ncloud_route_tables`"))
 	}
 
-	resources, err := getRouteTableListFiltered(d, config)
+	resources, err := getRouteTableListFiltered(ctx, d, config)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(time.Now().UTC().String())
 	if err := d.Set("route_tables", resources); err != nil {
-		return fmt.Errorf("Error setting route tables: %s", err)
+		return diag.FromErr(fmt.Errorf("Error setting route tables: %s", err))
 	}
 
 	return nil
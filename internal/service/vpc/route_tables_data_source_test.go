@@ -5,7 +5,6 @@ import (
 	"regexp"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/acctest"
@@ -28,7 +27,7 @@ func TestAccDataSourceNcloudRouteTablesBasic(t *testing.T) {
 
 func TestAccDataSourceNcloudRouteTablesFilter(t *testing.T) {
 	dataName := "data.ncloud_route_tables.filter"
-	name := fmt.Sprintf("test-rt-data-%s", acctest.RandString(5))
+	name := fmt.Sprintf("test-rt-data-%s", RandString(t, 5))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { TestAccPreCheck(t) },
@@ -64,7 +63,7 @@ func TestAccDataSourceNcloudRouteTablesName(t *testing.T) {
 }
 
 func TestAccDataSourceNcloudRouteTablesVpcNo(t *testing.T) {
-	name := fmt.Sprintf("test-table-data-%s", acctest.RandString(5))
+	name := fmt.Sprintf("test-table-data-%s", RandString(t, 5))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { TestAccPreCheck(t) },
@@ -1,13 +1,13 @@
 package vpc_test
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"testing"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vpc"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 
@@ -19,7 +19,7 @@ import (
 
 func TestAccresourceNcloudRoute_basic(t *testing.T) {
 	var route vpc.Route
-	name := fmt.Sprintf("test-route-basic-%s", acctest.RandString(5))
+	name := fmt.Sprintf("test-route-basic-%s", RandString(t, 5))
 	resourceName := "ncloud_route.foo"
 
 	resource.Test(t, resource.TestCase{
@@ -45,7 +45,7 @@ func TestAccresourceNcloudRoute_basic(t *testing.T) {
 
 func TestAccresourceNcloudRoute_disappears(t *testing.T) {
 	var route vpc.Route
-	name := fmt.Sprintf("test-route-disappear-%s", acctest.RandString(5))
+	name := fmt.Sprintf("test-route-disappear-%s", RandString(t, 5))
 	resourceName := "ncloud_route.foo"
 
 	resource.Test(t, resource.TestCase{
@@ -122,13 +122,13 @@ func testAccCheckRouteExists(n string, route *vpc.Route) resource.TestCheckFunc
 			RouteTableNo: ncloud.String(rs.Primary.Attributes["route_table_no"]),
 		}
 
-		LogCommonRequest("GetRouteList", reqParams)
+		LogCommonRequest(context.Background(), "GetRouteList", reqParams)
 		resp, err := config.Client.Vpc.V2Api.GetRouteList(reqParams)
 		if err != nil {
-			LogErrorResponse("GetRouteList", err, reqParams)
+			LogErrorResponse(context.Background(), "GetRouteList", err, reqParams)
 			return fmt.Errorf("Not found: %s", n)
 		}
-		LogResponse("GetRouteList", resp)
+		LogResponse(context.Background(), "GetRouteList", resp)
 
 		if resp.RouteList != nil {
 			for _, i := range resp.RouteList {
@@ -164,7 +164,7 @@ func testAccCheckRouteDestroy(s *terraform.State) error {
 			continue
 		}
 
-		instance, err := vpcservice.GetRouteTableInstance(config, rs.Primary.Attributes["route_table_no"])
+		instance, err := vpcservice.GetRouteTableInstance(context.Background(), config, rs.Primary.Attributes["route_table_no"])
 
 		if err != nil {
 			return err
@@ -181,7 +181,7 @@ func testAccCheckRouteDestroy(s *terraform.State) error {
 
 		resp, err := config.Client.Vpc.V2Api.GetRouteList(reqParams)
 		if err != nil {
-			LogErrorResponse("GetRouteList", err, reqParams)
+			LogErrorResponse(context.Background(), "GetRouteList", err, reqParams)
 			return err
 		}
 
@@ -202,7 +202,7 @@ func testAccCheckRouteDisappears(instance *vpc.Route) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		config := GetTestProvider(true).Meta().(*conn.ProviderConfig)
 
-		routeTable, err := vpcservice.GetRouteTableInstance(config, *instance.RouteTableNo)
+		routeTable, err := vpcservice.GetRouteTableInstance(context.Background(), config, *instance.RouteTableNo)
 		if err != nil {
 			return err
 		}
@@ -226,7 +226,7 @@ func testAccCheckRouteDisappears(instance *vpc.Route) resource.TestCheckFunc {
 
 		_, err = config.Client.Vpc.V2Api.RemoveRoute(reqParams)
 
-		if err := vpcservice.WaitForNcloudRouteTableUpdate(config, *instance.RouteTableNo); err != nil {
+		if err := vpcservice.WaitForNcloudRouteTableUpdate(context.Background(), config, *instance.RouteTableNo); err != nil {
 			return err
 		}
 
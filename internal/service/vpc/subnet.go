@@ -2,15 +2,16 @@ package vpc
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
+
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
-	"time"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vpc"
-	sdkresource "github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -23,10 +24,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/framework"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/verify"
-)
-
-const (
-	SubnetPleaseTryAgainErrorCode = "3000"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/waiter"
 )
 
 var (
@@ -165,28 +163,10 @@ func (s *subnetResource) Create(ctx context.Context, req resource.CreateRequest,
 		reqParams.UsageTypeCode = plan.UsageType.ValueStringPointer()
 	}
 
-	timeout := time.Minute * 20
-	var response *vpc.CreateSubnetResponse
-
-	err := sdkresource.RetryContext(ctx, timeout, func() *sdkresource.RetryError {
-		var err error
-		tflog.Info(ctx, "CreateSubnet", map[string]any{
-			"reqParams": common.MarshalUncheckedString(reqParams),
-		})
-		response, err = s.config.Client.Vpc.V2Api.CreateSubnet(reqParams)
-
-		if err != nil {
-			errBody, _ := common.GetCommonErrorBody(err)
-			if errBody.ReturnCode == "1001015" || errBody.ReturnCode == SubnetPleaseTryAgainErrorCode {
-				common.LogErrorResponse("retry CreateSubnet", err, reqParams)
-				time.Sleep(time.Second * 5)
-				return sdkresource.RetryableError(err)
-			}
-			return sdkresource.NonRetryableError(err)
-		}
-		return nil
+	tflog.Info(ctx, "CreateSubnet", map[string]any{
+		"reqParams": common.MarshalUncheckedString(reqParams),
 	})
-
+	response, err := s.config.Client.Vpc.V2Api.CreateSubnet(reqParams)
 	if err != nil {
 		resp.Diagnostics.AddError("fail to create subnet", err.Error())
 		return
@@ -195,7 +175,7 @@ func (s *subnetResource) Create(ctx context.Context, req resource.CreateRequest,
 	subnetInstance := response.SubnetList[0]
 	plan.ID = types.StringPointerValue(subnetInstance.SubnetNo)
 
-	output, err := waitForNcloudSubnetCreation(s.config, *subnetInstance.SubnetNo)
+	output, err := waitForNcloudSubnetCreation(ctx, s.config, *subnetInstance.SubnetNo)
 	if err != nil {
 		resp.Diagnostics.AddError("waiting for Subnet creation", err.Error())
 		return
@@ -215,7 +195,7 @@ func (s *subnetResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
-	output, err := GetSubnetInstance(s.config, state.ID.ValueString())
+	output, err := GetSubnetInstance(ctx, s.config, state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("GetSubnet", err.Error())
 		return
@@ -271,14 +251,14 @@ func (s *subnetResource) Update(ctx context.Context, req resource.UpdateRequest,
 			"updateSubnetResponse": common.MarshalUncheckedString(response),
 		})
 
-		if err := waitForNcloudNetworkACLUpdate(s.config, plan.NetworkAclNo.ValueString()); err != nil {
+		if err := waitForNcloudNetworkACLUpdate(ctx, s.config, plan.NetworkAclNo.ValueString()); err != nil {
 			resp.Diagnostics.AddError(
 				"fail to wait for subnet update",
 				err.Error(),
 			)
 		}
 
-		output, err := GetSubnetInstance(s.config, state.ID.ValueString())
+		output, err := GetSubnetInstance(ctx, s.config, state.ID.ValueString())
 		if err != nil {
 			resp.Diagnostics.AddError("GetSubnet", err.Error())
 			return
@@ -320,7 +300,7 @@ func (s *subnetResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		"deleteSubnetResponse": common.MarshalUncheckedString(response),
 	})
 
-	if err := WaitForNcloudSubnetDeletion(s.config, state.ID.ValueString()); err != nil {
+	if err := WaitForNcloudSubnetDeletion(ctx, s.config, state.ID.ValueString()); err != nil {
 		resp.Diagnostics.AddError(
 			"fail to wait for subnet deletion",
 			err.Error(),
@@ -328,81 +308,83 @@ func (s *subnetResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	}
 }
 
-func waitForNcloudSubnetCreation(config *conn.ProviderConfig, id string) (*vpc.Subnet, error) {
-	var subnetInstance *vpc.Subnet
-	stateConf := &sdkresource.StateChangeConf{
+func waitForNcloudSubnetCreation(ctx context.Context, config *conn.ProviderConfig, id string) (*vpc.Subnet, error) {
+	stateConf := &waiter.Config[*vpc.Subnet]{
 		Pending: []string{"INIT", "CREATING"},
 		Target:  []string{"RUN"},
-		Refresh: func() (interface{}, string, error) {
-			instance, err := GetSubnetInstance(config, id)
-			subnetInstance = instance
-			return VpcCommonStateRefreshFunc(instance, err, "SubnetStatus")
-		},
-		Timeout:    conn.DefaultCreateTimeout,
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Refresh: waiter.StatusOf(func(ctx context.Context) (*vpc.Subnet, error) {
+			return GetSubnetInstance(ctx, config, id)
+		}, func(instance *vpc.Subnet) string {
+			return ncloud.StringValue(common.GetCodePtrByCommonCode(instance.SubnetStatus))
+		}),
+		Timeout:     conn.DefaultCreateTimeout,
+		Delay:       2 * time.Second,
+		MinInterval: 3 * time.Second,
 	}
 
-	if _, err := stateConf.WaitForState(); err != nil {
+	subnetInstance, err := stateConf.WaitForState(ctx)
+	if err != nil {
 		return nil, fmt.Errorf("Error waiting for Subnet (%s) to become available: %s", id, err)
 	}
 
 	return subnetInstance, nil
 }
 
-func waitForNcloudNetworkACLUpdate(config *conn.ProviderConfig, id string) error {
-	stateConf := &sdkresource.StateChangeConf{
+func waitForNcloudNetworkACLUpdate(ctx context.Context, config *conn.ProviderConfig, id string) error {
+	stateConf := &waiter.Config[*vpc.NetworkAcl]{
 		Pending: []string{"SET"},
 		Target:  []string{"RUN"},
-		Refresh: func() (interface{}, string, error) {
-			instance, err := GetNetworkACLInstance(config, id)
-			return VpcCommonStateRefreshFunc(instance, err, "NetworkAclStatus")
-		},
-		Timeout:    conn.DefaultTimeout,
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Refresh: waiter.StatusOf(func(ctx context.Context) (*vpc.NetworkAcl, error) {
+			return GetNetworkACLInstance(ctx, config, id)
+		}, func(instance *vpc.NetworkAcl) string {
+			return ncloud.StringValue(common.GetCodePtrByCommonCode(instance.NetworkAclStatus))
+		}),
+		Timeout:     conn.DefaultTimeout,
+		Delay:       2 * time.Second,
+		MinInterval: 3 * time.Second,
 	}
 
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForState(ctx); err != nil {
 		return fmt.Errorf("Error waiting for Set network ACL for Subnet (%s) to become running: %s", id, err)
 	}
 
 	return nil
 }
 
-func WaitForNcloudSubnetDeletion(config *conn.ProviderConfig, id string) error {
-	stateConf := &sdkresource.StateChangeConf{
+func WaitForNcloudSubnetDeletion(ctx context.Context, config *conn.ProviderConfig, id string) error {
+	stateConf := &waiter.Config[*vpc.Subnet]{
 		Pending: []string{"RUN", "TERMTING"},
 		Target:  []string{"TERMINATED"},
-		Refresh: func() (interface{}, string, error) {
-			instance, err := GetSubnetInstance(config, id)
-			return VpcCommonStateRefreshFunc(instance, err, "SubnetStatus")
-		},
-		Timeout:    conn.DefaultTimeout,
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Refresh: waiter.StatusOf(func(ctx context.Context) (*vpc.Subnet, error) {
+			return GetSubnetInstance(ctx, config, id)
+		}, func(instance *vpc.Subnet) string {
+			return ncloud.StringValue(common.GetCodePtrByCommonCode(instance.SubnetStatus))
+		}),
+		Timeout:     conn.DefaultTimeout,
+		Delay:       2 * time.Second,
+		MinInterval: 3 * time.Second,
 	}
 
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForState(ctx); err != nil {
 		return fmt.Errorf("Error waiting for Subnet (%s) to become termintaing: %s", id, err)
 	}
 
 	return nil
 }
 
-func GetSubnetInstance(config *conn.ProviderConfig, id string) (*vpc.Subnet, error) {
+func GetSubnetInstance(ctx context.Context, config *conn.ProviderConfig, id string) (*vpc.Subnet, error) {
 	reqParams := &vpc.GetSubnetDetailRequest{
 		RegionCode: &config.RegionCode,
 		SubnetNo:   ncloud.String(id),
 	}
 
-	common.LogCommonRequest("GetSubnetDetail", reqParams)
+	ctx = common.LogCommonRequest(ctx, "GetSubnetDetail", reqParams)
 	resp, err := config.Client.Vpc.V2Api.GetSubnetDetail(reqParams)
 	if err != nil {
-		common.LogErrorResponse("GetSubnetDetail", err, reqParams)
+		common.LogErrorResponse(ctx, "GetSubnetDetail", err, reqParams)
 		return nil, err
 	}
-	common.LogResponse("GetSubnetDetail", resp)
+	common.LogResponse(ctx, "GetSubnetDetail", resp)
 
 	if len(resp.SubnetList) > 0 {
 		instance := resp.SubnetList[0]
@@ -412,6 +394,25 @@ func GetSubnetInstance(config *conn.ProviderConfig, id string) (*vpc.Subnet, err
 	return nil, nil
 }
 
+// GetCachedSubnetInstance returns the subnet from the catalog cache of the provider, to look up its attributes which
+// never change, such as its VPC and zone. GetSubnetInstance is used for its status.
+func GetCachedSubnetInstance(ctx context.Context, config *conn.ProviderConfig, id string) (*vpc.Subnet, error) {
+	subnet, err := conn.CachedLookup(config, conn.CatalogKey("vpc.GetSubnetDetail", id), func() (*vpc.Subnet, error) {
+		subnet, err := GetSubnetInstance(ctx, config, id)
+		if err == nil && subnet == nil {
+			// not cached, the subnet may be created later on
+			return nil, errSubnetNotFound
+		}
+		return subnet, err
+	})
+	if errors.Is(err, errSubnetNotFound) {
+		return nil, nil
+	}
+	return subnet, err
+}
+
+var errSubnetNotFound = errors.New("subnet not found")
+
 type subnetResourceModel struct {
 	NetworkAclNo types.String `tfsdk:"network_acl_no"`
 	VpcNo        types.String `tfsdk:"vpc_no"`
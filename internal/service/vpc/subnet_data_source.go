@@ -3,6 +3,7 @@ package vpc
 import (
 	"context"
 	"fmt"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vpc"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -11,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/paging"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/verify"
 
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
@@ -151,11 +153,23 @@ func (s *subnetDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		reqParams.UsageTypeCode = data.UsageType.ValueStringPointer()
 	}
 
-	tflog.Info(ctx, "GetSubnetList", map[string]any{
-		"reqParams": common.MarshalUncheckedString(reqParams),
-	})
-	subnetResp, err := s.config.Client.Vpc.V2Api.GetSubnetList(reqParams)
+	subnets, err := paging.All(ctx, func(ctx context.Context, pageNo, pageSize int32) ([]*vpc.Subnet, *int32, error) {
+		reqParams.PageNo = ncloud.Int32(pageNo)
+		reqParams.PageSize = ncloud.Int32(pageSize)
+
+		tflog.Info(ctx, "GetSubnetList", map[string]any{
+			"reqParams": common.MarshalUncheckedString(reqParams),
+		})
+		subnetResp, err := s.config.Client.Vpc.V2Api.GetSubnetList(reqParams)
+		if err != nil {
+			return nil, nil, err
+		}
+		tflog.Info(ctx, "GetSubnetList response", map[string]any{
+			"subnetResponse": common.MarshalUncheckedString(subnetResp),
+		})
 
+		return subnetResp.SubnetList, subnetResp.TotalRows, nil
+	})
 	if err != nil {
 		var diags diag.Diagnostics
 		diags.AddError(
@@ -165,11 +179,8 @@ func (s *subnetDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		resp.Diagnostics.Append(diags...)
 		return
 	}
-	tflog.Info(ctx, "GetSubnetList response", map[string]any{
-		"subnetResponse": common.MarshalUncheckedString(subnetResp),
-	})
 
-	subnetList, diags := flattenSubnets(subnetResp.SubnetList, s.config)
+	subnetList, diags := flattenSubnets(subnets, s.config)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -1,13 +1,13 @@
 package vpc_test
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"regexp"
 	"testing"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vpc"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 
@@ -18,7 +18,7 @@ import (
 
 func TestAccResourceNcloudSubnet_basic(t *testing.T) {
 	var subnet vpc.Subnet
-	name := fmt.Sprintf("test-subnet-basic-%s", sdkacctest.RandString(5))
+	name := fmt.Sprintf("test-subnet-basic-%s", RandString(t, 5))
 	cidr := "10.2.2.0/24"
 	resourceName := "ncloud_subnet.bar"
 
@@ -49,7 +49,7 @@ func TestAccResourceNcloudSubnet_basic(t *testing.T) {
 
 func TestAccResourceNcloudSubnet_disappears(t *testing.T) {
 	var subnet vpc.Subnet
-	name := fmt.Sprintf("test-subnet-disappears-%s", sdkacctest.RandString(5))
+	name := fmt.Sprintf("test-subnet-disappears-%s", RandString(t, 5))
 	cidr := "10.2.2.0/24"
 	resourceName := "ncloud_subnet.bar"
 
@@ -72,7 +72,7 @@ func TestAccResourceNcloudSubnet_disappears(t *testing.T) {
 
 func TestAccResourceNcloudSubnet_updateName(t *testing.T) {
 	var subnet vpc.Subnet
-	name := fmt.Sprintf("test-subnet-name-%s", sdkacctest.RandString(5))
+	name := fmt.Sprintf("test-subnet-name-%s", RandString(t, 5))
 	cidr := "10.2.2.0/24"
 	resourceName := "ncloud_subnet.bar"
 
@@ -104,7 +104,7 @@ func TestAccResourceNcloudSubnet_updateNetworkACL(t *testing.T) {
 	t.Skip()
 
 	var subnet vpc.Subnet
-	name := fmt.Sprintf("test-subnet-update-nacl-%s", sdkacctest.RandString(5))
+	name := fmt.Sprintf("test-subnet-update-nacl-%s", RandString(t, 5))
 	cidr := "10.2.2.0/24"
 	resourceName := "ncloud_subnet.bar"
 
@@ -130,7 +130,7 @@ func TestAccResourceNcloudSubnet_updateNetworkACL(t *testing.T) {
 }
 
 func TestAccResourceNcloudSubnet_InvalidCIDR(t *testing.T) {
-	name := fmt.Sprintf("test-subnet-update-nacl-%s", sdkacctest.RandString(5))
+	name := fmt.Sprintf("test-subnet-update-nacl-%s", RandString(t, 5))
 	cidr := "10.3.2.0/24"
 
 	resource.Test(t, resource.TestCase{
@@ -221,7 +221,7 @@ func testAccCheckSubnetExists(n string, subnet *vpc.Subnet) resource.TestCheckFu
 		}
 
 		config := GetTestProvider(true).Meta().(*conn.ProviderConfig)
-		instance, err := vpcservice.GetSubnetInstance(config, rs.Primary.ID)
+		instance, err := vpcservice.GetSubnetInstance(context.Background(), config, rs.Primary.ID)
 		if err != nil {
 			return err
 		}
@@ -240,7 +240,7 @@ func testAccCheckSubnetDestroy(s *terraform.State) error {
 			continue
 		}
 
-		instance, err := vpcservice.GetSubnetInstance(config, rs.Primary.ID)
+		instance, err := vpcservice.GetSubnetInstance(context.Background(), config, rs.Primary.ID)
 
 		if err != nil {
 			return err
@@ -265,7 +265,7 @@ func testAccCheckSubnetDisappears(instance *vpc.Subnet) resource.TestCheckFunc {
 
 		_, err := config.Client.Vpc.V2Api.DeleteSubnet(reqParams)
 
-		if err := vpcservice.WaitForNcloudSubnetDeletion(config, *instance.SubnetNo); err != nil {
+		if err := vpcservice.WaitForNcloudSubnetDeletion(context.Background(), config, *instance.SubnetNo); err != nil {
 			return err
 		}
 
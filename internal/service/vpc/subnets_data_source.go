@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 
@@ -20,6 +21,7 @@ import (
 
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/paging"
 )
 
 var (
@@ -81,6 +83,11 @@ func (s *subnetsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest
 				},
 				Description: "Usage type. GEN(Normal), LOADB(Load Balance), BM(BareMetal), NATGW(NAT Gateway). default : GEN(Normal).",
 			},
+			"sort_by":     common.DataSourceSortByAttribute(),
+			"most_recent": common.DataSourceMostRecentAttribute(),
+			"output_file": schema.StringAttribute{
+				Optional: true,
+			},
 		},
 		Blocks: map[string]schema.Block{
 			"filter": common.DataSourceFiltersBlock(),
@@ -191,11 +198,23 @@ func (s *subnetsDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		reqParams.UsageTypeCode = data.UsageType.ValueStringPointer()
 	}
 
-	tflog.Info(ctx, "GetSubnetList", map[string]any{
-		"reqParams": common.MarshalUncheckedString(reqParams),
-	})
-	subnetResp, err := s.config.Client.Vpc.V2Api.GetSubnetList(reqParams)
+	subnets, err := paging.All(ctx, func(ctx context.Context, pageNo, pageSize int32) ([]*vpc.Subnet, *int32, error) {
+		reqParams.PageNo = ncloud.Int32(pageNo)
+		reqParams.PageSize = ncloud.Int32(pageSize)
 
+		tflog.Info(ctx, "GetSubnetList", map[string]any{
+			"reqParams": common.MarshalUncheckedString(reqParams),
+		})
+		subnetResp, err := s.config.Client.Vpc.V2Api.GetSubnetList(reqParams)
+		if err != nil {
+			return nil, nil, err
+		}
+		tflog.Info(ctx, "GetSubnetList response", map[string]any{
+			"subnetResponse": common.MarshalUncheckedString(subnetResp),
+		})
+
+		return subnetResp.SubnetList, subnetResp.TotalRows, nil
+	})
 	if err != nil {
 		var diags diag.Diagnostics
 		diags.AddError(
@@ -205,21 +224,29 @@ func (s *subnetsDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		resp.Diagnostics.Append(diags...)
 		return
 	}
-	tflog.Info(ctx, "GetSubnetList response", map[string]any{
-		"subnetResponse": common.MarshalUncheckedString(subnetResp),
-	})
 
-	subnetList, diags := flattenSubnets(subnetResp.SubnetList, s.config)
+	subnetList, diags := flattenSubnets(subnets, s.config)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	filteredList := common.FilterModels(ctx, data.Filters, subnetList)
+	filteredList := common.QueryModels(ctx, data.Filters, data.SortBy, data.MostRecent, subnetList)
 
 	state := data
 	state.ID = types.StringValue(time.Now().UTC().String())
 	resp.Diagnostics.Append(state.refreshFromSubnetOutputModel(ctx, filteredList, s.config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !state.OutputFile.IsNull() && state.OutputFile.ValueString() != "" {
+		if err := common.WriteListToFile(ctx, state.OutputFile.ValueString(), state.Subnets); err != nil {
+			resp.Diagnostics.AddError("OUTPUT FILE ERROR", err.Error())
+			return
+		}
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
@@ -233,6 +260,9 @@ type subnetsDataSourceModel struct {
 	NetworkAclNo types.String `tfsdk:"network_acl_no"`
 	SubnetType   types.String `tfsdk:"subnet_type"`
 	UsageType    types.String `tfsdk:"usage_type"`
+	SortBy       types.String `tfsdk:"sort_by"`
+	MostRecent   types.Bool   `tfsdk:"most_recent"`
+	OutputFile   types.String `tfsdk:"output_file"`
 	Subnets      types.List   `tfsdk:"subnets"`
 }
 
@@ -0,0 +1,365 @@
+package vpc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vpc"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/paging"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/sweep"
+)
+
+func RegisterSweepers() {
+	sweep.AddTestSweepers("ncloud_vpc", &resource.Sweeper{
+		Name: "ncloud_vpc",
+		F:    sweepVpcs,
+		Dependencies: []string{
+			"ncloud_subnet",
+			"ncloud_network_acl",
+			"ncloud_route_table",
+			"ncloud_vpc_peering",
+			"ncloud_access_control_group",
+		},
+	})
+
+	sweep.AddTestSweepers("ncloud_subnet", &resource.Sweeper{
+		Name: "ncloud_subnet",
+		F:    sweepSubnets,
+		Dependencies: []string{
+			"ncloud_nat_gateway",
+			"ncloud_server",
+			"ncloud_network_interface",
+			"ncloud_lb",
+			"ncloud_auto_scaling_group",
+			"ncloud_nks_cluster",
+			"ncloud_mysql",
+			"ncloud_mongodb",
+			"ncloud_mssql",
+			"ncloud_postgresql",
+			"ncloud_redis",
+			"ncloud_hadoop",
+			"ncloud_cdss_cluster",
+			"ncloud_ses_cluster",
+		},
+	})
+
+	sweep.AddTestSweepers("ncloud_nat_gateway", &resource.Sweeper{
+		Name: "ncloud_nat_gateway",
+		F:    sweepNatGateways,
+	})
+
+	sweep.AddTestSweepers("ncloud_vpc_peering", &resource.Sweeper{
+		Name: "ncloud_vpc_peering",
+		F:    sweepVpcPeerings,
+	})
+
+	sweep.AddTestSweepers("ncloud_network_acl", &resource.Sweeper{
+		Name: "ncloud_network_acl",
+		F:    sweepNetworkACLs,
+		Dependencies: []string{
+			"ncloud_network_acl_deny_allow_group",
+		},
+	})
+
+	sweep.AddTestSweepers("ncloud_network_acl_deny_allow_group", &resource.Sweeper{
+		Name: "ncloud_network_acl_deny_allow_group",
+		F:    sweepNetworkACLDenyAllowGroups,
+	})
+
+	sweep.AddTestSweepers("ncloud_route_table", &resource.Sweeper{
+		Name: "ncloud_route_table",
+		F:    sweepRouteTables,
+		Dependencies: []string{
+			"ncloud_subnet",
+		},
+	})
+
+	sweep.AddChildTestSweepers("ncloud_network_acl",
+		"ncloud_network_acl_rule",
+	)
+
+	sweep.AddChildTestSweepers("ncloud_route_table",
+		"ncloud_route",
+		"ncloud_route_table_association",
+	)
+}
+
+func sweepVpcs(region string) error {
+	config, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+	if !config.SupportVPC {
+		return nil
+	}
+
+	resp, err := config.Client.Vpc.V2Api.GetVpcList(&vpc.GetVpcListRequest{RegionCode: &config.RegionCode})
+	if err != nil {
+		return fmt.Errorf("listing VPCs: %w", err)
+	}
+
+	var sweepables []sweep.Sweepable
+	for _, v := range resp.VpcList {
+		if !sweep.HasResourcePrefix(v.VpcName) {
+			continue
+		}
+		sweepables = append(sweepables, sweep.NewFrameworkSweepResource(NewVpcResource, config, *v.VpcNo, map[string]string{
+			"vpc_no": *v.VpcNo,
+		}))
+	}
+
+	return sweep.SweepOrchestrator(context.Background(), sweepables)
+}
+
+func sweepSubnets(region string) error {
+	config, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+	if !config.SupportVPC {
+		return nil
+	}
+
+	subnetList, err := paging.All(context.Background(), func(ctx context.Context, pageNo, pageSize int32) ([]*vpc.Subnet, *int32, error) {
+		resp, err := config.Client.Vpc.V2Api.GetSubnetList(&vpc.GetSubnetListRequest{RegionCode: &config.RegionCode, PageNo: ncloud.Int32(pageNo), PageSize: ncloud.Int32(pageSize)})
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.SubnetList, resp.TotalRows, nil
+	})
+	if err != nil {
+		return fmt.Errorf("listing subnets: %w", err)
+	}
+
+	var sweepables []sweep.Sweepable
+	for _, s := range subnetList {
+		if !sweep.HasResourcePrefix(s.SubnetName) {
+			continue
+		}
+		sweepables = append(sweepables, sweep.NewFrameworkSweepResource(NewSubnetResource, config, *s.SubnetNo, map[string]string{
+			"subnet_no": *s.SubnetNo,
+		}))
+	}
+
+	return sweep.SweepOrchestrator(context.Background(), sweepables)
+}
+
+func sweepNatGateways(region string) error {
+	config, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+	if !config.SupportVPC {
+		return nil
+	}
+
+	natGatewayInstanceList, err := paging.All(context.Background(), func(ctx context.Context, pageNo, pageSize int32) ([]*vpc.NatGatewayInstance, *int32, error) {
+		resp, err := config.Client.Vpc.V2Api.GetNatGatewayInstanceList(&vpc.GetNatGatewayInstanceListRequest{RegionCode: &config.RegionCode, PageNo: ncloud.Int32(pageNo), PageSize: ncloud.Int32(pageSize)})
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.NatGatewayInstanceList, resp.TotalRows, nil
+	})
+	if err != nil {
+		return fmt.Errorf("listing NAT gateways: %w", err)
+	}
+
+	var sweepables []sweep.Sweepable
+	for _, n := range natGatewayInstanceList {
+		if !sweep.HasResourcePrefix(n.NatGatewayName) {
+			continue
+		}
+
+		routes, err := routeSweepables(config, *n.VpcNo, *n.NatGatewayInstanceNo)
+		if err != nil {
+			return err
+		}
+		sweepables = append(sweepables, routes...)
+		sweepables = append(sweepables, sweep.NewFrameworkSweepResource(NewNatGatewayResource, config, *n.NatGatewayInstanceNo, map[string]string{
+			"nat_gateway_no": *n.NatGatewayInstanceNo,
+		}))
+	}
+
+	return sweep.SweepOrchestrator(context.Background(), sweepables)
+}
+
+func sweepVpcPeerings(region string) error {
+	config, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+	if !config.SupportVPC {
+		return nil
+	}
+
+	vpcPeeringInstanceList, err := paging.All(context.Background(), func(ctx context.Context, pageNo, pageSize int32) ([]*vpc.VpcPeeringInstance, *int32, error) {
+		resp, err := config.Client.Vpc.V2Api.GetVpcPeeringInstanceList(&vpc.GetVpcPeeringInstanceListRequest{RegionCode: &config.RegionCode, PageNo: ncloud.Int32(pageNo), PageSize: ncloud.Int32(pageSize)})
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.VpcPeeringInstanceList, resp.TotalRows, nil
+	})
+	if err != nil {
+		return fmt.Errorf("listing VPC peerings: %w", err)
+	}
+
+	var sweepables []sweep.Sweepable
+	for _, p := range vpcPeeringInstanceList {
+		if !sweep.HasResourcePrefix(p.VpcPeeringName) {
+			continue
+		}
+
+		routes, err := routeSweepables(config, *p.SourceVpcNo, *p.VpcPeeringInstanceNo)
+		if err != nil {
+			return err
+		}
+		sweepables = append(sweepables, routes...)
+		sweepables = append(sweepables, sweep.NewFrameworkSweepResource(NewVpcPeeringResource, config, *p.VpcPeeringInstanceNo, map[string]string{
+			"vpc_peering_no": *p.VpcPeeringInstanceNo,
+		}))
+	}
+
+	return sweep.SweepOrchestrator(context.Background(), sweepables)
+}
+
+func sweepNetworkACLs(region string) error {
+	config, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+	if !config.SupportVPC {
+		return nil
+	}
+
+	networkAclList, err := paging.All(context.Background(), func(ctx context.Context, pageNo, pageSize int32) ([]*vpc.NetworkAcl, *int32, error) {
+		resp, err := config.Client.Vpc.V2Api.GetNetworkAclList(&vpc.GetNetworkAclListRequest{RegionCode: &config.RegionCode, PageNo: ncloud.Int32(pageNo), PageSize: ncloud.Int32(pageSize)})
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.NetworkAclList, resp.TotalRows, nil
+	})
+	if err != nil {
+		return fmt.Errorf("listing network ACLs: %w", err)
+	}
+
+	var sweepables []sweep.Sweepable
+	for _, acl := range networkAclList {
+		// default network ACLs are deleted with their VPC
+		if ncloud.BoolValue(acl.IsDefault) || !sweep.HasResourcePrefix(acl.NetworkAclName) {
+			continue
+		}
+		sweepables = append(sweepables, sweep.NewSweepResource(ResourceNcloudNetworkACL(), config, *acl.NetworkAclNo, map[string]interface{}{
+			"network_acl_no": *acl.NetworkAclNo,
+		}))
+	}
+
+	return sweep.SweepOrchestrator(context.Background(), sweepables)
+}
+
+func sweepNetworkACLDenyAllowGroups(region string) error {
+	config, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+	if !config.SupportVPC {
+		return nil
+	}
+
+	networkAclDenyAllowGroupList, err := paging.All(context.Background(), func(ctx context.Context, pageNo, pageSize int32) ([]*vpc.NetworkAclDenyAllowGroup, *int32, error) {
+		resp, err := config.Client.Vpc.V2Api.GetNetworkAclDenyAllowGroupList(&vpc.GetNetworkAclDenyAllowGroupListRequest{RegionCode: &config.RegionCode, PageNo: ncloud.Int32(pageNo), PageSize: ncloud.Int32(pageSize)})
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.NetworkAclDenyAllowGroupList, resp.TotalRows, nil
+	})
+	if err != nil {
+		return fmt.Errorf("listing network ACL deny-allow groups: %w", err)
+	}
+
+	var sweepables []sweep.Sweepable
+	for _, g := range networkAclDenyAllowGroupList {
+		if !sweep.HasResourcePrefix(g.NetworkAclDenyAllowGroupName) {
+			continue
+		}
+		sweepables = append(sweepables, sweep.NewFrameworkSweepResource(NewNetworkACLDenyAllowGroupResource, config, *g.NetworkAclDenyAllowGroupNo, nil))
+	}
+
+	return sweep.SweepOrchestrator(context.Background(), sweepables)
+}
+
+func sweepRouteTables(region string) error {
+	config, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+	if !config.SupportVPC {
+		return nil
+	}
+
+	routeTableList, err := paging.All(context.Background(), func(ctx context.Context, pageNo, pageSize int32) ([]*vpc.RouteTable, *int32, error) {
+		resp, err := config.Client.Vpc.V2Api.GetRouteTableList(&vpc.GetRouteTableListRequest{RegionCode: &config.RegionCode, PageNo: ncloud.Int32(pageNo), PageSize: ncloud.Int32(pageSize)})
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.RouteTableList, resp.TotalRows, nil
+	})
+	if err != nil {
+		return fmt.Errorf("listing route tables: %w", err)
+	}
+
+	var sweepables []sweep.Sweepable
+	for _, rt := range routeTableList {
+		// default route tables are deleted with their VPC
+		if ncloud.BoolValue(rt.IsDefault) || !sweep.HasResourcePrefix(rt.RouteTableName) {
+			continue
+		}
+		sweepables = append(sweepables, sweep.NewSweepResource(ResourceNcloudRouteTable(), config, *rt.RouteTableNo, map[string]interface{}{
+			"route_table_no": *rt.RouteTableNo,
+		}))
+	}
+
+	return sweep.SweepOrchestrator(context.Background(), sweepables)
+}
+
+// routeSweepables returns the routes of the route tables of the VPC to the target, which must be removed before it
+func routeSweepables(config *conn.ProviderConfig, vpcNo, targetNo string) ([]sweep.Sweepable, error) {
+	tables, err := config.Client.Vpc.V2Api.GetRouteTableList(&vpc.GetRouteTableListRequest{
+		RegionCode: &config.RegionCode,
+		VpcNo:      &vpcNo,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("listing route tables of VPC %s: %w", vpcNo, err)
+	}
+
+	var sweepables []sweep.Sweepable
+	for _, rt := range tables.RouteTableList {
+		routes, err := config.Client.Vpc.V2Api.GetRouteList(&vpc.GetRouteListRequest{
+			RegionCode:   &config.RegionCode,
+			VpcNo:        &vpcNo,
+			RouteTableNo: rt.RouteTableNo,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("listing routes of route table %s: %w", *rt.RouteTableNo, err)
+		}
+
+		for _, r := range routes.RouteList {
+			if ncloud.StringValue(r.TargetNo) != targetNo {
+				continue
+			}
+			sweepables = append(sweepables, sweep.NewSweepResource(ResourceNcloudRoute(), config, fmt.Sprintf("%s:%s", *rt.RouteTableNo, *r.DestinationCidrBlock), map[string]interface{}{
+				"vpc_no":                 vpcNo,
+				"route_table_no":         *rt.RouteTableNo,
+				"destination_cidr_block": ncloud.StringValue(r.DestinationCidrBlock),
+				"target_type":            ncloud.StringValue(r.TargetType.Code),
+				"target_name":            ncloud.StringValue(r.TargetName),
+				"target_no":              targetNo,
+			}))
+		}
+	}
+
+	return sweepables, nil
+}
@@ -11,7 +11,6 @@ import (
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vpc"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	sdkresource "github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -24,6 +23,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/framework"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/verify"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/waiter"
 )
 
 var (
@@ -146,13 +146,13 @@ func (r *vpcResource) Create(ctx context.Context, req resource.CreateRequest, re
 	plan.ID = types.StringPointerValue(vpcInstance.VpcNo)
 	tflog.Info(ctx, "VPC ID", map[string]any{"vpcNo": *vpcInstance.VpcNo})
 
-	output, err := waitForNcloudVpcCreation(r.config, *vpcInstance.VpcNo)
+	output, err := waitForNcloudVpcCreation(ctx, r.config, *vpcInstance.VpcNo)
 	if err != nil {
 		resp.Diagnostics.AddError("waiting for VPC creation", err.Error())
 		return
 	}
 
-	if err := plan.refreshFromOutput(output, r.config); err != nil {
+	if err := plan.refreshFromOutput(ctx, output, r.config); err != nil {
 		resp.Diagnostics.AddError("refreshing vpc details", err.Error())
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
@@ -166,7 +166,7 @@ func (r *vpcResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 		return
 	}
 
-	output, err := GetVpcInstance(r.config, state.ID.ValueString())
+	output, err := GetVpcInstance(ctx, r.config, state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("GetVPC", err.Error())
 		return
@@ -177,7 +177,7 @@ func (r *vpcResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 		return
 	}
 
-	if err := state.refreshFromOutput(output, r.config); err != nil {
+	if err := state.refreshFromOutput(ctx, output, r.config); err != nil {
 		resp.Diagnostics.AddError("refreshing vpc details", err.Error())
 	}
 
@@ -219,7 +219,7 @@ func (r *vpcResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 		"deleteVpcResponse": common.MarshalUncheckedString(response),
 	})
 
-	if err := WaitForNcloudVpcDeletion(r.config, state.ID.ValueString()); err != nil {
+	if err := WaitForNcloudVpcDeletion(ctx, r.config, state.ID.ValueString()); err != nil {
 		resp.Diagnostics.AddError(
 			"fail to wait for vpc deletion",
 			err.Error(),
@@ -232,21 +232,21 @@ func (r *vpcResource) ImportState(ctx context.Context, req resource.ImportStateR
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
-func getDefaultNetworkACL(config *conn.ProviderConfig, id string) (string, error) {
+func getDefaultNetworkACL(ctx context.Context, config *conn.ProviderConfig, id string) (string, error) {
 	reqParams := &vpc.GetNetworkAclListRequest{
 		RegionCode: &config.RegionCode,
 		VpcNo:      ncloud.String(id),
 	}
 
-	common.LogCommonRequest("GetNetworkAclList", reqParams)
+	ctx = common.LogCommonRequest(ctx, "GetNetworkAclList", reqParams)
 	resp, err := config.Client.Vpc.V2Api.GetNetworkAclList(reqParams)
 
 	if err != nil {
-		common.LogErrorResponse("GetNetworkAclList", err, reqParams)
+		common.LogErrorResponse(ctx, "GetNetworkAclList", err, reqParams)
 		return "", err
 	}
 
-	common.LogResponse("GetNetworkAclList", resp)
+	common.LogResponse(ctx, "GetNetworkAclList", resp)
 
 	if resp == nil || len(resp.NetworkAclList) == 0 {
 		return "", fmt.Errorf("no matching Network ACL found")
@@ -261,21 +261,29 @@ func getDefaultNetworkACL(config *conn.ProviderConfig, id string) (string, error
 	return "", fmt.Errorf("No matching default network ACL found")
 }
 
-func GetDefaultAccessControlGroup(config *conn.ProviderConfig, id string) (string, error) {
+// GetDefaultAccessControlGroup returns the default access control group of the VPC, which is kept in the catalog
+// cache of the provider as it never changes
+func GetDefaultAccessControlGroup(ctx context.Context, config *conn.ProviderConfig, id string) (string, error) {
+	return conn.CachedLookup(config, conn.CatalogKey("vserver.GetDefaultAccessControlGroup", id), func() (string, error) {
+		return getDefaultAccessControlGroup(ctx, config, id)
+	})
+}
+
+func getDefaultAccessControlGroup(ctx context.Context, config *conn.ProviderConfig, id string) (string, error) {
 	reqParams := &vserver.GetAccessControlGroupListRequest{
 		RegionCode: &config.RegionCode,
 		VpcNo:      ncloud.String(id),
 	}
 
-	common.LogCommonRequest("getDefaultAccessControlGroup", reqParams)
+	ctx = common.LogCommonRequest(ctx, "getDefaultAccessControlGroup", reqParams)
 	resp, err := config.Client.Vserver.V2Api.GetAccessControlGroupList(reqParams)
 
 	if err != nil {
-		common.LogErrorResponse("getDefaultAccessControlGroup", err, reqParams)
+		common.LogErrorResponse(ctx, "getDefaultAccessControlGroup", err, reqParams)
 		return "", err
 	}
 
-	common.LogResponse("getDefaultAccessControlGroup", resp)
+	common.LogResponse(ctx, "getDefaultAccessControlGroup", resp)
 
 	if resp == nil || len(resp.AccessControlGroupList) == 0 {
 		return "", fmt.Errorf("no matching Access Control Group found")
@@ -290,21 +298,21 @@ func GetDefaultAccessControlGroup(config *conn.ProviderConfig, id string) (strin
 	return "", fmt.Errorf("No matching default Access Control Group found")
 }
 
-func getDefaultRouteTable(config *conn.ProviderConfig, id string) (publicRouteTableNo string, privateRouteTableNo string, error error) {
+func getDefaultRouteTable(ctx context.Context, config *conn.ProviderConfig, id string) (publicRouteTableNo string, privateRouteTableNo string, error error) {
 	reqParams := &vpc.GetRouteTableListRequest{
 		RegionCode: &config.RegionCode,
 		VpcNo:      ncloud.String(id),
 	}
 
-	common.LogCommonRequest("getDefaultRouteTable", reqParams)
+	ctx = common.LogCommonRequest(ctx, "getDefaultRouteTable", reqParams)
 	resp, err := config.Client.Vpc.V2Api.GetRouteTableList(reqParams)
 
 	if err != nil {
-		common.LogErrorResponse("getDefaultRouteTable", err, reqParams)
+		common.LogErrorResponse(ctx, "getDefaultRouteTable", err, reqParams)
 		return "", "", err
 	}
 
-	common.LogResponse("getDefaultRouteTable", resp)
+	common.LogResponse(ctx, "getDefaultRouteTable", resp)
 
 	for _, i := range resp.RouteTableList {
 		if *i.IsDefault && *i.SupportedSubnetType.Code == "PRIVATE" {
@@ -317,49 +325,50 @@ func getDefaultRouteTable(config *conn.ProviderConfig, id string) (publicRouteTa
 	return publicRouteTableNo, privateRouteTableNo, nil
 }
 
-func waitForNcloudVpcCreation(config *conn.ProviderConfig, id string) (*vpc.Vpc, error) {
-	var vpcInstance *vpc.Vpc
-	stateConf := &sdkresource.StateChangeConf{
+func waitForNcloudVpcCreation(ctx context.Context, config *conn.ProviderConfig, id string) (*vpc.Vpc, error) {
+	stateConf := &waiter.Config[*vpc.Vpc]{
 		Pending: []string{"INIT", "CREATING"},
 		Target:  []string{"RUN"},
-		Refresh: func() (interface{}, string, error) {
-			instance, err := GetVpcInstance(config, id)
-			vpcInstance = instance
-			return VpcCommonStateRefreshFunc(instance, err, "VpcStatus")
-		},
-		Timeout:    conn.DefaultCreateTimeout,
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Refresh: waiter.StatusOf(func(ctx context.Context) (*vpc.Vpc, error) {
+			return GetVpcInstance(ctx, config, id)
+		}, func(instance *vpc.Vpc) string {
+			return ncloud.StringValue(common.GetCodePtrByCommonCode(instance.VpcStatus))
+		}),
+		Timeout:     conn.DefaultCreateTimeout,
+		Delay:       2 * time.Second,
+		MinInterval: 3 * time.Second,
 	}
 
-	if _, err := stateConf.WaitForState(); err != nil {
+	vpcInstance, err := stateConf.WaitForState(ctx)
+	if err != nil {
 		return nil, fmt.Errorf("Error waiting for VPC (%s) to become available: %s", id, err)
 	}
 
 	return vpcInstance, nil
 }
 
-func WaitForNcloudVpcDeletion(config *conn.ProviderConfig, id string) error {
-	stateConf := &sdkresource.StateChangeConf{
+func WaitForNcloudVpcDeletion(ctx context.Context, config *conn.ProviderConfig, id string) error {
+	stateConf := &waiter.Config[*vpc.Vpc]{
 		Pending: []string{"RUN", "TERMTING"},
 		Target:  []string{"TERMINATED"},
-		Refresh: func() (interface{}, string, error) {
-			instance, err := GetVpcInstance(config, id)
-			return VpcCommonStateRefreshFunc(instance, err, "VpcStatus")
-		},
-		Timeout:    conn.DefaultTimeout,
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Refresh: waiter.StatusOf(func(ctx context.Context) (*vpc.Vpc, error) {
+			return GetVpcInstance(ctx, config, id)
+		}, func(instance *vpc.Vpc) string {
+			return ncloud.StringValue(common.GetCodePtrByCommonCode(instance.VpcStatus))
+		}),
+		Timeout:     conn.DefaultTimeout,
+		Delay:       2 * time.Second,
+		MinInterval: 3 * time.Second,
 	}
 
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForState(ctx); err != nil {
 		return fmt.Errorf("Error waiting for VPC (%s) to become termintaing: %s", id, err)
 	}
 
 	return nil
 }
 
-func GetVpcInstance(config *conn.ProviderConfig, id string) (*vpc.Vpc, error) {
+func GetVpcInstance(ctx context.Context, config *conn.ProviderConfig, id string) (*vpc.Vpc, error) {
 	reqParams := &vpc.GetVpcDetailRequest{
 		RegionCode: &config.RegionCode,
 		VpcNo:      ncloud.String(id),
@@ -367,10 +376,10 @@ func GetVpcInstance(config *conn.ProviderConfig, id string) (*vpc.Vpc, error) {
 
 	resp, err := config.Client.Vpc.V2Api.GetVpcDetail(reqParams)
 	if err != nil {
-		common.LogErrorResponse("Get Vpc Instance", err, reqParams)
+		common.LogErrorResponse(ctx, "Get Vpc Instance", err, reqParams)
 		return nil, err
 	}
-	common.LogResponse("GetVpcDetail", resp)
+	common.LogResponse(ctx, "GetVpcDetail", resp)
 
 	if len(resp.VpcList) > 0 {
 		vpc := resp.VpcList[0]
@@ -391,27 +400,27 @@ type vpcResourceModel struct {
 	VpcNo                       types.String `tfsdk:"vpc_no"`
 }
 
-func (m *vpcResourceModel) refreshFromOutput(output *vpc.Vpc, config *conn.ProviderConfig) error {
+func (m *vpcResourceModel) refreshFromOutput(ctx context.Context, output *vpc.Vpc, config *conn.ProviderConfig) error {
 	m.ID = types.StringPointerValue(output.VpcNo)
 	m.VpcNo = types.StringPointerValue(output.VpcNo)
 	m.Name = types.StringPointerValue(output.VpcName)
 	m.Ipv4CidrBlock = types.StringPointerValue(output.Ipv4CidrBlock)
 
 	if *output.VpcStatus.Code != "TERMTING" {
-		defaultNetworkACLNo, err := getDefaultNetworkACL(config, m.ID.ValueString())
+		defaultNetworkACLNo, err := getDefaultNetworkACL(ctx, config, m.ID.ValueString())
 		if err != nil {
 			return fmt.Errorf("error get default network acl for VPC (%s): %s", m.ID.ValueString(), err)
 		}
 
 		m.DefaultNetworkAclNo = types.StringValue(defaultNetworkACLNo)
 
-		defaultAcgNo, err := GetDefaultAccessControlGroup(config, m.ID.ValueString())
+		defaultAcgNo, err := GetDefaultAccessControlGroup(ctx, config, m.ID.ValueString())
 		if err != nil {
 			return fmt.Errorf("error get default Access Control Group for VPC (%s): %s", m.ID.ValueString(), err)
 		}
 		m.DefaultAccessControlGroupNo = types.StringValue(defaultAcgNo)
 
-		publicRouteTableNo, privateRouteTableNo, err := getDefaultRouteTable(config, m.ID.ValueString())
+		publicRouteTableNo, privateRouteTableNo, err := getDefaultRouteTable(ctx, config, m.ID.ValueString())
 		if err != nil {
 			return fmt.Errorf("error get default Route Table for VPC (%s): %s", m.ID.ValueString(), err)
 		}
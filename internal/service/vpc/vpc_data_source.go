@@ -164,7 +164,7 @@ func flattenVpcs(ctx context.Context, vpcs []*vpc.Vpc, config *conn.ProviderConf
 	for _, v := range vpcs {
 		var output vpcDataSourceModel
 
-		diags := output.refreshFromOutput(v, config)
+		diags := output.refreshFromOutput(ctx, v, config)
 		if diags.HasError() {
 			return nil, diags
 		}
@@ -187,11 +187,11 @@ type vpcDataSourceModel struct {
 	VpcNo                       types.String `tfsdk:"vpc_no"`
 }
 
-func (d *vpcDataSourceModel) refreshFromOutput(output *vpc.Vpc, config *conn.ProviderConfig) diag.Diagnostics {
+func (d *vpcDataSourceModel) refreshFromOutput(ctx context.Context, output *vpc.Vpc, config *conn.ProviderConfig) diag.Diagnostics {
 	var diags diag.Diagnostics
 	id := ncloud.StringValue(output.VpcNo)
 
-	defaultNetworkACLNo, err := getDefaultNetworkACL(config, id)
+	defaultNetworkACLNo, err := getDefaultNetworkACL(ctx, config, id)
 	if err != nil {
 		diags.AddError(
 			"GetDefaultNetworkAcl info",
@@ -199,7 +199,7 @@ func (d *vpcDataSourceModel) refreshFromOutput(output *vpc.Vpc, config *conn.Pro
 		)
 	}
 
-	defaultAcgNo, err := GetDefaultAccessControlGroup(config, id)
+	defaultAcgNo, err := GetDefaultAccessControlGroup(ctx, config, id)
 	if err != nil {
 		diags.AddError(
 			"GetDefaultAccessControlGroup info",
@@ -207,7 +207,7 @@ func (d *vpcDataSourceModel) refreshFromOutput(output *vpc.Vpc, config *conn.Pro
 		)
 	}
 
-	publicRouteTableNo, privateRouteTableNo, err := getDefaultRouteTable(config, id)
+	publicRouteTableNo, privateRouteTableNo, err := getDefaultRouteTable(ctx, config, id)
 	if err != nil {
 		diags.AddError(
 			"GetDefaultRouteTable info",
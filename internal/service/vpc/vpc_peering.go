@@ -9,7 +9,6 @@ import (
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vpc"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	sdkresource "github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -25,6 +24,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/framework"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/verify"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/waiter"
 )
 
 var (
@@ -330,21 +330,21 @@ func (m *vpcPeeringResourceModel) refreshFromOutput(output *vpc.VpcPeeringInstan
 }
 
 func waitForNcloudVpcPeeringCreation(ctx context.Context, config *conn.ProviderConfig, id string) (*vpc.VpcPeeringInstance, error) {
-	var vpcPeeringInstance *vpc.VpcPeeringInstance
-	stateConf := &sdkresource.StateChangeConf{
+	stateConf := &waiter.Config[*vpc.VpcPeeringInstance]{
 		Pending: []string{"INIT", "CREATING"},
 		Target:  []string{"RUN"},
-		Refresh: func() (interface{}, string, error) {
-			instance, err := GetVpcPeeringInstance(ctx, config, id)
-			vpcPeeringInstance = instance
-			return VpcCommonStateRefreshFunc(instance, err, "VpcPeeringInstanceStatus")
-		},
-		Timeout:    conn.DefaultCreateTimeout,
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Refresh: waiter.StatusOf(func(ctx context.Context) (*vpc.VpcPeeringInstance, error) {
+			return GetVpcPeeringInstance(ctx, config, id)
+		}, func(instance *vpc.VpcPeeringInstance) string {
+			return ncloud.StringValue(common.GetCodePtrByCommonCode(instance.VpcPeeringInstanceStatus))
+		}),
+		Timeout:     conn.DefaultCreateTimeout,
+		Delay:       2 * time.Second,
+		MinInterval: 3 * time.Second,
 	}
 
-	if _, err := stateConf.WaitForState(); err != nil {
+	vpcPeeringInstance, err := stateConf.WaitForState(ctx)
+	if err != nil {
 		return nil, fmt.Errorf("Error waiting for VPC Peering (%s) to become available: %s", id, err)
 	}
 
@@ -353,19 +353,20 @@ func waitForNcloudVpcPeeringCreation(ctx context.Context, config *conn.ProviderC
 
 func WaitForNcloudVpcPeeringDeletion(ctx context.Context, config *conn.ProviderConfig, id string) error {
 
-	stateConf := &sdkresource.StateChangeConf{
+	stateConf := &waiter.Config[*vpc.VpcPeeringInstance]{
 		Pending: []string{"RUN", "TERMTING"},
 		Target:  []string{"TERMINATED"},
-		Refresh: func() (interface{}, string, error) {
-			instance, err := GetVpcPeeringInstance(ctx, config, id)
-			return VpcCommonStateRefreshFunc(instance, err, "VpcPeeringInstanceStatus")
-		},
-		Timeout:    conn.DefaultTimeout,
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Refresh: waiter.StatusOf(func(ctx context.Context) (*vpc.VpcPeeringInstance, error) {
+			return GetVpcPeeringInstance(ctx, config, id)
+		}, func(instance *vpc.VpcPeeringInstance) string {
+			return ncloud.StringValue(common.GetCodePtrByCommonCode(instance.VpcPeeringInstanceStatus))
+		}),
+		Timeout:     conn.DefaultTimeout,
+		Delay:       2 * time.Second,
+		MinInterval: 3 * time.Second,
 	}
 
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForState(ctx); err != nil {
 		return fmt.Errorf("Error waiting for VPC Peering (%s) to become termintaing: %s", id, err)
 	}
 
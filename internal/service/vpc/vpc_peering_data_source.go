@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vpc"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -13,6 +14,7 @@ import (
 
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/paging"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/verify"
 )
 
@@ -135,12 +137,23 @@ func (v *vpcPeeringDataSource) Read(ctx context.Context, req datasource.ReadRequ
 		reqParams.SourceVpcName = data.SourceVpcName.ValueStringPointer()
 	}
 
-	tflog.Info(ctx, "GetVpcPeeringList", map[string]any{
-		"reqParams": common.MarshalUncheckedString(reqParams),
+	vpcPeeringInstanceList, err := paging.All(ctx, func(ctx context.Context, pageNo, pageSize int32) ([]*vpc.VpcPeeringInstance, *int32, error) {
+		reqParams.PageNo = ncloud.Int32(pageNo)
+		reqParams.PageSize = ncloud.Int32(pageSize)
+
+		tflog.Info(ctx, "GetVpcPeeringList", map[string]any{
+			"reqParams": common.MarshalUncheckedString(reqParams),
+		})
+		response, err := v.config.Client.Vpc.V2Api.GetVpcPeeringInstanceList(reqParams)
+		if err != nil {
+			return nil, nil, err
+		}
+		tflog.Info(ctx, "GetVpcPeeringList response", map[string]any{
+			"vpcPeeringResponse": common.MarshalUncheckedString(response),
+		})
+
+		return response.VpcPeeringInstanceList, response.TotalRows, nil
 	})
-
-	response, err := v.config.Client.Vpc.V2Api.GetVpcPeeringInstanceList(reqParams)
-
 	if err != nil {
 		var diags diag.Diagnostics
 		diags.AddError(
@@ -150,11 +163,8 @@ func (v *vpcPeeringDataSource) Read(ctx context.Context, req datasource.ReadRequ
 		resp.Diagnostics.Append(diags...)
 		return
 	}
-	tflog.Info(ctx, "GetVpcPeeringList response", map[string]any{
-		"vpcPeeringResponse": common.MarshalUncheckedString(response),
-	})
 
-	vpcPeeringList, diags := flattenVpcPeerings(ctx, response.VpcPeeringInstanceList, v.config)
+	vpcPeeringList, diags := flattenVpcPeerings(ctx, vpcPeeringInstanceList, v.config)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"testing"
 
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 
@@ -12,7 +11,7 @@ import (
 )
 
 func TestAccDataSourceNcloudVpcPeering_basic(t *testing.T) {
-	name := fmt.Sprintf("test-peering-data-%s", sdkacctest.RandString(5))
+	name := fmt.Sprintf("test-peering-data-%s", acctest.RandString(t, 5))
 	resourceName := "ncloud_vpc_peering.foo"
 	dataNameById := "data.ncloud_vpc_peering.by_id"
 	dataNameByName := "data.ncloud_vpc_peering.by_name"
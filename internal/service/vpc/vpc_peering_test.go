@@ -8,7 +8,6 @@ import (
 	"testing"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vpc"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 
@@ -20,7 +19,7 @@ import (
 func TestAccResourceNcloudVpcPeering_basic(t *testing.T) {
 	var vpcPeeringInstance vpc.VpcPeeringInstance
 	resourceName := "ncloud_vpc_peering.foo"
-	name := fmt.Sprintf("test-peering-basic-%s", sdkacctest.RandString(5))
+	name := fmt.Sprintf("test-peering-basic-%s", acctest.RandString(t, 5))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
@@ -50,7 +49,7 @@ func TestAccResourceNcloudVpcPeering_Peering(t *testing.T) {
 	var vpcPeeringInstance vpc.VpcPeeringInstance
 	resourceNameMain := "ncloud_vpc_peering.foo"
 	resourceNamePeer := "ncloud_vpc_peering.bar"
-	name := fmt.Sprintf("test-peering-basic-%s", sdkacctest.RandString(5))
+	name := fmt.Sprintf("test-peering-basic-%s", acctest.RandString(t, 5))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
@@ -75,7 +74,7 @@ func TestAccResourceNcloudVpcPeering_Peering(t *testing.T) {
 func TestAccResourceNcloudVpcPeering_disappears(t *testing.T) {
 	var vpcPeeringInstance vpc.VpcPeeringInstance
 	resourceName := "ncloud_vpc_peering.foo"
-	name := fmt.Sprintf("test-peering-disap-%s", sdkacctest.RandString(5))
+	name := fmt.Sprintf("test-peering-disap-%s", acctest.RandString(t, 5))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
@@ -97,7 +96,7 @@ func TestAccResourceNcloudVpcPeering_disappears(t *testing.T) {
 func TestAccResourceNcloudVpcPeering_description(t *testing.T) {
 	var vpcPeeringInstance vpc.VpcPeeringInstance
 	resourceName := "ncloud_vpc_peering.foo"
-	name := fmt.Sprintf("test-peering-desc-%s", sdkacctest.RandString(5))
+	name := fmt.Sprintf("test-peering-desc-%s", acctest.RandString(t, 5))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
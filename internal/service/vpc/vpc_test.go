@@ -1,6 +1,7 @@
 package vpc_test
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"math/rand"
@@ -8,7 +9,6 @@ import (
 	"testing"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vpc"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 
@@ -21,7 +21,7 @@ func TestAccResourceNcloudVpc_basic(t *testing.T) {
 	var vpc vpc.Vpc
 	rInt := rand.Intn(16)
 	cidr := fmt.Sprintf("10.%d.0.0/16", rInt)
-	name := fmt.Sprintf("test-vpc-basic-%s", sdkacctest.RandString(5))
+	name := fmt.Sprintf("test-vpc-basic-%s", acctest.RandString(t, 5))
 	resourceName := "ncloud_vpc.test"
 
 	resource.Test(t, resource.TestCase{
@@ -50,7 +50,7 @@ func TestAccResourceNcloudVpc_disappears(t *testing.T) {
 	var vpc vpc.Vpc
 	rInt := rand.Intn(16)
 	cidr := fmt.Sprintf("10.%d.0.0/16", rInt)
-	name := fmt.Sprintf("test-vpc-disapr-%s", sdkacctest.RandString(5))
+	name := fmt.Sprintf("test-vpc-disapr-%s", acctest.RandString(t, 5))
 	resourceName := "ncloud_vpc.test"
 
 	resource.Test(t, resource.TestCase{
@@ -74,7 +74,7 @@ func TestAccResourceNcloudVpc_updateName(t *testing.T) {
 	var vpc vpc.Vpc
 	rInt := rand.Intn(16)
 	cidr := fmt.Sprintf("10.%d.0.0/16", rInt)
-	name := fmt.Sprintf("test-vpc-name-%s", sdkacctest.RandString(5))
+	name := fmt.Sprintf("test-vpc-name-%s", acctest.RandString(t, 5))
 	resourceName := "ncloud_vpc.test"
 
 	resource.Test(t, resource.TestCase{
@@ -113,7 +113,7 @@ func testAccCheckVpcExists(n string, vpc *vpc.Vpc) resource.TestCheckFunc {
 		}
 
 		config := acctest.GetTestProvider(true).Meta().(*conn.ProviderConfig)
-		vpcInstance, err := vpcservice.GetVpcInstance(config, rs.Primary.ID)
+		vpcInstance, err := vpcservice.GetVpcInstance(context.Background(), config, rs.Primary.ID)
 		if err != nil {
 			return err
 		}
@@ -132,7 +132,7 @@ func testAccCheckVpcDestroy(s *terraform.State) error {
 			continue
 		}
 
-		instance, err := vpcservice.GetVpcInstance(config, rs.Primary.ID)
+		instance, err := vpcservice.GetVpcInstance(context.Background(), config, rs.Primary.ID)
 
 		if err != nil {
 			return err
@@ -157,7 +157,7 @@ func testAccCheckVpcDisappears(instance *vpc.Vpc) resource.TestCheckFunc {
 
 		_, err := config.Client.Vpc.V2Api.DeleteVpc(reqParams)
 
-		if err := vpcservice.WaitForNcloudVpcDeletion(config, *instance.VpcNo); err != nil {
+		if err := vpcservice.WaitForNcloudVpcDeletion(context.Background(), config, *instance.VpcNo); err != nil {
 			return err
 		}
 
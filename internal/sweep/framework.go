@@ -0,0 +1,85 @@
+package sweep
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+)
+
+// FrameworkSweepResource is a resource of the framework provider removed by its Delete,
+// from a state where only id and the given string attributes are known.
+type FrameworkSweepResource struct {
+	factory    func() resource.Resource
+	meta       *conn.ProviderConfig
+	id         string
+	attributes map[string]string
+}
+
+func NewFrameworkSweepResource(factory func() resource.Resource, meta *conn.ProviderConfig, id string, attributes map[string]string) *FrameworkSweepResource {
+	return &FrameworkSweepResource{
+		factory:    factory,
+		meta:       meta,
+		id:         id,
+		attributes: attributes,
+	}
+}
+
+func (s *FrameworkSweepResource) Delete(ctx context.Context) error {
+	r := s.factory()
+
+	if rc, ok := r.(resource.ResourceWithConfigure); ok {
+		resp := &resource.ConfigureResponse{}
+		rc.Configure(ctx, resource.ConfigureRequest{ProviderData: s.meta}, resp)
+		if resp.Diagnostics.HasError() {
+			return fmt.Errorf("configuring resource: %v", resp.Diagnostics)
+		}
+	}
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	if schemaResp.Diagnostics.HasError() {
+		return fmt.Errorf("getting resource schema: %v", schemaResp.Diagnostics)
+	}
+
+	objectType, ok := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	if !ok {
+		return fmt.Errorf("unexpected schema type")
+	}
+
+	attributes := map[string]string{"id": s.id}
+	for k, v := range s.attributes {
+		attributes[k] = v
+	}
+
+	values := map[string]tftypes.Value{}
+	for name, typ := range objectType.AttributeTypes {
+		if v, ok := attributes[name]; ok {
+			values[name] = tftypes.NewValue(tftypes.String, v)
+		} else {
+			values[name] = tftypes.NewValue(typ, nil)
+		}
+	}
+
+	resp := &resource.DeleteResponse{
+		State: tfsdk.State{Schema: schemaResp.Schema},
+	}
+	r.Delete(ctx, resource.DeleteRequest{
+		State: tfsdk.State{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(objectType, values),
+		},
+	}, resp)
+	if resp.Diagnostics.HasError() {
+		return fmt.Errorf("%v", resp.Diagnostics)
+	}
+	return nil
+}
+
+func (s *FrameworkSweepResource) String() string {
+	return s.id
+}
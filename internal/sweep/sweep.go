@@ -0,0 +1,214 @@
+// Package sweep removes the resources left behind by failed acceptance tests.
+//
+// Each service package registers the sweepers of its resources in RegisterSweepers,
+// and sweep_test.go runs them all, in the order of their dependencies:
+//
+//	make sweep SWEEP=KR
+//
+// Only resources whose name starts with ResourcePrefix are removed. Resources belonging to another resource,
+// e.g. rules of an access control group or users of a database, are removed with it: their sweeper,
+// registered by AddChildTestSweepers, runs the one of their parent.
+package sweep
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+)
+
+// ResourcePrefix is the prefix of the names of the resources created by acceptance tests
+const ResourcePrefix = "tf-"
+
+var (
+	clients   = map[string]*conn.ProviderConfig{}
+	clientsMu sync.Mutex
+)
+
+// SharedRegionalSweepClient returns the client of region, configured from the environment as the provider is.
+// Set NCLOUD_SUPPORT_VPC=false to sweep classic resources.
+func SharedRegionalSweepClient(region string) (*conn.ProviderConfig, error) {
+	clientsMu.Lock()
+	defer clientsMu.Unlock()
+
+	if config, ok := clients[region]; ok {
+		return config, nil
+	}
+
+	credentials, err := (&conn.CredentialsChain{
+		Profile:               os.Getenv("NCLOUD_PROFILE"),
+		SharedCredentialsFile: os.Getenv("NCLOUD_SHARED_CREDENTIALS_FILE"),
+	}).Retrieve()
+	if err != nil {
+		return nil, fmt.Errorf("getting credentials for sweepers: %w", err)
+	}
+
+	site := os.Getenv("NCLOUD_SITE")
+	config := &conn.ProviderConfig{
+		Site:       site,
+		SupportVPC: os.Getenv("NCLOUD_SUPPORT_VPC") != "false" || site == "fin",
+	}
+
+	client, err := (&conn.Config{
+		AccessKey:            credentials.AccessKey,
+		SecretKey:            credentials.SecretKey,
+		Region:               region,
+		Site:                 site,
+		MaxRetries:           conn.DefaultMaxRetries,
+		RateLimit:            conn.DefaultRateLimit,
+		RetryableReturnCodes: conn.DefaultRetryableReturnCodes,
+	}).Client()
+	if err != nil {
+		return nil, err
+	}
+	config.Client = client
+
+	if err := conn.SetRegionCache(context.Background(), config); err != nil {
+		return nil, err
+	}
+	if !conn.IsValidRegionCode(config, region) {
+		return nil, fmt.Errorf("no region data for region_code `%s`", region)
+	}
+	config.RegionCode = region
+	if !config.SupportVPC {
+		config.RegionNo = *conn.GetRegionNoByCode(config, region)
+	}
+
+	clients[region] = config
+	return config, nil
+}
+
+// HasResourcePrefix reports whether name is the name of a resource created by acceptance tests
+func HasResourcePrefix(name *string) bool {
+	return name != nil && strings.HasPrefix(*name, ResourcePrefix)
+}
+
+// Sweepable is a resource to remove
+type Sweepable interface {
+	Delete(ctx context.Context) error
+	String() string
+}
+
+// SweepOrchestrator removes resources, collecting errors instead of stopping at the first one
+func SweepOrchestrator(ctx context.Context, sweepables []Sweepable) error {
+	var errs *multierror.Error
+
+	for _, s := range sweepables {
+		log.Printf("[INFO] Sweeping %s", s)
+		if err := s.Delete(ctx); err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("sweeping %s: %w", s, err))
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
+
+// SweepResource is a resource of the SDKv2 provider removed by the Delete of its schema.Resource.
+type SweepResource struct {
+	resource *schema.Resource
+	d        *schema.ResourceData
+	meta     interface{}
+}
+
+// NewSweepResource returns the resource r with id and the attributes its Delete reads, if any
+func NewSweepResource(r *schema.Resource, meta interface{}, id string, attributes map[string]interface{}) *SweepResource {
+	d := r.Data(nil)
+	d.SetId(id)
+	for k, v := range attributes {
+		if err := d.Set(k, v); err != nil {
+			log.Printf("[WARN] setting %s of %s for sweeping: %s", k, id, err)
+		}
+	}
+
+	return &SweepResource{resource: r, d: d, meta: meta}
+}
+
+func (s *SweepResource) Delete(ctx context.Context) error {
+	switch {
+	case s.resource.DeleteContext != nil:
+		return diagError(s.resource.DeleteContext(ctx, s.d, s.meta))
+	case s.resource.DeleteWithoutTimeout != nil:
+		return diagError(s.resource.DeleteWithoutTimeout(ctx, s.d, s.meta))
+	case s.resource.Delete != nil: //nolint:staticcheck
+		return s.resource.Delete(s.d, s.meta) //nolint:staticcheck
+	}
+	return fmt.Errorf("resource has no delete function")
+}
+
+func (s *SweepResource) String() string {
+	return s.d.Id()
+}
+
+// SweepFunc is a resource removed by a function, e.g. when deleting it takes more than its resource Delete
+type SweepFunc struct {
+	ID string
+	F  func(ctx context.Context) error
+}
+
+func (s *SweepFunc) Delete(ctx context.Context) error {
+	return s.F(ctx)
+}
+
+func (s *SweepFunc) String() string {
+	return s.ID
+}
+
+// SkipSweepError reports whether err means the service isn't available in the region or for the account,
+// in which case the sweeper is skipped
+func SkipSweepError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	msg := err.Error()
+	for _, s := range []string{"not supported", "not available", "Not Found Exception", "is not subscribed", "401 Unauthorized"} {
+		if strings.Contains(msg, s) {
+			return true
+		}
+	}
+	return false
+}
+
+func diagError(diags diag.Diagnostics) error {
+	var errs *multierror.Error
+	for _, d := range diags {
+		if d.Severity == diag.Error {
+			errs = multierror.Append(errs, fmt.Errorf("%s: %s", d.Summary, d.Detail))
+		}
+	}
+	return errs.ErrorOrNil()
+}
+
+// sweepers are the dependencies of the sweepers registered by AddTestSweepers, by name
+var sweepers = map[string][]string{}
+
+// AddTestSweepers registers the sweeper s of the resource name
+func AddTestSweepers(name string, s *resource.Sweeper) {
+	sweepers[name] = s.Dependencies
+	resource.AddTestSweepers(name, s)
+}
+
+// Sweepers returns the names of the registered sweepers and their dependencies
+func Sweepers() map[string][]string {
+	return sweepers
+}
+
+// AddChildTestSweepers registers the sweepers of the resources names, removed with the resource parent
+func AddChildTestSweepers(parent string, names ...string) {
+	for _, name := range names {
+		AddTestSweepers(name, &resource.Sweeper{
+			Name:         name,
+			F:            func(string) error { return nil },
+			Dependencies: []string{parent},
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package sweep_test
+
+import (
+	"context"
+	"testing"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/provider"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/provider/fwprovider"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/service/autoscaling"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/service/cdss"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/service/classicloadbalancer"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/service/devtools"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/service/hadoop"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/service/loadbalancer"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/service/mongodb"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/service/mssql"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/service/mysql"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/service/nasvolume"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/service/nks"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/service/objectstorage"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/service/postgresql"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/service/redis"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/service/server"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/service/ses"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/service/vpc"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/sweep"
+)
+
+func TestMain(m *testing.M) {
+	autoscaling.RegisterSweepers()
+	cdss.RegisterSweepers()
+	classicloadbalancer.RegisterSweepers()
+	devtools.RegisterSweepers()
+	hadoop.RegisterSweepers()
+	loadbalancer.RegisterSweepers()
+	mongodb.RegisterSweepers()
+	mssql.RegisterSweepers()
+	mysql.RegisterSweepers()
+	nasvolume.RegisterSweepers()
+	nks.RegisterSweepers()
+	objectstorage.RegisterSweepers()
+	postgresql.RegisterSweepers()
+	redis.RegisterSweepers()
+	server.RegisterSweepers()
+	ses.RegisterSweepers()
+	vpc.RegisterSweepers()
+
+	resource.TestMain(m)
+}
+
+func TestSweepersCoverEveryResource(t *testing.T) {
+	ctx := context.Background()
+	primary := provider.New(ctx)
+	sweepers := sweep.Sweepers()
+
+	for name := range primary.ResourcesMap {
+		if _, ok := sweepers[name]; !ok {
+			t.Errorf("resource %s has no sweeper", name)
+		}
+	}
+
+	for _, f := range fwprovider.New(primary).Resources(ctx) {
+		resp := &fwresource.MetadataResponse{}
+		f().Metadata(ctx, fwresource.MetadataRequest{ProviderTypeName: "ncloud"}, resp)
+		if _, ok := sweepers[resp.TypeName]; !ok {
+			t.Errorf("resource %s has no sweeper", resp.TypeName)
+		}
+	}
+}
+
+func TestSweeperDependenciesAreRegistered(t *testing.T) {
+	sweepers := sweep.Sweepers()
+
+	for name, dependencies := range sweepers {
+		for _, d := range dependencies {
+			if _, ok := sweepers[d]; !ok {
+				t.Errorf("sweeper %s depends on %s, which isn't registered", name, d)
+			}
+		}
+	}
+}
@@ -0,0 +1,48 @@
+package verify
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+)
+
+var _ validator.String = portRangeValidator{}
+
+// portRangeValidator validates that a string Attribute's following the port range format
+type portRangeValidator struct {
+}
+
+// PortRangeValidator returns an validator which ensures that string is a port or a range of ports, like "22" or "1-65535"
+func PortRangeValidator() []validator.String {
+	return []validator.String{
+		portRangeValidator{},
+	}
+}
+
+// Description describes the validation in plain text formatting.
+func (validator portRangeValidator) Description(_ context.Context) string {
+	return "string must be a port or a range of ports from 1 to 65535, like \"22\" or \"1-65535\""
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (validator portRangeValidator) MarkdownDescription(ctx context.Context) string {
+	return validator.Description(ctx)
+}
+
+// Validate performs the validation.
+func (v portRangeValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	_, errs := ValidatePortRange(request.ConfigValue.ValueString(), request.Path.String())
+	for _, err := range errs {
+		response.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+			request.Path,
+			"PortRange Type Validation Error",
+			err.Error(),
+		))
+	}
+}
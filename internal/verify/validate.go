@@ -3,6 +3,7 @@ package verify
 import (
 	"fmt"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -118,3 +119,21 @@ func ValidateDateISO8601(v interface{}, k string) (ws []string, errors []error)
 	}
 	return
 }
+
+// ValidateCodeInList returns an error listing the valid codes of the scope, such as the zone or the region,
+// when code is not one of them
+func ValidateCodeInList(attribute, code string, validCodes []string, scope string) error {
+	for _, c := range validCodes {
+		if c == code {
+			return nil
+		}
+	}
+
+	if len(validCodes) == 0 {
+		return fmt.Errorf("invalid %s %q: no %s is available in %s", attribute, code, attribute, scope)
+	}
+
+	sorted := append([]string{}, validCodes...)
+	sort.Strings(sorted)
+	return fmt.Errorf("invalid %s %q: valid values in %s are %s", attribute, code, scope, strings.Join(sorted, ", "))
+}
@@ -197,3 +197,23 @@ func Test_ValidateDateISO8601(t *testing.T) {
 		}
 	}
 }
+
+func Test_ValidateCodeInList(t *testing.T) {
+	codes := []string{"SVR.VSVR.STAND.C004.M016.NET.SSD.B050.G002", "SVR.VSVR.STAND.C002.M008.NET.SSD.B050.G002"}
+
+	if err := verify.ValidateCodeInList("server_product_code", codes[0], codes, "zone KR-2"); err != nil {
+		t.Fatalf("Expected %q to be valid but %s", codes[0], err)
+	}
+
+	err := verify.ValidateCodeInList("server_product_code", "SVR.VSVR.HICPU", codes, "zone KR-2")
+	expected := `invalid server_product_code "SVR.VSVR.HICPU": valid values in zone KR-2 are ` + codes[1] + ", " + codes[0]
+	if err == nil || err.Error() != expected {
+		t.Fatalf("Expected error %q but %v", expected, err)
+	}
+
+	err = verify.ValidateCodeInList("product_code", "SVR.VDBAS", nil, "region KR")
+	expected = `invalid product_code "SVR.VDBAS": no product_code is available in region KR`
+	if err == nil || err.Error() != expected {
+		t.Fatalf("Expected error %q but %v", expected, err)
+	}
+}
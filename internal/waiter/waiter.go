@@ -0,0 +1,198 @@
+// Package waiter polls the status of NCP objects until they reach a target state.
+//
+// It replaces the per-service loops built on the StateChangeConf of the SDK: status functions are typed,
+// polling is cancelled with the context, the interval between polls grows exponentially and the progress
+// is logged through tflog.
+package waiter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	DefaultMinInterval = 3 * time.Second
+	DefaultMaxInterval = 10 * time.Second
+
+	// StateNotFound is the state of the objects StatusOf can't find anymore
+	StateNotFound = "TERMINATED"
+)
+
+// StatusFunc returns the object being waited for and its current state
+type StatusFunc[T any] func(ctx context.Context) (T, string, error)
+
+// StatusOf builds a StatusFunc of the object returned by get, in the state returned by status.
+// A nil object is in the StateNotFound state.
+func StatusOf[T any](get func(ctx context.Context) (*T, error), status func(*T) string) StatusFunc[*T] {
+	return func(ctx context.Context) (*T, string, error) {
+		obj, err := get(ctx)
+		if err != nil {
+			return nil, "", err
+		}
+		if obj == nil {
+			return nil, StateNotFound, nil
+		}
+		return obj, status(obj), nil
+	}
+}
+
+// Config describes how to wait for an object to reach one of the Target states
+type Config[T any] struct {
+	// Pending are the states the object may pass through. Any state is accepted when empty.
+	Pending []string
+	Target  []string
+	// Failure are the states the object won't leave anymore, such as the error states of a creation
+	Failure []string
+	Refresh StatusFunc[T]
+
+	Timeout time.Duration
+	// Delay is waited before the first poll
+	Delay time.Duration
+	// MinInterval is the interval after the first poll, doubled after each poll up to MaxInterval
+	MinInterval time.Duration
+	MaxInterval time.Duration
+}
+
+// TimeoutError is returned when the object didn't reach the target states in time
+type TimeoutError struct {
+	LastState string
+	Target    []string
+	Timeout   time.Duration
+	LastError error
+}
+
+func (e *TimeoutError) Error() string {
+	msg := fmt.Sprintf("timeout while waiting for state to become '%s' (last state: '%s', timeout: %s)",
+		strings.Join(e.Target, ", "), e.LastState, e.Timeout)
+	if e.LastError != nil {
+		msg += ": " + e.LastError.Error()
+	}
+	return msg
+}
+
+func (e *TimeoutError) Unwrap() error {
+	return e.LastError
+}
+
+// UnexpectedStateError is returned when the object reached a failure state, or one which is neither pending nor a target
+type UnexpectedStateError struct {
+	State  string
+	Target []string
+}
+
+func (e *UnexpectedStateError) Error() string {
+	return fmt.Sprintf("unexpected state '%s', wanted target '%s'", e.State, strings.Join(e.Target, ", "))
+}
+
+// WaitForState polls the status of the object until it reaches one of the target states, and returns it
+func (c *Config[T]) WaitForState(ctx context.Context) (T, error) {
+	var zero T
+
+	if c.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.Timeout)
+		defer cancel()
+	}
+
+	interval := c.MinInterval
+	if interval <= 0 {
+		interval = DefaultMinInterval
+	}
+	maxInterval := c.MaxInterval
+	if maxInterval <= 0 {
+		maxInterval = DefaultMaxInterval
+	}
+	if maxInterval < interval {
+		maxInterval = interval
+	}
+
+	start := time.Now()
+	lastState := ""
+
+	if err := c.sleep(ctx, c.Delay, lastState); err != nil {
+		return zero, err
+	}
+
+	for attempt := 1; ; attempt++ {
+		obj, state, err := c.Refresh(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return zero, c.contextError(ctx, lastState, err)
+			}
+			return zero, err
+		}
+		lastState = state
+
+		switch {
+		case contains(c.Target, state):
+			tflog.Debug(ctx, "Reached target state", map[string]interface{}{
+				"state":   state,
+				"attempt": attempt,
+				"elapsed": time.Since(start).Round(time.Second).String(),
+			})
+			return obj, nil
+		case contains(c.Failure, state), len(c.Pending) > 0 && !contains(c.Pending, state):
+			return obj, &UnexpectedStateError{State: state, Target: c.Target}
+		}
+
+		tflog.Debug(ctx, "Waiting for target state", map[string]interface{}{
+			"state":     state,
+			"target":    c.Target,
+			"attempt":   attempt,
+			"elapsed":   time.Since(start).Round(time.Second).String(),
+			"next_poll": interval.String(),
+		})
+
+		if err := c.sleep(ctx, interval, lastState); err != nil {
+			return obj, err
+		}
+
+		interval *= 2
+		if interval > maxInterval {
+			interval = maxInterval
+		}
+	}
+}
+
+func (c *Config[T]) sleep(ctx context.Context, d time.Duration, lastState string) error {
+	if d <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return c.contextError(ctx, lastState, nil)
+	case <-timer.C:
+		return nil
+	}
+}
+
+// contextError tells the timeout of the wait apart from the cancellation of the operation
+func (c *Config[T]) contextError(ctx context.Context, lastState string, lastErr error) error {
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return &TimeoutError{
+			LastState: lastState,
+			Target:    c.Target,
+			Timeout:   c.Timeout,
+			LastError: lastErr,
+		}
+	}
+	return ctx.Err()
+}
+
+func contains(states []string, state string) bool {
+	for _, s := range states {
+		if s == state {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,180 @@
+package waiter
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+type object struct {
+	status string
+}
+
+// statuses returns the states in order, then stays in the last one
+func statuses(states ...string) (StatusFunc[*object], *int) {
+	calls := 0
+	return func(ctx context.Context) (*object, string, error) {
+		state := states[len(states)-1]
+		if calls < len(states) {
+			state = states[calls]
+		}
+		calls++
+		return &object{status: state}, state, nil
+	}, &calls
+}
+
+func TestWaitForState_target(t *testing.T) {
+	refresh, calls := statuses("INIT", "CREAT", "RUN")
+
+	obj, err := (&Config[*object]{
+		Pending:     []string{"INIT", "CREAT"},
+		Target:      []string{"RUN"},
+		Refresh:     refresh,
+		Timeout:     time.Second,
+		MinInterval: time.Millisecond,
+	}).WaitForState(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if obj.status != "RUN" {
+		t.Errorf("expected the object in RUN but %s", obj.status)
+	}
+	if *calls != 3 {
+		t.Errorf("expected 3 polls but %d", *calls)
+	}
+}
+
+func TestWaitForState_timeout(t *testing.T) {
+	refresh, _ := statuses("CREAT")
+
+	_, err := (&Config[*object]{
+		Pending:     []string{"CREAT"},
+		Target:      []string{"RUN"},
+		Refresh:     refresh,
+		Timeout:     20 * time.Millisecond,
+		MinInterval: time.Millisecond,
+	}).WaitForState(context.Background())
+
+	var timeoutErr *TimeoutError
+	if !errors.As(err, &timeoutErr) {
+		t.Fatalf("expected a timeout error but %v", err)
+	}
+	if timeoutErr.LastState != "CREAT" {
+		t.Errorf("expected the last state CREAT but %s", timeoutErr.LastState)
+	}
+}
+
+func TestWaitForState_failure(t *testing.T) {
+	refresh, _ := statuses("CREAT", "ERROR")
+
+	obj, err := (&Config[*object]{
+		Target:      []string{"RUN"},
+		Failure:     []string{"ERROR"},
+		Refresh:     refresh,
+		Timeout:     time.Second,
+		MinInterval: time.Millisecond,
+	}).WaitForState(context.Background())
+
+	var stateErr *UnexpectedStateError
+	if !errors.As(err, &stateErr) {
+		t.Fatalf("expected an unexpected state error but %v", err)
+	}
+	if stateErr.State != "ERROR" || obj.status != "ERROR" {
+		t.Errorf("expected the failure state ERROR but %s", stateErr.State)
+	}
+}
+
+func TestWaitForState_unexpectedState(t *testing.T) {
+	refresh, _ := statuses("INIT", "TERMT")
+
+	_, err := (&Config[*object]{
+		Pending:     []string{"INIT", "CREAT"},
+		Target:      []string{"RUN"},
+		Refresh:     refresh,
+		Timeout:     time.Second,
+		MinInterval: time.Millisecond,
+	}).WaitForState(context.Background())
+
+	var stateErr *UnexpectedStateError
+	if !errors.As(err, &stateErr) || stateErr.State != "TERMT" {
+		t.Fatalf("expected the unexpected state TERMT but %v", err)
+	}
+}
+
+func TestWaitForState_refreshError(t *testing.T) {
+	refreshErr := errors.New("refresh failed")
+
+	_, err := (&Config[*object]{
+		Target: []string{"RUN"},
+		Refresh: func(ctx context.Context) (*object, string, error) {
+			return nil, "", refreshErr
+		},
+		Timeout: time.Second,
+	}).WaitForState(context.Background())
+	if !errors.Is(err, refreshErr) {
+		t.Fatalf("expected the refresh error but %v", err)
+	}
+}
+
+func TestWaitForState_cancel(t *testing.T) {
+	refresh, _ := statuses("CREAT")
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := (&Config[*object]{
+		Pending: []string{"CREAT"},
+		Target:  []string{"RUN"},
+		Refresh: refresh,
+		Timeout: time.Second,
+		Delay:   time.Second,
+	}).WaitForState(ctx)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected the cancellation but %v", err)
+	}
+}
+
+func TestWaitForState_exponentialInterval(t *testing.T) {
+	var polls []time.Time
+	_, err := (&Config[*object]{
+		Target: []string{"RUN"},
+		Refresh: func(ctx context.Context) (*object, string, error) {
+			polls = append(polls, time.Now())
+			if len(polls) == 4 {
+				return &object{}, "RUN", nil
+			}
+			return &object{}, "CREAT", nil
+		},
+		Timeout:     time.Second,
+		MinInterval: 10 * time.Millisecond,
+		MaxInterval: 20 * time.Millisecond,
+	}).WaitForState(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// 10ms, then 20ms twice as the interval is capped
+	for i, min := range []time.Duration{10 * time.Millisecond, 20 * time.Millisecond, 20 * time.Millisecond} {
+		if d := polls[i+1].Sub(polls[i]); d < min {
+			t.Errorf("expected poll %d at least %s after the previous one but %s", i+2, min, d)
+		}
+	}
+}
+
+func TestStatusOf(t *testing.T) {
+	var found *object
+	refresh := StatusOf(func(ctx context.Context) (*object, error) {
+		return found, nil
+	}, func(o *object) string {
+		return o.status
+	})
+
+	if _, state, err := refresh(context.Background()); err != nil || state != StateNotFound {
+		t.Errorf("expected %s but %s (%v)", StateNotFound, state, err)
+	}
+
+	found = &object{status: "RUN"}
+	if obj, state, err := refresh(context.Background()); err != nil || state != "RUN" || obj != found {
+		t.Errorf("expected the object in RUN but %s (%v)", state, err)
+	}
+}
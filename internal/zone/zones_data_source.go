@@ -74,7 +74,10 @@ func getClassicZones(config *conn.ProviderConfig) ([]*Zone, error) {
 	client := config.Client
 	regionNo := config.RegionNo
 
-	resp, err := client.Server.V2Api.GetZoneList(&server.GetZoneListRequest{RegionNo: &regionNo})
+	reqParams := &server.GetZoneListRequest{RegionNo: &regionNo}
+	resp, err := conn.CachedLookup(config, conn.CatalogKey("server.GetZoneList", reqParams), func() (*server.GetZoneListResponse, error) {
+		return client.Server.V2Api.GetZoneList(reqParams)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -96,7 +99,10 @@ func getVpcZones(config *conn.ProviderConfig) ([]*Zone, error) {
 	client := config.Client
 	regionCode := config.RegionCode
 
-	resp, err := client.Vserver.V2Api.GetZoneList(&vserver.GetZoneListRequest{RegionCode: &regionCode})
+	reqParams := &vserver.GetZoneListRequest{RegionCode: &regionCode}
+	resp, err := conn.CachedLookup(config, conn.CatalogKey("vserver.GetZoneList", reqParams), func() (*vserver.GetZoneListResponse, error) {
+		return client.Vserver.V2Api.GetZoneList(reqParams)
+	})
 	if err != nil {
 		return nil, err
 	}